@@ -0,0 +1,174 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultChunkSize = 10000
+
+// Series is a chunk of a query result.
+type Series struct {
+	Name    string            `json:"name"`
+	Tags    map[string]string `json:"tags"`
+	Columns []string          `json:"columns"`
+	Values  [][]interface{}   `json:"values"`
+}
+
+type queryResult struct {
+	Series []*Series `json:"series"`
+	Err    string    `json:"error"`
+}
+
+type queryResponse struct {
+	Results []queryResult `json:"results"`
+	Err     string        `json:"error"`
+}
+
+// ClientConfig holds the connection options shared by export and import.
+type ClientConfig struct {
+	Host      string
+	Username  string
+	Password  string
+	SSL       bool
+	UnsafeSSL bool
+	ChunkSize int
+	Timeout   time.Duration
+}
+
+// Client talks to the HTTP service of ts-sql, so that both export and import
+// go through the normal query and write path of the cluster.
+type Client struct {
+	addr      string
+	username  string
+	password  string
+	chunkSize int
+	http      *http.Client
+}
+
+func NewClient(conf *ClientConfig) *Client {
+	scheme := "http"
+	transport := &http.Transport{}
+	if conf.SSL {
+		scheme = "https"
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: conf.UnsafeSSL} // #nosec
+	}
+	chunkSize := conf.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = defaultChunkSize
+	}
+	return &Client{
+		addr:      scheme + "://" + conf.Host,
+		username:  conf.Username,
+		password:  conf.Password,
+		chunkSize: chunkSize,
+		http:      &http.Client{Transport: transport, Timeout: conf.Timeout},
+	}
+}
+
+// Query executes the statement on the database and calls fn for every series
+// chunk of the result.
+func (c *Client) Query(db, q string, fn func(*Series) error) error {
+	form := url.Values{}
+	form.Set("db", db)
+	form.Set("q", q)
+	form.Set("epoch", "ns")
+	form.Set("chunked", "true")
+	form.Set("chunk_size", strconv.Itoa(c.chunkSize))
+
+	req, err := http.NewRequest(http.MethodPost, c.addr+"/query", strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	for {
+		var r queryResponse
+		if err := dec.Decode(&r); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("decode query response: %v", err)
+		}
+		if r.Err != "" {
+			return fmt.Errorf("query %q: %s", q, r.Err)
+		}
+		for i := range r.Results {
+			if r.Results[i].Err != "" {
+				return fmt.Errorf("query %q: %s", q, r.Results[i].Err)
+			}
+			for _, s := range r.Results[i].Series {
+				if err := fn(s); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// Write writes a batch of line protocol with nanosecond timestamps.
+func (c *Client) Write(db, rp string, lines []byte) error {
+	params := url.Values{}
+	params.Set("db", db)
+	if rp != "" {
+		params.Set("rp", rp)
+	}
+	params.Set("precision", "ns")
+	req, err := http.NewRequest(http.MethodPost, c.addr+"/write?"+params.Encode(), bytes.NewReader(lines))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+	resp, err := c.do(req)
+	if err != nil {
+		return err
+	}
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.Body.Close()
+}
+
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	if c.username != "" {
+		req.SetBasicAuth(c.username, c.password)
+	}
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("%s %s: %s: %s", req.Method, req.URL.Path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return resp, nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	showMeasurementsResponse = `{"results":[{"statement_id":0,"series":[{"name":"measurements","columns":["name"],"values":[["cpu"],["mem"]]}]}]}`
+
+	cpuTagKeysResponse   = `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["tagKey"],"values":[["region"],["host"]]}]}]}`
+	cpuFieldKeysResponse = `{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["fieldKey","fieldType"],"values":[["count","integer"],["msg","string"],["ok","boolean"],["value","float"]]}]}]}`
+	cpuSelectResponse    = `{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"h 1","region":""},"columns":["time","count","msg","ok","value"],"values":[[1000,1,"a \"b\"",true,1.5],[2000,null,null,null,null],[3000,3,null,false,3.25]],"partial":true}],"partial":true}]}
+{"results":[{"statement_id":0,"series":[{"name":"cpu","tags":{"host":"h2","region":"r=1"},"columns":["time","count","msg","ok","value"],"values":[[2000,12345678901234,null,null,2]]}]}]}
+`
+	memTagKeysResponse   = `{"results":[{"statement_id":0}]}`
+	memFieldKeysResponse = `{"results":[{"statement_id":0,"series":[{"name":"mem","columns":["fieldKey","fieldType"],"values":[["used","integer"]]}]}]}`
+	memSelectResponse    = `{"results":[{"statement_id":0,"series":[{"name":"mem","columns":["time","used"],"values":[[1000,10],[5000,50]]}]}]}`
+)
+
+type mockServer struct {
+	*httptest.Server
+	mu      sync.Mutex
+	queries []string
+	writes  []string
+	params  []string
+}
+
+func newMockServer(t *testing.T) *mockServer {
+	s := &mockServer{}
+	responses := map[string]string{
+		"SHOW MEASUREMENTS ON db0":        showMeasurementsResponse,
+		"SHOW TAG KEYS ON db0 FROM cpu":   cpuTagKeysResponse,
+		"SHOW FIELD KEYS ON db0 FROM cpu": cpuFieldKeysResponse,
+		fmt.Sprintf("SELECT * FROM db0..cpu WHERE time >= %d AND time < %d GROUP BY *", influxql.MinTime, influxql.MaxTime): cpuSelectResponse,
+		"SHOW TAG KEYS ON db0 FROM mem":   memTagKeysResponse,
+		"SHOW FIELD KEYS ON db0 FROM mem": memFieldKeysResponse,
+		fmt.Sprintf("SELECT * FROM db0..mem WHERE time >= %d AND time < %d GROUP BY *", influxql.MinTime, influxql.MaxTime): memSelectResponse,
+		"CREATE DATABASE db1": `{"results":[{"statement_id":0}]}`,
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		switch r.URL.Path {
+		case "/query":
+			q := r.FormValue("q")
+			s.queries = append(s.queries, q)
+			require.Equal(t, "ns", r.FormValue("epoch"))
+			resp, ok := responses[q]
+			if !ok {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"error":"unexpected query"}`))
+				return
+			}
+			_, _ = w.Write([]byte(resp))
+		case "/write":
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			s.writes = append(s.writes, string(body))
+			s.params = append(s.params, r.URL.RawQuery)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *mockServer) clientConfig() ClientConfig {
+	return ClientConfig{Host: strings.TrimPrefix(s.URL, "http://")}
+}
+
+func TestExportImport_Line(t *testing.T) {
+	s := newMockServer(t)
+	dir := t.TempDir()
+	err := Export(&ExportConfig{
+		ClientConfig: s.clientConfig(),
+		Database:     "db0",
+		Start:        influxql.MinTime,
+		End:          influxql.MaxTime,
+		Format:       FormatLine,
+		Out:          dir,
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "cpu.lp"))
+	require.NoError(t, err)
+	require.Equal(t, `cpu,host=h\ 1 count=1i,msg="a \"b\"",ok=true,value=1.5 1000
+cpu,host=h\ 1 count=3i,ok=false,value=3.25 3000
+cpu,host=h2,region=r\=1 count=12345678901234i,value=2 2000
+`, string(data))
+
+	manifest, err := ReadManifest(dir)
+	require.NoError(t, err)
+	require.Equal(t, "db0", manifest.Database)
+	require.Equal(t, FormatLine, manifest.Format)
+	require.Equal(t, 2, len(manifest.Measurements))
+	require.Equal(t, &MeasurementFile{
+		Name:   "cpu",
+		File:   "cpu.lp",
+		Tags:   []string{"host", "region"},
+		Fields: map[string]string{"count": "integer", "msg": "string", "ok": "boolean", "value": "float"},
+		Rows:   3,
+	}, manifest.Measurements[0])
+	require.Equal(t, int64(2), manifest.Measurements[1].Rows)
+
+	// restore a single measurement and a time window into another database
+	err = Import(&ImportConfig{
+		ClientConfig:   s.clientConfig(),
+		Database:       "db1",
+		Measurements:   []string{"cpu"},
+		Start:          1000,
+		End:            3000,
+		In:             dir,
+		BatchSize:      1,
+		CreateDatabase: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "CREATE DATABASE db1", s.queries[len(s.queries)-1])
+	require.Equal(t, []string{
+		`cpu,host=h\ 1 count=1i,msg="a \"b\"",ok=true,value=1.5 1000` + "\n",
+		`cpu,host=h2,region=r\=1 count=12345678901234i,value=2 2000` + "\n",
+	}, s.writes)
+	require.Equal(t, "db=db1&precision=ns", s.params[0])
+}
+
+func TestExportImport_Parquet(t *testing.T) {
+	s := newMockServer(t)
+	dir := t.TempDir()
+	err := Export(&ExportConfig{
+		ClientConfig: s.clientConfig(),
+		Database:     "db0",
+		Measurements: []string{"cpu", "mem"},
+		Start:        influxql.MinTime,
+		End:          influxql.MaxTime,
+		Format:       FormatParquet,
+		Out:          dir,
+	})
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "cpu.parquet"))
+	require.NoError(t, err)
+
+	err = Import(&ImportConfig{
+		ClientConfig:    s.clientConfig(),
+		RetentionPolicy: "rp1",
+		Start:           influxql.MinTime,
+		End:             influxql.MaxTime,
+		In:              dir,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		`cpu,host=h\ 1 count=1i,msg="a \"b\"",ok=true,value=1.5 1000` + "\n" +
+			`cpu,host=h\ 1 count=3i,ok=false,value=3.25 3000` + "\n" +
+			`cpu,host=h2,region=r\=1 count=12345678901234i,value=2 2000` + "\n",
+		"mem used=10i 1000\nmem used=50i 5000\n",
+	}, s.writes)
+	require.Equal(t, "db=db0&precision=ns&rp=rp1", s.params[0])
+}
+
+func TestExport_Error(t *testing.T) {
+	s := newMockServer(t)
+	conf := &ExportConfig{
+		ClientConfig: s.clientConfig(),
+		Database:     "db0",
+		Measurements: []string{"disk"},
+		Start:        influxql.MinTime,
+		End:          influxql.MaxTime,
+		Format:       FormatLine,
+		Out:          t.TempDir(),
+	}
+	require.EqualError(t, Export(conf), `export measurement disk: POST /query: 400 Bad Request: {"error":"unexpected query"}`)
+
+	conf.Format = "csv"
+	require.EqualError(t, Export(conf), `invalid format "csv", expect line or parquet`)
+
+	err := Import(&ImportConfig{ClientConfig: s.clientConfig(), Start: 0, End: 1, In: t.TempDir()})
+	require.Error(t, err)
+}
@@ -0,0 +1,515 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	FormatLine    = "line"
+	FormatParquet = "parquet"
+
+	ManifestFile = "manifest.json"
+)
+
+// Manifest describes an export, it is written next to the data files and
+// read back by the importer.
+type Manifest struct {
+	Database        string             `json:"database"`
+	RetentionPolicy string             `json:"retentionPolicy"`
+	Format          string             `json:"format"`
+	Start           int64              `json:"start"`
+	End             int64              `json:"end"`
+	Measurements    []*MeasurementFile `json:"measurements"`
+}
+
+type MeasurementFile struct {
+	Name   string            `json:"name"`
+	File   string            `json:"file"`
+	Tags   []string          `json:"tags"`
+	Fields map[string]string `json:"fields"`
+	Rows   int64             `json:"rows"`
+}
+
+func ReadManifest(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	m := &Manifest{}
+	if err := json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("parse %s: %v", ManifestFile, err)
+	}
+	return m, nil
+}
+
+func (m *Manifest) write(dir string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(dir, ManifestFile+".tmp")
+	if err := os.WriteFile(tmp, data, 0640); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, ManifestFile))
+}
+
+type ExportConfig struct {
+	ClientConfig
+	Database        string
+	RetentionPolicy string
+	Measurements    []string
+	Start           int64
+	End             int64
+	Format          string
+	Out             string
+}
+
+func (c *ExportConfig) validate() error {
+	if c.Database == "" {
+		return fmt.Errorf("missing required parameter: database")
+	}
+	if c.Out == "" {
+		return fmt.Errorf("missing required parameter: out")
+	}
+	if c.Format != FormatLine && c.Format != FormatParquet {
+		return fmt.Errorf("invalid format %q, expect %s or %s", c.Format, FormatLine, FormatParquet)
+	}
+	if c.Start >= c.End {
+		return fmt.Errorf("start time must be before end time")
+	}
+	return nil
+}
+
+// Export reads the measurements of a database through the query path and
+// writes one file per measurement, so the data can be restored into a cluster
+// of any size with Import.
+func Export(conf *ExportConfig) error {
+	if err := conf.validate(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(conf.Out, 0750); err != nil {
+		return err
+	}
+	client := NewClient(&conf.ClientConfig)
+
+	measurements := conf.Measurements
+	if len(measurements) == 0 {
+		var err error
+		if measurements, err = showMeasurements(client, conf.Database); err != nil {
+			return err
+		}
+	}
+
+	manifest := &Manifest{
+		Database:        conf.Database,
+		RetentionPolicy: conf.RetentionPolicy,
+		Format:          conf.Format,
+		Start:           conf.Start,
+		End:             conf.End,
+	}
+	for _, mst := range measurements {
+		mf, err := exportMeasurement(client, conf, mst)
+		if err != nil {
+			return fmt.Errorf("export measurement %s: %v", mst, err)
+		}
+		fmt.Printf("exported %d rows of measurement %s\n", mf.Rows, mst)
+		manifest.Measurements = append(manifest.Measurements, mf)
+	}
+	return manifest.write(conf.Out)
+}
+
+func showMeasurements(client *Client, db string) ([]string, error) {
+	var measurements []string
+	err := client.Query(db, "SHOW MEASUREMENTS ON "+influxql.QuoteIdent(db), func(s *Series) error {
+		for _, v := range s.Values {
+			if name, ok := v[0].(string); ok {
+				measurements = append(measurements, name)
+			}
+		}
+		return nil
+	})
+	return measurements, err
+}
+
+func measurementSource(rp, mst string) string {
+	if rp == "" {
+		return influxql.QuoteIdent(mst)
+	}
+	return influxql.QuoteIdent(rp, mst)
+}
+
+func exportMeasurement(client *Client, conf *ExportConfig, mst string) (*MeasurementFile, error) {
+	mf := &MeasurementFile{Name: mst, Fields: make(map[string]string)}
+	db, source := influxql.QuoteIdent(conf.Database), measurementSource(conf.RetentionPolicy, mst)
+
+	err := client.Query(conf.Database, fmt.Sprintf("SHOW TAG KEYS ON %s FROM %s", db, source), func(s *Series) error {
+		for _, v := range s.Values {
+			if key, ok := v[0].(string); ok {
+				mf.Tags = append(mf.Tags, key)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(mf.Tags)
+
+	err = client.Query(conf.Database, fmt.Sprintf("SHOW FIELD KEYS ON %s FROM %s", db, source), func(s *Series) error {
+		for _, v := range s.Values {
+			key, _ := v[0].(string)
+			typ, _ := v[1].(string)
+			if _, ok := mf.Fields[key]; !ok {
+				mf.Fields[key] = typ
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var w seriesWriter
+	if conf.Format == FormatParquet {
+		mf.File = url.PathEscape(mst) + ".parquet"
+		w, err = newParquetSeriesWriter(filepath.Join(conf.Out, mf.File), mf)
+	} else {
+		mf.File = url.PathEscape(mst) + ".lp"
+		w, err = newLineSeriesWriter(filepath.Join(conf.Out, mf.File), mf)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var rp string
+	if conf.RetentionPolicy != "" {
+		rp = influxql.QuoteIdent(conf.RetentionPolicy)
+	}
+	q := fmt.Sprintf("SELECT * FROM %s.%s.%s WHERE time >= %d AND time < %d GROUP BY *",
+		db, rp, influxql.QuoteIdent(mst), conf.Start, conf.End)
+	err = client.Query(conf.Database, q, func(s *Series) error {
+		rows, err := w.WriteSeries(s)
+		mf.Rows += int64(rows)
+		return err
+	})
+	if err != nil {
+		w.Abort()
+		return nil, err
+	}
+	return mf, w.Close()
+}
+
+// seriesWriter writes the chunks of a SELECT * ... GROUP BY * query, the first
+// column of each chunk is the time and the others are fields.
+type seriesWriter interface {
+	WriteSeries(s *Series) (int, error)
+	Close() error
+	Abort()
+}
+
+type lineSeriesWriter struct {
+	mf    *MeasurementFile
+	types map[string]uint8
+	file  *os.File
+	w     *bufio.Writer
+	buf   []byte
+}
+
+func newLineSeriesWriter(path string, mf *MeasurementFile) (*lineSeriesWriter, error) {
+	types, err := mf.fieldTypes()
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, err
+	}
+	return &lineSeriesWriter{mf: mf, types: types, file: f, w: bufio.NewWriterSize(f, 1<<20)}, nil
+}
+
+func (w *lineSeriesWriter) WriteSeries(s *Series) (int, error) {
+	key := appendSeriesKey(nil, w.mf.Name, s.Tags, sortedTagKeys(s.Tags))
+	rows := 0
+	for _, values := range s.Values {
+		w.buf = append(w.buf[:0], key...)
+		sep := byte(' ')
+		var err error
+		for i := 1; i < len(values); i++ {
+			if values[i] == nil {
+				continue
+			}
+			w.buf = append(w.buf, sep)
+			w.buf = append(w.buf, keyEscaper.Replace(s.Columns[i])...)
+			w.buf = append(w.buf, '=')
+			if w.buf, err = appendFieldValue(w.buf, w.types[s.Columns[i]], values[i]); err != nil {
+				return rows, fmt.Errorf("field %s: %v", s.Columns[i], err)
+			}
+			sep = ','
+		}
+		if sep == ' ' {
+			// all fields are null
+			continue
+		}
+		tm, ok := values[0].(json.Number)
+		if !ok {
+			return rows, fmt.Errorf("unexpected time %v", values[0])
+		}
+		w.buf = append(w.buf, ' ')
+		w.buf = append(w.buf, tm...)
+		w.buf = append(w.buf, '\n')
+		if _, err = w.w.Write(w.buf); err != nil {
+			return rows, err
+		}
+		rows++
+	}
+	return rows, nil
+}
+
+func (w *lineSeriesWriter) Close() error {
+	if err := w.w.Flush(); err != nil {
+		_ = w.file.Close()
+		return err
+	}
+	if err := w.file.Sync(); err != nil {
+		_ = w.file.Close()
+		return err
+	}
+	return w.file.Close()
+}
+
+func (w *lineSeriesWriter) Abort() {
+	_ = w.file.Close()
+	_ = os.Remove(w.file.Name())
+}
+
+type parquetSeriesWriter struct {
+	path   string
+	w      *parquet.Writer
+	schema record.Schemas
+}
+
+func newParquetSeriesWriter(path string, mf *MeasurementFile) (*parquetSeriesWriter, error) {
+	types, err := mf.fieldTypes()
+	if err != nil {
+		return nil, err
+	}
+	schemas := make(map[string]uint8, len(mf.Tags)+len(types)+1)
+	schema := make(record.Schemas, 0, len(types)+1)
+	for name, typ := range types {
+		schemas[name] = typ
+		schema = append(schema, record.Field{Name: name, Type: int(typ)})
+	}
+	sort.Sort(schema)
+	for _, tag := range mf.Tags {
+		if _, ok := schemas[tag]; ok || tag == record.TimeField {
+			return nil, fmt.Errorf("tag key %s conflicts with a field", tag)
+		}
+		schemas[tag] = influx.Field_Type_String
+	}
+	schemas[record.TimeField] = influx.Field_Type_Int
+	schema = append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+
+	w, err := parquet.NewWriter(path, "", parquet.MetaData{Mst: mf.Name, Schemas: schemas})
+	if err != nil {
+		return nil, err
+	}
+	return &parquetSeriesWriter{path: path, w: w, schema: schema}, nil
+}
+
+func (w *parquetSeriesWriter) WriteSeries(s *Series) (int, error) {
+	columns := make([]int, len(w.schema)-1)
+	for i := range columns {
+		columns[i] = -1
+		for j := 1; j < len(s.Columns); j++ {
+			if s.Columns[j] == w.schema[i].Name {
+				columns[i] = j
+				break
+			}
+		}
+	}
+
+	rec := record.NewRecordBuilder(w.schema)
+	timeCol := rec.Column(len(w.schema) - 1)
+	for _, values := range s.Values {
+		if allNull(values[1:]) {
+			continue
+		}
+		tm, err := toInt64(values[0])
+		if err != nil {
+			return 0, fmt.Errorf("unexpected time %v", values[0])
+		}
+		timeCol.AppendInteger(tm)
+		for i, j := range columns {
+			var v interface{}
+			if j >= 0 {
+				v = values[j]
+			}
+			if err := appendColumnValue(rec.Column(i), w.schema[i].Type, v); err != nil {
+				return 0, fmt.Errorf("field %s: %v", w.schema[i].Name, err)
+			}
+		}
+	}
+	if rec.RowNums() == 0 {
+		return 0, nil
+	}
+
+	tags := make(map[string]string, len(s.Tags))
+	for k, v := range s.Tags {
+		if v != "" {
+			tags[k] = v
+		}
+	}
+	if err := w.w.WriteRecord(tags, rec); err != nil {
+		return 0, err
+	}
+	return rec.RowNums(), nil
+}
+
+func (w *parquetSeriesWriter) Close() error {
+	defer w.w.Close()
+	return w.w.WriteStop()
+}
+
+func (w *parquetSeriesWriter) Abort() {
+	w.w.Close()
+	_ = os.Remove(w.path + ".tmp")
+}
+
+func appendColumnValue(col *record.ColVal, typ int, v interface{}) error {
+	var err error
+	switch typ {
+	case influx.Field_Type_Float:
+		if v == nil {
+			col.AppendFloatNull()
+			return nil
+		}
+		n, ok := v.(json.Number)
+		if !ok {
+			break
+		}
+		var f float64
+		if f, err = n.Float64(); err == nil {
+			col.AppendFloat(f)
+			return nil
+		}
+	case influx.Field_Type_Int:
+		if v == nil {
+			col.AppendIntegerNull()
+			return nil
+		}
+		var i int64
+		if i, err = toInt64(v); err == nil {
+			col.AppendInteger(i)
+			return nil
+		}
+	case influx.Field_Type_UInt:
+		if v == nil {
+			col.AppendUnsignedNull()
+			return nil
+		}
+		var u uint64
+		if u, err = toUint64(v); err == nil {
+			col.AppendUnsigned(u)
+			return nil
+		}
+	case influx.Field_Type_String:
+		if v == nil {
+			col.AppendStringNull()
+			return nil
+		}
+		if s, ok := v.(string); ok {
+			col.AppendString(s)
+			return nil
+		}
+	case influx.Field_Type_Boolean:
+		if v == nil {
+			col.AppendBooleanNull()
+			return nil
+		}
+		if b, ok := v.(bool); ok {
+			col.AppendBoolean(b)
+			return nil
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("unexpected value %v of type %T", v, v)
+}
+
+func allNull(values []interface{}) bool {
+	for _, v := range values {
+		if v != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func toInt64(v interface{}) (int64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v of type %T", v, v)
+	}
+	return n.Int64()
+}
+
+func toUint64(v interface{}) (uint64, error) {
+	n, ok := v.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v of type %T", v, v)
+	}
+	return strconv.ParseUint(string(n), 10, 64)
+}
+
+func (mf *MeasurementFile) fieldTypes() (map[string]uint8, error) {
+	types := make(map[string]uint8, len(mf.Fields))
+	for name, typ := range mf.Fields {
+		t, err := parseFieldType(typ)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %v", name, err)
+		}
+		types[name] = t
+	}
+	return types, nil
+}
+
+// ParseTime parses a RFC3339 time, an empty string means def.
+func ParseTime(s string, def int64) (int64, error) {
+	if s == "" {
+		return def, nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return 0, err
+	}
+	return t.UnixNano(), nil
+}
@@ -0,0 +1,284 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const defaultBatchSize = 5000
+
+type ImportConfig struct {
+	ClientConfig
+	// Database and RetentionPolicy override the ones recorded in the manifest
+	Database        string
+	RetentionPolicy string
+	Measurements    []string
+	Start           int64
+	End             int64
+	In              string
+	BatchSize       int
+	CreateDatabase  bool
+}
+
+// Import writes an export made by Export through the write path of the
+// cluster. Only the selected measurements and points in [Start, End) are
+// restored.
+func Import(conf *ImportConfig) error {
+	if conf.In == "" {
+		return fmt.Errorf("missing required parameter: in")
+	}
+	if conf.Start >= conf.End {
+		return fmt.Errorf("start time must be before end time")
+	}
+	manifest, err := ReadManifest(conf.In)
+	if err != nil {
+		return err
+	}
+	db, rp := manifest.Database, manifest.RetentionPolicy
+	if conf.Database != "" {
+		db = conf.Database
+	}
+	if conf.RetentionPolicy != "" {
+		rp = conf.RetentionPolicy
+	}
+
+	client := NewClient(&conf.ClientConfig)
+	if conf.CreateDatabase {
+		if err = client.Query(db, "CREATE DATABASE "+influxql.QuoteIdent(db), func(*Series) error { return nil }); err != nil {
+			return err
+		}
+	}
+
+	selected := make(map[string]bool, len(conf.Measurements))
+	for _, mst := range conf.Measurements {
+		selected[mst] = true
+	}
+	for _, mf := range manifest.Measurements {
+		if len(selected) > 0 && !selected[mf.Name] {
+			continue
+		}
+		w := &batchWriter{client: client, db: db, rp: rp, size: conf.BatchSize, start: conf.Start, end: conf.End}
+		if w.size <= 0 {
+			w.size = defaultBatchSize
+		}
+		path := filepath.Join(conf.In, mf.File)
+		switch manifest.Format {
+		case FormatLine:
+			err = importLineFile(path, w)
+		case FormatParquet:
+			err = importParquetFile(path, mf, w)
+		default:
+			err = fmt.Errorf("invalid format %q", manifest.Format)
+		}
+		if err == nil {
+			err = w.flush()
+		}
+		if err != nil {
+			return fmt.Errorf("import measurement %s: %v", mf.Name, err)
+		}
+		fmt.Printf("imported %d rows of measurement %s\n", w.rows, mf.Name)
+	}
+	return nil
+}
+
+type batchWriter struct {
+	client *Client
+	db     string
+	rp     string
+	size   int
+	start  int64
+	end    int64
+
+	buf   []byte
+	lines int
+	rows  int64
+}
+
+// add appends a line without the trailing newline if its time is selected.
+func (w *batchWriter) add(line []byte, tm int64) error {
+	if tm < w.start || tm >= w.end {
+		return nil
+	}
+	w.buf = append(w.buf, line...)
+	w.buf = append(w.buf, '\n')
+	w.lines++
+	if w.lines >= w.size {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *batchWriter) flush() error {
+	if w.lines == 0 {
+		return nil
+	}
+	if err := w.client.Write(w.db, w.rp, w.buf); err != nil {
+		return err
+	}
+	w.rows += int64(w.lines)
+	w.buf = w.buf[:0]
+	w.lines = 0
+	return nil
+}
+
+func importLineFile(path string, w *batchWriter) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 1<<20), 64<<20)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 || line[0] == '#' {
+			continue
+		}
+		tm, err := lineTime(string(line))
+		if err != nil {
+			return err
+		}
+		if err = w.add(line, tm); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+func importParquetFile(path string, mf *MeasurementFile, w *batchWriter) error {
+	types, err := mf.fieldTypes()
+	if err != nil {
+		return err
+	}
+	fields := make([]string, 0, len(types))
+	for name := range types {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+
+	pf, err := file.OpenParquetFile(path, false)
+	if err != nil {
+		return err
+	}
+	defer pf.Close()
+	fr, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{BatchSize: int64(w.size)}, memory.DefaultAllocator)
+	if err != nil {
+		return err
+	}
+	rr, err := fr.GetRecordReader(context.Background(), nil, nil)
+	if err != nil {
+		return err
+	}
+	defer rr.Release()
+
+	var line []byte
+	tags := make(map[string]string, len(mf.Tags))
+	for rr.Next() {
+		rec := rr.Record()
+		schema := rec.Schema()
+		column := func(name string) arrow.Array {
+			if idx := schema.FieldIndices(name); len(idx) > 0 {
+				return rec.Column(idx[0])
+			}
+			return nil
+		}
+		times, ok := column("time").(*array.Timestamp)
+		if !ok {
+			return fmt.Errorf("missing time column in %s", path)
+		}
+		tagCols := make([]arrow.Array, len(mf.Tags))
+		for i, tag := range mf.Tags {
+			tagCols[i] = column(tag)
+		}
+		fieldCols := make([]arrow.Array, len(fields))
+		for i, name := range fields {
+			fieldCols[i] = column(name)
+		}
+
+		for row := 0; row < int(rec.NumRows()); row++ {
+			for i, tag := range mf.Tags {
+				tags[tag] = ""
+				if col, ok := tagCols[i].(*array.String); ok && col.IsValid(row) {
+					tags[tag] = col.Value(row)
+				}
+			}
+			line = appendSeriesKey(line[:0], mf.Name, tags, sortedTagKeys(tags))
+			sep := byte(' ')
+			for i, name := range fields {
+				v := arrowValue(fieldCols[i], row)
+				if v == nil {
+					continue
+				}
+				line = append(line, sep)
+				line = append(line, keyEscaper.Replace(name)...)
+				line = append(line, '=')
+				if line, err = appendFieldValue(line, types[name], v); err != nil {
+					return fmt.Errorf("field %s: %v", name, err)
+				}
+				sep = ','
+			}
+			if sep == ' ' {
+				continue
+			}
+			tm := int64(times.Value(row))
+			line = append(line, ' ')
+			line = strconv.AppendInt(line, tm, 10)
+			if err = w.add(line, tm); err != nil {
+				return err
+			}
+		}
+	}
+	if err = rr.Err(); err != nil && !errors.Is(err, io.EOF) {
+		return err
+	}
+	return nil
+}
+
+func arrowValue(col arrow.Array, row int) interface{} {
+	if col == nil || col.IsNull(row) {
+		return nil
+	}
+	switch c := col.(type) {
+	case *array.Float64:
+		return c.Value(row)
+	case *array.Int64:
+		return c.Value(row)
+	case *array.Uint64:
+		return c.Value(row)
+	case *array.String:
+		return c.Value(row)
+	case *array.Boolean:
+		return c.Value(row)
+	}
+	return nil
+}
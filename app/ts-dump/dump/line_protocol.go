@@ -0,0 +1,122 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dump
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `)
+	keyEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `)
+	stringEscaper      = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+)
+
+var fieldTypes = map[string]uint8{
+	"float":    influx.Field_Type_Float,
+	"integer":  influx.Field_Type_Int,
+	"unsigned": influx.Field_Type_UInt,
+	"string":   influx.Field_Type_String,
+	"boolean":  influx.Field_Type_Boolean,
+}
+
+func parseFieldType(typ string) (uint8, error) {
+	t, ok := fieldTypes[typ]
+	if !ok {
+		return influx.Field_Type_Unknown, fmt.Errorf("unsupported field type %q", typ)
+	}
+	return t, nil
+}
+
+func sortedTagKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for k, v := range tags {
+		if v != "" {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func appendSeriesKey(dst []byte, mst string, tags map[string]string, keys []string) []byte {
+	dst = append(dst, measurementEscaper.Replace(mst)...)
+	for _, k := range keys {
+		dst = append(dst, ',')
+		dst = append(dst, keyEscaper.Replace(k)...)
+		dst = append(dst, '=')
+		dst = append(dst, keyEscaper.Replace(tags[k])...)
+	}
+	return dst
+}
+
+// appendFieldValue appends a field value decoded from a query response or read
+// from a parquet file. json.Number is written as is to keep the precision.
+func appendFieldValue(dst []byte, typ uint8, v interface{}) ([]byte, error) {
+	switch typ {
+	case influx.Field_Type_Float:
+		switch n := v.(type) {
+		case json.Number:
+			return append(dst, n...), nil
+		case float64:
+			if math.IsNaN(n) || math.IsInf(n, 0) {
+				return dst, fmt.Errorf("invalid float value %v", n)
+			}
+			return strconv.AppendFloat(dst, n, 'g', -1, 64), nil
+		}
+	case influx.Field_Type_Int:
+		switch n := v.(type) {
+		case json.Number:
+			return append(append(dst, n...), 'i'), nil
+		case int64:
+			return append(strconv.AppendInt(dst, n, 10), 'i'), nil
+		}
+	case influx.Field_Type_UInt:
+		switch n := v.(type) {
+		case json.Number:
+			return append(append(dst, n...), 'u'), nil
+		case uint64:
+			return append(strconv.AppendUint(dst, n, 10), 'u'), nil
+		}
+	case influx.Field_Type_String:
+		if s, ok := v.(string); ok {
+			dst = append(dst, '"')
+			dst = append(dst, stringEscaper.Replace(s)...)
+			return append(dst, '"'), nil
+		}
+	case influx.Field_Type_Boolean:
+		if b, ok := v.(bool); ok {
+			return strconv.AppendBool(dst, b), nil
+		}
+	}
+	return dst, fmt.Errorf("unexpected value %v of type %T", v, v)
+}
+
+// lineTime returns the timestamp of a line written by the exporter, which is
+// always the last element of the line.
+func lineTime(line string) (int64, error) {
+	i := strings.LastIndexByte(line, ' ')
+	if i < 0 {
+		return 0, fmt.Errorf("missing timestamp in line %q", line)
+	}
+	return strconv.ParseInt(line[i+1:], 10, 64)
+}
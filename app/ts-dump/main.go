@@ -0,0 +1,223 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/influxdata/influxdb/cmd"
+	"github.com/openGemini/openGemini/app"
+	"github.com/openGemini/openGemini/app/ts-dump/dump"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const TsDump = "ts-dump"
+
+const mainUsage = `Export and import openGemini data as portable line protocol or parquet files.
+
+Usage: %s [[command]] [arguments]
+
+The commands are:
+	export          export a database through the query path
+	import          import an export through the write path
+	version         display the openGemini version
+
+Use "%s [command] -help" for more information about a command.
+`
+
+const exportUsage = `Export a database through the query path.
+
+Usage: %s export [flags]
+
+    -host <host:port>
+            The ts-sql HTTP address. Default: 127.0.0.1:8086.
+    -username <name>
+    -password <password>
+    -ssl
+            Use https to connect.
+    -unsafeSsl
+            Skip the certificate verification.
+    -timeout <duration>
+            The timeout of each HTTP request, no timeout if 0.
+    -database <name>
+            The database to export, required.
+    -retentionPolicy <name>
+            The retention policy to export, the default one if empty.
+    -measurements <m1,m2>
+            The measurements to export, all if empty.
+    -start <RFC3339 time>
+    -end <RFC3339 time>
+            Export the points in [start, end).
+    -format <line|parquet>
+            The output format. Default: line.
+    -out <dir>
+            The output directory, required.
+    -chunkSize <n>
+            The number of points of each query response chunk. Default: 10000.
+`
+
+const importUsage = `Import an export through the write path.
+
+Usage: %s import [flags]
+
+    -host <host:port>
+            The ts-sql HTTP address. Default: 127.0.0.1:8086.
+    -username <name>
+    -password <password>
+    -ssl
+            Use https to connect.
+    -unsafeSsl
+            Skip the certificate verification.
+    -timeout <duration>
+            The timeout of each HTTP request, no timeout if 0.
+    -in <dir>
+            The directory written by export, required.
+    -database <name>
+            Import into this database instead of the exported one.
+    -retentionPolicy <name>
+            Import into this retention policy instead of the exported one.
+    -createDatabase
+            Create the database before importing.
+    -measurements <m1,m2>
+            The measurements to import, all if empty.
+    -start <RFC3339 time>
+    -end <RFC3339 time>
+            Import the points in [start, end).
+    -batchSize <n>
+            The number of points of each write request. Default: 5000.
+`
+
+func main() {
+	if err := doRun(os.Args[1:]...); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func doRun(args ...string) error {
+	name, args := cmd.ParseCommandName(args)
+	switch name {
+	case "export":
+		conf, err := parseExportFlags(args...)
+		if err != nil {
+			return err
+		}
+		if err = dump.Export(conf); err != nil {
+			return err
+		}
+		fmt.Println("export success !")
+	case "import":
+		conf, err := parseImportFlags(args...)
+		if err != nil {
+			return err
+		}
+		if err = dump.Import(conf); err != nil {
+			return err
+		}
+		fmt.Println("import success !")
+	case "version":
+		fmt.Println(app.FullVersion(TsDump))
+	default:
+		return fmt.Errorf(mainUsage, TsDump, TsDump)
+	}
+	return nil
+}
+
+type timeRange struct {
+	start, end string
+}
+
+func clientFlags(fs *flag.FlagSet, conf *dump.ClientConfig, tr *timeRange, measurements *string) {
+	fs.StringVar(&conf.Host, "host", "127.0.0.1:8086", "")
+	fs.StringVar(&conf.Username, "username", "", "")
+	fs.StringVar(&conf.Password, "password", "", "")
+	fs.BoolVar(&conf.SSL, "ssl", false, "")
+	fs.BoolVar(&conf.UnsafeSSL, "unsafeSsl", false, "")
+	fs.DurationVar(&conf.Timeout, "timeout", 0, "")
+	fs.StringVar(measurements, "measurements", "", "")
+	fs.StringVar(&tr.start, "start", "", "")
+	fs.StringVar(&tr.end, "end", "", "")
+}
+
+func parseTimeRange(tr *timeRange) (int64, int64, error) {
+	start, err := dump.ParseTime(tr.start, influxql.MinTime)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid start time: %s", err)
+	}
+	end, err := dump.ParseTime(tr.end, influxql.MaxTime)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid end time: %s", err)
+	}
+	return start, end, nil
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func parseExportFlags(args ...string) (*dump.ExportConfig, error) {
+	conf := &dump.ExportConfig{}
+	var tr timeRange
+	var measurements string
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf(exportUsage, TsDump)
+	}
+	clientFlags(fs, &conf.ClientConfig, &tr, &measurements)
+	fs.StringVar(&conf.Database, "database", "", "")
+	fs.StringVar(&conf.RetentionPolicy, "retentionPolicy", "", "")
+	fs.StringVar(&conf.Format, "format", dump.FormatLine, "")
+	fs.StringVar(&conf.Out, "out", "", "")
+	fs.IntVar(&conf.ChunkSize, "chunkSize", 0, "")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	conf.Measurements = splitList(measurements)
+	var err error
+	conf.Start, conf.End, err = parseTimeRange(&tr)
+	return conf, err
+}
+
+func parseImportFlags(args ...string) (*dump.ImportConfig, error) {
+	conf := &dump.ImportConfig{}
+	var tr timeRange
+	var measurements string
+	fs := flag.NewFlagSet("", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Printf(importUsage, TsDump)
+	}
+	clientFlags(fs, &conf.ClientConfig, &tr, &measurements)
+	fs.StringVar(&conf.In, "in", "", "")
+	fs.StringVar(&conf.Database, "database", "", "")
+	fs.StringVar(&conf.RetentionPolicy, "retentionPolicy", "", "")
+	fs.BoolVar(&conf.CreateDatabase, "createDatabase", false, "")
+	fs.IntVar(&conf.BatchSize, "batchSize", 0, "")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	conf.Measurements = splitList(measurements)
+	var err error
+	conf.Start, conf.End, err = parseTimeRange(&tr)
+	return conf, err
+}
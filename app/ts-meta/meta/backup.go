@@ -45,8 +45,20 @@ func (s *Backup) RunBackupMeta() error {
 		return err
 	}
 
+	var dest backup.Destination = backup.NewLocalDestination(s.BackupPath)
+	if s.IsRemote {
+		storage, err := backup.NewRemoteStorage(globalService.config.RemoteBackup)
+		if err != nil {
+			return err
+		}
+		defer storage.Close()
+		if dest, err = backup.NewRemoteDestination(storage, s.BackupPath, backup.RemoteMetaNode, s.time, false); err != nil {
+			return err
+		}
+	}
+
 	dstPath := filepath.Join(s.BackupPath, backup.MetaBackupDir)
-	if err := backup.FolderCopy(globalService.store.path, dstPath); err != nil {
+	if err := dest.CopyFolder(globalService.store.path, dstPath); err != nil {
 		return err
 	}
 
@@ -63,9 +75,9 @@ func (s *Backup) RunBackupMeta() error {
 	if err != nil {
 		return err
 	}
-	if err := backup.WriteBackupLogFile(content, dstPath, backup.MetaBackupLog); err != nil {
+	if err := dest.WriteLog(content, dstPath, backup.MetaBackupLog); err != nil {
 		return err
 	}
 
-	return nil
+	return dest.Commit()
 }
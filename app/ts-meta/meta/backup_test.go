@@ -23,6 +23,7 @@ import (
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/mocks"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
@@ -60,6 +61,41 @@ func TestRunBackup(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("remote", func(t *testing.T) {
+		server := mocks.NewObjectStorage("backup")
+		defer server.Close()
+		remote := config.NewRemoteBackup()
+		remote.Enabled = true
+		remote.Endpoint = server.URL
+		remote.Bucket = "backup"
+		remote.PathStyle = true
+		globalService.config.RemoteBackup = &remote
+		defer func() {
+			globalService.config.RemoteBackup = nil
+		}()
+
+		s := &Store{
+			raft:   &MockRaftForSG{isLeader: true},
+			path:   fmt.Sprintf("%s/openGemini/backup_dir/data/meta/", diskDir),
+			Logger: logger.NewLogger(errno.ModuleMeta).With(zap.String("service", "meta")),
+			cacheData: &meta.Data{
+				MetaNodes: []meta.NodeInfo{meta.NodeInfo{ID: 1}},
+			},
+		}
+		globalService.store = s
+		b := &Backup{
+			IsNode:     true,
+			IsRemote:   true,
+			BackupPath: "gen1",
+		}
+		assert.NoError(t, b.RunBackupMeta())
+		_, ok := server.Object("gen1/manifest/meta.json")
+		assert.True(t, ok)
+
+		b.BackupPath = "/tmp/gen1"
+		assert.Error(t, b.RunBackupMeta())
+	})
+
 	t.Run("2", func(t *testing.T) {
 		BackupPath := t.TempDir()
 		s := &Store{
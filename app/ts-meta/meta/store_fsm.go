@@ -138,6 +138,7 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_ReShardingCommand:                applyReSharding,
 	proto2.Command_UpdateSchemaCommand:              applyUpdateSchema,
 	proto2.Command_AlterShardKeyCmd:                 applyAlterShardKey,
+	proto2.Command_AlterMeasurementTTLCmd:           applyAlterMeasurementTTL,
 	proto2.Command_PruneGroupsCommand:               applyPruneGroups,
 	proto2.Command_MarkMeasurementDeleteCommand:     applyMarkMeasurementDelete,
 	proto2.Command_DropMeasurementCommand:           applyDropMeasurement,
@@ -172,6 +173,13 @@ var applyFunc = map[proto2.Command_Type]func(fsm *storeFSM, cmd *proto2.Command)
 	proto2.Command_UpdateNodeTmpIndexCommand:        applyUpdateNodeTmpIndexCommand,
 	proto2.Command_InsertFilesCommand:               applyInsertFilesCommand,
 	proto2.Command_UpdateMetaNodeStatusCommand:      applyUpdateMetaNodeStatus,
+	proto2.Command_CreateAlertRuleCommand:           applyCreateAlertRule,
+	proto2.Command_DropAlertRuleCommand:             applyDropAlertRule,
+	proto2.Command_CreateAlertReceiverCommand:       applyCreateAlertReceiver,
+	proto2.Command_DropAlertReceiverCommand:         applyDropAlertReceiver,
+	proto2.Command_CreateAlertSilenceCommand:        applyCreateAlertSilence,
+	proto2.Command_DropAlertSilenceCommand:          applyDropAlertSilence,
+	proto2.Command_AlertStateReportCommand:          applyAlertStateReport,
 	proto2.Command_UpdateIndexInfoTierCommand:       applyUpdateIndexInfoTier,
 }
 
@@ -287,6 +295,10 @@ func applyAlterShardKey(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyAlterShardKeyCommand(cmd)
 }
 
+func applyAlterMeasurementTTL(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyAlterMeasurementTTLCommand(cmd)
+}
+
 func applyPruneGroups(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyPruneGroupsCommand(cmd)
 }
@@ -419,6 +431,34 @@ func applyUpdateMeasurement(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyUpdateMeasurementCommand(cmd)
 }
 
+func applyCreateAlertRule(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyCreateAlertRuleCommand(cmd)
+}
+
+func applyDropAlertRule(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDropAlertRuleCommand(cmd)
+}
+
+func applyCreateAlertReceiver(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyCreateAlertReceiverCommand(cmd)
+}
+
+func applyDropAlertReceiver(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDropAlertReceiverCommand(cmd)
+}
+
+func applyCreateAlertSilence(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyCreateAlertSilenceCommand(cmd)
+}
+
+func applyDropAlertSilence(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyDropAlertSilenceCommand(cmd)
+}
+
+func applyAlertStateReport(fsm *storeFSM, cmd *proto2.Command) interface{} {
+	return fsm.applyAlertStateReportCommand(cmd)
+}
+
 func applyUpdateNodeTmpIndexCommand(fsm *storeFSM, cmd *proto2.Command) interface{} {
 	return fsm.applyUpdateNodeTmpIndexCommand(cmd)
 }
@@ -447,6 +487,10 @@ func (fsm *storeFSM) applyAlterShardKeyCommand(cmd *proto2.Command) interface{}
 	return meta2.ApplyAlterShardKey(fsm.data, cmd)
 }
 
+func (fsm *storeFSM) applyAlterMeasurementTTLCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyAlterMeasurementTTL(fsm.data, cmd)
+}
+
 func (fsm *storeFSM) applyMarkMeasurementDeleteCommand(cmd *proto2.Command) interface{} {
 	return meta2.ApplyMarkMeasurementDelete(fsm.data, cmd)
 }
@@ -860,6 +904,34 @@ func (fsm *storeFSM) applyUpdateMeasurementCommand(cmd *proto2.Command) interfac
 	return meta2.ApplyUpdateMeasurement(fsm.data, cmd)
 }
 
+func (fsm *storeFSM) applyCreateAlertRuleCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyCreateAlertRule(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyDropAlertRuleCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDropAlertRule(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyCreateAlertReceiverCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyCreateAlertReceiver(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyDropAlertReceiverCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDropAlertReceiver(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyCreateAlertSilenceCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyCreateAlertSilence(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyDropAlertSilenceCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyDropAlertSilence(fsm.data, cmd)
+}
+
+func (fsm *storeFSM) applyAlertStateReportCommand(cmd *proto2.Command) interface{} {
+	return meta2.ApplyAlertStateReport(fsm.data, cmd)
+}
+
 func (fsm *storeFSM) applyUpdateNodeTmpIndexCommand(cmd *proto2.Command) interface{} {
 	ext, _ := proto.GetExtension(cmd, proto2.E_UpdateNodeTmpIndexCommand_Command)
 	v, ok := ext.(*proto2.UpdateNodeTmpIndexCommand)
@@ -71,6 +71,7 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 
 	c.Meta.DataDir = c.Data.DataDir
 	c.Meta.WalDir = c.Data.WALDir
+	c.Meta.RemoteBackup = &c.Data.RemoteBackup
 	lockFile := fileops.FileLockOption("")
 	if err := fileops.MkdirAll(c.Meta.Dir, 0750, lockFile); err != nil {
 		return nil, fmt.Errorf("mkdir all: %s", err)
@@ -80,6 +80,9 @@ func ParseFlags(usage func(), args ...string) (recover.RecoverConfig, error) {
 	fs.StringVar(&options.RecoverMode, "recoverMode", "1", "")
 	fs.StringVar(&options.FullBackupDataPath, "fullBackupDataPath", "", "")
 	fs.StringVar(&options.IncBackupDataPath, "incBackupDataPath", "", "")
+	fs.StringVar(&options.PointInTime, "pointInTime", "", "")
+	fs.BoolVar(&options.Remote, "remote", false, "")
+	fs.StringVar(&options.StagingPath, "stagingPath", "", "")
 	if err := fs.Parse(args); err != nil {
 		return recover.RecoverConfig{}, err
 	}
@@ -0,0 +1,232 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recover
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/engine"
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+const walSuffix = ".wal"
+
+// walSegment is a wal file which may be replayed for a point-in-time recovery.
+type walSegment struct {
+	path      string
+	walPath   string // the wal path of the shard
+	partition string
+	openTime  int64 // 0 if it is unknown
+	closeTime int64
+	local     bool // the file is in the wal path of the node, it is removed once it is replayed
+}
+
+func (s *walSegment) key() string {
+	return filepath.Join(s.walPath, s.partition, filepath.Base(s.path))
+}
+
+type backupLogChecksums struct {
+	WalFileList []string          `json:"walFileList"`
+	Checksums   map[string]string `json:"checksums"`
+}
+
+// verifyBackup verifies the checksums recorded in the backup logs before
+// anything is restored, and returns the wal files archived in the backup.
+func verifyBackup(backupPath string) ([]*walSegment, error) {
+	dataPath := filepath.Join(backupPath, backup.DataBackupDir)
+	if _, err := os.Stat(dataPath); err != nil {
+		return nil, nil
+	}
+
+	var segments []*walSegment
+	err := filepath.WalkDir(dataPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || (d.Name() != backup.FullBackupLog && d.Name() != backup.IncBackupLog) {
+			return nil
+		}
+		backupLog := &backupLogChecksums{}
+		if err = backup.ReadBackupLogFile(path, backupLog); err != nil {
+			return err
+		}
+		for file, sum := range backupLog.Checksums {
+			if err = backup.VerifyFileChecksum(filepath.Join(dataPath, file), sum); err != nil {
+				return err
+			}
+		}
+		for _, file := range backupLog.WalFileList {
+			seg, err := newArchivedWalSegment(file)
+			if err != nil {
+				return err
+			}
+			seg.path = filepath.Join(dataPath, file)
+			segments = append(segments, seg)
+		}
+		return nil
+	})
+	return segments, err
+}
+
+// newArchivedWalSegment parses the path of an archived wal file, which is
+// <wal path of the shard>/archive/<partition>/<openTime>_<closeTime>_<seq>.wal
+func newArchivedWalSegment(file string) (*walSegment, error) {
+	partitionPath := filepath.Dir(file)
+	archivePath := filepath.Dir(partitionPath)
+	if filepath.Base(archivePath) != backup.WalArchiveDir {
+		return nil, fmt.Errorf("invalid wal archive file %s", file)
+	}
+	openTime, closeTime, err := backup.ParseWalArchiveFileName(filepath.Base(file))
+	if err != nil {
+		return nil, err
+	}
+	return &walSegment{
+		path:      file,
+		walPath:   filepath.Dir(archivePath),
+		partition: filepath.Base(partitionPath),
+		openTime:  openTime,
+		closeTime: closeTime,
+	}, nil
+}
+
+// localWalSegments returns the archived and the not yet flushed wal files in
+// the wal directory of the node.
+func localWalSegments(walDir string) ([]*walSegment, error) {
+	// <wal dir>/wal/<db>/<pt>/<rp>/<shard>/
+	shardPattern := filepath.Join(walDir, config.WalDirectory, "*", "*", "*", "*")
+	archived, err := filepath.Glob(filepath.Join(shardPattern, backup.WalArchiveDir, "*", "*"+walSuffix))
+	if err != nil {
+		return nil, err
+	}
+	segments := make([]*walSegment, 0, len(archived))
+	for _, file := range archived {
+		seg, err := newArchivedWalSegment(file)
+		if err != nil {
+			return nil, err
+		}
+		seg.local = true
+		segments = append(segments, seg)
+	}
+
+	files, err := filepath.Glob(filepath.Join(shardPattern, "*", "*"+walSuffix))
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		partition := filepath.Base(filepath.Dir(file))
+		if _, err = strconv.Atoi(partition); err != nil {
+			// stream or archive directory
+			continue
+		}
+		stat, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, &walSegment{
+			path:      file,
+			walPath:   filepath.Dir(filepath.Dir(file)),
+			partition: partition,
+			closeTime: stat.ModTime().UnixNano(),
+			local:     true,
+		})
+	}
+	return segments, nil
+}
+
+// replayWal rebuilds the wal files of every shard with the data written before
+// the target time, ts-store replays them when the shards are opened. The wal
+// file being written at the target time is filtered by the write time markers.
+func replayWal(walDir string, backupSegments []*walSegment, target int64) error {
+	localSegments, err := localWalSegments(walDir)
+	if err != nil {
+		return err
+	}
+
+	groups := make(map[string][]*walSegment)
+	seen := make(map[string]bool)
+	for _, seg := range append(localSegments, backupSegments...) {
+		if seen[seg.key()] {
+			continue
+		}
+		seen[seg.key()] = true
+		dir := filepath.Join(seg.walPath, seg.partition)
+		groups[dir] = append(groups[dir], seg)
+	}
+
+	for dir, segments := range groups {
+		if err = replayWalPartition(dir, segments, target); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func replayWalPartition(dir string, segments []*walSegment, target int64) error {
+	sort.SliceStable(segments, func(i, j int) bool {
+		if segments[i].closeTime != segments[j].closeTime {
+			return segments[i].closeTime < segments[j].closeTime
+		}
+		return segments[i].path < segments[j].path
+	})
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return err
+	}
+
+	var replayFiles []string
+	var err error
+	for _, seg := range segments {
+		if seg.openTime >= target {
+			continue
+		}
+		tmp := filepath.Join(dir, fmt.Sprintf("%d%s.pitr", len(replayFiles)+1, walSuffix))
+		if seg.closeTime <= target {
+			_, err = fileops.CopyFile(seg.path, tmp)
+		} else {
+			err = engine.FilterWalFile(seg.path, tmp, target)
+		}
+		if err != nil {
+			return err
+		}
+		// the wal file is archived again with its close time after it is replayed
+		closeTime := time.Unix(0, seg.closeTime)
+		if err = os.Chtimes(tmp, closeTime, closeTime); err != nil {
+			return err
+		}
+		replayFiles = append(replayFiles, tmp)
+	}
+
+	for _, seg := range segments {
+		if seg.local && seg.openTime < target {
+			if err = os.Remove(seg.path); err != nil {
+				return err
+			}
+		}
+	}
+	for _, tmp := range replayFiles {
+		if err = os.Rename(tmp, strings.TrimSuffix(tmp, ".pitr")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recover
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/stretchr/testify/require"
+)
+
+// walRecord returns a wal record which is copied as it is by the filter
+func walRecord(body string) string {
+	return string([]byte{2, 0, 0, 0, byte(len(body))}) + body
+}
+
+func TestPointInTimeRecover(t *testing.T) {
+	root := t.TempDir()
+	walDir := filepath.Join(root, "wal_dir")
+	shardWalPath := filepath.Join(walDir, "wal", "db0", "0", "rp0", "1_0_100_1")
+	fullBackupPath := filepath.Join(root, "backup")
+
+	// wal file archived in the full backup
+	archivedFile := filepath.Join(shardWalPath, backup.WalArchiveDir, "0", backup.WalArchiveFileName(100, 200, "1"))
+	backupFile := filepath.Join(fullBackupPath, backup.DataBackupDir, archivedFile)
+	CreateFile(backupFile, walRecord("a"))
+	sum, err := backup.FileChecksum(backupFile)
+	require.NoError(t, err)
+	content, err := json.Marshal(&backup.BackupLogInfo{
+		WalFileList: []string{archivedFile},
+		Checksums:   map[string]string{archivedFile: sum},
+	})
+	require.NoError(t, err)
+	CreateFile(filepath.Join(fullBackupPath, backup.DataBackupDir, root, "data", backup.BackupLogPath, backup.FullBackupLog), string(content))
+
+	// wal files archived after the backup
+	CreateFile(filepath.Join(shardWalPath, backup.WalArchiveDir, "0", backup.WalArchiveFileName(200, 300, "2")), walRecord("b"))
+	afterTarget := filepath.Join(shardWalPath, backup.WalArchiveDir, "0", backup.WalArchiveFileName(400, 500, "3"))
+	CreateFile(afterTarget, walRecord("c"))
+
+	// wal file being written
+	liveFile := filepath.Join(shardWalPath, "0", "1.wal")
+	CreateFile(liveFile, walRecord("d"))
+	require.NoError(t, os.Chtimes(liveFile, time.Unix(0, 350), time.Unix(0, 350)))
+
+	segments, err := verifyBackup(fullBackupPath)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segments))
+	require.Equal(t, shardWalPath, segments[0].walPath)
+
+	require.NoError(t, replayWal(walDir, segments, 320))
+	for i, exp := range []string{"a", "b", "d"} {
+		data, err := os.ReadFile(filepath.Join(shardWalPath, "0", []string{"1.wal", "2.wal", "3.wal"}[i]))
+		require.NoError(t, err)
+		require.Equal(t, walRecord(exp), string(data))
+	}
+	files, err := filepath.Glob(filepath.Join(shardWalPath, backup.WalArchiveDir, "0", "*"))
+	require.NoError(t, err)
+	require.Equal(t, []string{afterTarget}, files)
+	stat, err := os.Stat(filepath.Join(shardWalPath, "0", "2.wal"))
+	require.NoError(t, err)
+	require.Equal(t, int64(300), stat.ModTime().UnixNano())
+
+	// the backup is corrupted
+	CreateFile(backupFile, walRecord("e"))
+	_, err = verifyBackup(fullBackupPath)
+	require.ErrorContains(t, err, "checksum mismatch")
+}
+
+func TestPointInTimeRecoverConfig(t *testing.T) {
+	err := BackupRecover(&RecoverConfig{
+		FullBackupDataPath: t.TempDir(),
+		RecoverMode:        FullRecoverMode,
+		PointInTime:        "yesterday",
+	}, nil)
+	require.ErrorContains(t, err, "invalid pointInTime")
+}
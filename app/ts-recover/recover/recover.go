@@ -21,6 +21,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/config"
@@ -35,6 +36,13 @@ type RecoverConfig struct {
 	ConfigPath         string
 	FullBackupDataPath string
 	IncBackupDataPath  string
+	// PointInTime is a RFC3339 time, the archived wal files are replayed up to
+	// it after the backups are restored
+	PointInTime string
+	// Remote restores the backups named by FullBackupDataPath and IncBackupDataPath
+	// from the remote backup bucket, they are downloaded to StagingPath first
+	Remote      bool
+	StagingPath string
 }
 
 type RecoverFunc func(rc *RecoverConfig, path string) error
@@ -46,19 +54,51 @@ func BackupRecover(opt *RecoverConfig, tsRecover *config.TsRecover) error {
 	if opt.RecoverMode == "1" && opt.IncBackupDataPath == "" {
 		return fmt.Errorf("`missing required parameter: incBackupDataPath")
 	}
-	var err error
+	if opt.RecoverMode != FullAndIncRecoverMode && opt.RecoverMode != FullRecoverMode {
+		return fmt.Errorf("invalid recovermode")
+	}
+	var target int64
+	if opt.PointInTime != "" {
+		t, err := time.Parse(time.RFC3339Nano, opt.PointInTime)
+		if err != nil {
+			return fmt.Errorf("invalid pointInTime: %s", err)
+		}
+		target = t.UnixNano()
+	}
+	if opt.Remote {
+		stagingPaths, err := stageRemoteBackup(opt, tsRecover)
+		if err != nil {
+			return err
+		}
+		defer removeStagingPaths(stagingPaths)
+	}
+
+	walSegments, err := verifyBackup(opt.FullBackupDataPath)
+	if err != nil {
+		return err
+	}
+	if opt.IncBackupDataPath != "" {
+		// the wal files archived in the incremental backup can be replayed on top of the full backup
+		incWalSegments, err := verifyBackup(opt.IncBackupDataPath)
+		if err != nil {
+			return err
+		}
+		walSegments = append(walSegments, incWalSegments...)
+	}
+
 	switch opt.RecoverMode {
 	case FullAndIncRecoverMode:
 		err = recoverWithFullAndInc(tsRecover, opt)
 	case FullRecoverMode:
 		err = recoverWithFull(tsRecover, opt)
-	default:
-		return fmt.Errorf("invalid recovermode")
 	}
 	if err != nil {
 		return err
 	}
 
+	if opt.PointInTime != "" {
+		return replayWal(tsRecover.Data.WALDir, walSegments, target)
+	}
 	return nil
 }
 
@@ -0,0 +1,64 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package recover
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/config"
+)
+
+const defaultStagingDir = "ts-recover"
+
+// stageRemoteBackup downloads the backups of the node from the remote backup bucket to the
+// staging path, and points the backup paths of the options to them. The files left by an
+// interrupted download are reused.
+func stageRemoteBackup(opt *RecoverConfig, tsRecover *config.TsRecover) ([]string, error) {
+	storage, err := backup.NewRemoteStorage(&tsRecover.Data.RemoteBackup)
+	if err != nil {
+		return nil, err
+	}
+	defer storage.Close()
+
+	stagingPath := opt.StagingPath
+	if stagingPath == "" {
+		stagingPath = filepath.Join(os.TempDir(), defaultStagingDir)
+	}
+	node := backup.RemoteNodeName(tsRecover.Data.InsertAddr())
+
+	var paths []string
+	for _, p := range []*string{&opt.FullBackupDataPath, &opt.IncBackupDataPath} {
+		if *p == "" {
+			continue
+		}
+		dst := filepath.Join(stagingPath, *p)
+		fmt.Printf("downloading remote backup %s to %s\n", *p, dst)
+		if err = backup.DownloadRemoteBackup(storage, *p, node, dst); err != nil {
+			return nil, err
+		}
+		*p = dst
+		paths = append(paths, dst)
+	}
+	return paths, nil
+}
+
+func removeStagingPaths(paths []string) {
+	for _, p := range paths {
+		_ = os.RemoveAll(p)
+	}
+}
@@ -38,6 +38,7 @@ import (
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/auth"
@@ -47,9 +48,11 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/openGemini/openGemini/lib/validation"
 	"github.com/openGemini/openGemini/services"
+	"github.com/openGemini/openGemini/services/alert"
 	"github.com/openGemini/openGemini/services/arrowflight"
 	"github.com/openGemini/openGemini/services/castor"
 	"github.com/openGemini/openGemini/services/continuousquery"
+	"github.com/openGemini/openGemini/services/plaintext"
 	"github.com/openGemini/openGemini/services/runtimecfg"
 	"github.com/openGemini/openGemini/services/sherlock"
 	"github.com/openGemini/openGemini/services/writer"
@@ -91,11 +94,16 @@ type Server struct {
 	sherlockService *sherlock.Service
 
 	cqService *continuousquery.Service
+
+	alertService *alert.Service
 	// reload runtimecfg
 	runtimeCfgService *runtimecfg.Service
 
 	writerService *writer.Service
 
+	plaintextService *plaintext.Service
+	traceExporter    *tracing.Exporter
+
 	ctx          context.Context
 	ctxCancel    context.CancelFunc
 	serfInstance *serf.Serf
@@ -197,6 +205,10 @@ func NewServer(conf config.Config, info app.ServerInfo, logger *Logger.Logger) (
 	}
 
 	s.initRecordWriterService()
+	s.initPlaintextService()
+	if c.Tracing.Enabled {
+		s.traceExporter = tracing.NewExporter(c.Tracing, info.App)
+	}
 	return s, nil
 }
 
@@ -209,6 +221,13 @@ func newServer(info app.ServerInfo, logger *Logger.Logger, c *config.TSSql, meta
 		cqService.WithLogger(logger)
 	}
 
+	// new alerting service
+	var alertService *alert.Service
+	if c.Alert.Enabled {
+		alertService = alert.NewService(config.CombineDomain(c.HTTP.Domain, c.HTTP.BindAddress), c.Alert)
+		alertService.WithLogger(logger)
+	}
+
 	s := &Server{
 		info:          info,
 		Logger:        logger,
@@ -218,7 +237,8 @@ func newServer(info app.ServerInfo, logger *Logger.Logger, c *config.TSSql, meta
 		metaUseTLS:    false,
 		config:        c,
 
-		cqService: cqService,
+		cqService:    cqService,
+		alertService: alertService,
 	}
 	if c.Meta.UseIncSyncData {
 		s.MetaClient.EnableUseSnapshotV2(c.Meta.RetentionAutoCreate, c.Meta.ExpandShardsEnable)
@@ -264,6 +284,23 @@ func (s *Server) initRecordWriterService() {
 	s.writerService = ws
 }
 
+func (s *Server) initPlaintextService() {
+	if !s.config.Graphite.Enabled && !s.config.OpenTSDB.Enabled {
+		return
+	}
+
+	ps, err := plaintext.NewService(s.config.Graphite, s.config.OpenTSDB)
+	if err != nil {
+		s.Logger.Error("Failed to create plaintext service", zap.Error(err))
+		return
+	}
+	ps.WithLogger(s.Logger)
+	ps.PointsWriter = s.PointsWriter
+	ps.MetaClient = s.MetaClient
+	ps.MaxBodySize = s.config.HTTP.MaxBodySize
+	s.plaintextService = ps
+}
+
 func (s *Server) initArrowFlightService(c *config.TSSql) error {
 	if role := s.info.App; !(role == config.AppSingle || role == config.AppData) {
 		return errno.NewError(errno.ArrowFlightGetRoleErr)
@@ -295,14 +332,15 @@ func (s *Server) initQueryExecutor(c *config.TSSql) {
 			NetStore:   s.TSDBStore,
 			Logger:     s.Logger.With(zap.String("shardMapper", "cluster")),
 		},
-		MetaExecutor:            metaExecutor,
-		MaxQueryMem:             int64(c.Coordinator.MaxQueryMem),
-		MaxRowSizeLimit:         int64(c.HTTP.MaxRowSizeLimit),
-		QueryTimeCompareEnabled: c.Coordinator.QueryTimeCompareEnabled,
-		RetentionPolicyLimit:    c.Coordinator.RetentionPolicyLimit,
-		StmtExecLogger:          Logger.NewLogger(errno.ModuleQueryEngine).With(zap.String("query", "StatementExecutor")),
-		Hostname:                config.CombineDomain(s.config.HTTP.Domain, s.config.HTTP.BindAddress),
-		SqlConfigs:              c.ShowConfigs(),
+		MetaExecutor:                 metaExecutor,
+		MaxQueryMem:                  int64(c.Coordinator.MaxQueryMem),
+		MaxRowSizeLimit:              int64(c.HTTP.MaxRowSizeLimit),
+		QueryTimeCompareEnabled:      c.Coordinator.QueryTimeCompareEnabled,
+		RetentionPolicyLimit:         c.Coordinator.RetentionPolicyLimit,
+		TagValuesCardinalityEstimate: c.Coordinator.TagValuesCardinalityEstimate,
+		StmtExecLogger:               Logger.NewLogger(errno.ModuleQueryEngine).With(zap.String("query", "StatementExecutor")),
+		Hostname:                     config.CombineDomain(s.config.HTTP.Domain, s.config.HTTP.BindAddress),
+		SqlConfigs:                   c.ShowConfigs(),
 	}
 	s.QueryExecutor.TaskManager.QueryTimeout = time.Duration(c.Coordinator.QueryTimeout)
 	s.QueryExecutor.TaskManager.LogQueriesAfter = time.Duration(c.Coordinator.LogQueriesAfter)
@@ -330,6 +368,12 @@ func (s *Server) Open() error {
 		return err
 	}
 
+	if s.traceExporter != nil {
+		if err := s.traceExporter.Open(); err != nil {
+			return err
+		}
+	}
+
 	s.PointsWriter.MetaClient = s.MetaClient
 	s.httpService.Handler.MetaClient = s.MetaClient
 	s.httpService.Handler.SQLConfig = s.config
@@ -355,6 +399,13 @@ func (s *Server) Open() error {
 		}
 	}
 
+	if s.alertService != nil {
+		s.alertService.MetaClient = s.MetaClient
+		if err := s.alertService.Open(); err != nil {
+			return err
+		}
+	}
+
 	s.httpService.Handler.QueryExecutor.PointsWriter = s.PointsWriter
 	s.httpService.Handler.PointsWriter = s.PointsWriter
 	if s.SubscriberManager != nil {
@@ -381,6 +432,7 @@ func (s *Server) Open() error {
 
 		s.arrowFlightService.MetaClient = s.MetaClient
 		s.arrowFlightService.RecordWriter = s.RecordWriter
+		s.arrowFlightService.QueryExecutor = s.QueryExecutor
 		if err := s.arrowFlightService.Open(); err != nil {
 			return err
 		}
@@ -403,6 +455,11 @@ func (s *Server) Open() error {
 			return err
 		}
 	}
+	if s.plaintextService != nil {
+		if err := s.plaintextService.Open(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -446,6 +503,10 @@ func (s *Server) Close() error {
 		util.MustClose(s.writerService)
 	}
 
+	if s.plaintextService != nil {
+		util.MustClose(s.plaintextService)
+	}
+
 	if s.httpService != nil {
 		util.MustClose(s.httpService)
 	}
@@ -482,9 +543,17 @@ func (s *Server) Close() error {
 		util.MustClose(s.cqService)
 	}
 
+	if s.alertService != nil {
+		util.MustClose(s.alertService)
+	}
+
 	if s.runtimeCfgService != nil {
 		util.MustClose(s.runtimeCfgService)
 	}
+
+	if s.traceExporter != nil {
+		util.MustClose(s.traceExporter)
+	}
 	return nil
 }
 
@@ -544,6 +613,8 @@ func (s *Server) initStatisticsPusher() {
 	stat.InitExecutorStatistics(globalTags)
 	stat.NewErrnoStat().Init(globalTags)
 	stat.NewLogKeeperStatistics().Init(globalTags)
+	stat.InitSubscriberStatistics(globalTags)
+	stat.InitTenantLimitsStatistics(globalTags)
 	stat.NewCollector().SetGlobalTags(globalTags)
 
 	s.statisticsPusher.Register(
@@ -553,6 +624,8 @@ func (s *Server) initStatisticsPusher() {
 		stat.CollectExecutorStatistics,
 		stat.NewErrnoStat().Collect,
 		stat.NewLogKeeperStatistics().Collect,
+		stat.CollectSubscriberStatistics,
+		stat.CollectTenantLimitsStatistics,
 		stat.NewCollector().Collect,
 	)
 
@@ -42,6 +42,7 @@ import (
 	"github.com/openGemini/openGemini/lib/statisticsPusher"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -77,6 +78,7 @@ type Server struct {
 
 	sherlockService *sherlock.Service
 	iodetector      *iodetector.IODetector
+	traceExporter   *tracing.Exporter
 }
 
 // NewServer returns a new instance of Server built from a config.
@@ -146,6 +148,9 @@ func NewServer(c config.Config, info app.ServerInfo, logger *Logger.Logger) (app
 
 	s.sherlockService = sherlock.NewService(conf.Sherlock)
 	s.sherlockService.WithLogger(s.Logger)
+	if conf.Tracing.Enabled {
+		s.traceExporter = tracing.NewExporter(conf.Tracing, info.App)
+	}
 	logstore.InitializeVlmCache()
 	logstore.StartHotDataDetector()
 	immutable.NewHotFileManager().Run()
@@ -166,6 +171,12 @@ func (s *Server) Open() error {
 	// Mark start-up in log.
 	app.LogStarting("TSStore", &s.info)
 
+	if s.traceExporter != nil {
+		if err := s.traceExporter.Open(); err != nil {
+			return err
+		}
+	}
+
 	s.transServer = transport.NewServer(s.ingestAddr, s.selectAddr)
 	if err := s.transServer.Open(); err != nil {
 		return err
@@ -287,6 +298,10 @@ func (s *Server) Close() error {
 		s.iodetector.Close()
 	}
 
+	if s.traceExporter != nil {
+		util.MustClose(s.traceExporter)
+	}
+
 	shelf.NewRunner().Close()
 	mutable.NewMemTablePoolManager().Close()
 	immutable.NewHotFileManager().Stop()
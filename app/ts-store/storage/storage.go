@@ -74,6 +74,8 @@ type StoreEngine interface {
 	SeriesKeys(string, []uint32, []string, influxql.Expr, influxql.TimeRange) ([]string, error)
 	TagValues(string, []uint32, map[string][][]byte, influxql.Expr, influxql.TimeRange) (netstorage.TablesTagSets, error)
 	TagValuesCardinality(string, []uint32, map[string][][]byte, influxql.Expr, influxql.TimeRange) (map[string]uint64, error)
+	TagValuesSketch(string, []uint32, map[string][][]byte, influxql.Expr, influxql.TimeRange) (map[string][]byte, error)
+	SeriesQuota(string, []uint32, []string) (*netstorage.SeriesQuota, error)
 	SendSysCtrlOnNode(*netstorage.SysCtrlRequest) (map[string]string, error)
 	GetShardDownSampleLevel(db string, ptId uint32, shardID uint64) int
 	PreOffload(uint64, *meta.DbPtInfo) error
@@ -235,6 +237,7 @@ func OpenStorage(path string, node *metaclient.Node, cli *metaclient.Client, con
 	opt.DownSampleWriteDrop = conf.Data.DownSampleWriteDrop
 	opt.MaxDownSampleTaskConcurrency = conf.Data.MaxDownSampleTaskConcurrency
 	opt.MaxSeriesPerDatabase = conf.Data.MaxSeriesPerDatabase
+	opt.MaxSeriesPerMeasurement = conf.Data.MaxSeriesPerMeasurement
 	opt.MaxRowsPerSegment = conf.Data.MaxRowsPerSegment
 	opt.ShardMoveLayoutSwitchEnabled = conf.Data.ShardMoveLayoutSwitchEnabled
 
@@ -567,6 +570,14 @@ func (s *Storage) TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[st
 	return s.engine.TagValuesCardinality(db, ptIDs, tagKeys, condition, tr)
 }
 
+func (s *Storage) SeriesQuota(db string, ptIDs []uint32, measurements []string) (*netstorage.SeriesQuota, error) {
+	return s.engine.SeriesQuota(db, ptIDs, stringSlice2BytesSlice(measurements))
+}
+
+func (s *Storage) TagValuesSketch(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string][]byte, error) {
+	return s.engine.TagValuesSketch(db, ptIDs, tagKeys, condition, tr)
+}
+
 func (s *Storage) TagKeys(db string, ptIDs []uint32, measurements []string, condition influxql.Expr, tr influxql.TimeRange) ([]string, error) {
 	ms := stringSlice2BytesSlice(measurements)
 
@@ -563,7 +563,10 @@ func (s *TagTask) calculateRec(cache *CacheRecord) error {
 				if val == nil {
 					continue
 				}
-				if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_UInt || rec.Schema.Field(callIds[c]).Type == influx.Field_Type_Int {
+				if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_UInt {
+					v, _ := val.UnsignedValue(i)
+					curVal = float64(v)
+				} else if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_Int {
 					v, _ := val.IntegerValue(i)
 					curVal = float64(v)
 				} else if rec.Schema.Field(callIds[c]).Type == influx.Field_Type_Float {
@@ -645,7 +648,7 @@ func (s *TagTask) replayWalRow(cache *ReplayRow) error {
 			} else {
 				for f := range row.Fields {
 					if row.Fields[f].Key == s.fieldCalls[c].Name || row.Fields[f].Key == s.fieldCalls[c].Alias {
-						curVal = row.Fields[f].NumValue
+						curVal = row.Fields[f].Float64Value()
 						break
 					}
 				}
@@ -716,7 +719,7 @@ func (s *TagTask) calculateRow(cache *TaskCache) error {
 			} else {
 				for f := range row.Fields {
 					if row.Fields[f].Key == s.fieldCalls[c].Name || row.Fields[f].Key == s.fieldCalls[c].Alias {
-						curVal = row.Fields[f].NumValue
+						curVal = row.Fields[f].Float64Value()
 						break
 					}
 				}
@@ -766,7 +769,7 @@ func (s *TagTask) buildRow(flushTime int64) func(k, vv any) bool {
 			if v[s.offset+i] == nil {
 				continue
 			}
-			(*fields)[validNum].NumValue = atomic2.LoadFloat64(v[s.offset+i])
+			(*fields)[validNum].SetFloat64Value(atomic2.LoadFloat64(v[s.offset+i]))
 			validNum++
 		}
 
@@ -388,19 +388,20 @@ func isMatchCond(row *influx.Row, varRef *influxql.VarRef, value influxql.Expr,
 }
 
 func isNumberFieldMatchCond(field *influx.Field, value float64, op influxql.Token) bool {
+	fieldValue := field.Float64Value()
 	switch op {
 	case influxql.EQ:
-		return field.NumValue == value
+		return fieldValue == value
 	case influxql.NEQ:
-		return field.NumValue != value
+		return fieldValue != value
 	case influxql.GT:
-		return field.NumValue > value
+		return fieldValue > value
 	case influxql.GTE:
-		return field.NumValue >= value
+		return fieldValue >= value
 	case influxql.LT:
-		return field.NumValue < value
+		return fieldValue < value
 	case influxql.LTE:
-		return field.NumValue <= value
+		return fieldValue <= value
 	default:
 		return false
 	}
@@ -714,7 +715,7 @@ func (s *TimeTask) calculateRow(cache *TaskCache) {
 			if call.Call == "count" && !row.StreamOnly {
 				curVal = 1
 			} else {
-				curVal = row.Fields[f].NumValue
+				curVal = row.Fields[f].Float64Value()
 			}
 			id := base + c
 			s.values[id] = call.SingleThreadFunc(s.values[id], curVal)
@@ -793,7 +794,7 @@ func (s *TimeTask) buildRow() bool {
 		if !s.validValues[s.offset+i] {
 			continue
 		}
-		(*fields)[validNum].NumValue = atomic2.LoadFloat64(&s.values[s.offset+i])
+		(*fields)[validNum].SetFloat64Value(atomic2.LoadFloat64(&s.values[s.offset+i]))
 		validNum++
 	}
 	if validNum == 0 {
@@ -37,6 +37,10 @@ func NewHandler(typ uint8) RPCHandler {
 		return &ShowTagValues{}
 	case netstorage.ShowTagValuesCardinalityRequestMessage:
 		return &ShowTagValuesCardinality{}
+	case netstorage.TagValuesSketchRequestMessage:
+		return &TagValuesSketch{}
+	case netstorage.SeriesQuotaRequestMessage:
+		return &SeriesQuota{}
 	case netstorage.GetShardSplitPointsRequestMessage:
 		return &GetShardSplitPoints{}
 	case netstorage.DeleteRequestMessage:
@@ -141,6 +145,40 @@ func (h *ShowTagValuesCardinality) SetMessage(msg codec.BinaryCodec) error {
 	return nil
 }
 
+type TagValuesSketch struct {
+	BaseHandler
+
+	req *netstorage.TagValuesSketchRequest
+	rsp *netstorage.TagValuesSketchResponse
+}
+
+func (h *TagValuesSketch) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.TagValuesSketchResponse{}
+	req, ok := msg.(*netstorage.TagValuesSketchRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.TagValuesSketchRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
+type SeriesQuota struct {
+	BaseHandler
+
+	req *netstorage.SeriesQuotaRequest
+	rsp *netstorage.SeriesQuotaResponse
+}
+
+func (h *SeriesQuota) SetMessage(msg codec.BinaryCodec) error {
+	h.rsp = &netstorage.SeriesQuotaResponse{}
+	req, ok := msg.(*netstorage.SeriesQuotaRequest)
+	if !ok {
+		return executor.NewInvalidTypeError("*netstorage.SeriesQuotaRequest", msg)
+	}
+	h.req = req
+	return nil
+}
+
 type GetShardSplitPoints struct {
 	BaseHandler
 
@@ -228,6 +228,32 @@ func (h *ShowTagValuesCardinality) Process() (codec.BinaryCodec, error) {
 	return h.rsp, nil
 }
 
+func (h *TagValuesSketch) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(h.req.Condition, func(expr influxql.Expr, tr influxql.TimeRange) error {
+		var err error
+		h.rsp.Sketches, err = h.store.TagValuesSketch(*h.req.Db, h.req.PtIDs, h.req.GetTagKeysBytes(), expr, tr)
+		return err
+	})
+
+	return h.rsp, nil
+}
+
+func (h *SeriesQuota) Process() (codec.BinaryCodec, error) {
+	h.rsp.Err = processDDL(nil, func(_ influxql.Expr, _ influxql.TimeRange) error {
+		quota, err := h.store.SeriesQuota(*h.req.Db, h.req.PtIDs, h.req.Measurements)
+		if err != nil {
+			return err
+		}
+		h.rsp.Series = quota.Series
+		h.rsp.Limit = proto.Uint64(quota.Limit)
+		h.rsp.DatabaseSeries = proto.Uint64(quota.DatabaseSeries)
+		h.rsp.DatabaseLimit = proto.Uint64(quota.DatabaseLimit)
+		return nil
+	})
+
+	return h.rsp, nil
+}
+
 func (h *ShowQueries) Process() (codec.BinaryCodec, error) {
 	var queries []*netstorage.QueryExeInfo
 
@@ -219,8 +219,11 @@ func (s *Select) process(w spdy.Responser, node hybridqp.QueryNode, req *executo
 	} else {
 		ctx = context.WithValue(s.context, QueryDurationKey, qDuration)
 	}
-	if req.Analyze {
-		ctx = s.initTrace(ctx)
+	if req.Analyze || req.Export {
+		ctx = s.initTrace(ctx, req.TraceParent)
+	}
+	if req.Export {
+		defer tracing.Export(s.trace)
 	}
 
 	defer func() {
@@ -250,6 +253,9 @@ func (s *Select) process(w spdy.Responser, node hybridqp.QueryNode, req *executo
 	}
 
 	if s.trace != nil {
+		tracing.Finish(s.buildPlanSpan, s.createPlanSpan)
+	}
+	if req.Analyze {
 		s.responseAnalyze(w)
 	}
 
@@ -280,7 +286,6 @@ func (s *Select) execute(ctx context.Context, p hybridqp.Executor) error {
 }
 
 func (s *Select) responseAnalyze(w spdy.Responser) {
-	tracing.Finish(s.buildPlanSpan, s.createPlanSpan)
 	rsp := executor.NewAnalyzeResponse(s.trace)
 
 	if err := w.Response(rsp, false); err != nil {
@@ -288,8 +293,10 @@ func (s *Select) responseAnalyze(w spdy.Responser) {
 	}
 }
 
-func (s *Select) initTrace(ctx context.Context) context.Context {
+func (s *Select) initTrace(ctx context.Context, traceParent string) context.Context {
 	s.trace, s.rootSpan = tracing.NewTrace("TS-Store")
+	// the spans are exported under the caller's trace, if the caller's trace is sampled
+	s.trace.Join(traceParent)
 	ctx = tracing.NewContextWithTrace(ctx, s.trace)
 	ctx = tracing.NewContextWithSpan(ctx, s.rootSpan)
 	s.rootSpan.Finish()
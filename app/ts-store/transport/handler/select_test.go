@@ -16,9 +16,14 @@ package handler
 
 import (
 	"context"
+	"encoding/hex"
 	"errors"
+	"io"
 	"math"
+	"net/http"
+	"net/http/httptest"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -36,11 +41,15 @@ import (
 	"github.com/openGemini/openGemini/lib/spdy"
 	"github.com/openGemini/openGemini/lib/spdy/rpc"
 	"github.com/openGemini/openGemini/lib/spdy/transport"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	qry "github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 	"github.com/stretchr/testify/require"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 type MockStoreEngine struct {
@@ -97,6 +106,14 @@ func (s *MockStoreEngine) TagValuesCardinality(db string, ptIDs []uint32, tagKey
 	return nil, nil
 }
 
+func (s *MockStoreEngine) TagValuesSketch(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string][]byte, error) {
+	return nil, nil
+}
+
+func (s *MockStoreEngine) SeriesQuota(db string, ptIDs []uint32, measurements []string) (*netstorage.SeriesQuota, error) {
+	return &netstorage.SeriesQuota{}, nil
+}
+
 func (s *MockStoreEngine) SendSysCtrlOnNode(req *netstorage.SysCtrlRequest) (map[string]string, error) {
 	return nil, nil
 }
@@ -256,6 +273,63 @@ func TestCreateSerfInstance(t *testing.T) {
 	require.EqualError(t, h.Process(), errno.NewError(errno.ShortBufferSize, util.Uint64SizeBytes, 1).Error())
 }
 
+func TestSelectExportTrace(t *testing.T) {
+	hookLogicPlan()
+
+	var mu sync.Mutex
+	var spans []*tracepb.Span
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &coltracepb.ExportTraceServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, req))
+		mu.Lock()
+		defer mu.Unlock()
+		for _, rs := range req.ResourceSpans {
+			for _, ss := range rs.ScopeSpans {
+				spans = append(spans, ss.Spans...)
+			}
+		}
+	}))
+	defer collector.Close()
+
+	conf := config.NewTracingConfig()
+	conf.Enabled = true
+	conf.Endpoint = collector.URL + "/v1/traces"
+	exporter := tracing.NewExporter(conf, config.AppStore)
+	require.NoError(t, exporter.Open())
+
+	schema := executor.NewQuerySchema(nil, nil, &qry.ProcessorOptions{}, nil)
+	node, err := executor.MarshalQueryNode(executor.NewLogicalSeries(schema))
+	require.NoError(t, err)
+	req := &executor.RemoteQuery{
+		Database:    "db0",
+		PtID:        1,
+		NodeID:      1,
+		ShardIDs:    []uint64{1},
+		Node:        node,
+		Export:      true,
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}
+
+	store := mockStorage(t.TempDir())
+	resp := &analyzeResponser{}
+	resp.session = spdy.NewMultiplexedSession(spdy.DefaultConfiguration(), nil, 0)
+	h := NewSelect(store, resp, req)
+	h.SetContext(context.Background())
+	require.NoError(t, h.Process())
+	require.NoError(t, exporter.Close())
+	// the spans of a sampled query are exported by the store, not returned to the caller
+	require.Equal(t, 0, resp.analyzed)
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.NotEmpty(t, spans)
+	require.Equal(t, "TS-Store", spans[0].Name)
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hex.EncodeToString(spans[0].TraceId))
+	require.Equal(t, "00f067aa0ba902b7", hex.EncodeToString(spans[0].ParentSpanId))
+}
+
 type EmptyResponser struct {
 	transport.Responser
 	session *spdy.MultiplexedSession
@@ -272,6 +346,19 @@ func (r *EmptyResponser) Response(response interface{}, full bool) error {
 	return r.err
 }
 
+type analyzeResponser struct {
+	EmptyResponser
+
+	analyzed int
+}
+
+func (r *analyzeResponser) Response(response interface{}, full bool) error {
+	if msg, ok := response.(*rpc.Message); ok && msg.Type() == executor.AnalyzeResponseMessage {
+		r.analyzed++
+	}
+	return r.err
+}
+
 func (r *EmptyResponser) Callback(data interface{}) error {
 
 	return nil
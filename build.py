@@ -28,7 +28,8 @@ targets = {
     'ts-server' : './app/ts-server',
     'ts-monitor' : './app/ts-monitor',
     'ts-data' : './app/ts-data',
-    'ts-recover': './app/ts-recover'
+    'ts-recover': './app/ts-recover',
+    'ts-dump': './app/ts-dump'
 }
 
 supported_builds = {
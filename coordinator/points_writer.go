@@ -73,7 +73,7 @@ type PWMetaClient interface {
 	Measurement(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error)
 	UpdateSchema(database string, retentionPolicy string, mst string, fieldToCreate []*proto2.FieldSchema) error
 	CreateMeasurement(database string, retentionPolicy string, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation, engineType config.EngineType,
-		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error)
+		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error)
 	GetAliveShards(database string, sgi *meta2.ShardGroupInfo, isRead bool) []int
 	GetStreamInfos() map[string]*meta2.StreamInfo
 	GetDstStreamInfos(db, rp string, dstSis *[]*meta2.StreamInfo) bool
@@ -306,7 +306,7 @@ func (w *PointsWriter) writePointRows(database, retentionPolicy string, rows []i
 	handlerStat.WriteStoresDuration.AddSinceNano(start)
 
 	if err != nil {
-		if errno.Equal(err, errno.ErrorTagArrayFormat, errno.WriteErrorArray, errno.SeriesLimited) {
+		if errno.Equal(err, errno.ErrorTagArrayFormat, errno.WriteErrorArray, errno.SeriesLimited, errno.MeasurementSeriesLimited) {
 			return netstorage.PartialWriteError{Reason: err, Dropped: dropped}
 		}
 		return err
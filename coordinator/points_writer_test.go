@@ -103,7 +103,7 @@ func (mmc *MockMetaClient) UpdateSchema(database string, retentionPolicy string,
 }
 
 func (mmc *MockMetaClient) CreateMeasurement(database string, retentionPolicy string, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation,
-	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error) {
+	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error) {
 	return mmc.CreateMeasurementFn(database, retentionPolicy, mst, shardKey, numOfShards, indexR, engineType, nil)
 }
 
@@ -259,7 +259,7 @@ func (c *MockRWMetaClient) UpdateSchema(_ string, _ string, _ string, _ []*proto
 }
 
 func (c *MockRWMetaClient) CreateMeasurement(_ string, _ string, _ string, _ *meta.ShardKeyInfo, _ int32, _ *influxql.IndexRelation, _ config.EngineType, _ *meta.ColStoreInfo,
-	_ []*proto.FieldSchema, _ *meta2.Options) (*meta.MeasurementInfo, error) {
+	_ []*proto.FieldSchema, _ *meta2.Options, _ time.Duration) (*meta.MeasurementInfo, error) {
 	return nil, c.CreateMeasurementErr
 }
 
@@ -692,10 +692,14 @@ func (csm *ClusterShardMapping) makeRemoteQuery(ctx context.Context, src influxq
 		shardIDs[i] = shardInfos[i].ID
 	}
 	opt.Sources = src
+	csm.hideExpiredRows(src, &opt)
 
-	analyze := false
+	analyze, export := false, false
 	if span := tracing.SpanFromContext(ctx); span != nil {
-		analyze = true
+		analyze = !tracing.ExportOnly(ctx)
+	}
+	if trace := tracing.TraceFromContext(ctx); trace != nil {
+		export = trace.Sampled()
 	}
 
 	node, err := csm.MetaClient.DataNode(nodeID)
@@ -714,11 +718,37 @@ func (csm *ClusterShardMapping) makeRemoteQuery(ctx context.Context, src influxq
 		PtQuerys: ptQuerys,
 		Opt:      opt,
 		Analyze:  analyze,
+		Export:   export,
 		Node:     nil,
 	}
 	return rq, nil
 }
 
+// hideExpiredRows raises the start time of the query to the ttl boundary of the measurements,
+// so that rows which are expired but not yet removed by the compaction are not returned.
+// The sources share the time range of the query, so the earliest boundary is used and the
+// start time is kept if any measurement has no ttl.
+func (csm *ClusterShardMapping) hideExpiredRows(src influxql.Sources, opt *query.ProcessorOptions) {
+	var expire int64
+	now := time.Now().UnixNano()
+	for i := range src {
+		m, ok := src[i].(*influxql.Measurement)
+		if !ok {
+			return
+		}
+		msti, err := csm.MetaClient.Measurement(m.Database, m.RetentionPolicy, m.Name)
+		if err != nil || msti == nil || msti.TTL <= 0 {
+			return
+		}
+		if t := now - msti.TTL; i == 0 || t < expire {
+			expire = t
+		}
+	}
+	if len(src) > 0 && opt.StartTime < expire {
+		opt.StartTime = expire
+	}
+}
+
 func (csm *ClusterShardMapping) LogicalPlanCost(m *influxql.Measurement, opt query.ProcessorOptions) (hybridqp.LogicalPlanCost, error) {
 	return hybridqp.LogicalPlanCost{}, nil
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/openGemini/openGemini/lib/obs"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -99,7 +100,7 @@ func (m mocShardMapperMetaClient) DropStream(name string) error {
 }
 
 func (m mocShardMapperMetaClient) CreateMeasurement(database string, retentionPolicy string, mst string, shardKey *meta.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation,
-	engineType config.EngineType, colStoreInfo *meta.ColStoreInfo, schemaInfo []*proto.FieldSchema, options *meta2.Options) (*meta.MeasurementInfo, error) {
+	engineType config.EngineType, colStoreInfo *meta.ColStoreInfo, schemaInfo []*proto.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta.MeasurementInfo, error) {
 	return nil, nil
 }
 
@@ -1193,6 +1194,19 @@ func Test_CreateRemoteQuery(t *testing.T) {
 	if err != nil {
 		t.Fatalf("makeRemoteQuery failed: %v", err)
 	}
+
+	// EXPLAIN ANALYZE returns the traces of the stores
+	trace, span := tracing.NewTrace("SELECT")
+	actx := tracing.NewContextWithSpan(tracing.NewContextWithTrace(ctx, trace), span)
+	rq, err := csm.makeRemoteQuery(actx, influxql.Sources{source}, opt, 1, 1, []executor.ShardInfo{{ID: 1}}, nil)
+	require.NoError(t, err)
+	require.True(t, rq.Analyze)
+	require.False(t, rq.Export)
+
+	// a sampled query only exports the traces, the stores do not return them
+	rq, err = csm.makeRemoteQuery(tracing.NewContextWithExportOnly(actx), influxql.Sources{source}, opt, 1, 1, []executor.ShardInfo{{ID: 1}}, nil)
+	require.NoError(t, err)
+	require.False(t, rq.Analyze)
 }
 
 func Test_CreateLogicalPlanForRWSplit(t *testing.T) {
@@ -1579,3 +1593,40 @@ func Test_MapTypeBatchBinOpNilMst(t *testing.T) {
 		t.Fatal()
 	}
 }
+
+func TestHideExpiredRows(t *testing.T) {
+	rp := &meta.RetentionPolicyInfo{Measurements: map[string]*meta.MeasurementInfo{
+		"day":  {Name: "day", TTL: int64(24 * time.Hour)},
+		"hour": {Name: "hour", TTL: int64(time.Hour)},
+		"all":  {Name: "all"},
+	}}
+	csm := &ClusterShardMapping{MetaClient: &mocShardMapperMetaClient{databases: map[string]*meta.DatabaseInfo{
+		"db0": {Name: "db0", RetentionPolicies: map[string]*meta.RetentionPolicyInfo{"rp0": rp}},
+	}}}
+	source := func(names ...string) influxql.Sources {
+		var src influxql.Sources
+		for _, name := range names {
+			src = append(src, &influxql.Measurement{Database: "db0", RetentionPolicy: "rp0", Name: name})
+		}
+		return src
+	}
+
+	now := time.Now().UnixNano()
+	opt := &query.ProcessorOptions{StartTime: influxql.MinTime}
+	csm.hideExpiredRows(source("hour"), opt)
+	assert.InDelta(t, now-int64(time.Hour), opt.StartTime, float64(time.Minute))
+
+	// the earliest boundary of the sources is used
+	opt = &query.ProcessorOptions{StartTime: influxql.MinTime}
+	csm.hideExpiredRows(source("hour", "day"), opt)
+	assert.InDelta(t, now-int64(24*time.Hour), opt.StartTime, float64(time.Minute))
+
+	opt = &query.ProcessorOptions{StartTime: influxql.MinTime}
+	csm.hideExpiredRows(source("hour", "all"), opt)
+	assert.Equal(t, int64(influxql.MinTime), opt.StartTime)
+
+	// a later start time is kept
+	opt = &query.ProcessorOptions{StartTime: now}
+	csm.hideExpiredRows(source("hour"), opt)
+	assert.Equal(t, now, opt.StartTime)
+}
@@ -19,6 +19,7 @@ import (
 	"sync"
 
 	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/hll"
 	"github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
@@ -32,6 +33,7 @@ type ShowTagValuesExecutor struct {
 	me          IMetaExecutor
 	store       netstorage.Storage
 	cardinality bool
+	approximate bool
 	dimensions  influxql.Dimensions
 }
 
@@ -49,11 +51,22 @@ func (e *ShowTagValuesExecutor) Cardinality(dimensions influxql.Dimensions) {
 	e.cardinality = true
 }
 
+// ApproximateCardinality estimates the cardinality by merging the HyperLogLog sketches of the tag values
+// built on each store, instead of transferring and deduplicating all the tag values
+func (e *ShowTagValuesExecutor) ApproximateCardinality(dimensions influxql.Dimensions) {
+	e.Cardinality(dimensions)
+	e.approximate = true
+}
+
 func (e *ShowTagValuesExecutor) Execute(stmt *influxql.ShowTagValuesStatement) (models.Rows, error) {
 	if stmt.Database == "" {
 		return nil, ErrDatabaseNameRequired
 	}
 
+	if e.cardinality && e.approximate {
+		return e.estimateCardinality(stmt)
+	}
+
 	tagValues, err := e.queryTagValues(stmt)
 	if err != nil {
 		return nil, err
@@ -126,6 +139,70 @@ func (e *ShowTagValuesExecutor) emitCardinality(tagValues TagValuesSlice) (model
 	return rows, nil
 }
 
+func (e *ShowTagValuesExecutor) estimateCardinality(q *influxql.ShowTagValuesStatement) (models.Rows, error) {
+	tagKeys, err := e.mc.QueryTagKeys(q.Database, q.Sources.Measurements(), q.TagKeyCondition)
+	if err != nil {
+		return nil, err
+	}
+	if len(tagKeys) == 0 {
+		e.logger.Info("no matching tag key found", zap.String("pos", "ShowTagValuesExecutor.estimateCardinality"))
+		return nil, nil
+	}
+
+	sketches := make(map[string]*hll.Sketch, len(tagKeys))
+	lock := new(sync.Mutex)
+	err = e.me.EachDBNodes(q.Database, func(nodeID uint64, pts []uint32) error {
+		s, err := e.store.TagValuesSketch(nodeID, q.Database, pts, tagKeys, q.Condition)
+		if err != nil {
+			return err
+		}
+
+		lock.Lock()
+		defer lock.Unlock()
+		for name, buf := range s {
+			other := &hll.Sketch{}
+			if err := other.UnmarshalBinary(buf); err != nil {
+				return err
+			}
+			sketch, ok := sketches[name]
+			if !ok {
+				sketches[name] = other
+				continue
+			}
+			if err := sketch.Merge(other); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		e.logger.Error("failed to estimate tag values cardinality", zap.Error(err))
+		return nil, err
+	}
+
+	names := make([]string, 0, len(sketches))
+	for name := range sketches {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rows := make(models.Rows, 0, len(names))
+	for _, name := range names {
+		count := sketches[name].Count()
+		if count == 0 {
+			continue
+		}
+		rows = append(rows, &models.Row{
+			Name:    name,
+			Columns: []string{"count"},
+			Values: [][]interface{}{
+				{int(count)},
+			},
+		})
+	}
+	return rows, nil
+}
+
 func (e *ShowTagValuesExecutor) applyLimit(offset, limit, orderBy int, values netstorage.TagSets) netstorage.TagSets {
 	size := len(values)
 	if offset >= size {
@@ -22,6 +22,7 @@ import (
 
 	"github.com/influxdata/influxdb/models"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/hll"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
@@ -254,6 +255,31 @@ func TestShowTagValuesExecutorCardinality(t *testing.T) {
 	}
 }
 
+func TestShowTagValuesExecutorApproximateCardinality(t *testing.T) {
+	e := NewShowTagValuesExecutor(logger.NewLogger(errno.ModuleUnknown),
+		&mockMC{}, &mockME{}, &mockNS{})
+	e.ApproximateCardinality(influxql.Dimensions{})
+	rows, err := e.Execute(&influxql.ShowTagValuesStatement{
+		Database: "db0",
+		Sources:  append(influxql.Sources{}, &influxql.Measurement{}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, getCardinalityExpRows(), rows)
+
+	rows, err = e.Execute(&influxql.ShowTagValuesStatement{
+		Database: "db_nil",
+		Sources:  append(influxql.Sources{}, &influxql.Measurement{}),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(rows))
+
+	_, err = e.Execute(&influxql.ShowTagValuesStatement{
+		Database: "db_error",
+		Sources:  append(influxql.Sources{}, &influxql.Measurement{}),
+	})
+	assert.EqualError(t, err, "mock error")
+}
+
 func TestApplyLimit(t *testing.T) {
 	e := &ShowTagValuesExecutor{}
 
@@ -415,6 +441,22 @@ func (m *mockNS) TagValues(nodeID uint64, db string, ptIDs []uint32, tagKeys map
 	}), nil
 }
 
+func (m *mockNS) TagValuesSketch(nodeID uint64, db string, ptIDs []uint32, tagKeys map[string]map[string]struct{}, cond influxql.Expr) (map[string][]byte, error) {
+	tagValues, _ := m.TagValues(nodeID, db, ptIDs, tagKeys, cond, 0, false)
+	ret := make(map[string][]byte, len(tagValues))
+	for _, item := range tagValues {
+		if len(item.Values) == 0 {
+			continue
+		}
+		sketch := hll.NewDefault()
+		for _, tv := range item.Values {
+			sketch.AddString(tv.Key + "\x00" + tv.Value)
+		}
+		ret[item.Name], _ = sketch.MarshalBinary()
+	}
+	return ret, nil
+}
+
 type mockME struct {
 	MetaExecutor
 }
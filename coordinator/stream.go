@@ -240,7 +240,7 @@ func (s *Stream) calculateWindow(rows []*influx.Row, si *meta2.StreamInfo, task
 				// the computation of string type is not supported
 				return fmt.Errorf("the %s string type is not supported for stream task %s", fv.Key, si.Name)
 			}
-			curVal := fv.NumValue
+			curVal := fv.Float64Value()
 			if task.calls[i].Call == "count" {
 				curVal = 1
 			}
@@ -303,8 +303,8 @@ func (s *Stream) mapRowsToShard(
 					continue
 				}
 				r.Fields[i].Key = task.calls[i].Alias
-				r.Fields[i].NumValue = *v[i]
 				r.Fields[i].Type = task.calls[i].OutFieldType
+				r.Fields[i].SetFloat64Value(*v[i])
 				fieldCount++
 			}
 			if fieldCount == 0 {
@@ -17,10 +17,12 @@ package coordinator
 import (
 	"bytes"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -28,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/crypto"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"go.uber.org/zap"
 )
@@ -35,6 +38,26 @@ import (
 type Client interface {
 	Send(db, rp string, lineProtocol []byte) error
 	Destination() string
+	Close() error
+}
+
+// nonRetryableError is returned by a client if the destination rejects the write request itself,
+// such a write request is dropped instead of being retried
+type nonRetryableError struct {
+	err error
+}
+
+func (e *nonRetryableError) Error() string {
+	return e.err.Error()
+}
+
+func (e *nonRetryableError) Unwrap() error {
+	return e.err
+}
+
+func isRetryable(err error) bool {
+	var e *nonRetryableError
+	return !errors.As(err, &e)
 }
 
 type HTTPClient struct {
@@ -65,7 +88,12 @@ func (c *HTTPClient) Send(db, rp string, lineProtocol []byte) error {
 		if err != nil {
 			return err
 		}
-		err = fmt.Errorf(string(body))
+		err = errors.New(string(body))
+		// the client errors except timeout and rate limiting fail again if retried
+		if resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+			resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests {
+			return &nonRetryableError{err: err}
+		}
 		return err
 	}
 	return nil
@@ -75,12 +103,17 @@ func (c *HTTPClient) Destination() string {
 	return c.url.String()
 }
 
+func (c *HTTPClient) Close() error {
+	c.client.CloseIdleConnections()
+	return nil
+}
+
 func NewHTTPClient(url *url.URL, timeout time.Duration) *HTTPClient {
 	c := &http.Client{Timeout: timeout}
 	return &HTTPClient{client: c, url: url}
 }
 
-func NewHTTPSClient(url *url.URL, timeout time.Duration, skipVerify bool, certs string) (*HTTPClient, error) {
+func newTLSConfig(skipVerify bool, certs string) (*tls.Config, error) {
 	tlsConfig := config.NewTLSConfig(skipVerify)
 	if certs != "" {
 		cert, err := tls.X509KeyPair(
@@ -92,6 +125,14 @@ func NewHTTPSClient(url *url.URL, timeout time.Duration, skipVerify bool, certs
 		}
 		tlsConfig.Certificates = []tls.Certificate{cert}
 	}
+	return tlsConfig, nil
+}
+
+func NewHTTPSClient(url *url.URL, timeout time.Duration, skipVerify bool, certs string) (*HTTPClient, error) {
+	tlsConfig, err := newTLSConfig(skipVerify, certs)
+	if err != nil {
+		return nil, err
+	}
 
 	transport := &http.Transport{
 		TLSClientConfig: tlsConfig,
@@ -108,20 +149,90 @@ type WriteRequest struct {
 type BaseWriter struct {
 	ch      chan *WriteRequest
 	clients []Client
+	stats   []*statistics.SubscriberStats
 	db      string
 	rp      string
 	name    string
 	logger  *logger.Logger
+
+	// disk-backed queues of the clients, write requests are appended to them by
+	// the appender goroutine, so that disk writes are kept out of the write path
+	queues           []*SubscriberQueue
+	appended         sync.WaitGroup
+	done             chan struct{}
+	syncInterval     time.Duration
+	retryInterval    time.Duration
+	maxRetryInterval time.Duration
+
+	// failover sends the write requests of an unavailable client to the other clients
+	failover bool
+	// unix nano time until which the client is considered unavailable after a failed delivery
+	downUntil []atomic.Int64
 }
 
 func NewBaseWriter(db, rp, name string, clients []Client, logger *logger.Logger) BaseWriter {
-	return BaseWriter{db: db, rp: rp, name: name, clients: clients, logger: logger}
+	stats := make([]*statistics.SubscriberStats, len(clients))
+	for i, c := range clients {
+		stats[i] = statistics.SubscriberStat.GetStats(db, rp, name, c.Destination())
+	}
+	return BaseWriter{db: db, rp: rp, name: name, clients: clients, stats: stats, logger: logger,
+		retryInterval: config.DefaultSubscriberRetryInterval, downUntil: make([]atomic.Int64, len(clients))}
+}
+
+func (w *BaseWriter) available(i int) bool {
+	return w.downUntil[i].Load() <= time.Now().UnixNano()
+}
+
+func (w *BaseWriter) markDown(i int, d time.Duration) {
+	w.downUntil[i].Store(time.Now().Add(d).UnixNano())
+}
+
+func (w *BaseWriter) markUp(i int) {
+	w.downUntil[i].Store(0)
+}
+
+// failoverClient returns an available client other than i, or -1 if there is none
+func (w *BaseWriter) failoverClient(i int) int {
+	for k := 1; k < len(w.clients); k++ {
+		j := (i + k) % len(w.clients)
+		if w.available(j) {
+			return j
+		}
+	}
+	return -1
+}
+
+// OpenQueues buffers the write requests of each client in a disk-backed queue,
+// the undelivered write requests of the last run are delivered after Start
+func (w *BaseWriter) OpenQueues(conf config.Subscriber) error {
+	dir := filepath.Join(conf.QueueDir, w.db, w.rp, w.name)
+	queues := make([]*SubscriberQueue, 0, len(w.clients))
+	for i, c := range w.clients {
+		q, err := OpenSubscriberQueue(filepath.Join(dir, url.PathEscape(c.Destination())), int64(conf.QueueMaxSize),
+			int64(conf.QueueSegmentSize), time.Duration(conf.QueueMaxAge), w.stats[i], w.logger)
+		if err != nil {
+			for _, q := range queues {
+				q.Close()
+			}
+			return err
+		}
+		queues = append(queues, q)
+	}
+	w.queues = queues
+	w.syncInterval = time.Duration(conf.QueueSyncInterval)
+	w.retryInterval = time.Duration(conf.RetryInterval)
+	w.maxRetryInterval = time.Duration(conf.MaxRetryInterval)
+	return nil
 }
 
 func (w *BaseWriter) Send(wr *WriteRequest) {
 	select {
 	case w.ch <- wr:
+		if w.queues == nil {
+			w.stats[wr.Client].AddEnqueued(1)
+		}
 	default:
+		w.stats[wr.Client].AddDropped(1)
 		w.logger.Error("failed to send write request to write buffer", zap.String("dest", w.clients[wr.Client].Destination()),
 			zap.String("db", w.db), zap.String("rp", w.rp))
 	}
@@ -130,9 +241,116 @@ func (w *BaseWriter) Send(wr *WriteRequest) {
 func (w *BaseWriter) Run() {
 	for wr := range w.ch {
 		err := w.clients[wr.Client].Send(w.db, w.rp, wr.LineProtocol)
+		if err != nil && w.failover && isRetryable(err) {
+			w.markDown(wr.Client, w.retryInterval)
+			if j := w.failoverClient(wr.Client); j >= 0 {
+				w.logger.Warn("fail over write request", zap.String("from", w.clients[wr.Client].Destination()),
+					zap.String("to", w.clients[j].Destination()), zap.String("db", w.db), zap.String("rp", w.rp), zap.Error(err))
+				w.stats[wr.Client].AddDropped(1)
+				w.stats[j].AddEnqueued(1)
+				wr.Client = j
+				err = w.clients[j].Send(w.db, w.rp, wr.LineProtocol)
+			}
+		}
 		if err != nil {
+			w.stats[wr.Client].AddDropped(1)
 			w.logger.Error("failed to forward write request", zap.String("dest", w.clients[wr.Client].Destination()),
 				zap.String("db", w.db), zap.String("rp", w.rp), zap.Error(err))
+		} else {
+			w.markUp(wr.Client)
+			w.stats[wr.Client].AddDelivered(1)
+		}
+	}
+}
+
+// runAppend appends the buffered write requests to the queues and syncs the queues periodically
+func (w *BaseWriter) runAppend() {
+	defer w.appended.Done()
+	ticker := time.NewTicker(w.syncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case wr, ok := <-w.ch:
+			if !ok {
+				return
+			}
+			if err := w.queues[wr.Client].Append(wr.LineProtocol); err != nil {
+				w.logger.Error("failed to append write request to subscriber queue", zap.String("dest", w.clients[wr.Client].Destination()),
+					zap.String("db", w.db), zap.String("rp", w.rp), zap.Error(err))
+			}
+		case <-ticker.C:
+			for _, q := range w.queues {
+				if err := q.Sync(); err != nil {
+					w.logger.Error("failed to sync subscriber queue", zap.String("db", w.db), zap.String("rp", w.rp),
+						zap.String("sub", w.name), zap.Error(err))
+				}
+			}
+		}
+	}
+}
+
+// runQueue delivers the write requests in the queue of a client in order,
+// a failed delivery is retried with an exponential backoff until it succeeds
+// or the write request is dropped by the queue. A write request rejected by
+// the destination is dropped, and in failover mode the write requests of an
+// unavailable client are moved to the queue of another available client.
+func (w *BaseWriter) runQueue(i int) {
+	q, client := w.queues[i], w.clients[i]
+	backoff := w.retryInterval
+	for {
+		data, next, err := q.Next()
+		if err == errQueueEmpty {
+			select {
+			case <-q.Notify():
+				continue
+			case <-w.done:
+				return
+			}
+		}
+		if err == errQueueClosed {
+			return
+		}
+		if err == nil {
+			err = client.Send(w.db, w.rp, data)
+		}
+		if err != nil && !isRetryable(err) {
+			w.stats[i].AddDropped(1)
+			w.logger.Error("drop write request rejected by destination", zap.String("dest", client.Destination()),
+				zap.String("db", w.db), zap.String("rp", w.rp), zap.Error(err))
+			if err = q.Skip(next); err == errQueueClosed {
+				return
+			}
+			continue
+		}
+		if err != nil && w.failover {
+			w.markDown(i, backoff)
+			if j := w.failoverClient(i); j >= 0 {
+				if e := w.queues[j].Append(data); e == nil {
+					w.logger.Warn("fail over write request", zap.String("from", client.Destination()),
+						zap.String("to", w.clients[j].Destination()), zap.String("db", w.db), zap.String("rp", w.rp), zap.Error(err))
+					if err = q.Skip(next); err == errQueueClosed {
+						return
+					}
+					continue
+				}
+			}
+		}
+		if err != nil {
+			w.stats[i].AddRetries(1)
+			w.logger.Error("failed to forward write request", zap.String("dest", client.Destination()),
+				zap.String("db", w.db), zap.String("rp", w.rp), zap.Duration("backoff", backoff), zap.Error(err))
+			select {
+			case <-time.After(backoff):
+			case <-w.done:
+				return
+			}
+			backoff = min(backoff*2, w.maxRetryInterval)
+			continue
+		}
+		backoff = w.retryInterval
+		w.markUp(i)
+		if err = q.Advance(next); err == errQueueClosed {
+			return
 		}
 	}
 }
@@ -147,13 +365,48 @@ func (w *BaseWriter) Clients() []Client {
 
 func (w *BaseWriter) Start(concurrency, buffersize int) {
 	w.ch = make(chan *WriteRequest, buffersize)
+	if w.queues != nil {
+		// the write requests of a client are appended and delivered by one goroutine to keep the order
+		w.done = make(chan struct{})
+		w.appended.Add(1)
+		go w.runAppend()
+		for i := range w.queues {
+			go w.runQueue(i)
+		}
+		return
+	}
+
 	for i := 0; i < concurrency; i++ {
 		go w.Run()
 	}
 }
 
+// Stop stops the delivery, the undelivered write requests are kept in the queues
 func (w *BaseWriter) Stop() {
 	close(w.ch)
+	if w.queues != nil {
+		// the buffered write requests are appended before the queues are closed
+		w.appended.Wait()
+		close(w.done)
+		for _, q := range w.queues {
+			q.Close()
+		}
+	}
+	closeClients(w.clients)
+}
+
+// Drop stops the delivery and removes the queues and statistics of the dropped subscription
+func (w *BaseWriter) Drop() {
+	w.Stop()
+	for _, q := range w.queues {
+		if err := q.Remove(); err != nil {
+			w.logger.Error("failed to remove subscriber queue", zap.String("db", w.db), zap.String("rp", w.rp),
+				zap.String("sub", w.name), zap.Error(err))
+		}
+	}
+	for _, c := range w.clients {
+		statistics.SubscriberStat.Delete(w.db, w.rp, w.name, c.Destination())
+	}
 }
 
 type SubscriberWriter interface {
@@ -162,6 +415,7 @@ type SubscriberWriter interface {
 	Run()
 	Start(concurrency, buffersize int)
 	Stop()
+	Drop()
 	Clients() []Client
 }
 
@@ -184,9 +438,16 @@ type RoundRobinWriter struct {
 	i int32
 }
 
+// Write sends the write request to the clients in turn, the unavailable clients are skipped
+// until their retry interval elapses
 func (w *RoundRobinWriter) Write(lineProtocol []byte) {
-	i := atomic.AddInt32(&w.i, 1) % int32(len(w.clients))
-	wr := &WriteRequest{Client: int(i), LineProtocol: lineProtocol}
+	i := int(atomic.AddInt32(&w.i, 1) % int32(len(w.clients)))
+	if !w.available(i) {
+		if j := w.failoverClient(i); j >= 0 {
+			i = j
+		}
+	}
+	wr := &WriteRequest{Client: i, LineProtocol: lineProtocol}
 	w.Send(wr)
 }
 
@@ -213,27 +474,66 @@ func (s *SubscriberManager) NewSubscriberWriter(db, rp, name, mode string, desti
 		if err != nil {
 			return nil, fmt.Errorf("fail to parse %s", err)
 		}
-		var c Client
-		switch u.Scheme {
-		case "http":
-			c = NewHTTPClient(u, time.Duration(s.config.HTTPTimeout))
-		case "https":
-			c, err = NewHTTPSClient(u, time.Duration(s.config.HTTPTimeout), s.config.InsecureSkipVerify, s.config.HttpsCertificate)
-			if err != nil {
-				return nil, err
-			}
-		default:
-			return nil, fmt.Errorf("unknown subscription schema %s", u.Scheme)
+		c, err := s.newClient(u, fmt.Sprintf("%s.%s.%s", db, rp, name))
+		if err != nil {
+			closeClients(clients)
+			return nil, err
 		}
 		clients = append(clients, c)
 	}
+	var writer SubscriberWriter
+	var base *BaseWriter
 	switch mode {
 	case "ALL":
-		return &AllWriter{BaseWriter: NewBaseWriter(db, rp, name, clients, s.Logger)}, nil
+		w := &AllWriter{BaseWriter: NewBaseWriter(db, rp, name, clients, s.Logger)}
+		writer, base = w, &w.BaseWriter
 	case "ANY":
-		return &RoundRobinWriter{BaseWriter: NewBaseWriter(db, rp, name, clients, s.Logger)}, nil
+		w := &RoundRobinWriter{BaseWriter: NewBaseWriter(db, rp, name, clients, s.Logger)}
+		w.failover = true
+		writer, base = w, &w.BaseWriter
+	default:
+		return nil, fmt.Errorf("unknown subscription mode %s", mode)
+	}
+	if s.config.QueueDir != "" {
+		if err := base.OpenQueues(s.config); err != nil {
+			for _, c := range clients {
+				statistics.SubscriberStat.Delete(db, rp, name, c.Destination())
+			}
+			return nil, err
+		}
+	}
+	return writer, nil
+}
+
+// newClient creates the client of a destination by the scheme:
+// http/https for the /write API, grpc/grpcs for the record-write service,
+// and file for the local rolling files
+func (s *SubscriberManager) newClient(u *url.URL, name string) (Client, error) {
+	timeout := time.Duration(s.config.HTTPTimeout)
+	switch u.Scheme {
+	case "http":
+		return NewHTTPClient(u, timeout), nil
+	case "https":
+		return NewHTTPSClient(u, timeout, s.config.InsecureSkipVerify, s.config.HttpsCertificate)
+	case "grpc":
+		return NewGRPCClient(u, timeout, nil)
+	case "grpcs":
+		tlsConfig, err := newTLSConfig(s.config.InsecureSkipVerify, s.config.HttpsCertificate)
+		if err != nil {
+			return nil, err
+		}
+		return NewGRPCClient(u, timeout, tlsConfig)
+	case "file":
+		return NewFileClient(u, name)
+	default:
+		return nil, fmt.Errorf("unknown subscription schema %s", u.Scheme)
+	}
+}
+
+func closeClients(clients []Client) {
+	for _, c := range clients {
+		_ = c.Close()
 	}
-	return nil, fmt.Errorf("unknown subscription mode %s", mode)
 }
 
 func (s *SubscriberManager) InitWriters() {
@@ -323,7 +623,7 @@ func (s *SubscriberManager) UpdateWriters() {
 					writers[position] = writers[i]
 					position++
 				} else {
-					writers[i].Stop()
+					writers[i].Drop()
 					s.Logger.Info("remove subscriber writer", zap.String("db", dbi.Name), zap.String("rp", rpi.Name), zap.String("sub", writers[i].Name()))
 				}
 			}
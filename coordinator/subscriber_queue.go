@@ -0,0 +1,542 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"go.uber.org/zap"
+)
+
+const (
+	queueSegmentSuffix = ".seg"
+	queueOffsetFile    = "offset"
+
+	// length(4) + crc32(4) + enqueue time(8)
+	queueRecordHeaderSize = 16
+	// delivered offset(8) + crc32(4)
+	queueOffsetSize = 12
+)
+
+var (
+	errQueueEmpty   = errors.New("subscriber queue is empty")
+	errQueueClosed  = errors.New("subscriber queue is closed")
+	errQueueCorrupt = errors.New("subscriber queue record is corrupted")
+
+	queueCrcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// queueSegment is a file of records, records are addressed by the offset
+// in the whole queue, so that a segment covers [start, start+size)
+type queueSegment struct {
+	start     int64
+	size      int64
+	lastWrite time.Time // enqueue time of the last record
+	path      string
+}
+
+func (s *queueSegment) end() int64 {
+	return s.start + s.size
+}
+
+// SubscriberQueue is a disk-backed FIFO queue of the write requests of one subscription destination.
+// Write requests are appended to segment files like a WAL, and the offset of the delivered data is
+// persisted, so the undelivered write requests are delivered again after a restart.
+// The files are synced when a segment is full, when Sync is called and when the queue is closed.
+// The oldest write requests are dropped if the queue exceeds the max size or the max age.
+type SubscriberQueue struct {
+	mu          sync.Mutex
+	dir         string
+	maxSize     int64
+	maxAge      time.Duration
+	segmentSize int64
+
+	segments []*queueSegment
+	writer   *os.File // the last segment
+	reader   *os.File
+	readSeg  *queueSegment
+	offset   *os.File
+	head     int64 // offset of the oldest undelivered record
+	closed   bool
+
+	// whether the last segment or the offset file is written after the last sync
+	writerDirty bool
+	offsetDirty bool
+
+	notify chan struct{}
+	stat   *statistics.SubscriberStats
+	logger *logger.Logger
+}
+
+func OpenSubscriberQueue(dir string, maxSize, segmentSize int64, maxAge time.Duration, stat *statistics.SubscriberStats, l *logger.Logger) (*SubscriberQueue, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	q := &SubscriberQueue{
+		dir:         dir,
+		maxSize:     maxSize,
+		maxAge:      maxAge,
+		segmentSize: segmentSize,
+		notify:      make(chan struct{}, 1),
+		stat:        stat,
+		logger:      l,
+	}
+	if err := q.open(); err != nil {
+		q.closeFiles()
+		return nil, err
+	}
+	return q, nil
+}
+
+func (q *SubscriberQueue) open() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, queueSegmentSuffix) {
+			continue
+		}
+		start, err := strconv.ParseInt(strings.TrimSuffix(name, queueSegmentSuffix), 16, 64)
+		if err != nil {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return err
+		}
+		q.segments = append(q.segments, &queueSegment{start: start, size: info.Size(), lastWrite: info.ModTime(), path: filepath.Join(q.dir, name)})
+	}
+	sort.Slice(q.segments, func(i, j int) bool {
+		return q.segments[i].start < q.segments[j].start
+	})
+
+	q.offset, err = os.OpenFile(filepath.Join(q.dir, queueOffsetFile), os.O_RDWR|os.O_CREATE, 0640)
+	if err != nil {
+		return err
+	}
+	q.head = q.loadOffset()
+
+	if len(q.segments) == 0 {
+		return q.createSegment(q.head)
+	}
+
+	// a record may be partially written when the process crashed, truncate it
+	last := q.segments[len(q.segments)-1]
+	size, err := q.validSize(last)
+	if err != nil {
+		return err
+	}
+	if size != last.size {
+		q.logger.Warn("truncate subscriber queue segment", zap.String("path", last.path), zap.Int64("size", last.size), zap.Int64("valid", size))
+		if err = os.Truncate(last.path, size); err != nil {
+			return err
+		}
+		last.size = size
+	}
+	q.writer, err = os.OpenFile(last.path, os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		return err
+	}
+
+	if q.head < q.segments[0].start {
+		q.head = q.segments[0].start
+	} else if q.head > last.end() {
+		q.head = last.end()
+	}
+	q.removeDelivered()
+	q.stat.SetLagBytes(q.tail() - q.head)
+	return nil
+}
+
+// loadOffset returns the persisted delivered offset, all the records are
+// delivered again if the offset is missing or corrupted
+func (q *SubscriberQueue) loadOffset() int64 {
+	buf := make([]byte, queueOffsetSize)
+	if _, err := q.offset.ReadAt(buf, 0); err != nil {
+		if err != io.EOF {
+			q.logger.Warn("failed to read subscriber queue offset", zap.String("dir", q.dir), zap.Error(err))
+		}
+		return 0
+	}
+	if crc32.Checksum(buf[:8], queueCrcTable) != binary.BigEndian.Uint32(buf[8:]) {
+		q.logger.Warn("subscriber queue offset is corrupted", zap.String("dir", q.dir))
+		return 0
+	}
+	return int64(binary.BigEndian.Uint64(buf[:8]))
+}
+
+func (q *SubscriberQueue) saveOffset() error {
+	var buf [queueOffsetSize]byte
+	binary.BigEndian.PutUint64(buf[:8], uint64(q.head))
+	binary.BigEndian.PutUint32(buf[8:], crc32.Checksum(buf[:8], queueCrcTable))
+	_, err := q.offset.WriteAt(buf[:], 0)
+	q.offsetDirty = true
+	return err
+}
+
+func (q *SubscriberQueue) createSegment(start int64) error {
+	path := filepath.Join(q.dir, fmt.Sprintf("%016x%s", start, queueSegmentSuffix))
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND|os.O_TRUNC, 0640)
+	if err != nil {
+		return err
+	}
+	if q.writer != nil {
+		// the full segment is never written again, persist it before switching to the new one
+		if err = q.writer.Sync(); err != nil {
+			q.logger.Warn("failed to sync subscriber queue segment", zap.String("dir", q.dir), zap.Error(err))
+		}
+		if err = q.writer.Close(); err != nil {
+			q.logger.Warn("failed to close subscriber queue segment", zap.String("dir", q.dir), zap.Error(err))
+		}
+	}
+	q.writer = f
+	q.writerDirty = false
+	q.segments = append(q.segments, &queueSegment{start: start, lastWrite: time.Now(), path: path})
+	return nil
+}
+
+func (q *SubscriberQueue) tail() int64 {
+	return q.segments[len(q.segments)-1].end()
+}
+
+// Notify returns a channel which receives a signal after a record is appended
+func (q *SubscriberQueue) Notify() <-chan struct{} {
+	return q.notify
+}
+
+// Append appends a write request to the queue
+func (q *SubscriberQueue) Append(data []byte) error {
+	now := time.Now()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return errQueueClosed
+	}
+
+	size := int64(queueRecordHeaderSize + len(data))
+	last := q.segments[len(q.segments)-1]
+	if last.size > 0 && last.size+size > q.segmentSize {
+		if err := q.createSegment(last.end()); err != nil {
+			q.stat.AddDropped(1)
+			return err
+		}
+		last = q.segments[len(q.segments)-1]
+	}
+
+	buf := make([]byte, size)
+	binary.BigEndian.PutUint32(buf[0:4], uint32(len(data)))
+	binary.BigEndian.PutUint64(buf[8:16], uint64(now.UnixNano()))
+	copy(buf[queueRecordHeaderSize:], data)
+	binary.BigEndian.PutUint32(buf[4:8], crc32.Checksum(buf[8:], queueCrcTable))
+	if _, err := q.writer.Write(buf); err != nil {
+		// drop the partially written record, so that the following records can be read
+		if e := q.writer.Truncate(last.size); e != nil {
+			q.logger.Error("failed to truncate subscriber queue segment", zap.String("path", last.path), zap.Error(e))
+		}
+		q.stat.AddDropped(1)
+		return err
+	}
+
+	if q.head == last.end() {
+		q.stat.SetHeadTime(now.UnixNano())
+	}
+	last.size += size
+	last.lastWrite = now
+	q.writerDirty = true
+	q.stat.AddEnqueued(1)
+
+	for len(q.segments) > 1 && q.tail()-q.segments[0].start > q.maxSize {
+		q.dropOldest()
+	}
+	q.stat.SetLagBytes(q.tail() - q.head)
+
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Next returns the oldest undelivered write request and the offset after it,
+// the write request is not removed from the queue until Advance is called.
+// The write requests older than the max age are dropped.
+func (q *SubscriberQueue) Next() ([]byte, int64, error) {
+	now := time.Now()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return nil, 0, errQueueClosed
+	}
+
+	for len(q.segments) > 1 && now.Sub(q.segments[0].lastWrite) > q.maxAge {
+		q.dropOldest()
+	}
+
+	for q.head < q.tail() {
+		seg := q.segmentOf(q.head)
+		data, t, err := q.readRecord(seg, q.head-seg.start)
+		if err == errQueueCorrupt || err == io.ErrUnexpectedEOF {
+			// the rest of the segment can not be located, skip it
+			q.logger.Error("subscriber queue segment is corrupted", zap.String("path", seg.path), zap.Int64("offset", q.head))
+			q.stat.AddDropped(1)
+			q.setHead(seg.end())
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		next := q.head + int64(queueRecordHeaderSize+len(data))
+		if now.Sub(time.Unix(0, t)) > q.maxAge {
+			q.stat.AddDropped(1)
+			q.setHead(next)
+			continue
+		}
+		q.stat.SetHeadTime(t)
+		q.stat.SetLagBytes(q.tail() - q.head)
+		return data, next, nil
+	}
+
+	q.stat.SetHeadTime(0)
+	q.stat.SetLagBytes(0)
+	return nil, 0, errQueueEmpty
+}
+
+// Advance marks the write requests before the offset as delivered
+func (q *SubscriberQueue) Advance(offset int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return errQueueClosed
+	}
+	if offset <= q.head {
+		return nil
+	}
+	q.stat.AddDelivered(1)
+	q.setHead(offset)
+	return nil
+}
+
+// Skip removes the write requests before the offset without delivering them
+func (q *SubscriberQueue) Skip(offset int64) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return errQueueClosed
+	}
+	if offset > q.head {
+		q.setHead(offset)
+	}
+	return nil
+}
+
+// Sync persists the appended write requests and the delivered offset
+func (q *SubscriberQueue) Sync() error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return nil
+	}
+	return q.sync()
+}
+
+func (q *SubscriberQueue) sync() error {
+	if q.writerDirty {
+		if err := q.writer.Sync(); err != nil {
+			return err
+		}
+		q.writerDirty = false
+	}
+	if q.offsetDirty {
+		if err := q.offset.Sync(); err != nil {
+			return err
+		}
+		q.offsetDirty = false
+	}
+	return nil
+}
+
+func (q *SubscriberQueue) setHead(offset int64) {
+	q.head = offset
+	q.removeDelivered()
+	q.stat.SetLagBytes(q.tail() - q.head)
+	if err := q.saveOffset(); err != nil {
+		q.logger.Error("failed to save subscriber queue offset", zap.String("dir", q.dir), zap.Error(err))
+	}
+}
+
+func (q *SubscriberQueue) segmentOf(offset int64) *queueSegment {
+	i := sort.Search(len(q.segments), func(i int) bool {
+		return q.segments[i].end() > offset
+	})
+	return q.segments[i]
+}
+
+// removeDelivered removes the segments in which all the records are delivered
+func (q *SubscriberQueue) removeDelivered() {
+	for len(q.segments) > 1 && q.segments[0].end() <= q.head {
+		q.removeOldest()
+	}
+}
+
+// dropOldest removes the oldest segment and drops its undelivered records
+func (q *SubscriberQueue) dropOldest() {
+	seg := q.segments[0]
+	if q.head < seg.end() {
+		dropped := q.countRecords(seg, q.head)
+		q.stat.AddDropped(dropped)
+		q.logger.Warn("drop undelivered subscriber write requests", zap.String("dir", q.dir), zap.Int64("count", dropped))
+		q.head = seg.end()
+		if err := q.saveOffset(); err != nil {
+			q.logger.Error("failed to save subscriber queue offset", zap.String("dir", q.dir), zap.Error(err))
+		}
+	}
+	q.removeOldest()
+}
+
+func (q *SubscriberQueue) removeOldest() {
+	seg := q.segments[0]
+	if q.readSeg == seg {
+		q.closeReader()
+	}
+	if err := os.Remove(seg.path); err != nil {
+		q.logger.Error("failed to remove subscriber queue segment", zap.String("path", seg.path), zap.Error(err))
+	}
+	q.segments = q.segments[1:]
+}
+
+func (q *SubscriberQueue) countRecords(seg *queueSegment, from int64) int64 {
+	var n int64
+	pos := max(from, seg.start) - seg.start
+	for pos < seg.size {
+		data, _, err := q.readRecord(seg, pos)
+		if err != nil {
+			return n + 1
+		}
+		pos += int64(queueRecordHeaderSize + len(data))
+		n++
+	}
+	return n
+}
+
+// validSize returns the size of the complete records at the beginning of the segment
+func (q *SubscriberQueue) validSize(seg *queueSegment) (int64, error) {
+	var pos int64
+	for pos < seg.size {
+		data, _, err := q.readRecord(seg, pos)
+		if err == errQueueCorrupt || err == io.ErrUnexpectedEOF || err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		pos += int64(queueRecordHeaderSize + len(data))
+	}
+	return pos, nil
+}
+
+func (q *SubscriberQueue) readRecord(seg *queueSegment, pos int64) ([]byte, int64, error) {
+	if q.readSeg != seg {
+		q.closeReader()
+		f, err := os.Open(seg.path)
+		if err != nil {
+			return nil, 0, err
+		}
+		q.reader, q.readSeg = f, seg
+	}
+
+	var header [queueRecordHeaderSize]byte
+	if _, err := q.reader.ReadAt(header[:], pos); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+	size := int64(binary.BigEndian.Uint32(header[0:4]))
+	if pos+queueRecordHeaderSize+size > seg.size {
+		return nil, 0, errQueueCorrupt
+	}
+	buf := make([]byte, 8+size)
+	copy(buf, header[8:])
+	if _, err := q.reader.ReadAt(buf[8:], pos+queueRecordHeaderSize); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, 0, err
+	}
+	if crc32.Checksum(buf, queueCrcTable) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, 0, errQueueCorrupt
+	}
+	return buf[8:], int64(binary.BigEndian.Uint64(header[8:16])), nil
+}
+
+func (q *SubscriberQueue) closeReader() {
+	if q.reader != nil {
+		_ = q.reader.Close()
+		q.reader, q.readSeg = nil, nil
+	}
+}
+
+func (q *SubscriberQueue) closeFiles() {
+	q.closeReader()
+	if q.writer != nil {
+		_ = q.writer.Close()
+		q.writer = nil
+	}
+	if q.offset != nil {
+		_ = q.offset.Close()
+		q.offset = nil
+	}
+}
+
+// Close closes the queue, the undelivered write requests are kept on disk
+func (q *SubscriberQueue) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	if err := q.sync(); err != nil {
+		q.logger.Error("failed to sync subscriber queue", zap.String("dir", q.dir), zap.Error(err))
+	}
+	q.closed = true
+	q.closeFiles()
+}
+
+// Remove closes the queue and removes all its files
+func (q *SubscriberQueue) Remove() error {
+	q.Close()
+	return os.RemoveAll(q.dir)
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestQueue(t *testing.T, dir string, maxSize, segmentSize int64, maxAge time.Duration) (*SubscriberQueue, *statistics.SubscriberStats) {
+	stat := &statistics.SubscriberStats{}
+	q, err := OpenSubscriberQueue(dir, maxSize, segmentSize, maxAge, stat, logger.NewLogger(errno.ModuleCoordinator))
+	require.NoError(t, err)
+	return q, stat
+}
+
+func deliverAll(t *testing.T, q *SubscriberQueue) []string {
+	var lines []string
+	for {
+		data, next, err := q.Next()
+		if err == errQueueEmpty {
+			return lines
+		}
+		require.NoError(t, err)
+		lines = append(lines, string(data))
+		require.NoError(t, q.Advance(next))
+	}
+}
+
+func TestSubscriberQueue(t *testing.T) {
+	dir := t.TempDir()
+	q, stat := openTestQueue(t, dir, 1024, 64, time.Hour)
+	for i := 0; i < 5; i++ {
+		require.NoError(t, q.Append([]byte(fmt.Sprintf("cpu value=%d", i))))
+	}
+	require.Equal(t, int64(5), stat.Enqueued)
+	require.Equal(t, int64(5*(queueRecordHeaderSize+11)), stat.LagBytes)
+
+	// the write request is delivered again if it is not advanced
+	data, _, err := q.Next()
+	require.NoError(t, err)
+	require.Equal(t, "cpu value=0", string(data))
+	data, next, err := q.Next()
+	require.NoError(t, err)
+	require.Equal(t, "cpu value=0", string(data))
+	require.NoError(t, q.Advance(next))
+	q.Close()
+
+	_, _, err = q.Next()
+	require.Equal(t, errQueueClosed, err)
+	require.Equal(t, errQueueClosed, q.Append([]byte("cpu value=5")))
+
+	// the delivered offset survives restarts
+	q, stat = openTestQueue(t, dir, 1024, 64, time.Hour)
+	require.Equal(t, []string{"cpu value=1", "cpu value=2", "cpu value=3", "cpu value=4"}, deliverAll(t, q))
+	require.Equal(t, int64(4), stat.Delivered)
+	require.Equal(t, int64(0), stat.LagBytes)
+	require.Equal(t, int64(0), stat.HeadTime)
+
+	// delivered segments are removed
+	segments, err := filepath.Glob(filepath.Join(dir, "*"+queueSegmentSuffix))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(segments))
+
+	require.NoError(t, q.Append([]byte("cpu value=5")))
+	require.Equal(t, []string{"cpu value=5"}, deliverAll(t, q))
+	require.NoError(t, q.Remove())
+	_, err = os.Stat(dir)
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestSubscriberQueue_TornRecord(t *testing.T) {
+	dir := t.TempDir()
+	q, _ := openTestQueue(t, dir, 1024, 1024, time.Hour)
+	require.NoError(t, q.Append([]byte("cpu value=0")))
+	require.NoError(t, q.Append([]byte("cpu value=1")))
+	path := q.segments[0].path
+	q.Close()
+
+	// simulate a crash while appending a record
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0640)
+	require.NoError(t, err)
+	_, err = f.Write([]byte{0, 0, 0, 11, 1, 2})
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	q, _ = openTestQueue(t, dir, 1024, 1024, time.Hour)
+	require.NoError(t, q.Append([]byte("cpu value=2")))
+	require.Equal(t, []string{"cpu value=0", "cpu value=1", "cpu value=2"}, deliverAll(t, q))
+	q.Close()
+}
+
+func TestSubscriberQueue_Bound(t *testing.T) {
+	// each record is 27 bytes, and each segment holds 2 records
+	q, stat := openTestQueue(t, t.TempDir(), 120, 60, time.Hour)
+	for i := 0; i < 6; i++ {
+		require.NoError(t, q.Append([]byte(fmt.Sprintf("cpu value=%d", i))))
+	}
+	require.Equal(t, int64(2), stat.Dropped)
+	require.Equal(t, []string{"cpu value=2", "cpu value=3", "cpu value=4", "cpu value=5"}, deliverAll(t, q))
+	q.Close()
+
+	q, stat = openTestQueue(t, t.TempDir(), 1024, 60, 50*time.Millisecond)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, q.Append([]byte(fmt.Sprintf("cpu value=%d", i))))
+	}
+	time.Sleep(100 * time.Millisecond)
+	require.NoError(t, q.Append([]byte("cpu value=3")))
+	require.Equal(t, []string{"cpu value=3"}, deliverAll(t, q))
+	require.Equal(t, int64(3), stat.Dropped)
+	q.Close()
+}
+
+type MockFailedSubscriberClient struct {
+	dest     string
+	failures int32
+	lines    chan string
+}
+
+func (c *MockFailedSubscriberClient) Send(db, rp string, lineProtocol []byte) error {
+	if strings.Contains(string(lineProtocol), "invalid") {
+		return &nonRetryableError{err: errors.New("mock bad request")}
+	}
+	if atomic.AddInt32(&c.failures, -1) >= 0 {
+		return errors.New("mock send error")
+	}
+	c.lines <- string(lineProtocol)
+	return nil
+}
+
+func (c *MockFailedSubscriberClient) Destination() string {
+	if c.dest != "" {
+		return c.dest
+	}
+	return "mock://127.0.0.1:8086"
+}
+
+func (c *MockFailedSubscriberClient) Close() error {
+	return nil
+}
+
+func TestBaseWriter_Retry(t *testing.T) {
+	conf := config.NewSubscriber()
+	conf.QueueDir = t.TempDir()
+	conf.RetryInterval = toml.Duration(time.Millisecond)
+	conf.MaxRetryInterval = toml.Duration(4 * time.Millisecond)
+
+	client := &MockFailedSubscriberClient{failures: 3, lines: make(chan string, 2)}
+	w := &AllWriter{NewBaseWriter("db0", "rp0", "sub0", []Client{client}, logger.NewLogger(errno.ModuleCoordinator))}
+	require.NoError(t, w.OpenQueues(conf))
+	w.Start(1, 2)
+	w.Write([]byte("cpu value=0"))
+	w.Write([]byte("cpu value=1"))
+
+	for i := 0; i < 2; i++ {
+		select {
+		case line := <-client.lines:
+			require.Equal(t, fmt.Sprintf("cpu value=%d", i), line)
+		case <-time.After(5 * time.Second):
+			t.Fatal("write request is not delivered")
+		}
+	}
+	stat := statistics.SubscriberStat.GetStats("db0", "rp0", "sub0", client.Destination())
+	require.Equal(t, int64(3), atomic.LoadInt64(&stat.Retries))
+	require.Eventually(t, func() bool {
+		return atomic.LoadInt64(&stat.Delivered) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	w.Drop()
+	_, err := os.Stat(filepath.Join(conf.QueueDir, "db0"))
+	require.NoError(t, err)
+	entries, err := os.ReadDir(filepath.Join(conf.QueueDir, "db0", "rp0", "sub0"))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestSubscriberQueue_SkipAndSync(t *testing.T) {
+	dir := t.TempDir()
+	q, stat := openTestQueue(t, dir, 1024, 64, time.Hour)
+	require.NoError(t, q.Append([]byte("cpu value=0")))
+	require.NoError(t, q.Append([]byte("cpu value=1")))
+	_, next, err := q.Next()
+	require.NoError(t, err)
+	require.NoError(t, q.Skip(next))
+	require.NoError(t, q.Sync())
+	require.False(t, q.writerDirty)
+	require.False(t, q.offsetDirty)
+	q.Close()
+	require.Equal(t, int64(0), stat.Delivered)
+
+	q, _ = openTestQueue(t, dir, 1024, 64, time.Hour)
+	require.Equal(t, []string{"cpu value=1"}, deliverAll(t, q))
+	q.Close()
+}
+
+func TestBaseWriter_DropRejected(t *testing.T) {
+	conf := config.NewSubscriber()
+	conf.QueueDir = t.TempDir()
+	conf.RetryInterval = toml.Duration(time.Millisecond)
+
+	client := &MockFailedSubscriberClient{dest: "mock://127.0.0.1:8087", lines: make(chan string, 1)}
+	w := &AllWriter{NewBaseWriter("db0", "rp0", "sub1", []Client{client}, logger.NewLogger(errno.ModuleCoordinator))}
+	require.NoError(t, w.OpenQueues(conf))
+	w.Start(1, 2)
+	w.Write([]byte("cpu invalid"))
+	w.Write([]byte("cpu value=1"))
+
+	select {
+	case line := <-client.lines:
+		require.Equal(t, "cpu value=1", line)
+	case <-time.After(5 * time.Second):
+		t.Fatal("write request is not delivered")
+	}
+	stat := statistics.SubscriberStat.GetStats("db0", "rp0", "sub1", client.Destination())
+	require.Equal(t, int64(0), atomic.LoadInt64(&stat.Retries))
+	require.Equal(t, int64(1), atomic.LoadInt64(&stat.Dropped))
+	w.Drop()
+}
+
+func TestRoundRobinWriter_Failover(t *testing.T) {
+	conf := config.NewSubscriber()
+	conf.QueueDir = t.TempDir()
+	conf.RetryInterval = toml.Duration(time.Hour)
+
+	down := &MockFailedSubscriberClient{dest: "mock://127.0.0.1:8088", failures: math.MaxInt32, lines: make(chan string, 4)}
+	up := &MockFailedSubscriberClient{dest: "mock://127.0.0.1:8089", lines: make(chan string, 4)}
+	w := &RoundRobinWriter{BaseWriter: NewBaseWriter("db0", "rp0", "sub2", []Client{up, down}, logger.NewLogger(errno.ModuleCoordinator))}
+	w.failover = true
+	require.NoError(t, w.OpenQueues(conf))
+	w.Start(1, 4)
+
+	for i := 0; i < 4; i++ {
+		w.Write([]byte(fmt.Sprintf("cpu value=%d", i)))
+	}
+	got := make(map[string]bool)
+	for i := 0; i < 4; i++ {
+		select {
+		case line := <-up.lines:
+			got[line] = true
+		case <-time.After(5 * time.Second):
+			t.Fatal("write request is not failed over")
+		}
+	}
+	require.Len(t, got, 4)
+	require.False(t, w.available(1))
+	w.Drop()
+}
@@ -0,0 +1,442 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	pb "github.com/openGemini/opengemini-client-go/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	FileFormatLineProtocol = "line"
+	FileFormatNDJSON       = "ndjson"
+
+	DefaultFileRotateSize     = 64 * 1024 * 1024
+	DefaultFileRotateInterval = time.Hour
+
+	fileSinkTmpSuffix = ".tmp"
+)
+
+// parseLineProtocol parses the line protocol of a write request,
+// the time of the points without timestamp is set to now
+func parseLineProtocol(lineProtocol []byte) ([]influx.Row, error) {
+	rs := &influx.PointRows{}
+	if err := rs.Unmarshal(string(lineProtocol), false); err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixNano()
+	for i := range rs.Rows {
+		if rs.Rows[i].Timestamp == influx.NoTimestamp {
+			rs.Rows[i].Timestamp = now
+		}
+	}
+	return rs.Rows, nil
+}
+
+// GRPCClient writes to the record-write service of another cluster,
+// which speaks the WriteService protocol of opengemini-client-go
+type GRPCClient struct {
+	conn     *grpc.ClientConn
+	client   pb.WriteServiceClient
+	url      *url.URL
+	username string
+	password string
+	timeout  time.Duration
+}
+
+func NewGRPCClient(u *url.URL, timeout time.Duration, tlsConfig *tls.Config) (*GRPCClient, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(u.Host, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	c := &GRPCClient{conn: conn, client: pb.NewWriteServiceClient(conn), url: u, timeout: timeout}
+	if u.User != nil {
+		c.username = u.User.Username()
+		c.password, _ = u.User.Password()
+	}
+	return c, nil
+}
+
+func (c *GRPCClient) Send(db, rp string, lineProtocol []byte) error {
+	rows, err := parseLineProtocol(lineProtocol)
+	if err != nil {
+		return err
+	}
+	records, err := rowsToRecords(rows)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+	resp, err := c.client.Write(ctx, &pb.WriteRequest{
+		Database:        db,
+		RetentionPolicy: rp,
+		Username:        c.username,
+		Password:        c.password,
+		Records:         records,
+	})
+	if err != nil {
+		return err
+	}
+	// the rows failed in a partial write are invalid, retrying them does not help
+	if resp.Code == pb.ResponseCode_Failed {
+		return fmt.Errorf("failed to write to %s", c.Destination())
+	}
+	return nil
+}
+
+func (c *GRPCClient) Destination() string {
+	return c.url.Redacted()
+}
+
+func (c *GRPCClient) Close() error {
+	return c.conn.Close()
+}
+
+// rowsToRecords converts the rows to a record per measurement, tags are stored as tag columns
+func rowsToRecords(rows []influx.Row) ([]*pb.Record, error) {
+	var names []string
+	groups := make(map[string][]*influx.Row)
+	for i := range rows {
+		name := rows[i].Name
+		if _, ok := groups[name]; !ok {
+			names = append(names, name)
+		}
+		groups[name] = append(groups[name], &rows[i])
+	}
+
+	records := make([]*pb.Record, 0, len(names))
+	for _, name := range names {
+		rec, err := buildRecord(groups[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid points of measurement %s: %v", name, err)
+		}
+		pr := &pb.Record{Measurement: name, Block: rec.Marshal(nil), MinTime: math.MaxInt64, MaxTime: math.MinInt64}
+		for _, row := range groups[name] {
+			pr.MinTime = min(pr.MinTime, row.Timestamp)
+			pr.MaxTime = max(pr.MaxTime, row.Timestamp)
+		}
+		records = append(records, pr)
+	}
+	return records, nil
+}
+
+func buildRecord(rows []*influx.Row) (*record.Record, error) {
+	types := make(map[string]int)
+	for _, row := range rows {
+		for _, tag := range row.Tags {
+			if old, ok := types[tag.Key]; ok && old != influx.Field_Type_Tag {
+				return nil, fmt.Errorf("conflict type of %s", tag.Key)
+			}
+			types[tag.Key] = influx.Field_Type_Tag
+		}
+		for _, field := range row.Fields {
+			typ := int(field.Type)
+			if old, ok := types[field.Key]; ok && old != typ {
+				return nil, fmt.Errorf("conflict type of %s", field.Key)
+			}
+			types[field.Key] = typ
+		}
+	}
+
+	rec := &record.Record{}
+	for name, typ := range types {
+		rec.Schema = append(rec.Schema, record.Field{Name: name, Type: typ})
+	}
+	sort.Sort(rec.Schema)
+	rec.Schema = append(rec.Schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
+	rec.ColVals = make([]record.ColVal, len(rec.Schema))
+
+	columns := make(map[string]int, len(types))
+	for i := range rec.Schema[:len(types)] {
+		columns[rec.Schema[i].Name] = i
+	}
+	filled := make([]bool, len(types))
+	for _, row := range rows {
+		clear(filled)
+		for _, tag := range row.Tags {
+			i := columns[tag.Key]
+			if filled[i] {
+				return nil, fmt.Errorf("duplicate tag %s", tag.Key)
+			}
+			rec.ColVals[i].AppendString(tag.Value)
+			filled[i] = true
+		}
+		for j := range row.Fields {
+			field := &row.Fields[j]
+			i := columns[field.Key]
+			if filled[i] {
+				return nil, fmt.Errorf("duplicate field %s", field.Key)
+			}
+			col := &rec.ColVals[i]
+			switch field.Type {
+			case influx.Field_Type_Int:
+				col.AppendInteger(int64(field.NumValue))
+			case influx.Field_Type_UInt:
+				col.AppendUnsigned(field.UintValue())
+			case influx.Field_Type_Float:
+				col.AppendFloat(field.NumValue)
+			case influx.Field_Type_Boolean:
+				col.AppendBoolean(field.NumValue != 0)
+			case influx.Field_Type_String:
+				col.AppendString(field.StrValue)
+			default:
+				return nil, errors.New("unsupported data type")
+			}
+			filled[i] = true
+		}
+		for i, ok := range filled {
+			if !ok {
+				appendNull(&rec.ColVals[i], rec.Schema[i].Type)
+			}
+		}
+		rec.ColVals[len(types)].AppendInteger(row.Timestamp)
+	}
+	return rec, nil
+}
+
+func appendNull(col *record.ColVal, typ int) {
+	switch typ {
+	case influx.Field_Type_Int:
+		col.AppendIntegerNull()
+	case influx.Field_Type_UInt:
+		col.AppendUnsignedNull()
+	case influx.Field_Type_Float:
+		col.AppendFloatNull()
+	case influx.Field_Type_Boolean:
+		col.AppendBooleanNull()
+	default:
+		col.AppendStringNull()
+	}
+}
+
+// FileClient writes the write requests to local files for batch pickup.
+// The file being written has a ".tmp" suffix, and it is renamed when it is rotated
+// by the size or the interval, so only the complete files should be picked up.
+//
+// The destination is like file:///data/subscription?format=ndjson&rotate-size=64m&rotate-interval=1h,
+// the format is "line" (line protocol) by default.
+type FileClient struct {
+	mu             sync.Mutex
+	url            *url.URL
+	dir            string
+	prefix         string
+	format         string
+	rotateSize     int64
+	rotateInterval time.Duration
+
+	file    *os.File
+	size    int64
+	created time.Time
+	closed  bool
+}
+
+func NewFileClient(u *url.URL, prefix string) (*FileClient, error) {
+	c := &FileClient{
+		url:            u,
+		dir:            u.Path,
+		prefix:         prefix,
+		format:         FileFormatLineProtocol,
+		rotateSize:     DefaultFileRotateSize,
+		rotateInterval: DefaultFileRotateInterval,
+	}
+	if c.dir == "" {
+		return nil, fmt.Errorf("missing directory in %s", u.String())
+	}
+
+	query := u.Query()
+	if format := query.Get("format"); format != "" {
+		if format != FileFormatLineProtocol && format != FileFormatNDJSON {
+			return nil, fmt.Errorf("unknown file format %s", format)
+		}
+		c.format = format
+	}
+	if s := query.Get("rotate-size"); s != "" {
+		var size toml.Size
+		if err := size.UnmarshalText([]byte(s)); err != nil || size == 0 {
+			return nil, fmt.Errorf("invalid rotate-size %s", s)
+		}
+		c.rotateSize = int64(size)
+	}
+	if s := query.Get("rotate-interval"); s != "" {
+		d, err := time.ParseDuration(s)
+		if err != nil || d <= 0 {
+			return nil, fmt.Errorf("invalid rotate-interval %s", s)
+		}
+		c.rotateInterval = d
+	}
+
+	if err := os.MkdirAll(c.dir, 0750); err != nil {
+		return nil, err
+	}
+	// the files left by the last run are complete
+	files, err := filepath.Glob(filepath.Join(c.dir, c.prefix+"-*"+fileSinkTmpSuffix))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if err = os.Rename(f, strings.TrimSuffix(f, fileSinkTmpSuffix)); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func (c *FileClient) Send(db, rp string, lineProtocol []byte) error {
+	data := lineProtocol
+	if c.format == FileFormatNDJSON {
+		var err error
+		if data, err = lineProtocolToNDJSON(db, rp, lineProtocol); err != nil {
+			return err
+		}
+	} else if len(data) > 0 && data[len(data)-1] != '\n' {
+		data = append(data[:len(data):len(data)], '\n')
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return errors.New("file client is closed")
+	}
+	if c.file != nil && (c.size >= c.rotateSize || time.Since(c.created) >= c.rotateInterval) {
+		if err := c.rotate(); err != nil {
+			return err
+		}
+	}
+	if c.file == nil {
+		c.created = time.Now()
+		name := fmt.Sprintf("%s-%d.%s%s", c.prefix, c.created.UnixNano(), c.extension(), fileSinkTmpSuffix)
+		f, err := os.OpenFile(filepath.Join(c.dir, name), os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0640)
+		if err != nil {
+			return err
+		}
+		c.file, c.size = f, 0
+	}
+
+	n, err := c.file.Write(data)
+	c.size += int64(n)
+	return err
+}
+
+func (c *FileClient) extension() string {
+	if c.format == FileFormatNDJSON {
+		return "ndjson"
+	}
+	return "lp"
+}
+
+// rotate completes the current file
+func (c *FileClient) rotate() error {
+	name := c.file.Name()
+	err := c.file.Close()
+	c.file = nil
+	if err != nil {
+		return err
+	}
+	return os.Rename(name, strings.TrimSuffix(name, fileSinkTmpSuffix))
+}
+
+func (c *FileClient) Destination() string {
+	return c.url.String()
+}
+
+func (c *FileClient) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	if c.file == nil {
+		return nil
+	}
+	return c.rotate()
+}
+
+type ndjsonPoint struct {
+	Database        string                 `json:"database"`
+	RetentionPolicy string                 `json:"retention_policy"`
+	Measurement     string                 `json:"measurement"`
+	Tags            map[string]string      `json:"tags,omitempty"`
+	Fields          map[string]interface{} `json:"fields"`
+	Timestamp       int64                  `json:"timestamp"`
+}
+
+func lineProtocolToNDJSON(db, rp string, lineProtocol []byte) ([]byte, error) {
+	rows, err := parseLineProtocol(lineProtocol)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf []byte
+	for i := range rows {
+		row := &rows[i]
+		p := ndjsonPoint{
+			Database:        db,
+			RetentionPolicy: rp,
+			Measurement:     row.Name,
+			Fields:          make(map[string]interface{}, len(row.Fields)),
+			Timestamp:       row.Timestamp,
+		}
+		if len(row.Tags) > 0 {
+			p.Tags = make(map[string]string, len(row.Tags))
+			for _, tag := range row.Tags {
+				p.Tags[tag.Key] = tag.Value
+			}
+		}
+		for _, field := range row.Fields {
+			switch field.Type {
+			case influx.Field_Type_Int:
+				p.Fields[field.Key] = int64(field.NumValue)
+			case influx.Field_Type_UInt:
+				p.Fields[field.Key] = field.UintValue()
+			case influx.Field_Type_Boolean:
+				p.Fields[field.Key] = field.NumValue != 0
+			case influx.Field_Type_String:
+				p.Fields[field.Key] = field.StrValue
+			default:
+				p.Fields[field.Key] = field.NumValue
+			}
+		}
+		line, err := json.Marshal(&p)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, line...)
+		buf = append(buf, '\n')
+	}
+	return buf, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coordinator
+
+import (
+	"context"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	pb "github.com/openGemini/opengemini-client-go/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+type MockWriteService struct {
+	pb.UnimplementedWriteServiceServer
+	requests chan *pb.WriteRequest
+	code     pb.ResponseCode
+}
+
+func (s *MockWriteService) Write(_ context.Context, req *pb.WriteRequest) (*pb.WriteResponse, error) {
+	s.requests <- req
+	return &pb.WriteResponse{Code: s.code}, nil
+}
+
+func startMockWriteService(t *testing.T, code pb.ResponseCode) (*MockWriteService, string) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	service := &MockWriteService{requests: make(chan *pb.WriteRequest, 1), code: code}
+	pb.RegisterWriteServiceServer(server, service)
+	go func() {
+		_ = server.Serve(ln)
+	}()
+	t.Cleanup(server.Stop)
+	return service, ln.Addr().String()
+}
+
+func TestGRPCClient(t *testing.T) {
+	service, addr := startMockWriteService(t, pb.ResponseCode_Success)
+	u, err := url.Parse("grpc://admin:pass@" + addr)
+	require.NoError(t, err)
+	c, err := NewGRPCClient(u, time.Second, nil)
+	require.NoError(t, err)
+	defer c.Close()
+	require.Equal(t, "grpc://admin:xxxxx@"+addr, c.Destination())
+
+	lines := "cpu,host=h1 value=1.5,count=2i 1000\n" +
+		"mem,host=h1 used=10i 1000\n" +
+		"cpu,host=h2,region=r1 value=2.5,ok=true 2000\n"
+	require.NoError(t, c.Send("db0", "rp0", []byte(lines)))
+
+	req := <-service.requests
+	require.Equal(t, "db0", req.Database)
+	require.Equal(t, "rp0", req.RetentionPolicy)
+	require.Equal(t, "admin", req.Username)
+	require.Equal(t, "pass", req.Password)
+	require.Equal(t, 2, len(req.Records))
+	require.Equal(t, "cpu", req.Records[0].Measurement)
+	require.Equal(t, int64(1000), req.Records[0].MinTime)
+	require.Equal(t, int64(2000), req.Records[0].MaxTime)
+	require.Equal(t, "mem", req.Records[1].Measurement)
+
+	rec := &record.Record{}
+	rec.Unmarshal(req.Records[0].Block)
+	record.CheckRecord(rec)
+	var names []string
+	for _, f := range rec.Schema {
+		names = append(names, f.Name)
+	}
+	require.Equal(t, []string{"count", "host", "ok", "region", "value", "time"}, names)
+	require.Equal(t, influx.Field_Type_Tag, rec.Schema[1].Type)
+	require.Equal(t, 2, rec.RowNums())
+	host, _ := rec.ColVals[1].StringValue(1)
+	require.Equal(t, "h2", string(host))
+	_, isNil := rec.ColVals[3].StringValue(0)
+	require.True(t, isNil)
+	require.Equal(t, []int64{1000, 2000}, rec.Times())
+
+	require.Error(t, c.Send("db0", "rp0", []byte("cpu value=1,value=2i 1000")))
+}
+
+func TestGRPCClient_Failed(t *testing.T) {
+	_, addr := startMockWriteService(t, pb.ResponseCode_Failed)
+	u, err := url.Parse("grpc://" + addr)
+	require.NoError(t, err)
+	c, err := NewGRPCClient(u, time.Second, nil)
+	require.NoError(t, err)
+	defer c.Close()
+	require.EqualError(t, c.Send("db0", "rp0", []byte("cpu value=1 1000")), "failed to write to grpc://"+addr)
+}
+
+func readSinkFiles(t *testing.T, dir string, pattern string) []string {
+	files, err := filepath.Glob(filepath.Join(dir, pattern))
+	require.NoError(t, err)
+	sort.Strings(files)
+	contents := make([]string, 0, len(files))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		require.NoError(t, err)
+		contents = append(contents, string(data))
+	}
+	return contents
+}
+
+func TestFileClient(t *testing.T) {
+	dir := t.TempDir()
+	u, err := url.Parse("file://" + dir + "?rotate-size=40")
+	require.NoError(t, err)
+	c, err := NewFileClient(u, "db0.rp0.sub0")
+	require.NoError(t, err)
+
+	require.NoError(t, c.Send("db0", "rp0", []byte("cpu,host=h1 value=1 1000")))
+	require.NoError(t, c.Send("db0", "rp0", []byte("cpu,host=h1 value=2 2000\n")))
+	require.NoError(t, c.Send("db0", "rp0", []byte("cpu,host=h1 value=3 3000")))
+	require.Equal(t, []string{"cpu,host=h1 value=1 1000\ncpu,host=h1 value=2 2000\n"}, readSinkFiles(t, dir, "db0.rp0.sub0-*.lp"))
+	require.Equal(t, []string{"cpu,host=h1 value=3 3000\n"}, readSinkFiles(t, dir, "*.tmp"))
+	require.NoError(t, c.Close())
+	require.Error(t, c.Send("db0", "rp0", []byte("cpu value=4 4000")))
+	require.Equal(t, 2, len(readSinkFiles(t, dir, "*.lp")))
+	require.Empty(t, readSinkFiles(t, dir, "*.tmp"))
+
+	u, err = url.Parse("file://" + dir + "?format=ndjson")
+	require.NoError(t, err)
+	c, err = NewFileClient(u, "db0.rp0.sub1")
+	require.NoError(t, err)
+	require.NoError(t, c.Send("db0", "rp0", []byte("cpu,host=h1 value=1.5,count=2i,ok=true,msg=\"hi\" 1000\nmem used=3i 2000")))
+	require.NoError(t, c.Close())
+	require.Equal(t, []string{
+		`{"database":"db0","retention_policy":"rp0","measurement":"cpu","tags":{"host":"h1"},"fields":{"count":2,"msg":"hi","ok":true,"value":1.5},"timestamp":1000}` + "\n" +
+			`{"database":"db0","retention_policy":"rp0","measurement":"mem","fields":{"used":3},"timestamp":2000}` + "\n",
+	}, readSinkFiles(t, dir, "db0.rp0.sub1-*.ndjson"))
+
+	for _, dest := range []string{"file://" + dir + "?format=csv", "file://" + dir + "?rotate-size=0", "file://" + dir + "?rotate-interval=1x", "file://"} {
+		u, err = url.Parse(dest)
+		require.NoError(t, err)
+		_, err = NewFileClient(u, "db0.rp0.sub2")
+		require.Error(t, err, dest)
+	}
+}
+
+func TestFileClient_RecoverTmpFile(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "db0.rp0.sub0-1.lp.tmp"), []byte("cpu value=1 1000\n"), 0640))
+	u, err := url.Parse("file://" + dir)
+	require.NoError(t, err)
+	_, err = NewFileClient(u, "db0.rp0.sub0")
+	require.NoError(t, err)
+	require.Equal(t, []string{"cpu value=1 1000\n"}, readSinkFiles(t, dir, "db0.rp0.sub0-1.lp"))
+}
+
+func TestNewSubscriberWriterScheme(t *testing.T) {
+	conf := config.NewSubscriber()
+	s := NewSubscriberManager(conf, &MockSubscriberMetaClient{}, logger.NewLogger(errno.ModuleCoordinator))
+	dir := t.TempDir()
+	w, err := s.NewSubscriberWriter("db0", "rp0", "sub0", "ALL",
+		[]string{"http://127.0.0.1:8086", "grpc://127.0.0.1:8305", "grpcs://127.0.0.1:8305", "file://" + dir})
+	require.NoError(t, err)
+	var dests []string
+	for _, c := range w.Clients() {
+		dests = append(dests, c.Destination())
+	}
+	require.Equal(t, "http://127.0.0.1:8086,grpc://127.0.0.1:8305,grpcs://127.0.0.1:8305,file://"+dir, strings.Join(dests, ","))
+	w.Start(1, 1)
+	w.Drop()
+
+	_, err = s.NewSubscriberWriter("db0", "rp0", "sub0", "ALL", []string{"http://127.0.0.1:8086", "udp://127.0.0.1:8089"})
+	require.EqualError(t, err, "unknown subscription schema udp")
+}
@@ -47,6 +47,10 @@ func (c *MockSubscriberClient) Destination() string {
 	return c.dest
 }
 
+func (c *MockSubscriberClient) Close() error {
+	return nil
+}
+
 func TestAllWriter(t *testing.T) {
 	destinations := []string{"http://127.0.0.1:8086", "http://127.0.0.1:8087", "http://127.0.0.1:8088"}
 	clients := make([]Client, 3)
@@ -670,7 +670,7 @@ func (wc *writeRecCtx) Reset() {
 type ComMetaClient interface {
 	Measurement(database string, rpName string, mstName string) (*meta2.MeasurementInfo, error)
 	CreateMeasurement(database string, retentionPolicy string, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation, engineType config.EngineType,
-		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error)
+		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error)
 	CreateShardGroup(database, policy string, timestamp time.Time, version uint32, engineType config.EngineType) (*meta2.ShardGroupInfo, error)
 }
 
@@ -690,7 +690,7 @@ func createMeasurement(database, retentionPolicy, name string, client ComMetaCli
 	mst, err := client.Measurement(database, retentionPolicy, name)
 	if err == meta2.ErrMeasurementNotFound {
 		ski := &meta2.ShardKeyInfo{ShardKey: nil, Type: influxql.HASH}
-		mst, err = client.CreateMeasurement(database, retentionPolicy, name, ski, 0, nil, engineType, nil, nil, nil)
+		mst, err = client.CreateMeasurement(database, retentionPolicy, name, ski, 0, nil, engineType, nil, nil, nil, 0)
 	}
 
 	if err == nil {
@@ -672,6 +672,9 @@ func (s *AggTagSetCursor) buildMinFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerMin
 		s.functions[column][1] = record.UpdateIntegerMinFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedMin
+		s.functions[column][1] = record.UpdateUnsignedMinFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatMin
 		s.functions[column][1] = record.UpdateFloatMinFast
@@ -704,6 +707,9 @@ func (s *AggTagSetCursor) buildMinFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerColumnMin
 		s.functions[column][1] = record.UpdateIntegerColumnMinFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedColumnMin
+		s.functions[column][1] = record.UpdateUnsignedColumnMinFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatColumnMin
 		s.functions[column][1] = record.UpdateFloatColumnMinFast
@@ -719,6 +725,9 @@ func (s *AggTagSetCursor) buildMaxFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerMax
 		s.functions[column][1] = record.UpdateIntegerMaxFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedMax
+		s.functions[column][1] = record.UpdateUnsignedMaxFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatMax
 		s.functions[column][1] = record.UpdateFloatMaxFast
@@ -740,6 +749,9 @@ func (s *AggTagSetCursor) buildMaxFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerColumnMax
 		s.functions[column][1] = record.UpdateIntegerColumnMaxFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedColumnMax
+		s.functions[column][1] = record.UpdateUnsignedColumnMaxFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatColumnMax
 		s.functions[column][1] = record.UpdateFloatColumnMaxFast
@@ -755,6 +767,9 @@ func (s *AggTagSetCursor) buildFirstFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerFirst
 		s.functions[column][1] = record.UpdateIntegerFirstFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedFirst
+		s.functions[column][1] = record.UpdateUnsignedFirstFast
 	case influx.Field_Type_String:
 		s.functions[column][0] = record.UpdateStringFirst
 		s.functions[column][1] = record.UpdateStringFirst
@@ -774,6 +789,9 @@ func (s *AggTagSetCursor) buildFirstFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerColumnFirst
 		s.functions[column][1] = record.UpdateIntegerColumnFirstFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedColumnFirst
+		s.functions[column][1] = record.UpdateUnsignedColumnFirstFast
 	case influx.Field_Type_String:
 		s.functions[column][0] = record.UpdateStringColumnFirst
 		s.functions[column][1] = record.UpdateStringColumnFirst
@@ -793,6 +811,9 @@ func (s *AggTagSetCursor) buildLastFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerLast
 		s.functions[column][1] = record.UpdateIntegerLastFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedLast
+		s.functions[column][1] = record.UpdateUnsignedLastFast
 	case influx.Field_Type_String:
 		s.functions[column][0] = record.UpdateStringLast
 		s.functions[column][1] = record.UpdateStringLast
@@ -812,6 +833,9 @@ func (s *AggTagSetCursor) buildLastFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerColumnLast
 		s.functions[column][1] = record.UpdateIntegerColumnLastFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedColumnLast
+		s.functions[column][1] = record.UpdateUnsignedColumnLastFast
 	case influx.Field_Type_String:
 		s.functions[column][0] = record.UpdateStringColumnLast
 		s.functions[column][1] = record.UpdateStringColumnLast
@@ -831,6 +855,9 @@ func (s *AggTagSetCursor) buildSumFunc() {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerSum
 		s.functions[column][1] = record.UpdateIntegerSumFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedSum
+		s.functions[column][1] = record.UpdateUnsignedSumFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatSum
 		s.functions[column][1] = record.UpdateFloatSumFast
@@ -843,6 +870,9 @@ func (s *AggTagSetCursor) buildSumFuncs(i int) {
 	case influx.Field_Type_Int:
 		s.functions[column][0] = record.UpdateIntegerSum
 		s.functions[column][1] = record.UpdateIntegerSumFast
+	case influx.Field_Type_UInt:
+		s.functions[column][0] = record.UpdateUnsignedSum
+		s.functions[column][1] = record.UpdateUnsignedSumFast
 	case influx.Field_Type_Float:
 		s.functions[column][0] = record.UpdateFloatSum
 		s.functions[column][1] = record.UpdateFloatSumFast
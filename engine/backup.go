@@ -17,7 +17,9 @@ package engine
 import (
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -25,7 +27,9 @@ import (
 
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/util"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -42,26 +46,49 @@ type Backup struct {
 	BackupLogInfo   *backup.BackupLogInfo
 	Engine          *Engine
 	IsAborted       bool
+
+	// checksums of the files copied for the current shard
+	checksums map[string]string
+	// dest is the local path or the remote bucket the backup is written to
+	dest backup.Destination
+	// archived wal files that are removed once the backup is complete
+	walFiles []string
 }
 
 func (s *Backup) RunBackupData() error {
 	s.time = time.Now().UnixNano()
+	s.walFiles = s.walFiles[:0]
+	if s.IsRemote {
+		conf := config.GetStoreConfig()
+		storage, err := backup.NewRemoteStorage(&conf.RemoteBackup)
+		if err != nil {
+			return err
+		}
+		defer storage.Close()
+		s.dest, err = backup.NewRemoteDestination(storage, s.BackupPath, backup.RemoteNodeName(conf.InsertAddr()), s.time, s.IsInc)
+		if err != nil {
+			return err
+		}
+	} else {
+		s.dest = backup.NewLocalDestination(s.BackupPath)
+	}
+
 	dbPtIds := s.Engine.GetDBPtIds()
 	ch := make(chan struct{})
 	var wg sync.WaitGroup
 
 	res := "backup success"
 	wg.Add(1)
-	go execTicker(ch, s.BackupPath, &wg)
+	go execTicker(ch, s.dest, &wg)
 	defer func() {
 		close(ch)
 		wg.Wait()
 		if r := recover(); r != nil {
 			err := errno.NewError(errno.RecoverPanic, r)
 			log.Error(err.Error())
-			_ = backup.WriteBackupLogFile([]byte(err.Error()), s.BackupPath, backup.ResultLog)
+			_ = s.dest.WriteResult([]byte(err.Error()))
 		} else {
-			_ = backup.WriteBackupLogFile([]byte(res), s.BackupPath, backup.ResultLog)
+			_ = s.dest.WriteResult([]byte(res))
 		}
 	}()
 
@@ -74,6 +101,17 @@ func (s *Backup) RunBackupData() error {
 			}
 		}
 	}
+
+	if err := s.dest.Commit(); err != nil {
+		res = fmt.Sprintf("backup failed, error: %s", err.Error())
+		return err
+	}
+	// the archived wal files are kept until the backup is complete, so that an interrupted
+	// backup can be taken again
+	if err := removeWalArchive(s.walFiles); err != nil {
+		res = fmt.Sprintf("backup failed, error: %s", err.Error())
+		return err
+	}
 	return nil
 }
 
@@ -120,7 +158,7 @@ func (s *Backup) BackupPt(dbName string, ptId uint32) error {
 	for _, ib := range p.indexBuilder {
 		indexPath := ib.Path()
 		dstPath := filepath.Join(backupPath, indexPath)
-		if err := backup.FolderCopy(indexPath, dstPath); err != nil {
+		if err := s.dest.CopyFolder(indexPath, dstPath); err != nil {
 			log.Error("backup index file error", zap.Error(err))
 			return err
 		}
@@ -132,6 +170,7 @@ func (s *Backup) FullBackup(sh Shard, dataPath, nodePath string, peersPtIDMap ma
 	t := sh.GetTableStore()
 	logPath := sh.GetDataPath()
 	fileListMap := make(map[string][][]string)
+	s.checksums = make(map[string]string)
 
 	fileList := t.GetAllMstList()
 
@@ -149,10 +188,17 @@ func (s *Backup) FullBackup(sh Shard, dataPath, nodePath string, peersPtIDMap ma
 
 	}
 
-	if len(fileListMap) > 0 {
+	walFileList, err := s.BackupWalArchive(sh, dataPath)
+	if err != nil {
+		return err
+	}
+
+	if len(fileListMap) > 0 || len(walFileList) > 0 {
 		backupLog := &backup.BackupLogInfo{
 			FullBackupTime: s.time,
 			FileListMap:    fileListMap,
+			WalFileList:    walFileList,
+			Checksums:      s.checksums,
 		}
 		content, err := json.MarshalIndent(&backupLog, "", "\t")
 		if err != nil {
@@ -161,11 +207,12 @@ func (s *Backup) FullBackup(sh Shard, dataPath, nodePath string, peersPtIDMap ma
 		if err := backup.WriteBackupLogFile(content, logPath, backup.FullBackupLog); err != nil {
 			return err
 		}
-		if err := backup.WriteBackupLogFile(content, filepath.Join(dataPath, logPath), backup.FullBackupLog); err != nil {
+		if err := s.dest.WriteLog(content, filepath.Join(dataPath, logPath), backup.FullBackupLog); err != nil {
 			return err
 		}
 	}
 
+	s.walFiles = append(s.walFiles, walFileList...)
 	return nil
 }
 
@@ -174,6 +221,7 @@ func (s *Backup) IncBackup(sh Shard, dataPath, nodePath string, peersPtIDMap map
 	logPath := sh.GetDataPath()
 
 	fileList := t.GetAllMstList()
+	s.checksums = make(map[string]string)
 	addFileListMap := make(map[string][][]string, 0)
 	delFileListMap := make(map[string][][]string, 0)
 	for _, name := range fileList {
@@ -192,17 +240,24 @@ func (s *Backup) IncBackup(sh Shard, dataPath, nodePath string, peersPtIDMap map
 		}
 	}
 
-	if len(addFileListMap) > 0 || len(delFileListMap) > 0 {
+	walFileList, err := s.BackupWalArchive(sh, dataPath)
+	if err != nil {
+		return err
+	}
+
+	if len(addFileListMap) > 0 || len(delFileListMap) > 0 || len(walFileList) > 0 {
 		incBackupLog := &backup.IncBackupLogInfo{
 			AddFileListMap: addFileListMap,
 			DelFileListMap: delFileListMap,
+			WalFileList:    walFileList,
+			Checksums:      s.checksums,
 		}
 		content, err := json.MarshalIndent(&incBackupLog, "", "\t")
 		if err != nil {
 			return err
 		}
 		incBackupLogPath := filepath.Join(dataPath, logPath)
-		if err := backup.WriteBackupLogFile(content, incBackupLogPath, backup.IncBackupLog); err != nil {
+		if err := s.dest.WriteLog(content, incBackupLogPath, backup.IncBackupLog); err != nil {
 			return err
 		}
 		if err := backup.WriteBackupLogFile(content, logPath, backup.IncBackupLog); err != nil {
@@ -210,6 +265,7 @@ func (s *Backup) IncBackup(sh Shard, dataPath, nodePath string, peersPtIDMap map
 		}
 	}
 
+	s.walFiles = append(s.walFiles, walFileList...)
 	return nil
 }
 
@@ -230,7 +286,7 @@ func (s *Backup) FullBackupTableFile(sh Shard, t immutable.TablesStore, peersPtI
 		if s.IsAborted {
 			return nil, fmt.Errorf("backup aborted")
 		}
-		if err := copyFullTableFile(f, sh, peersPtIDMap, nodePath, outPath, &fileList); err != nil {
+		if err := s.copyFullTableFile(f, sh, peersPtIDMap, nodePath, outPath, &fileList); err != nil {
 			return fileList, err
 		}
 	}
@@ -238,7 +294,7 @@ func (s *Backup) FullBackupTableFile(sh Shard, t immutable.TablesStore, peersPtI
 	return fileList, nil
 }
 
-func copyFullTableFile(f immutable.TSSPFile, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, fileList *[][]string) error {
+func (s *Backup) copyFullTableFile(f immutable.TSSPFile, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, fileList *[][]string) error {
 	f.RefFileReader()
 	defer func() {
 		f.UnrefFileReader()
@@ -247,7 +303,7 @@ func copyFullTableFile(f immutable.TSSPFile, sh Shard, peersPtIDMap map[uint32]*
 	fileListItem := GenPeerPtFilePath(sh, peersPtIDMap, nodePath, fullPath)
 	*fileList = append(*fileList, fileListItem)
 	dstPath := filepath.Join(outPath, fullPath)
-	if err := backup.FileCopy(fullPath, dstPath); err != nil {
+	if err := s.copyFile(fullPath, dstPath); err != nil {
 		log.Error("backup file error", zap.Error(err))
 		return err
 	}
@@ -278,7 +334,7 @@ func (s *Backup) IncBackupTableFile(sh Shard, t immutable.TablesStore, peersPtID
 		if s.IsAborted {
 			return nil, nil, fmt.Errorf("backup aborted")
 		}
-		if err := copyIncTableFile(f, seen, sh, peersPtIDMap, nodePath, outPath, &addFileList); err != nil {
+		if err := s.copyIncTableFile(f, seen, sh, peersPtIDMap, nodePath, outPath, &addFileList); err != nil {
 			return addFileList, deleteFileList, err
 		}
 	}
@@ -293,7 +349,7 @@ func (s *Backup) IncBackupTableFile(sh Shard, t immutable.TablesStore, peersPtID
 	return addFileList, deleteFileList, nil
 }
 
-func copyIncTableFile(f immutable.TSSPFile, seen map[string]bool, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, addFileList *[][]string) error {
+func (s *Backup) copyIncTableFile(f immutable.TSSPFile, seen map[string]bool, sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, outPath string, addFileList *[][]string) error {
 	f.RefFileReader()
 	defer func() {
 		f.UnrefFileReader()
@@ -306,9 +362,53 @@ func copyIncTableFile(f immutable.TSSPFile, seen map[string]bool, sh Shard, peer
 	addFileListItem := GenPeerPtFilePath(sh, peersPtIDMap, nodePath, fullPath)
 	*addFileList = append(*addFileList, addFileListItem)
 	dstPath := filepath.Join(outPath, fullPath)
-	if err := backup.FileCopy(fullPath, dstPath); err != nil {
+	return s.copyFile(fullPath, dstPath)
+}
+
+// copyFile copies the file to the backup destination and records its checksum,
+// ts-recover verifies it before restoring the file.
+func (s *Backup) copyFile(fullPath, dstPath string) error {
+	sum, err := s.dest.CopyFile(fullPath, dstPath)
+	if err != nil {
 		return err
 	}
+	if s.checksums != nil {
+		s.checksums[fullPath] = sum
+	}
+	return nil
+}
+
+// BackupWalArchive copies the archived wal files of the shard into the backup
+// path. They are removed from the archive once the backup is complete.
+func (s *Backup) BackupWalArchive(sh Shard, outPath string) ([]string, error) {
+	archivePath := filepath.Join(sh.GetWalPath(), backup.WalArchiveDir)
+	files, err := filepath.Glob(filepath.Join(archivePath, "*", "*"))
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	fileList := make([]string, 0, len(files))
+	for _, f := range files {
+		if s.IsAborted {
+			return nil, fmt.Errorf("backup aborted")
+		}
+		dstPath := filepath.Join(outPath, f)
+		if err = s.copyFile(f, dstPath); err != nil {
+			log.Error("backup wal file error", zap.Error(err))
+			return nil, err
+		}
+		fileList = append(fileList, f)
+	}
+	return fileList, nil
+}
+
+func removeWalArchive(files []string) error {
+	for _, f := range files {
+		if err := fileops.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -388,7 +488,7 @@ func GenPeerPtFilePath(sh Shard, peersPtIDMap map[uint32]*NodeInfo, nodePath, fu
 	return fileListItem
 }
 
-func execTicker(ch chan struct{}, path string, wg *sync.WaitGroup) {
+func execTicker(ch chan struct{}, dest backup.Destination, wg *sync.WaitGroup) {
 	t := time.NewTicker(1 * time.Minute)
 	defer func() {
 		t.Stop()
@@ -399,7 +499,7 @@ func execTicker(ch chan struct{}, path string, wg *sync.WaitGroup) {
 		case <-t.C:
 			result := time.Now().String()
 			result = fmt.Sprintf("%s: Backing up...", result)
-			_ = backup.WriteBackupLogFile([]byte(result), path, backup.ResultLog)
+			_ = dest.WriteResult([]byte(result))
 		case <-ch:
 			return
 		}
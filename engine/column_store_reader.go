@@ -193,9 +193,10 @@ func (r *ColumnStoreReader) initQueryCtx() (tr util.TimeRange, readCtx *immutabl
 
 	// init the query ctx
 	r.queryCtx = &idKeyCursorContext{
-		engineType:  config.COLUMNSTORE,
-		decs:        immutable.NewReadContext(true),
-		querySchema: querySchema}
+		engineType:   config.COLUMNSTORE,
+		decs:         immutable.NewReadContext(true),
+		querySchema:  querySchema,
+		pointLimiter: queryPointLimiter(querySchema)}
 	err = newCursorSchema(r.queryCtx, querySchema)
 	if err != nil {
 		return
@@ -432,6 +433,9 @@ func (r *ColumnStoreReader) Run(ctx context.Context) (iterCount, rowCountAfterFi
 
 			iterCount++
 			rowCountAfterFilter += rec.RowNums()
+			if err = r.queryCtx.pointLimiter.Add(rec.RowNums()); err != nil {
+				return
+			}
 
 			if r.limit > 0 && rowCountAfterFilter >= r.limit {
 				err = r.runLimit(rec, ch, rowCountAfterFilter)
@@ -114,9 +114,10 @@ func (storage *columnstoreImpl) writeSnapshot(s *shard) {
 func (storage *columnstoreImpl) flush(s *shard, idx int, curSize int64, walFiles *WalFiles, start time.Time) {
 	s.commitSnapshot(storage.snapshotContainer[idx])
 	nodeMutableLimit.freeResource(curSize)
-	err := removeWalFiles(walFiles)
-	if err != nil {
-		panic("wal remove files failed: " + err.Error())
+	if tryArchiveWalFiles(walFiles) {
+		if err := removeWalFiles(walFiles); err != nil {
+			panic("wal remove files failed: " + err.Error())
+		}
 	}
 
 	//This fail point is used in scenarios where "s.snapshotTbl" is not recycled
@@ -100,6 +100,9 @@ type Engine struct {
 	backup        *Backup
 
 	onPTOffload map[uint64]func(ptID uint32)
+
+	quotaMu      sync.Mutex
+	seriesQuotas map[string]*tsi.SeriesQuota // [db, SeriesQuota]
 }
 
 func NewEngine(dataPath, walPath string, options netstorage.EngineOptions, ctx *meta.LoadCtx) (netstorage.Engine, error) {
@@ -1059,6 +1062,9 @@ func (e *Engine) dropDBPTInfo(database string, ptID uint32) {
 		delete(dbPT, ptID)
 		if len(dbPT) == 0 {
 			delete(e.DBPartitions, database)
+			e.quotaMu.Lock()
+			delete(e.seriesQuotas, database)
+			e.quotaMu.Unlock()
 		}
 	}
 }
@@ -1076,6 +1082,7 @@ func (e *Engine) CreateDBPT(db string, pt uint32, enableTagArray bool) {
 	dbPTInfo.lockPath = &lockPath
 	e.addDBPTInfo(dbPTInfo)
 	dbPTInfo.SetOption(e.engOpt)
+	dbPTInfo.SetSeriesQuota(e.getSeriesQuota(db))
 	dbPTInfo.enableReportShardLoad()
 	dbPTInfo.enableTagArray = enableTagArray
 }
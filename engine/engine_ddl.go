@@ -30,6 +30,7 @@ import (
 	"github.com/openGemini/openGemini/engine/index/tsi"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/hll"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	stat "github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics/opsStat"
@@ -232,6 +233,8 @@ func (e *Engine) DropMeasurement(db string, rp string, name string, shardIds []u
 		}
 		pt.mu.RUnlock()
 	}
+	// the series of the dropped measurement are given back to the quota
+	e.getSeriesQuota(db).Release([]byte(name))
 
 	return nil
 }
@@ -321,7 +324,15 @@ func (e *Engine) SeriesCardinality(db string, ptIDs []uint32, namesWithVer [][]b
 	return measurementCardinalityInfos, nil
 }
 
-func (e *Engine) TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string]uint64, error) {
+// SeriesQuota returns the usage of the series quota of the database on this node.
+// The series of a measurement are counted over the indexes of all partitions and index durations on the node.
+func (e *Engine) SeriesQuota(db string, ptIDs []uint32, namesWithVer [][]byte) (*netstorage.SeriesQuota, error) {
+	quota := &netstorage.SeriesQuota{
+		Series:        make(map[string]uint64, len(namesWithVer)),
+		Limit:         uint64(e.engOpt.MaxSeriesPerMeasurement),
+		DatabaseLimit: uint64(e.engOpt.MaxSeriesPerDatabase),
+	}
+
 	e.mu.RLock()
 	var err error
 	if ptIDs, err = e.checkAndAddRefPTSNoLock(db, ptIDs); err != nil {
@@ -332,12 +343,114 @@ func (e *Engine) TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[str
 	pts, ok := e.DBPartitions[db]
 	e.mu.RUnlock()
 	if !ok {
-		return nil, nil
+		return quota, nil
+	}
+
+	seriesQuota := e.getSeriesQuota(db)
+	for _, name := range namesWithVer {
+		n, err := seriesQuota.Usage(name)
+		if err != nil {
+			return nil, err
+		}
+		quota.Series[influx.GetOriginMstName(util.Bytes2str(name))] = n
+	}
+
+	for i := range ptIDs {
+		pt, ok := pts[ptIDs[i]]
+		if !ok {
+			continue
+		}
+		pt.mu.RLock()
+		pt.databaseSeries(quota)
+		pt.mu.RUnlock()
+	}
+	return quota, nil
+}
+
+// getSeriesQuota returns the series quota of the database on this node, the partitions of the database share it.
+func (e *Engine) getSeriesQuota(db string) *tsi.SeriesQuota {
+	e.quotaMu.Lock()
+	defer e.quotaMu.Unlock()
+	if e.seriesQuotas == nil {
+		e.seriesQuotas = make(map[string]*tsi.SeriesQuota)
 	}
+	quota, ok := e.seriesQuotas[db]
+	if !ok {
+		quota = tsi.NewSeriesQuota(uint64(e.engOpt.MaxSeriesPerMeasurement))
+		e.seriesQuotas[db] = quota
+	}
+	return quota
+}
+
+func (e *Engine) TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string]uint64, error) {
 	tvMap := make(map[string]map[string]struct{}, len(tagKeys))
 	for name := range tagKeys {
 		tvMap[name] = make(map[string]struct{}, 64)
 	}
+	err := e.walkTagValues(db, ptIDs, tagKeys, condition, tr, func(name string, _ []byte, values []string) {
+		for _, v := range values {
+			tvMap[name][v] = struct{}{}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]uint64, len(tagKeys))
+	for nameWithVer := range tagKeys {
+		name := influx.GetOriginMstName(nameWithVer)
+		result[name] = uint64(len(tvMap[nameWithVer]))
+	}
+	return result, nil
+}
+
+// TagValuesSketch returns the serialized HyperLogLog sketch of the distinct tag values of each measurement,
+// the sketches of the stores are merged on ts-sql to estimate the cardinality without transferring the tag values.
+// The values are counted as TagValuesCardinality does, a value shared by several tag keys is counted once.
+func (e *Engine) TagValuesSketch(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (map[string][]byte, error) {
+	sketches := make(map[string]*hll.Sketch, len(tagKeys))
+	for name := range tagKeys {
+		sketches[name] = hll.NewDefault()
+	}
+	err := e.walkTagValues(db, ptIDs, tagKeys, condition, tr, func(name string, _ []byte, values []string) {
+		sketch := sketches[name]
+		for _, v := range values {
+			sketch.AddString(v)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string][]byte, len(tagKeys))
+	for nameWithVer, sketch := range sketches {
+		if sketch.IsEmpty() {
+			continue
+		}
+		b, err := sketch.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		result[influx.GetOriginMstName(nameWithVer)] = b
+	}
+	return result, nil
+}
+
+// walkTagValues calls fn with the values of each tag key of the measurements in the index of the given pts
+func (e *Engine) walkTagValues(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange,
+	fn func(name string, key []byte, values []string)) error {
+	e.mu.RLock()
+	var err error
+	if ptIDs, err = e.checkAndAddRefPTSNoLock(db, ptIDs); err != nil {
+		e.mu.RUnlock()
+		return err
+	}
+	defer e.unrefDBPTs(db, ptIDs)
+	pts, ok := e.DBPartitions[db]
+	e.mu.RUnlock()
+	if !ok {
+		return nil
+	}
 	for _, ptID := range ptIDs {
 		pt, ok := pts[ptID]
 		if !ok {
@@ -353,28 +466,20 @@ func (e *Engine) TagValuesCardinality(db string, ptIDs []uint32, tagKeys map[str
 				values, err := idx.SearchTagValues([]byte(name), tks, condition)
 				if err != nil {
 					pt.mu.RUnlock()
-					return nil, err
+					return err
 				}
 				if values == nil {
 					// Measurement name not found
 					continue
 				}
-				for _, vs := range values {
-					for _, v := range vs {
-						tvMap[name][v] = struct{}{}
-					}
+				for i, vs := range values {
+					fn(name, tks[i], vs)
 				}
 			}
 		}
 		pt.mu.RUnlock()
 	}
-
-	result := make(map[string]uint64, len(tagKeys))
-	for nameWithVer := range tagKeys {
-		name := influx.GetOriginMstName(nameWithVer)
-		result[name] = uint64(len(tvMap[nameWithVer]))
-	}
-	return result, nil
+	return nil
 }
 
 func (e *Engine) TagValues(db string, ptIDs []uint32, tagKeys map[string][][]byte, condition influxql.Expr, tr influxql.TimeRange) (netstorage.TablesTagSets, error) {
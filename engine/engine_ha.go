@@ -113,6 +113,7 @@ func (e *Engine) PreAssign(opId uint64, db string, ptId uint32, durationInfos ma
 	lockPath := ""
 	dbPt := NewDBPTInfo(db, ptId, ptPath, walPath, e.loadCtx, e.fileInfos, options)
 	dbPt.SetOption(e.engOpt)
+	dbPt.SetSeriesQuota(e.getSeriesQuota(db))
 	dbPt.SetParams(true, &lockPath, dbBriefInfo.EnableTagArray)
 	start := time.Now()
 	if err = e.loadDbPtShards(opId, dbPt, durationInfos, immutable.PRELOAD, client); err != nil {
@@ -209,6 +210,7 @@ func (e *Engine) Assign(opId uint64, nodeId uint64, db string, ptId uint32, ver
 	}
 	dbPt.logicClock = ver
 	dbPt.SetOption(e.engOpt)
+	dbPt.SetSeriesQuota(e.getSeriesQuota(db))
 	dbPt.SetParams(dbPt.preload, &lockPath, dbBriefInfo.EnableTagArray)
 
 	e.log.Info("[ASSIGN]start load dbpt shards", zap.Uint64("opId", opId), zap.String("db", db), zap.Uint32("pt", ptId))
@@ -38,6 +38,7 @@ import (
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/hll"
 	"github.com/openGemini/openGemini/lib/interruptsignal"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/metaclient"
@@ -1212,6 +1213,96 @@ func TestEngine_TagValuesCardinality(t *testing.T) {
 	require.Equal(t, uint64(0), tagsets["cpu"])
 }
 
+func TestEngine_TagValuesSketch(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+
+	if err := eng.WriteRows("db0", "rp0", 0, 1, rows, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	dbInfo := eng.DBPartitions["db0"][0]
+	idx, ok := dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex)
+	if !ok {
+		t.Fatal()
+	}
+	idx.DebugFlush()
+
+	tr := influxql.TimeRange{
+		Min: time.Unix(0, influxql.MinTime).UTC(),
+		Max: time.Unix(0, influxql.MaxTime).UTC(),
+	}
+	sketches, err := eng.TagValuesSketch("db0", []uint32{0}, map[string][][]byte{
+		msNames[0]:            {[]byte("tagkey1"), []byte("tagkey2")},
+		"invalid_measurement": {[]byte("tagkey1")},
+	}, nil, tr)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sketches))
+
+	sketch := &hll.Sketch{}
+	require.NoError(t, sketch.UnmarshalBinary(sketches[msNames[0]]))
+	require.Equal(t, uint64(20), sketch.Count())
+
+	// the sketch counts the same unit as the exact cardinality
+	exact, err := eng.TagValuesCardinality("db0", []uint32{0}, map[string][][]byte{
+		msNames[0]: {[]byte("tagkey1"), []byte("tagkey2")},
+	}, nil, tr)
+	require.NoError(t, err)
+	require.Equal(t, exact[msNames[0]], sketch.Count())
+
+	_, err = eng.TagValuesSketch("db0", []uint32{0xff}, map[string][][]byte{
+		msNames[0]: {[]byte("tagkey1")},
+	}, nil, tr)
+	require.Equal(t, true, errno.Equal(err, errno.PtNotFound))
+}
+
+func TestEngine_SeriesQuota(t *testing.T) {
+	dir := t.TempDir()
+	eng, err := initEngine1(dir, config.TSSTORE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer eng.Close()
+	eng.engOpt.MaxSeriesPerMeasurement = 100
+	dbInfo := eng.DBPartitions["db0"][0]
+	dbInfo.indexBuilder[659].SetSeriesQuota(eng.getSeriesQuota("db0"))
+
+	msNames := []string{"cpu"}
+	tm := time.Now().Truncate(time.Second)
+	rows, _, _ := GenDataRecord(msNames, 10, 200, time.Second, tm, false, true, false)
+
+	if err := eng.WriteRows("db0", "rp0", 0, 1, rows, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	idx, ok := dbInfo.indexBuilder[659].GetPrimaryIndex().(*tsi.MergeSetIndex)
+	if !ok {
+		t.Fatal()
+	}
+	idx.DebugFlush()
+
+	quota, err := eng.SeriesQuota("db0", []uint32{0}, [][]byte{[]byte(msNames[0]), []byte("invalid_measurement")})
+	require.NoError(t, err)
+	require.Equal(t, uint64(100), quota.Limit)
+	require.Equal(t, uint64(10), quota.Series[msNames[0]])
+	require.Equal(t, uint64(0), quota.Series["invalid_measurement"])
+
+	// the series of the dropped measurement are given back to the quota
+	require.NoError(t, eng.DropMeasurement("db0", "rp0", msNames[0], []uint64{1}))
+	quota, err = eng.SeriesQuota("db0", []uint32{0}, [][]byte{[]byte(msNames[0])})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), quota.Series[msNames[0]])
+
+	_, err = eng.SeriesQuota("db0", []uint32{0xff}, [][]byte{[]byte(msNames[0])})
+	require.Equal(t, true, errno.Equal(err, errno.PtNotFound))
+}
+
 func Test_Engine_DropMeasurement(t *testing.T) {
 	dir := t.TempDir()
 	eng, err := initEngine(dir)
@@ -1479,6 +1570,34 @@ func TestEngine_SeriesLimited(t *testing.T) {
 	require.EqualError(t, err, errno.NewError(errno.SeriesLimited, defaultDb, 10, 20).Error())
 }
 
+func TestEngine_MeasurementSeriesLimited(t *testing.T) {
+	testDir := t.TempDir()
+
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, testDir, config.TSSTORE)
+	require.NoError(t, err)
+
+	defer sh.Close()
+	defer sh.indexBuilder.Close()
+
+	sh.indexBuilder.SetSeriesQuota(tsi.NewSeriesQuota(10))
+	rows, _, _ := GenDataRecord([]string{"mst"}, 10, 1, 1, time.Now(), false, true, false)
+	err = writeData(sh, rows, true)
+	require.NoError(t, err)
+
+	// rewriting existing series is still allowed
+	err = writeData(sh, rows, true)
+	require.NoError(t, err)
+
+	rows, _, _ = GenDataRecord([]string{"mst"}, 20, 1, 1, time.Now(), false, true, false)
+	err = writeData(sh, rows, true)
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+
+	// other measurements have their own quota
+	rows, _, _ = GenDataRecord([]string{"mst2"}, 10, 1, 1, time.Now(), false, true, false)
+	err = writeData(sh, rows, true)
+	require.NoError(t, err)
+}
+
 func TestEngine_RowCount(t *testing.T) {
 	dir := t.TempDir()
 	eng, err := initEngine1(dir, config.TSSTORE)
@@ -889,6 +889,179 @@ func (r *IntegerIterator) Next(ie *IteratorEndpoint, p *IteratorParams) {
 	}
 }
 
+type UnsignedIterator struct {
+	isSingleCall bool
+	inOrdinal    int
+	outOrdinal   int
+	prevPoint    *Point[uint64]
+	currPoint    *Point[uint64]
+	fn           ColReduceFunc[uint64]
+	fv           ColMergeFunc[uint64]
+	auxChunk     Chunk
+	auxProcessor []*AuxProcessor
+}
+
+func NewUnsignedIterator(fn ColReduceFunc[uint64], fv ColMergeFunc[uint64],
+	isSingleCall bool, inOrdinal, outOrdinal int, auxProcessor []*AuxProcessor, rowDataType hybridqp.RowDataType,
+) *UnsignedIterator {
+	r := &UnsignedIterator{
+		fn:           fn,
+		fv:           fv,
+		isSingleCall: isSingleCall,
+		inOrdinal:    inOrdinal,
+		outOrdinal:   outOrdinal,
+		prevPoint:    newPoint[uint64](),
+		currPoint:    newPoint[uint64](),
+	}
+	if isSingleCall && len(auxProcessor) > 0 {
+		r.auxProcessor = auxProcessor
+		r.auxChunk = NewChunkBuilder(rowDataType).NewChunk("")
+	}
+	return r
+}
+
+func (r *UnsignedIterator) appendInAuxCol(
+	inChunk, outChunk Chunk, index int,
+) {
+	for j := range r.auxProcessor {
+		r.auxProcessor[j].auxHelperFunc(
+			inChunk.Column(r.auxProcessor[j].inOrdinal),
+			outChunk.Column(r.auxProcessor[j].outOrdinal),
+			index,
+		)
+	}
+}
+
+func (r *UnsignedIterator) appendOutAuxCol(
+	inChunk, outChunk Chunk, index int,
+) {
+	for j := range r.auxProcessor {
+		r.auxProcessor[j].auxHelperFunc(
+			inChunk.Column(r.auxProcessor[j].outOrdinal),
+			outChunk.Column(r.auxProcessor[j].outOrdinal),
+			index,
+		)
+	}
+}
+
+func (r *UnsignedIterator) mergePrevItem(
+	inChunk, outChunk Chunk,
+) {
+	if r.isSingleCall {
+		outChunk.AppendTime(r.prevPoint.time)
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outColumn := outChunk.Column(r.outOrdinal)
+	outColumn.AppendNotNil()
+	outColumn.AppendUnsignedValue(r.prevPoint.value)
+	if r.auxProcessor != nil {
+		if r.prevPoint.index == 0 {
+			r.appendOutAuxCol(r.auxChunk, outChunk, r.prevPoint.index)
+		} else {
+			r.appendInAuxCol(inChunk, outChunk, r.prevPoint.index-1)
+		}
+		r.auxChunk.Reset()
+	}
+}
+
+func (r *UnsignedIterator) processFirstWindow(
+	inChunk, outChunk Chunk, isNil, sameInterval, onlyOneInterval bool, index int, value uint64,
+) {
+	// To distinguish values between inChunk and auxChunk, r.currPoint.index incremented by 1.
+	if !isNil {
+		r.currPoint.Set(index+1, inChunk.TimeByIndex(index), value)
+		r.fv(r.prevPoint, r.currPoint)
+	}
+	if onlyOneInterval && sameInterval {
+		if r.auxProcessor != nil && r.prevPoint.index > 0 {
+			r.auxChunk.Reset()
+			r.auxChunk.AppendTime(inChunk.TimeByIndex(r.prevPoint.index - 1))
+			r.appendInAuxCol(inChunk, r.auxChunk, r.prevPoint.index-1)
+		}
+		r.prevPoint.index = 0
+	} else {
+		if !r.prevPoint.isNil {
+			r.mergePrevItem(inChunk, outChunk)
+		}
+		r.prevPoint.Reset()
+	}
+	r.currPoint.Reset()
+}
+
+func (r *UnsignedIterator) processLastWindow(
+	inChunk Chunk, index int, isNil bool, value uint64,
+) {
+	if isNil {
+		r.prevPoint.Reset()
+	} else {
+		r.prevPoint.Set(0, inChunk.TimeByIndex(index), value)
+	}
+	if r.auxProcessor != nil {
+		r.auxChunk.AppendTime(inChunk.TimeByIndex(index))
+		r.appendInAuxCol(inChunk, r.auxChunk, index)
+	}
+}
+
+func (r *UnsignedIterator) processMiddleWindow(
+	inChunk, outChunk Chunk, index int, value uint64,
+) {
+	if r.isSingleCall {
+		outChunk.AppendTime(inChunk.TimeByIndex(index))
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outColumn := outChunk.Column(r.outOrdinal)
+	outColumn.AppendNotNil()
+	outColumn.AppendUnsignedValue(value)
+	if r.auxProcessor != nil {
+		r.appendInAuxCol(inChunk, outChunk, index)
+	}
+}
+
+func (r *UnsignedIterator) Next(ie *IteratorEndpoint, p *IteratorParams) {
+	inChunk, outChunk := ie.InputPoint.Chunk, ie.OutputPoint.Chunk
+	inColumn := inChunk.Column(r.inOrdinal)
+	outColumn := outChunk.Column(r.outOrdinal)
+	if inColumn.IsEmpty() && r.prevPoint.isNil {
+		var addIntervalLen int
+		if p.sameInterval {
+			addIntervalLen = inChunk.IntervalLen() - 1
+		} else {
+			addIntervalLen = inChunk.IntervalLen()
+		}
+		if addIntervalLen > 0 {
+			outColumn.AppendManyNil(addIntervalLen)
+		}
+		return
+	}
+
+	var end int
+	firstIndex, lastIndex := 0, len(inChunk.IntervalIndex())-1
+	values := inColumn.UnsignedValues()
+	for i, start := range inChunk.IntervalIndex() {
+		if i < lastIndex {
+			end = inChunk.IntervalIndex()[i+1]
+		} else {
+			end = inChunk.NumberOfRows()
+		}
+		index, value, isNil := r.fn(inChunk, values, r.inOrdinal, start, end)
+		if isNil && ((i > firstIndex && i < lastIndex) ||
+			(firstIndex == lastIndex && r.prevPoint.isNil && !p.sameInterval) ||
+			(firstIndex != lastIndex && i == firstIndex && r.prevPoint.isNil) ||
+			(firstIndex != lastIndex && i == lastIndex && !p.sameInterval)) {
+			outColumn.AppendNil()
+			continue
+		}
+		if i == firstIndex && !r.prevPoint.isNil {
+			r.processFirstWindow(inChunk, outChunk, isNil, p.sameInterval,
+				firstIndex == lastIndex, index, value)
+		} else if i == lastIndex && p.sameInterval {
+			r.processLastWindow(inChunk, index, isNil, value)
+		} else if !isNil {
+			r.processMiddleWindow(inChunk, outChunk, index, value)
+		}
+	}
+}
+
 type StringMerge func(prevPoint, currPoint *StringPoint)
 
 type StringIterator struct {
@@ -3108,3 +3281,132 @@ func (r *IntegerTimeColIntegerIterator) Next(ie *IteratorEndpoint, p *IteratorPa
 		}
 	}
 }
+
+type UnsignedTimeColUnsignedIterator struct {
+	initTimeCol bool
+	inOrdinal   int
+	outOrdinal  int
+	prevPoint   *Point[uint64]
+	currPoint   *Point[uint64]
+	fn          TimeColReduceFunc[uint64]
+	fv          ColMergeFunc[uint64]
+}
+
+func NewUnsignedTimeColUnsignedIterator(
+	fn TimeColReduceFunc[uint64], fv ColMergeFunc[uint64], inOrdinal, outOrdinal int,
+) *UnsignedTimeColUnsignedIterator {
+	r := &UnsignedTimeColUnsignedIterator{
+		fn:         fn,
+		fv:         fv,
+		inOrdinal:  inOrdinal,
+		outOrdinal: outOrdinal,
+		prevPoint:  newPoint[uint64](),
+		currPoint:  newPoint[uint64](),
+	}
+	return r
+}
+
+func (r *UnsignedTimeColUnsignedIterator) mergePrevItem(
+	outChunk Chunk,
+) {
+	outColumn := outChunk.Column(r.outOrdinal)
+	outColumn.AppendUnsignedValue(r.prevPoint.value)
+	outColumn.AppendColumnTime(r.prevPoint.time)
+	outColumn.AppendNotNil()
+}
+
+func (r *UnsignedTimeColUnsignedIterator) processFirstWindow(
+	inChunk, outChunk Chunk, isNil, sameInterval, onlyOneInterval bool, index int, value uint64,
+) {
+	// To distinguish values between inChunk and auxChunk, r.currPoint.index incremented by 1.
+	if !isNil {
+		if r.initTimeCol {
+			r.currPoint.Set(index+1, inChunk.Column(r.inOrdinal).ColumnTime(index), value)
+		} else {
+			r.currPoint.Set(index+1, inChunk.TimeByIndex(index), value)
+		}
+		r.fv(r.prevPoint, r.currPoint)
+	}
+	if onlyOneInterval && sameInterval {
+		r.prevPoint.index = 0
+	} else {
+		if !r.prevPoint.isNil {
+			r.mergePrevItem(outChunk)
+		}
+		r.prevPoint.Reset()
+	}
+	r.currPoint.Reset()
+}
+
+func (r *UnsignedTimeColUnsignedIterator) processLastWindow(
+	inChunk Chunk, index int, isNil bool, value uint64,
+) {
+	if isNil {
+		r.prevPoint.Reset()
+		return
+	}
+	if r.initTimeCol {
+		r.prevPoint.Set(0, inChunk.Column(r.inOrdinal).ColumnTime(index), value)
+	} else {
+		r.prevPoint.Set(0, inChunk.TimeByIndex(index), value)
+	}
+}
+
+func (r *UnsignedTimeColUnsignedIterator) processMiddleWindow(
+	inChunk, outChunk Chunk, index int, value uint64,
+) {
+	outColumn := outChunk.Column(r.outOrdinal)
+	if r.initTimeCol {
+		outColumn.AppendColumnTime(inChunk.Column(r.inOrdinal).ColumnTime(index))
+	} else {
+		outColumn.AppendColumnTime(inChunk.TimeByIndex(index))
+	}
+	outColumn.AppendUnsignedValue(value)
+	outColumn.AppendNotNil()
+}
+
+func (r *UnsignedTimeColUnsignedIterator) Next(ie *IteratorEndpoint, p *IteratorParams) {
+	inChunk, outChunk := ie.InputPoint.Chunk, ie.OutputPoint.Chunk
+	inColumn := inChunk.Column(r.inOrdinal)
+	outColumn := outChunk.Column(r.outOrdinal)
+	if inColumn.IsEmpty() && r.prevPoint.isNil {
+		var addIntervalLen int
+		if p.sameInterval {
+			addIntervalLen = inChunk.IntervalLen() - 1
+		} else {
+			addIntervalLen = inChunk.IntervalLen()
+		}
+		if addIntervalLen > 0 {
+			outColumn.AppendManyNil(addIntervalLen)
+		}
+		return
+	}
+
+	var end int
+	r.initTimeCol = len(inColumn.ColumnTimes()) > 0
+	firstIndex, lastIndex := 0, len(inChunk.IntervalIndex())-1
+	values := inColumn.UnsignedValues()
+	for i, start := range inChunk.IntervalIndex() {
+		if i < lastIndex {
+			end = inChunk.IntervalIndex()[i+1]
+		} else {
+			end = inChunk.NumberOfRows()
+		}
+		index, value, isNil := r.fn(inChunk, values, r.inOrdinal, start, end)
+		if isNil && ((i > firstIndex && i < lastIndex) ||
+			(firstIndex == lastIndex && r.prevPoint.isNil && !p.sameInterval) ||
+			(firstIndex != lastIndex && i == firstIndex && r.prevPoint.isNil) ||
+			(firstIndex != lastIndex && i == lastIndex && !p.sameInterval)) {
+			outColumn.AppendNil()
+			continue
+		}
+		if i == firstIndex && !r.prevPoint.isNil {
+			r.processFirstWindow(inChunk, outChunk, isNil, p.sameInterval,
+				firstIndex == lastIndex, index, value)
+		} else if i == lastIndex && p.sameInterval {
+			r.processLastWindow(inChunk, index, isNil, value)
+		} else if !isNil {
+			r.processMiddleWindow(inChunk, outChunk, index, value)
+		}
+	}
+}
@@ -27,6 +27,9 @@ func init() {
 	RegistryAggOp("min", &MinOp{})
 	RegistryAggOp("max", &MaxOp{})
 	RegistryAggOp("percentile_approx", &PercentileApproxOp{})
+	RegistryAggOp(HLLInsert, &HLLOp{})
+	RegistryAggOp(HLLMerge, &HLLOp{})
+	RegistryAggOp(HLLCount, &HLLOp{})
 	RegistryAggOp("min_prom", &MinPromOp{})
 	RegistryAggOp("max_prom", &MaxPromOp{})
 	RegistryAggOp("count_prom", &FloatCountPromOp{})
@@ -55,6 +58,10 @@ func (c *MinOp) CreateRoutine(params *AggCallFuncParams) (Routine, error) {
 		return NewRoutineImpl(NewIntegerIterator(MinReduce[int64], MinMerge[int64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(NewUnsignedIterator(MinReduce[uint64], MinMerge[uint64],
+			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(NewFloatIterator(MinReduce[float64], MinMerge[float64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -83,6 +90,10 @@ func (c *MaxOp) CreateRoutine(params *AggCallFuncParams) (Routine, error) {
 		return NewRoutineImpl(NewIntegerIterator(MaxReduce[int64], MaxMerge[int64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(NewUnsignedIterator(MaxReduce[uint64], MaxMerge[uint64],
+			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(NewFloatIterator(MaxReduce[float64], MaxMerge[float64],
 			isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
@@ -118,6 +129,34 @@ func (c *PercentileApproxOp) CreateRoutine(params *AggCallFuncParams) (Routine,
 	return NewPercentileApproxRoutineImpl(inRowDataType, outRowDataType, exprOpt, isSingleCall, opt, name, clusterNum, percentile)
 }
 
+// HLLOp creates the routines of the composite call of approx_count_distinct.
+type HLLOp struct{}
+
+func (c *HLLOp) CreateRoutine(params *AggCallFuncParams) (Routine, error) {
+	inRowDataType, outRowDataType, exprOpt, isSingleCall, name, opt := params.InRowDataType, params.OutRowDataType, params.ExprOpt, params.IsSingleCall, params.Name, params.Opt
+	inOrdinal := inRowDataType.FieldIndex(exprOpt.Expr.(*influxql.Call).Args[0].(*influxql.VarRef).Val)
+	outOrdinal := outRowDataType.FieldIndex(exprOpt.Ref.Val)
+	if inOrdinal < 0 || outOrdinal < 0 {
+		return nil, errno.NewError(errno.SchemaNotAligned, name, "input and output schemas are not aligned")
+	}
+	dataType := inRowDataType.Field(inOrdinal).Expr.(*influxql.VarRef).Type
+
+	var item OGSketchItem
+	switch {
+	case name == HLLCount:
+		item = NewHLLCountItem(isSingleCall, inOrdinal, outOrdinal, dataType)
+	case name == HLLMerge && dataType == influxql.FloatTuple:
+		item = NewHLLMergeItem(isSingleCall, inOrdinal, outOrdinal)
+	case name == HLLInsert && dataType != influxql.FloatTuple:
+		item = NewHLLInsertItem(isSingleCall, inOrdinal, outOrdinal, dataType)
+	default:
+		return nil, errno.NewError(errno.UnsupportedDataType, name, dataType.String())
+	}
+	// one row is written for each window, so the cluster number is 1
+	return NewRoutineImpl(NewOGSketchIterator(isSingleCall, inOrdinal, outOrdinal, 1, opt, item),
+		inOrdinal, outOrdinal), nil
+}
+
 type BasePromOp struct {
 	op string
 	fn ColReduceFunc[float64]
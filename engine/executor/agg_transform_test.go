@@ -7961,3 +7961,43 @@ func TestStreamAggregateTransformMaxProm(t *testing.T) {
 		false,
 	)
 }
+
+func TestStreamAggregateTransformHLLCount(t *testing.T) {
+	inRowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "value1", Type: influxql.Integer})
+	outRowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: `hll_count("value1")`, Type: influxql.Integer})
+
+	inCk := executor.NewChunkBuilder(inRowDataType).NewChunk("mst")
+	inCk.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("name=aaa"), *ParseChunkTags("name=bbb")}, []int{0, 4})
+	inCk.AppendIntervalIndexes([]int{0, 4})
+	inCk.AppendTimes([]int64{1, 2, 3, 4, 5, 6, 7})
+	inCk.Column(0).AppendIntegerValues([]int64{1, 2, 2, 3, 5, 5, 5})
+	inCk.Column(0).AppendManyNotNil(7)
+
+	dstCk := executor.NewChunkBuilder(outRowDataType).NewChunk("mst")
+	dstCk.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("name=aaa"), *ParseChunkTags("name=bbb")}, []int{0, 1})
+	dstCk.AppendIntervalIndexes([]int{0, 1})
+	dstCk.AppendTimes([]int64{0, 0})
+	dstCk.Column(0).AppendIntegerValues([]int64{3, 1})
+	dstCk.Column(0).AppendManyNotNil(2)
+
+	exprOpt := []hybridqp.ExprOptions{
+		{
+			Expr: &influxql.Call{Name: "hll_count", Args: []influxql.Expr{hybridqp.MustParseExpr("value1")}},
+			Ref:  influxql.VarRef{Val: `hll_count("value1")`, Type: influxql.Integer},
+		},
+	}
+	opt := query.ProcessorOptions{
+		Exprs:      []influxql.Expr{hybridqp.MustParseExpr(`hll_count("value1")`)},
+		Dimensions: []string{"name"},
+		Ordered:    true,
+		Ascending:  true,
+		ChunkSize:  10,
+	}
+
+	testStreamAggregateTransformBase(
+		t,
+		[]executor.Chunk{inCk}, []executor.Chunk{dstCk},
+		inRowDataType, outRowDataType,
+		exprOpt, &opt, true,
+	)
+}
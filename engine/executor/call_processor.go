@@ -41,6 +41,13 @@ const (
 	PercentileOGSketch = "percentile_ogsketch"
 )
 
+const (
+	ApproxCountDistinct = "approx_count_distinct"
+	HLLInsert           = "hll_insert"
+	HLLMerge            = "hll_merge"
+	HLLCount            = "hll_count"
+)
+
 func NewProcessors(inRowDataType, outRowDataType hybridqp.RowDataType, exprOpt []hybridqp.ExprOptions, opt *query.ProcessorOptions, isSubQuery bool) (*processorResults, error) {
 	var err error
 	proRes := &processorResults{}
@@ -395,6 +402,11 @@ func NewCountRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 			NewIntegerIterator(CountReduce, CountMerge[int64], isSingleCall, inOrdinal, outOrdinal,
 				nil, nil),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(
+			NewIntegerIterator(CountReduce, CountMerge[int64], isSingleCall, inOrdinal, outOrdinal,
+				nil, nil),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(
 			NewIntegerIterator(CountReduce, CountMerge[int64], isSingleCall, inOrdinal, outOrdinal,
@@ -428,6 +440,11 @@ func NewSumRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt h
 			NewIntegerIterator(SumReduce[int64], SumMerge[int64], isSingleCall, inOrdinal, outOrdinal,
 				nil, nil),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		return NewRoutineImpl(
+			NewUnsignedIterator(SumReduce[uint64], SumMerge[uint64], isSingleCall, inOrdinal, outOrdinal,
+				nil, nil),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		return NewRoutineImpl(
 			NewFloatIterator(SumReduce[float64], SumMerge[float64], isSingleCall, inOrdinal, outOrdinal,
@@ -454,6 +471,15 @@ func NewFirstRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 		return NewRoutineImpl(NewIntegerTimeColIntegerIterator(FirstTimeColReduce[int64], FirstTimeColMerge[int64],
 			inOrdinal, outOrdinal),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		if isSingleCall {
+			return NewRoutineImpl(NewUnsignedIterator(FirstReduce[uint64], FirstMerge[uint64],
+				isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+				inOrdinal, outOrdinal), nil
+		}
+		return NewRoutineImpl(NewUnsignedTimeColUnsignedIterator(FirstTimeColReduce[uint64], FirstTimeColMerge[uint64],
+			inOrdinal, outOrdinal),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		if isSingleCall {
 			return NewRoutineImpl(NewFloatIterator(FirstReduce[float64], FirstMerge[float64],
@@ -503,6 +529,15 @@ func NewLastRoutineImpl(inRowDataType, outRowDataType hybridqp.RowDataType, opt
 		return NewRoutineImpl(NewIntegerTimeColIntegerIterator(LastTimeColReduce[int64], LastTimeColMerge[int64],
 			inOrdinal, outOrdinal),
 			inOrdinal, outOrdinal), nil
+	case influxql.Unsigned:
+		if isSingleCall {
+			return NewRoutineImpl(NewUnsignedIterator(LastReduce[uint64], LastMerge[uint64],
+				isSingleCall, inOrdinal, outOrdinal, auxProcessor, outRowDataType),
+				inOrdinal, outOrdinal), nil
+		}
+		return NewRoutineImpl(NewUnsignedTimeColUnsignedIterator(LastTimeColReduce[uint64], LastTimeColMerge[uint64],
+			inOrdinal, outOrdinal),
+			inOrdinal, outOrdinal), nil
 	case influxql.Float:
 		if isSingleCall {
 			return NewRoutineImpl(NewFloatIterator(LastReduce[float64], LastMerge[float64],
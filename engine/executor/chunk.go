@@ -88,6 +88,27 @@ func initIntegerColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []inte
 	return dst
 }
 
+func initUnsignedColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{} {
+	// fast path
+	if col.NilCount() == 0 {
+		values := col.UnsignedValues()[bmStart:bmEnd]
+		for _, v := range values {
+			dst = append(dst, v)
+		}
+		return dst
+	}
+
+	// slow path
+	for j := bmStart; j < bmEnd; j++ {
+		if col.IsNilV2(j) {
+			dst = append(dst, nil)
+		} else {
+			dst = append(dst, col.UnsignedValue(col.GetValueIndexV2(j)))
+		}
+	}
+	return dst
+}
+
 func initBooleanColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{} {
 	// fast path
 	if col.NilCount() == 0 {
@@ -140,12 +161,14 @@ func initTagColumnFunc(col Column, bmStart, bmEnd int, ckLen int, dst []interfac
 }
 
 func initColumnTypeFunc() {
-	GetColValsFn = make(map[influxql.DataType]func(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{}, 5)
+	GetColValsFn = make(map[influxql.DataType]func(col Column, bmStart, bmEnd int, ckLen int, dst []interface{}) []interface{}, 6)
 
 	GetColValsFn[influxql.Float] = initFloatColumnFunc
 
 	GetColValsFn[influxql.Integer] = initIntegerColumnFunc
 
+	GetColValsFn[influxql.Unsigned] = initUnsignedColumnFunc
+
 	GetColValsFn[influxql.Boolean] = initBooleanColumnFunc
 
 	GetColValsFn[influxql.String] = initStringColumnFunc
@@ -385,6 +408,8 @@ func (c *ChunkImpl) AppendIntervalFullRows(start, step int64, num int, tag Chunk
 		switch c.rowDataType.Field(i).Expr.(*influxql.VarRef).Type {
 		case influxql.Integer:
 			c.Column(i).AppendIntegerValues(make([]int64, num))
+		case influxql.Unsigned:
+			c.Column(i).AppendUnsignedValues(make([]uint64, num))
 		case influxql.Float:
 			c.Column(i).AppendFloatValues(make([]float64, num))
 		case influxql.Boolean:
@@ -503,6 +528,8 @@ func (c *ChunkImpl) copy(dst Chunk) Chunk {
 		switch dataType {
 		case influxql.Integer:
 			dst.Column(i).AppendIntegerValues(c.Column(i).IntegerValues())
+		case influxql.Unsigned:
+			dst.Column(i).AppendUnsignedValues(c.Column(i).UnsignedValues())
 		case influxql.FloatTuple:
 			dst.Column(i).AppendFloatTuples(c.Column(i).FloatTuples())
 		case influxql.Float:
@@ -521,6 +548,8 @@ func (c *ChunkImpl) copy(dst Chunk) Chunk {
 		switch dst.Dim(i).DataType() {
 		case influxql.Integer:
 			dst.Dim(i).AppendIntegerValues(c.Dim(i).IntegerValues())
+		case influxql.Unsigned:
+			dst.Dim(i).AppendUnsignedValues(c.Dim(i).UnsignedValues())
 		case influxql.FloatTuple:
 			dst.Dim(i).AppendFloatTuples(c.Dim(i).FloatTuples())
 		case influxql.Float:
@@ -558,6 +587,8 @@ func (c *ChunkImpl) CopyByRowDataType(dst Chunk, fromRt hybridqp.RowDataType, ds
 			switch dataType {
 			case influxql.Integer:
 				dst.Column(v2).AppendIntegerValues(c.Column(v1).IntegerValues())
+			case influxql.Unsigned:
+				dst.Column(v2).AppendUnsignedValues(c.Column(v1).UnsignedValues())
 			case influxql.FloatTuple:
 				dst.Column(v2).AppendFloatTuples(c.Column(v1).FloatTuples())
 			case influxql.Float:
@@ -647,6 +678,8 @@ func (c *ChunkImpl) String() string {
 			switch c.Column(i).DataType() {
 			case influxql.Integer:
 				line = append(line, strconv.FormatInt(c.Column(i).IntegerValue(l), 10))
+			case influxql.Unsigned:
+				line = append(line, strconv.FormatUint(c.Column(i).UnsignedValue(l), 10))
 			case influxql.Float:
 				line = append(line, strconv.FormatFloat(c.Column(i).FloatValue(l), 'f', -1, 64))
 			case influxql.Boolean:
@@ -754,6 +787,24 @@ func (valuer *IntegerFieldValuer) At(col Column, pos int, field *influx.Field) b
 	return true
 }
 
+type UnsignedFieldValuer struct {
+	key string
+	typ int32
+}
+
+func (valuer *UnsignedFieldValuer) At(col Column, pos int, field *influx.Field) bool {
+	if col.IsNilV2(pos) {
+		return false
+	}
+
+	valueIndex := col.GetValueIndexV2(pos)
+
+	field.Key = valuer.key
+	field.Type = valuer.typ
+	field.SetUintValue(col.UnsignedValue(valueIndex))
+	return true
+}
+
 type FieldValuer interface {
 	At(Column, int, *influx.Field) bool
 }
@@ -819,6 +870,11 @@ func NewFieldValuer(ref *influxql.VarRef) (FieldValuer, error) {
 		valuer.key = ref.Val
 		valuer.typ = influx.Field_Type_Int
 		return valuer, nil
+	case influxql.Unsigned:
+		valuer := &UnsignedFieldValuer{}
+		valuer.key = ref.Val
+		valuer.typ = influx.Field_Type_UInt
+		return valuer, nil
 	case influxql.Float:
 		valuer := &FloatFieldValuer{}
 		valuer.key = ref.Val
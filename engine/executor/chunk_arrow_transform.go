@@ -16,6 +16,7 @@ package executor
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/apache/arrow/go/v13/arrow"
 	"github.com/apache/arrow/go/v13/arrow/array"
@@ -277,19 +278,98 @@ func buildRecordField(info map[string]*fieldInfo) ([]arrow.Field, *errno.Error)
 	aFields := make([]arrow.Field, len(info)+1) // add 1 for timestamp
 
 	for _, f := range info {
-		switch f.dType {
-		case influxql.Float:
-			aFields[f.idx] = arrow.Field{Name: f.name, Type: arrow.PrimitiveTypes.Float64}
-		case influxql.Integer:
-			aFields[f.idx] = arrow.Field{Name: f.name, Type: arrow.PrimitiveTypes.Int64}
-		default:
+		// castor algorithms only accept numeric columns
+		if f.dType != influxql.Float && f.dType != influxql.Integer {
 			return nil, errno.NewError(errno.DtypeNotSupport)
 		}
+		dType, err := ArrowDataType(f.dType)
+		if err != nil {
+			return nil, err
+		}
+		aFields[f.idx] = arrow.Field{Name: f.name, Type: dType}
 	}
 	aFields[len(info)] = arrow.Field{Name: string(castor.DataTime), Type: arrow.PrimitiveTypes.Int64}
 	return aFields, nil
 }
 
+// ArrowDataType returns the arrow data type used to carry the values of an influxql data type.
+func ArrowDataType(dType influxql.DataType) (arrow.DataType, *errno.Error) {
+	switch dType {
+	case influxql.Float:
+		return arrow.PrimitiveTypes.Float64, nil
+	case influxql.Integer:
+		return arrow.PrimitiveTypes.Int64, nil
+	case influxql.Unsigned:
+		return arrow.PrimitiveTypes.Uint64, nil
+	case influxql.String, influxql.Tag:
+		return arrow.BinaryTypes.String, nil
+	case influxql.Boolean:
+		return arrow.FixedWidthTypes.Boolean, nil
+	case influxql.Time:
+		return arrow.FixedWidthTypes.Timestamp_ns, nil
+	default:
+		return nil, errno.NewError(errno.DtypeNotSupport)
+	}
+}
+
+// AppendArrowValue appends a single result value to an arrow builder created for ArrowDataType.
+// A nil value is appended as null.
+func AppendArrowValue(b array.Builder, v interface{}) *errno.Error {
+	if v == nil {
+		b.AppendNull()
+		return nil
+	}
+	switch b := b.(type) {
+	case *array.Float64Builder:
+		switch val := v.(type) {
+		case float64:
+			b.Append(val)
+		case int64:
+			b.Append(float64(val))
+		case uint64:
+			b.Append(float64(val))
+		default:
+			return errno.NewError(errno.DtypeNotMatch, influxql.Float, influxql.InspectDataType(v))
+		}
+	case *array.Int64Builder:
+		val, ok := v.(int64)
+		if !ok {
+			return errno.NewError(errno.DtypeNotMatch, influxql.Integer, influxql.InspectDataType(v))
+		}
+		b.Append(val)
+	case *array.Uint64Builder:
+		val, ok := v.(uint64)
+		if !ok {
+			return errno.NewError(errno.DtypeNotMatch, influxql.Unsigned, influxql.InspectDataType(v))
+		}
+		b.Append(val)
+	case *array.StringBuilder:
+		val, ok := v.(string)
+		if !ok {
+			return errno.NewError(errno.DtypeNotMatch, influxql.String, influxql.InspectDataType(v))
+		}
+		b.Append(val)
+	case *array.BooleanBuilder:
+		val, ok := v.(bool)
+		if !ok {
+			return errno.NewError(errno.DtypeNotMatch, influxql.Boolean, influxql.InspectDataType(v))
+		}
+		b.Append(val)
+	case *array.TimestampBuilder:
+		switch val := v.(type) {
+		case time.Time:
+			b.Append(arrow.Timestamp(val.UnixNano()))
+		case int64:
+			b.Append(arrow.Timestamp(val))
+		default:
+			return errno.NewError(errno.DtypeNotMatch, influxql.Time, influxql.InspectDataType(v))
+		}
+	default:
+		return errno.NewError(errno.DtypeNotSupport)
+	}
+	return nil
+}
+
 func appendArrowFloat64(b *array.RecordBuilder, col Column, fieldIndex, seriesStart, seriesEnd int) {
 	floatValues := col.FloatValues()
 	if col.NilCount() == 0 {
@@ -19,9 +19,11 @@ import (
 	"reflect"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/v13/arrow"
 	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -529,3 +531,51 @@ func buildChunkSchema(schema *arrow.Schema) (*hybridqp.RowDataTypeImpl, *errno.E
 	row := hybridqp.NewRowDataTypeImpl(varRefs...)
 	return row, nil
 }
+
+func TestAppendArrowValue(t *testing.T) {
+	dTypes := []influxql.DataType{influxql.Time, influxql.Float, influxql.Integer, influxql.String, influxql.Boolean, influxql.Unsigned}
+	fields := make([]arrow.Field, 0, len(dTypes))
+	for i, dt := range dTypes {
+		aType, err := executor.ArrowDataType(dt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		fields = append(fields, arrow.Field{Name: fmt.Sprintf("f%d", i), Type: aType, Nullable: true})
+	}
+	b := array.NewRecordBuilder(memory.NewGoAllocator(), arrow.NewSchema(fields, nil))
+	defer b.Release()
+
+	rows := [][]interface{}{
+		{time.Unix(0, 1), 1.5, int64(1), "a", true, uint64(1)},
+		{int64(2), int64(2), nil, nil, nil, nil},
+	}
+	for _, row := range rows {
+		for i, v := range row {
+			if err := executor.AppendArrowValue(b.Field(i), v); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	if rec.NumRows() != 2 {
+		t.Fatalf("expect 2 rows, got %d", rec.NumRows())
+	}
+	if !reflect.DeepEqual(rec.Column(0).(*array.Timestamp).TimestampValues(), []arrow.Timestamp{1, 2}) {
+		t.Fatal("wrong timestamp values")
+	}
+	if !reflect.DeepEqual(rec.Column(1).(*array.Float64).Float64Values(), []float64{1.5, 2}) {
+		t.Fatal("integer value should be appended to float column")
+	}
+	if rec.Column(2).NullN() != 1 {
+		t.Fatal("nil value should be appended as null")
+	}
+
+	if err := executor.AppendArrowValue(b.Field(2), "x"); err == nil || !errno.Equal(err, errno.DtypeNotMatch) {
+		t.Fatal("expect DtypeNotMatch error")
+	}
+	if _, err := executor.ArrowDataType(influxql.Duration); err == nil || !errno.Equal(err, errno.DtypeNotSupport) {
+		t.Fatal("expect DtypeNotSupport error")
+	}
+}
@@ -234,6 +234,7 @@ func (c *ColumnImpl) Marshal(buf []byte) ([]byte, error) {
 	buf = codec.AppendInt(buf, int(c.dataType))
 	buf = codec.AppendFloat64Slice(buf, c.floatValues)
 	buf = codec.AppendInt64Slice(buf, c.integerValues)
+	buf = codec.AppendUint64Slice(buf, c.unsignedValues)
 	buf = codec.AppendBytes(buf, c.stringBytes)
 	buf = codec.AppendUint32Slice(buf, c.offset)
 	buf = codec.AppendBoolSlice(buf, c.booleanValues)
@@ -272,6 +273,7 @@ func (c *ColumnImpl) Unmarshal(buf []byte) error {
 	c.dataType = influxql.DataType(dec.Int())
 	c.floatValues = dec.Float64Slice()
 	c.integerValues = dec.Int64Slice()
+	c.unsignedValues = dec.Uint64Slice()
 	c.stringBytes = dec.Bytes()
 	c.offset = dec.Uint32Slice()
 	c.booleanValues = dec.BoolSlice()
@@ -313,6 +315,7 @@ func (c *ColumnImpl) Size() int {
 	size += codec.SizeOfInt()
 	size += codec.SizeOfFloat64Slice(c.floatValues)
 	size += codec.SizeOfInt64Slice(c.integerValues)
+	size += codec.SizeOfUint64Slice(c.unsignedValues)
 	size += codec.SizeOfByteSlice(c.stringBytes)
 	size += codec.SizeOfUint32Slice(c.offset)
 	size += codec.SizeOfBoolSlice(c.booleanValues)
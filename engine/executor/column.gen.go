@@ -78,6 +78,13 @@ type Column interface {
 	SetIntegerValues([]int64)
 	UpdateIntegerValueFast(v int64, row int)
 
+	UnsignedValue(int) uint64
+	UnsignedValues() []uint64
+	AppendUnsignedValue(uint64)
+	AppendUnsignedValues([]uint64)
+	SetUnsignedValues([]uint64)
+	UpdateUnsignedValueFast(v uint64, row int)
+
 	StringValue(int) string
 	StringValuesV2(dst []string) []string
 	StringValuesRange(dst []string, start, end int) []string
@@ -118,15 +125,16 @@ func NewfloatTuple(tuple []float64) *floatTuple {
 }
 
 type ColumnImpl struct {
-	dataType      influxql.DataType
-	floatValues   []float64
-	floatTuples   []floatTuple
-	integerValues []int64
-	stringBytes   []byte
-	offset        []uint32
-	booleanValues []bool
-	times         []int64
-	nilsV2        *Bitmap
+	dataType       influxql.DataType
+	floatValues    []float64
+	floatTuples    []floatTuple
+	integerValues  []int64
+	unsignedValues []uint64
+	stringBytes    []byte
+	offset         []uint32
+	booleanValues  []bool
+	times          []int64
+	nilsV2         *Bitmap
 }
 
 func NewColumnImpl(dataType influxql.DataType) *ColumnImpl {
@@ -248,6 +256,7 @@ func (c *ColumnImpl) Reset() {
 	c.floatValues = c.floatValues[:0]
 	c.floatTuples = c.floatTuples[:0]
 	c.integerValues = c.integerValues[:0]
+	c.unsignedValues = c.unsignedValues[:0]
 	c.stringBytes = c.stringBytes[:0]
 	c.offset = c.offset[:0]
 	c.booleanValues = c.booleanValues[:0]
@@ -323,6 +332,30 @@ func (c *ColumnImpl) UpdateIntegerValueFast(v int64, row int) {
 	c.integerValues[row] = v
 }
 
+func (c *ColumnImpl) UnsignedValue(idx int) uint64 {
+	return c.unsignedValues[idx]
+}
+
+func (c *ColumnImpl) UnsignedValues() []uint64 {
+	return c.unsignedValues
+}
+
+func (c *ColumnImpl) AppendUnsignedValue(value uint64) {
+	c.unsignedValues = append(c.unsignedValues, value)
+}
+
+func (c *ColumnImpl) AppendUnsignedValues(values []uint64) {
+	c.unsignedValues = append(c.unsignedValues, values...)
+}
+
+func (c *ColumnImpl) SetUnsignedValues(values []uint64) {
+	c.unsignedValues = values
+}
+
+func (c *ColumnImpl) UpdateUnsignedValueFast(v uint64, row int) {
+	c.unsignedValues[row] = v
+}
+
 // String type
 
 func (c *ColumnImpl) StringValue(idx int) string {
@@ -509,6 +542,13 @@ func (c *ColumnImpl) CheckColumn(length int) {
 		if c.NilCount()+len(c.integerValues) != length {
 			panic("Row in chunk check failed: the number of the data(include nil data) doesn't fit chunk length!")
 		}
+	case influxql.Unsigned:
+		if len(c.integerValues) != 0 || len(c.floatValues) != 0 || len(c.stringBytes) != 0 || len(c.booleanValues) != 0 {
+			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be uint64!")
+		}
+		if c.NilCount()+len(c.unsignedValues) != length {
+			panic("Row in chunk check failed: the number of the data(include nil data) doesn't fit chunk length!")
+		}
 	case influxql.Boolean:
 		if len(c.integerValues) != 0 || len(c.stringBytes) != 0 || len(c.floatValues) != 0 {
 			panic("Row in chunk check failed: it has wrong datatype, the row's dataType should be boolean!")
@@ -236,6 +236,36 @@ func (f *IntegerNullFillProcessor) fillAppendFunc(input, output, prev Chunk, fil
 	}
 }
 
+type UnsignedNullFillProcessor struct {
+	inOrdinal  int
+	outOrdinal int
+}
+
+func NewUnsignedNullFillProcessor(inOrdinal, outOrdinal int) *UnsignedNullFillProcessor {
+	return &UnsignedNullFillProcessor{
+		inOrdinal:  inOrdinal,
+		outOrdinal: outOrdinal,
+	}
+}
+
+func (f *UnsignedNullFillProcessor) fillHelperFunc(input, output, prev Chunk, fillItem *FillItem, prevWindow *prevWindow) {
+	// append nil value
+	output.Column(f.outOrdinal).AppendNil()
+}
+
+func (f *UnsignedNullFillProcessor) fillAppendFunc(input, output, prev Chunk, fillItem *FillItem, prevWindow *prevWindow) {
+	if !input.Column(f.inOrdinal).IsNilV2(fillItem.currIndex) {
+		valueIndex := input.Column(f.inOrdinal).GetValueIndexV2(fillItem.currIndex)
+		output.Column(f.outOrdinal).AppendUnsignedValue(input.Column(f.inOrdinal).UnsignedValue(valueIndex))
+		if input.Column(f.inOrdinal).ColumnTimes() != nil {
+			output.Column(f.outOrdinal).AppendColumnTime(input.Column(f.inOrdinal).ColumnTime(valueIndex))
+		}
+		output.Column(f.outOrdinal).AppendNotNil()
+	} else {
+		f.fillHelperFunc(input, output, prev, fillItem, prevWindow)
+	}
+}
+
 type StringNullFillProcessor struct {
 	inOrdinal  int
 	outOrdinal int
@@ -358,6 +388,37 @@ func (f *IntegerNumberFillProcessor) fillAppendFunc(input, output, prev Chunk, f
 	}
 }
 
+type UnsignedNumberFillProcessor struct {
+	inOrdinal  int
+	outOrdinal int
+}
+
+func NewUnsignedNumberFillProcessor(inOrdinal, outOrdinal int) *UnsignedNumberFillProcessor {
+	return &UnsignedNumberFillProcessor{
+		inOrdinal:  inOrdinal,
+		outOrdinal: outOrdinal,
+	}
+}
+
+func (f *UnsignedNumberFillProcessor) fillHelperFunc(input, output, prev Chunk, fillItem *FillItem, prevWindow *prevWindow) {
+	value, _ := hybridqp.TransToUnsigned(fillItem.fillValue)
+	output.Column(f.outOrdinal).AppendUnsignedValue(value)
+	output.Column(f.outOrdinal).AppendNotNil()
+}
+
+func (f *UnsignedNumberFillProcessor) fillAppendFunc(input, output, prev Chunk, fillItem *FillItem, prevWindow *prevWindow) {
+	if !input.Column(f.inOrdinal).IsNilV2(fillItem.currIndex) {
+		valueIndex := input.Column(f.inOrdinal).GetValueIndexV2(fillItem.currIndex)
+		output.Column(f.outOrdinal).AppendUnsignedValue(input.Column(f.inOrdinal).UnsignedValue(valueIndex))
+		if input.Column(f.inOrdinal).ColumnTimes() != nil {
+			output.Column(f.outOrdinal).AppendColumnTime(input.Column(f.inOrdinal).ColumnTime(valueIndex))
+		}
+		output.Column(f.outOrdinal).AppendNotNil()
+	} else {
+		f.fillHelperFunc(input, output, prev, fillItem, prevWindow)
+	}
+}
+
 type StringNumberFillProcessor struct {
 	inOrdinal  int
 	outOrdinal int
@@ -498,6 +559,45 @@ func (f *IntegerPreviousFillProcessor) fillAppendFunc(input, output, prev Chunk,
 	}
 }
 
+type UnsignedPreviousFillProcessor struct {
+	inOrdinal  int
+	outOrdinal int
+}
+
+func NewUnsignedPreviousFillProcessor(inOrdinal, outOrdinal int) *UnsignedPreviousFillProcessor {
+	return &UnsignedPreviousFillProcessor{
+		inOrdinal:  inOrdinal,
+		outOrdinal: outOrdinal,
+	}
+}
+
+func (f *UnsignedPreviousFillProcessor) fillHelperFunc(input, output, prev Chunk, fillItem *FillItem, prevWindow *prevWindow) {
+	if prev != nil && !prev.Column(f.inOrdinal).IsNilV2(fillItem.prevReadAt) {
+		valueIndex := prev.Column(f.inOrdinal).GetValueIndexV2(fillItem.prevReadAt)
+		output.Column(f.outOrdinal).AppendUnsignedValue(prev.Column(f.inOrdinal).UnsignedValue(valueIndex))
+		output.Column(f.outOrdinal).AppendNotNil()
+	} else if prevWindow.value != nil && !prevWindow.nil[f.inOrdinal] {
+		output.Column(f.outOrdinal).AppendUnsignedValue(prevWindow.value[f.inOrdinal].(uint64))
+		output.Column(f.outOrdinal).AppendNotNil()
+	} else {
+		// append nil value
+		output.Column(f.outOrdinal).AppendNil()
+	}
+}
+
+func (f *UnsignedPreviousFillProcessor) fillAppendFunc(input, output, prev Chunk, fillItem *FillItem, prevWindow *prevWindow) {
+	if !input.Column(f.inOrdinal).IsNilV2(fillItem.currIndex) {
+		valueIndex := input.Column(f.inOrdinal).GetValueIndexV2(fillItem.currIndex)
+		output.Column(f.outOrdinal).AppendUnsignedValue(input.Column(f.inOrdinal).UnsignedValue(valueIndex))
+		if input.Column(f.inOrdinal).ColumnTimes() != nil {
+			output.Column(f.outOrdinal).AppendColumnTime(input.Column(f.inOrdinal).ColumnTime(valueIndex))
+		}
+		output.Column(f.outOrdinal).AppendNotNil()
+	} else {
+		f.fillHelperFunc(input, output, prev, fillItem, prevWindow)
+	}
+}
+
 type StringPreviousFillProcessor struct {
 	inOrdinal  int
 	outOrdinal int
@@ -883,6 +883,8 @@ func NewPreviousFillProcessor(rowDataType hybridqp.RowDataType) []FillProcessor
 		switch f.Expr.(*influxql.VarRef).Type {
 		case influxql.Integer:
 			fillProcessor[i] = NewIntegerPreviousFillProcessor(i, i)
+		case influxql.Unsigned:
+			fillProcessor[i] = NewUnsignedPreviousFillProcessor(i, i)
 		case influxql.Float:
 			fillProcessor[i] = NewFloatPreviousFillProcessor(i, i)
 		case influxql.String, influxql.Tag:
@@ -907,6 +909,8 @@ func NewNullFillProcessor(rowDataType hybridqp.RowDataType, schema *QuerySchema)
 			} else {
 				fillProcessor[i] = NewIntegerNullFillProcessor(i, i)
 			}
+		case influxql.Unsigned:
+			fillProcessor[i] = NewUnsignedNullFillProcessor(i, i)
 		case influxql.Float:
 			fillProcessor[i] = NewFloatNullFillProcessor(i, i)
 		case influxql.String, influxql.Tag:
@@ -927,6 +931,8 @@ func NewNumberFillProcessor(rowDataType hybridqp.RowDataType) []FillProcessor {
 		switch f.Expr.(*influxql.VarRef).Type {
 		case influxql.Integer:
 			fillProcessor[i] = NewIntegerNumberFillProcessor(i, i)
+		case influxql.Unsigned:
+			fillProcessor[i] = NewUnsignedNumberFillProcessor(i, i)
 		case influxql.Float:
 			fillProcessor[i] = NewFloatNumberFillProcessor(i, i)
 		case influxql.String, influxql.Tag:
@@ -979,6 +985,9 @@ func NewPrevWindowFunc(rowDataType hybridqp.RowDataType) ([]func(prev Chunk, win
 		case influxql.Integer:
 			appendFunc[i] = appendIntegerPrevWindowFunc
 			updateFunc[i] = updateIntegerPrevWindowFunc
+		case influxql.Unsigned:
+			appendFunc[i] = appendUnsignedPrevWindowFunc
+			updateFunc[i] = updateUnsignedPrevWindowFunc
 		case influxql.Float:
 			appendFunc[i] = appendFloatPrevWindowFunc
 			updateFunc[i] = updateFloatPrevWindowFunc
@@ -1000,6 +1009,13 @@ func appendIntegerPrevWindowFunc(prev Chunk, window *prevWindow, ordinal int) {
 	}
 }
 
+func appendUnsignedPrevWindowFunc(prev Chunk, window *prevWindow, ordinal int) {
+	if !window.nil[ordinal] {
+		prev.Column(ordinal).AppendUnsignedValue(window.value[ordinal].(uint64))
+		prev.Column(ordinal).AppendNotNil()
+	}
+}
+
 func appendFloatPrevWindowFunc(prev Chunk, window *prevWindow, ordinal int) {
 	if !window.nil[ordinal] {
 		prev.Column(ordinal).AppendFloatValue(window.value[ordinal].(float64))
@@ -1037,6 +1053,22 @@ func updateIntegerPrevWindowFunc(input Chunk, window *prevWindow, prevValues []i
 	}
 }
 
+func updateUnsignedPrevWindowFunc(input Chunk, window *prevWindow, prevValues []interface{}, ordinal int) {
+	if input.Column(ordinal).IsNilV2(input.Len() - 1) {
+		if prevValues[ordinal] != nil {
+			window.value[ordinal] = prevValues[ordinal]
+			window.nil[ordinal] = false
+		} else {
+			window.value[ordinal] = nil
+			window.nil[ordinal] = true
+		}
+	} else {
+		inCol := input.Column(ordinal)
+		window.value[ordinal] = inCol.UnsignedValue(inCol.GetValueIndexV2(input.Len() - 1))
+		window.nil[ordinal] = false
+	}
+}
+
 func updateFloatPrevWindowFunc(input Chunk, window *prevWindow, prevValues []interface{}, ordinal int) {
 	if input.Column(ordinal).IsNilV2(input.Len() - 1) {
 		if prevValues[ordinal] != nil {
@@ -1092,6 +1124,8 @@ func NewPrevValuesFunc(rowDataType hybridqp.RowDataType) []func(prev Chunk, prev
 		switch dataType {
 		case influxql.Integer:
 			updateFunc[i] = updateIntegerPrevValuesFunc
+		case influxql.Unsigned:
+			updateFunc[i] = updateUnsignedPrevValuesFunc
 		case influxql.Float:
 			updateFunc[i] = updateFloatPrevValuesFunc
 		case influxql.String, influxql.Tag:
@@ -1110,6 +1144,13 @@ func updateIntegerPrevValuesFunc(prev Chunk, prevValues []interface{}, ordinal i
 	}
 }
 
+func updateUnsignedPrevValuesFunc(prev Chunk, prevValues []interface{}, ordinal int) {
+	numOfRows := len(prev.Column(ordinal).UnsignedValues())
+	if numOfRows > 0 {
+		prevValues[ordinal] = prev.Column(ordinal).UnsignedValues()[numOfRows-1]
+	}
+}
+
 func updateFloatPrevValuesFunc(prev Chunk, prevValues []interface{}, ordinal int) {
 	numOfRows := len(prev.Column(ordinal).FloatValues())
 	if numOfRows > 0 {
@@ -625,6 +625,11 @@ func (trans *FullJoinTransform) appendNilSeriesVal(oDataType influxql.DataType,
 			var val int64 = 0
 			ocolumn.AppendIntegerValue(val)
 		}
+	case influxql.Unsigned:
+		{
+			var val uint64 = 0
+			ocolumn.AppendUnsignedValue(val)
+		}
 	case influxql.String:
 		{
 			val := ""
@@ -665,6 +670,11 @@ func (trans *FullJoinTransform) appendSeriesVal(oDataType influxql.DataType, i i
 			val := column.IntegerValue(startIndex)
 			ocolumn.AppendIntegerValue(val)
 		}
+	case influxql.Unsigned:
+		{
+			val := column.UnsignedValue(startIndex)
+			ocolumn.AppendUnsignedValue(val)
+		}
 	case influxql.String:
 		{
 			val := column.StringValue(startIndex)
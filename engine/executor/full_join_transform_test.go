@@ -16,6 +16,7 @@ package executor_test
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/openGemini/openGemini/engine/executor"
@@ -402,3 +403,44 @@ func TestFullJoinTransformDemo4(t *testing.T) {
 	_, err := executor.NewFullJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
 	assert.NotEqual(t, err, nil)
 }
+
+func buildUnsignedFullJoinChunk(name string, times []int64, values []uint64) executor.Chunk {
+	rowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "val0", Type: influxql.Unsigned})
+	b := executor.NewChunkBuilder(rowDataType)
+	chunk := b.NewChunk(name)
+	chunk.AppendTimes(times)
+	chunk.AddTagAndIndex(*ParseChunkTags("tag1=tag1val"), 0)
+	chunk.AddIntervalIndex(0)
+	chunk.Column(0).AppendUnsignedValues(values)
+	chunk.Column(0).AppendColumnTimes(times)
+	chunk.Column(0).AppendManyNotNil(len(values))
+	return chunk
+}
+
+func TestFullJoinTransformUnsigned(t *testing.T) {
+	chunk1 := buildUnsignedFullJoinChunk("m1", []int64{1, 2}, []uint64{1, math.MaxUint64})
+	chunk2 := buildUnsignedFullJoinChunk("m2", []int64{1, 2, 3}, []uint64{4, 5, 6})
+	outputRowDataType := hybridqp.NewRowDataTypeImpl(influxql.VarRef{Val: "val0", Type: influxql.Unsigned})
+	source1 := NewSourceFromMultiChunk(chunk1.RowDataType(), []executor.Chunk{chunk1})
+	source2 := NewSourceFromMultiChunk(chunk2.RowDataType(), []executor.Chunk{chunk2})
+	inRowDataTypes := []hybridqp.RowDataType{source1.Output.RowDataType, source2.Output.RowDataType}
+
+	opt := query.ProcessorOptions{ChunkSize: 1024, ChunkedSize: 10000, Dimensions: []string{"tag1"}}
+	schema := executor.NewQuerySchema(nil, nil, &opt, nil)
+	schema.Mapping()[&influxql.VarRef{Val: "m1.field1", Type: influxql.Unsigned}] = influxql.VarRef{Val: "val0", Type: influxql.Unsigned}
+	trans, err := executor.NewFullJoinTransform(inRowDataTypes, outputRowDataType, buildJoinCase(), schema)
+	assert.NoError(t, err)
+
+	var values []uint64
+	sink := NewSinkFromFunction(outputRowDataType, func(chunk executor.Chunk) error {
+		values = append(values, chunk.Column(0).UnsignedValues()...)
+		return nil
+	})
+	executor.Connect(source1.Output, trans.GetInputs()[0])
+	executor.Connect(source2.Output, trans.GetInputs()[1])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	assert.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	assert.Equal(t, []uint64{1, math.MaxUint64, 0}, values)
+}
@@ -143,6 +143,56 @@ func (m *HashMergeIntegerColumn) SetOutPut(col Column) {
 	}
 }
 
+type HashMergeUnsignedColumn struct {
+	values  []uint64
+	nils    []bool
+	oLoc    int
+	oValLoc int
+}
+
+func NewHashMergeUnsignedColumn() HashMergeColumn {
+	return &HashMergeUnsignedColumn{
+		values:  make([]uint64, 0),
+		nils:    make([]bool, 0),
+		oLoc:    0,
+		oValLoc: 0,
+	}
+}
+
+func (m *HashMergeUnsignedColumn) AppendValues(col Column, start int, end int) {
+	srcPoints := end - start
+	valueStart, valueEnd := start, end
+	if col.NilCount() != 0 {
+		valueStart, valueEnd = col.GetRangeValueIndexV2(start, end)
+	}
+	dstPoints := valueEnd - valueStart
+	if dstPoints == srcPoints {
+		for ; start < end; start++ {
+			m.nils = append(m.nils, true)
+		}
+		return
+	}
+	for ; start < end; start++ {
+		if col.IsNilV2(start) {
+			m.nils = append(m.nils, false)
+		} else {
+			m.nils = append(m.nils, true)
+		}
+	}
+}
+
+func (m *HashMergeUnsignedColumn) SetOutPut(col Column) {
+	if m.nils[m.oLoc] {
+		col.AppendNotNil()
+		col.AppendUnsignedValue(m.values[m.oValLoc])
+		m.oLoc++
+		m.oValLoc++
+	} else {
+		col.AppendNil()
+		m.oLoc++
+	}
+}
+
 type HashMergeStringColumn struct {
 	values  []string
 	nils    []bool
@@ -87,7 +87,8 @@ func (r *LimitPushdownToExchangeRule) OnMatch(call *OptRuleCall) {
 		return
 	}
 
-	if exchange.Schema().HasCall() {
+	// window functions need all the rows of a partition before the limit
+	if exchange.Schema().HasCall() || exchange.Schema().HasWindowCall() {
 		return
 	}
 
@@ -167,7 +168,8 @@ func (r *LimitPushdownToReaderRule) OnMatch(call *OptRuleCall) {
 		return
 	}
 
-	if reader.Schema().HasCall() {
+	// window functions need all the rows of a partition before the limit
+	if reader.Schema().HasCall() || reader.Schema().HasWindowCall() {
 		return
 	}
 
@@ -398,7 +400,7 @@ func (r *AggPushdownToReaderRule) OnMatch(call *OptRuleCall) {
 	}
 
 	canSlidingWindowPushDown := reader.Schema().HasSlidingWindowCall() && sysconfig.GetEnableSlidingWindowPushUp() != sysconfig.OnSlidingWindowPushUp
-	if !reader.Schema().CanCallsPushdown() || (!reader.Schema().HasPercentileOGSketch() && !canSlidingWindowPushDown) {
+	if !reader.Schema().CanCallsPushdown() || (!reader.Schema().HasCompositeCall() && !canSlidingWindowPushDown) {
 		return
 	}
 
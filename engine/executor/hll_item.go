@@ -0,0 +1,207 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/openGemini/openGemini/lib/hll"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"go.uber.org/zap"
+)
+
+// The items of approx_count_distinct. They follow the composite call of percentile_ogsketch:
+// hll_insert builds the sketch of the raw values on the store, hll_merge merges the sketches
+// of the shards and hll_count merges the sketches of the stores and estimates the count.
+// The sketch of a window is carried in one FloatTuple, so unlike the ogsketch one row is
+// written for each window.
+
+// hllInsertValues adds the values of column in [start, end) to the sketch.
+func hllInsertValues(sketch *hll.Sketch, column Column, dataType influxql.DataType, start, end int, buf []byte) []byte {
+	switch dataType {
+	case influxql.Float:
+		for _, v := range column.FloatValues()[start:end] {
+			buf = binary.BigEndian.AppendUint64(buf[:0], math.Float64bits(v))
+			sketch.Add(buf)
+		}
+	case influxql.Integer:
+		for _, v := range column.IntegerValues()[start:end] {
+			buf = binary.BigEndian.AppendUint64(buf[:0], uint64(v))
+			sketch.Add(buf)
+		}
+	case influxql.Unsigned:
+		for _, v := range column.UnsignedValues()[start:end] {
+			buf = binary.BigEndian.AppendUint64(buf[:0], v)
+			sketch.Add(buf)
+		}
+	case influxql.Boolean:
+		for _, v := range column.BooleanValues()[start:end] {
+			if v {
+				sketch.Add([]byte{1})
+			} else {
+				sketch.Add([]byte{0})
+			}
+		}
+	case influxql.String, influxql.Tag:
+		for i := start; i < end; i++ {
+			sketch.AddString(column.StringValue(i))
+		}
+	}
+	return buf
+}
+
+func hllMergeTuples(sketch *hll.Sketch, tuples []floatTuple) {
+	for i := range tuples {
+		if err := sketch.MergeFloats(tuples[i].values); err != nil {
+			logger.GetLogger().Warn("skip the invalid hll sketch", zap.Error(err))
+		}
+	}
+}
+
+func writeHLLSketch(sketch *hll.Sketch, isSingleCall bool, outChunk Chunk, outOrdinal int, time int64) {
+	if isSingleCall {
+		outChunk.AppendTime(time)
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outColumn := outChunk.Column(outOrdinal)
+	outColumn.AppendFloatTuple(floatTuple{values: sketch.AppendFloats(nil)})
+	outColumn.AppendNotNil()
+}
+
+type HLLInsertItem struct {
+	isSingleCall bool
+	inOrdinal    int
+	outOrdinal   int
+	dataType     influxql.DataType
+	rows         int
+	sketch       *hll.Sketch
+	buf          []byte
+}
+
+func NewHLLInsertItem(isSingleCall bool, inOrdinal, outOrdinal int, dataType influxql.DataType) *HLLInsertItem {
+	return &HLLInsertItem{
+		isSingleCall: isSingleCall,
+		inOrdinal:    inOrdinal,
+		outOrdinal:   outOrdinal,
+		dataType:     dataType,
+		sketch:       hll.NewDefault(),
+	}
+}
+
+func (o *HLLInsertItem) UpdateCluster(inChunk Chunk, start, end int) {
+	o.buf = hllInsertValues(o.sketch, inChunk.Column(o.inOrdinal), o.dataType, start, end, o.buf)
+	o.rows += end - start
+}
+
+func (o *HLLInsertItem) WriteResult(outChunk Chunk, time int64) {
+	writeHLLSketch(o.sketch, o.isSingleCall, outChunk, o.outOrdinal, time)
+}
+
+func (o *HLLInsertItem) IsNil() bool {
+	return o.rows == 0
+}
+
+func (o *HLLInsertItem) Reset() {
+	o.sketch.Reset()
+	o.rows = 0
+}
+
+type HLLMergeItem struct {
+	isSingleCall bool
+	inOrdinal    int
+	outOrdinal   int
+	rows         int
+	sketch       *hll.Sketch
+}
+
+func NewHLLMergeItem(isSingleCall bool, inOrdinal, outOrdinal int) *HLLMergeItem {
+	return &HLLMergeItem{
+		isSingleCall: isSingleCall,
+		inOrdinal:    inOrdinal,
+		outOrdinal:   outOrdinal,
+		sketch:       hll.NewDefault(),
+	}
+}
+
+func (o *HLLMergeItem) UpdateCluster(inChunk Chunk, start, end int) {
+	hllMergeTuples(o.sketch, inChunk.Column(o.inOrdinal).FloatTuples()[start:end])
+	o.rows += end - start
+}
+
+func (o *HLLMergeItem) WriteResult(outChunk Chunk, time int64) {
+	writeHLLSketch(o.sketch, o.isSingleCall, outChunk, o.outOrdinal, time)
+}
+
+func (o *HLLMergeItem) IsNil() bool {
+	return o.rows == 0
+}
+
+func (o *HLLMergeItem) Reset() {
+	o.sketch.Reset()
+	o.rows = 0
+}
+
+// HLLCountItem estimates the count of distinct values. The input is either the sketches
+// written by hll_insert or hll_merge, or the raw values when the query is not distributed.
+type HLLCountItem struct {
+	isSingleCall bool
+	inOrdinal    int
+	outOrdinal   int
+	dataType     influxql.DataType
+	rows         int
+	sketch       *hll.Sketch
+	buf          []byte
+}
+
+func NewHLLCountItem(isSingleCall bool, inOrdinal, outOrdinal int, dataType influxql.DataType) *HLLCountItem {
+	return &HLLCountItem{
+		isSingleCall: isSingleCall,
+		inOrdinal:    inOrdinal,
+		outOrdinal:   outOrdinal,
+		dataType:     dataType,
+		sketch:       hll.NewDefault(),
+	}
+}
+
+func (o *HLLCountItem) UpdateCluster(inChunk Chunk, start, end int) {
+	column := inChunk.Column(o.inOrdinal)
+	if o.dataType == influxql.FloatTuple {
+		hllMergeTuples(o.sketch, column.FloatTuples()[start:end])
+	} else {
+		o.buf = hllInsertValues(o.sketch, column, o.dataType, start, end, o.buf)
+	}
+	o.rows += end - start
+}
+
+func (o *HLLCountItem) WriteResult(outChunk Chunk, time int64) {
+	if o.isSingleCall {
+		outChunk.AppendTime(time)
+		outChunk.AppendIntervalIndex(outChunk.Len() - 1)
+	}
+	outColumn := outChunk.Column(o.outOrdinal)
+	outColumn.AppendIntegerValue(int64(o.sketch.Count()))
+	outColumn.AppendNotNil()
+}
+
+func (o *HLLCountItem) IsNil() bool {
+	return o.rows == 0
+}
+
+func (o *HLLCountItem) Reset() {
+	o.sketch.Reset()
+	o.rows = 0
+}
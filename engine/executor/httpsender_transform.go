@@ -329,8 +329,9 @@ func (w *HttpChunkSender) GetRows(chunk Chunk) models.Rows {
 
 func (w *HttpChunkSender) sendRows(rows models.Rows, partial bool) {
 	rc := query.RowsChan{
-		Rows:    rows,
-		Partial: partial,
+		Rows:        rows,
+		Partial:     partial,
+		ColumnTypes: w.RowChunk.ColumnTypes(),
 	}
 
 	if w.opt.AbortChan == nil {
@@ -357,6 +358,7 @@ type RowChunk struct {
 
 	Series     []*Row
 	ColumnName []string
+	ColumnType []influxql.DataType
 }
 
 type Row struct {
@@ -370,6 +372,10 @@ func (r *RowChunk) RowsInit(c Chunk) {
 		for i, f := range c.RowDataType().Fields() {
 			r.ColumnName[i] = f.Name() // TODO....
 		}
+		r.ColumnType = make([]influxql.DataType, c.NumberOfCols())
+		for i, col := range c.Columns() {
+			r.ColumnType[i] = col.DataType()
+		}
 	}
 	r.Name = c.Name()
 	r.Tags = c.Tags()
@@ -383,6 +389,14 @@ func (r *RowChunk) RowsInit(c Chunk) {
 	}
 }
 
+// ColumnTypes returns the data types of the columns of the generated rows, including the leading time column.
+func (r *RowChunk) ColumnTypes() []influxql.DataType {
+	if len(r.ColumnType) == 0 {
+		return nil
+	}
+	return append([]influxql.DataType{influxql.Time}, r.ColumnType...)
+}
+
 func (r *RowChunk) RowsGen(c Chunk) []*Row {
 	var start, end int
 	index := 0
@@ -545,6 +559,8 @@ func (g *RowsGenerator) GetColValue(col2 Column, idx int) interface{} {
 		return col.FloatValue(idx)
 	case influxql.Integer:
 		return col.IntegerValue(idx)
+	case influxql.Unsigned:
+		return col.UnsignedValue(idx)
 	case influxql.Boolean:
 		return col.BooleanValue(idx)
 	case influxql.String, influxql.Tag:
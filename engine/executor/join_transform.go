@@ -0,0 +1,621 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+const (
+	innerJoinTransformName      = "InnerJoinTransform"
+	leftOuterJoinTransformName  = "LeftOuterJoinTransform"
+	rightOuterJoinTransformName = "RightOuterJoinTransform"
+	asofJoinTransformName       = "AsofJoinTransform"
+)
+
+const (
+	joinLeft  = 0
+	joinRight = 1
+)
+
+type SortMergeJoinTransformCreator struct {
+}
+
+func (c *SortMergeJoinTransformCreator) Create(plan LogicalPlan, _ *query.ProcessorOptions) (Processor, error) {
+	join, ok := plan.(*LogicalSortMergeJoin)
+	if !ok {
+		return nil, fmt.Errorf("%v is not a LogicalSortMergeJoin", plan)
+	}
+	inRowDataTypes := make([]hybridqp.RowDataType, 0, len(plan.Children()))
+	for _, inPlan := range plan.Children() {
+		inRowDataTypes = append(inRowDataTypes, inPlan.RowDataType())
+	}
+	schema := plan.Schema().(*QuerySchema)
+	joinCase := schema.joinCases[0]
+	switch join.joinType {
+	case influxql.InnerJoin:
+		return NewInnerJoinTransform(inRowDataTypes, plan.RowDataType(), joinCase, schema)
+	case influxql.LeftOuterJoin:
+		return NewLeftOuterJoinTransform(inRowDataTypes, plan.RowDataType(), joinCase, schema)
+	case influxql.RightOuterJoin:
+		return NewRightOuterJoinTransform(inRowDataTypes, plan.RowDataType(), joinCase, schema)
+	case influxql.AsofJoin:
+		return NewAsofJoinTransform(inRowDataTypes, plan.RowDataType(), joinCase, schema)
+	default:
+		return nil, fmt.Errorf("unsupported join type: %s", join.joinType)
+	}
+}
+
+var _ = RegistryTransformCreator(&LogicalSortMergeJoin{}, &SortMergeJoinTransformCreator{})
+
+// InnerJoinTransform outputs the rows whose series keys satisfy the join condition
+// and whose timestamps exist on both sides.
+type InnerJoinTransform struct {
+	sortMergeJoinTransform
+}
+
+func NewInnerJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType hybridqp.RowDataType,
+	joinCase *influxql.Join, schema *QuerySchema) (*InnerJoinTransform, error) {
+	trans := &InnerJoinTransform{}
+	if err := trans.init(inRowDataTypes, outRowDataType, joinCase, schema, innerJoinTransformName, false, false); err != nil {
+		return nil, err
+	}
+	return trans, nil
+}
+
+func (trans *InnerJoinTransform) Name() string {
+	return innerJoinTransformName
+}
+
+// LeftOuterJoinTransform outputs every row of the left input, the columns of
+// the right input are null when there is no matching row.
+type LeftOuterJoinTransform struct {
+	sortMergeJoinTransform
+}
+
+func NewLeftOuterJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType hybridqp.RowDataType,
+	joinCase *influxql.Join, schema *QuerySchema) (*LeftOuterJoinTransform, error) {
+	trans := &LeftOuterJoinTransform{}
+	if err := trans.init(inRowDataTypes, outRowDataType, joinCase, schema, leftOuterJoinTransformName, true, false); err != nil {
+		return nil, err
+	}
+	return trans, nil
+}
+
+func (trans *LeftOuterJoinTransform) Name() string {
+	return leftOuterJoinTransformName
+}
+
+// RightOuterJoinTransform outputs every row of the right input, the columns of
+// the left input are null when there is no matching row.
+type RightOuterJoinTransform struct {
+	sortMergeJoinTransform
+}
+
+func NewRightOuterJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType hybridqp.RowDataType,
+	joinCase *influxql.Join, schema *QuerySchema) (*RightOuterJoinTransform, error) {
+	trans := &RightOuterJoinTransform{}
+	if err := trans.init(inRowDataTypes, outRowDataType, joinCase, schema, rightOuterJoinTransformName, false, true); err != nil {
+		return nil, err
+	}
+	return trans, nil
+}
+
+func (trans *RightOuterJoinTransform) Name() string {
+	return rightOuterJoinTransformName
+}
+
+// AsofJoinTransform outputs every row of the left input paired with the latest
+// row of the right input whose time is not after it. The columns of the right
+// input are null if there is no such row or it is older than the tolerance.
+type AsofJoinTransform struct {
+	sortMergeJoinTransform
+}
+
+func NewAsofJoinTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataType hybridqp.RowDataType,
+	joinCase *influxql.Join, schema *QuerySchema) (*AsofJoinTransform, error) {
+	trans := &AsofJoinTransform{}
+	if err := trans.init(inRowDataTypes, outRowDataType, joinCase, schema, asofJoinTransformName, true, false); err != nil {
+		return nil, err
+	}
+	trans.asof = true
+	trans.tolerance = int64(joinCase.Tolerance)
+	trans.lastRight = NewChunkBuilder(inRowDataTypes[joinRight]).NewChunk("")
+	return trans, nil
+}
+
+func (trans *AsofJoinTransform) Name() string {
+	return asofJoinTransformName
+}
+
+// joinCursor walks the rows of one join input. Only the current chunk of each
+// input is held, the upstream processors reuse their chunks.
+type joinCursor struct {
+	port   *ChunkPort
+	chunk  Chunk
+	tagLoc int
+	rowLoc int
+	closed bool
+}
+
+func (c *joinCursor) nextChunk(ctx context.Context) {
+	for {
+		select {
+		case chunk, ok := <-c.port.State:
+			if !ok {
+				c.chunk, c.closed = nil, true
+				return
+			}
+			if chunk.NumberOfRows() == 0 {
+				continue
+			}
+			c.chunk, c.tagLoc, c.rowLoc = chunk, 0, 0
+			return
+		case <-ctx.Done():
+			c.chunk, c.closed = nil, true
+			return
+		}
+	}
+}
+
+func (c *joinCursor) tags() *ChunkTags {
+	return &c.chunk.Tags()[c.tagLoc]
+}
+
+// seriesEnd returns the end row of the current series in the current chunk.
+func (c *joinCursor) seriesEnd() int {
+	if c.tagLoc+1 < c.chunk.TagLen() {
+		return c.chunk.TagIndex()[c.tagLoc+1]
+	}
+	return c.chunk.NumberOfRows()
+}
+
+// seek moves the cursor to the row, the next chunk is read once the current one is done.
+func (c *joinCursor) seek(ctx context.Context, row int) {
+	c.rowLoc = row
+	if c.rowLoc >= c.chunk.NumberOfRows() {
+		c.nextChunk(ctx)
+		return
+	}
+	for c.rowLoc >= c.seriesEnd() {
+		c.tagLoc++
+	}
+}
+
+type joinColumn struct {
+	side  int
+	index int
+}
+
+// sortMergeJoinTransform joins two inputs that are both sorted by series key and time.
+// keepLeft and keepRight decide whether the unmatched rows of each side are output.
+type sortMergeJoinTransform struct {
+	BaseProcessor
+	name          string
+	keepLeft      bool
+	keepRight     bool
+	inputs        []*ChunkPort
+	cursors       [2]*joinCursor
+	output        *ChunkPort
+	outputChunk   Chunk
+	chunkPool     *CircularChunkPool
+	newName       string
+	aliases       [2]string
+	joinCondition influxql.Expr
+	joinTags      []influxql.VarRef
+	filterMap     map[string]interface{}
+	columns       []joinColumn
+	schema        *QuerySchema
+	workTracing   *tracing.Span
+	err           error // the first error when appending the values, it stops the join
+
+	// asof join keeps a copy of the latest right row, the right chunk it comes from may be reused by upstream
+	asof          bool
+	tolerance     int64
+	lastRight     Chunk
+	lastRightTags *ChunkTags
+	hasLastRight  bool
+}
+
+func (trans *sortMergeJoinTransform) init(inRowDataTypes []hybridqp.RowDataType, outRowDataType hybridqp.RowDataType,
+	joinCase *influxql.Join, schema *QuerySchema, name string, keepLeft, keepRight bool) error {
+	if len(inRowDataTypes) != 2 {
+		return errno.NewError(errno.UnsupportedConditionInJoin, joinCase.JoinType)
+	}
+	lSrc, lok := joinCase.LSrc.(*influxql.SubQuery)
+	rSrc, rok := joinCase.RSrc.(*influxql.SubQuery)
+	if !lok || !rok || lSrc.Alias == rSrc.Alias {
+		return errno.NewError(errno.UnsupportedConditionInJoin, joinCase.JoinType)
+	}
+	trans.name = name
+	trans.keepLeft = keepLeft
+	trans.keepRight = keepRight
+	trans.aliases = [2]string{lSrc.Alias, rSrc.Alias}
+	trans.newName = lSrc.Alias + "," + rSrc.Alias
+	trans.joinCondition = joinCase.Condition
+	trans.filterMap = make(map[string]interface{})
+	trans.schema = schema
+	trans.output = NewChunkPort(outRowDataType)
+	trans.chunkPool = NewCircularChunkPool(CircularChunkNum, NewChunkBuilder(outRowDataType))
+	for i := range inRowDataTypes {
+		trans.inputs = append(trans.inputs, NewChunkPort(inRowDataTypes[i]))
+		trans.cursors[i] = &joinCursor{port: trans.inputs[i]}
+	}
+	trans.outputChunk = trans.chunkPool.GetChunk()
+
+	if err := trans.initColumns(); err != nil {
+		return errno.NewError(errno.UnsupportedConditionInJoin, joinCase.JoinType)
+	}
+	if err := trans.initJoinTags(trans.joinCondition); err != nil {
+		return errno.NewError(errno.UnsupportedConditionInJoin, joinCase.JoinType)
+	}
+	if len(trans.joinTags) != 2*len(schema.opt.GetOptDimension()) {
+		return errno.NewError(errno.UnsupportedConditionInJoin, joinCase.JoinType)
+	}
+	for _, tag := range trans.joinTags {
+		trans.filterMap[tag.Val] = nil
+	}
+	return nil
+}
+
+// initColumns finds the input side and column of every output column by the alias of the subquery.
+func (trans *sortMergeJoinTransform) initColumns() error {
+	valFieldMap := make(map[string]string, len(trans.schema.mapping))
+	for k, v := range trans.schema.mapping {
+		valFieldMap[v.Val] = k.(*influxql.VarRef).Val
+	}
+	for _, outField := range trans.output.RowDataType.Fields() {
+		outVal := outField.Expr.(*influxql.VarRef).Val
+		field := valFieldMap[outVal]
+		prefix, _, ok := strings.Cut(field, ".")
+		if !ok {
+			return fmt.Errorf("unknown join field: %s", outVal)
+		}
+		side := joinLeft
+		if prefix == trans.aliases[joinRight] {
+			side = joinRight
+		} else if prefix != trans.aliases[joinLeft] {
+			return fmt.Errorf("unknown join field: %s", field)
+		}
+		index := trans.inputs[side].RowDataType.FieldIndex(outVal)
+		if index < 0 {
+			return fmt.Errorf("unknown join field: %s", field)
+		}
+		trans.columns = append(trans.columns, joinColumn{side: side, index: index})
+	}
+	return nil
+}
+
+// initJoinTags collects the tags of the join condition, only equal conditions of tags are supported.
+func (trans *sortMergeJoinTransform) initJoinTags(expr influxql.Expr) error {
+	switch expr := expr.(type) {
+	case *influxql.ParenExpr:
+		return trans.initJoinTags(expr.Expr)
+	case *influxql.BinaryExpr:
+		if expr.Op != influxql.AND && expr.Op != influxql.EQ {
+			return fmt.Errorf("unsupported operator: %s", expr.Op)
+		}
+		if err := trans.initJoinTags(expr.LHS); err != nil {
+			return err
+		}
+		return trans.initJoinTags(expr.RHS)
+	case *influxql.VarRef:
+		for _, alias := range trans.aliases {
+			for _, tag := range trans.schema.opt.GetOptDimension() {
+				if expr.Val == alias+"."+tag {
+					trans.joinTags = append(trans.joinTags, *expr)
+					return nil
+				}
+			}
+		}
+		return fmt.Errorf("unknown join tag: %s", expr.Val)
+	default:
+		return fmt.Errorf("unsupported join condition: %s", expr)
+	}
+}
+
+func (trans *sortMergeJoinTransform) Explain() []ValuePair {
+	return nil
+}
+
+func (trans *sortMergeJoinTransform) Close() {
+	trans.output.Close()
+}
+
+func (trans *sortMergeJoinTransform) Work(ctx context.Context) error {
+	span := trans.StartSpan(fmt.Sprintf("[%s] TotalWorkCost", trans.name), false)
+	trans.workTracing = tracing.Start(span, "cost_for_join", false)
+	defer func() {
+		trans.Close()
+		tracing.Finish(span, trans.workTracing)
+	}()
+
+	l, r := trans.cursors[joinLeft], trans.cursors[joinRight]
+	l.nextChunk(ctx)
+	r.nextChunk(ctx)
+	trans.outputChunk.SetName(trans.newName)
+	for !l.closed && !r.closed && trans.err == nil {
+		lEnd, rEnd := l.seriesEnd(), r.seriesEnd()
+		switch trans.joinMatch(l.tags(), r.tags()) {
+		case 0:
+			if trans.asof {
+				trans.asofJoinSeries(l, r, lEnd, rEnd)
+			} else {
+				trans.joinSeries(l, r, lEnd, rEnd)
+			}
+			l.seek(ctx, l.rowLoc)
+			r.seek(ctx, r.rowLoc)
+		case -1:
+			trans.appendSide(joinLeft, lEnd)
+			l.seek(ctx, lEnd)
+		default:
+			trans.appendSide(joinRight, rEnd)
+			r.seek(ctx, rEnd)
+		}
+		trans.sendChunk(false)
+	}
+	if trans.err != nil {
+		return trans.err
+	}
+	trans.drain(ctx, joinLeft)
+	trans.drain(ctx, joinRight)
+	if trans.err != nil {
+		return trans.err
+	}
+	trans.sendChunk(true)
+	return nil
+}
+
+// drain consumes the rest of the input after the other input is done.
+func (trans *sortMergeJoinTransform) drain(ctx context.Context, side int) {
+	c := trans.cursors[side]
+	keep := trans.keepLeft
+	if side == joinRight {
+		keep = trans.keepRight
+	}
+	for !c.closed && trans.err == nil {
+		if !keep {
+			c.nextChunk(ctx)
+			continue
+		}
+		end := c.seriesEnd()
+		trans.appendSide(side, end)
+		c.seek(ctx, end)
+		trans.sendChunk(false)
+	}
+}
+
+// joinMatch returns 0 if the series keys satisfy the join condition,
+// otherwise it returns which side is smaller by the tag values.
+func (trans *sortMergeJoinTransform) joinMatch(ltags, rtags *ChunkTags) int {
+	_, ltagvals := ltags.GetChunkTagAndValues()
+	_, rtagvals := rtags.GetChunkTagAndValues()
+	dims := trans.schema.opt.GetOptDimension()
+	for _, tag := range trans.joinTags {
+		for i, dim := range dims {
+			if tag.Val == trans.aliases[joinLeft]+"."+dim {
+				trans.filterMap[tag.Val] = ltagvals[i]
+			} else if tag.Val == trans.aliases[joinRight]+"."+dim {
+				trans.filterMap[tag.Val] = rtagvals[i]
+			}
+		}
+	}
+	valuer := influxql.ValuerEval{
+		Valuer: influxql.MultiValuer(
+			influxql.MapValuer(trans.filterMap),
+		),
+	}
+	if valuer.EvalBool(trans.joinCondition) {
+		return 0
+	}
+	if slices.Compare(ltagvals, rtagvals) <= 0 {
+		return -1
+	}
+	return 1
+}
+
+// joinSeries merges the rows of two matched series by time until one of them reaches the end.
+func (trans *sortMergeJoinTransform) joinSeries(l, r *joinCursor, lEnd, rEnd int) {
+	for l.rowLoc < lEnd && r.rowLoc < rEnd {
+		ltime := l.chunk.TimeByIndex(l.rowLoc)
+		rtime := r.chunk.TimeByIndex(r.rowLoc)
+		if ltime == rtime {
+			trans.appendRow(l.tags(), ltime, l.chunk, l.rowLoc, r.chunk, r.rowLoc)
+			l.rowLoc++
+			r.rowLoc++
+		} else if ltime < rtime {
+			if trans.keepLeft {
+				trans.appendRow(l.tags(), ltime, l.chunk, l.rowLoc, nil, -1)
+			}
+			l.rowLoc++
+		} else {
+			if trans.keepRight {
+				trans.appendRow(r.tags(), rtime, nil, -1, r.chunk, r.rowLoc)
+			}
+			r.rowLoc++
+		}
+	}
+}
+
+// asofJoinSeries pairs the rows of two matched series until one of them reaches the end.
+// A left row waits for the next right chunk if all the right rows before it are consumed,
+// because the same series may continue in the next chunk.
+func (trans *sortMergeJoinTransform) asofJoinSeries(l, r *joinCursor, lEnd, rEnd int) {
+	if trans.hasLastRight && !bytes.Equal(trans.lastRightTags.subset, r.tags().subset) {
+		trans.hasLastRight = false
+	}
+	for l.rowLoc < lEnd {
+		ltime := l.chunk.TimeByIndex(l.rowLoc)
+		for r.rowLoc < rEnd && r.chunk.TimeByIndex(r.rowLoc) <= ltime {
+			trans.keepLastRight(r)
+			r.rowLoc++
+		}
+		if r.rowLoc >= rEnd {
+			return
+		}
+		trans.appendAsofRow(l, l.rowLoc, trans.hasLastRight)
+		l.rowLoc++
+	}
+}
+
+func (trans *sortMergeJoinTransform) keepLastRight(r *joinCursor) {
+	if !trans.hasLastRight {
+		trans.lastRightTags = NewChunkTagsDeepCopy(r.tags().subset, r.tags().offsets)
+		trans.hasLastRight = true
+	}
+	time := r.chunk.TimeByIndex(r.rowLoc)
+	trans.lastRight.Reset()
+	trans.lastRight.AppendTime(time)
+	for i, column := range r.chunk.Columns() {
+		if err := trans.appendValue(trans.lastRight.Column(i), column, r.rowLoc, time); err != nil {
+			trans.err = err
+			return
+		}
+	}
+}
+
+func (trans *sortMergeJoinTransform) appendAsofRow(l *joinCursor, row int, matched bool) {
+	ltime := l.chunk.TimeByIndex(row)
+	if matched && (trans.tolerance <= 0 || ltime-trans.lastRight.TimeByIndex(0) <= trans.tolerance) {
+		trans.appendRow(l.tags(), ltime, l.chunk, row, trans.lastRight, 0)
+		return
+	}
+	trans.appendRow(l.tags(), ltime, l.chunk, row, nil, -1)
+}
+
+// appendSide outputs the rows of the unmatched series if the side is kept.
+func (trans *sortMergeJoinTransform) appendSide(side int, end int) {
+	c := trans.cursors[side]
+	if (side == joinLeft && !trans.keepLeft) || (side == joinRight && !trans.keepRight) {
+		return
+	}
+	if trans.asof {
+		// the latest right row may come from the same series in the former chunk
+		matched := trans.hasLastRight && trans.joinMatch(c.tags(), trans.lastRightTags) == 0
+		for row := c.rowLoc; row < end; row++ {
+			trans.appendAsofRow(c, row, matched)
+		}
+		return
+	}
+	for row := c.rowLoc; row < end; row++ {
+		if side == joinLeft {
+			trans.appendRow(c.tags(), c.chunk.TimeByIndex(row), c.chunk, row, nil, -1)
+		} else {
+			trans.appendRow(c.tags(), c.chunk.TimeByIndex(row), nil, -1, c.chunk, row)
+		}
+	}
+}
+
+// appendRow outputs one row, the columns of one side are null if the chunk of that side is nil.
+func (trans *sortMergeJoinTransform) appendRow(tags *ChunkTags, time int64, lchunk Chunk, lrow int, rchunk Chunk, rrow int) {
+	trans.appendSeriesKey(tags)
+	trans.outputChunk.AppendTime(time)
+	for i, col := range trans.columns {
+		ocolumn := trans.outputChunk.Column(i)
+		chunk, row := lchunk, lrow
+		if col.side == joinRight {
+			chunk, row = rchunk, rrow
+		}
+		if chunk == nil {
+			ocolumn.AppendColumnTime(time)
+			ocolumn.AppendNil()
+			continue
+		}
+		if err := trans.appendValue(ocolumn, chunk.Column(col.index), row, time); err != nil {
+			trans.err = err
+			return
+		}
+	}
+}
+
+func (trans *sortMergeJoinTransform) appendSeriesKey(tags *ChunkTags) {
+	if n := trans.outputChunk.TagLen(); n > 0 && bytes.Equal(trans.outputChunk.Tags()[n-1].subset, tags.subset) {
+		return
+	}
+	index := trans.outputChunk.NumberOfRows()
+	trans.outputChunk.AppendTagsAndIndex(*NewChunkTagsDeepCopy(tags.subset, tags.offsets), index)
+	trans.outputChunk.AppendIntervalIndex(index)
+}
+
+func (trans *sortMergeJoinTransform) appendValue(ocolumn Column, column Column, row int, time int64) error {
+	if column.IsNilV2(row) {
+		ocolumn.AppendColumnTime(time)
+		ocolumn.AppendNil()
+		return nil
+	}
+	index := column.GetValueIndexV2(row)
+	switch ocolumn.DataType() {
+	case influxql.Float:
+		ocolumn.AppendFloatValue(column.FloatValue(index))
+	case influxql.Integer:
+		ocolumn.AppendIntegerValue(column.IntegerValue(index))
+	case influxql.Unsigned:
+		ocolumn.AppendUnsignedValue(column.UnsignedValue(index))
+	case influxql.Boolean:
+		ocolumn.AppendBooleanValue(column.BooleanValue(index))
+	case influxql.String, influxql.Tag:
+		ocolumn.AppendStringValue(column.StringValue(index))
+	default:
+		return errno.NewError(errno.DtypeNotSupport)
+	}
+	ocolumn.AppendColumnTime(time)
+	ocolumn.AppendNotNil()
+	return nil
+}
+
+func (trans *sortMergeJoinTransform) sendChunk(last bool) {
+	if trans.err != nil || trans.outputChunk.Len() == 0 {
+		return
+	}
+	if !last && trans.outputChunk.Len() < trans.schema.GetOptions().ChunkSizeNum() {
+		return
+	}
+	for _, col := range trans.outputChunk.Columns() {
+		col.NilsV2().SetLen(trans.outputChunk.NumberOfRows())
+	}
+	trans.output.State <- trans.outputChunk
+	trans.outputChunk = trans.chunkPool.GetChunk()
+	trans.outputChunk.SetName(trans.newName)
+}
+
+func (trans *sortMergeJoinTransform) GetOutputs() Ports {
+	return Ports{trans.output}
+}
+
+func (trans *sortMergeJoinTransform) GetInputs() Ports {
+	ports := make(Ports, 0, len(trans.inputs))
+	for _, input := range trans.inputs {
+		ports = append(ports, input)
+	}
+	return ports
+}
+
+func (trans *sortMergeJoinTransform) GetOutputNumber(_ Port) int {
+	return 0
+}
+
+func (trans *sortMergeJoinTransform) GetInputNumber(_ Port) int {
+	return 0
+}
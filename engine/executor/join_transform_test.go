@@ -0,0 +1,276 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runJoinTransform returns every output row as "tag,time,left value,right value".
+func runJoinTransform(t *testing.T, joinType influxql.JoinType, left, right []executor.Chunk) []string {
+	joinCase := buildJoinCase()
+	joinCase.JoinType = joinType
+	return runJoinTransformWithCase(t, joinCase, left, right)
+}
+
+func runJoinTransformWithCase(t *testing.T, joinCase *influxql.Join, left, right []executor.Chunk) []string {
+	outputRowDataType := buildOutputRowDataType()
+	source1 := NewSourceFromMultiChunk(buildInRowDataType(), left)
+	source2 := NewSourceFromMultiChunk(buildInRowDataType(), right)
+	inRowDataTypes := []hybridqp.RowDataType{source1.Output.RowDataType, source2.Output.RowDataType}
+	schema := buildFullJoinSchema()
+
+	var trans executor.Processor
+	var err error
+	switch joinCase.JoinType {
+	case influxql.InnerJoin:
+		trans, err = executor.NewInnerJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
+	case influxql.LeftOuterJoin:
+		trans, err = executor.NewLeftOuterJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
+	case influxql.RightOuterJoin:
+		trans, err = executor.NewRightOuterJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
+	case influxql.AsofJoin:
+		trans, err = executor.NewAsofJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
+	}
+	require.NoError(t, err)
+
+	var rows []string
+	sink := NewSinkFromFunction(outputRowDataType, func(chunk executor.Chunk) error {
+		assert.Equal(t, "m1,m2", chunk.Name())
+		tagLoc := 0
+		for i := 0; i < chunk.NumberOfRows(); i++ {
+			if tagLoc+1 < chunk.TagLen() && chunk.TagIndex()[tagLoc+1] == i {
+				tagLoc++
+			}
+			_, tagVals := chunk.Tags()[tagLoc].GetChunkTagAndValues()
+			lval, rval := "null", "null"
+			if !chunk.Column(0).IsNilV2(i) {
+				lval = fmt.Sprint(chunk.Column(0).FloatValue(chunk.Column(0).GetValueIndexV2(i)))
+			}
+			if !chunk.Column(3).IsNilV2(i) {
+				rval = fmt.Sprint(chunk.Column(3).IntegerValue(chunk.Column(3).GetValueIndexV2(i)))
+			}
+			rows = append(rows, fmt.Sprintf("%s,%d,%s,%s", tagVals[0], chunk.TimeByIndex(i), lval, rval))
+		}
+		return nil
+	})
+	executor.Connect(source1.Output, trans.GetInputs()[0])
+	executor.Connect(source2.Output, trans.GetInputs()[1])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	return rows
+}
+
+func TestInnerJoinTransform(t *testing.T) {
+	rows := runJoinTransform(t, influxql.InnerJoin,
+		[]executor.Chunk{BuildInChunk1("m1"), BuildInChunk4("m1")},
+		[]executor.Chunk{BuildInChunk2("m2"), BuildInChunk3("m2")})
+	assert.Equal(t, []string{
+		"tag1val,1,1,1",
+		"tag1val,2,2,2",
+	}, rows)
+}
+
+func TestLeftOuterJoinTransform(t *testing.T) {
+	rows := runJoinTransform(t, influxql.LeftOuterJoin,
+		[]executor.Chunk{BuildInChunk1("m1"), BuildInChunk4("m1")},
+		[]executor.Chunk{BuildInChunk2("m2"), BuildInChunk3("m2")})
+	assert.Equal(t, []string{
+		"tag1val,1,1,1",
+		"tag1val,2,2,2",
+		"tag1val,3,3,null",
+		"tag1val2,6,6,null",
+		"tag1val2,7,7,null",
+	}, rows)
+}
+
+func TestRightOuterJoinTransform(t *testing.T) {
+	rows := runJoinTransform(t, influxql.RightOuterJoin,
+		[]executor.Chunk{BuildInChunk1("m1"), BuildInChunk4("m1")},
+		[]executor.Chunk{BuildInChunk2("m2"), BuildInChunk3("m2")})
+	assert.Equal(t, []string{
+		"tag1val,1,1,1",
+		"tag1val,2,2,2",
+		"tag1val,6,null,6",
+		"tag1val,7,null,7",
+	}, rows)
+
+	rows = runJoinTransform(t, influxql.RightOuterJoin,
+		[]executor.Chunk{BuildInChunk4("m1")},
+		[]executor.Chunk{BuildInChunk2("m2"), BuildInChunk3("m2")})
+	assert.Equal(t, []string{
+		"tag1val,1,null,1",
+		"tag1val,2,null,2",
+		"tag1val,6,null,6",
+		"tag1val,7,null,7",
+	}, rows)
+}
+
+func buildAsofJoinChunk(name string, tag string, times []int64) executor.Chunk {
+	b := executor.NewChunkBuilder(buildInRowDataType())
+	chunk := b.NewChunk(name)
+	chunk.AppendTimes(times)
+	chunk.AddTagAndIndex(*ParseChunkTags("tag1=" + tag), 0)
+	chunk.AddIntervalIndex(0)
+	for _, t := range times {
+		chunk.Column(0).AppendFloatValue(float64(t))
+		chunk.Column(1).AppendStringValue(fmt.Sprintf("f%d", t))
+		chunk.Column(2).AppendBooleanValue(true)
+		chunk.Column(3).AppendIntegerValue(t * 10)
+	}
+	for i := range chunk.Columns() {
+		chunk.Column(i).AppendColumnTimes(times)
+		chunk.Column(i).AppendManyNotNil(len(times))
+	}
+	return chunk
+}
+
+func TestAsofJoinTransform(t *testing.T) {
+	left := func() []executor.Chunk {
+		return []executor.Chunk{
+			buildAsofJoinChunk("m1", "tag1val", []int64{1, 2, 3}),
+			buildAsofJoinChunk("m1", "tag1val", []int64{6}),
+			buildAsofJoinChunk("m1", "tag1val2", []int64{6, 7}),
+		}
+	}
+	right := func() []executor.Chunk {
+		return []executor.Chunk{
+			buildAsofJoinChunk("m2", "tag1val", []int64{0, 2}),
+			buildAsofJoinChunk("m2", "tag1val", []int64{4}),
+			buildAsofJoinChunk("m2", "tag1val1", []int64{1}),
+		}
+	}
+
+	joinCase := buildJoinCase()
+	joinCase.JoinType = influxql.AsofJoin
+	rows := runJoinTransformWithCase(t, joinCase, left(), right())
+	assert.Equal(t, []string{
+		"tag1val,1,1,0",
+		"tag1val,2,2,20",
+		"tag1val,3,3,20",
+		"tag1val,6,6,40",
+		"tag1val2,6,6,null",
+		"tag1val2,7,7,null",
+	}, rows)
+
+	joinCase.Tolerance = 1
+	rows = runJoinTransformWithCase(t, joinCase, left(), right())
+	assert.Equal(t, []string{
+		"tag1val,1,1,0",
+		"tag1val,2,2,20",
+		"tag1val,3,3,20",
+		"tag1val,6,6,null",
+		"tag1val2,6,6,null",
+		"tag1val2,7,7,null",
+	}, rows)
+}
+
+func TestJoinTransformUnsupportedCondition(t *testing.T) {
+	outputRowDataType := buildOutputRowDataType()
+	inRowDataTypes := []hybridqp.RowDataType{outputRowDataType, outputRowDataType}
+	schema := buildFullJoinSchema()
+
+	joinCase := buildJoinCase()
+	joinCase.JoinType = influxql.InnerJoin
+	joinCase.RSrc.(*influxql.SubQuery).Alias = "m1"
+	_, err := executor.NewInnerJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
+	assert.EqualError(t, err, "unsupported condition in inner join")
+
+	joinCase = buildJoinCase()
+	joinCase.JoinType = influxql.LeftOuterJoin
+	joinCase.Condition = &influxql.BinaryExpr{
+		Op:  influxql.NEQ,
+		LHS: &influxql.VarRef{Val: "m1.tag1"},
+		RHS: &influxql.VarRef{Val: "m2.tag1"},
+	}
+	_, err = executor.NewLeftOuterJoinTransform(inRowDataTypes, outputRowDataType, joinCase, schema)
+	assert.EqualError(t, err, "unsupported condition in left outer join")
+}
+
+func TestLogicalSortMergeJoin(t *testing.T) {
+	schema := buildFullJoinSchema()
+	left := executor.NewLogicalMst(buildOutputRowDataType())
+	right := executor.NewLogicalMst(buildOutputRowDataType())
+	inner := executor.NewLogicalSortMergeJoin(left, right, influxql.InnerJoin, schema)
+	outer := executor.NewLogicalSortMergeJoin(left, right, influxql.LeftOuterJoin, schema)
+	assert.Equal(t, influxql.InnerJoin, inner.JoinType())
+	assert.Equal(t, 2, len(inner.Children()))
+	assert.NotEqual(t, inner.Digest(), outer.Digest())
+	assert.Equal(t, influxql.LeftOuterJoin, outer.Clone().(*executor.LogicalSortMergeJoin).JoinType())
+}
+
+func buildUnsignedJoinRowDataType() hybridqp.RowDataType {
+	return hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "val0", Type: influxql.Float},
+		influxql.VarRef{Val: "val1", Type: influxql.String},
+		influxql.VarRef{Val: "val2", Type: influxql.Boolean},
+		influxql.VarRef{Val: "val3", Type: influxql.Unsigned},
+	)
+}
+
+func buildUnsignedJoinChunk(name string, times []int64) executor.Chunk {
+	chunk := executor.NewChunkBuilder(buildUnsignedJoinRowDataType()).NewChunk(name)
+	chunk.AppendTimes(times)
+	chunk.AddTagAndIndex(*ParseChunkTags("tag1=tag1val"), 0)
+	chunk.AddIntervalIndex(0)
+	for _, t := range times {
+		chunk.Column(0).AppendFloatValue(float64(t))
+		chunk.Column(1).AppendStringValue(fmt.Sprintf("f%d", t))
+		chunk.Column(2).AppendBooleanValue(true)
+		chunk.Column(3).AppendUnsignedValue(uint64(t) + 1<<63)
+	}
+	for i := range chunk.Columns() {
+		chunk.Column(i).AppendColumnTimes(times)
+		chunk.Column(i).AppendManyNotNil(len(times))
+	}
+	return chunk
+}
+
+func TestInnerJoinTransformUnsigned(t *testing.T) {
+	rowDataType := buildUnsignedJoinRowDataType()
+	source1 := NewSourceFromMultiChunk(rowDataType, []executor.Chunk{buildUnsignedJoinChunk("m1", []int64{1, 2})})
+	source2 := NewSourceFromMultiChunk(rowDataType, []executor.Chunk{buildUnsignedJoinChunk("m2", []int64{2, 3})})
+	joinCase := buildJoinCase()
+	joinCase.JoinType = influxql.InnerJoin
+	trans, err := executor.NewInnerJoinTransform([]hybridqp.RowDataType{rowDataType, rowDataType}, rowDataType, joinCase, buildFullJoinSchema())
+	require.NoError(t, err)
+
+	var values []uint64
+	sink := NewSinkFromFunction(rowDataType, func(chunk executor.Chunk) error {
+		col := chunk.Column(3)
+		for i := 0; i < chunk.NumberOfRows(); i++ {
+			require.False(t, col.IsNilV2(i))
+			values = append(values, col.UnsignedValue(col.GetValueIndexV2(i)))
+		}
+		return nil
+	})
+	executor.Connect(source1.Output, trans.GetInputs()[0])
+	executor.Connect(source2.Output, trans.GetInputs()[1])
+	executor.Connect(trans.GetOutputs()[0], sink.Input)
+	executors := executor.NewPipelineExecutor(executor.Processors{source1, source2, trans, sink})
+	require.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+	assert.Equal(t, []uint64{2 + 1<<63}, values)
+}
@@ -55,6 +55,7 @@ var (
 	_ LogicalPlan = &LogicalHttpSender{}
 	_ LogicalPlan = &LogicalExchange{}
 	_ LogicalPlan = &LogicalFullJoin{}
+	_ LogicalPlan = &LogicalSortMergeJoin{}
 	_ LogicalPlan = &LogicalSort{}
 	_ LogicalPlan = &LogicalHashMerge{}
 	_ LogicalPlan = &LogicalSparseIndexScan{}
@@ -550,12 +551,12 @@ func (p *LogicalIncHashAgg) Digest() string {
 }
 
 type LogicalAggregate struct {
-	isCountDistinct      bool
-	isPercentileOGSketch bool
-	isPromNestedCall     bool
-	aggType              int
-	calls                map[string]*influxql.Call
-	callsOrder           []string
+	isCountDistinct  bool
+	isCompositeCall  bool
+	isPromNestedCall bool
+	aggType          int
+	calls            map[string]*influxql.Call
+	callsOrder       []string
 	LogicalPlanSingle
 }
 
@@ -567,12 +568,12 @@ func NewCountDistinctAggregate(input hybridqp.QueryNode, schema hybridqp.Catalog
 
 func NewLogicalAggregate(input hybridqp.QueryNode, schema hybridqp.Catalog) *LogicalAggregate {
 	agg := &LogicalAggregate{
-		calls:                make(map[string]*influxql.Call),
-		callsOrder:           make([]string, 0, len(schema.Calls())),
-		isCountDistinct:      false,
-		isPercentileOGSketch: schema.HasPercentileOGSketch(),
-		isPromNestedCall:     schema.HasPromNestedCall(),
-		LogicalPlanSingle:    *NewLogicalPlanSingle(input, schema),
+		calls:             make(map[string]*influxql.Call),
+		callsOrder:        make([]string, 0, len(schema.Calls())),
+		isCountDistinct:   false,
+		isCompositeCall:   schema.HasCompositeCall(),
+		isPromNestedCall:  schema.HasPromNestedCall(),
+		LogicalPlanSingle: *NewLogicalPlanSingle(input, schema),
 	}
 
 	var ok bool
@@ -724,7 +725,7 @@ func (p *LogicalAggregate) initCountDistinct() {
 func (p *LogicalAggregate) init() {
 	var level AggLevel
 	var cc map[string]*hybridqp.OGSketchCompositeOperator
-	if p.isPercentileOGSketch {
+	if p.isCompositeCall {
 		level = p.inferAggLevel()
 		cc = p.schema.CompositeCall()
 	}
@@ -744,7 +745,7 @@ func (p *LogicalAggregate) init() {
 
 	for k, c := range p.calls {
 		var ref influxql.VarRef
-		if p.isPercentileOGSketch && c.Name == PercentileOGSketch {
+		if p.isCompositeCall && (c.Name == PercentileOGSketch || c.Name == ApproxCountDistinct) {
 			c = p.getOGSketchOp(k, level, cc)
 			ref = p.schema.Mapping()[c]
 		} else if p.isPromNestedCall && p.schema.IsPromNestedCall(c) {
@@ -795,7 +796,7 @@ func (p *LogicalAggregate) init() {
 }
 
 func (p *LogicalAggregate) ForwardCallArgs() {
-	if p.isPercentileOGSketch || p.isPromNestedCall {
+	if p.isCompositeCall || p.isPromNestedCall {
 		return
 	}
 	for k, call := range p.calls {
@@ -2655,6 +2656,13 @@ func (b *LogicalPlanBuilderImpl) HoltWinters() LogicalPlanBuilder {
 	return b
 }
 
+func (b *LogicalPlanBuilderImpl) Window() LogicalPlanBuilder {
+	last := b.stack.Pop()
+	plan := NewLogicalWindow(last, b.schema)
+	b.stack.Push(plan)
+	return b
+}
+
 func (b *LogicalPlanBuilderImpl) CountDistinct() LogicalPlanBuilder {
 	if b.schema.CountDistinct() != nil {
 		last := b.stack.Pop()
@@ -3671,6 +3679,104 @@ func (p *LogicalFullJoin) Digest() string {
 	return string(p.digestName)
 }
 
+// LogicalSortMergeJoin joins two subqueries which are sorted by series key and time,
+// it is used by inner join, left outer join, right outer join and asof join.
+// The join itself runs on ts-sql: each subquery is planned on its own, so its condition, time range
+// and aggregates are still pushed down to ts-store, but the join is not, because the matched series
+// of both sides may be stored on different nodes.
+type LogicalSortMergeJoin struct {
+	left     hybridqp.QueryNode
+	right    hybridqp.QueryNode
+	joinType influxql.JoinType
+	LogicalPlanBase
+}
+
+func NewLogicalSortMergeJoin(left hybridqp.QueryNode, right hybridqp.QueryNode, joinType influxql.JoinType, schema hybridqp.Catalog) *LogicalSortMergeJoin {
+	join := &LogicalSortMergeJoin{
+		left:     left,
+		right:    right,
+		joinType: joinType,
+		LogicalPlanBase: LogicalPlanBase{
+			id:     hybridqp.GenerateNodeId(),
+			schema: schema,
+			rt:     nil,
+			ops:    nil,
+		},
+	}
+	join.init()
+	return join
+}
+
+func (p *LogicalSortMergeJoin) New(inputs []hybridqp.QueryNode, schema hybridqp.Catalog, eTrait []hybridqp.Trait) hybridqp.QueryNode {
+	return NewLogicalSortMergeJoin(inputs[0], inputs[1], p.joinType, schema)
+}
+
+func (p *LogicalSortMergeJoin) DeriveOperations() {
+	p.init()
+}
+
+func (p *LogicalSortMergeJoin) init() {
+	p.InitRef(p.right)
+	p.InitRef(p.left)
+}
+
+func (p *LogicalSortMergeJoin) Clone() hybridqp.QueryNode {
+	clone := &LogicalSortMergeJoin{}
+	*clone = *p
+	clone.id = hybridqp.GenerateNodeId()
+	return clone
+}
+
+func (p *LogicalSortMergeJoin) Children() []hybridqp.QueryNode {
+	return []hybridqp.QueryNode{p.left, p.right}
+}
+
+func (p *LogicalSortMergeJoin) ReplaceChildren(children []hybridqp.QueryNode) {
+	if len(children) != 2 {
+		panic("children count in LogicalSortMergeJoin is not 2")
+	}
+	p.left = children[0]
+	p.right = children[1]
+}
+
+func (p *LogicalSortMergeJoin) ReplaceChild(ordinal int, child hybridqp.QueryNode) {
+	if ordinal > 1 {
+		panic(fmt.Sprintf("index %d out of range %d", ordinal, 1))
+	}
+	if ordinal == 0 {
+		p.left = child
+	} else {
+		p.right = child
+	}
+}
+
+func (p *LogicalSortMergeJoin) JoinType() influxql.JoinType {
+	return p.joinType
+}
+
+func (p *LogicalSortMergeJoin) Explain(writer LogicalPlanWriter) {
+	p.ExplainIterms(writer)
+	writer.Item("join_type", p.joinType.String())
+	writer.Explain(p)
+}
+
+func (p *LogicalSortMergeJoin) Type() string {
+	return GetType(p)
+}
+
+func (p *LogicalSortMergeJoin) Digest() string {
+	if p.digest {
+		return string(p.digestName)
+	}
+	p.digest = true
+	p.digestName = p.digestName[:0]
+	p.digestName = encoding.MarshalUint32(p.digestName, uint32(p.LogicPlanType()))
+	p.digestName = encoding.MarshalUint32(p.digestName, uint32(p.joinType))
+	p.digestName = encoding.MarshalUint64(p.digestName, p.left.ID())
+	p.digestName = encoding.MarshalUint64(p.digestName, p.right.ID())
+	return string(p.digestName)
+}
+
 type LogicalHoltWinters struct {
 	LogicalPlanSingle
 }
@@ -3724,6 +3830,76 @@ func (p *LogicalHoltWinters) Digest() string {
 	return string(p.digestName)
 }
 
+// LogicalWindow evaluates the window functions, such as lag() OVER (...),
+// on the rows of each partition.
+type LogicalWindow struct {
+	LogicalPlanSingle
+}
+
+func NewLogicalWindow(input hybridqp.QueryNode, schema hybridqp.Catalog) *LogicalWindow {
+	window := &LogicalWindow{
+		LogicalPlanSingle: *NewLogicalPlanSingle(input, schema),
+	}
+	window.init()
+	return window
+}
+
+// impl me
+func (p *LogicalWindow) New(inputs []hybridqp.QueryNode, schema hybridqp.Catalog, eTrait []hybridqp.Trait) hybridqp.QueryNode {
+	return nil
+}
+
+func (p *LogicalWindow) DeriveOperations() {
+	p.init()
+}
+
+func (p *LogicalWindow) init() {
+	p.ForwardInit(p.inputs[0])
+	calls := p.schema.WindowCalls()
+	for i, f := range p.rt.Fields() {
+		ref, ok := f.Expr.(*influxql.VarRef)
+		if !ok {
+			continue
+		}
+		call, ok := calls[ref.Val]
+		if !ok || (call.Name != "row_number" && call.Name != "rank") {
+			continue
+		}
+		p.rt.SetDataType(i, influxql.Integer)
+		if val, ok := p.ops[i].Expr.(*influxql.VarRef); ok {
+			val.SetDataType(influxql.Integer)
+		}
+		p.ops[i].Ref.SetDataType(influxql.Integer)
+	}
+}
+
+func (p *LogicalWindow) Clone() hybridqp.QueryNode {
+	clone := &LogicalWindow{}
+	*clone = *p
+	clone.id = hybridqp.GenerateNodeId()
+	return clone
+}
+
+func (p *LogicalWindow) Explain(writer LogicalPlanWriter) {
+	p.ExplainIterms(writer)
+	writer.Explain(p)
+}
+
+func (p *LogicalWindow) Type() string {
+	return GetType(p)
+}
+
+func (p *LogicalWindow) Digest() string {
+	if p.digest {
+		return string(p.digestName)
+	}
+	p.digest = true
+	p.digestName = p.digestName[:0]
+	p.digestName = encoding.MarshalUint32(p.digestName, uint32(p.LogicPlanType()))
+	p.digestName = encoding.MarshalUint64(p.digestName, p.inputs[0].ID())
+	return string(p.digestName)
+}
+
 // Digest format: printf("%s(%d)[%d](%s)(%s)", name, typ, id, fields, calls)
 func buildDigest(buf *bytes.Buffer, name string, typ int, id uint64, fields influxql.Fields,
 	calls map[string]*influxql.Call, callsOrder []string) {
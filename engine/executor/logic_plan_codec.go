@@ -228,6 +228,10 @@ func (p *LogicalHoltWinters) LogicPlanType() internal.LogicPlanType {
 	return internal.LogicPlanType_LogicalHoltWinters
 }
 
+func (p *LogicalWindow) LogicPlanType() internal.LogicPlanType {
+	return internal.LogicPlanType_LogicalWindow
+}
+
 func (p *LogicalSortAppend) LogicPlanType() internal.LogicPlanType {
 	return internal.LogicPlanType_LogicalSortAppend
 }
@@ -292,6 +296,10 @@ func (p *LogicalPromSubquery) LogicPlanType() internal.LogicPlanType {
 	return internal.LogicPlanType_LogicalPromSubquery
 }
 
+func (p *LogicalSortMergeJoin) LogicPlanType() internal.LogicPlanType {
+	return internal.LogicPlanType_LogicalSortMergeJoin
+}
+
 func (p *LogicalHashAgg) String() string {
 	return "LogicalHashAgg"
 }
@@ -400,6 +408,10 @@ func (p *LogicalHoltWinters) String() string {
 	return "LogicalHoltWinters"
 }
 
+func (p *LogicalWindow) String() string {
+	return "LogicalWindow"
+}
+
 func (p *LogicalSortAppend) String() string {
 	return "LogicalSortAppend"
 }
@@ -463,6 +475,10 @@ func (p *LogicalPromSort) String() string {
 	return "LogicalPromSort"
 }
 
+func (p *LogicalSortMergeJoin) String() string {
+	return "LogicalSortMergeJoin"
+}
+
 func MarshalBinary(q hybridqp.QueryNode) ([]byte, error) {
 	switch p := q.(type) {
 	case *HeuVertex:
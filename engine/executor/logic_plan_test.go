@@ -333,6 +333,34 @@ func TestNewHoltWintersQueryTransforms(t *testing.T) {
 	}
 }
 
+func TestNewLogicalWindow(t *testing.T) {
+	m := createMeasurement()
+	opt := query.ProcessorOptions{Sources: []influxql.Source{m}}
+	value := &influxql.VarRef{Val: "f1", Type: influxql.Float}
+	fields := influxql.Fields{
+		{Expr: value},
+		{Expr: &influxql.Call{Name: "lag", Args: []influxql.Expr{influxql.CloneExpr(value)}, Window: &influxql.WindowSpec{}}},
+		{Expr: &influxql.Call{Name: "row_number", Window: &influxql.WindowSpec{}}},
+	}
+	schema := executor.NewQuerySchema(fields, []string{"f1", "lag", "row_number"}, &opt, nil)
+	schema.AddTable(m, schema.MakeRefs())
+	assert.True(t, schema.HasWindowCall())
+
+	node := executor.NewLogicalSeries(schema)
+	project := executor.NewLogicalProject(node, schema)
+	window := executor.NewLogicalWindow(project, schema)
+	types := make([]influxql.DataType, 0, 3)
+	for _, f := range window.RowDataType().Fields() {
+		types = append(types, f.Expr.(*influxql.VarRef).Type)
+	}
+	assert.Equal(t, []influxql.DataType{influxql.Float, influxql.Float, influxql.Integer}, types)
+
+	clone := window.Clone().(*executor.LogicalWindow)
+	assert.Equal(t, window.Type(), clone.Type())
+	assert.Equal(t, "LogicalWindow", clone.String())
+	assert.NotEqual(t, "", clone.Digest())
+}
+
 func TestSetHoltWintersType(t *testing.T) {
 	var fields influxql.Fields
 	f := &influxql.IntegerLiteral{Val: 1}
@@ -481,7 +509,7 @@ func TestLogicalPlanNilNew(t *testing.T) {
 		&executor.LogicalTagSubset{}, &executor.LogicalGroupBy{}, &executor.LogicalOrderBy{}, &executor.LogicalHttpSenderHint{},
 		&executor.LogicalTarget{}, &executor.LogicalDummyShard{}, &executor.LogicalTSSPScan{}, &executor.LogicalWriteIntoStorage{},
 		&executor.LogicalSequenceAggregate{}, &executor.LogicalSplitGroup{}, &executor.LogicalFullJoin{}, &executor.LogicalHoltWinters{},
-		&executor.LogicalSort{}, &executor.LogicalMerge{}, &executor.LogicalSortMerge{}}
+		&executor.LogicalSort{}, &executor.LogicalMerge{}, &executor.LogicalSortMerge{}, &executor.LogicalWindow{}}
 	newResult := make([]hybridqp.QueryNode, len(logicalNode))
 	for i, node := range logicalNode {
 		if newResult[i] != node.New(nil, nil, nil) {
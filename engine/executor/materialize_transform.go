@@ -119,6 +119,11 @@ func TransparentForwardIntegerColumn(dst Column, src Column) {
 	AdjustNils(dst, src, 0, src.Length())
 }
 
+func TransparentForwardUnsignedColumn(dst Column, src Column) {
+	dst.AppendUnsignedValues(src.UnsignedValues())
+	AdjustNils(dst, src, 0, src.Length())
+}
+
 func TransparentForwardFloatColumn(dst Column, src Column) {
 	dst.AppendFloatValues(src.FloatValues())
 	AdjustNils(dst, src, 0, src.Length())
@@ -139,6 +144,11 @@ func TransparentForwardInteger(dst Column, src Chunk, index []int) {
 	TransparentForwardIntegerColumn(dst, srcCol)
 }
 
+func TransparentForwardUnsigned(dst Column, src Chunk, index []int) {
+	srcCol := src.Column(index[0])
+	TransparentForwardUnsignedColumn(dst, srcCol)
+}
+
 func TransparentForwardFloat(dst Column, src Chunk, index []int) {
 	srcCol := src.Column(index[0])
 	TransparentForwardFloatColumn(dst, srcCol)
@@ -264,6 +274,8 @@ func getRowValue(column Column, index int) interface{} {
 	switch column.DataType() {
 	case influxql.Integer:
 		return column.IntegerValue(index)
+	case influxql.Unsigned:
+		return column.UnsignedValue(index)
 	case influxql.Float:
 		return column.FloatValue(index)
 	case influxql.Boolean:
@@ -283,6 +295,12 @@ func AppendRowValue(column Column, value interface{}) {
 		} else {
 			panic("expect integer value")
 		}
+	case influxql.Unsigned:
+		if v, ok := value.(uint64); ok {
+			column.AppendUnsignedValue(v)
+		} else {
+			panic("expect unsigned value")
+		}
 	case influxql.Float:
 		if v, ok := value.(float64); ok {
 			column.AppendFloatValue(v)
@@ -570,6 +588,8 @@ func (trans *MaterializeTransform) processVarRef(vr *influxql.VarRef, transparen
 	switch vr.Type {
 	case influxql.Integer:
 		transparents[i] = TransparentForwardInteger
+	case influxql.Unsigned:
+		transparents[i] = TransparentForwardUnsigned
 	case influxql.Float:
 		transparents[i] = TransparentForwardFloat
 	case influxql.Boolean:
@@ -707,6 +727,8 @@ func (re *ResultEval) appendLen(l int, dataType influxql.DataType) {
 		re.appendFloatLen(l)
 	case influxql.Integer:
 		re.appendIntLen(l)
+	case influxql.Unsigned:
+		re.appendUintLen(l)
 	case influxql.Boolean:
 		re.appendBoolLen(l)
 	}
@@ -722,6 +744,8 @@ func (re *ResultEval) copy(column *ColumnImpl) {
 			copy(re.floatValue, column.floatValues)
 		case influxql.Integer:
 			copy(re.integerValue, column.integerValues)
+		case influxql.Unsigned:
+			copy(re.uintValue, column.unsignedValues)
 		case influxql.Boolean:
 			copy(re.booleanValue, column.booleanValues)
 		}
@@ -749,6 +773,16 @@ func (re *ResultEval) copy(column *ColumnImpl) {
 			re.integerValue[i] = column.IntegerValue(k)
 			k = k + 1
 		}
+	case influxql.Unsigned:
+		for i := 0; i < column.Length(); i++ {
+			if column.IsNilV2(i) {
+				re.isNil[i] = true
+				continue
+			}
+			re.isNil[i] = false
+			re.uintValue[i] = column.UnsignedValue(k)
+			k = k + 1
+		}
 	case influxql.Boolean:
 		for i := 0; i < column.Length(); i++ {
 			if column.IsNilV2(i) {
@@ -768,6 +802,8 @@ func (res *ResultEval) copyTo(l int, dst *ColumnImpl) {
 		res.copyToForFloat(l, dst)
 	case influxql.Integer:
 		res.copyToForInteger(l, dst)
+	case influxql.Unsigned:
+		res.copyToForUnsigned(l, dst)
 	case influxql.Boolean:
 		res.copyToForBoolean(l, dst)
 	}
@@ -810,6 +846,24 @@ func (res *ResultEval) copyToForInteger(l int, dst *ColumnImpl) {
 	}
 }
 
+func (res *ResultEval) copyToForUnsigned(l int, dst *ColumnImpl) {
+	for index := 0; index < l; {
+		num := 0
+		for index < l && res.IsNil(index) {
+			index += 1
+			num += 1
+		}
+		dst.AppendManyNil(num)
+		num = 0
+		for index < l && !res.IsNil(index) {
+			dst.AppendUnsignedValue(res.getUint64(index))
+			index += 1
+			num += 1
+		}
+		dst.AppendManyNotNil(num)
+	}
+}
+
 func (res *ResultEval) copyToForBoolean(l int, dst *ColumnImpl) {
 	for index := 0; index < l; {
 		num := 0
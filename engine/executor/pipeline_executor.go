@@ -1423,6 +1423,9 @@ func (builder *ExecutorBuilder) IsMultiMstPlanNode(node hybridqp.QueryNode) bool
 	if _, ok := node.(*LogicalFullJoin); ok {
 		return true
 	}
+	if _, ok := node.(*LogicalSortMergeJoin); ok {
+		return true
+	}
 	if _, ok := node.(*LogicalSortAppend); ok {
 		return true
 	}
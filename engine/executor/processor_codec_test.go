@@ -26,6 +26,34 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
 )
 
+func TestProcessorCodecPointLimiter(t *testing.T) {
+	opt := &query.ProcessorOptions{PointLimiter: query.NewPointLimiter(10, nil)}
+	buf, err := opt.MarshalBinary()
+	if err != nil {
+		t.Fatalf("ProcessorOptions marshal failed: %v", err)
+	}
+
+	other := &query.ProcessorOptions{}
+	if err := other.UnmarshalBinary(buf); err != nil {
+		t.Fatalf("failed to unmarshal ProcessorOptions: %v", err)
+	}
+	if other.PointLimiter == opt.PointLimiter || other.PointLimiter.Limit() != 10 {
+		t.Fatalf("expect a new limiter with the same limit, got %d", other.PointLimiter.Limit())
+	}
+
+	buf, err = (&query.ProcessorOptions{}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("ProcessorOptions marshal failed: %v", err)
+	}
+	other = &query.ProcessorOptions{}
+	if err := other.UnmarshalBinary(buf); err != nil {
+		t.Fatalf("failed to unmarshal ProcessorOptions: %v", err)
+	}
+	if other.PointLimiter != nil {
+		t.Fatal("expect no limiter without limit")
+	}
+}
+
 func TestProcessorCodec(t *testing.T) {
 	cond, err := influxql.ParseExpr("a=b AND c=1")
 	if err != nil {
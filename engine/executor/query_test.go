@@ -479,6 +479,102 @@ func TestPercentileOGSketch(t *testing.T) {
 	}
 }
 
+func TestApproxCountDistinct(t *testing.T) {
+	ddl := func(c *Catalog) error {
+		db, err := c.CreateDatabase("db0", "rp0")
+		if err != nil {
+			return err
+		}
+		mst0 := NewTable("mst0")
+		dataTypes := make(map[string]influxql.DataType)
+		dataTypes["t"] = influxql.Tag
+		dataTypes["v_int"] = influxql.Integer
+		dataTypes["v_str"] = influxql.String
+		mst0.AddDataTypes(dataTypes)
+		db.AddTable(mst0)
+		return nil
+	}
+	dml := func(s *Storage) error {
+		rdt := hybridqp.NewRowDataTypeImpl(
+			influxql.VarRef{Val: "t", Type: influxql.String},
+			influxql.VarRef{Val: "v_int", Type: influxql.Integer},
+			influxql.VarRef{Val: "v_str", Type: influxql.String})
+
+		builder := executor.NewChunkBuilder(rdt)
+		chunk1 := builder.NewChunk("mst0")
+		chunk1.AppendTimes([]int64{1000000000, 2000000000, 3000000000, 4000000000, 5000000000})
+		chunk1.Column(0).AppendStringValues([]string{"a", "a", "a", "a", "a"})
+		chunk1.Column(0).AppendManyNotNil(5)
+		chunk1.Column(1).AppendIntegerValues([]int64{1, 2, 2, 3, 3})
+		chunk1.Column(1).AppendManyNotNil(5)
+		chunk1.Column(2).AppendStringValues([]string{"u1", "u2", "u1", "u3", "u2"})
+		chunk1.Column(2).AppendManyNotNil(5)
+		pts1 := influx.PointTags{influx.Tag{Key: "t", Value: "a"}}
+		s.Write("db0.rp0.mst0", &pts1, chunk1)
+
+		chunk2 := builder.NewChunk("mst0")
+		chunk2.AppendTimes([]int64{6000000000, 7000000000, 8000000000, 9000000000, 10000000000})
+		chunk2.Column(0).AppendStringValues([]string{"b", "b", "b", "b", "b"})
+		chunk2.Column(0).AppendManyNotNil(5)
+		chunk2.Column(1).AppendIntegerValues([]int64{3, 4, 5, 6, 6})
+		chunk2.Column(1).AppendManyNotNil(5)
+		chunk2.Column(2).AppendStringValues([]string{"u3", "u4", "u4", "u4", "u1"})
+		chunk2.Column(2).AppendManyNotNil(5)
+		pts2 := influx.PointTags{influx.Tag{Key: "t", Value: "b"}}
+		s.Write("db0.rp0.mst0", &pts2, chunk2)
+		return nil
+	}
+
+	for _, tc := range []struct {
+		name      string
+		sql       string
+		validator func([]executor.Chunk)
+	}{
+		{
+			name: "approx_count_distinct(*)",
+			sql: "SELECT approx_count_distinct(v_int) as v_int, approx_count_distinct(v_str) as v_str " +
+				"from db0.rp0.mst0 where time >= 1000000000 and time <= 10000000000",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{6})
+				assert.Equal(t, results[0].Columns()[1].IntegerValues(), []int64{4})
+			},
+		},
+		{
+			name: "approx_count_distinct group by time",
+			sql: "SELECT approx_count_distinct(v_int) as v_int from db0.rp0.mst0 " +
+				"where time >= 1000000000 and time <= 10000000000 group by time(5s)",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Time(), []int64{0, 5000000000, 10000000000})
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{3, 4, 1})
+			},
+		},
+		{
+			name: "approx_count_distinct of subquery",
+			sql: "SELECT approx_count_distinct(v_str) as v_str from " +
+				"(SELECT v_str from db0.rp0.mst0 where time >= 1000000000 and time <= 10000000000)",
+			validator: func(results []executor.Chunk) {
+				assert.Equal(t, len(results), 1)
+				assert.Equal(t, results[0].Columns()[0].IntegerValues(), []int64{4})
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tsdb := NewTSDBSystem()
+			if err := tsdb.DDL(ddl); err != nil {
+				t.Error(err)
+			}
+			if err := tsdb.DML(dml); err != nil {
+				t.Error(err)
+			}
+			if err := tsdb.ExecSQL(tc.sql, tc.validator, nil, false); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestFillNull(t *testing.T) {
 	for _, tc := range []struct {
 		name          string
@@ -0,0 +1,199 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+)
+
+const (
+	// RowTimeColumn is the name of the time column of the query results
+	RowTimeColumn = "time"
+	// RowMeasurementMetaKey is the schema metadata key holding the measurement name of the result
+	RowMeasurementMetaKey = "measurement"
+)
+
+// RowRecordBuilder converts the rows of a query result into arrow records.
+// All records share the schema built from the first row with values:
+// the time column, the group by tags as string columns, and then the selected columns.
+// The column types come from the query result (see SetColumnTypes), the values of the first row
+// are inspected only for the columns whose type is unknown.
+type RowRecordBuilder struct {
+	mem     memory.Allocator
+	schema  *arrow.Schema
+	builder *array.RecordBuilder
+	types   []influxql.DataType
+
+	name     string
+	tagKeys  []string
+	columns  []string
+	timeIdx  int
+	colIndex []int // column of models.Row -> field of schema
+}
+
+func NewRowRecordBuilder(mem memory.Allocator) *RowRecordBuilder {
+	return &RowRecordBuilder{mem: mem, timeIdx: -1}
+}
+
+// Schema returns the schema of the records, nil before any row with values is appended.
+func (b *RowRecordBuilder) Schema() *arrow.Schema {
+	return b.schema
+}
+
+// SetColumnTypes sets the data types of the columns of the rows to be appended, see query.Result.ColumnTypes.
+// It takes effect if it is called before the schema is built.
+func (b *RowRecordBuilder) SetColumnTypes(types []influxql.DataType) {
+	if b.schema == nil {
+		b.types = types
+	}
+}
+
+// Init builds the schema from the row, the values of the row are not appended.
+func (b *RowRecordBuilder) Init(row *models.Row) error {
+	b.name = row.Name
+	b.columns = row.Columns
+	b.tagKeys = make([]string, 0, len(row.Tags))
+	for k := range row.Tags {
+		b.tagKeys = append(b.tagKeys, k)
+	}
+	sort.Strings(b.tagKeys)
+
+	fields := make([]arrow.Field, 0, len(row.Columns)+len(b.tagKeys))
+	b.colIndex = make([]int, len(row.Columns))
+	for i, col := range row.Columns {
+		if col == RowTimeColumn {
+			b.timeIdx = i
+			b.colIndex[i] = 0
+			fields = append(fields, arrow.Field{Name: RowTimeColumn, Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true})
+			break
+		}
+	}
+	for _, k := range b.tagKeys {
+		fields = append(fields, arrow.Field{Name: k, Type: arrow.BinaryTypes.String, Nullable: true})
+	}
+	for i, col := range row.Columns {
+		if i == b.timeIdx {
+			continue
+		}
+		dType, err := ArrowDataType(b.columnType(row, i))
+		if err != nil {
+			return fmt.Errorf("column %s: %s", col, err.Error())
+		}
+		b.colIndex[i] = len(fields)
+		fields = append(fields, arrow.Field{Name: col, Type: dType, Nullable: true})
+	}
+
+	md := arrow.NewMetadata([]string{RowMeasurementMetaKey}, []string{row.Name})
+	b.schema = arrow.NewSchema(fields, &md)
+	b.builder = array.NewRecordBuilder(b.mem, b.schema)
+	return nil
+}
+
+func (b *RowRecordBuilder) columnType(row *models.Row, col int) influxql.DataType {
+	if len(b.types) == len(row.Columns) {
+		switch t := b.types[col]; t {
+		case influxql.Float, influxql.Integer, influxql.Unsigned, influxql.String, influxql.Tag, influxql.Boolean:
+			return t
+		}
+	}
+	return inspectColumnType(row, col)
+}
+
+// inspectColumnType returns the data type of the first non-nil value of the column.
+// A column without any value is treated as a float column.
+func inspectColumnType(row *models.Row, col int) influxql.DataType {
+	for _, values := range row.Values {
+		if col < len(values) && values[col] != nil {
+			return influxql.InspectDataType(values[col])
+		}
+	}
+	return influxql.Float
+}
+
+// Append buffers the values of the row, the schema is built by the first row with values.
+func (b *RowRecordBuilder) Append(row *models.Row) error {
+	if len(row.Values) == 0 {
+		return nil
+	}
+	if b.schema == nil {
+		if err := b.Init(row); err != nil {
+			return err
+		}
+	}
+	if row.Name != b.name {
+		return fmt.Errorf("result of multiple measurements is not supported: %s, %s", b.name, row.Name)
+	}
+	if !sameColumns(row.Columns, b.columns) {
+		return fmt.Errorf("result columns of series are not the same: %v, %v", b.columns, row.Columns)
+	}
+
+	tagOffset := 0
+	if b.timeIdx >= 0 {
+		tagOffset = 1
+	}
+	for _, values := range row.Values {
+		for i, k := range b.tagKeys {
+			sb := b.builder.Field(tagOffset + i).(*array.StringBuilder)
+			if v, ok := row.Tags[k]; ok {
+				sb.Append(v)
+			} else {
+				sb.AppendNull()
+			}
+		}
+		for i := range b.columns {
+			var v interface{}
+			if i < len(values) {
+				v = values[i]
+			}
+			if err := AppendArrowValue(b.builder.Field(b.colIndex[i]), v); err != nil {
+				return fmt.Errorf("column %s: %s", b.columns[i], err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+func sameColumns(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NewRecord returns the buffered rows as one record, or nil if nothing is buffered.
+func (b *RowRecordBuilder) NewRecord() arrow.Record {
+	if b.builder == nil || b.builder.Field(0).Len() == 0 {
+		return nil
+	}
+	return b.builder.NewRecord()
+}
+
+func (b *RowRecordBuilder) Release() {
+	if b.builder != nil {
+		b.builder.Release()
+	}
+}
@@ -157,6 +157,7 @@ func (c *RPCClient) sendRequest() error {
 	if c.span != nil {
 		trans.StartAnalyze(c.span)
 		c.span.AddStringField("remote_addr", trans.Requester().Session().Connection().RemoteAddr().String())
+		c.query.TraceParent = c.trace.TraceParent(c.span)
 	}
 	trans.EnableDataACK()
 
@@ -258,8 +259,8 @@ func (c *RPCClient) errorMessage(data interface{}) error {
 	}
 
 	// ha or not ha, kill query qid will return error
-	if msg.errCode == errno.ErrQueryKilled {
-		err := errno.NewError(errno.ErrQueryKilled)
+	if msg.errCode == errno.ErrQueryKilled || msg.errCode == errno.SelectPointsLimitExceeded {
+		err := errno.NewError(msg.errCode)
 		err.SetMessage(msg.data)
 		return err
 	}
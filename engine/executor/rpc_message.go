@@ -326,6 +326,12 @@ type RemoteQuery struct {
 	Opt      query.ProcessorOptions
 	Analyze  bool
 	Node     []byte
+
+	// TraceParent is the W3C trace context of the caller, the store exports its spans under it.
+	TraceParent string
+	// Export is set if the caller's trace is sampled, the store exports its spans to the OTLP
+	// collector without returning them to the caller unless Analyze is set.
+	Export bool
 }
 
 func (c *RemoteQuery) Marshal(buf []byte) ([]byte, error) {
@@ -335,14 +341,16 @@ func (c *RemoteQuery) Marshal(buf []byte) ([]byte, error) {
 	}
 
 	msg, err := proto.Marshal(&proto2.RemoteQuery{
-		Database:  c.Database,
-		PtID:      c.PtID,
-		ShardIDs:  c.ShardIDs,
-		NodeID:    c.NodeID,
-		Opt:       opt,
-		Analyze:   c.Analyze,
-		QueryNode: c.Node,
-		PtQuerys:  MarshalPtQuerys(c.PtQuerys),
+		Database:    c.Database,
+		PtID:        c.PtID,
+		ShardIDs:    c.ShardIDs,
+		NodeID:      c.NodeID,
+		Opt:         opt,
+		Analyze:     c.Analyze,
+		QueryNode:   c.Node,
+		PtQuerys:    MarshalPtQuerys(c.PtQuerys),
+		TraceParent: c.TraceParent,
+		Export:      c.Export,
 	})
 
 	ret := make([]byte, len(buf)+len(msg))
@@ -365,6 +373,8 @@ func (c *RemoteQuery) Unmarshal(buf []byte) error {
 	c.NodeID = pb.GetNodeID()
 	c.Node = pb.QueryNode
 	c.PtQuerys = UnmarshalPtQuerys(pb.GetPtQuerys())
+	c.TraceParent = pb.GetTraceParent()
+	c.Export = pb.GetExport()
 	if err := c.Opt.UnmarshalBinary(pb.GetOpt()); err != nil {
 		return err
 	}
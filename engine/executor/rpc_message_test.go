@@ -16,9 +16,12 @@ package executor_test
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/hex"
 	"fmt"
 	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -26,6 +29,7 @@ import (
 	"github.com/openGemini/openGemini/engine/executor"
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/cache"
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/spdy"
@@ -82,8 +86,10 @@ func makeRemoteQueryMsg(nodeID uint64) *executor.RemoteQuery {
 			EnableBinaryTreeMerge: 0,
 			HintType:              0,
 		},
-		Analyze: false,
-		Node:    []byte{1, 2, 3, 4, 5, 6, 7},
+		Analyze:     false,
+		Node:        []byte{1, 2, 3, 4, 5, 6, 7},
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		Export:      true,
 	}
 }
 
@@ -148,7 +154,8 @@ func TestRemoteQueryForPtQuery(t *testing.T) {
 }
 
 type RPCServer struct {
-	seq uint64
+	seq         uint64
+	traceParent atomic.Value
 }
 
 func (c *RPCServer) GetQueryExeInfo() *netstorage.QueryExeInfo {
@@ -158,6 +165,7 @@ func (c *RPCServer) GetQueryExeInfo() *netstorage.QueryExeInfo {
 func (c *RPCServer) Handle(w spdy.Responser, data interface{}) error {
 	msg, _ := data.(*rpc.Message).Data().(*executor.RemoteQuery)
 	qid := msg.Opt.QueryId
+	c.traceParent.Store(msg.TraceParent)
 	if msg.Analyze {
 		fmt.Println("msg.Analyze")
 		time.Sleep(time.Second)
@@ -261,6 +269,42 @@ func TestTransport(t *testing.T) {
 	assert.EqualError(t, err, fmt.Sprintf("unknown error"))
 }
 
+func TestTransportTraceParent(t *testing.T) {
+	address := "127.0.0.13:18293"
+	var nodeID uint64 = 3
+
+	conf := config.NewTracingConfig()
+	conf.Enabled = true
+	conf.Endpoint = "http://127.0.0.1:4318/v1/traces"
+	exporter := tracing.NewExporter(conf, config.AppSql)
+	require.NoError(t, exporter.Open())
+	defer exporter.Close()
+
+	// Server
+	rpcServer := &RPCServer{}
+	server := startServer(address, rpcServer)
+	defer server.Stop()
+
+	// Client
+	transport.NewNodeManager().Add(nodeID, address)
+
+	time.Sleep(time.Second)
+
+	trace, span := tracing.NewTrace("root")
+	require.True(t, trace.Sample("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+	client := executor.NewRPCClient(makeRemoteQueryMsg(nodeID))
+	client.StartAnalyze(span)
+	client.Init(tracing.NewContextWithTrace(context.Background(), trace), nil)
+	assert.EqualError(t, client.Run(), "unknown error")
+
+	// the store continues the trace under the span of the rpc client
+	tc, err := tracing.ParseTraceParent(rpcServer.traceParent.Load().(string))
+	require.NoError(t, err)
+	require.True(t, tc.Sampled())
+	require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hex.EncodeToString(tc.TraceID[:]))
+	require.Equal(t, span.Context().SpanID, binary.BigEndian.Uint64(tc.SpanID[:]))
+}
+
 func TestEmptyMessage(t *testing.T) {
 	address := "127.0.0.11:18291"
 	var nodeID uint64 = 1
@@ -20,6 +20,7 @@ import (
 
 	"github.com/openGemini/openGemini/engine/hybridqp"
 	"github.com/openGemini/openGemini/lib/bufferpool"
+	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/spdy"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/tracing"
@@ -46,10 +47,11 @@ type RPCReaderTransform struct {
 	abortSignal chan struct{}
 	aborted     bool
 
-	span        *tracing.Span
-	outputSpan  *tracing.Span
-	queryId     uint64
-	NoMarkCrash bool
+	span         *tracing.Span
+	outputSpan   *tracing.Span
+	queryId      uint64
+	NoMarkCrash  bool
+	pointLimiter *query.PointLimiter
 }
 
 func (t *RPCReaderTransform) IsSink() bool {
@@ -120,6 +122,10 @@ func (t *RPCReaderTransform) Work(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if opt, ok := t.distributed.Schema().Options().(*query.ProcessorOptions); ok {
+		t.pointLimiter = opt.PointLimiter
+	}
+
 	client := &t.client
 	client.Init(ctx, queryNode)
 	client.StartAnalyze(t.BaseSpan())
@@ -141,7 +147,11 @@ func (t *RPCReaderTransform) Work(ctx context.Context) error {
 					retry outside until pt owner is alive node
 		3. replication: master pt offline retry until master pt online
 	*/
-	return client.Run()
+	err = client.Run()
+	if errno.Equal(err, errno.SelectPointsLimitExceeded) {
+		t.pointLimiter.Exceeded()
+	}
+	return err
 }
 
 func (t *RPCReaderTransform) GetOutputs() Ports {
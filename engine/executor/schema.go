@@ -182,6 +182,7 @@ type QuerySchema struct {
 	promTimeCalls map[string]*influxql.Call
 	slidingWindow map[string]*influxql.Call
 	holtWinters   []*influxql.Field
+	windowCalls   map[string]*influxql.Call
 	compositeCall map[string]*hybridqp.OGSketchCompositeOperator
 	// promNestedCall is used to optimize the nested push down of function and aggregate operator
 	promNestedCall map[string]*hybridqp.PromNestedCall
@@ -218,6 +219,7 @@ func NewQuerySchema(fields influxql.Fields, columnNames []string, opt hybridqp.O
 		promTimeCalls:  make(map[string]*influxql.Call),
 		slidingWindow:  make(map[string]*influxql.Call),
 		holtWinters:    make([]*influxql.Field, 0),
+		windowCalls:    make(map[string]*influxql.Call),
 		compositeCall:  make(map[string]*hybridqp.OGSketchCompositeOperator),
 		promNestedCall: make(map[string]*hybridqp.PromNestedCall),
 		i:              0,
@@ -232,8 +234,8 @@ func NewQuerySchema(fields influxql.Fields, columnNames []string, opt hybridqp.O
 
 	schema.init()
 
-	if schema.HasPercentileOGSketch() {
-		schema.rewritePercentileOGSketchCompositeCall()
+	if schema.HasCompositeCall() {
+		schema.rewriteCompositeCall()
 	}
 
 	if schema.HasPromNestedCall() {
@@ -283,16 +285,21 @@ func (qs *QuerySchema) reset(fields influxql.Fields, column []string) {
 	qs.slidingWindow = make(map[string]*influxql.Call)
 	qs.promNestedCall = make(map[string]*hybridqp.PromNestedCall)
 	qs.holtWinters = qs.holtWinters[0:0]
+	qs.windowCalls = make(map[string]*influxql.Call)
 	qs.unnestCases = qs.unnestCases[:0]
 	qs.i = 0
 	qs.init()
 }
 
 func (qs *QuerySchema) init() {
-	for _, f := range qs.queryFields {
+	for i, f := range qs.queryFields {
 		clone := qs.CloneField(f)
 		if call, ok := clone.Expr.(*influxql.Call); ok {
-			if call.Name == "sliding_window" {
+			if call.Window != nil {
+				// window functions are evaluated by the window transform on the rows of their input
+				qs.AddWindowCall(qs.columnNames[i], call)
+				clone.Expr = qs.windowCallInput(call)
+			} else if call.Name == "sliding_window" {
 				qs.AddSlidingWindow(call.String(), call)
 				clone.Expr = call.Args[0]
 			} else if call.Name == "holt_winters" || call.Name == "holt_winters_with_fit" {
@@ -537,7 +544,7 @@ func (qs *QuerySchema) HasNonPreCall() bool {
 }
 
 func (qs *QuerySchema) CanLimitCut() bool {
-	return qs.HasLimit() && !qs.HasCall() && !qs.HasFieldCondition() && qs.Options().FieldWildcard()
+	return qs.HasLimit() && !qs.HasCall() && !qs.HasWindowCall() && !qs.HasFieldCondition() && qs.Options().FieldWildcard()
 }
 
 func (qs *QuerySchema) CountField() map[int]bool {
@@ -578,6 +585,21 @@ func (qs *QuerySchema) HasPercentileOGSketch() bool {
 	return false
 }
 
+func (qs *QuerySchema) HasApproxCountDistinct() bool {
+	for _, f := range qs.queryFields {
+		if c, ok := f.Expr.(*influxql.Call); ok && c.Name == ApproxCountDistinct {
+			return true
+		}
+	}
+	return false
+}
+
+// HasCompositeCall returns true if the calls are split into the insert, merge and query operators
+// which are executed at different levels of the distributed plan, see CompositeCall.
+func (qs *QuerySchema) HasCompositeCall() bool {
+	return qs.HasPercentileOGSketch() || qs.HasApproxCountDistinct()
+}
+
 func (qs *QuerySchema) HasPromNestedCall() bool {
 	return len(qs.promNestedCall) > 0
 }
@@ -616,6 +638,14 @@ func (qs *QuerySchema) isPercentileOGSketch(call *influxql.Call) bool {
 	return call.Name == PercentileOGSketch
 }
 
+func (qs *QuerySchema) isApproxCountDistinct(call *influxql.Call) bool {
+	if len(call.Args) == 0 {
+		return false
+	}
+
+	return call.Name == ApproxCountDistinct
+}
+
 func (qs *QuerySchema) IsPromNestedCall(call *influxql.Call) bool {
 	if !qs.opt.IsRangeVectorSelector() {
 		return false
@@ -625,9 +655,9 @@ func (qs *QuerySchema) IsPromNestedCall(call *influxql.Call) bool {
 	return ok
 }
 
-func (qs *QuerySchema) rewritePercentileOGSketchCompositeCall() {
+func (qs *QuerySchema) rewriteCompositeCall() {
 	for key, call := range qs.calls {
-		if call.Name == PercentileOGSketch {
+		if call.Name == PercentileOGSketch || call.Name == ApproxCountDistinct {
 			cc := qs.compositeCall[key]
 
 			io := cc.GetInsertOp()
@@ -651,43 +681,51 @@ func (qs *QuerySchema) rewritePercentileOGSketchCompositeCall() {
 }
 
 func (qs *QuerySchema) genOGSketchOperator(call *influxql.Call) {
-	// add the percentile_ogsketch call
+	qs.genCompositeOperator(call, OGSketchInsert, OGSketchMerge, OGSketchPercentile)
+}
+
+func (qs *QuerySchema) genHLLOperator(call *influxql.Call) {
+	qs.genCompositeOperator(call, HLLInsert, HLLMerge, HLLCount)
+}
+
+func (qs *QuerySchema) genCompositeOperator(call *influxql.Call, insert, merge, query string) {
+	// add the original call, such as percentile_ogsketch
 	qs.addCall(call.String(), call)
 	qs.mapSymbol(call.String(), call)
 
-	// ogsketch insert
-	OGSketchInsertCall, ok := influxql.CloneExpr(call).(*influxql.Call)
+	// insert
+	insertCall, ok := influxql.CloneExpr(call).(*influxql.Call)
 	if !ok {
 		panic(fmt.Sprintf("the type of the %s should be a *influxql.Call", call.String()))
 	}
-	OGSketchInsertCall.Name = OGSketchInsert
+	insertCall.Name = insert
 	qs.notIncI = true
-	qs.mapSymbol(OGSketchInsertCall.String(), OGSketchInsertCall)
+	qs.mapSymbol(insertCall.String(), insertCall)
 	qs.notIncI = false
 
-	// ogsketch merge
-	OGSketchMergeCall, ok := influxql.CloneExpr(call).(*influxql.Call)
+	// merge
+	mergeCall, ok := influxql.CloneExpr(call).(*influxql.Call)
 	if !ok {
 		panic(fmt.Sprintf("the type of the %s should be a *influxql.Call", call.String()))
 	}
-	insetRef := qs.mapping[OGSketchInsertCall]
-	OGSketchMergeCall.Args[0].(*influxql.VarRef).Val = insetRef.Val
-	OGSketchMergeCall.Args[0].(*influxql.VarRef).Type = insetRef.Type
-	OGSketchMergeCall.Name = OGSketchMerge
-	qs.mapSymbol(OGSketchMergeCall.String(), OGSketchMergeCall)
+	insetRef := qs.mapping[insertCall]
+	mergeCall.Args[0].(*influxql.VarRef).Val = insetRef.Val
+	mergeCall.Args[0].(*influxql.VarRef).Type = insetRef.Type
+	mergeCall.Name = merge
+	qs.mapSymbol(mergeCall.String(), mergeCall)
 
-	// ogsketch query
-	OGSketchPercentileCall, ok := influxql.CloneExpr(call).(*influxql.Call)
+	// query
+	queryCall, ok := influxql.CloneExpr(call).(*influxql.Call)
 	if !ok {
 		panic(fmt.Sprintf("the type of the %s should be a *influxql.Call", call.String()))
 	}
-	mergeRef := qs.mapping[OGSketchMergeCall]
-	OGSketchPercentileCall.Args[0].(*influxql.VarRef).Val = mergeRef.Val
-	OGSketchPercentileCall.Args[0].(*influxql.VarRef).Type = mergeRef.Type
-	OGSketchPercentileCall.Name = OGSketchPercentile
-	qs.mapSymbol(OGSketchPercentileCall.String(), OGSketchPercentileCall)
+	mergeRef := qs.mapping[mergeCall]
+	queryCall.Args[0].(*influxql.VarRef).Val = mergeRef.Val
+	queryCall.Args[0].(*influxql.VarRef).Type = mergeRef.Type
+	queryCall.Name = query
+	qs.mapSymbol(queryCall.String(), queryCall)
 
-	operator := hybridqp.NewOGSketchCompositeOperator(OGSketchInsertCall, OGSketchMergeCall, OGSketchPercentileCall)
+	operator := hybridqp.NewOGSketchCompositeOperator(insertCall, mergeCall, queryCall)
 	qs.addCompositeCall(call.String(), operator)
 }
 
@@ -879,6 +917,10 @@ func (qs *QuerySchema) HoltWinters() []*influxql.Field {
 	return qs.holtWinters
 }
 
+func (qs *QuerySchema) WindowCalls() map[string]*influxql.Call {
+	return qs.windowCalls
+}
+
 func (qs *QuerySchema) SetHoltWinters(calls []*influxql.Call) {
 	for _, call := range calls {
 		f := &influxql.Field{
@@ -995,6 +1037,24 @@ func (qs *QuerySchema) AddHoltWinters(call *influxql.Call, alias string) {
 	qs.holtWinters = append(qs.holtWinters, f)
 }
 
+func (qs *QuerySchema) AddWindowCall(column string, call *influxql.Call) {
+	qs.windowCalls[column] = call
+}
+
+// windowCallInput returns the expression which feeds the window function. Functions without
+// arguments, such as row_number(), only need the rows, so any field of the query is used.
+func (qs *QuerySchema) windowCallInput(call *influxql.Call) influxql.Expr {
+	if len(call.Args) > 0 {
+		return call.Args[0]
+	}
+	for _, f := range qs.queryFields {
+		if refs := influxql.ExprNames(f.Expr); len(refs) > 0 {
+			return influxql.CloneVarRef(&refs[0])
+		}
+	}
+	return &influxql.VarRef{Val: call.Name, Type: influxql.Integer}
+}
+
 func (qs *QuerySchema) Visit(n influxql.Node) influxql.Visitor {
 	expr, ok := n.(influxql.Expr)
 	if !ok {
@@ -1042,6 +1102,11 @@ func (qs *QuerySchema) Visit(n influxql.Node) influxql.Visitor {
 			return qs
 		}
 
+		if qs.isApproxCountDistinct(n) {
+			qs.genHLLOperator(n)
+			return qs
+		}
+
 		if qs.IsPromNestedCall(n) {
 			qs.genPromNestedCall(n)
 			return qs
@@ -1288,7 +1353,7 @@ func (qs *QuerySchema) ContainSeriesIgnoreCall() bool {
 		if op.IsUDAFOp(call) {
 			return true
 		}
-		if call.Name == PercentileOGSketch {
+		if call.Name == PercentileOGSketch || call.Name == ApproxCountDistinct {
 			return true
 		}
 	}
@@ -1391,6 +1456,10 @@ func (qs *QuerySchema) HasHoltWintersCall() bool {
 	return len(qs.holtWinters) > 0
 }
 
+func (qs *QuerySchema) HasWindowCall() bool {
+	return len(qs.windowCalls) > 0
+}
+
 func (qs *QuerySchema) BuildDownSampleSchema(addPrefix bool) record.Schemas {
 	var outSchema record.Schemas
 	for _, f := range qs.origCalls {
@@ -330,6 +330,7 @@ func BuildInConditionPlan(ctx context.Context, qc query.LogicalPlanCreator, stmt
 	opt, _ := schema.Options().(*query.ProcessorOptions)
 	sopt := query.SelectOptions{
 		MaxSeriesN:       opt.MaxSeriesN,
+		PointLimiter:     opt.PointLimiter,
 		Authorizer:       opt.Authorizer,
 		ChunkedSize:      opt.ChunkedSize,
 		Chunked:          opt.Chunked,
@@ -392,6 +393,9 @@ func BuildFullJoinQueryPlan(ctx context.Context, qc query.LogicalPlanCreator, st
 	if len(joinNodes) == 0 {
 		return nil, nil
 	}
+	if joinCases[0].JoinType != influxql.FullJoin {
+		return NewLogicalSortMergeJoin(joinNodes[0], joinNodes[1], joinCases[0].JoinType, schema), nil
+	}
 	return NewLogicalFullJoin(joinNodes[0], joinNodes[1], joinConditon, schema), nil
 }
 
@@ -639,6 +643,11 @@ func buildNodes(builder *LogicalPlanBuilderImpl, schema hybridqp.Catalog, s *Que
 		buildSortNode(builder, schema, s)
 	}
 
+	// window functions are evaluated on the ordered rows before limit and offset
+	if schema.HasWindowCall() {
+		builder.Window()
+	}
+
 	// Apply limit & offset.
 	if schema.HasLimit() {
 		if schema.Options().IsExcept() {
@@ -852,7 +861,7 @@ func RebuildAggNodes(plan hybridqp.QueryNode) {
 	}
 	if childPlan, ok := plan.Children()[0].(*LogicalAggregate); ok {
 		switch childPlan.Children()[0].(type) {
-		case *LogicalOrderBy, *LogicalSortAppend, *LogicalFullJoin, *LogicalGroupBy, *LogicalSubQuery, *LogicalSort, *LogicalPromSort:
+		case *LogicalOrderBy, *LogicalSortAppend, *LogicalFullJoin, *LogicalSortMergeJoin, *LogicalGroupBy, *LogicalSubQuery, *LogicalSort, *LogicalPromSort:
 		default:
 			plan.SetInputs(plan.Children()[0].Children())
 		}
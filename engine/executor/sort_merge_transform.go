@@ -208,6 +208,12 @@ func AuxHelper(opt query.ProcessorOptions, rt hybridqp.RowDataType, filedMap map
 						colIndex:  rt.FieldIndex(value.Val),
 						isTag:     false,
 						name:      keyValue})
+				case influxql.Unsigned:
+					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
+						auxHelper: UnsignedAscendingAuxHelper,
+						colIndex:  rt.FieldIndex(value.Val),
+						isTag:     false,
+						name:      keyValue})
 				case influxql.Float:
 					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
 						auxHelper: Float64AscendingAuxHelper,
@@ -245,6 +251,12 @@ func AuxHelper(opt query.ProcessorOptions, rt hybridqp.RowDataType, filedMap map
 						colIndex:  rt.FieldIndex(value.Val),
 						isTag:     false,
 						name:      keyValue})
+				case influxql.Unsigned:
+					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
+						auxHelper: UnsignedDescendingAuxHelper,
+						colIndex:  rt.FieldIndex(value.Val),
+						isTag:     false,
+						name:      keyValue})
 				case influxql.Float:
 					AuxCompareHelpers = append(AuxCompareHelpers, &SortedMergeAuxHelper{
 						auxHelper: Float64DescendingAuxHelper,
@@ -468,6 +480,15 @@ func IntegerAscendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	return false, x.IntegerValue(xvi) < y.IntegerValue(yvj)
 }
 
+func UnsignedAscendingAuxHelper(x, y Column, i, j int) (bool, bool) {
+	xvi := x.GetValueIndexV2(i)
+	yvj := y.GetValueIndexV2(j)
+	if x.UnsignedValue(xvi) == y.UnsignedValue(yvj) {
+		return true, false
+	}
+	return false, x.UnsignedValue(xvi) < y.UnsignedValue(yvj)
+}
+
 func Float64AscendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	xvi := x.GetValueIndexV2(i)
 	yvj := y.GetValueIndexV2(j)
@@ -504,6 +525,15 @@ func IntegerDescendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	return false, x.IntegerValue(xvi) > y.IntegerValue(yvj)
 }
 
+func UnsignedDescendingAuxHelper(x, y Column, i, j int) (bool, bool) {
+	xvi := x.GetValueIndexV2(i)
+	yvj := y.GetValueIndexV2(j)
+	if x.UnsignedValue(xvi) == y.UnsignedValue(yvj) {
+		return true, false
+	}
+	return false, x.UnsignedValue(xvi) > y.UnsignedValue(yvj)
+}
+
 func Float64DescendingAuxHelper(x, y Column, i, j int) (bool, bool) {
 	xvi := x.GetValueIndexV2(i)
 	yvj := y.GetValueIndexV2(j)
@@ -238,6 +238,8 @@ func (trans *SortTransform) initNewResultFuncs() error {
 			trans.newResultFuncs = append(trans.newResultFuncs, NewFloatSortEle)
 		case influxql.Integer:
 			trans.newResultFuncs = append(trans.newResultFuncs, NewIntegerSortEle)
+		case influxql.Unsigned:
+			trans.newResultFuncs = append(trans.newResultFuncs, NewUnsignedSortEle)
 		case influxql.Boolean:
 			trans.newResultFuncs = append(trans.newResultFuncs, NewBoolSortEle)
 		case influxql.String, influxql.Tag:
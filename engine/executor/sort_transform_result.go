@@ -217,6 +217,64 @@ func (ele *integerSortEle) Clone() sortEleMsg {
 	return ele
 }
 
+type unsignedSortEle struct {
+	val      uint64
+	validVal bool
+}
+
+func NewUnsignedSortEle() sortEleMsg {
+	return &unsignedSortEle{
+		val:      0,
+		validVal: false,
+	}
+}
+
+func (ele *unsignedSortEle) LessThan(oele sortEleMsg) int {
+	if ele.validVal && oele.(*unsignedSortEle).validVal {
+		if ele.val < oele.(*unsignedSortEle).val {
+			return less
+		} else if ele.val == oele.(*unsignedSortEle).val {
+			return eq
+		} else {
+			return greater
+		}
+	} else {
+		if !ele.validVal && oele.(*unsignedSortEle).validVal {
+			return less
+		} else if !ele.validVal && !oele.(*unsignedSortEle).validVal {
+			return eq
+		} else {
+			return greater
+		}
+	}
+}
+
+func (ele *unsignedSortEle) SetVal(col Column, startLoc int) {
+	if col.IsNilV2(startLoc) {
+		return
+	}
+	ele.validVal = true
+	if col.NilCount() == 0 {
+		ele.val = col.UnsignedValue(startLoc)
+		return
+	}
+	startLoc = col.GetValueIndexV2(startLoc)
+	ele.val = col.UnsignedValue(startLoc)
+}
+
+func (ele *unsignedSortEle) AppendToCol(col Column) {
+	if !ele.validVal {
+		col.AppendNilsV2(ele.validVal)
+	} else {
+		col.AppendUnsignedValue(ele.val)
+		col.AppendNilsV2(ele.validVal)
+	}
+}
+
+func (ele *unsignedSortEle) Clone() sortEleMsg {
+	return ele
+}
+
 type stringSortEle struct {
 	val      string
 	validVal bool
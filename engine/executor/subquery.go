@@ -34,12 +34,13 @@ func (b *SubQueryBuilder) newSubOptions(ctx context.Context, opt *query.Processo
 		return query.ProcessorOptions{}, fmt.Errorf("except: sub-query or join-query is unsupported")
 	}
 	subOpt, err := query.NewProcessorOptionsStmt(b.stmt, query.SelectOptions{
-		Authorizer:  opt.Authorizer,
-		MaxSeriesN:  opt.MaxSeriesN,
-		ChunkedSize: opt.ChunkedSize,
-		Chunked:     opt.Chunked,
-		ChunkSize:   opt.ChunkSize,
-		RowsChan:    opt.RowsChan,
+		Authorizer:   opt.Authorizer,
+		MaxSeriesN:   opt.MaxSeriesN,
+		PointLimiter: opt.PointLimiter,
+		ChunkedSize:  opt.ChunkedSize,
+		Chunked:      opt.Chunked,
+		ChunkSize:    opt.ChunkSize,
+		RowsChan:     opt.RowsChan,
 	})
 
 	if err != nil {
@@ -0,0 +1,424 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+)
+
+// windowValue is the value of a window function for one row.
+type windowValue struct {
+	isNil bool
+	f     float64
+	i     int64
+	u     uint64
+	s     string
+	b     bool
+}
+
+func readWindowValue(col Column, row int) windowValue {
+	if col.IsNilV2(row) {
+		return windowValue{isNil: true}
+	}
+	idx := col.GetValueIndexV2(row)
+	switch col.DataType() {
+	case influxql.Float:
+		return windowValue{f: col.FloatValue(idx)}
+	case influxql.Integer:
+		return windowValue{i: col.IntegerValue(idx)}
+	case influxql.Unsigned:
+		return windowValue{u: col.UnsignedValue(idx)}
+	case influxql.String, influxql.Tag:
+		return windowValue{s: col.StringValue(idx)}
+	case influxql.Boolean:
+		return windowValue{b: col.BooleanValue(idx)}
+	default:
+		return windowValue{isNil: true}
+	}
+}
+
+func appendWindowValue(col Column, v windowValue) {
+	if v.isNil {
+		col.AppendNil()
+		return
+	}
+	switch col.DataType() {
+	case influxql.Float:
+		col.AppendFloatValue(v.f)
+	case influxql.Integer:
+		col.AppendIntegerValue(v.i)
+	case influxql.Unsigned:
+		col.AppendUnsignedValue(v.u)
+	case influxql.String, influxql.Tag:
+		col.AppendStringValue(v.s)
+	case influxql.Boolean:
+		col.AppendBooleanValue(v.b)
+	}
+	col.AppendNotNil()
+}
+
+// windowPendingChunk is an output chunk whose lead() values may depend on the
+// rows of the following chunks. It is sent once all of its values are known.
+type windowPendingChunk struct {
+	chunk      Chunk
+	values     [][]windowValue
+	unresolved int
+}
+
+type windowCell struct {
+	pending *windowPendingChunk
+	value   *windowValue
+}
+
+// windowColumn is the state of a window function in the current partition.
+type windowColumn struct {
+	name    string
+	index   int
+	offset  int
+	typ     influxql.DataType
+	history []windowValue
+	waiting []windowCell
+	first   windowValue
+	started bool
+}
+
+func (col *windowColumn) reset() {
+	for _, cell := range col.waiting {
+		// the following rows of the partition do not exist
+		cell.value.isNil = true
+		cell.pending.unresolved--
+	}
+	col.waiting = col.waiting[:0]
+	col.history = col.history[:0]
+	col.started = false
+}
+
+func (col *windowColumn) update(pending *windowPendingChunk, cell *windowValue, v windowValue) {
+	switch col.name {
+	case "lag":
+		if len(col.history) < col.offset {
+			cell.isNil = true
+			col.history = append(col.history, v)
+			return
+		}
+		*cell = col.history[0]
+		copy(col.history, col.history[1:])
+		col.history[len(col.history)-1] = v
+	case "lead":
+		col.waiting = append(col.waiting, windowCell{pending: pending, value: cell})
+		pending.unresolved++
+		if len(col.waiting) <= col.offset {
+			return
+		}
+		*col.waiting[0].value = v
+		col.waiting[0].pending.unresolved--
+		copy(col.waiting, col.waiting[1:])
+		col.waiting = col.waiting[:len(col.waiting)-1]
+	case "first_value":
+		if !col.started {
+			col.first = v
+			col.started = true
+		}
+		*cell = col.first
+	case "last_value":
+		// the frame of the window ends at the current row
+		*cell = v
+	}
+}
+
+// WindowTransform evaluates the window functions lag, lead, row_number, rank, first_value and
+// last_value. The rows of a partition may span many chunks, so the state of the partition is
+// kept until the tags of the input change.
+type WindowTransform struct {
+	BaseProcessor
+
+	Inputs  ChunkPorts
+	Outputs ChunkPorts
+	opt     *query.ProcessorOptions
+
+	outRowDataType hybridqp.RowDataType
+	columns        []*windowColumn
+	partition      []byte
+	keyBuf         []byte
+	hasPartition   bool
+	rowNumber      int64
+	rank           int64
+	lastTime       int64
+	pending        []*windowPendingChunk
+
+	span         *tracing.Span
+	ppWindowCost *tracing.Span
+}
+
+func NewWindowTransform(inRowDataTypes []hybridqp.RowDataType, outRowDataTypes []hybridqp.RowDataType,
+	opt *query.ProcessorOptions, schema hybridqp.Catalog) (*WindowTransform, error) {
+	if len(inRowDataTypes) != 1 || len(outRowDataTypes) != 1 {
+		panic("NewWindowTransform raise error: the Inputs and Outputs should be 1")
+	}
+
+	trans := &WindowTransform{
+		opt:            opt,
+		Inputs:         make(ChunkPorts, 0, len(inRowDataTypes)),
+		Outputs:        make(ChunkPorts, 0, len(outRowDataTypes)),
+		outRowDataType: outRowDataTypes[0],
+	}
+
+	calls := schema.WindowCalls()
+	for i, f := range outRowDataTypes[0].Fields() {
+		ref, ok := f.Expr.(*influxql.VarRef)
+		if !ok {
+			continue
+		}
+		call, ok := calls[ref.Val]
+		if !ok {
+			continue
+		}
+		col := &windowColumn{name: call.Name, index: i, offset: 1, typ: ref.Type}
+		if len(call.Args) > 1 {
+			n, ok := call.Args[1].(*influxql.IntegerLiteral)
+			if !ok || n.Val < 1 {
+				return nil, fmt.Errorf("invalid offset of window function %s", call)
+			}
+			col.offset = int(n.Val)
+		}
+		trans.columns = append(trans.columns, col)
+	}
+
+	for _, schema := range inRowDataTypes {
+		input := NewChunkPort(schema)
+		trans.Inputs = append(trans.Inputs, input)
+	}
+
+	for _, schema := range outRowDataTypes {
+		output := NewChunkPort(schema)
+		trans.Outputs = append(trans.Outputs, output)
+	}
+
+	return trans, nil
+}
+
+type WindowTransformCreator struct {
+}
+
+func (c *WindowTransformCreator) Create(plan LogicalPlan, opt *query.ProcessorOptions) (Processor, error) {
+	p, err := NewWindowTransform([]hybridqp.RowDataType{plan.Children()[0].RowDataType()}, []hybridqp.RowDataType{plan.RowDataType()}, opt, plan.Schema())
+	return p, err
+}
+
+var _ = RegistryTransformCreator(&LogicalWindow{}, &WindowTransformCreator{})
+
+func (trans *WindowTransform) Name() string {
+	return "WindowTransform"
+}
+
+func (trans *WindowTransform) Explain() []ValuePair {
+	return nil
+}
+
+func (trans *WindowTransform) Close() {
+	for _, output := range trans.Outputs {
+		output.Close()
+	}
+}
+
+func (trans *WindowTransform) initSpan() {
+	trans.span = trans.StartSpan("[Window]TotalWorkCost", true)
+	if trans.span != nil {
+		trans.ppWindowCost = trans.span.StartSpan("window_function_cost")
+	}
+}
+
+func (trans *WindowTransform) Work(ctx context.Context) error {
+	trans.initSpan()
+	defer func() {
+		tracing.Finish(trans.ppWindowCost)
+	}()
+
+	runnable := func() {
+		for {
+			select {
+			case c, ok := <-trans.Inputs[0].State:
+				tracing.StartPP(trans.span)
+				if !ok {
+					trans.endPartition()
+					trans.flush()
+					return
+				}
+				tracing.SpanElapsed(trans.ppWindowCost, func() {
+					trans.work(c)
+				})
+				tracing.EndPP(trans.span)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+
+	runnable()
+
+	trans.Close()
+
+	return nil
+}
+
+func (trans *WindowTransform) work(c Chunk) {
+	// the input chunk may be reused by the previous transform, but lead() holds it
+	out := c.Clone()
+	out.SetRowDataType(trans.outRowDataType)
+
+	rows := c.NumberOfRows()
+	pending := &windowPendingChunk{chunk: out, values: make([][]windowValue, len(trans.columns))}
+	for i := range pending.values {
+		pending.values[i] = make([]windowValue, rows)
+	}
+
+	tagIndex := c.TagIndex()
+	if len(tagIndex) == 0 {
+		trans.switchPartition(c.Name(), nil)
+		trans.processRows(c, pending, 0, rows)
+	}
+	for i, start := range tagIndex {
+		end := rows
+		if i < len(tagIndex)-1 {
+			end = tagIndex[i+1]
+		}
+		trans.switchPartition(c.Name(), &c.Tags()[i])
+		trans.processRows(c, pending, start, end)
+	}
+
+	trans.pending = append(trans.pending, pending)
+	trans.flush()
+}
+
+// switchPartition ends the current partition if the rows belong to another series group.
+func (trans *WindowTransform) switchPartition(name string, tags *ChunkTags) {
+	trans.keyBuf = append(trans.keyBuf[:0], name...)
+	trans.keyBuf = append(trans.keyBuf, 0)
+	if tags != nil {
+		trans.keyBuf = append(trans.keyBuf, tags.Subset(trans.opt.Dimensions)...)
+	}
+	if trans.hasPartition && bytes.Equal(trans.keyBuf, trans.partition) {
+		return
+	}
+
+	trans.endPartition()
+	trans.partition = append(trans.partition[:0], trans.keyBuf...)
+	trans.hasPartition = true
+}
+
+func (trans *WindowTransform) endPartition() {
+	for _, col := range trans.columns {
+		col.reset()
+	}
+	trans.rowNumber = 0
+	trans.rank = 0
+}
+
+func (trans *WindowTransform) processRows(c Chunk, pending *windowPendingChunk, start, end int) {
+	times := c.Time()
+	for row := start; row < end; row++ {
+		trans.rowNumber++
+		if trans.rowNumber == 1 || times[row] != trans.lastTime {
+			trans.rank = trans.rowNumber
+		}
+		trans.lastTime = times[row]
+
+		for i, col := range trans.columns {
+			cell := &pending.values[i][row]
+			switch col.name {
+			case "row_number":
+				cell.i = trans.rowNumber
+			case "rank":
+				cell.i = trans.rank
+			default:
+				col.update(pending, cell, readWindowValue(c.Column(col.index), row))
+			}
+		}
+	}
+}
+
+// flush sends the pending chunks in order, until a chunk still waits for the following rows.
+func (trans *WindowTransform) flush() {
+	n := 0
+	for _, pending := range trans.pending {
+		if pending.unresolved > 0 {
+			break
+		}
+		trans.sendChunk(pending)
+		n++
+	}
+	if n == 0 {
+		return
+	}
+	copy(trans.pending, trans.pending[n:])
+	for i := len(trans.pending) - n; i < len(trans.pending); i++ {
+		trans.pending[i] = nil
+	}
+	trans.pending = trans.pending[:len(trans.pending)-n]
+}
+
+func (trans *WindowTransform) sendChunk(pending *windowPendingChunk) {
+	for i, col := range trans.columns {
+		column := NewColumnImpl(col.typ)
+		for _, v := range pending.values[i] {
+			appendWindowValue(column, v)
+		}
+		pending.chunk.SetColumn(column, col.index)
+	}
+	trans.Outputs[0].State <- pending.chunk
+}
+
+func (trans *WindowTransform) GetOutputs() Ports {
+	ports := make(Ports, 0, len(trans.Outputs))
+
+	for _, output := range trans.Outputs {
+		ports = append(ports, output)
+	}
+	return ports
+}
+
+func (trans *WindowTransform) GetInputs() Ports {
+	ports := make(Ports, 0, len(trans.Inputs))
+
+	for _, input := range trans.Inputs {
+		ports = append(ports, input)
+	}
+	return ports
+}
+
+func (trans *WindowTransform) GetOutputNumber(port Port) int {
+	for i, output := range trans.Outputs {
+		if output == port {
+			return i
+		}
+	}
+	return INVALID_NUMBER
+}
+
+func (trans *WindowTransform) GetInputNumber(port Port) int {
+	for i, input := range trans.Inputs {
+		if input == port {
+			return i
+		}
+	}
+	return INVALID_NUMBER
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/engine/hybridqp"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildWindowSchema(opt *query.ProcessorOptions) *executor.QuerySchema {
+	value := &influxql.VarRef{Val: "value", Type: influxql.Float}
+	window := &influxql.WindowSpec{PartitionBy: influxql.Dimensions{{Expr: &influxql.VarRef{Val: "host"}}}}
+	fields := influxql.Fields{
+		{Expr: influxql.CloneExpr(value)},
+		{Expr: &influxql.Call{Name: "lag", Args: []influxql.Expr{influxql.CloneExpr(value)}, Window: window}},
+		{Expr: &influxql.Call{Name: "lead", Args: []influxql.Expr{influxql.CloneExpr(value)}, Window: window}},
+		{Expr: &influxql.Call{Name: "row_number", Window: window}},
+		{Expr: &influxql.Call{Name: "rank", Window: window}},
+		{Expr: &influxql.Call{Name: "first_value", Args: []influxql.Expr{influxql.CloneExpr(value)}, Window: window}},
+		{Expr: &influxql.Call{Name: "last_value", Args: []influxql.Expr{influxql.CloneExpr(value)}, Window: window}},
+	}
+	columnNames := []string{"value", "lag", "lead", "row_number", "rank", "first_value", "last_value"}
+	return executor.NewQuerySchema(fields, columnNames, opt, nil)
+}
+
+func buildWindowInRowDataType() hybridqp.RowDataType {
+	return hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "value", Type: influxql.Float},
+		influxql.VarRef{Val: "lag", Type: influxql.Float},
+		influxql.VarRef{Val: "lead", Type: influxql.Float},
+		influxql.VarRef{Val: "row_number", Type: influxql.Float},
+		influxql.VarRef{Val: "rank", Type: influxql.Float},
+		influxql.VarRef{Val: "first_value", Type: influxql.Float},
+		influxql.VarRef{Val: "last_value", Type: influxql.Float},
+	)
+}
+
+func buildWindowOutRowDataType() hybridqp.RowDataType {
+	return hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "value", Type: influxql.Float},
+		influxql.VarRef{Val: "lag", Type: influxql.Float},
+		influxql.VarRef{Val: "lead", Type: influxql.Float},
+		influxql.VarRef{Val: "row_number", Type: influxql.Integer},
+		influxql.VarRef{Val: "rank", Type: influxql.Integer},
+		influxql.VarRef{Val: "first_value", Type: influxql.Float},
+		influxql.VarRef{Val: "last_value", Type: influxql.Float},
+	)
+}
+
+func appendWindowInput(ck executor.Chunk, values []float64, nils []bool) {
+	for i := 0; i < ck.NumberOfCols(); i++ {
+		ck.Column(i).AppendFloatValues(values)
+		ck.Column(i).AppendNilsV2(nils...)
+	}
+}
+
+// buildWindowInputChunks builds the rows of host=a, host=b and host=c,
+// and the partition of host=b spans the two chunks.
+func buildWindowInputChunks() []executor.Chunk {
+	b := executor.NewChunkBuilder(buildWindowInRowDataType())
+
+	ck1 := b.NewChunk("cpu")
+	ck1.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("host=a"), *ParseChunkTags("host=b")}, []int{0, 3})
+	ck1.AppendIntervalIndexes([]int{0, 3})
+	ck1.AppendTimes([]int64{1, 2, 3, 1, 2})
+	appendWindowInput(ck1, []float64{1, 2, 3, 10}, []bool{true, true, true, true, false})
+
+	ck2 := b.NewChunk("cpu")
+	ck2.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("host=b"), *ParseChunkTags("host=c")}, []int{0, 2})
+	ck2.AppendIntervalIndexes([]int{0, 2})
+	ck2.AppendTimes([]int64{2, 3, 5})
+	appendWindowInput(ck2, []float64{30, 40, 100}, []bool{true, true, true})
+
+	return []executor.Chunk{ck1, ck2}
+}
+
+func windowColumnValues(col executor.Column) []interface{} {
+	values := make([]interface{}, 0, col.Length())
+	for i := 0; i < col.Length(); i++ {
+		if col.IsNilV2(i) {
+			values = append(values, nil)
+			continue
+		}
+		idx := col.GetValueIndexV2(i)
+		switch col.DataType() {
+		case influxql.Float:
+			values = append(values, col.FloatValue(idx))
+		case influxql.Integer:
+			values = append(values, col.IntegerValue(idx))
+		}
+	}
+	return values
+}
+
+func TestWindowTransform(t *testing.T) {
+	opt := &query.ProcessorOptions{Dimensions: []string{"host"}, Ascending: true}
+	schema := buildWindowSchema(opt)
+	inRowDataType, outRowDataType := buildWindowInRowDataType(), buildWindowOutRowDataType()
+
+	source := NewSourceFromMultiChunk(inRowDataType, buildWindowInputChunks())
+	trans, err := executor.NewWindowTransform([]hybridqp.RowDataType{inRowDataType}, []hybridqp.RowDataType{outRowDataType}, opt, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := NewNilSink(outRowDataType)
+	assert.NoError(t, executor.Connect(source.Output, trans.Inputs[0]))
+	assert.NoError(t, executor.Connect(trans.Outputs[0], sink.Input))
+
+	executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	assert.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+
+	expects := [][][]interface{}{
+		{
+			{1.0, 2.0, 3.0, 10.0, nil},
+			{nil, 1.0, 2.0, nil, 10.0},
+			{2.0, 3.0, nil, nil, 30.0},
+			{int64(1), int64(2), int64(3), int64(1), int64(2)},
+			{int64(1), int64(2), int64(3), int64(1), int64(2)},
+			{1.0, 1.0, 1.0, 10.0, 10.0},
+			{1.0, 2.0, 3.0, 10.0, nil},
+		},
+		{
+			{30.0, 40.0, 100.0},
+			{nil, 30.0, nil},
+			{40.0, nil, nil},
+			{int64(3), int64(4), int64(1)},
+			{int64(2), int64(4), int64(1)},
+			{10.0, 10.0, 100.0},
+			{30.0, 40.0, 100.0},
+		},
+	}
+	if !assert.Equal(t, len(expects), len(sink.Chunks)) {
+		return
+	}
+	for i, ck := range sink.Chunks {
+		assert.Equal(t, "cpu", ck.Name())
+		for j := range expects[i] {
+			assert.Equal(t, expects[i][j], windowColumnValues(ck.Column(j)), "chunk %d column %d", i, j)
+		}
+	}
+}
+
+func TestWindowTransformLagOffset(t *testing.T) {
+	opt := &query.ProcessorOptions{Ascending: true}
+	value := &influxql.VarRef{Val: "value", Type: influxql.Float}
+	fields := influxql.Fields{
+		{Expr: &influxql.Call{Name: "lag", Args: []influxql.Expr{value, &influxql.IntegerLiteral{Val: 2}}, Window: &influxql.WindowSpec{}}},
+		{Expr: &influxql.Call{Name: "lead", Args: []influxql.Expr{value, &influxql.IntegerLiteral{Val: 2}}, Window: &influxql.WindowSpec{}}},
+	}
+	schema := executor.NewQuerySchema(fields, []string{"lag", "lead"}, opt, nil)
+	rowDataType := hybridqp.NewRowDataTypeImpl(
+		influxql.VarRef{Val: "lag", Type: influxql.Float},
+		influxql.VarRef{Val: "lead", Type: influxql.Float},
+	)
+
+	// every chunk holds a single row, so lead() waits for the following chunks
+	b := executor.NewChunkBuilder(rowDataType)
+	chunks := make([]executor.Chunk, 0, 4)
+	for i := 1; i <= 4; i++ {
+		ck := b.NewChunk("cpu")
+		ck.AppendTagsAndIndexes([]executor.ChunkTags{*ParseChunkTags("host=a")}, []int{0})
+		ck.AppendIntervalIndexes([]int{0})
+		ck.AppendTimes([]int64{int64(i)})
+		appendWindowInput(ck, []float64{float64(i)}, []bool{true})
+		chunks = append(chunks, ck)
+	}
+
+	source := NewSourceFromMultiChunk(rowDataType, chunks)
+	trans, err := executor.NewWindowTransform([]hybridqp.RowDataType{rowDataType}, []hybridqp.RowDataType{rowDataType}, opt, schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink := NewNilSink(rowDataType)
+	assert.NoError(t, executor.Connect(source.Output, trans.Inputs[0]))
+	assert.NoError(t, executor.Connect(trans.Outputs[0], sink.Input))
+
+	executors := executor.NewPipelineExecutor(executor.Processors{source, trans, sink})
+	assert.NoError(t, executors.Execute(context.Background()))
+	executors.Release()
+
+	var lag, lead []interface{}
+	for _, ck := range sink.Chunks {
+		lag = append(lag, windowColumnValues(ck.Column(0))...)
+		lead = append(lead, windowColumnValues(ck.Column(1))...)
+	}
+	assert.Equal(t, []interface{}{nil, nil, 1.0, 2.0}, lag)
+	assert.Equal(t, []interface{}{3.0, 4.0, nil, nil}, lead)
+}
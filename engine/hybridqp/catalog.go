@@ -214,6 +214,7 @@ type Catalog interface {
 	IsPromAbsentCall() bool
 	IsPromNestedCountCall() bool
 	HasPercentileOGSketch() bool
+	HasCompositeCall() bool
 	HasPromNestedCall() bool
 	Options() Options
 	PromResetTime() bool
@@ -228,6 +229,7 @@ type Catalog interface {
 	Calls() map[string]*influxql.Call
 	SlidingWindow() map[string]*influxql.Call
 	HoltWinters() []*influxql.Field
+	WindowCalls() map[string]*influxql.Call
 	CompositeCall() map[string]*OGSketchCompositeOperator
 	PromNestedCall() map[string]*PromNestedCall
 	Binarys() map[string]*influxql.BinaryExpr
@@ -252,6 +254,7 @@ type Catalog interface {
 	HasStreamCall() bool
 	HasSlidingWindowCall() bool
 	HasHoltWintersCall() bool
+	HasWindowCall() bool
 	IsMultiMeasurements() bool
 	HasGroupBy() bool
 	Sources() influxql.Sources
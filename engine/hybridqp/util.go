@@ -223,6 +223,21 @@ func TransToInteger(v interface{}) (int64, bool) {
 	}
 }
 
+func TransToUnsigned(v interface{}) (uint64, bool) {
+	switch v := v.(type) {
+	case float64:
+		return uint64(v), true
+	case int:
+		return uint64(v), true
+	case int64:
+		return uint64(v), true
+	case uint64:
+		return v, true
+	default:
+		return uint64(0), false
+	}
+}
+
 func TransToString(v interface{}) (string, bool) {
 	s, ok := v.(string)
 	if !ok {
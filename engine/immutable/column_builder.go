@@ -54,6 +54,7 @@ type ColumnBuilder struct {
 	swapCols  []record.ColVal
 
 	intPreAggBuilder    PreAggBuilder
+	uintPreAggBuilder   PreAggBuilder
 	floatPreAggBuilder  PreAggBuilder
 	stringPreAggBuilder PreAggBuilder
 	boolPreAggBuilder   PreAggBuilder
@@ -84,6 +85,9 @@ func (b *ColumnBuilder) resetPreAgg() {
 	if b.intPreAggBuilder != nil {
 		b.intPreAggBuilder.reset()
 	}
+	if b.uintPreAggBuilder != nil {
+		b.uintPreAggBuilder.reset()
+	}
 	if b.floatPreAggBuilder != nil {
 		b.floatPreAggBuilder.reset()
 	}
@@ -114,6 +118,15 @@ func (b *ColumnBuilder) initEncoder(ref record.Field) error {
 		}
 		b.intPreAggBuilder.reset()
 		return nil
+	case influx.Field_Type_UInt:
+		if b.coder.GetUnsignedCoder() == nil {
+			b.coder.SetUnsignedCoder(encoding.GetUnsignedCoder())
+		}
+		if b.uintPreAggBuilder == nil {
+			b.uintPreAggBuilder = acquireColumnBuilder(influx.Field_Type_UInt)
+		}
+		b.uintPreAggBuilder.reset()
+		return nil
 	case influx.Field_Type_Float:
 		if b.coder.GetFloatCoder() == nil {
 			b.coder.SetFloatCoder(encoding.GetFloatCoder())
@@ -198,6 +211,56 @@ func (b *ColumnBuilder) encIntegerColumn(timeCols []record.ColVal, segCols []rec
 	return err
 }
 
+func (b *ColumnBuilder) encUnsignedColumn(timeCols []record.ColVal, segCols []record.ColVal, offset int64) error {
+	var err error
+	if b.uintPreAggBuilder == nil {
+		b.uintPreAggBuilder = acquireColumnBuilder(influx.Field_Type_UInt)
+	}
+	b.uintPreAggBuilder.reset()
+
+	for i := range segCols {
+		segCol := &segCols[i]
+		tmCol := timeCols[i]
+		if segCol.Length() != tmCol.Length() {
+			err = fmt.Errorf("%v column rows not equal time rows, %v != %v", b.colMeta.Name(), segCol.Length(), tmCol.Length())
+			b.log.Error(err.Error())
+			panic(err)
+		}
+
+		times := tmCol.IntegerValues()
+		b.uintPreAggBuilder.addValues(segCol, times)
+		m := &b.colMeta.entries[i+b.position]
+		m.setOffset(offset)
+		pos := len(b.data)
+		if b.encodeMode != nil {
+			b.data = b.encodeMode.reserveCrc(b.data)
+		}
+
+		if CanEncodeOneRowMode(segCol) {
+			b.data = append(b.data, encoding.BlockUnsignedOne)
+			b.data = append(b.data, segCol.Val...)
+		} else {
+			b.data = EncodeColumnHeader(segCol, b.data, encoding.BlockUnsigned)
+			b.data, err = encoding.EncodeUnsignedFieldBlock(segCol.Val, b.data, b.coder)
+			if err != nil {
+				b.log.Error("encode unsigned value fail", zap.Error(err))
+				return err
+			}
+		}
+
+		if b.encodeMode != nil {
+			b.data = b.encodeMode.setCrc(b.data, pos)
+		}
+		size := uint32(len(b.data) - pos)
+		m.setSize(size)
+		offset += int64(size)
+	}
+
+	b.colMeta.preAgg = b.uintPreAggBuilder.marshal(b.colMeta.preAgg[:0])
+
+	return err
+}
+
 func (b *ColumnBuilder) encFloatColumn(timeCols []record.ColVal, segCols []record.ColVal, offset int64) error {
 	var err error
 	if b.floatPreAggBuilder == nil {
@@ -377,6 +440,8 @@ func (b *ColumnBuilder) encode(ref record.Field, dataCols, timeCols []record.Col
 	switch ref.Type {
 	case influx.Field_Type_Int:
 		err = b.encIntegerColumn(timeCols, dataCols, dataOffset)
+	case influx.Field_Type_UInt:
+		err = b.encUnsignedColumn(timeCols, dataCols, dataOffset)
 	case influx.Field_Type_Float:
 		err = b.encFloatColumn(timeCols, dataCols, dataOffset)
 	case influx.Field_Type_String:
@@ -418,6 +483,8 @@ func (b *ColumnBuilder) BuildPreAgg() {
 		} else {
 			builder = b.intPreAggBuilder
 		}
+	case influx.Field_Type_UInt:
+		builder = b.uintPreAggBuilder
 	default:
 		panic(b.colMeta.ty)
 	}
@@ -180,6 +180,8 @@ func (m *MmsTables) compact(itrs *ChunkIterators, files []TSSPFile, level uint16
 	tableBuilder.WithLog(cLog)
 
 	correctTimeDisorder := config.GetStoreConfig().Compact.CorrectTimeDisorder
+	expire := m.expireTime(itrs.name)
+	ttlRec := &record.Record{}
 
 	for {
 		select {
@@ -208,6 +210,13 @@ func (m *MmsTables) compact(itrs *ChunkIterators, files []TSSPFile, level uint16
 			record.CheckTimes(rec.Times())
 		}
 
+		if expire > 0 {
+			rec = dropExpiredRows(rec, ttlRec, expire)
+			if rec == nil {
+				continue
+			}
+		}
+
 		tableBuilder, err = tableBuilder.WriteRecord(id, rec, func(fn TSSPFileName) (uint64, uint16, uint16, uint16) {
 			ext := fn.extent
 			ext++
@@ -58,6 +58,7 @@ type FirstLastReader struct {
 	segIndex    int
 	floatPreAgg *FloatPreAgg
 	intPreAgg   *IntegerPreAgg
+	uintPreAgg  *UnsignedPreAgg
 }
 
 func (r *FirstLastReader) Init(cm *ChunkMeta, cr ColumnReader, ref *record.Field, dst *record.Record, first bool) *FirstLastReader {
@@ -319,6 +320,13 @@ func (r *FirstLastReader) getIntPreAgg() PreAggBuilder {
 	return r.intPreAgg
 }
 
+func (r *FirstLastReader) getUnsignedPreAgg() PreAggBuilder {
+	if r.uintPreAgg == nil {
+		r.uintPreAgg = NewUnsignedPreAgg()
+	}
+	return r.uintPreAgg
+}
+
 func (r *FirstLastReader) getFloatPreAgg() PreAggBuilder {
 	if r.floatPreAgg == nil {
 		r.floatPreAgg = NewFloatPreAgg()
@@ -331,6 +339,8 @@ func (r *FirstLastReader) unmarshalPreAgg(col *ColumnMeta) (PreAggBuilder, bool)
 	switch col.ty {
 	case influx.Field_Type_Int:
 		ab = r.getIntPreAgg()
+	case influx.Field_Type_UInt:
+		ab = r.getUnsignedPreAgg()
 	case influx.Field_Type_Float:
 		ab = r.getFloatPreAgg()
 	default:
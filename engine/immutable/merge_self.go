@@ -52,6 +52,8 @@ func (m *MergeSelf) Merge(mst string, toLevel uint16, files []TSSPFile) (TSSPFil
 	defer sh.Release()
 
 	itrs := m.createIterators(files)
+	expire := m.mts.expireTime(mst)
+	ttlRec := &record.Record{}
 
 	for {
 		sid, rec, err := itrs.Next()
@@ -69,6 +71,12 @@ func (m *MergeSelf) Merge(mst string, toLevel uint16, files []TSSPFile) (TSSPFil
 		record.CheckRecord(rec)
 		rec = sh.Sort(rec)
 		itrs.merged = rec
+		if expire > 0 {
+			rec = dropExpiredRows(rec, ttlRec, expire)
+			if rec == nil {
+				continue
+			}
+		}
 		builder, err = builder.WriteRecord(sid, rec, nil)
 		if err != nil {
 			builder.Reset()
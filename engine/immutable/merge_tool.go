@@ -239,6 +239,11 @@ func (mt *mergeTool) mergeSelfFastMode(ctx *MergeContext) {
 	begin := time.Now()
 
 	merged, err := ms.Merge(ctx.mst, ctx.ToLevel(), files.Files())
+	if err == nil && merged == nil {
+		// all the rows are expired, the files are dropped by DropExpiredFiles
+		mt.lg.Info("all rows are expired, skip merge self", zap.String("mst", ctx.mst))
+		return
+	}
 
 	if err == nil {
 		err = events.TriggerReplaceFile(filepath.Dir(mt.mts.path), *mt.mts.lock)
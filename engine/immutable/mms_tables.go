@@ -102,6 +102,8 @@ type TablesStore interface {
 	GetShardID() uint64
 	SetIndexMergeSet(idx IndexMergeSet)
 	GetAllMstList() []string
+	SetMeasurementTTL(fn MeasurementTTL)
+	DropExpiredFiles()
 }
 
 type ImmTable interface {
@@ -161,6 +163,7 @@ type MmsTables struct {
 
 	indexMergeSet IndexMergeSet
 	scheduler     *scheduler.TaskScheduler
+	ttl           MeasurementTTL
 }
 
 func NewTableStore(dir string, lock *string, tier *uint64, compactRecovery bool, config *Config) *MmsTables {
@@ -1312,6 +1312,11 @@ func (b *MsBuilder) releaseEncoders() {
 					colBuilder.intPreAggBuilder = nil
 				}
 
+				if colBuilder.uintPreAggBuilder != nil {
+					colBuilder.uintPreAggBuilder.release()
+					colBuilder.uintPreAggBuilder = nil
+				}
+
 				if colBuilder.floatPreAggBuilder != nil {
 					colBuilder.floatPreAggBuilder.release()
 					colBuilder.floatPreAggBuilder = nil
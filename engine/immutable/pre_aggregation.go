@@ -55,14 +55,15 @@ type PreAggBuilder interface {
 }
 
 var (
-	integerPreAggPool = sync.Pool{}
-	floatPreAggPool   = sync.Pool{}
-	boolPreAggPool    = sync.Pool{}
-	stringPreAggPool  = sync.Pool{}
-	timePreAggPool    = sync.Pool{}
-	SegmentLen        = (Segment{}).bytes()
-	ColumnMetaLenMin  = (ColumnMeta{}).bytes(1)
-	ChunkMetaMinLen   = (&ChunkMeta{}).minBytes()
+	integerPreAggPool  = sync.Pool{}
+	unsignedPreAggPool = sync.Pool{}
+	floatPreAggPool    = sync.Pool{}
+	boolPreAggPool     = sync.Pool{}
+	stringPreAggPool   = sync.Pool{}
+	timePreAggPool     = sync.Pool{}
+	SegmentLen         = (Segment{}).bytes()
+	ColumnMetaLenMin   = (ColumnMeta{}).bytes(1)
+	ChunkMetaMinLen    = (&ChunkMeta{}).minBytes()
 )
 
 const (
@@ -73,6 +74,7 @@ const (
 
 type PreAggBuilders struct {
 	intBuilder    PreAggBuilder
+	uintBuilder   PreAggBuilder
 	floatBuilder  PreAggBuilder
 	stringBuilder PreAggBuilder
 	boolBuilder   PreAggBuilder
@@ -82,6 +84,7 @@ type PreAggBuilders struct {
 func newPreAggBuilders() *PreAggBuilders {
 	b := &PreAggBuilders{
 		intBuilder:    acquireColumnBuilder(influx.Field_Type_Int),
+		uintBuilder:   acquireColumnBuilder(influx.Field_Type_UInt),
 		floatBuilder:  acquireColumnBuilder(influx.Field_Type_Float),
 		stringBuilder: acquireColumnBuilder(influx.Field_Type_String),
 		boolBuilder:   acquireColumnBuilder(influx.Field_Type_Boolean),
@@ -107,8 +110,17 @@ func (b *PreAggBuilders) IntegerBuilder() *IntegerPreAgg {
 	return builder
 }
 
+func (b *PreAggBuilders) UnsignedBuilder() *UnsignedPreAgg {
+	builder, ok := b.uintBuilder.(*UnsignedPreAgg)
+	if !ok || builder == nil {
+		builder = &UnsignedPreAgg{}
+	}
+	return builder
+}
+
 func (b *PreAggBuilders) reset() {
 	b.intBuilder.reset()
+	b.uintBuilder.reset()
 	b.floatBuilder.reset()
 	b.stringBuilder.reset()
 	b.boolBuilder.reset()
@@ -122,6 +134,8 @@ func (b *PreAggBuilders) Release() {
 
 	ReleaseColumnBuilder(b.intBuilder)
 	b.intBuilder = nil
+	ReleaseColumnBuilder(b.uintBuilder)
+	b.uintBuilder = nil
 	ReleaseColumnBuilder(b.floatBuilder)
 	b.floatBuilder = nil
 	ReleaseColumnBuilder(b.stringBuilder)
@@ -139,6 +153,8 @@ func (b *PreAggBuilders) aggBuilder(ref *record.Field) PreAggBuilder {
 			return b.timeBuilder
 		}
 		return b.intBuilder
+	case influx.Field_Type_UInt:
+		return b.uintBuilder
 	case influx.Field_Type_Float:
 		return b.floatBuilder
 	case influx.Field_Type_String:
@@ -165,6 +181,12 @@ func acquireColumnBuilder(ty int) PreAggBuilder {
 			return NewIntegerPreAgg()
 		}
 		return v.(*IntegerPreAgg)
+	case influx.Field_Type_UInt:
+		v := unsignedPreAggPool.Get()
+		if v == nil {
+			return NewUnsignedPreAgg()
+		}
+		return v.(*UnsignedPreAgg)
 	case influx.Field_Type_Float:
 		v := floatPreAggPool.Get()
 		if v == nil {
@@ -405,6 +427,229 @@ func (m *IntegerPreAgg) merge(other *IntegerPreAgg) {
 	m.values[countIndex] += other.values[countIndex]
 }
 
+// UnsignedPreAgg If you change the order of the elements in the structure,
+// remember to modify marshal() and unmarshal() as well.
+type UnsignedPreAgg struct {
+	minV    uint64
+	maxV    uint64
+	minTime int64
+	maxTime int64
+	sumV    uint64
+	countV  int64
+}
+
+func NewUnsignedPreAgg() *UnsignedPreAgg {
+	m := &UnsignedPreAgg{}
+	m.reset()
+	return m
+}
+
+func (m *UnsignedPreAgg) size() int {
+	return int(unsafe.Sizeof(*m))
+}
+
+func (m *UnsignedPreAgg) marshal(dst []byte) []byte {
+	if m.countV == 1 {
+		dst = numberenc.MarshalUint64Append(dst, m.minV)
+		dst = numberenc.MarshalInt64Append(dst, m.minTime)
+		return dst
+	}
+
+	if IsChunkMetaCompressSelf() {
+		size := len(dst)
+		dst = m.VLCEncode(dst)
+
+		if PreAggOnlyOneRow(dst[size:]) {
+			//Conflict with the encoding mode of only one row of data
+			//Pad 0 at the end for placeholder
+			dst = append(dst, 0)
+			return dst
+		}
+
+		if len(dst)-size < m.size() {
+			return dst
+		}
+		//negative income, coded in the original way
+		dst = dst[:size]
+	}
+
+	dst = numberenc.MarshalUint64Append(dst, m.minV)
+	dst = numberenc.MarshalUint64Append(dst, m.maxV)
+	dst = numberenc.MarshalInt64Append(dst, m.minTime)
+	dst = numberenc.MarshalInt64Append(dst, m.maxTime)
+	dst = numberenc.MarshalUint64Append(dst, m.sumV)
+	dst = numberenc.MarshalInt64Append(dst, m.countV)
+	return dst
+}
+
+func (m *UnsignedPreAgg) unmarshal(src []byte) ([]byte, error) {
+	if PreAggOnlyOneRow(src) {
+		// only one row of data
+		m.minV, src = numberenc.UnmarshalUint64(src), src[8:]
+		m.minTime, src = numberenc.UnmarshalInt64(src), src[8:]
+		m.maxV = m.minV
+		m.maxTime = m.minTime
+		m.sumV = m.minV
+		m.countV = 1
+		return src, nil
+	}
+
+	if len(src) < m.size() {
+		return m.VLCDecode(src)
+	}
+
+	m.minV, src = numberenc.UnmarshalUint64(src), src[8:]
+	m.maxV, src = numberenc.UnmarshalUint64(src), src[8:]
+	m.minTime, src = numberenc.UnmarshalInt64(src), src[8:]
+	m.maxTime, src = numberenc.UnmarshalInt64(src), src[8:]
+	m.sumV, src = numberenc.UnmarshalUint64(src), src[8:]
+	m.countV, src = numberenc.UnmarshalInt64(src), src[8:]
+	return src, nil
+}
+
+func (m *UnsignedPreAgg) VLCEncode(dst []byte) []byte {
+	dst = binary.AppendUvarint(dst, m.minV)
+	dst = binary.AppendUvarint(dst, m.maxV)
+	dst = binary.AppendUvarint(dst, m.sumV)
+	dst = binary.AppendUvarint(dst, uint64(m.countV))
+
+	dst = codec.AppendInt64WithScale(dst, m.minTime)
+	dst = codec.AppendInt64WithScale(dst, m.maxTime-m.minTime)
+	return dst
+}
+
+func (m *UnsignedPreAgg) VLCDecode(src []byte) ([]byte, error) {
+	var n int
+
+	m.minV, n = binary.Uvarint(src)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid min value")
+	}
+
+	src = src[n:]
+	m.maxV, n = binary.Uvarint(src)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid max value")
+	}
+
+	src = src[n:]
+	m.sumV, n = binary.Uvarint(src)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid sum value")
+	}
+
+	src = src[n:]
+	v, n := binary.Uvarint(src)
+	if n <= 0 {
+		return nil, fmt.Errorf("invalid count value")
+	}
+	m.countV = int64(v)
+
+	src, minTime, maxTime, err := DecodeAggTimes(src[n:])
+	if err != nil {
+		return nil, err
+	}
+	m.minTime = minTime
+	m.maxTime = maxTime
+
+	return src, nil
+}
+
+func (m *UnsignedPreAgg) reset() {
+	m.minV = math.MaxUint64 // min
+	m.maxV = 0              // max
+	m.minTime = 0           // minT
+	m.maxTime = 0           // maxT
+	m.sumV = 0              // sum
+	m.countV = 0            // count
+}
+
+func (m *UnsignedPreAgg) min() (interface{}, int64) {
+	return m.minV, m.minTime
+}
+
+func (m *UnsignedPreAgg) max() (interface{}, int64) {
+	return m.maxV, m.maxTime
+}
+
+func (m *UnsignedPreAgg) count() int64 {
+	return m.countV
+}
+
+func (m *UnsignedPreAgg) sum() interface{} {
+	return m.sumV
+}
+
+func (m *UnsignedPreAgg) addValues(col *record.ColVal, times []int64) {
+	values := col.UnsignedValues()
+	valLen := len(values)
+	for i, j := 0, 0; i < col.Len; i++ {
+		if col.NilCount > 0 && col.IsNil(i) {
+			continue
+		}
+
+		v := values[j]
+		j++
+		// the first value must set both min and max, max starts at 0 which is a valid value
+		if m.minV > v || (m.countV == 0 && j == 1) {
+			m.minV = v
+			m.minTime = times[i]
+		}
+		if m.maxV < v || (m.countV == 0 && j == 1) {
+			m.maxV = v
+			m.maxTime = times[i]
+		}
+
+		m.sumV += v
+	}
+
+	m.countV += int64(valLen)
+}
+
+func (m *UnsignedPreAgg) release() {
+	m.reset()
+	unsignedPreAggPool.Put(m)
+}
+
+// addMin, addMax and addSum take float64 to satisfy PreAggBuilder, merge
+// uses addMinUnsigned and addMaxUnsigned so no precision is lost.
+func (m *UnsignedPreAgg) addMin(v float64, tm int64) { m.addMinUnsigned(uint64(v), tm) }
+func (m *UnsignedPreAgg) addMax(v float64, tm int64) { m.addMaxUnsigned(uint64(v), tm) }
+func (m *UnsignedPreAgg) addSum(v float64)           { m.sumV += uint64(v) }
+func (m *UnsignedPreAgg) addCount(n int64)           { m.countV += n }
+
+func (m *UnsignedPreAgg) addMinUnsigned(v uint64, tm int64) {
+	if m.minV > v {
+		m.minV = v
+		m.minTime = tm
+	} else if m.minV == v && tm < m.minTime {
+		m.minTime = tm
+	}
+}
+
+func (m *UnsignedPreAgg) addMaxUnsigned(v uint64, tm int64) {
+	if m.maxV < v {
+		m.maxV = v
+		m.maxTime = tm
+	} else if m.maxV == v && tm < m.maxTime {
+		m.maxTime = tm
+	}
+}
+
+func (m *UnsignedPreAgg) merge(other *UnsignedPreAgg) {
+	if other.countV == 0 {
+		return
+	}
+	if m.countV == 0 {
+		*m = *other
+		return
+	}
+	m.addMinUnsigned(other.minV, other.minTime)
+	m.addMaxUnsigned(other.maxV, other.maxTime)
+	m.sumV += other.sumV
+	m.countV += other.countV
+}
+
 // FloatPreAgg If you change the order of the elements in the structure,
 // remember to modify marshal() and unmarshal() as well.
 type FloatPreAgg struct {
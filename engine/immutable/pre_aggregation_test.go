@@ -16,6 +16,7 @@ package immutable
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -48,6 +49,40 @@ func TestIntegerPreAgg(t *testing.T) {
 	require.Equal(t, int64(6), time)
 }
 
+func TestUnsignedPreAgg(t *testing.T) {
+	agg := NewUnsignedPreAgg()
+	col := &record.ColVal{}
+	var times []int64
+
+	for i := 0; i < 10; i++ {
+		if i > 5 {
+			col.AppendUnsigned(math.MaxUint64 - uint64(i))
+		} else {
+			col.AppendUnsignedNull()
+		}
+		times = append(times, int64(i))
+	}
+
+	agg.addValues(col, times)
+	val, time := agg.max()
+	require.Equal(t, uint64(math.MaxUint64-6), val.(uint64))
+	require.Equal(t, int64(6), time)
+
+	val, time = agg.min()
+	require.Equal(t, uint64(math.MaxUint64-9), val.(uint64))
+	require.Equal(t, int64(9), time)
+	require.Equal(t, int64(4), agg.count())
+
+	for _, mode := range []int{ChunkMetaCompressNone, ChunkMetaCompressSelf} {
+		SetChunkMetaCompressMode(mode)
+		other := NewUnsignedPreAgg()
+		_, err := other.unmarshal(agg.marshal(nil))
+		require.NoError(t, err)
+		require.Equal(t, agg, other)
+	}
+	SetChunkMetaCompressMode(ChunkMetaCompressNone)
+}
+
 func TestFloatPreAgg(t *testing.T) {
 	agg := NewFloatPreAgg()
 	col := &record.ColVal{}
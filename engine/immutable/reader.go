@@ -205,6 +205,23 @@ func readMinRowIndex(callRef *record.Field, callCol, timeCol *record.ColVal, met
 			meta.SetMin(min, t)
 			isSet = true
 		}
+	case influx.Field_Type_UInt:
+		var min uint64
+		for i := rowIdxStart; i < rowIdxStop; i++ {
+			v, isNil := callCol.UnsignedValue(i)
+			if !isNil && (v < min || !seen) {
+				min = v
+				rowIndex = i
+				seen = true
+			}
+		}
+
+		origMin, _ := meta.Min()
+		if seen && (IsInterfaceNil(origMin) || origMin.(uint64) > min) {
+			t, _ := timeCol.IntegerValue(rowIndex)
+			meta.SetMin(min, t)
+			isSet = true
+		}
 	case influx.Field_Type_Float:
 		min := math.MaxFloat64
 		for i := rowIdxStart; i < rowIdxStop; i++ {
@@ -301,6 +318,23 @@ func readMaxRowIndex(callRef *record.Field, callCol, timeCol *record.ColVal, met
 			meta.SetMax(max, t)
 			isSet = true
 		}
+	case influx.Field_Type_UInt:
+		var max uint64
+		for i := rowIdxStart; i < rowIdxStop; i++ {
+			v, isNil := callCol.UnsignedValue(i)
+			if !isNil && (v > max || !seen) {
+				max = v
+				rowIndex = i
+				seen = true
+			}
+		}
+
+		origMax, _ := meta.Max()
+		if seen && (IsInterfaceNil(origMax) || origMax.(uint64) < max) {
+			t, _ := timeCol.IntegerValue(rowIndex)
+			meta.SetMax(max, t)
+			isSet = true
+		}
 	case influx.Field_Type_Float:
 		max := -math.MaxFloat64
 		for i := rowIdxStart; i < rowIdxStop; i++ {
@@ -364,6 +398,11 @@ func getColumnValue(ref *record.Field, col *record.ColVal, rowIndex int) interfa
 		if isNil {
 			return nil
 		}
+	case influx.Field_Type_UInt:
+		value, isNil = col.UnsignedValue(rowIndex)
+		if isNil {
+			return nil
+		}
 	case influx.Field_Type_Float:
 		value, isNil = col.FloatValue(rowIndex)
 		if isNil {
@@ -393,6 +432,14 @@ func reserveColumnValue(ref *record.Field, col *record.ColVal, rowIndex int) {
 		} else {
 			col.AppendIntegerNull()
 		}
+	case influx.Field_Type_UInt:
+		value, isNil := col.UnsignedValue(rowIndex)
+		col.Init()
+		if !isNil {
+			col.AppendUnsigned(value)
+		} else {
+			col.AppendUnsignedNull()
+		}
 	case influx.Field_Type_Float:
 		value, isNil := col.FloatValue(rowIndex)
 		col.Init()
@@ -425,6 +472,9 @@ func setColumnDefaultValue(ref *record.Field, col *record.ColVal) {
 	case influx.Field_Type_Int:
 		col.Init()
 		col.AppendInteger(int64(0))
+	case influx.Field_Type_UInt:
+		col.Init()
+		col.AppendUnsigned(uint64(0))
 	case influx.Field_Type_Float:
 		col.Init()
 		col.AppendFloat(float64(0))
@@ -442,6 +492,9 @@ func setColumnNullValue(ref *record.Field, col *record.ColVal) {
 	case influx.Field_Type_Int:
 		col.Init()
 		col.AppendIntegerNull()
+	case influx.Field_Type_UInt:
+		col.Init()
+		col.AppendUnsignedNull()
 	case influx.Field_Type_Float:
 		col.Init()
 		col.AppendFloatNull()
@@ -480,6 +533,25 @@ func sumRangeValues(ref *record.Field, col *record.ColVal, rowIdxStart, rowIdxSt
 			sum += s
 		}
 		meta.SetSum(sum)
+	case influx.Field_Type_UInt:
+		var sum uint64
+		values := col.SubUnsignedValues(rowIdxStart, rowIdxStop)
+		if len(values) == 0 {
+			return
+		}
+		for _, n := range values {
+			sum += n
+		}
+
+		s := meta.Sum()
+		if !IsInterfaceNil(s) {
+			s, ok := s.(uint64)
+			if !ok {
+				panic("meta Sum isn't uint64 type")
+			}
+			sum += s
+		}
+		meta.SetSum(sum)
 	case influx.Field_Type_Float:
 		var sum float64
 		values := col.SubFloatValues(rowIdxStart, rowIdxStop)
@@ -528,6 +600,33 @@ func appendIntegerColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte,
 	return nil
 }
 
+func appendUnsignedColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, ctx *ReadContext) error {
+	col.Init()
+	if len(encData) != 0 {
+		values, err := encoding.DecodeUnsignedFieldBlock(encData, &col.Val, ctx.coderCtx)
+		if err != nil {
+			return err
+		}
+
+		rows := len(values) + int(nilCount)
+		col.ReserveBitmap(len(col.Val))
+		col.AppendBitmap(nilBitmap, int(bitmapOffset), rows, 0, rows)
+
+		if !ctx.Ascending {
+			_ = reverseValues(values)
+			col.Bitmap = record.ReverseBitMap(col.Bitmap, uint32(col.BitMapOffset), rows)
+		}
+
+		col.Len += rows
+		col.NilCount += int(nilCount)
+	} else {
+		rows := int(nilCount)
+		col.Append(nil, nil, nilBitmap, int(bitmapOffset), rows, int(nilCount), influx.Field_Type_UInt, 0, rows, 0, 0)
+	}
+
+	return nil
+}
+
 func appendFloatColumn(nilBitmap []byte, bitmapOffset uint32, encData []byte, nilCount uint32, col *record.ColVal, ctx *ReadContext) error {
 	col.Init()
 	if len(encData) != 0 {
@@ -621,6 +720,7 @@ var decFuncs = make(map[int]func(nilBitmap []byte, bitmapOffset uint32, encData
 
 func InitDecFunctions() {
 	decFuncs[influx.Field_Type_Int] = appendIntegerColumn
+	decFuncs[influx.Field_Type_UInt] = appendUnsignedColumn
 	decFuncs[influx.Field_Type_Float] = appendFloatColumn
 	decFuncs[influx.Field_Type_Boolean] = appendBooleanColumn
 	decFuncs[influx.Field_Type_String] = appendStringColumn
@@ -886,6 +986,10 @@ func getValues(rec *record.Record, filterOption *BaseFilterOptions, validCountsL
 		if rec.Schema[id].Type == influx.Field_Type_Int {
 			Integervalues[k] = rec.ColVals[id].IntegerValues()
 		}
+		if rec.Schema[id].Type == influx.Field_Type_UInt {
+			// unsigned values share the 8-byte layout of integers, see ignoreTypeFun
+			Integervalues[k] = rec.ColVals[id].IntegerValues()
+		}
 		if rec.Schema[id].Type == influx.Field_Type_Boolean {
 			Boolvalues[k] = rec.ColVals[id].BooleanValues()
 		}
@@ -1006,6 +1110,14 @@ func initIgnoreTypeFun() {
 		filterMap.SetFilterMapValue(name, Integervalue[validCount])
 	}
 
+	ignoreTypeFun[influx.Field_Type_UInt] = func(filterMap influxql.FilterMapValuer, name string, i int, col record.ColVal, validCount int, Integervalue []int64, Floatvalue []float64, Boolvalue []bool) {
+		if col.IsNil(i) {
+			filterMap.SetFilterMapValue(name, (*uint64)(nil))
+			return
+		}
+		filterMap.SetFilterMapValue(name, uint64(Integervalue[validCount]))
+	}
+
 	ignoreTypeFun[influx.Field_Type_Float] = func(filterMap influxql.FilterMapValuer, name string, i int, col record.ColVal, validCount int, Integervalue []int64, Floatvalue []float64, Boolvalue []bool) {
 		if col.IsNil(i) {
 			filterMap.SetFilterMapValue(name, (*float64)(nil))
@@ -1032,7 +1144,7 @@ func initIgnoreTypeFun() {
 	}
 }
 
-func reverseValues[T int64 | float64 | bool](values []T) []T {
+func reverseValues[T int64 | uint64 | float64 | bool](values []T) []T {
 	for i, j := 0, len(values)-1; i < j; {
 		values[i], values[j] = values[j], values[i]
 		i++
@@ -1091,6 +1203,8 @@ func readAuxData(cm *ChunkMeta, segment int, rowIndex int, dst *record.Record, c
 				col.AppendFloatNull()
 			case influx.Field_Type_Int:
 				col.AppendIntegerNull()
+			case influx.Field_Type_UInt:
+				col.AppendUnsignedNull()
 			case influx.Field_Type_String:
 				col.AppendStringNull()
 			case influx.Field_Type_Boolean:
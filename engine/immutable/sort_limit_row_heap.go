@@ -82,6 +82,8 @@ func (rs *SortLimitRows) PopToRec() *record.Record {
 					r.ColVals[i].AppendFloatNull()
 				case influx.Field_Type_Int:
 					r.ColVals[i].AppendIntegerNull()
+				case influx.Field_Type_UInt:
+					r.ColVals[i].AppendUnsignedNull()
 				case influx.Field_Type_String:
 					r.ColVals[i].AppendStringNull()
 				case influx.Field_Type_Boolean:
@@ -96,6 +98,8 @@ func (rs *SortLimitRows) PopToRec() *record.Record {
 				r.ColVals[i].AppendFloat(data[i].(float64))
 			case influx.Field_Type_Int:
 				r.ColVals[i].AppendInteger(data[i].(int64))
+			case influx.Field_Type_UInt:
+				r.ColVals[i].AppendUnsigned(data[i].(uint64))
 			case influx.Field_Type_String:
 				r.ColVals[i].AppendString(data[i].(string))
 			case influx.Field_Type_Boolean:
@@ -136,6 +140,12 @@ func compareRows(sortIndex []int, schema record.Schemas, row1, row2 []interface{
 				continue
 			}
 			return isSort
+		case influx.Field_Type_UInt:
+			isSort, isEqual := CompareT(row1[index].(uint64), row2[index].(uint64), isAscending)
+			if isEqual {
+				continue
+			}
+			return isSort
 		case influx.Field_Type_String:
 			isSort, isEqual := CompareT(row1[index].(string), row2[index].(string), isAscending)
 			if isEqual {
@@ -149,7 +159,7 @@ func compareRows(sortIndex []int, schema record.Schemas, row1, row2 []interface{
 	return true
 }
 
-func CompareT[T int | int64 | float64 | string](s1, s2 T, isAscending bool) (bool, bool) {
+func CompareT[T int | int64 | uint64 | float64 | string](s1, s2 T, isAscending bool) (bool, bool) {
 	if s1 == s2 {
 		return false, true
 	}
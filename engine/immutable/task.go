@@ -91,7 +91,7 @@ func (t *CompactTask) Execute() {
 	}
 
 	var tmpTSSP = fi.oldFiles[0].Path()
-	err = m.ImmTable.compactToLevel(m, fi, t.full, NonStreamingCompaction(fi))
+	err = m.ImmTable.compactToLevel(m, fi, t.full, NonStreamingCompaction(fi) || m.hasExpiredRows(fi))
 	if err != nil {
 		compactStat.AddErrors(1)
 		log.Error("compact error", zap.Error(err))
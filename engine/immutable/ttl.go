@@ -0,0 +1,156 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable
+
+import (
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/record"
+	"go.uber.org/zap"
+)
+
+// MeasurementTTL returns the time to live of the measurement, 0 means the rows never expire.
+// The name of the measurement is passed with version.
+type MeasurementTTL func(mst string) time.Duration
+
+func (m *MmsTables) SetMeasurementTTL(fn MeasurementTTL) {
+	m.mu.Lock()
+	m.ttl = fn
+	m.mu.Unlock()
+}
+
+// expireTime returns the time before which the rows of the measurement are expired,
+// 0 means the measurement has no ttl.
+func (m *MmsTables) expireTime(mst string) int64 {
+	m.mu.RLock()
+	fn := m.ttl
+	m.mu.RUnlock()
+	if fn == nil {
+		return 0
+	}
+
+	ttl := fn(mst)
+	if ttl <= 0 {
+		return 0
+	}
+	return time.Now().UnixNano() - int64(ttl)
+}
+
+// hasExpiredRows reports whether the files to compact contain expired rows,
+// these files are compacted by the non-streaming compaction which drops the expired rows.
+func (m *MmsTables) hasExpiredRows(fi FilesInfo) bool {
+	expire := m.expireTime(fi.name)
+	if expire == 0 {
+		return false
+	}
+	for _, f := range fi.oldFiles {
+		minTime, _, err := f.MinMaxTime()
+		if err == nil && minTime < expire {
+			return true
+		}
+	}
+	return false
+}
+
+// DropExpiredFiles removes the tssp files of which all rows are expired, without rewriting them.
+// Files that are only partially expired are trimmed by the next compaction.
+func (m *MmsTables) DropExpiredFiles() {
+	if !m.CompactionEnabled() {
+		return
+	}
+
+	m.mu.RLock()
+	names := make([]string, 0, len(m.Order)+len(m.OutOfOrder))
+	for mst := range m.Order {
+		names = append(names, mst)
+	}
+	for mst := range m.OutOfOrder {
+		if _, ok := m.Order[mst]; !ok {
+			names = append(names, mst)
+		}
+	}
+	m.mu.RUnlock()
+
+	for _, mst := range names {
+		if m.isClosed() || m.isCompMergeStopped() {
+			return
+		}
+
+		expire := m.expireTime(mst)
+		if expire == 0 {
+			continue
+		}
+
+		if !m.inMerge.Add(mst) {
+			continue
+		}
+		m.dropExpiredFiles(mst, true, expire)
+		m.dropExpiredFiles(mst, false, expire)
+		m.inMerge.Del(mst)
+	}
+}
+
+func (m *MmsTables) dropExpiredFiles(mst string, isOrder bool, expire int64) {
+	tfs, ok := m.getTSSPFiles(mst, isOrder)
+	if !ok {
+		return
+	}
+
+	var expired []TSSPFile
+	var paths []string
+	tfs.lock.RLock()
+	for _, f := range tfs.files {
+		_, maxTime, err := f.MinMaxTime()
+		if err != nil || maxTime >= expire {
+			continue
+		}
+		expired = append(expired, f)
+		paths = append(paths, f.Path())
+	}
+	tfs.lock.RUnlock()
+
+	if len(expired) == 0 || InParquetProcess(paths...) || !m.acquire(paths) {
+		return
+	}
+	defer m.CompactDone(paths)
+
+	tfs.lock.Lock()
+	defer tfs.lock.Unlock()
+	for _, f := range expired {
+		tfs.deleteFile(f)
+		m.removeFile(f)
+	}
+	log.Info("drop expired files", zap.String("mst", mst), zap.Bool("isOrder", isOrder),
+		zap.Int("files", len(expired)), zap.Int64("expire", expire))
+}
+
+// dropExpiredRows returns the rows of the time sorted record which are not expired,
+// nil is returned if all the rows are expired.
+func dropExpiredRows(rec, dst *record.Record, expire int64) *record.Record {
+	times := rec.Times()
+	if len(times) == 0 || times[0] >= expire {
+		return rec
+	}
+	if times[len(times)-1] < expire {
+		return nil
+	}
+
+	start := sort.Search(len(times), func(i int) bool {
+		return times[i] >= expire
+	})
+	dst.SliceFromRecord(rec, start, len(times))
+	return dst
+}
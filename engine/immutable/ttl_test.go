@@ -0,0 +1,92 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package immutable_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/engine/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func hourTTL(mst string) time.Duration {
+	if mst == "mst" {
+		return time.Hour
+	}
+	return 0
+}
+
+func TestDropExpiredFiles(t *testing.T) {
+	defer beforeTest(t, 0)()
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+
+	now := time.Now().UnixNano()
+	rg := newRecordGenerator(now-2*time.Hour.Nanoseconds(), defaultInterval, true)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToUnordered())
+
+	rg.setBegin(now - 10*time.Minute.Nanoseconds())
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	// no ttl, nothing is dropped
+	mh.store.DropExpiredFiles()
+	require.Equal(t, 2, mh.store.Order["mst"].Len())
+	require.Equal(t, 1, mh.store.OutOfOrder["mst"].Len())
+
+	mh.store.SetMeasurementTTL(hourTTL)
+	mh.store.DropExpiredFiles()
+	require.Equal(t, 1, mh.store.Order["mst"].Len())
+	require.Equal(t, 0, mh.store.OutOfOrder["mst"].Len())
+
+	minTime, _, err := mh.store.Order["mst"].Files()[0].MinMaxTime()
+	require.NoError(t, err)
+	require.True(t, minTime >= now-10*time.Minute.Nanoseconds())
+}
+
+func TestCompactDropExpiredRows(t *testing.T) {
+	defer beforeTest(t, 0)()
+	mh := NewMergeTestHelper(immutable.NewTsStoreConfig())
+	defer mh.store.Close()
+	mh.store.SetMeasurementTTL(hourTTL)
+
+	// rows from 2 hours ago to 1 hour later, the first 4 rows are expired
+	now := time.Now().UnixNano()
+	interval := 20 * time.Minute.Nanoseconds()
+	rg := newRecordGenerator(now-2*time.Hour.Nanoseconds(), interval, false)
+	mh.addRecord(100, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+	rg.setBegin(now - 2*time.Hour.Nanoseconds())
+	mh.addRecord(101, rg.generate(getDefaultSchemas(), 10))
+	require.NoError(t, mh.saveToOrder())
+
+	mh.store.CompactionEnable()
+	require.NoError(t, mh.store.FullCompact(1))
+	mh.store.Wait()
+
+	require.Equal(t, 1, mh.store.Order["mst"].Len())
+	merged := mh.readMergedRecord()
+	require.Equal(t, 2, len(merged))
+	for sid, rec := range merged {
+		require.Equal(t, 6, rec.RowNums(), "sid %d", sid)
+		for _, tm := range rec.Times() {
+			require.True(t, tm > now-time.Hour.Nanoseconds())
+		}
+	}
+}
@@ -57,6 +57,7 @@ type IndexBuilder struct {
 	EnableTagArray bool
 
 	seriesLimiter func() error
+	seriesQuota   *SeriesQuota
 }
 
 func NewIndexBuilder(opt *Options) *IndexBuilder {
@@ -120,6 +121,20 @@ func (iBuilder *IndexBuilder) SeriesLimited() error {
 	return iBuilder.seriesLimiter()
 }
 
+// SetSeriesQuota accounts the series of the index to the series quota of the database on the node.
+func (iBuilder *IndexBuilder) SetSeriesQuota(quota *SeriesQuota) {
+	idx, ok := iBuilder.GetPrimaryIndex().(*MergeSetIndex)
+	if !ok {
+		return
+	}
+	iBuilder.seriesQuota = quota
+	quota.addIndex(idx)
+}
+
+func (iBuilder *IndexBuilder) SeriesQuota() *SeriesQuota {
+	return iBuilder.seriesQuota
+}
+
 func (iBuilder *IndexBuilder) GenerateUUID() uint64 {
 	b := kbPool.Get()
 	// first three bytes is big endian of logicClock
@@ -459,6 +474,11 @@ func (iBuilder *IndexBuilder) Delete(name []byte, condition influxql.Expr, tr Ti
 }
 
 func (iBuilder *IndexBuilder) Close() error {
+	if iBuilder.seriesQuota != nil {
+		if idx, ok := iBuilder.GetPrimaryIndex().(*MergeSetIndex); ok {
+			iBuilder.seriesQuota.removeIndex(idx)
+		}
+	}
 	for i := range iBuilder.Relations {
 		if !iBuilder.isRelationInited(uint32(i)) {
 			continue
@@ -749,6 +749,10 @@ func (idx *MergeSetIndex) createIndexesIfNotExists(vkey, vname []byte, tags []in
 	if err = idx.indexBuilder.SeriesLimited(); err != nil {
 		return 0, err
 	}
+	reserved, err := idx.reserveSeries(vname, vkey)
+	if err != nil {
+		return 0, err
+	}
 	// add new series key to mem bf
 	idx.AddNewSeriesKey(vkey)
 
@@ -761,6 +765,9 @@ func (idx *MergeSetIndex) createIndexesIfNotExists(vkey, vname []byte, tags []in
 	}(&tsid)
 
 	tsid, err = idx.createIndexes(vkey, vname, tags, nil, false)
+	if err != nil && reserved {
+		idx.releaseSeries(vname, vkey)
+	}
 	return tsid, err
 }
 
@@ -793,6 +800,10 @@ func (idx *MergeSetIndex) createIndexesIfNotExistsWithTagArray(vkey, vname []byt
 	if err = idx.indexBuilder.SeriesLimited(); err != nil {
 		return 0, err
 	}
+	reserved, err := idx.reserveSeries(vname, combineIndexKey.B)
+	if err != nil {
+		return 0, err
+	}
 	// add new series key to mem bf
 	idx.AddNewSeriesKey(combineIndexKey.B)
 
@@ -805,6 +816,9 @@ func (idx *MergeSetIndex) createIndexesIfNotExistsWithTagArray(vkey, vname []byt
 	}(&tsid)
 
 	tsid, err = idx.createIndexes(combineIndexKey.B, vname, tags, dstTagSets.tagsArray, true)
+	if err != nil && reserved {
+		idx.releaseSeries(vname, combineIndexKey.B)
+	}
 	return tsid, err
 }
 
@@ -1654,7 +1668,14 @@ func (idx *MergeSetIndex) DeleteTSIDs(name []byte, condition influxql.Expr, tr T
 		return err
 	}
 
-	return idx.deleteTSIDs(tsids)
+	if err = idx.deleteTSIDs(tsids); err != nil {
+		return err
+	}
+	// the deleted series may still be held by other indexes, the quota counts them again from the indexes
+	if quota := idx.indexBuilder.SeriesQuota(); quota != nil {
+		quota.invalidate(name)
+	}
+	return nil
 }
 
 func (idx *MergeSetIndex) deleteTSIDs(tsids []uint64) error {
@@ -0,0 +1,235 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsi
+
+import (
+	"io"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// SeriesQuota limits the number of series each measurement of a database can hold on this node.
+// The series are counted over the indexes of all partitions and index durations of the database on the node,
+// a series held by several indexes, e.g. written before and after the rollover of the index duration, is counted once.
+// The series of a measurement are loaded from the indexes when the measurement gets its first new series,
+// after that they are maintained in memory, so that checking the quota does not scan the indexes.
+type SeriesQuota struct {
+	limit uint64
+
+	mu      sync.RWMutex
+	indexes map[*MergeSetIndex]struct{}
+	series  map[string]*measurementSeries
+}
+
+// measurementSeries holds the hashes of the series keys of a measurement on the node
+type measurementSeries struct {
+	mu     sync.Mutex
+	loaded bool
+	keys   map[uint64]struct{}
+}
+
+func NewSeriesQuota(limit uint64) *SeriesQuota {
+	return &SeriesQuota{
+		limit:   limit,
+		indexes: make(map[*MergeSetIndex]struct{}),
+		series:  make(map[string]*measurementSeries),
+	}
+}
+
+// SetLimit sets the maximum number of series each measurement can hold on the node, 0 means unlimited.
+func (q *SeriesQuota) SetLimit(limit uint64) {
+	atomic.StoreUint64(&q.limit, limit)
+}
+
+func (q *SeriesQuota) Limit() uint64 {
+	return atomic.LoadUint64(&q.limit)
+}
+
+// addIndex accounts the series of the index to the quota. The loaded series are dropped,
+// they are loaded again with the series of the index when they are needed.
+func (q *SeriesQuota) addIndex(idx *MergeSetIndex) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.indexes[idx] = struct{}{}
+	q.series = make(map[string]*measurementSeries)
+}
+
+// removeIndex gives back the series of a closed index, the series also held by other indexes stay accounted.
+func (q *SeriesQuota) removeIndex(idx *MergeSetIndex) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.indexes[idx]; !ok {
+		return
+	}
+	delete(q.indexes, idx)
+	q.series = make(map[string]*measurementSeries)
+}
+
+// Release gives back all the series of a dropped measurement. The series are not loaded again from the indexes,
+// because the series of a dropped measurement are still in the indexes.
+func (q *SeriesQuota) Release(name []byte) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.series[string(name)] = &measurementSeries{loaded: true, keys: make(map[uint64]struct{})}
+}
+
+// invalidate drops the loaded series of the measurement, e.g. after some of its series are deleted,
+// they are loaded again from the indexes when they are needed.
+func (q *SeriesQuota) invalidate(name []byte) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.series, string(name))
+}
+
+func (q *SeriesQuota) measurement(name []byte) *measurementSeries {
+	q.mu.RLock()
+	ms, ok := q.series[string(name)]
+	q.mu.RUnlock()
+	if ok {
+		return ms
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if ms, ok = q.series[string(name)]; !ok {
+		ms = &measurementSeries{}
+		q.series[string(name)] = ms
+	}
+	return ms
+}
+
+// load collects the series of the measurement held by the indexes of the node.
+// The indexes are read without their locks, the caller may hold the lock of the index it creates the series in.
+func (q *SeriesQuota) load(name []byte) (map[uint64]struct{}, error) {
+	q.mu.RLock()
+	defer q.mu.RUnlock()
+	keys := make(map[uint64]struct{})
+	for idx := range q.indexes {
+		err := idx.walkSeriesKeys(name, func(key []byte) {
+			keys[xxhash.Sum64(key)] = struct{}{}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// reserve accounts the series of the measurement before it is created in an index.
+// It returns false if the series is already held by another index of the node, it is not accounted again.
+// It returns errno.MeasurementSeriesLimited if the measurement already holds the maximum number of series,
+// writes of the existing series are not affected.
+func (q *SeriesQuota) reserve(name, key []byte) (bool, error) {
+	limit := q.Limit()
+	if limit == 0 {
+		return false, nil
+	}
+
+	ms := q.measurement(name)
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if !ms.loaded {
+		keys, err := q.load(name)
+		if err != nil {
+			return false, err
+		}
+		ms.keys, ms.loaded = keys, true
+	}
+
+	h := xxhash.Sum64(key)
+	if _, ok := ms.keys[h]; ok {
+		return false, nil
+	}
+	if count := uint64(len(ms.keys)); count >= limit {
+		return false, errno.NewError(errno.MeasurementSeriesLimited, influx.GetOriginMstName(string(name)), limit, count)
+	}
+	ms.keys[h] = struct{}{}
+	return true, nil
+}
+
+// release gives back the series reserved by reserve if the series failed to be created.
+func (q *SeriesQuota) release(name, key []byte) {
+	ms := q.measurement(name)
+	ms.mu.Lock()
+	delete(ms.keys, xxhash.Sum64(key))
+	ms.mu.Unlock()
+}
+
+// Usage returns the number of series of the measurement held by the indexes of the node.
+func (q *SeriesQuota) Usage(name []byte) (uint64, error) {
+	if q.Limit() == 0 {
+		// the series are not maintained without limit
+		keys, err := q.load(name)
+		return uint64(len(keys)), err
+	}
+
+	ms := q.measurement(name)
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+	if !ms.loaded {
+		keys, err := q.load(name)
+		if err != nil {
+			return 0, err
+		}
+		ms.keys, ms.loaded = keys, true
+	}
+	return uint64(len(ms.keys)), nil
+}
+
+// reserveSeries accounts a new series of the index to the series quota of the node.
+func (idx *MergeSetIndex) reserveSeries(name, key []byte) (bool, error) {
+	quota := idx.indexBuilder.SeriesQuota()
+	if quota == nil {
+		return false, nil
+	}
+	return quota.reserve(name, key)
+}
+
+// releaseSeries gives back the series reserved by reserveSeries if the series failed to be created.
+func (idx *MergeSetIndex) releaseSeries(name, key []byte) {
+	if quota := idx.indexBuilder.SeriesQuota(); quota != nil {
+		quota.release(name, key)
+	}
+}
+
+// walkSeriesKeys calls fn with the series key of each series of the measurement in the index.
+func (idx *MergeSetIndex) walkSeriesKeys(name []byte, fn func(key []byte)) error {
+	is := idx.getIndexSearch()
+	defer idx.putIndexSearch(is)
+
+	tsids, err := is.searchTSIDs(name, nil, TimeRange{})
+	if err != nil {
+		return err
+	}
+
+	key := kbPool.Get()
+	defer kbPool.Put(key)
+	for _, tsid := range tsids {
+		key.B, err = is.searchSeriesKey(key.B[:0], tsid)
+		if err == io.EOF {
+			// the series key is not flushed yet
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		fn(key.B)
+	}
+	return nil
+}
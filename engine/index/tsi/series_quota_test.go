@@ -0,0 +1,136 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tsi
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func createQuotaSeries(idx Index, name, value string) (uint64, error) {
+	row := &influx.Row{
+		Name: name,
+		Tags: influx.PointTags{
+			{Key: "tk1", Value: value},
+			{Key: "tk2", Value: "value2"},
+			{Key: "tk3", Value: "value3"},
+		},
+	}
+	row.UnmarshalIndexKeys(nil)
+	return idx.(*MergeSetIndex).CreateIndexIfNotExistsBySeries([]byte(row.Name), row.IndexKey, row.Tags)
+}
+
+func TestMeasurementSeriesLimit(t *testing.T) {
+	path := t.TempDir()
+	idx, idxBuilder := getTestIndexAndBuilder(path, config.TSSTORE)
+	defer idxBuilder.Close()
+	// mn-1_0000 holds 5 series before the limit is applied
+	CreateIndexByPts(idx)
+	idx.(*MergeSetIndex).DebugFlush()
+
+	quota := NewSeriesQuota(6)
+	idxBuilder.SetSeriesQuota(quota)
+
+	sid, err := createQuotaSeries(idx, "mn-1_0000", "quota1")
+	require.NoError(t, err)
+	require.True(t, sid > 0)
+
+	_, err = createQuotaSeries(idx, "mn-1_0000", "quota2")
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+
+	// writes to existing series are not affected by the quota
+	existSid, err := createQuotaSeries(idx, "mn-1_0000", "quota1")
+	require.NoError(t, err)
+	require.Equal(t, sid, existSid)
+	existSid, err = createQuotaSeries(idx, "mn-1_0000", "value1")
+	require.NoError(t, err)
+	require.True(t, existSid > 0)
+
+	// the quota applies to each measurement separately
+	for i := 0; i < 6; i++ {
+		_, err = createQuotaSeries(idx, "mn-2_0000", string(rune('a'+i)))
+		require.NoError(t, err)
+	}
+	_, err = createQuotaSeries(idx, "mn-2_0000", "g")
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+	usage, err := quota.Usage([]byte("mn-2_0000"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), usage)
+
+	// the series of a dropped measurement are given back
+	quota.Release([]byte("mn-2_0000"))
+	usage, err = quota.Usage([]byte("mn-2_0000"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), usage)
+	_, err = createQuotaSeries(idx, "mn-2_0000", "g")
+	require.NoError(t, err)
+
+	// the deleted series are given back
+	idx.(*MergeSetIndex).DebugFlush()
+	require.NoError(t, idx.(*MergeSetIndex).DeleteTSIDs([]byte("mn-1_0000"), nil, TimeRange{Min: 0, Max: 0}))
+	usage, err = quota.Usage([]byte("mn-1_0000"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), usage)
+	_, err = createQuotaSeries(idx, "mn-1_0000", "quota2")
+	require.NoError(t, err)
+
+	quota.SetLimit(0)
+	_, err = createQuotaSeries(idx, "mn-1_0000", "quota3")
+	require.NoError(t, err)
+}
+
+func TestMeasurementSeriesLimit_Indexes(t *testing.T) {
+	idx1, idxBuilder1 := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+	defer idxBuilder1.Close()
+	idx2, idxBuilder2 := getTestIndexAndBuilder(t.TempDir(), config.TSSTORE)
+
+	quota := NewSeriesQuota(3)
+	idxBuilder1.SetSeriesQuota(quota)
+	idxBuilder2.SetSeriesQuota(quota)
+
+	for _, value := range []string{"a", "b"} {
+		_, err := createQuotaSeries(idx1, "mn-1_0000", value)
+		require.NoError(t, err)
+	}
+	idx1.(*MergeSetIndex).DebugFlush()
+
+	// the series already held by another index of the node are counted once
+	for _, value := range []string{"a", "b", "c"} {
+		_, err := createQuotaSeries(idx2, "mn-1_0000", value)
+		require.NoError(t, err)
+	}
+	usage, err := quota.Usage([]byte("mn-1_0000"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), usage)
+
+	// the quota covers all the indexes of the node
+	_, err = createQuotaSeries(idx1, "mn-1_0000", "d")
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+	_, err = createQuotaSeries(idx2, "mn-1_0000", "d")
+	require.True(t, errno.Equal(err, errno.MeasurementSeriesLimited))
+
+	// the series only held by a closed index are given back
+	idx2.(*MergeSetIndex).DebugFlush()
+	require.NoError(t, idxBuilder2.Close())
+	usage, err = quota.Usage([]byte("mn-1_0000"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), usage)
+	_, err = createQuotaSeries(idx1, "mn-1_0000", "d")
+	require.NoError(t, err)
+}
@@ -381,6 +381,11 @@ func initTransColMetaFun() {
 		column.AppendNotNil()
 	}
 
+	transColMetaFun[influxql.Unsigned] = func(value interface{}, column executor.Column) {
+		column.AppendUnsignedValue(value.(uint64))
+		column.AppendNotNil()
+	}
+
 	transColMetaFun[influxql.Float] = func(value interface{}, column executor.Column) {
 		column.AppendFloatValue(value.(float64))
 		column.AppendNotNil()
@@ -409,6 +414,11 @@ func initTransColAuxFun() {
 		column.AppendIntegerValues(values)
 	}
 
+	transColAuxFun[influxql.Unsigned] = func(recColumn *record.ColVal, column executor.Column) {
+		values := recColumn.UnsignedValues()
+		column.AppendUnsignedValues(values)
+	}
+
 	transColAuxFun[influxql.Float] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.FloatValues()
 		column.AppendFloatValues(values)
@@ -446,6 +456,11 @@ func initTransColumnFun() {
 		column.SetIntegerValues(values)
 	}
 
+	transColumnFun[influxql.Unsigned] = func(recColumn *record.ColVal, column executor.Column) {
+		values := recColumn.UnsignedValues()
+		column.SetUnsignedValues(values)
+	}
+
 	transColumnFun[influxql.Float] = func(recColumn *record.ColVal, column executor.Column) {
 		values := recColumn.FloatValues()
 		column.SetFloatValues(values)
@@ -482,6 +497,11 @@ func initCopyColumnFun() {
 		dstColumn.SetIntegerValues(values)
 	}
 
+	copyColumnFun[influxql.Unsigned] = func(srcColumn executor.Column, dstColumn executor.Column) {
+		values := srcColumn.UnsignedValues()
+		dstColumn.SetUnsignedValues(values)
+	}
+
 	copyColumnFun[influxql.Float] = func(srcColumn executor.Column, dstColumn executor.Column) {
 		values := srcColumn.FloatValues()
 		dstColumn.SetFloatValues(values)
@@ -504,7 +524,7 @@ func initCopyColumnFun() {
 }
 
 func validColumnType(dataType influxql.DataType) bool {
-	if dataType == influxql.Integer || dataType == influxql.Float || dataType == influxql.Boolean ||
+	if dataType == influxql.Integer || dataType == influxql.Unsigned || dataType == influxql.Float || dataType == influxql.Boolean ||
 		dataType == influxql.String || dataType == influxql.Tag {
 		return true
 	}
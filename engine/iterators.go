@@ -78,7 +78,7 @@ var (
 var AppendManyNils map[int]func(colVal *record.ColVal, count int)
 
 func init() {
-	AppendManyNils = make(map[int]func(colVal *record.ColVal, count int), 4)
+	AppendManyNils = make(map[int]func(colVal *record.ColVal, count int), 5)
 
 	AppendManyNils[influx.Field_Type_Float] = func(colVal *record.ColVal, count int) {
 		colVal.AppendFloatNulls(count)
@@ -88,6 +88,10 @@ func init() {
 		colVal.AppendIntegerNulls(count)
 	}
 
+	AppendManyNils[influx.Field_Type_UInt] = func(colVal *record.ColVal, count int) {
+		colVal.AppendUnsignedNulls(count)
+	}
+
 	AppendManyNils[influx.Field_Type_Boolean] = func(colVal *record.ColVal, count int) {
 		colVal.AppendBooleanNulls(count)
 	}
@@ -309,6 +313,7 @@ func (s *shard) initGroupCursors(ctx context.Context, querySchema *executor.Quer
 				querySchema:  querySchema,
 				interTr:      util.TimeRange{Min: iTr.Min, Max: iTr.Max},
 				closedSignal: closedSignal,
+				pointLimiter: queryPointLimiter(querySchema),
 			},
 			querySchema: querySchema,
 		}
@@ -663,6 +668,15 @@ type idKeyCursorContext struct {
 	closedSignal    *bool
 	immTableReaders map[uint64]*immutable.MmsReaders
 	memTableReader  map[uint64]MemDataReader
+	pointLimiter    *query.PointLimiter // counts the points scanned by the query on this node
+}
+
+// queryPointLimiter returns the points limiter of the query, it is shared by all the shards of the query on this node.
+func queryPointLimiter(querySchema *executor.QuerySchema) *query.PointLimiter {
+	if opt, ok := querySchema.Options().(*query.ProcessorOptions); ok {
+		return opt.PointLimiter
+	}
+	return nil
 }
 
 func (i *idKeyCursorContext) IsAborted() bool {
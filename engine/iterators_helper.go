@@ -240,6 +240,8 @@ func (r *recordIter) readMemTableMetaRecord(ops []*comm.CallOption) {
 		switch r.record.Schema[idx].Type {
 		case influx.Field_Type_Int:
 			r.setIntColumnMeta(timeCol, idx, r.record, ops)
+		case influx.Field_Type_UInt:
+			r.setUnsignedColumnMeta(timeCol, idx, r.record, ops)
 		case influx.Field_Type_String, influx.Field_Type_Tag:
 			r.setStringColumnMeta(timeCol, idx, r.record, ops)
 		case influx.Field_Type_Float:
@@ -313,6 +315,68 @@ func (r *recordIter) setIntColumnMeta(timeColVals *record.ColVal, idx int, rec *
 	setColValInAux(timeColVals, idx, ops, rec, minIndex, firstIndex, maxIndex, lastIndex)
 }
 
+func (r *recordIter) setUnsignedColumnMeta(timeColVals *record.ColVal, idx int, rec *record.Record, ops []*comm.CallOption) {
+	timeCols := timeColVals.IntegerValues()
+	colVals := rec.ColVals[idx]
+	cols := colVals.UnsignedValues()
+	if cols == nil {
+		if len(ops) == 1 {
+			r.reset()
+		}
+		return
+	}
+
+	var minVTime, maxVTime, countV int64
+	var minV, maxV, sumV uint64
+	var colIndex, lastIndex, firstIndex, minIndex, maxIndex int
+	nilCount := 0
+	colIndex = -1
+	lastIndex, firstIndex, minIndex, maxIndex = -1, -1, -1, -1
+	firstInit := false
+	for index, timeCol := range timeCols {
+		if colVals.IsNil(index) {
+			nilCount += 1
+			continue
+		}
+		if !firstInit {
+			minV = cols[index-nilCount]
+			minVTime = timeCol
+			maxV = cols[index-nilCount]
+			maxVTime = timeCol
+			firstIndex, minIndex, maxIndex = index, index, index
+			firstInit = true
+		}
+		countV += 1
+		colIndex += 1
+		if colIndex == 0 {
+			rec.ColMeta[idx].SetFirst(cols[index-nilCount], timeCol)
+			firstIndex = index
+		}
+		if cols[index-nilCount] < minV || (cols[index-nilCount] == minV && minVTime > timeCol) {
+			minV = cols[index-nilCount]
+			minVTime = timeCol
+			minIndex = index
+		}
+
+		if cols[index-nilCount] > maxV || (cols[index-nilCount] == maxV && maxVTime > timeCol) {
+			maxV = cols[index-nilCount]
+			maxVTime = timeCol
+			maxIndex = index
+		}
+
+		sumV += cols[index-nilCount]
+		lastIndex = colIndex
+	}
+
+	rec.ColMeta[idx].SetLast(cols[lastIndex], timeCols[len(timeCols)-1])
+	rec.ColMeta[idx].SetMin(minV, minVTime)
+	rec.ColMeta[idx].SetMax(maxV, maxVTime)
+	rec.ColMeta[idx].SetCount(countV)
+	rec.ColMeta[idx].SetSum(sumV)
+
+	setColValInAux(timeColVals, idx, ops, rec, minIndex, firstIndex, maxIndex, lastIndex)
+}
+
 func (r *recordIter) setBoolColumnMeta(timeColVals *record.ColVal, idx int, rec *record.Record, ops []*comm.CallOption) {
 	timeCols := timeColVals.IntegerValues()
 	colVals := rec.ColVals[idx]
@@ -532,6 +596,12 @@ func setSchemaColVal(field *record.Field, col *record.ColVal, rowIndex int) {
 			col.Init()
 			col.AppendInteger(value)
 		}
+	case influx.Field_Type_UInt:
+		value, isNil := col.UnsignedValue(rowIndex)
+		if !isNil {
+			col.Init()
+			col.AppendUnsigned(value)
+		}
 	case influx.Field_Type_String:
 		value, isNil := col.StringValueSafe(rowIndex)
 		if !isNil {
@@ -39,6 +39,10 @@ type LogWriter struct {
 	fileNames       []string
 	currentFd       fileops.File
 	currentFileSize int
+
+	// markTime writes the write time markers for the point-in-time recovery
+	markTime     bool
+	lastMarkTime int64
 }
 
 type LogReplays []LogReplay
@@ -142,6 +146,9 @@ func (w *LogWriter) Write(compBuf []byte) error {
 		}
 		w.syncMu.Unlock()
 
+		if err = w.tryMarkTime(); err != nil {
+			return err
+		}
 		if _, err = w.currentFd.Write(compBuf); err != nil {
 			return err
 		}
@@ -155,6 +162,26 @@ func (w *LogWriter) Write(compBuf []byte) error {
 	}
 }
 
+// tryMarkTime writes a write time marker at the beginning of a wal file and then
+// every walTimeMarkInterval, so that the records after a marker are written within
+// walTimeMarkInterval after the time of the marker
+func (w *LogWriter) tryMarkTime() error {
+	if !w.markTime {
+		return nil
+	}
+	now := time.Now().UnixNano()
+	if w.currentFileSize > 0 && now-w.lastMarkTime < int64(walTimeMarkInterval) {
+		return nil
+	}
+	record := encodeWalTimeRecord(now)
+	if _, err := w.currentFd.Write(record); err != nil {
+		return err
+	}
+	w.currentFileSize += len(record)
+	w.lastMarkTime = now
+	return nil
+}
+
 func (w *LogWriter) close() error {
 	close(w.closed)
 
@@ -103,6 +103,7 @@ type DBPTInfo struct {
 	doingOff            bool
 	doingShardMoveN     int
 	dbObsOptions        *obs.ObsOptions
+	seriesQuota         *tsi.SeriesQuota // series quota of the database on the node
 }
 
 func NewDBPTInfo(db string, id uint32, dataPath, walPath string, ctx *metaclient.LoadCtx, ch chan []immutable.FileInfoExtend, options *obs.ObsOptions) *DBPTInfo {
@@ -360,6 +361,9 @@ func (dbPT *DBPTInfo) OpenIndexes(opId uint64, rp string, engineType config.Engi
 		dbPT.mu.Lock()
 		dbPT.indexBuilder[res.i.GetIndexID()] = res.i
 		dbPT.mu.Unlock()
+		if dbPT.seriesQuota != nil {
+			res.i.SetSeriesQuota(dbPT.seriesQuota)
+		}
 	}
 	close(resC)
 	return err
@@ -807,6 +811,9 @@ func (dbPT *DBPTInfo) NewShard(rp string, shardID uint64, timeRangeInfo *meta.Sh
 		if err != nil {
 			return nil, err
 		}
+		if dbPT.seriesQuota != nil {
+			indexBuilder.SetSeriesQuota(dbPT.seriesQuota)
+		}
 	}
 
 	id := strconv.Itoa(int(shardID))
@@ -934,6 +941,23 @@ func (dbPT *DBPTInfo) seriesCardinality(measurements [][]byte, measurementCardin
 	return measurementCardinalityInfos, nil
 }
 
+func (dbPT *DBPTInfo) databaseSeries(quota *netstorage.SeriesQuota) {
+	for _, sh := range dbPT.shards {
+		s, ok := sh.(*shard)
+		if !ok || s.immTables == nil {
+			continue
+		}
+		if n := s.immTables.SeriesTotal(); n > quota.DatabaseSeries {
+			quota.DatabaseSeries = n
+		}
+	}
+}
+
+// SetSeriesQuota sets the series quota of the database on the node, the partitions of the database share it.
+func (dbPT *DBPTInfo) SetSeriesQuota(quota *tsi.SeriesQuota) {
+	dbPT.seriesQuota = quota
+}
+
 func (dbPT *DBPTInfo) seriesCardinalityWithCondition(measurements [][]byte, condition influxql.Expr,
 	measurementCardinalityInfos []meta.MeasurementCardinalityInfo, tr influxql.TimeRange) ([]meta.MeasurementCardinalityInfo, error) {
 	for i := range measurements {
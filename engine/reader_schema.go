@@ -41,7 +41,7 @@ func NewRecordSchema(querySchema *executor.QuerySchema, auxTags []string, schema
 
 func genSchemaByRef(fieldMap *dictpool.Dict, key string, ref *influxql.VarRef, schema *record.Schemas, auxTags *[]string, engineType config.EngineType, queryRef bool) {
 	switch ref.Type {
-	case influxql.Integer, influxql.String, influxql.Boolean, influxql.Float:
+	case influxql.Integer, influxql.Unsigned, influxql.String, influxql.Boolean, influxql.Float:
 		{
 			fieldType := ref.Type
 			v := fieldMap.Get(key)
@@ -283,7 +283,7 @@ func (r *FieldIter) initSchemaList() {
 	for _, ref := range r.querySchema.Refs() {
 		var schema record.Schemas
 
-		if ref.Type == influxql.Integer || ref.Type == influxql.String || ref.Type == influxql.Boolean || ref.Type == influxql.Float {
+		if ref.Type == influxql.Integer || ref.Type == influxql.Unsigned || ref.Type == influxql.String || ref.Type == influxql.Boolean || ref.Type == influxql.Float {
 			schema = append(schema, record.Field{Name: ref.Val, Type: record.ToModelTypes(ref.Type)})
 			schema = append(schema, record.Field{Name: record.TimeField, Type: influx.Field_Type_Int})
 			r.schemaList = append(r.schemaList, schema)
@@ -1266,6 +1266,8 @@ func AppendNilRowWithTime(rec *record.Record, t int64) {
 			rec.ColVals[i].AppendFloatNull()
 		case influx.Field_Type_Int:
 			rec.ColVals[i].AppendIntegerNull()
+		case influx.Field_Type_UInt:
+			rec.ColVals[i].AppendUnsignedNull()
 		case influx.Field_Type_Boolean:
 			rec.ColVals[i].AppendBooleanNull()
 		case influx.Field_Type_String:
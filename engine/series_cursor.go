@@ -280,6 +280,9 @@ func (s *seriesCursor) Next() (*record.Record, comm.SeriesInfoIntf, error) {
 		}
 		rec = rec.KickNilRow(nil, s.colAux)
 	}
+	if err = s.ctx.pointLimiter.Add(rec.RowNums()); err != nil {
+		return nil, nil, err
+	}
 	newRec := s.recordPool.Get()
 	newRec.AppendRecForSeries(rec, 0, rec.RowNums(), s.ridIdx)
 	return newRec, info, err
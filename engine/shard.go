@@ -637,6 +637,9 @@ func (s *shard) Compact() error {
 		if !s.immTables.CompactionEnabled() {
 			return nil
 		}
+		if s.engineType == config.TSSTORE {
+			s.immTables.DropExpiredFiles()
+		}
 		nowTime := fasttime.UnixTimestamp()
 		lastWrite := s.LastWriteTime()
 		d := nowTime - lastWrite
@@ -818,7 +821,7 @@ func calculateMemSize(rows influx.Rows) int64 {
 				memCost += int64(len(rows[i].Fields[j].StrValue))
 			} else if rows[i].Fields[j].Type == influx.Field_Type_Boolean {
 				memCost += int64(util.BooleanSizeBytes)
-			} else if rows[i].Fields[j].Type == influx.Field_Type_Int {
+			} else if rows[i].Fields[j].Type == influx.Field_Type_Int || rows[i].Fields[j].Type == influx.Field_Type_UInt {
 				memCost += int64(util.Uint64SizeBytes)
 			}
 		}
@@ -924,6 +927,13 @@ func (s *shard) commitSnapshot(snapshot *mutable.MemTable) {
 func (s *shard) SetClient(client metaclient.MetaClient) {
 	s.storage.SetClient(client)
 	s.activeTbl.MTable.SetClient(client)
+	s.immTables.SetMeasurementTTL(func(mst string) time.Duration {
+		msti, err := client.Measurement(s.ident.OwnerDb, s.ident.Policy, influx.GetOriginMstName(mst))
+		if err != nil || msti == nil {
+			return 0
+		}
+		return time.Duration(msti.TTL)
+	})
 }
 
 func (s *shard) getRowCount(msName string) (int64, bool) {
@@ -1128,7 +1138,7 @@ func (s *shard) syncReplayWal(ctx context.Context) error {
 		func(binary []byte, rowsCtx *walRowsObjects, writeWalType WalRecordType, logReplay LogReplay) error {
 			err := s.writeWalBuffer(binary, rowsCtx, writeWalType)
 			// SeriesLimited error is ignored in the wal playback process
-			if errno.Equal(err, errno.SeriesLimited) {
+			if errno.Equal(err, errno.SeriesLimited, errno.MeasurementSeriesLimited) {
 				err = nil
 			}
 			return err
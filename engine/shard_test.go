@@ -2636,6 +2636,50 @@ func TestQueryOnlyInImmutableWithLimit(t *testing.T) {
 	}
 }
 
+func TestQueryWithPointLimiter(t *testing.T) {
+	testDir := t.TempDir()
+	msNames := []string{"cpu"}
+	sh, err := createShard(defaultDb, defaultRp, defaultPtId, testDir, config.TSSTORE)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, closeShard(sh))
+	}()
+
+	rows, minTime, maxTime := GenDataRecord(msNames, 10, 100, time.Second, time.Now(), false, true, false)
+	require.NoError(t, writeData(sh, rows, true))
+
+	c := TestCase{"AllField", minTime, maxTime, createFieldAux(nil), "", nil, true, nil}
+	opt := genQueryOpt(&c, msNames[0], true)
+	// 1000 points are scanned, more than the limit
+	opt.PointLimiter = query.NewPointLimiter(500, nil)
+	querySchema := genQuerySchema(c.fieldAux, opt)
+
+	info, err := sh.CreateCursor(context.Background(), querySchema)
+	require.NoError(t, err)
+	require.NotNil(t, info)
+	defer info.Unref()
+
+	var readErr error
+	for _, cur := range info.GetCursors() {
+		for readErr == nil {
+			if groupCursor, isTagSet := cur.(*groupCursor); isTagSet {
+				for i := range groupCursor.tagSetCursors {
+					t := groupCursor.tagSetCursors[i].(*tagSetCursor)
+					t.SetSchema(t.GetSchema())
+				}
+			}
+			rec, _, err := cur.Next()
+			if err != nil {
+				readErr = err
+			} else if rec == nil {
+				break
+			}
+		}
+		_ = cur.Close()
+	}
+	require.True(t, errno.Equal(readErr, errno.SelectPointsLimitExceeded), "unexpected error: %v", readErr)
+}
+
 func TestQueryOnlyInImmutableWithLimit_Lazy(t *testing.T) {
 	testDir := t.TempDir()
 	configs := []TestConfig{
@@ -7192,7 +7236,7 @@ func (client *MockMetaClient) GetNodePtsMap(database string) (map[uint64][]uint3
 }
 
 func (client *MockMetaClient) CreateMeasurement(database string, retentionPolicy string, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation,
-	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, _ []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error) {
+	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, _ []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error) {
 	return nil, nil
 }
 func (client *MockMetaClient) AlterShardKey(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo) error {
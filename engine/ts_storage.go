@@ -60,7 +60,7 @@ func (storage *tsstoreImpl) WriteRowsToTable(s *shard, rows influx.Rows, mw *mst
 
 	// write index
 	indexErr := storage.WriteIndex(s, &rows, mw)
-	if indexErr != nil && !errno.Equal(indexErr, errno.SeriesLimited) {
+	if indexErr != nil && !errno.Equal(indexErr, errno.SeriesLimited, errno.MeasurementSeriesLimited) {
 		nodeMutableLimit.freeResource(curSize)
 		return indexErr
 	}
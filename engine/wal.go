@@ -34,6 +34,7 @@ import (
 	"github.com/docker/go-units"
 	"github.com/golang/snappy"
 	"github.com/openGemini/openGemini/lib/bufferpool"
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/fileops"
@@ -60,6 +61,7 @@ const (
 	WriteWalUnKnownType = iota
 	WriteWalLineProtocol
 	WriteWalArrowFlight
+	WriteWalTime // the write time marker for the point-in-time recovery, it is skipped by the replay
 	WriteWalEnd
 )
 
@@ -128,6 +130,7 @@ type WAL struct {
 	replayParallel  bool
 	replayBatchSize int
 	maxRowTime      int64
+	switchTime      int64 // the time of the last switch, it is when the current wal files are opened
 }
 
 func NewWAL(path string, lockPath *string, shardID uint64, walSyncInterval time.Duration, walEnabled, replayParallel bool, partitionNum int, walReplayBatchSize int) *WAL {
@@ -147,6 +150,7 @@ func NewWAL(path string, lockPath *string, shardID uint64, walSyncInterval time.
 		log:             logger.NewLogger(errno.ModuleWal),
 		lock:            lockPath,
 		maxRowTime:      math.MinInt64,
+		switchTime:      time.Now().UnixNano(),
 	}
 
 	lock := fileops.FileLockOption(*lockPath)
@@ -156,6 +160,7 @@ func NewWAL(path string, lockPath *string, shardID uint64, walSyncInterval time.
 			logPath:      filepath.Join(path, strconv.Itoa(i)),
 			SyncInterval: walSyncInterval,
 			lock:         lockPath,
+			markTime:     config.GetStoreConfig().Wal.WalArchiveEnabled,
 		}
 		_, err := fileops.Stat(wal.logWriter[i].logPath)
 		if err != nil && os.IsNotExist(err) {
@@ -272,6 +277,9 @@ func (l *WAL) Switch() (*WalFiles, error) {
 
 	walFiles := newWalFiles(l.maxRowTime, l.lock, l.logPath)
 	l.maxRowTime = math.MinInt64
+	walFiles.openTime = l.switchTime
+	walFiles.closeTime = time.Now().UnixNano()
+	l.switchTime = walFiles.closeTime
 
 	for i := 0; i < l.partitionNum; i++ {
 		go func(lw *LogWriter) {
@@ -291,6 +299,13 @@ func (l *WAL) Remove(files []string) error {
 	}
 	lock := fileops.FileLockOption(*l.lock)
 	for _, fn := range files {
+		if config.GetStoreConfig().Wal.WalArchiveEnabled {
+			if err := archiveWalFile(l.logPath, fn, 0, 0, l.lock); err != nil {
+				// keep the wal file, it is replayed and archived again at the next open
+				l.log.Error("failed to archive wal file, keep it", zap.String("file", fn), zap.Error(err))
+				continue
+			}
+		}
 		err := fileops.Remove(fn, lock)
 		if err != nil {
 			l.log.Error("failed to remove wal file", zap.String("file", fn))
@@ -323,6 +338,12 @@ func (l *WAL) replayPhysicRecord(fr *bufio.Reader, walFileName string, recordCom
 	// prepare record memory
 	compBinaryLen := binary.BigEndian.Uint32(recordHeader[1:WalRecordHeadSize])
 	recordCompBuff = bufferpool.Resize(recordCompBuff, int(compBinaryLen))
+	if writeWalType == WriteWalTime {
+		if _, err = io.ReadFull(fr, recordCompBuff); err != nil {
+			return recordCompBuff, io.EOF
+		}
+		return recordCompBuff, nil
+	}
 
 	// read wal binary body
 	var rowsObjects = getWalRowsObjects()
@@ -0,0 +1,162 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/bufferpool"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/logger"
+	"go.uber.org/zap"
+)
+
+// walTimeMarkInterval is the precision of the point-in-time recovery
+const walTimeMarkInterval = time.Second
+
+func encodeWalTimeRecord(t int64) []byte {
+	var body [8]byte
+	binary.BigEndian.PutUint64(body[:], uint64(t))
+	comp := snappy.Encode(nil, body[:])
+	record := make([]byte, WalRecordHeadSize, WalRecordHeadSize+len(comp))
+	record[0] = byte(WriteWalTime)
+	binary.BigEndian.PutUint32(record[1:], uint32(len(comp)))
+	return append(record, comp...)
+}
+
+// archiveWalFiles keeps the wal files switched by a flush in the archive
+// directory of the shard, the backup moves them into the backup path later.
+func archiveWalFiles(files *WalFiles) error {
+	if files == nil || !config.GetStoreConfig().Wal.WalArchiveEnabled {
+		return nil
+	}
+	for _, f := range files.files {
+		if err := archiveWalFile(files.dir, f, files.openTime, files.closeTime, files.lock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tryArchiveWalFiles archives the wal files switched by a flush, it returns false
+// if the archive failed, then the wal files are kept instead of being removed, they
+// are replayed and archived again when the shard is opened
+func tryArchiveWalFiles(files *WalFiles) bool {
+	if err := archiveWalFiles(files); err != nil {
+		logger.NewLogger(errno.ModuleWal).Error("failed to archive wal files, keep them", zap.Strings("files", files.files), zap.Error(err))
+		return false
+	}
+	return true
+}
+
+func archiveWalFile(walPath, file string, openTime, closeTime int64, lock *string) error {
+	stat, err := fileops.Stat(file)
+	if err != nil {
+		return err
+	}
+	if stat.Size() == 0 {
+		return nil
+	}
+	if closeTime == 0 {
+		// the wal file is replayed after restart
+		closeTime = stat.ModTime().UnixNano()
+	}
+
+	partition := filepath.Base(filepath.Dir(file))
+	seq := strings.TrimSuffix(filepath.Base(file), "."+WALFileSuffixes)
+	dir := filepath.Join(walPath, backup.WalArchiveDir, partition)
+	if err = fileops.MkdirAll(dir, 0750, fileops.FileLockOption(*lock)); err != nil {
+		return err
+	}
+	dst := filepath.Join(dir, backup.WalArchiveFileName(openTime, closeTime, seq))
+	if err = os.Link(file, dst); err != nil {
+		logger.NewLogger(errno.ModuleWal).Warn("link wal file failed, copy it instead", zap.String("file", file), zap.Error(err))
+		_, err = fileops.CopyFile(file, dst)
+	}
+	return err
+}
+
+// FilterWalFile copies the records of the wal file src written before the target
+// time to dst. The write time is known from the time markers in the file, so the
+// records after the first marker later than the target are dropped, and the records
+// written within walTimeMarkInterval after the target may be kept. It is used to
+// replay the wal file which was being written at the recovery point.
+func FilterWalFile(src, dst string, target int64) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+
+	err = filterWalRecords(bufio.NewReader(in), out, target)
+	if err == nil {
+		err = out.Sync()
+	}
+	if e := out.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+func filterWalRecords(r io.Reader, w io.Writer, target int64) error {
+	var header [WalRecordHeadSize]byte
+	var comp, data []byte
+	for {
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			// the tail of the last wal file may be torn, replay stops there as well
+			return nil
+		}
+		size := binary.BigEndian.Uint32(header[1:])
+		comp = bufferpool.Resize(comp, int(size))
+		if _, err := io.ReadFull(r, comp); err != nil {
+			return nil
+		}
+		if WalRecordType(header[0]) == WriteWalTime {
+			var err error
+			if data, err = snappy.Decode(data[:cap(data)], comp); err != nil || len(data) != 8 {
+				return fmt.Errorf("invalid wal time record: %v", err)
+			}
+			if int64(binary.BigEndian.Uint64(data)) > target {
+				return nil
+			}
+		}
+		if err := writeWalRecord(w, header[:], comp); err != nil {
+			return err
+		}
+	}
+}
+
+func writeWalRecord(w io.Writer, header, body []byte) error {
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(body)
+	return err
+}
@@ -0,0 +1,198 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/openGemini/openGemini/lib/backup"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+)
+
+func readWalRowTimes(t *testing.T, file string) []int64 {
+	data, err := os.ReadFile(file)
+	require.NoError(t, err)
+	var times []int64
+	for len(data) > 0 {
+		size := binary.BigEndian.Uint32(data[1:WalRecordHeadSize])
+		body := data[WalRecordHeadSize : WalRecordHeadSize+size]
+		typ := WalRecordType(data[0])
+		data = data[WalRecordHeadSize+size:]
+		if typ == WriteWalTime {
+			continue
+		}
+		decoded, err := snappy.Decode(nil, body)
+		require.NoError(t, err)
+		rows, _, _, _, _, err := influx.FastUnmarshalMultiRows(decoded, nil, nil, nil, nil, nil)
+		require.NoError(t, err)
+		for _, row := range rows {
+			times = append(times, row.Timestamp)
+		}
+	}
+	return times
+}
+
+func TestArchiveWalFiles(t *testing.T) {
+	config.GetStoreConfig().Wal.WalArchiveEnabled = true
+	defer func() {
+		config.GetStoreConfig().Wal.WalArchiveEnabled = false
+	}()
+
+	dir := t.TempDir()
+	lock := ""
+	wal := NewWAL(dir, &lock, 1, 0, true, false, 2, 0)
+	_, walBinary := buildRows(t, []int64{100, 200})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "0", "1.wal"), walBinary, 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1", "1.wal"), nil, 0600))
+
+	walFiles := newWalFiles(200, &lock, dir)
+	walFiles.openTime, walFiles.closeTime = 1000, 2000
+	walFiles.Add(filepath.Join(dir, "0", "1.wal"), filepath.Join(dir, "1", "1.wal"))
+	require.NoError(t, RemoveWalFiles(walFiles))
+
+	archived, err := filepath.Glob(filepath.Join(dir, backup.WalArchiveDir, "*", "*"))
+	require.NoError(t, err)
+	require.Equal(t, []string{filepath.Join(dir, backup.WalArchiveDir, "0", "1000_2000_1.wal")}, archived)
+	require.Equal(t, []int64{100, 200}, readWalRowTimes(t, archived[0]))
+	_, err = os.Stat(filepath.Join(dir, "0", "1.wal"))
+	require.True(t, os.IsNotExist(err))
+
+	// the wal files replayed after restart are archived with their modification time
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "1", "2.wal"), walBinary, 0600))
+	stat, err := os.Stat(filepath.Join(dir, "1", "2.wal"))
+	require.NoError(t, err)
+	require.NoError(t, wal.Remove([]string{filepath.Join(dir, "1", "2.wal")}))
+	_, err = os.Stat(filepath.Join(dir, backup.WalArchiveDir, "1", backup.WalArchiveFileName(0, stat.ModTime().UnixNano(), "2")))
+	require.NoError(t, err)
+}
+
+func TestFilterWalFile(t *testing.T) {
+	dir := t.TempDir()
+	_, first := buildRows(t, []int64{100, 300, 200})
+	_, second := buildRows(t, []int64{400, 500})
+	_, third := buildRows(t, []int64{50})
+	arrow := []byte{byte(WriteWalArrowFlight), 0, 0, 0, 3, 1, 2, 3}
+	src := filepath.Join(dir, "src.wal")
+	var data []byte
+	data = append(data, encodeWalTimeRecord(1000)...)
+	data = append(data, first...)
+	data = append(data, arrow...)
+	data = append(data, encodeWalTimeRecord(2000)...)
+	data = append(data, second...)
+	// the rows are dropped by the write time, not by the time of the rows
+	data = append(data, encodeWalTimeRecord(3000)...)
+	data = append(data, third...)
+	// torn record
+	data = append(data, byte(WriteWalLineProtocol), 0, 0)
+	require.NoError(t, os.WriteFile(src, data, 0600))
+
+	dst := filepath.Join(dir, "dst.wal")
+	require.NoError(t, FilterWalFile(src, dst, 2500))
+	out, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	var expect []byte
+	expect = append(expect, encodeWalTimeRecord(1000)...)
+	expect = append(expect, first...)
+	expect = append(expect, arrow...)
+	expect = append(expect, encodeWalTimeRecord(2000)...)
+	expect = append(expect, second...)
+	require.Equal(t, expect, out)
+
+	require.NoError(t, FilterWalFile(src, dst, 999))
+	out, err = os.ReadFile(dst)
+	require.NoError(t, err)
+	require.Empty(t, out)
+
+	require.Error(t, FilterWalFile(filepath.Join(dir, "missing.wal"), dst, 250))
+}
+
+func TestWalTimeMarker(t *testing.T) {
+	config.GetStoreConfig().Wal.WalArchiveEnabled = true
+	defer func() {
+		config.GetStoreConfig().Wal.WalArchiveEnabled = false
+	}()
+
+	dir := t.TempDir()
+	lock := ""
+	wal := NewWAL(dir, &lock, 1, 0, true, false, 1, 0)
+	before := time.Now().UnixNano()
+	for _, times := range [][]int64{{100}, {200}} {
+		rows, _ := buildRows(t, times)
+		binary, err := influx.FastMarshalMultiRows(nil, rows)
+		require.NoError(t, err)
+		require.NoError(t, wal.Write(binary, WriteWalLineProtocol, times[0]))
+	}
+	walFiles, err := wal.Switch()
+	require.NoError(t, err)
+	require.Len(t, walFiles.files, 1)
+
+	data, err := os.ReadFile(walFiles.files[0])
+	require.NoError(t, err)
+	require.Equal(t, byte(WriteWalTime), data[0])
+	require.Equal(t, []int64{100, 200}, readWalRowTimes(t, walFiles.files[0]))
+
+	// all the rows are written after the target
+	dst := filepath.Join(dir, "dst.wal")
+	require.NoError(t, FilterWalFile(walFiles.files[0], dst, before-1))
+	out, err := os.ReadFile(dst)
+	require.NoError(t, err)
+	require.Empty(t, out)
+
+	// the replay skips the markers
+	var times []int64
+	wal.logReplay[0].fileNames = walFiles.files
+	_, err = wal.Replay(context.Background(), func(binary []byte, rowsCtx *walRowsObjects, writeWalType WalRecordType, logReplay LogReplay) error {
+		for _, row := range rowsCtx.rows {
+			times = append(times, row.Timestamp)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int64{100, 200}, times)
+}
+
+func TestArchiveWalFiles_Failed(t *testing.T) {
+	config.GetStoreConfig().Wal.WalArchiveEnabled = true
+	defer func() {
+		config.GetStoreConfig().Wal.WalArchiveEnabled = false
+	}()
+
+	dir := t.TempDir()
+	lock := ""
+	wal := NewWAL(dir, &lock, 1, 0, true, false, 1, 0)
+	_, walBinary := buildRows(t, []int64{100})
+	file := filepath.Join(dir, "0", "1.wal")
+	require.NoError(t, os.WriteFile(file, walBinary, 0600))
+	// the archive directory can not be created
+	require.NoError(t, os.WriteFile(filepath.Join(dir, backup.WalArchiveDir), nil, 0600))
+
+	walFiles := newWalFiles(100, &lock, dir)
+	walFiles.Add(file)
+	require.NoError(t, RemoveWalFiles(walFiles))
+	_, err := os.Stat(file)
+	require.NoError(t, err)
+
+	require.NoError(t, wal.Remove([]string{file}))
+	_, err = os.Stat(file)
+	require.NoError(t, err)
+}
@@ -168,6 +168,10 @@ type WalFiles struct {
 	maxTime int64
 	lock    *string
 	dir     string
+
+	// the files are written between openTime and closeTime
+	openTime  int64
+	closeTime int64
 }
 
 func newWalFiles(maxTime int64, lock *string, dir string) *WalFiles {
@@ -185,6 +189,10 @@ func RemoveWalFiles(files *WalFiles) error {
 		return nil
 	}
 
+	if !tryArchiveWalFiles(files) {
+		return nil
+	}
+
 	if config.GetStoreConfig().Wal.WalUsedForStream {
 		return moveToStream(files)
 	}
@@ -125,3 +125,44 @@ func CreateFile(path string) {
 	fd.Write([]byte("123"))
 	fd.Close()
 }
+
+func TestFileChecksum(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "00000001-0000-00000000.tssp")
+	CreateFile(path)
+
+	sum, err := FileChecksum(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = VerifyFileChecksum(path, sum); err != nil {
+		t.Fatal(err)
+	}
+
+	CreateFile(path)
+	fd, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0640)
+	fd.Write([]byte("4"))
+	fd.Close()
+	if err = VerifyFileChecksum(path, sum); err == nil {
+		t.Fatal("expect checksum mismatch")
+	}
+	if _, err = FileChecksum(filepath.Join(dir, "missing")); err == nil {
+		t.Fatal("expect error")
+	}
+}
+
+func TestWalArchiveFileName(t *testing.T) {
+	name := WalArchiveFileName(100, 200, "3")
+	if name != "100_200_3.wal" {
+		t.Fatalf("unexpected name %s", name)
+	}
+	openTime, closeTime, err := ParseWalArchiveFileName(name)
+	if err != nil || openTime != 100 || closeTime != 200 {
+		t.Fatalf("parse %s failed: %d %d %v", name, openTime, closeTime, err)
+	}
+	for _, name = range []string{"1.wal", "a_200_3.wal", "100_b_3.wal", "100_200_3.tssp"} {
+		if _, _, err = ParseWalArchiveFileName(name); err == nil {
+			t.Fatalf("expect error for %s", name)
+		}
+	}
+}
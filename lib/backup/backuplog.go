@@ -18,11 +18,15 @@ type BackupLogInfo struct {
 	FullBackupTime int64                 `json:"fullBackupTime"`
 	IncBackupTime  int64                 `json:"incBackupTime"`
 	FileListMap    map[string][][]string `json:"orderFileListMap"`
+	WalFileList    []string              `json:"walFileList,omitempty"`
+	Checksums      map[string]string     `json:"checksums,omitempty"`
 }
 
 type IncBackupLogInfo struct {
 	AddFileListMap map[string][][]string `json:"addOrderFileListMap"`
 	DelFileListMap map[string][][]string `json:"delOrderFileListMap"`
+	WalFileList    []string              `json:"walFileList,omitempty"`
+	Checksums      map[string]string     `json:"checksums,omitempty"`
 }
 
 type MetaBackupLogInfo struct {
@@ -0,0 +1,78 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"io/fs"
+	"path/filepath"
+)
+
+// Destination is where a backup is written to, a local path or a remote bucket
+type Destination interface {
+	// CopyFile copies the file src to dst in the backup and returns its checksum
+	CopyFile(src, dst string) (string, error)
+	// CopyFolder copies the folder src to dst in the backup
+	CopyFolder(src, dst string) error
+	// WriteLog writes a backup log in the backup_log dir under dir
+	WriteLog(content []byte, dir, logName string) error
+	// WriteResult reports the progress or the result of the backup
+	WriteResult(content []byte) error
+	// Commit is called once all files and logs are written
+	Commit() error
+}
+
+type LocalDestination struct {
+	path string
+}
+
+func NewLocalDestination(path string) *LocalDestination {
+	return &LocalDestination{path: path}
+}
+
+func (d *LocalDestination) CopyFile(src, dst string) (string, error) {
+	if err := FileCopy(src, dst); err != nil {
+		return "", err
+	}
+	return FileChecksum(dst)
+}
+
+func (d *LocalDestination) CopyFolder(src, dst string) error {
+	return FolderCopy(src, dst)
+}
+
+func (d *LocalDestination) WriteLog(content []byte, dir, logName string) error {
+	return WriteBackupLogFile(content, dir, logName)
+}
+
+func (d *LocalDestination) WriteResult(content []byte) error {
+	return WriteBackupLogFile(content, d.path, ResultLog)
+}
+
+func (d *LocalDestination) Commit() error {
+	return nil
+}
+
+func walkFiles(root string, fn func(file, rel string) error) error {
+	return filepath.WalkDir(root, func(file string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, file)
+		if err != nil {
+			return err
+		}
+		return fn(file, rel)
+	})
+}
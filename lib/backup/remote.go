@@ -0,0 +1,395 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/huaweicloud/huaweicloud-sdk-go-obs/obs"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/crypto"
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+const (
+	// checksumMetaKey is the object metadata holding the checksum of the uploaded file,
+	// an object with the same checksum is not uploaded again when a backup is resumed
+	checksumMetaKey = "checksum"
+
+	maxDeleteObjects = 1000
+)
+
+// RemoteStorage reads and writes the backup objects in the bucket of the remote backup config.
+type RemoteStorage struct {
+	client    *obs.ObsClient
+	bucket    string
+	basePath  string
+	partSize  int64
+	retention int
+}
+
+func NewRemoteStorage(conf *config.RemoteBackup) (*RemoteStorage, error) {
+	if conf == nil || !conf.Enabled {
+		return nil, errors.New("remote backup is not enabled")
+	}
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+	signature := obs.SignatureV4
+	if conf.Signature == config.RemoteBackupSignatureOBS {
+		signature = obs.SignatureObs
+	}
+	client, err := obs.New(conf.AccessKey, crypto.Decrypt(conf.SecretKey), conf.Endpoint,
+		obs.WithSignature(signature), obs.WithPathStyle(conf.PathStyle), obs.WithMaxRetryCount(3))
+	if err != nil {
+		return nil, err
+	}
+	return &RemoteStorage{
+		client:    client,
+		bucket:    conf.Bucket,
+		basePath:  strings.Trim(conf.BasePath, "/"),
+		partSize:  int64(conf.PartSize),
+		retention: conf.Retention,
+	}, nil
+}
+
+func (s *RemoteStorage) Close() {
+	s.client.Close()
+}
+
+func (s *RemoteStorage) objectKey(name string) string {
+	return path.Join(s.basePath, filepath.ToSlash(name))
+}
+
+// IsRemoteNotExist returns true if the error is returned for a missing object
+func IsRemoteNotExist(err error) bool {
+	var obsErr obs.ObsError
+	if errors.As(err, &obsErr) {
+		return obsErr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
+
+func (s *RemoteStorage) Put(name string, content []byte) error {
+	input := &obs.PutObjectInput{}
+	input.Bucket = s.bucket
+	input.Key = s.objectKey(name)
+	input.ContentLength = int64(len(content))
+	input.Body = bytes.NewReader(content)
+	_, err := s.client.PutObject(input)
+	return err
+}
+
+func (s *RemoteStorage) Get(name string) ([]byte, error) {
+	input := &obs.GetObjectInput{}
+	input.Bucket = s.bucket
+	input.Key = s.objectKey(name)
+	output, err := s.client.GetObject(input)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = output.Body.Close()
+	}()
+	return io.ReadAll(output.Body)
+}
+
+func (s *RemoteStorage) checksum(name string) (string, error) {
+	input := &obs.GetObjectMetadataInput{Bucket: s.bucket, Key: s.objectKey(name)}
+	output, err := s.client.GetObjectMetadata(input)
+	if err != nil {
+		return "", err
+	}
+	return output.Metadata[checksumMetaKey], nil
+}
+
+// UploadFile uploads the local file src to the object name. Files larger than the part size
+// are uploaded by multipart upload, the parts uploaded by an interrupted backup are reused.
+func (s *RemoteStorage) UploadFile(name, src, checksum string) error {
+	sum, err := s.checksum(name)
+	if err == nil && sum == checksum {
+		return nil
+	}
+	if err != nil && !IsRemoteNotExist(err) {
+		return err
+	}
+
+	fi, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if fi.Size() <= s.partSize {
+		input := &obs.PutFileInput{SourceFile: src}
+		input.Bucket = s.bucket
+		input.Key = s.objectKey(name)
+		input.Metadata = map[string]string{checksumMetaKey: checksum}
+		_, err = s.client.PutFile(input)
+		return err
+	}
+	return s.multipartUpload(s.objectKey(name), src, fi.Size(), checksum)
+}
+
+func (s *RemoteStorage) multipartUpload(key, src string, size int64, checksum string) error {
+	uploadID, uploaded, err := s.pendingUpload(key)
+	if err != nil {
+		return err
+	}
+	if uploadID == "" {
+		input := &obs.InitiateMultipartUploadInput{}
+		input.Bucket = s.bucket
+		input.Key = key
+		input.Metadata = map[string]string{checksumMetaKey: checksum}
+		output, err := s.client.InitiateMultipartUpload(input)
+		if err != nil {
+			return err
+		}
+		uploadID = output.UploadId
+	}
+
+	num := int((size + s.partSize - 1) / s.partSize)
+	parts := make([]obs.Part, 0, num)
+	for i := 1; i <= num; i++ {
+		offset := int64(i-1) * s.partSize
+		partSize := s.partSize
+		if offset+partSize > size {
+			partSize = size - offset
+		}
+
+		if p, ok := uploaded[i]; ok && p.Size == partSize {
+			same, err := samePart(src, offset, partSize, p.ETag)
+			if err != nil {
+				return err
+			}
+			if same {
+				parts = append(parts, obs.Part{PartNumber: i, ETag: p.ETag})
+				continue
+			}
+		}
+
+		output, err := s.client.UploadPart(&obs.UploadPartInput{
+			Bucket:     s.bucket,
+			Key:        key,
+			PartNumber: i,
+			UploadId:   uploadID,
+			SourceFile: src,
+			Offset:     offset,
+			PartSize:   partSize,
+		})
+		if err != nil {
+			return err
+		}
+		parts = append(parts, obs.Part{PartNumber: i, ETag: output.ETag})
+	}
+
+	_, err = s.client.CompleteMultipartUpload(&obs.CompleteMultipartUploadInput{
+		Bucket:   s.bucket,
+		Key:      key,
+		UploadId: uploadID,
+		Parts:    parts,
+	})
+	return err
+}
+
+// pendingUpload returns the latest multipart upload of the key left by an interrupted backup
+// and its uploaded parts, the other uploads of the key are aborted.
+func (s *RemoteStorage) pendingUpload(key string) (string, map[int]obs.Part, error) {
+	output, err := s.client.ListMultipartUploads(&obs.ListMultipartUploadsInput{Bucket: s.bucket, Prefix: key})
+	if err != nil {
+		return "", nil, err
+	}
+	var uploads []obs.Upload
+	for _, u := range output.Uploads {
+		if u.Key == key {
+			uploads = append(uploads, u)
+		}
+	}
+	if len(uploads) == 0 {
+		return "", nil, nil
+	}
+	sort.Slice(uploads, func(i, j int) bool {
+		return uploads[i].Initiated.After(uploads[j].Initiated)
+	})
+	for _, u := range uploads[1:] {
+		if err = s.abortUpload(key, u.UploadId); err != nil {
+			return "", nil, err
+		}
+	}
+
+	uploadID := uploads[0].UploadId
+	parts := make(map[int]obs.Part)
+	input := &obs.ListPartsInput{Bucket: s.bucket, Key: key, UploadId: uploadID}
+	for {
+		output, err := s.client.ListParts(input)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, p := range output.Parts {
+			parts[p.PartNumber] = p
+		}
+		if !output.IsTruncated {
+			break
+		}
+		input.PartNumberMarker = output.NextPartNumberMarker
+	}
+	return uploadID, parts, nil
+}
+
+func (s *RemoteStorage) abortUpload(key, uploadID string) error {
+	_, err := s.client.AbortMultipartUpload(&obs.AbortMultipartUploadInput{Bucket: s.bucket, Key: key, UploadId: uploadID})
+	return err
+}
+
+// samePart checks whether the part uploaded before has the same content as the local file
+func samePart(src string, offset, size int64, etag string) (bool, error) {
+	f, err := os.Open(src)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	h := md5.New()
+	if _, err = io.Copy(h, io.NewSectionReader(f, offset, size)); err != nil {
+		return false, err
+	}
+	return hex.EncodeToString(h.Sum(nil)) == strings.Trim(etag, "\""), nil
+}
+
+// DownloadFile downloads the object name to the local file dst
+func (s *RemoteStorage) DownloadFile(name, dst string) error {
+	input := &obs.GetObjectInput{}
+	input.Bucket = s.bucket
+	input.Key = s.objectKey(name)
+	output, err := s.client.GetObject(input)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = output.Body.Close()
+	}()
+
+	if err = fileops.MkdirAll(filepath.Dir(dst), 0750); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(f, output.Body); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// List returns the names of the objects under the prefix
+func (s *RemoteStorage) List(prefix string) ([]string, error) {
+	var names []string
+	err := s.list(prefix, "", func(output *obs.ListObjectsOutput) {
+		for _, c := range output.Contents {
+			names = append(names, s.trimBasePath(c.Key))
+		}
+	})
+	return names, err
+}
+
+// ListDirs returns the names of the directories directly under the prefix
+func (s *RemoteStorage) ListDirs(prefix string) ([]string, error) {
+	var names []string
+	err := s.list(prefix, "/", func(output *obs.ListObjectsOutput) {
+		for _, p := range output.CommonPrefixes {
+			names = append(names, path.Base(s.trimBasePath(p)))
+		}
+	})
+	return names, err
+}
+
+func (s *RemoteStorage) list(prefix, delimiter string, fn func(*obs.ListObjectsOutput)) error {
+	input := &obs.ListObjectsInput{Bucket: s.bucket}
+	input.Prefix = s.objectKey(prefix)
+	if input.Prefix != "" {
+		input.Prefix += "/"
+	}
+	input.Delimiter = delimiter
+	for {
+		output, err := s.client.ListObjects(input)
+		if err != nil {
+			return err
+		}
+		fn(output)
+		if !output.IsTruncated {
+			return nil
+		}
+		input.Marker = output.NextMarker
+		if input.Marker == "" && len(output.Contents) > 0 {
+			input.Marker = output.Contents[len(output.Contents)-1].Key
+		}
+	}
+}
+
+func (s *RemoteStorage) trimBasePath(key string) string {
+	if s.basePath == "" {
+		return key
+	}
+	return strings.TrimPrefix(key, s.basePath+"/")
+}
+
+// RemoveAll removes the objects and the pending multipart uploads under the prefix
+func (s *RemoteStorage) RemoveAll(prefix string) error {
+	names, err := s.List(prefix)
+	if err != nil {
+		return err
+	}
+	for len(names) > 0 {
+		n := len(names)
+		if n > maxDeleteObjects {
+			n = maxDeleteObjects
+		}
+		input := &obs.DeleteObjectsInput{Bucket: s.bucket, Quiet: true}
+		for _, name := range names[:n] {
+			input.Objects = append(input.Objects, obs.ObjectToDelete{Key: s.objectKey(name)})
+		}
+		output, err := s.client.DeleteObjects(input)
+		if err != nil {
+			return err
+		}
+		if len(output.Errors) > 0 {
+			return fmt.Errorf("delete object %s failed: %s", output.Errors[0].Key, output.Errors[0].Message)
+		}
+		names = names[n:]
+	}
+
+	output, err := s.client.ListMultipartUploads(&obs.ListMultipartUploadsInput{Bucket: s.bucket, Prefix: s.objectKey(prefix) + "/"})
+	if err != nil {
+		return err
+	}
+	for _, u := range output.Uploads {
+		if err = s.abortUpload(u.Key, u.UploadId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openGemini/openGemini/lib/fileops"
+)
+
+// The objects of a remote backup generation are laid out as:
+//
+//	<base-path>/<generation>/<node>/data_backup/...   files of a ts-store node
+//	<base-path>/<generation>/meta/meta_backup/...     files of the meta backup
+//	<base-path>/<generation>/manifest/<node>.json     written when the backup of the node is complete
+//	<base-path>/<generation>/result/<node>            progress or result of the backup of the node
+const (
+	RemoteManifestDir = "manifest"
+	RemoteResultDir   = "result"
+	RemoteMetaNode    = "meta"
+
+	remoteManifestSuffix = ".json"
+)
+
+// RemoteManifest replaces the backup logs of a node in a remote backup
+type RemoteManifest struct {
+	Time  int64 `json:"time"`
+	IsInc bool  `json:"isInc"`
+	// checksums of the backup files, by their path in the backup of the node
+	Files map[string]string `json:"files"`
+	// backup logs, by their path in the backup of the node
+	Logs map[string]json.RawMessage `json:"logs"`
+}
+
+// RemoteNodeName returns the name of the node in the remote backup from its address
+func RemoteNodeName(addr string) string {
+	return strings.NewReplacer(":", "_", "/", "_").Replace(addr)
+}
+
+func validGeneration(generation string) error {
+	if generation == "" || generation == "." || generation == ".." || strings.ContainsAny(generation, `/\`) {
+		return fmt.Errorf("invalid remote backup name %q, it must be a single path element", generation)
+	}
+	return nil
+}
+
+func remoteManifestName(generation, node string) string {
+	return path.Join(generation, RemoteManifestDir, node+remoteManifestSuffix)
+}
+
+// RemoteDestination uploads the backup of a node to the remote storage, BackupPath is the name of
+// the generation in the bucket.
+type RemoteDestination struct {
+	storage    *RemoteStorage
+	generation string
+	node       string
+	manifest   *RemoteManifest
+}
+
+func NewRemoteDestination(storage *RemoteStorage, generation, node string, backupTime int64, isInc bool) (*RemoteDestination, error) {
+	if err := validGeneration(generation); err != nil {
+		return nil, err
+	}
+	return &RemoteDestination{
+		storage:    storage,
+		generation: generation,
+		node:       node,
+		manifest: &RemoteManifest{
+			Time:  backupTime,
+			IsInc: isInc,
+			Files: make(map[string]string),
+			Logs:  make(map[string]json.RawMessage),
+		},
+	}, nil
+}
+
+func (d *RemoteDestination) relPath(dst string) (string, error) {
+	rel, err := filepath.Rel(d.generation, dst)
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf("%s is not in the backup %s", dst, d.generation)
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func (d *RemoteDestination) CopyFile(src, dst string) (string, error) {
+	rel, err := d.relPath(dst)
+	if err != nil {
+		return "", err
+	}
+	sum, err := FileChecksum(src)
+	if err != nil {
+		return "", err
+	}
+	if err = d.storage.UploadFile(path.Join(d.generation, d.node, rel), src, sum); err != nil {
+		return "", err
+	}
+	d.manifest.Files[rel] = sum
+	return sum, nil
+}
+
+func (d *RemoteDestination) CopyFolder(src, dst string) error {
+	return walkFiles(src, func(file, rel string) error {
+		_, err := d.CopyFile(file, filepath.Join(dst, rel))
+		return err
+	})
+}
+
+func (d *RemoteDestination) WriteLog(content []byte, dir, logName string) error {
+	rel, err := d.relPath(filepath.Join(dir, BackupLogPath, logName))
+	if err != nil {
+		return err
+	}
+	if !json.Valid(content) {
+		return fmt.Errorf("backup log %s is not json", rel)
+	}
+	d.manifest.Logs[rel] = content
+	return nil
+}
+
+func (d *RemoteDestination) WriteResult(content []byte) error {
+	return d.storage.Put(path.Join(d.generation, RemoteResultDir, d.node), content)
+}
+
+// Commit writes the manifest of the node, then removes the generations beyond the retention
+func (d *RemoteDestination) Commit() error {
+	content, err := json.MarshalIndent(d.manifest, "", "\t")
+	if err != nil {
+		return err
+	}
+	if err = d.storage.Put(remoteManifestName(d.generation, d.node), content); err != nil {
+		return err
+	}
+	return ApplyRetention(d.storage)
+}
+
+func readRemoteManifest(s *RemoteStorage, name string) (*RemoteManifest, error) {
+	content, err := s.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	m := &RemoteManifest{}
+	if err = json.Unmarshal(content, m); err != nil {
+		return nil, fmt.Errorf("invalid remote manifest %s: %s", name, err)
+	}
+	return m, nil
+}
+
+type remoteGeneration struct {
+	name  string
+	time  int64
+	isInc bool
+}
+
+// ApplyRetention keeps the latest full backup generations of the retention and the
+// incremental ones taken after them, the older generations are removed. Generations
+// without any manifest are in progress or failed, they are left as they are.
+func ApplyRetention(s *RemoteStorage) error {
+	if s.retention <= 0 {
+		return nil
+	}
+	names, err := s.ListDirs("")
+	if err != nil {
+		return err
+	}
+
+	generations := make([]remoteGeneration, 0, len(names))
+	for _, name := range names {
+		manifests, err := s.List(path.Join(name, RemoteManifestDir))
+		if err != nil {
+			return err
+		}
+		if len(manifests) == 0 {
+			continue
+		}
+		g := remoteGeneration{name: name}
+		for _, m := range manifests {
+			manifest, err := readRemoteManifest(s, m)
+			if err != nil {
+				return err
+			}
+			g.time = max(g.time, manifest.Time)
+			g.isInc = g.isInc || manifest.IsInc
+		}
+		generations = append(generations, g)
+	}
+	sort.Slice(generations, func(i, j int) bool {
+		return generations[i].time > generations[j].time
+	})
+
+	full := 0
+	for i, g := range generations {
+		if g.isInc {
+			continue
+		}
+		full++
+		if full < s.retention {
+			continue
+		}
+		for _, old := range generations[i+1:] {
+			if err = s.RemoveAll(old.name); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// DownloadRemoteBackup downloads the backup of the node and the meta backup in the generation
+// to dst, laid out as a local backup. The files downloaded before are kept if their checksums match.
+func DownloadRemoteBackup(s *RemoteStorage, generation, node, dst string) error {
+	if err := validGeneration(generation); err != nil {
+		return err
+	}
+	found := false
+	for _, n := range []string{node, RemoteMetaNode} {
+		m, err := readRemoteManifest(s, remoteManifestName(generation, n))
+		if IsRemoteNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		found = true
+
+		for rel, sum := range m.Files {
+			local := filepath.Join(dst, filepath.FromSlash(rel))
+			if VerifyFileChecksum(local, sum) == nil {
+				continue
+			}
+			if err = s.DownloadFile(path.Join(generation, n, rel), local); err != nil {
+				return err
+			}
+			if err = VerifyFileChecksum(local, sum); err != nil {
+				return err
+			}
+		}
+		for rel, content := range m.Logs {
+			local := filepath.Join(dst, filepath.FromSlash(rel))
+			if err = fileops.MkdirAll(filepath.Dir(local), 0750); err != nil {
+				return err
+			}
+			if err = os.WriteFile(local, content, 0640); err != nil {
+				return err
+			}
+		}
+	}
+	if !found {
+		return fmt.Errorf("remote backup %s of %s is not found or not complete", generation, node)
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRemoteStorage(t *testing.T, retention int) (*RemoteStorage, *mocks.ObjectStorage) {
+	server := mocks.NewObjectStorage("backup")
+	t.Cleanup(server.Close)
+	conf := config.NewRemoteBackup()
+	conf.Enabled = true
+	conf.Endpoint = server.URL
+	conf.Bucket = "backup"
+	conf.AccessKey = "ak"
+	conf.SecretKey = "sk"
+	conf.BasePath = "/openGemini/"
+	conf.PathStyle = true
+	conf.PartSize = 10
+	conf.Retention = retention
+	s, err := NewRemoteStorage(&conf)
+	require.NoError(t, err)
+	t.Cleanup(s.Close)
+	return s, server
+}
+
+func writeTestFile(t *testing.T, file, content string) string {
+	require.NoError(t, os.MkdirAll(filepath.Dir(file), 0750))
+	require.NoError(t, os.WriteFile(file, []byte(content), 0640))
+	return file
+}
+
+func TestNewRemoteStorage(t *testing.T) {
+	conf := config.NewRemoteBackup()
+	_, err := NewRemoteStorage(&conf)
+	require.EqualError(t, err, "remote backup is not enabled")
+	conf.Enabled = true
+	_, err = NewRemoteStorage(&conf)
+	require.EqualError(t, err, "data remote-backup endpoint must not be empty")
+}
+
+func TestRemoteStorage_UploadFile(t *testing.T) {
+	s, server := newTestRemoteStorage(t, 0)
+	dir := t.TempDir()
+
+	small := writeTestFile(t, filepath.Join(dir, "small"), "0123456789")
+	sum, err := FileChecksum(small)
+	require.NoError(t, err)
+	require.NoError(t, s.UploadFile("gen/small", small, sum))
+	require.NoError(t, s.UploadFile("gen/small", small, sum))
+	require.Equal(t, 1, server.Requests("PutObject"))
+	data, ok := server.Object("openGemini/gen/small")
+	require.True(t, ok)
+	require.Equal(t, "0123456789", string(data))
+
+	content := strings.Repeat("abcdefghij", 3) + "xyz"
+	large := writeTestFile(t, filepath.Join(dir, "large"), content)
+	sum, err = FileChecksum(large)
+	require.NoError(t, err)
+
+	// the upload is interrupted after 2 of the 4 parts
+	server.FailUploadPartAfter(2)
+	require.Error(t, s.UploadFile("gen/large", large, sum))
+	require.Equal(t, 1, server.PendingUploads())
+	_, ok = server.Object("openGemini/gen/large")
+	require.False(t, ok)
+
+	// the uploaded parts are reused
+	server.FailUploadPartAfter(-1)
+	uploaded := server.Requests("UploadPart")
+	require.NoError(t, s.UploadFile("gen/large", large, sum))
+	require.Equal(t, 2, server.Requests("UploadPart")-uploaded)
+	require.Equal(t, 0, server.PendingUploads())
+	data, ok = server.Object("openGemini/gen/large")
+	require.True(t, ok)
+	require.Equal(t, content, string(data))
+
+	require.NoError(t, s.DownloadFile("gen/large", filepath.Join(dir, "download", "large")))
+	require.NoError(t, VerifyFileChecksum(filepath.Join(dir, "download", "large"), sum))
+	_, err = s.Get("gen/missing")
+	require.True(t, IsRemoteNotExist(err))
+}
+
+func TestRemoteStorage_List(t *testing.T) {
+	s, server := newTestRemoteStorage(t, 0)
+	server.MaxKeys = 2
+	for _, name := range []string{"gen1/a", "gen1/b/c", "gen2/a", "gen3/a", "gen3/b"} {
+		require.NoError(t, s.Put(name, []byte(name)))
+	}
+	names, err := s.List("gen3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"gen3/a", "gen3/b"}, names)
+	names, err = s.List("")
+	require.NoError(t, err)
+	require.Equal(t, 5, len(names))
+	dirs, err := s.ListDirs("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"gen1", "gen2", "gen3"}, dirs)
+
+	require.NoError(t, s.RemoveAll("gen1"))
+	require.Equal(t, []string{"openGemini/gen2/a", "openGemini/gen3/a", "openGemini/gen3/b"}, server.Keys())
+}
+
+func runRemoteBackup(t *testing.T, s *RemoteStorage, generation, node string, backupTime int64, isInc bool, files map[string]string) {
+	src := t.TempDir()
+	d, err := NewRemoteDestination(s, generation, node, backupTime, isInc)
+	require.NoError(t, err)
+	for name, content := range files {
+		file := writeTestFile(t, filepath.Join(src, name), content)
+		_, err = d.CopyFile(file, filepath.Join(generation, DataBackupDir, name))
+		require.NoError(t, err)
+	}
+	log, err := json.Marshal(&BackupLogInfo{FullBackupTime: backupTime})
+	require.NoError(t, err)
+	require.NoError(t, d.WriteLog(log, filepath.Join(generation, DataBackupDir, "shard"), FullBackupLog))
+	require.NoError(t, d.WriteResult([]byte("backup success")))
+	require.NoError(t, d.Commit())
+}
+
+func TestRemoteDestination(t *testing.T) {
+	s, server := newTestRemoteStorage(t, 0)
+	_, err := NewRemoteDestination(s, "a/b", "node", 0, false)
+	require.Error(t, err)
+
+	d, err := NewRemoteDestination(s, "gen1", "node1", 0, false)
+	require.NoError(t, err)
+	_, err = d.CopyFile("/tmp/file", "gen2/data_backup/file")
+	require.Error(t, err)
+	require.Error(t, d.WriteLog([]byte("backup success"), "gen1", FullBackupLog))
+
+	src := t.TempDir()
+	writeTestFile(t, filepath.Join(src, "index", "a"), "index a")
+	writeTestFile(t, filepath.Join(src, "index", "b", "c"), "index c")
+	require.NoError(t, d.CopyFolder(filepath.Join(src, "index"), filepath.Join("gen1", DataBackupDir, "index")))
+	require.NoError(t, d.Commit())
+	runRemoteBackup(t, s, "gen1", "node2", 1, false, map[string]string{"shard/tssp/a.tssp": "0123456789abcdefghij"})
+	runRemoteBackup(t, s, "gen1", RemoteMetaNode, 1, false, map[string]string{"meta/snapshot": "meta"})
+
+	require.Equal(t, []string{
+		"openGemini/gen1/manifest/meta.json",
+		"openGemini/gen1/manifest/node1.json",
+		"openGemini/gen1/manifest/node2.json",
+		"openGemini/gen1/meta/data_backup/meta/snapshot",
+		"openGemini/gen1/node1/data_backup/index/a",
+		"openGemini/gen1/node1/data_backup/index/b/c",
+		"openGemini/gen1/node2/data_backup/shard/tssp/a.tssp",
+		"openGemini/gen1/result/meta",
+		"openGemini/gen1/result/node2",
+	}, server.Keys())
+
+	// only the backup of the node and the meta backup are downloaded
+	dst := t.TempDir()
+	require.NoError(t, DownloadRemoteBackup(s, "gen1", "node2", dst))
+	data, err := os.ReadFile(filepath.Join(dst, DataBackupDir, "shard/tssp/a.tssp"))
+	require.NoError(t, err)
+	require.Equal(t, "0123456789abcdefghij", string(data))
+	_, err = os.Stat(filepath.Join(dst, DataBackupDir, "meta/snapshot"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dst, DataBackupDir, "index"))
+	require.True(t, os.IsNotExist(err))
+	log := &BackupLogInfo{}
+	require.NoError(t, ReadBackupLogFile(filepath.Join(dst, DataBackupDir, "shard", BackupLogPath, FullBackupLog), log))
+	require.Equal(t, int64(1), log.FullBackupTime)
+
+	// the files downloaded before are kept
+	downloads := server.Requests("GetObject")
+	require.NoError(t, DownloadRemoteBackup(s, "gen1", "node2", dst))
+	require.Equal(t, 2, server.Requests("GetObject")-downloads)
+
+	require.NoError(t, DownloadRemoteBackup(s, "gen1", "node1", dst))
+	data, err = os.ReadFile(filepath.Join(dst, DataBackupDir, "index/b/c"))
+	require.NoError(t, err)
+	require.Equal(t, "index c", string(data))
+
+	require.EqualError(t, DownloadRemoteBackup(s, "gen2", "node1", dst), "remote backup gen2 of node1 is not found or not complete")
+}
+
+func TestApplyRetention(t *testing.T) {
+	s, server := newTestRemoteStorage(t, 2)
+	files := map[string]string{"a.tssp": "a"}
+	runRemoteBackup(t, s, "full1", "node", 1, false, files)
+	runRemoteBackup(t, s, "inc1", "node", 2, true, files)
+	runRemoteBackup(t, s, "full2", "node", 3, false, files)
+	require.NoError(t, s.Put("failed/node/data_backup/a.tssp", []byte("a")))
+	runRemoteBackup(t, s, "inc2", "node", 4, true, files)
+	dirs, err := s.ListDirs("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"failed", "full1", "full2", "inc1", "inc2"}, dirs)
+
+	// full1 and inc1 are removed once the third full backup is complete
+	runRemoteBackup(t, s, "full3", "node", 5, false, files)
+	dirs, err = s.ListDirs("")
+	require.NoError(t, err)
+	require.Equal(t, []string{"failed", "full2", "full3", "inc2"}, dirs)
+	for _, key := range server.Keys() {
+		require.False(t, strings.HasPrefix(key, "openGemini/full1/"), key)
+	}
+}
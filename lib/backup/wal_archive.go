@@ -0,0 +1,83 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// WalArchiveDir is the directory under the wal path of a shard where the
+	// closed wal files are kept until they are backed up
+	WalArchiveDir = "archive"
+
+	walArchiveSuffix = ".wal"
+)
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// WalArchiveFileName returns the name of an archived wal file which was written
+// between openTime and closeTime. openTime is 0 if it is unknown.
+func WalArchiveFileName(openTime, closeTime int64, seq string) string {
+	return fmt.Sprintf("%d_%d_%s%s", openTime, closeTime, seq, walArchiveSuffix)
+}
+
+func ParseWalArchiveFileName(name string) (openTime, closeTime int64, err error) {
+	items := strings.Split(strings.TrimSuffix(name, walArchiveSuffix), "_")
+	if !strings.HasSuffix(name, walArchiveSuffix) || len(items) != 3 {
+		return 0, 0, fmt.Errorf("invalid wal archive file name %s", name)
+	}
+	if openTime, err = strconv.ParseInt(items[0], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid wal archive file name %s", name)
+	}
+	if closeTime, err = strconv.ParseInt(items[1], 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid wal archive file name %s", name)
+	}
+	return openTime, closeTime, nil
+}
+
+// FileChecksum returns the hex encoded CRC-32C of the file content.
+func FileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := crc32.New(crc32c)
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// VerifyFileChecksum returns an error if the checksum of the file is not the one
+// recorded in the backup log.
+func VerifyFileChecksum(path, checksum string) error {
+	sum, err := FileChecksum(path)
+	if err != nil {
+		return err
+	}
+	if sum != checksum {
+		return fmt.Errorf("checksum mismatch for %s, expect %s, got %s", path, checksum, sum)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultAlertRunInterval        = 10 * time.Second
+	DefaultAlertEvaluationInterval = time.Minute
+	DefaultAlertQueryURL           = "http://127.0.0.1:8086"
+	DefaultAlertQueryTimeout       = 30 * time.Second
+	DefaultAlertWebhookTimeout     = 10 * time.Second
+	DefaultAlertResolvedRetention  = 15 * time.Minute
+	DefaultAlertMaxConcurrent      = 4
+)
+
+// AlertConfig represents the configuration of the alerting service, which evaluates the alert rules
+// stored in ts-meta and posts the notifications of their alerts to the webhook receivers.
+type AlertConfig struct {
+	Enabled bool `toml:"enabled"`
+
+	// RunInterval is how often the service checks which rules are due to be evaluated.
+	RunInterval toml.Duration `toml:"run-interval"`
+	// EvaluationInterval is the interval of the rules which do not specify their own.
+	EvaluationInterval toml.Duration `toml:"evaluation-interval"`
+
+	// QueryURL is the http endpoint of ts-sql which the rule queries are sent to.
+	QueryURL string `toml:"query-url"`
+	Username string `toml:"username"`
+	Password string `toml:"password"`
+	// ExternalURL is sent to the receivers to identify the source of the notifications.
+	ExternalURL string `toml:"external-url"`
+
+	QueryTimeout   toml.Duration `toml:"query-timeout"`
+	WebhookTimeout toml.Duration `toml:"webhook-timeout"`
+	// ResolvedRetention is how long the resolved alerts are kept, so that they can be notified as resolved.
+	ResolvedRetention toml.Duration `toml:"resolved-retention"`
+	MaxConcurrent     int           `toml:"max-concurrent"`
+}
+
+func NewAlertConfig() AlertConfig {
+	return AlertConfig{
+		Enabled:            false,
+		RunInterval:        toml.Duration(DefaultAlertRunInterval),
+		EvaluationInterval: toml.Duration(DefaultAlertEvaluationInterval),
+		QueryURL:           DefaultAlertQueryURL,
+		QueryTimeout:       toml.Duration(DefaultAlertQueryTimeout),
+		WebhookTimeout:     toml.Duration(DefaultAlertWebhookTimeout),
+		ResolvedRetention:  toml.Duration(DefaultAlertResolvedRetention),
+		MaxConcurrent:      DefaultAlertMaxConcurrent,
+	}
+}
+
+func (c AlertConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if time.Duration(c.RunInterval) < time.Second {
+		return errors.New("alert run-interval must be at least 1 second")
+	}
+	if c.EvaluationInterval < c.RunInterval {
+		return errors.New("alert evaluation-interval must not be less than run-interval")
+	}
+	u, err := url.Parse(c.QueryURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("alert query-url %q must be a http or https url", c.QueryURL)
+	}
+	if c.QueryTimeout <= 0 || c.WebhookTimeout <= 0 {
+		return errors.New("alert query-timeout and webhook-timeout must be greater than zero")
+	}
+	if c.ResolvedRetention < 0 {
+		return errors.New("alert resolved-retention must not be negative")
+	}
+	if c.MaxConcurrent <= 0 {
+		return errors.New("alert max-concurrent must be greater than zero")
+	}
+	return nil
+}
+
+func (c AlertConfig) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"alert.enabled":             c.Enabled,
+		"alert.run-interval":        c.RunInterval,
+		"alert.evaluation-interval": c.EvaluationInterval,
+		"alert.query-url":           c.QueryURL,
+		"alert.username":            c.Username,
+		"alert.external-url":        c.ExternalURL,
+		"alert.query-timeout":       c.QueryTimeout,
+		"alert.webhook-timeout":     c.WebhookTimeout,
+		"alert.resolved-retention":  c.ResolvedRetention,
+		"alert.max-concurrent":      c.MaxConcurrent,
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlertConfig_Validate(t *testing.T) {
+	c := NewAlertConfig()
+	c.QueryURL = ""
+	require.NoError(t, c.Validate())
+
+	c = NewAlertConfig()
+	c.Enabled = true
+	require.NoError(t, c.Validate())
+
+	c.RunInterval = toml.Duration(time.Millisecond)
+	require.EqualError(t, c.Validate(), "alert run-interval must be at least 1 second")
+	c.RunInterval = toml.Duration(2 * time.Minute)
+	require.EqualError(t, c.Validate(), "alert evaluation-interval must not be less than run-interval")
+	c.RunInterval = toml.Duration(DefaultAlertRunInterval)
+
+	c.QueryURL = "127.0.0.1:8086"
+	require.Error(t, c.Validate())
+	c.QueryURL = DefaultAlertQueryURL
+
+	c.MaxConcurrent = 0
+	require.EqualError(t, c.Validate(), "alert max-concurrent must be greater than zero")
+}
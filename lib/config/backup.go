@@ -0,0 +1,81 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultRemoteBackupPartSize  = 64 * MB
+	DefaultRemoteBackupRetention = 7
+
+	RemoteBackupSignatureV4  = "v4"
+	RemoteBackupSignatureOBS = "obs"
+)
+
+// RemoteBackup represents the object storage bucket that backups are written to
+// when a backup is started with isRemote=true, ts-recover restores from it too.
+type RemoteBackup struct {
+	Enabled   bool   `toml:"enabled"`
+	Endpoint  string `toml:"endpoint"`
+	Bucket    string `toml:"bucket"`
+	AccessKey string `toml:"access-key"`
+	SecretKey string `toml:"secret-key"`
+	// all backup generations are stored under base-path in the bucket
+	BasePath string `toml:"base-path"`
+	// v4 for S3 compatible storages, obs for OBS
+	Signature string `toml:"signature"`
+	PathStyle bool   `toml:"path-style"`
+
+	// files larger than part-size are uploaded by multipart upload
+	PartSize toml.Size `toml:"part-size"`
+	// number of full backup generations to keep, 0 keeps all of them
+	Retention int `toml:"retention"`
+}
+
+func NewRemoteBackup() RemoteBackup {
+	return RemoteBackup{
+		Enabled:   false,
+		Signature: RemoteBackupSignatureV4,
+		PartSize:  DefaultRemoteBackupPartSize,
+		Retention: DefaultRemoteBackupRetention,
+	}
+}
+
+func (c RemoteBackup) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	svItems := []stringValidatorItem{
+		{"data remote-backup endpoint", c.Endpoint},
+		{"data remote-backup bucket", c.Bucket},
+	}
+	if err := (stringValidator{}).Validate(svItems); err != nil {
+		return err
+	}
+	if c.Signature != RemoteBackupSignatureV4 && c.Signature != RemoteBackupSignatureOBS {
+		return errors.New("data remote-backup signature must be v4 or obs")
+	}
+	if c.PartSize <= 0 {
+		return errors.New("data remote-backup part-size must be positive")
+	}
+	if c.Retention < 0 {
+		return errors.New("data remote-backup retention can not be negative")
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config_test
+
+import (
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemoteBackup_Validate(t *testing.T) {
+	conf := config.NewRemoteBackup()
+	require.NoError(t, conf.Validate())
+
+	conf.Enabled = true
+	require.EqualError(t, conf.Validate(), "data remote-backup endpoint must not be empty")
+	conf.Endpoint = "http://127.0.0.1:9000"
+	require.EqualError(t, conf.Validate(), "data remote-backup bucket must not be empty")
+	conf.Bucket = "backup"
+	require.NoError(t, conf.Validate())
+
+	conf.Signature = "v2"
+	require.EqualError(t, conf.Validate(), "data remote-backup signature must be v4 or obs")
+	conf.Signature = config.RemoteBackupSignatureOBS
+	conf.PartSize = 0
+	require.EqualError(t, conf.Validate(), "data remote-backup part-size must be positive")
+	conf.PartSize = config.DefaultRemoteBackupPartSize
+	conf.Retention = -1
+	require.EqualError(t, conf.Validate(), "data remote-backup retention can not be negative")
+
+	store := config.NewStore()
+	store.RemoteBackup.Enabled = true
+	require.Error(t, store.Validate())
+}
@@ -15,6 +15,7 @@
 package config
 
 import (
+	"errors"
 	"time"
 
 	"github.com/prometheus/common/model"
@@ -35,10 +36,27 @@ type Limits struct {
 	CreationGracePeriod       model.Duration `toml:"creation-grace-period" yaml:"creation_grace_period"`
 	EnforceMetadataMetricName bool           `toml:"enforce-metadata-metric-name" yaml:"enforce_metadata_metric_name"`
 	EnforceMetricName         bool           `toml:"enforce-metric-name" yaml:"enforce_metric_name"`
+
+	// ingestion rate limits, zero means unlimited
+	IngestionRate           float64        `toml:"ingestion-rate" yaml:"ingestion_rate"`
+	IngestionBurstSize      int            `toml:"ingestion-burst-size" yaml:"ingestion_burst_size"`
+	IngestionBytesRate      float64        `toml:"ingestion-bytes-rate" yaml:"ingestion_bytes_rate"`
+	IngestionBytesBurstSize int            `toml:"ingestion-bytes-burst-size" yaml:"ingestion_bytes_burst_size"`
+	MaxActiveSeries         int            `toml:"max-active-series" yaml:"max_active_series"`
+	ActiveSeriesIdleTimeout model.Duration `toml:"active-series-idle-timeout" yaml:"active_series_idle_timeout"`
+
+	// MaxNativeHistogramBuckets caps the buckets of a native histogram sample, each bucket is
+	// stored as an le series, the resolution of a larger histogram is reduced. Zero means unlimited.
+	MaxNativeHistogramBuckets int `toml:"max-native-histogram-buckets" yaml:"max_native_histogram_buckets"`
+
 	// query limits
-	MaxQueryLength model.Duration `toml:"max-query-length" yaml:"max_query_length"`
+	MaxQueryLength       model.Duration `toml:"max-query-length" yaml:"max_query_length"`
+	MaxConcurrentQueries int            `toml:"max-concurrent-queries" yaml:"max_concurrent_queries"`
+	MaxPointsPerQuery    int            `toml:"max-points-per-query" yaml:"max_points_per_query"`
 }
 
+const DefaultMaxNativeHistogramBuckets = 160
+
 func NewLimits() Limits {
 	l := Limits{
 		PromLimitEnabled:          false,
@@ -51,12 +69,29 @@ func NewLimits() Limits {
 		CreationGracePeriod:       model.Duration(10 * time.Minute),    // Duration which table will be created/deleted before/after it's needed; we won't accept sample from before this time.
 		EnforceMetadataMetricName: true,                                // Enforce every sample has a metric name.
 		EnforceMetricName:         true,                                // Enforce every metadata has a metric name.
+		ActiveSeriesIdleTimeout:   model.Duration(10 * time.Minute),    // Duration after which a series without samples is no longer active.
+		MaxNativeHistogramBuckets: DefaultMaxNativeHistogramBuckets,    // Maximum number of buckets of a native histogram sample.
 	}
 	return l
 }
 
 // Validate the limits config and returns an error if the validation doesn't pass
 func (l *Limits) Validate() error {
+	if l.IngestionRate < 0 || l.IngestionBurstSize < 0 {
+		return errors.New("ingestion-rate and ingestion-burst-size can not be negative")
+	}
+	if l.IngestionBytesRate < 0 || l.IngestionBytesBurstSize < 0 {
+		return errors.New("ingestion-bytes-rate and ingestion-bytes-burst-size can not be negative")
+	}
+	if l.MaxActiveSeries < 0 || l.MaxConcurrentQueries < 0 || l.MaxPointsPerQuery < 0 {
+		return errors.New("max-active-series, max-concurrent-queries and max-points-per-query can not be negative")
+	}
+	if l.MaxNativeHistogramBuckets < 0 {
+		return errors.New("max-native-histogram-buckets can not be negative")
+	}
+	if l.MaxActiveSeries > 0 && l.ActiveSeriesIdleTimeout <= 0 {
+		return errors.New("active-series-idle-timeout must be positive when max-active-series is set")
+	}
 	return nil
 }
 
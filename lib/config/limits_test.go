@@ -79,3 +79,24 @@ func TestLimitsAlwaysUsesPromDuration(t *testing.T) {
 
 	require.Empty(t, badDurationType, "some Limits fields are using stdlib time.Duration instead of model.Duration")
 }
+
+func TestLimitsValidate(t *testing.T) {
+	l := NewLimits()
+	require.NoError(t, l.Validate())
+
+	l.IngestionRate = -1
+	require.EqualError(t, l.Validate(), "ingestion-rate and ingestion-burst-size can not be negative")
+
+	l = NewLimits()
+	l.IngestionBytesBurstSize = -1
+	require.EqualError(t, l.Validate(), "ingestion-bytes-rate and ingestion-bytes-burst-size can not be negative")
+
+	l = NewLimits()
+	l.MaxConcurrentQueries = -1
+	require.Error(t, l.Validate())
+
+	l = NewLimits()
+	l.MaxActiveSeries = 100
+	l.ActiveSeriesIdleTimeout = 0
+	require.EqualError(t, l.Validate(), "active-series-idle-timeout must be positive when max-active-series is set")
+}
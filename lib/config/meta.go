@@ -191,6 +191,9 @@ type Meta struct {
 	MetaEventHandleEn bool `toml:"meta-event-handle-enable"`
 	BindPeers         []string
 
+	// RemoteBackup is copied from the data section, the meta backup is written to it too
+	RemoteBackup *RemoteBackup `toml:"-"`
+
 	Heartbeat *HeartbeatConfig `toml:"heartbeat"`
 }
 
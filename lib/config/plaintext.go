@@ -0,0 +1,157 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultGraphiteBindAddress = ":2003"
+	DefaultGraphiteDatabase    = "graphite"
+	DefaultGraphiteProtocol    = "tcp"
+	DefaultGraphiteSeparator   = "."
+
+	DefaultOpenTSDBBindAddress = ":4242"
+	DefaultOpenTSDBDatabase    = "opentsdb"
+
+	DefaultPlaintextBatchSize    = 5000
+	DefaultPlaintextBatchTimeout = time.Second
+)
+
+// GraphiteConfig represents the configuration of the Graphite plaintext listener.
+type GraphiteConfig struct {
+	Enabled         bool   `toml:"enabled"`
+	BindAddress     string `toml:"bind-address"`
+	Protocol        string `toml:"protocol"`
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+
+	// Separator joins the path parts which map to the same measurement, tag or field.
+	Separator string `toml:"separator"`
+	// Templates map the parts of a Graphite path to measurement, tags and field,
+	// formatted as "[filter] template [tag1=value1,tag2=value2]".
+	Templates []string `toml:"templates"`
+	// Tags are added to all the points, formatted as "tag=value".
+	Tags []string `toml:"tags"`
+
+	BatchSize    int           `toml:"batch-size"`
+	BatchTimeout toml.Duration `toml:"batch-timeout"`
+}
+
+func NewGraphiteConfig() GraphiteConfig {
+	return GraphiteConfig{
+		Enabled:      false,
+		BindAddress:  DefaultGraphiteBindAddress,
+		Protocol:     DefaultGraphiteProtocol,
+		Database:     DefaultGraphiteDatabase,
+		Separator:    DefaultGraphiteSeparator,
+		BatchSize:    DefaultPlaintextBatchSize,
+		BatchTimeout: toml.Duration(DefaultPlaintextBatchTimeout),
+	}
+}
+
+func (c GraphiteConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.BindAddress == "" {
+		return errors.New("graphite bind-address must be specified")
+	}
+	if c.Protocol != "tcp" && c.Protocol != "udp" {
+		return fmt.Errorf("graphite protocol must be tcp or udp, got %q", c.Protocol)
+	}
+	if c.Database == "" {
+		return errors.New("graphite database must be specified")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("graphite batch-size must be greater than zero")
+	}
+	for _, tag := range c.Tags {
+		if strings.Count(tag, "=") != 1 {
+			return fmt.Errorf("graphite tag %q must be formatted as tag=value", tag)
+		}
+	}
+	return nil
+}
+
+func (c GraphiteConfig) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"graphite.enabled":          c.Enabled,
+		"graphite.bind-address":     c.BindAddress,
+		"graphite.protocol":         c.Protocol,
+		"graphite.database":         c.Database,
+		"graphite.retention-policy": c.RetentionPolicy,
+		"graphite.separator":        c.Separator,
+		"graphite.templates":        c.Templates,
+		"graphite.tags":             c.Tags,
+		"graphite.batch-size":       c.BatchSize,
+		"graphite.batch-timeout":    c.BatchTimeout,
+	}
+}
+
+// OpenTSDBConfig represents the configuration of the OpenTSDB listener, which
+// accepts both the telnet put command and the HTTP /api/put on the same address.
+type OpenTSDBConfig struct {
+	Enabled         bool   `toml:"enabled"`
+	BindAddress     string `toml:"bind-address"`
+	Database        string `toml:"database"`
+	RetentionPolicy string `toml:"retention-policy"`
+
+	BatchSize    int           `toml:"batch-size"`
+	BatchTimeout toml.Duration `toml:"batch-timeout"`
+}
+
+func NewOpenTSDBConfig() OpenTSDBConfig {
+	return OpenTSDBConfig{
+		Enabled:      false,
+		BindAddress:  DefaultOpenTSDBBindAddress,
+		Database:     DefaultOpenTSDBDatabase,
+		BatchSize:    DefaultPlaintextBatchSize,
+		BatchTimeout: toml.Duration(DefaultPlaintextBatchTimeout),
+	}
+}
+
+func (c OpenTSDBConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.BindAddress == "" {
+		return errors.New("opentsdb bind-address must be specified")
+	}
+	if c.Database == "" {
+		return errors.New("opentsdb database must be specified")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("opentsdb batch-size must be greater than zero")
+	}
+	return nil
+}
+
+func (c OpenTSDBConfig) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"opentsdb.enabled":          c.Enabled,
+		"opentsdb.bind-address":     c.BindAddress,
+		"opentsdb.database":         c.Database,
+		"opentsdb.retention-policy": c.RetentionPolicy,
+		"opentsdb.batch-size":       c.BatchSize,
+		"opentsdb.batch-timeout":    c.BatchTimeout,
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphiteConfig_Validate(t *testing.T) {
+	c := NewGraphiteConfig()
+	assert.NoError(t, c.Validate())
+
+	c.Enabled = true
+	assert.NoError(t, c.Validate())
+
+	c.Protocol = "http"
+	assert.Error(t, c.Validate())
+	c.Protocol = "udp"
+
+	c.Tags = []string{"region"}
+	assert.Error(t, c.Validate())
+	c.Tags = []string{"region=sh"}
+	assert.NoError(t, c.Validate())
+
+	c.BatchSize = 0
+	assert.Error(t, c.Validate())
+}
+
+func TestOpenTSDBConfig_Validate(t *testing.T) {
+	c := NewOpenTSDBConfig()
+	c.Enabled = true
+	assert.NoError(t, c.Validate())
+
+	c.Database = ""
+	assert.Error(t, c.Validate())
+}
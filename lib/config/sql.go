@@ -94,6 +94,10 @@ type TSSql struct {
 	Limits        Limits            `toml:"limits"`
 	RuntimeConfig RuntimeConfig     `toml:"runtime-config"`
 	RecordWrite   RecordWriteConfig `toml:"record-write"`
+	Graphite      GraphiteConfig    `toml:"graphite"`
+	OpenTSDB      OpenTSDBConfig    `toml:"opentsdb"`
+	Tracing       TracingConfig     `toml:"tracing"`
+	Alert         AlertConfig       `toml:"alert"`
 }
 
 // NewTSSql returns an instance of Config with reasonable defaults.
@@ -115,6 +119,10 @@ func NewTSSql(enableGossip bool) *TSSql {
 	c.Limits = NewLimits()
 	c.RuntimeConfig = NewRuntimeConfig()
 	c.RecordWrite = NewRecordWriteConfig()
+	c.Graphite = NewGraphiteConfig()
+	c.OpenTSDB = NewOpenTSDBConfig()
+	c.Tracing = NewTracingConfig()
+	c.Alert = NewAlertConfig()
 	return c
 }
 
@@ -177,6 +185,11 @@ func (c *TSSql) Validate() error {
 		c.ContinuousQuery,
 		c.RuntimeConfig,
 		c.RecordWrite,
+		c.Graphite,
+		c.OpenTSDB,
+		c.Tracing,
+		c.Alert,
+		&c.Limits,
 	}
 
 	for _, item := range items {
@@ -231,6 +244,18 @@ func (c *TSSql) ShowConfigs() map[string]interface{} {
 	for k, v := range c.RecordWrite.ShowConfigs() {
 		sqlConfig[k] = v
 	}
+	for k, v := range c.Graphite.ShowConfigs() {
+		sqlConfig[k] = v
+	}
+	for k, v := range c.OpenTSDB.ShowConfigs() {
+		sqlConfig[k] = v
+	}
+	for k, v := range c.Tracing.ShowConfigs() {
+		sqlConfig[k] = v
+	}
+	for k, v := range c.Alert.ShowConfigs() {
+		sqlConfig[k] = v
+	}
 	return sqlConfig
 }
 
@@ -262,6 +287,9 @@ type Coordinator struct {
 	QueryTimeCompareEnabled bool `toml:"query-time-compare-enabled"`
 	ForceBroadcastQuery     bool `toml:"force-broadcast-query"`
 
+	// SHOW TAG VALUES CARDINALITY without EXACT is estimated by HyperLogLog sketches
+	TagValuesCardinalityEstimate bool `toml:"tag-values-cardinality-estimate"`
+
 	HardWrite bool `toml:"hard-write"`
 }
 
@@ -302,23 +330,24 @@ func (c Coordinator) Validate() error {
 
 func (c *Coordinator) ShowConfigs() map[string]interface{} {
 	return map[string]interface{}{
-		"coordinator.write-timeout":               c.WriteTimeout,
-		"coordinator.max-concurrent-queries":      c.MaxConcurrentQueries,
-		"coordinator.log-queries-after":           c.LogQueriesAfter,
-		"coordinator.shard-writer-timeout":        c.ShardWriterTimeout,
-		"coordinator.shard-mapper-timeout":        c.ShardMapperTimeout,
-		"coordinator.max-query-mem":               c.MaxQueryMem,
-		"coordinator.meta-executor-write-timeout": c.MetaExecutorWriteTimeout,
-		"coordinator.query-timeout":               c.QueryTimeout,
-		"coordinator.query-limit-interval-time":   c.QueryLimitIntervalTime,
-		"coordinator.query-limit-level":           c.QueryLimitLevel,
-		"coordinator.query-limit-flag":            c.QueryLimitFlag,
-		"coordinator.query-time-compare-enabled":  c.QueryTimeCompareEnabled,
-		"coordinator.force-broadcast-query":       c.ForceBroadcastQuery,
-		"coordinator.shard-tier":                  c.ShardTier,
-		"coordinator.rp-limit":                    c.RetentionPolicyLimit,
-		"coordinator.time-range-limit":            c.TimeRangeLimit,
-		"coordinator.tag-limit":                   c.TagLimit,
-		"coordinator.hard-write":                  c.HardWrite,
+		"coordinator.write-timeout":                   c.WriteTimeout,
+		"coordinator.max-concurrent-queries":          c.MaxConcurrentQueries,
+		"coordinator.log-queries-after":               c.LogQueriesAfter,
+		"coordinator.shard-writer-timeout":            c.ShardWriterTimeout,
+		"coordinator.shard-mapper-timeout":            c.ShardMapperTimeout,
+		"coordinator.max-query-mem":                   c.MaxQueryMem,
+		"coordinator.meta-executor-write-timeout":     c.MetaExecutorWriteTimeout,
+		"coordinator.query-timeout":                   c.QueryTimeout,
+		"coordinator.query-limit-interval-time":       c.QueryLimitIntervalTime,
+		"coordinator.query-limit-level":               c.QueryLimitLevel,
+		"coordinator.query-limit-flag":                c.QueryLimitFlag,
+		"coordinator.query-time-compare-enabled":      c.QueryTimeCompareEnabled,
+		"coordinator.force-broadcast-query":           c.ForceBroadcastQuery,
+		"coordinator.tag-values-cardinality-estimate": c.TagValuesCardinalityEstimate,
+		"coordinator.shard-tier":                      c.ShardTier,
+		"coordinator.rp-limit":                        c.RetentionPolicyLimit,
+		"coordinator.time-range-limit":                c.TimeRangeLimit,
+		"coordinator.tag-limit":                       c.TagLimit,
+		"coordinator.hard-write":                      c.HardWrite,
 	}
 }
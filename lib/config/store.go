@@ -106,6 +106,8 @@ type TSStore struct {
 
 	// logkeeper config
 	LogStore *LogStoreConfig `toml:"logstore"`
+
+	Tracing TracingConfig `toml:"tracing"`
 }
 
 // NewTSStore returns an instance of Config with reasonable defaults.
@@ -134,6 +136,7 @@ func NewTSStore(enableGossip bool) *TSStore {
 	c.Meta = NewMeta()
 	c.ClvConfig = NewClvConfig()
 	c.LogStore = NewLogStoreConfig()
+	c.Tracing = NewTracingConfig()
 	return c
 }
 
@@ -156,6 +159,7 @@ func (c *TSStore) Validate() error {
 		c.Analysis,
 		c.Sherlock,
 		c.IODetector,
+		c.Tracing,
 	}
 
 	for _, item := range items {
@@ -232,6 +236,9 @@ type Store struct {
 	// configs for wal
 	Wal Wal `toml:"wal"`
 
+	// configs for remote backup
+	RemoteBackup RemoteBackup `toml:"remote-backup"`
+
 	// configs for raftStorage
 	RaftStorage RaftStorage `toml:"raft-storage"`
 
@@ -241,9 +248,10 @@ type Store struct {
 	EnableMmapRead bool `toml:"enable-mmap-read"`
 	Readonly       bool `toml:"readonly"`
 
-	WriteConcurrentLimit int `toml:"write-concurrent-limit"`
-	OpenShardLimit       int `toml:"open-shard-limit"`
-	MaxSeriesPerDatabase int `toml:"max-series-per-database"`
+	WriteConcurrentLimit    int `toml:"write-concurrent-limit"`
+	OpenShardLimit          int `toml:"open-shard-limit"`
+	MaxSeriesPerDatabase    int `toml:"max-series-per-database"`
+	MaxSeriesPerMeasurement int `toml:"max-series-per-measurement"`
 
 	DownSampleWriteDrop          bool `toml:"downsample-write-drop"`
 	ShardMoveLayoutSwitchEnabled bool `toml:"shard-move-layout-switch"`
@@ -321,6 +329,7 @@ func NewStore() Store {
 		Compact:                      NewCompactConfig(),
 		MemTable:                     NewMemTableConfig(),
 		Wal:                          NewWalConfig(),
+		RemoteBackup:                 NewRemoteBackup(),
 		ReadCache:                    NewReadCacheConfig(),
 		RaftStorage:                  NewRaftStorageConfig(),
 		EnableMmapRead:               false,
@@ -420,13 +429,14 @@ func (c Store) Validate() error {
 		{"data max-full-compactions", int64(c.Compact.MaxFullCompactions), true},
 		{"data write-cold-duration", int64(c.MemTable.WriteColdDuration), false},
 		{"data max-write-hang-time", int64(c.MemTable.MaxWriteHangTime), false},
+		{"data max-series-per-measurement", int64(c.MaxSeriesPerMeasurement), true},
 	}
 	iv := intValidator{0, math.MaxInt64}
 	if err := iv.Validate(ivItems); err != nil {
 		return err
 	}
 
-	return nil
+	return c.RemoteBackup.Validate()
 }
 
 func (c Store) ValidateEngine(engines []string) error {
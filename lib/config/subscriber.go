@@ -25,6 +25,13 @@ import (
 const (
 	DefaultHTTPTimeout = 30 * time.Second // 30 seconds
 	DefaultBufferSize  = 100              // channel size 100
+
+	DefaultSubscriberQueueMaxSize      = 1 * GB
+	DefaultSubscriberQueueMaxAge       = 24 * time.Hour
+	DefaultSubscriberQueueSegmentSize  = 16 * MB
+	DefaultSubscriberRetryInterval     = time.Second
+	DefaultSubscriberMaxRetryInterval  = time.Minute
+	DefaultSubscriberQueueSyncInterval = time.Second
 )
 
 type Subscriber struct {
@@ -34,6 +41,16 @@ type Subscriber struct {
 	HttpsCertificate   string        `toml:"https-certificate"`
 	WriteBufferSize    int           `toml:"write-buffer-size"`
 	WriteConcurrency   int           `toml:"write-concurrency"`
+
+	// QueueDir is the directory of the disk-backed queues of the subscriptions,
+	// write requests are buffered in memory only if it is empty, which is the default.
+	QueueDir          string        `toml:"queue-dir"`
+	QueueMaxSize      toml.Size     `toml:"queue-max-size"`
+	QueueMaxAge       toml.Duration `toml:"queue-max-age"`
+	QueueSegmentSize  toml.Size     `toml:"queue-segment-size"`
+	QueueSyncInterval toml.Duration `toml:"queue-sync-interval"`
+	RetryInterval     toml.Duration `toml:"retry-interval"`
+	MaxRetryInterval  toml.Duration `toml:"max-retry-interval"`
 }
 
 func NewSubscriber() Subscriber {
@@ -44,6 +61,13 @@ func NewSubscriber() Subscriber {
 		HttpsCertificate:   "",
 		WriteBufferSize:    DefaultBufferSize,
 		WriteConcurrency:   runtime.NumCPU() * 2,
+		QueueDir:           "",
+		QueueMaxSize:       toml.Size(DefaultSubscriberQueueMaxSize),
+		QueueMaxAge:        toml.Duration(DefaultSubscriberQueueMaxAge),
+		QueueSegmentSize:   toml.Size(DefaultSubscriberQueueSegmentSize),
+		QueueSyncInterval:  toml.Duration(DefaultSubscriberQueueSyncInterval),
+		RetryInterval:      toml.Duration(DefaultSubscriberRetryInterval),
+		MaxRetryInterval:   toml.Duration(DefaultSubscriberMaxRetryInterval),
 	}
 }
 
@@ -57,6 +81,27 @@ func (s Subscriber) Validate() error {
 	if s.WriteConcurrency <= 0 {
 		return errors.New("subscriber write-concurrency can not be zero or negative")
 	}
+	if s.QueueDir == "" {
+		return nil
+	}
+	if s.QueueSegmentSize == 0 {
+		return errors.New("subscriber queue-segment-size can not be zero")
+	}
+	if s.QueueMaxSize < s.QueueSegmentSize {
+		return errors.New("subscriber queue-max-size can not be less than queue-segment-size")
+	}
+	if s.QueueMaxAge <= 0 {
+		return errors.New("subscriber queue-max-age can not be zero or negative")
+	}
+	if s.QueueSyncInterval <= 0 {
+		return errors.New("subscriber queue-sync-interval can not be zero or negative")
+	}
+	if s.RetryInterval <= 0 {
+		return errors.New("subscriber retry-interval can not be zero or negative")
+	}
+	if s.MaxRetryInterval < s.RetryInterval {
+		return errors.New("subscriber max-retry-interval can not be less than retry-interval")
+	}
 	return nil
 }
 
@@ -68,5 +113,12 @@ func (c *Subscriber) ShowConfigs() map[string]interface{} {
 		"subscriber.https-certificate":    c.HttpsCertificate,
 		"subscriber.write-buffer-size":    c.WriteBufferSize,
 		"subscriber.write-concurrency":    c.WriteConcurrency,
+		"subscriber.queue-dir":            c.QueueDir,
+		"subscriber.queue-max-size":       c.QueueMaxSize,
+		"subscriber.queue-max-age":        c.QueueMaxAge,
+		"subscriber.queue-segment-size":   c.QueueSegmentSize,
+		"subscriber.queue-sync-interval":  c.QueueSyncInterval,
+		"subscriber.retry-interval":       c.RetryInterval,
+		"subscriber.max-retry-interval":   c.MaxRetryInterval,
 	}
 }
@@ -0,0 +1,99 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+)
+
+const (
+	DefaultTracingServiceName   = "openGemini"
+	DefaultTracingBatchSize     = 512
+	DefaultTracingQueueSize     = 4096
+	DefaultTracingFlushInterval = 5 * time.Second
+	DefaultTracingTimeout       = 10 * time.Second
+)
+
+// TracingConfig represents the configuration of exporting the query execution spans
+// to an OpenTelemetry collector through OTLP/HTTP.
+type TracingConfig struct {
+	Enabled bool `toml:"enabled"`
+	// Endpoint is the url of the OTLP/HTTP traces receiver, such as http://127.0.0.1:4318/v1/traces.
+	Endpoint    string `toml:"otlp-endpoint"`
+	ServiceName string `toml:"service-name"`
+	// SampleRatio is the ratio of the queries traced when the request carries no traceparent header,
+	// the requests with a traceparent header follow the sampled flag of the caller.
+	SampleRatio float64 `toml:"sample-ratio"`
+
+	BatchSize     int           `toml:"batch-size"`
+	QueueSize     int           `toml:"queue-size"`
+	FlushInterval toml.Duration `toml:"flush-interval"`
+	Timeout       toml.Duration `toml:"timeout"`
+}
+
+func NewTracingConfig() TracingConfig {
+	return TracingConfig{
+		Enabled:       false,
+		ServiceName:   DefaultTracingServiceName,
+		BatchSize:     DefaultTracingBatchSize,
+		QueueSize:     DefaultTracingQueueSize,
+		FlushInterval: toml.Duration(DefaultTracingFlushInterval),
+		Timeout:       toml.Duration(DefaultTracingTimeout),
+	}
+}
+
+func (c TracingConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.Endpoint == "" {
+		return errors.New("tracing otlp-endpoint must be specified")
+	}
+	u, err := url.Parse(c.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("tracing otlp-endpoint %q must be a http or https url", c.Endpoint)
+	}
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return fmt.Errorf("tracing sample-ratio must be between 0 and 1, got %v", c.SampleRatio)
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("tracing batch-size must be greater than zero")
+	}
+	if c.QueueSize < c.BatchSize {
+		return errors.New("tracing queue-size must not be less than batch-size")
+	}
+	if c.FlushInterval <= 0 {
+		return errors.New("tracing flush-interval must be greater than zero")
+	}
+	return nil
+}
+
+func (c TracingConfig) ShowConfigs() map[string]interface{} {
+	return map[string]interface{}{
+		"tracing.enabled":        c.Enabled,
+		"tracing.otlp-endpoint":  c.Endpoint,
+		"tracing.service-name":   c.ServiceName,
+		"tracing.sample-ratio":   c.SampleRatio,
+		"tracing.batch-size":     c.BatchSize,
+		"tracing.queue-size":     c.QueueSize,
+		"tracing.flush-interval": c.FlushInterval,
+		"tracing.timeout":        c.Timeout,
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracingConfig_Validate(t *testing.T) {
+	c := NewTracingConfig()
+	assert.NoError(t, c.Validate())
+
+	c.Enabled = true
+	assert.Error(t, c.Validate())
+
+	c.Endpoint = "127.0.0.1:4318"
+	assert.Error(t, c.Validate())
+	c.Endpoint = "http://127.0.0.1:4318/v1/traces"
+	assert.NoError(t, c.Validate())
+
+	c.SampleRatio = 1.5
+	assert.Error(t, c.Validate())
+	c.SampleRatio = 0.1
+
+	c.QueueSize = c.BatchSize - 1
+	assert.Error(t, c.Validate())
+	c.QueueSize = DefaultTracingQueueSize
+
+	c.FlushInterval = 0
+	assert.Error(t, c.Validate())
+}
@@ -32,6 +32,10 @@ type Wal struct {
 	WalReplayAsync     bool          `toml:"wal-replay-async"`
 	WalUsedForStream   bool          `toml:"wal-used-for-stream"`
 	WalReplayBatchSize toml.Size     `toml:"wal-replay-batch-size"`
+	// WalArchiveEnabled keeps the flushed wal files until they are backed up,
+	// so that ts-recover can restore the data to a point in time. The wal files
+	// are marked with the write time every second, which is the recovery precision
+	WalArchiveEnabled bool `toml:"wal-archive-enabled"`
 }
 
 func NewWalConfig() Wal {
@@ -42,5 +46,6 @@ func NewWalConfig() Wal {
 		WalReplayAsync:     false,
 		WalUsedForStream:   false,
 		WalReplayBatchSize: DefaultWalReplayBatchSize,
+		WalArchiveEnabled:  false,
 	}
 }
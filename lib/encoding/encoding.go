@@ -33,6 +33,9 @@ const (
 	// BlockInteger designates a block encodes int64 values.
 	BlockInteger = byte(influx.Field_Type_Int)
 
+	// BlockUnsigned designates a block encodes uint64 values.
+	BlockUnsigned = byte(influx.Field_Type_UInt)
+
 	// BlockBoolean designates a block encodes boolean values.
 	BlockBoolean = byte(influx.Field_Type_Boolean)
 
@@ -42,26 +45,29 @@ const (
 	// BlockTag designates a block encodes tag values.
 	BlockTag = byte(influx.Field_Type_Tag)
 
-	BlockOneBegin   = 16
-	BlockFloat64One = 17
-	BlockIntegerOne = 18
-	BlockBooleanOne = 19
-	BlockStringOne  = 20
-	BlockOneEnd     = 21
-
-	BlockFullBegin   = 30
-	BlockFloat64Full = 31
-	BlockIntegerFull = 32
-	BlockBooleanFull = 33
-	BlockStringFull  = 34
-	BlockFullEnd     = 35
-
-	BlockEmptyBegin   = 40
-	BlockFloat64Empty = 41
-	BlockIntegerEmpty = 42
-	BlockBooleanEmpty = 43
-	BlockStringEmpty  = 44
-	BlockEmptyEnd     = 45
+	BlockOneBegin    = 16
+	BlockFloat64One  = 17
+	BlockIntegerOne  = 18
+	BlockBooleanOne  = 19
+	BlockStringOne   = 20
+	BlockUnsignedOne = 21
+	BlockOneEnd      = 22
+
+	BlockFullBegin    = 30
+	BlockFloat64Full  = 31
+	BlockIntegerFull  = 32
+	BlockBooleanFull  = 33
+	BlockStringFull   = 34
+	BlockUnsignedFull = 35
+	BlockFullEnd      = 36
+
+	BlockEmptyBegin    = 40
+	BlockFloat64Empty  = 41
+	BlockIntegerEmpty  = 42
+	BlockBooleanEmpty  = 43
+	BlockStringEmpty   = 44
+	BlockUnsignedEmpty = 45
+	BlockEmptyEnd      = 46
 )
 
 func IsBlockOne(typ uint8) bool {
@@ -82,6 +88,8 @@ func RewriteTypeToFull(typ uint8) uint8 {
 		return BlockFloat64Full
 	case BlockInteger:
 		return BlockIntegerFull
+	case BlockUnsigned:
+		return BlockUnsignedFull
 	case BlockBoolean:
 		return BlockBooleanFull
 	case BlockString:
@@ -97,6 +105,8 @@ func RewriteTypeToEmpty(typ uint8) uint8 {
 		return BlockFloat64Empty
 	case BlockInteger:
 		return BlockIntegerEmpty
+	case BlockUnsigned:
+		return BlockUnsignedEmpty
 	case BlockBoolean:
 		return BlockBooleanEmpty
 	case BlockString:
@@ -177,6 +187,7 @@ func growBuffer(buf []byte, size int) []byte {
 
 var (
 	intPool    = sync.Pool{}
+	uintPool   = sync.Pool{}
 	flotPool   = sync.Pool{}
 	boolPool   = sync.Pool{}
 	stringPool = sync.Pool{}
@@ -191,6 +202,14 @@ func GetIntCoder() *Integer {
 	return &Integer{buf: NewBytesBuffer(nil)}
 }
 
+func GetUnsignedCoder() *Unsigned {
+	v := uintPool.Get()
+	if v != nil {
+		return v.(*Unsigned)
+	}
+	return NewUnsigned()
+}
+
 func GetTimeCoder() *Time {
 	v := timePool.Get()
 	if v != nil {
@@ -229,6 +248,8 @@ func PutDataCoder(coder DataCoder) {
 	switch t := coder.(type) {
 	case *Integer:
 		intPool.Put(t)
+	case *Unsigned:
+		uintPool.Put(t)
 	case *Float:
 		flotPool.Put(t)
 	case *Boolean:
@@ -245,6 +266,7 @@ func PutDataCoder(coder DataCoder) {
 type CoderContext struct {
 	timeCoder   *Time
 	intCoder    *Integer
+	uintCoder   *Unsigned
 	floatCoder  *Float
 	stringCoder *String
 	boolCoder   *Boolean
@@ -261,6 +283,11 @@ func (ctx *CoderContext) Release() {
 		ctx.intCoder = nil
 	}
 
+	if ctx.uintCoder != nil {
+		PutDataCoder(ctx.uintCoder)
+		ctx.uintCoder = nil
+	}
+
 	if ctx.floatCoder != nil {
 		PutDataCoder(ctx.floatCoder)
 		ctx.floatCoder = nil
@@ -298,6 +325,14 @@ func (ctx *CoderContext) SetIntCoder(intCoder *Integer) {
 	ctx.intCoder = intCoder
 }
 
+func (ctx *CoderContext) GetUnsignedCoder() *Unsigned {
+	return ctx.uintCoder
+}
+
+func (ctx *CoderContext) SetUnsignedCoder(uintCoder *Unsigned) {
+	ctx.uintCoder = uintCoder
+}
+
 func (ctx *CoderContext) GetFloatCoder() *Float {
 	return ctx.floatCoder
 }
@@ -621,3 +656,34 @@ func DecodeUnsignedBlock(in []byte, out *[]byte, ctx *CoderContext) ([]uint64, e
 
 	return util.Bytes2Uint64Slice(values), nil
 }
+
+// EncodeUnsignedFieldBlock encodes the values of an unsigned field with the Unsigned coder.
+// EncodeUnsignedBlock stays on the Integer coder, the series ids of the sequencer are written by it.
+func EncodeUnsignedFieldBlock(in, out []byte, ctx *CoderContext) ([]byte, error) {
+	if len(in) == 0 {
+		return out, nil
+	}
+
+	if ctx.uintCoder == nil {
+		ctx.uintCoder = GetUnsignedCoder()
+	}
+	return ctx.uintCoder.Encoding(in, out)
+}
+
+func DecodeUnsignedFieldBlock(in []byte, out *[]byte, ctx *CoderContext) ([]uint64, error) {
+	if len(in) == 0 {
+		return util.Bytes2Uint64Slice(*out), nil
+	}
+
+	if ctx.uintCoder == nil {
+		ctx.uintCoder = GetUnsignedCoder()
+	}
+
+	values, err := ctx.uintCoder.Decoding(in, *out)
+	if err != nil {
+		return nil, err
+	}
+	*out = values
+
+	return util.Bytes2Uint64Slice(values), nil
+}
@@ -17,6 +17,8 @@ package encoding
 import (
 	safeRand "crypto/rand"
 	"fmt"
+	"math"
+	"math/rand"
 	"reflect"
 	"sort"
 	"testing"
@@ -378,6 +380,81 @@ func TestEncoding_IntBlock_Negatives(t *testing.T) {
 	intTest(util.Int64Slice2byte(arr))
 }
 
+func TestEncoding_UnsignedFieldBlock(t *testing.T) {
+	const big = uint64(1) << 63
+
+	counter := make([]uint64, 1000)
+	random := make([]uint64, 1000)
+	for i := range counter {
+		counter[i] = big + uint64(i*i)
+		random[i] = rand.Uint64()
+	}
+	reset := append(append([]uint64{}, counter[:500]...), counter[:500]...)
+
+	inValues := map[string][]uint64{
+		"short":      {math.MaxUint64, 1},
+		"constDelta": {big, big + 10, big + 20, big + 30, big + 40},
+		"constMax":   {math.MaxUint64, math.MaxUint64, math.MaxUint64},
+		"counter":    counter,
+		"reset":      reset,
+		"decreasing": {math.MaxUint64, math.MaxUint64 - 1, 3, 2, 1, 0},
+		"random":     random,
+	}
+	prefix := util.Uint64Slice2byte([]uint64{11, 22, 33})
+
+	for name, values := range inValues {
+		for _, pre := range [][]byte{nil, prefix} {
+			out, err := EncodeUnsignedFieldBlock(util.Uint64Slice2byte(values), append([]byte{}, pre...), decs)
+			require.NoError(t, err, name)
+			require.Equal(t, util.Bytes2Uint64Slice(pre), util.Bytes2Uint64Slice(out[:len(pre)]), name)
+
+			decOut := append([]byte{}, pre...)
+			got, err := DecodeUnsignedFieldBlock(out[len(pre):], &decOut, decs)
+			require.NoError(t, err, name)
+			require.Equal(t, values, got[len(pre)/8:], name)
+			require.Equal(t, util.Bytes2Uint64Slice(pre), util.Bytes2Uint64Slice(decOut[:len(pre)]), name)
+		}
+	}
+
+	out, err := EncodeUnsignedFieldBlock(util.Uint64Slice2byte(counter), nil, decs)
+	require.NoError(t, err)
+	require.Equal(t, uintCompressedSimple8b, int(out[0]>>4))
+	require.Less(t, len(out), len(counter)*8/2)
+}
+
+func TestEncoding_UnsignedFieldBlock_IntegerCompatible(t *testing.T) {
+	// blocks written by the integer coder are still readable
+	values := []uint64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89}
+	out, err := EncodeIntegerBlock(util.Uint64Slice2byte(values), nil, decs)
+	require.NoError(t, err)
+
+	var decOut []byte
+	got, err := DecodeUnsignedFieldBlock(out, &decOut, decs)
+	require.NoError(t, err)
+	require.Equal(t, values, got)
+
+	_, err = DecodeUnsignedFieldBlock([]byte{uintFormat | 7<<4, 0, 0, 0, 0}, &decOut, decs)
+	require.EqualError(t, err, "unsigned: invalid compressed data, 7")
+}
+
+func TestEncoding_UnsignedBlock(t *testing.T) {
+	// the series ids of the sequencer are kept in the integer format
+	values := []uint64{1, 2, 3, 5, 8, 13, 21, 34, 55, 89}
+	out, err := EncodeUnsignedBlock(util.Uint64Slice2byte(values), nil, decs)
+	require.NoError(t, err)
+	require.Zero(t, out[0]&uintFormat)
+
+	var decOut []byte
+	got, err := DecodeIntegerBlock(out, &decOut, decs)
+	require.NoError(t, err)
+	require.Equal(t, values, util.Bytes2Uint64Slice(util.Int64Slice2byte(got)))
+
+	decOut = decOut[:0]
+	unsigned, err := DecodeUnsignedBlock(out, &decOut, decs)
+	require.NoError(t, err)
+	require.Equal(t, values, unsigned)
+}
+
 func TestEncoding_BooleanBlock_Basic(t *testing.T) {
 	boolTest := func(preData []byte, valueCount int) {
 		values := make([]bool, valueCount)
@@ -0,0 +1,338 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package encoding
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/openGemini/openGemini/lib/numberenc"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/encoding/simple8b"
+)
+
+const (
+	uintCompressedConstDelta = 1
+	uintCompressedSimple8b   = 2
+	uintCompressZSTD         = 3
+	uintUncompressed         = 4
+)
+
+// flags kept in the low four bits of the header byte
+const (
+	// uintZigZagDeltas is set when the values are not monotonic, the deltas
+	// are then zigzag encoded so that a counter reset stays small.
+	uintZigZagDeltas = 1 << 0
+	// uintFormat marks blocks written by Unsigned. Blocks without it were
+	// written by the Integer coder and are decoded by it.
+	uintFormat = 1 << 3
+)
+
+// Unsigned encodes uint64 values. Most unsigned fields are monotonic counters,
+// so the deltas are kept as plain unsigned numbers and only fall back to
+// zigzag when a value decreases. Unlike Integer, no value is ever reinterpreted
+// as int64, counters above 2^63 keep their order and compress like any other.
+type Unsigned struct {
+	encodingType int
+	flags        byte
+	isConstDelta bool
+	isSimple8b   bool
+
+	buf     *BytesBuffer
+	zstdEnc *zstd.Encoder
+	zstdDec *zstd.Decoder
+	values  [240]uint64
+	deltas  []uint64
+
+	outPos int
+	out    []byte
+
+	intCoder *Integer
+}
+
+func NewUnsigned() *Unsigned {
+	return &Unsigned{buf: NewBytesBuffer(nil)}
+}
+
+func (enc *Unsigned) SetEncodingType(ty int) {
+	enc.encodingType = ty
+}
+
+func (enc *Unsigned) init(arr []uint64) {
+	enc.deltas = enc.deltas[:0]
+	enc.flags = uintFormat
+	enc.isConstDelta = false
+	enc.isSimple8b = false
+	if len(arr) < 3 {
+		return
+	}
+
+	monotonic := true
+	enc.deltas = append(enc.deltas, arr[0])
+	for i := 1; i < len(arr); i++ {
+		monotonic = monotonic && arr[i] >= arr[i-1]
+		enc.deltas = append(enc.deltas, arr[i]-arr[i-1])
+	}
+	if !monotonic {
+		enc.flags |= uintZigZagDeltas
+		for i := 1; i < len(enc.deltas); i++ {
+			enc.deltas[i] = ZigZagEncode(int64(enc.deltas[i]))
+		}
+	}
+
+	enc.isConstDelta = true
+	enc.isSimple8b = true
+	for i := 1; i < len(enc.deltas); i++ {
+		enc.isConstDelta = enc.isConstDelta && enc.deltas[i] == enc.deltas[1]
+		enc.isSimple8b = enc.isSimple8b && enc.deltas[i] <= simple8b.MaxValue
+	}
+}
+
+func (enc *Unsigned) header() byte {
+	return byte(enc.encodingType)<<4 | enc.flags
+}
+
+func (enc *Unsigned) encodingConstDelta(out []byte) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	out = append(out, enc.header())
+	// first value
+	out = numberenc.MarshalUint64Append(out, enc.deltas[0])
+	// the delta
+	n := binary.PutUvarint(buf[:], enc.deltas[1])
+	out = append(out, buf[:n]...)
+	// number of times the delta is repeated
+	n = binary.PutUvarint(buf[:], uint64(len(enc.deltas)-1))
+	out = append(out, buf[:n]...)
+	return out
+}
+
+func (enc *Unsigned) encodingSimple8b(out []byte) ([]byte, error) {
+	srcCount := len(enc.deltas)
+	encData, err := simple8b.EncodeAll(enc.deltas[1:])
+	if err != nil {
+		return nil, err
+	}
+
+	out = append(out, enc.header())
+	out = numberenc.MarshalUint32Append(out, uint32(len(encData))) // enc count
+	out = numberenc.MarshalUint32Append(out, uint32(srcCount))     // src count
+	out = numberenc.MarshalUint64Append(out, enc.deltas[0])
+	out = numberenc.MarshalUint64SliceAppend(out, encData)
+	return out, nil
+}
+
+func (enc *Unsigned) encodingZSTD(in, out []byte) ([]byte, error) {
+	pos := len(out)
+	out = growBuffer(out, ZSTDCompressBound(len(in))+9)
+	out = append(out, enc.header())
+	out = numberenc.MarshalUint32Append(out, uint32(len(in))) // source len
+	out = numberenc.MarshalUint32Append(out, 0)               // compressed data len
+	encPos := len(out)
+
+	if enc.zstdEnc == nil {
+		var err error
+		enc.zstdEnc, err = zstd.NewWriter(nil,
+			zstd.WithEncoderCRC(false),
+			zstd.WithEncoderLevel(zstd.SpeedFastest))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	encData := enc.zstdEnc.EncodeAll(in, out[encPos:])
+	compLen := len(encData) + encPos
+	if compressionRation(compLen-pos, len(in)) > minCompReta {
+		return enc.uncompressedData(in, out[:pos]), nil
+	}
+
+	numberenc.MarshalUint32Copy(out[encPos-4:encPos], uint32(len(encData)))
+	return out[:encPos+len(encData)], nil
+}
+
+func (enc *Unsigned) uncompressedData(in []byte, out []byte) []byte {
+	enc.encodingType = uintUncompressed
+	out = append(out, enc.header())
+	out = numberenc.MarshalUint32Append(out, uint32(len(in)))
+	return numberenc.MarshalUint64SliceAppend(out, util.Bytes2Uint64Slice(in))
+}
+
+func (enc *Unsigned) Encoding(in []byte, out []byte) ([]byte, error) {
+	if len(in) == 0 {
+		return out, nil
+	}
+
+	enc.init(util.Bytes2Uint64Slice(in))
+	switch {
+	case enc.isConstDelta:
+		enc.encodingType = uintCompressedConstDelta
+		return enc.encodingConstDelta(out), nil
+	case enc.isSimple8b:
+		enc.encodingType = uintCompressedSimple8b
+		return enc.encodingSimple8b(out)
+	case len(enc.deltas) >= 2:
+		enc.encodingType = uintCompressZSTD
+		return enc.encodingZSTD(in, out)
+	default:
+		return enc.uncompressedData(in, out), nil
+	}
+}
+
+func (enc *Unsigned) grow(count int) []uint64 {
+	l := enc.outPos + count*util.Uint64SizeBytes
+	if cap(enc.out) < l {
+		enc.out = append(make([]byte, 0, l), enc.out...)
+	}
+	enc.out = enc.out[:l]
+	return util.Bytes2Uint64Slice(enc.out[enc.outPos:])
+}
+
+// restore turns the deltas in arr[1:] back into values.
+func (enc *Unsigned) restore(arr []uint64) {
+	if enc.flags&uintZigZagDeltas == 0 {
+		for i := 1; i < len(arr); i++ {
+			arr[i] += arr[i-1]
+		}
+		return
+	}
+	for i := 1; i < len(arr); i++ {
+		arr[i] = arr[i-1] + uint64(ZigZagDecode(arr[i]))
+	}
+}
+
+func (enc *Unsigned) decodingConstDelta(in []byte) ([]byte, error) {
+	if len(in) < 8 {
+		return nil, fmt.Errorf("unsigned: too small data for decode %v", len(in))
+	}
+	first := numberenc.UnmarshalUint64(in)
+	in = in[8:]
+
+	delta, n := binary.Uvarint(in)
+	if n <= 0 {
+		return nil, fmt.Errorf("unsigned: invalid const delta value")
+	}
+	in = in[n:]
+
+	count, n := binary.Uvarint(in)
+	if n <= 0 {
+		return nil, fmt.Errorf("unsigned: invalid const delta count")
+	}
+
+	arr := enc.grow(int(count) + 1)
+	arr[0] = first
+	for i := 1; i < len(arr); i++ {
+		arr[i] = delta
+	}
+	enc.restore(arr)
+	return enc.out, nil
+}
+
+func (enc *Unsigned) decodingSimple8b(in []byte) ([]byte, error) {
+	if len(in) < 16 {
+		return nil, fmt.Errorf("unsigned: too small data for decode %v", len(in))
+	}
+	encCount := int(numberenc.UnmarshalUint32(in))
+	srcCount := int(numberenc.UnmarshalUint32(in[4:]))
+	in = in[8:]
+	if len(in) < (encCount+1)*util.Uint64SizeBytes {
+		return nil, fmt.Errorf("unsigned: too small data for decode %v < %v", len(in), (encCount+1)*util.Uint64SizeBytes)
+	}
+
+	arr := enc.grow(srcCount)
+	arr[0] = numberenc.UnmarshalUint64(in)
+	idx := 1
+	for i := 1; i <= encCount; i++ {
+		n, err := simple8b.Decode(&enc.values, numberenc.UnmarshalUint64(in[i*util.Uint64SizeBytes:]))
+		if err != nil {
+			return nil, err
+		}
+		if idx+n > srcCount {
+			return nil, fmt.Errorf("unsigned: too many values for decode %v > %v", idx+n, srcCount)
+		}
+		idx += copy(arr[idx:], enc.values[:n])
+	}
+	if idx != srcCount {
+		return nil, fmt.Errorf("unsigned: decoded %v values, expected %v", idx, srcCount)
+	}
+	enc.restore(arr)
+	return enc.out, nil
+}
+
+func (enc *Unsigned) decodingZSTD(in []byte) ([]byte, error) {
+	if len(in) < 8 {
+		return nil, fmt.Errorf("unsigned: too small data for decode %v", len(in))
+	}
+	compLen := int(numberenc.UnmarshalUint32(in[4:]))
+	in = in[8:]
+	if len(in) < compLen {
+		return nil, fmt.Errorf("unsigned: invalid compressed len, %v < %v", len(in), compLen)
+	}
+
+	if enc.zstdDec == nil {
+		var err error
+		enc.zstdDec, err = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return enc.zstdDec.DecodeAll(in[:compLen], enc.out)
+}
+
+func (enc *Unsigned) decodingUncompressed(in []byte) ([]byte, error) {
+	if len(in) < 4 {
+		return nil, fmt.Errorf("unsigned: too small data for decode %v", len(in))
+	}
+	inLen := int(numberenc.UnmarshalUint32(in))
+	in = in[4:]
+	if len(in) < inLen {
+		return nil, fmt.Errorf("unsigned: invalid uncompressed data len, %v < %v", len(in), inLen)
+	}
+
+	arr := enc.grow(inLen / util.Uint64SizeBytes)
+	for i := range arr {
+		arr[i] = numberenc.UnmarshalUint64(in[i*util.Uint64SizeBytes:])
+	}
+	return enc.out, nil
+}
+
+func (enc *Unsigned) Decoding(in []byte, out []byte) ([]byte, error) {
+	if len(in) < 5 {
+		return nil, fmt.Errorf("unsigned: invalid compressed len, %v", len(in))
+	}
+	if in[0]&uintFormat == 0 {
+		if enc.intCoder == nil {
+			enc.intCoder = GetIntCoder()
+		}
+		return enc.intCoder.Decoding(in, out)
+	}
+
+	enc.encodingType, enc.flags, in = int(in[0]>>4), in[0]&0x0f, in[1:]
+	enc.outPos = len(out)
+	enc.out = out
+	switch enc.encodingType {
+	case uintCompressedConstDelta:
+		return enc.decodingConstDelta(in)
+	case uintCompressedSimple8b:
+		return enc.decodingSimple8b(in)
+	case uintCompressZSTD:
+		return enc.decodingZSTD(in)
+	case uintUncompressed:
+		return enc.decodingUncompressed(in)
+	default:
+		return nil, fmt.Errorf("unsigned: invalid compressed data, %v", enc.encodingType)
+	}
+}
+
+var _ DataCoder = (*Unsigned)(nil)
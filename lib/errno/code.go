@@ -109,6 +109,7 @@ const (
 	ChunkReaderCursor            = 1127
 	ApplyFuncErr                 = 1128
 	QueryAborted                 = 1129
+	SelectPointsLimitExceeded    = 1130
 )
 
 // promql2influxql
@@ -235,6 +236,7 @@ const (
 	ErrInputTimeExceedTimeRange    = 3018
 	FailedPutNodeMaxIterNum        = 3019
 	ErrSameTagSet                  = 3020
+	UnsupportedConditionInJoin     = 3021
 )
 
 // meta
@@ -353,6 +355,7 @@ const (
 	UsedProposeId                  = 5039
 	WriteToRaftTimeoutAfterPropose = 5040
 	WriteDstStreamMstNotAllowed    = 5041
+	MeasurementSeriesLimited       = 5042
 )
 
 // write interface
@@ -380,6 +383,8 @@ const (
 	ErrReservedFieldDuplication     = 5521
 	ErrParseTimestamp               = 5522
 	ErrLogTagsDecode                = 5523
+	ErrCSVHeader                    = 5524
+	ErrInvalidPipeline              = 5525
 )
 
 // index
@@ -80,6 +80,7 @@ var messageMap = map[Errno]*Message{
 	WriteErrorArray:                newWarnMessage("error tag array", ModuleWrite),
 	TooManyTagKeys:                 newWarnMessage("too many tag keys", ModuleWrite),
 	SeriesLimited:                  newWarnMessage("too many series in database %s. upper limit: %d; current: %d", ModuleWrite),
+	MeasurementSeriesLimited:       newWarnMessage("too many series in measurement %s. upper limit: %d; current: %d", ModuleWrite),
 	RecordWriterFatalErr:           newFatalMessage("record writer raise fatal error", ModuleWrite),
 	ArrowRecordTimeFieldErr:        newFatalMessage("the time field of arrow record should the last column", ModuleWrite),
 	ArrowFlightGetRoleErr:          newFatalMessage("arrow flight only support the ts-server or ts-data", ModuleWrite),
@@ -126,6 +127,8 @@ var messageMap = map[Errno]*Message{
 	ErrTagFieldDuplication:          newWarnMessage("[%s] tag field duplication", ModuleWriteInterface),
 	ErrReservedFieldDuplication:     newWarnMessage("[%s] and reserved field duplication", ModuleWriteInterface),
 	ErrParseTimestamp:               newWarnMessage("the timestamp format is incorrect", ModuleWriteInterface),
+	ErrCSVHeader:                    newWarnMessage("csv header error: %v", ModuleWriteInterface),
+	ErrInvalidPipeline:              newWarnMessage("invalid pipeline: %v", ModuleWriteInterface),
 
 	// network module error codes
 	NoConnectionAvailable: newFatalMessage("no connections available, node: %v, %v", ModuleNetwork),
@@ -181,6 +184,7 @@ var messageMap = map[Errno]*Message{
 	ErrQueryNotFound:             newWarnMessage("no such query id: %d", ModuleQueryEngine),
 	ErrQueryKilled:               newWarnMessage("query(%d) killed", ModuleQueryEngine),
 	ErrSameTagSet:                newFatalMessage("vector cannot contain metrics with the same labelset", ModuleQueryEngine),
+	UnsupportedConditionInJoin:   newWarnMessage("unsupported condition in %s", ModuleQueryEngine),
 
 	// store engine error codes
 	CreateIndexFailPointRowType:     newFatalMessage("create index failed due to rows are not belong to type PointRow", ModuleIndex),
@@ -238,6 +242,7 @@ var messageMap = map[Errno]*Message{
 	ShardBucketLacks:               newWarnMessage("get shard resources out of time: bucket lacks of resources", ModuleQueryEngine),
 	SeriesBucketLacks:              newWarnMessage("get series resources out of time: bucket lacks of resources", ModuleQueryEngine),
 	QueryAborted:                   newWarnMessage("query has been aborted", ModuleQueryEngine),
+	SelectPointsLimitExceeded:      newWarnMessage("max-select-point limit exceeed: (%d/%d)", ModuleQueryEngine),
 	SortTransformRunningErr:        newWarnMessage("SortTransform run error", ModuleQueryEngine),
 	HashMergeTransformRunningErr:   newWarnMessage("HashMergeTransform run error", ModuleQueryEngine),
 	HashAggTransformRunningErr:     newWarnMessage("HashAggTransform work error", ModuleQueryEngine),
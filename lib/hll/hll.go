@@ -0,0 +1,258 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hll implements the HyperLogLog sketch used to estimate the number of
+// distinct values. Sketches of the same precision are mergeable, so partial sketches
+// built on different nodes can be combined into the sketch of the whole data set.
+package hll
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+const (
+	MinPrecision = 4
+	MaxPrecision = 16
+
+	// DefaultPrecision uses 2^14 registers, the standard error is about 0.81%.
+	DefaultPrecision = 14
+)
+
+// Sketch is a HyperLogLog sketch with 2^p one byte registers.
+type Sketch struct {
+	p         uint8
+	registers []uint8
+}
+
+// New returns an empty sketch, the precision must be in [MinPrecision, MaxPrecision].
+func New(p uint8) (*Sketch, error) {
+	if p < MinPrecision || p > MaxPrecision {
+		return nil, fmt.Errorf("hll precision must be in [%d, %d], got %d", MinPrecision, MaxPrecision, p)
+	}
+	return &Sketch{p: p, registers: make([]uint8, 1<<p)}, nil
+}
+
+// NewDefault returns an empty sketch with the DefaultPrecision.
+func NewDefault() *Sketch {
+	s, _ := New(DefaultPrecision)
+	return s
+}
+
+func (s *Sketch) Precision() uint8 {
+	return s.p
+}
+
+// Add adds a value to the sketch.
+func (s *Sketch) Add(b []byte) {
+	s.AddHash(xxhash.Sum64(b))
+}
+
+func (s *Sketch) AddString(v string) {
+	s.AddHash(xxhash.Sum64String(v))
+}
+
+// AddHash adds a 64 bit hash of a value to the sketch.
+func (s *Sketch) AddHash(x uint64) {
+	idx := x >> (64 - s.p)
+	// the sentinel bit limits the rank to 64-p+1
+	w := x<<s.p | 1<<(s.p-1)
+	rank := uint8(bits.LeadingZeros64(w)) + 1
+	if rank > s.registers[idx] {
+		s.registers[idx] = rank
+	}
+}
+
+// Merge merges the other sketch into s, both sketches must have the same precision.
+func (s *Sketch) Merge(o *Sketch) error {
+	if o.p != s.p {
+		return fmt.Errorf("cannot merge hll sketches of precision %d and %d", s.p, o.p)
+	}
+	for i, r := range o.registers {
+		if r > s.registers[i] {
+			s.registers[i] = r
+		}
+	}
+	return nil
+}
+
+// Count returns the estimated number of distinct values added to the sketch.
+func (s *Sketch) Count() uint64 {
+	m := float64(len(s.registers))
+	sum := 0.0
+	zeros := 0
+	for _, r := range s.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := alpha(m) * m * m / sum
+	// small range correction by linear counting
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
+
+func alpha(m float64) float64 {
+	switch m {
+	case 16:
+		return 0.673
+	case 32:
+		return 0.697
+	case 64:
+		return 0.709
+	default:
+		return 0.7213 / (1 + 1.079/m)
+	}
+}
+
+func (s *Sketch) IsEmpty() bool {
+	for _, r := range s.registers {
+		if r != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *Sketch) Reset() {
+	for i := range s.registers {
+		s.registers[i] = 0
+	}
+}
+
+func (s *Sketch) nonZero() int {
+	n := 0
+	for _, r := range s.registers {
+		if r != 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// AppendFloats encodes the registers as float64 values, it is used to carry the sketch in a FloatTuple column.
+// Sparse sketches are encoded as pairs of register index and rank, otherwise every register is appended in order,
+// the two formats are told apart by the length.
+func (s *Sketch) AppendFloats(dst []float64) []float64 {
+	m := len(s.registers)
+	if n := s.nonZero(); 2*n < m {
+		for i, r := range s.registers {
+			if r != 0 {
+				dst = append(dst, float64(i), float64(r))
+			}
+		}
+		return dst
+	}
+	for _, r := range s.registers {
+		dst = append(dst, float64(r))
+	}
+	return dst
+}
+
+// MergeFloats merges the registers encoded by AppendFloats into s.
+func (s *Sketch) MergeFloats(values []float64) error {
+	m := len(s.registers)
+	if len(values) == m {
+		for i, v := range values {
+			s.setMax(i, uint8(v))
+		}
+		return nil
+	}
+	if len(values)%2 != 0 || len(values) > m {
+		return fmt.Errorf("invalid hll registers, length %d", len(values))
+	}
+	for i := 0; i < len(values); i += 2 {
+		idx := int(values[i])
+		if idx < 0 || idx >= m {
+			return fmt.Errorf("invalid hll register index %d", idx)
+		}
+		s.setMax(idx, uint8(values[i+1]))
+	}
+	return nil
+}
+
+func (s *Sketch) setMax(idx int, rank uint8) {
+	if rank > s.registers[idx] {
+		s.registers[idx] = rank
+	}
+}
+
+const (
+	formatSparse uint8 = iota
+	formatDense
+)
+
+// MarshalBinary encodes the sketch as the precision, the format and then the registers.
+// Sparse sketches only keep the non-zero registers as pairs of uint16 index and rank.
+func (s *Sketch) MarshalBinary() ([]byte, error) {
+	m := len(s.registers)
+	n := s.nonZero()
+	if 3*n < m {
+		buf := make([]byte, 2, 2+3*n)
+		buf[0], buf[1] = s.p, formatSparse
+		for i, r := range s.registers {
+			if r != 0 {
+				buf = binary.BigEndian.AppendUint16(buf, uint16(i))
+				buf = append(buf, r)
+			}
+		}
+		return buf, nil
+	}
+
+	buf := make([]byte, 2, 2+m)
+	buf[0], buf[1] = s.p, formatDense
+	return append(buf, s.registers...), nil
+}
+
+func (s *Sketch) UnmarshalBinary(buf []byte) error {
+	if len(buf) < 2 {
+		return fmt.Errorf("too small data for hll sketch, %d bytes", len(buf))
+	}
+	o, err := New(buf[0])
+	if err != nil {
+		return err
+	}
+	m := len(o.registers)
+	body := buf[2:]
+	switch buf[1] {
+	case formatDense:
+		if len(body) != m {
+			return fmt.Errorf("invalid dense hll sketch, expect %d registers, got %d", m, len(body))
+		}
+		copy(o.registers, body)
+	case formatSparse:
+		if len(body)%3 != 0 {
+			return fmt.Errorf("invalid sparse hll sketch, length %d", len(body))
+		}
+		for i := 0; i < len(body); i += 3 {
+			idx := int(binary.BigEndian.Uint16(body[i:]))
+			if idx >= m {
+				return fmt.Errorf("invalid hll register index %d", idx)
+			}
+			o.registers[idx] = body[i+2]
+		}
+	default:
+		return fmt.Errorf("unknown hll sketch format %d", buf[1])
+	}
+	*s = *o
+	return nil
+}
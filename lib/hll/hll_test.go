@@ -0,0 +1,96 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hll_test
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/openGemini/openGemini/lib/hll"
+	"github.com/stretchr/testify/require"
+)
+
+func requireEstimate(t *testing.T, expect int, got uint64) {
+	diff := math.Abs(float64(got)-float64(expect)) / float64(expect)
+	require.True(t, diff < 0.03, "expect about %d, got %d", expect, got)
+}
+
+func TestSketchCount(t *testing.T) {
+	s := hll.NewDefault()
+	require.True(t, s.IsEmpty())
+	require.Equal(t, uint64(0), s.Count())
+
+	for i := 0; i < 10; i++ {
+		s.AddString("a")
+	}
+	require.Equal(t, uint64(1), s.Count())
+
+	for _, n := range []int{100, 10000, 1000000} {
+		s.Reset()
+		for i := 0; i < n; i++ {
+			s.AddString("user_" + strconv.Itoa(i))
+			s.AddString("user_" + strconv.Itoa(i))
+		}
+		requireEstimate(t, n, s.Count())
+	}
+}
+
+func TestSketchMerge(t *testing.T) {
+	s1, s2 := hll.NewDefault(), hll.NewDefault()
+	for i := 0; i < 60000; i++ {
+		s1.AddString(strconv.Itoa(i))
+	}
+	for i := 40000; i < 100000; i++ {
+		s2.AddString(strconv.Itoa(i))
+	}
+	require.NoError(t, s1.Merge(s2))
+	requireEstimate(t, 100000, s1.Count())
+
+	s3, err := hll.New(10)
+	require.NoError(t, err)
+	require.Error(t, s1.Merge(s3))
+
+	_, err = hll.New(20)
+	require.Error(t, err)
+}
+
+func TestSketchEncode(t *testing.T) {
+	for _, n := range []int{0, 100, 100000} {
+		s := hll.NewDefault()
+		for i := 0; i < n; i++ {
+			s.AddString(strconv.Itoa(i))
+		}
+
+		other := hll.NewDefault()
+		require.NoError(t, other.MergeFloats(s.AppendFloats(nil)))
+		require.Equal(t, s.Count(), other.Count())
+
+		buf, err := s.MarshalBinary()
+		require.NoError(t, err)
+		other = &hll.Sketch{}
+		require.NoError(t, other.UnmarshalBinary(buf))
+		require.Equal(t, s.Count(), other.Count())
+		require.Equal(t, uint8(hll.DefaultPrecision), other.Precision())
+	}
+
+	s := hll.NewDefault()
+	require.Error(t, s.MergeFloats([]float64{1}))
+	require.Error(t, s.MergeFloats([]float64{1 << 20, 1}))
+	require.Error(t, s.UnmarshalBinary([]byte{14}))
+	require.Error(t, s.UnmarshalBinary([]byte{14, 1, 0}))
+	require.Error(t, s.UnmarshalBinary([]byte{14, 0, 0}))
+	require.Error(t, s.UnmarshalBinary([]byte{14, 9}))
+}
@@ -0,0 +1,464 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logql
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MatchType is the operator of the matchers and the line filters.
+type MatchType int
+
+const (
+	MatchEqual MatchType = iota
+	MatchNotEqual
+	MatchRegexp
+	MatchNotRegexp
+)
+
+func (t MatchType) String() string {
+	switch t {
+	case MatchEqual:
+		return "="
+	case MatchNotEqual:
+		return "!="
+	case MatchRegexp:
+		return "=~"
+	default:
+		return "!~"
+	}
+}
+
+// the range aggregations of the metric queries
+const (
+	OpCountOverTime = "count_over_time"
+	OpRate          = "rate"
+	OpBytesOverTime = "bytes_over_time"
+	OpBytesRate     = "bytes_rate"
+
+	// AggSum is the only supported vector aggregation
+	AggSum = "sum"
+)
+
+const (
+	// ErrorLabel is added to the labels of the lines which can not be parsed by the json stage
+	ErrorLabel = "__error__"
+	// JSONParserErr is the value of the error label of the json stage
+	JSONParserErr = "JSONParserErr"
+)
+
+// Expr is a LogSelector or a MetricExpr.
+type Expr interface {
+	String() string
+	expr()
+}
+
+// Matcher matches the value of a label, a missing label has the empty value.
+type Matcher struct {
+	Type  MatchType
+	Name  string
+	Value string
+
+	re *regexp.Regexp
+}
+
+// NewMatcher returns the matcher, the regexp of the matcher is anchored as a whole like the prometheus matchers.
+func NewMatcher(t MatchType, name, value string) (*Matcher, error) {
+	m := &Matcher{Type: t, Name: name, Value: value}
+	if t == MatchRegexp || t == MatchNotRegexp {
+		re, err := regexp.Compile("^(?:" + value + ")$")
+		if err != nil {
+			return nil, err
+		}
+		m.re = re
+	}
+	return m, nil
+}
+
+func (m *Matcher) Matches(v string) bool {
+	switch m.Type {
+	case MatchEqual:
+		return v == m.Value
+	case MatchNotEqual:
+		return v != m.Value
+	case MatchRegexp:
+		return m.re.MatchString(v)
+	default:
+		return !m.re.MatchString(v)
+	}
+}
+
+// Values returns the values selected by the equality matcher or the regexp matcher which is an alternation
+// of literals, so that the matcher can be pushed down as the equality conditions of the query. It returns
+// nil for the other matchers and for the matchers selecting the empty value, which also selects the logs
+// without the label.
+func (m *Matcher) Values() []string {
+	switch m.Type {
+	case MatchEqual:
+		if m.Value == "" {
+			return nil
+		}
+		return []string{m.Value}
+	case MatchRegexp:
+		values := strings.Split(m.Value, "|")
+		for _, v := range values {
+			if v == "" || regexp.QuoteMeta(v) != v {
+				return nil
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func (m *Matcher) String() string {
+	return m.Name + m.Type.String() + strconv.Quote(m.Value)
+}
+
+// Stage is a stage of the pipeline of the log selector, the parsers add the extracted labels to the labels.
+// It returns false if the line is filtered out.
+type Stage interface {
+	Process(line string, labels map[string]string) bool
+	String() string
+}
+
+// LineFilter filters the lines by the substring or the regexp, the regexp is not anchored.
+type LineFilter struct {
+	Type  MatchType
+	Match string
+
+	re *regexp.Regexp
+}
+
+func NewLineFilter(t MatchType, match string) (*LineFilter, error) {
+	f := &LineFilter{Type: t, Match: match}
+	if t == MatchRegexp || t == MatchNotRegexp {
+		re, err := regexp.Compile(match)
+		if err != nil {
+			return nil, err
+		}
+		f.re = re
+	}
+	return f, nil
+}
+
+func (f *LineFilter) Process(line string, _ map[string]string) bool {
+	switch f.Type {
+	case MatchEqual:
+		return strings.Contains(line, f.Match)
+	case MatchNotEqual:
+		return !strings.Contains(line, f.Match)
+	case MatchRegexp:
+		return f.re.MatchString(line)
+	default:
+		return !f.re.MatchString(line)
+	}
+}
+
+func (f *LineFilter) String() string {
+	switch f.Type {
+	case MatchEqual:
+		return "|= " + strconv.Quote(f.Match)
+	case MatchNotEqual:
+		return "!= " + strconv.Quote(f.Match)
+	case MatchRegexp:
+		return "|~ " + strconv.Quote(f.Match)
+	default:
+		return "!~ " + strconv.Quote(f.Match)
+	}
+}
+
+// JSONParser extracts the keys of the json line as labels, the keys of the nested objects are joined by '_'
+// and the arrays are skipped. The extracted label conflicting with an existing label gets the _extracted suffix.
+type JSONParser struct{}
+
+func (JSONParser) Process(line string, labels map[string]string) bool {
+	dec := json.NewDecoder(strings.NewReader(line))
+	dec.UseNumber()
+	var obj map[string]interface{}
+	if err := dec.Decode(&obj); err != nil || obj == nil {
+		labels[ErrorLabel] = JSONParserErr
+		return true
+	}
+	extracted := make(map[string]string)
+	flattenJSON("", obj, extracted)
+	for k, v := range extracted {
+		if _, ok := labels[k]; ok {
+			k += "_extracted"
+		}
+		labels[k] = v
+	}
+	return true
+}
+
+func (JSONParser) String() string {
+	return "| json"
+}
+
+func flattenJSON(prefix string, obj map[string]interface{}, labels map[string]string) {
+	for k, v := range obj {
+		key := sanitizeLabelName(prefix + k)
+		switch v := v.(type) {
+		case map[string]interface{}:
+			flattenJSON(key+"_", v, labels)
+		case []interface{}:
+		case string:
+			labels[key] = v
+		case json.Number:
+			labels[key] = v.String()
+		case bool:
+			labels[key] = strconv.FormatBool(v)
+		case nil:
+			labels[key] = ""
+		}
+	}
+}
+
+func sanitizeLabelName(name string) string {
+	b := []byte(name)
+	for i, c := range b {
+		if !(c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' && i > 0) {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
+
+// LabelFilter filters the lines by the labels, including the labels extracted by the parsers.
+type LabelFilter struct {
+	*Matcher
+}
+
+func (f *LabelFilter) Process(_ string, labels map[string]string) bool {
+	return f.Matches(labels[f.Name])
+}
+
+func (f *LabelFilter) String() string {
+	return "| " + f.Matcher.String()
+}
+
+// LogSelector selects the lines of the streams matched by the matchers, the lines are then passed through the stages.
+type LogSelector struct {
+	Matchers []*Matcher
+	Stages   []Stage
+}
+
+func (*LogSelector) expr() {}
+
+// Match reports whether the line of the labels is selected, the labels are extended by the parsers of the stages.
+func (s *LogSelector) Match(line string, labels map[string]string) bool {
+	for _, m := range s.Matchers {
+		if !m.Matches(labels[m.Name]) {
+			return false
+		}
+	}
+	for _, stage := range s.Stages {
+		if !stage.Process(line, labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *LogSelector) String() string {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, m := range s.Matchers {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(m.String())
+	}
+	b.WriteByte('}')
+	for _, stage := range s.Stages {
+		b.WriteByte(' ')
+		b.WriteString(stage.String())
+	}
+	return b.String()
+}
+
+// MetricExpr is a range aggregation of the lines of the selector, optionally summed up by the grouping labels.
+type MetricExpr struct {
+	Op       string
+	Range    time.Duration
+	Selector *LogSelector
+
+	// Aggregation is AggSum when the range vectors are summed up by the Grouping labels, empty otherwise
+	Aggregation string
+	Grouping    []string
+}
+
+func (*MetricExpr) expr() {}
+
+func (e *MetricExpr) String() string {
+	s := e.Op + "(" + e.Selector.String() + " [" + formatDuration(e.Range) + "])"
+	if e.Aggregation == "" {
+		return s
+	}
+	if len(e.Grouping) == 0 {
+		return e.Aggregation + "(" + s + ")"
+	}
+	return e.Aggregation + " by (" + strings.Join(e.Grouping, ", ") + ") (" + s + ")"
+}
+
+// Counting reports whether the samples of the expression count the lines rather than sum up their bytes.
+func (e *MetricExpr) Counting() bool {
+	return e.Op == OpCountOverTime || e.Op == OpRate
+}
+
+// SampleValue is the value of the line in the range aggregation.
+func (e *MetricExpr) SampleValue(line string) float64 {
+	if e.Counting() {
+		return 1
+	}
+	return float64(len(line))
+}
+
+// Sample is a value at the time, the values of the same labels in the range are aggregated.
+type Sample struct {
+	Timestamp int64
+	Value     float64
+	Labels    map[string]string
+}
+
+type Point struct {
+	T int64
+	V float64
+}
+
+type Series struct {
+	Labels map[string]string
+	Points []Point
+}
+
+// Eval evaluates the expression at the steps from start to end, all in nanoseconds, the range of a step t
+// is (t-range, t]. The steps without samples in their ranges have no points, as there is nothing to
+// aggregate. A step of 0 evaluates the expression at start only.
+func (e *MetricExpr) Eval(samples []Sample, start, end, step int64) []*Series {
+	type group struct {
+		labels  map[string]string
+		samples []Sample
+	}
+	groups := make(map[string]*group)
+	for _, s := range samples {
+		labels := e.outputLabels(s.Labels)
+		key := FormatLabels(labels)
+		g, ok := groups[key]
+		if !ok {
+			g = &group{labels: labels}
+			groups[key] = g
+		}
+		g.samples = append(g.samples, s)
+	}
+
+	keys := make([]string, 0, len(groups))
+	for k := range groups {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	if step <= 0 {
+		end, step = start, 1
+	}
+	rangeNs := e.Range.Nanoseconds()
+	series := make([]*Series, 0, len(groups))
+	for _, k := range keys {
+		g := groups[k]
+		sort.Slice(g.samples, func(i, j int) bool {
+			return g.samples[i].Timestamp < g.samples[j].Timestamp
+		})
+		s := &Series{Labels: g.labels}
+		// the samples in the range are g.samples[lo:hi]
+		var lo, hi int
+		var sum float64
+		for t := start; t <= end; t += step {
+			for hi < len(g.samples) && g.samples[hi].Timestamp <= t {
+				sum += g.samples[hi].Value
+				hi++
+			}
+			for lo < hi && g.samples[lo].Timestamp <= t-rangeNs {
+				sum -= g.samples[lo].Value
+				lo++
+			}
+			if lo == hi {
+				// reset the sum to drop the errors of the floating point additions
+				sum = 0
+				continue
+			}
+			v := sum
+			if e.Op == OpRate || e.Op == OpBytesRate {
+				v /= e.Range.Seconds()
+			}
+			s.Points = append(s.Points, Point{T: t, V: v})
+		}
+		if len(s.Points) > 0 {
+			series = append(series, s)
+		}
+	}
+	return series
+}
+
+func (e *MetricExpr) outputLabels(labels map[string]string) map[string]string {
+	if e.Aggregation == "" {
+		return labels
+	}
+	grouped := make(map[string]string, len(e.Grouping))
+	for _, name := range e.Grouping {
+		if v := labels[name]; v != "" {
+			grouped[name] = v
+		}
+	}
+	return grouped
+}
+
+// FormatLabels formats the labels sorted by the names in the form of the stream selector, such as {app="web"}.
+func FormatLabels(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(labels[name]))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func formatDuration(d time.Duration) string {
+	for _, u := range []struct {
+		unit string
+		d    time.Duration
+	}{{"d", 24 * time.Hour}, {"h", time.Hour}, {"m", time.Minute}, {"s", time.Second}, {"ms", time.Millisecond}} {
+		if d%u.d == 0 {
+			return strconv.FormatInt(int64(d/u.d), 10) + u.unit
+		}
+	}
+	return d.String()
+}
@@ -0,0 +1,445 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logql
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/common/model"
+)
+
+/*
+Parse parses the subset of LogQL below:
+
+	expr        = selector | metric
+	selector    = "{" matcher { "," matcher } "}" { stage }
+	matcher     = label ( "=" | "!=" | "=~" | "!~" ) string
+	stage       = ( "|=" | "!=" | "|~" | "!~" ) string | "|" "json" | "|" matcher
+	metric      = range | "sum" [ "by" labels ] "(" range ")" [ "by" labels ]
+	range       = ( "count_over_time" | "rate" | "bytes_over_time" | "bytes_rate" ) "(" selector "[" duration "]" ")"
+	labels      = "(" [ label { "," label } ] ")"
+*/
+func Parse(query string) (Expr, error) {
+	tokens, err := lex(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	var expr Expr
+	if p.peek().typ == tokLBrace {
+		expr, err = p.parseSelector()
+	} else {
+		expr, err = p.parseMetric()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.typ != tokEOF {
+		return nil, p.unexpected(t)
+	}
+	return expr, nil
+}
+
+// ParseLabels parses the labels of a pushed stream, which are in the form of the stream selector
+// with the equality matchers only, such as {app="web", env="prod"}.
+func ParseLabels(s string) (map[string]string, error) {
+	tokens, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	matchers, err := p.parseMatchers()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.typ != tokEOF {
+		return nil, p.unexpected(t)
+	}
+	labels := make(map[string]string, len(matchers))
+	for _, m := range matchers {
+		if m.Type != MatchEqual {
+			return nil, fmt.Errorf("invalid labels %s: only = is allowed", s)
+		}
+		labels[m.Name] = m.Value
+	}
+	return labels, nil
+}
+
+type tokenType int
+
+const (
+	tokEOF tokenType = iota
+	tokWord
+	tokString
+	tokLBrace
+	tokRBrace
+	tokLParen
+	tokRParen
+	tokLBracket
+	tokRBracket
+	tokComma
+	tokPipe
+	tokEq
+	tokNeq
+	tokRe
+	tokNre
+	tokPipeEq
+	tokPipeRe
+)
+
+type token struct {
+	typ tokenType
+	val string
+	pos int
+}
+
+func lex(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		pos := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '"' || c == '`':
+			j := i + 1
+			for j < len(s) && s[j] != c {
+				if c == '"' && s[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j >= len(s) {
+				return nil, fmt.Errorf("parse error at col %d: unterminated string", pos+1)
+			}
+			v, err := strconv.Unquote(s[i : j+1])
+			if err != nil {
+				return nil, fmt.Errorf("parse error at col %d: invalid string %s", pos+1, s[i:j+1])
+			}
+			tokens = append(tokens, token{typ: tokString, val: v, pos: pos})
+			i = j + 1
+			continue
+		case isWordChar(c):
+			j := i
+			for j < len(s) && isWordChar(s[j]) {
+				j++
+			}
+			tokens = append(tokens, token{typ: tokWord, val: s[i:j], pos: pos})
+			i = j
+			continue
+		}
+
+		typ, n := tokEOF, 1
+		next := byte(0)
+		if i+1 < len(s) {
+			next = s[i+1]
+		}
+		switch c {
+		case '{':
+			typ = tokLBrace
+		case '}':
+			typ = tokRBrace
+		case '(':
+			typ = tokLParen
+		case ')':
+			typ = tokRParen
+		case '[':
+			typ = tokLBracket
+		case ']':
+			typ = tokRBracket
+		case ',':
+			typ = tokComma
+		case '|':
+			switch next {
+			case '=':
+				typ, n = tokPipeEq, 2
+			case '~':
+				typ, n = tokPipeRe, 2
+			default:
+				typ = tokPipe
+			}
+		case '=':
+			if next == '~' {
+				typ, n = tokRe, 2
+			} else {
+				typ = tokEq
+			}
+		case '!':
+			switch next {
+			case '=':
+				typ, n = tokNeq, 2
+			case '~':
+				typ, n = tokNre, 2
+			}
+		}
+		if typ == tokEOF {
+			return nil, fmt.Errorf("parse error at col %d: unexpected character %q", pos+1, c)
+		}
+		tokens = append(tokens, token{typ: typ, val: s[i : i+n], pos: pos})
+		i += n
+	}
+	return append(tokens, token{typ: tokEOF, pos: len(s)}), nil
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c == '.' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.typ != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(typ tokenType) (token, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, p.unexpected(t)
+	}
+	return t, nil
+}
+
+func (p *parser) unexpected(t token) error {
+	if t.typ == tokEOF {
+		return fmt.Errorf("parse error at col %d: unexpected end of query", t.pos+1)
+	}
+	return fmt.Errorf("parse error at col %d: unexpected %s", t.pos+1, t.val)
+}
+
+func (p *parser) parseMetric() (*MetricExpr, error) {
+	t := p.peek()
+	if t.typ != tokWord || t.val != AggSum {
+		return p.parseRange()
+	}
+	p.next()
+
+	var grouping []string
+	var err error
+	hasGrouping := false
+	if by := p.peek(); by.typ == tokWord {
+		if grouping, err = p.parseGrouping(); err != nil {
+			return nil, err
+		}
+		hasGrouping = true
+	}
+	if _, err = p.expect(tokLParen); err != nil {
+		return nil, err
+	}
+	expr, err := p.parseRange()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = p.expect(tokRParen); err != nil {
+		return nil, err
+	}
+	if by := p.peek(); by.typ == tokWord && !hasGrouping {
+		if grouping, err = p.parseGrouping(); err != nil {
+			return nil, err
+		}
+	}
+	expr.Aggregation = AggSum
+	expr.Grouping = grouping
+	return expr, nil
+}
+
+func (p *parser) parseGrouping() ([]string, error) {
+	t := p.next()
+	if t.val != "by" {
+		return nil, fmt.Errorf("parse error at col %d: only the by grouping is supported, got %s", t.pos+1, t.val)
+	}
+	if _, err := p.expect(tokLParen); err != nil {
+		return nil, err
+	}
+	var labels []string
+	for p.peek().typ != tokRParen {
+		if len(labels) > 0 {
+			if _, err := p.expect(tokComma); err != nil {
+				return nil, err
+			}
+		}
+		label, err := p.expect(tokWord)
+		if err != nil {
+			return nil, err
+		}
+		labels = append(labels, label.val)
+	}
+	p.next()
+	return labels, nil
+}
+
+func (p *parser) parseRange() (*MetricExpr, error) {
+	t := p.next()
+	if t.typ != tokWord {
+		return nil, p.unexpected(t)
+	}
+	switch t.val {
+	case OpCountOverTime, OpRate, OpBytesOverTime, OpBytesRate:
+	default:
+		return nil, fmt.Errorf("parse error at col %d: unsupported function %s", t.pos+1, t.val)
+	}
+	if _, err := p.expect(tokLParen); err != nil {
+		return nil, err
+	}
+	selector, err := p.parseSelector()
+	if err != nil {
+		return nil, err
+	}
+	if _, err = p.expect(tokLBracket); err != nil {
+		return nil, err
+	}
+	d, err := p.expect(tokWord)
+	if err != nil {
+		return nil, err
+	}
+	r, err := model.ParseDuration(d.val)
+	if err != nil || r <= 0 {
+		return nil, fmt.Errorf("parse error at col %d: invalid range %s", d.pos+1, d.val)
+	}
+	if _, err = p.expect(tokRBracket); err != nil {
+		return nil, err
+	}
+	if _, err = p.expect(tokRParen); err != nil {
+		return nil, err
+	}
+	return &MetricExpr{Op: t.val, Range: time.Duration(r), Selector: selector}, nil
+}
+
+func (p *parser) parseSelector() (*LogSelector, error) {
+	matchers, err := p.parseMatchers()
+	if err != nil {
+		return nil, err
+	}
+	selector := &LogSelector{Matchers: matchers}
+	for {
+		t := p.peek()
+		var typ MatchType
+		switch t.typ {
+		case tokPipeEq:
+			typ = MatchEqual
+		case tokNeq:
+			typ = MatchNotEqual
+		case tokPipeRe:
+			typ = MatchRegexp
+		case tokNre:
+			typ = MatchNotRegexp
+		case tokPipe:
+			p.next()
+			stage, err := p.parseStage()
+			if err != nil {
+				return nil, err
+			}
+			selector.Stages = append(selector.Stages, stage)
+			continue
+		default:
+			return selector, nil
+		}
+		p.next()
+		s, err := p.expect(tokString)
+		if err != nil {
+			return nil, err
+		}
+		filter, err := NewLineFilter(typ, s.val)
+		if err != nil {
+			return nil, fmt.Errorf("parse error at col %d: %v", s.pos+1, err)
+		}
+		selector.Stages = append(selector.Stages, filter)
+	}
+}
+
+func (p *parser) parseStage() (Stage, error) {
+	t, err := p.expect(tokWord)
+	if err != nil {
+		return nil, err
+	}
+	if t.val == "json" && p.peek().typ != tokEq && p.peek().typ != tokNeq &&
+		p.peek().typ != tokRe && p.peek().typ != tokNre {
+		return JSONParser{}, nil
+	}
+	m, err := p.parseMatcherOf(t)
+	if err != nil {
+		return nil, err
+	}
+	return &LabelFilter{Matcher: m}, nil
+}
+
+func (p *parser) parseMatchers() ([]*Matcher, error) {
+	if _, err := p.expect(tokLBrace); err != nil {
+		return nil, err
+	}
+	var matchers []*Matcher
+	for {
+		name, err := p.expect(tokWord)
+		if err != nil {
+			return nil, err
+		}
+		m, err := p.parseMatcherOf(name)
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, m)
+
+		t := p.next()
+		switch t.typ {
+		case tokComma:
+		case tokRBrace:
+			return matchers, nil
+		default:
+			return nil, p.unexpected(t)
+		}
+	}
+}
+
+func (p *parser) parseMatcherOf(name token) (*Matcher, error) {
+	if c := name.val[0]; c == '.' || c >= '0' && c <= '9' {
+		return nil, fmt.Errorf("parse error at col %d: invalid label name %s", name.pos+1, name.val)
+	}
+	op := p.next()
+	var typ MatchType
+	switch op.typ {
+	case tokEq:
+		typ = MatchEqual
+	case tokNeq:
+		typ = MatchNotEqual
+	case tokRe:
+		typ = MatchRegexp
+	case tokNre:
+		typ = MatchNotRegexp
+	default:
+		return nil, p.unexpected(op)
+	}
+	v, err := p.expect(tokString)
+	if err != nil {
+		return nil, err
+	}
+	m, err := NewMatcher(typ, name.val, v.val)
+	if err != nil {
+		return nil, fmt.Errorf("parse error at col %d: %v", v.pos+1, err)
+	}
+	return m, nil
+}
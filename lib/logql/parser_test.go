@@ -0,0 +1,153 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logql
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	for query, expect := range map[string]string{
+		`{app="web"}`: `{app="web"}`,
+		`{app="web", env=~"prod|staging"} |= "error" != "timeout" |~ "5\\d\\d" !~ "^GET"`: `{app="web", env=~"prod|staging"} |= "error" != "timeout" |~ "5\\d\\d" !~ "^GET"`,
+		"{app=`web`} | json | level=\"error\" | status!~\"2..\"":                          `{app="web"} | json | level="error" | status!~"2.."`,
+		`count_over_time({app="web"}[5m])`:                                                `count_over_time({app="web"} [5m])`,
+		`rate({app="web"} |= "error" [1h30m])`:                                            `rate({app="web"} |= "error" [90m])`,
+		`sum by (level) (count_over_time({app="web"} | json [1m]))`:                       `sum by (level) (count_over_time({app="web"} | json [1m]))`,
+		`sum(bytes_rate({app="web"}[30s])) by (host, level)`:                              `sum by (host, level) (bytes_rate({app="web"} [30s]))`,
+		`sum(count_over_time({app="web"}[1d]))`:                                           `sum(count_over_time({app="web"} [1d]))`,
+	} {
+		expr, err := Parse(query)
+		require.NoError(t, err, query)
+		require.Equal(t, expect, expr.String(), query)
+	}
+
+	for _, query := range []string{
+		``,
+		`{}`,
+		`{app}`,
+		`{app="web"`,
+		`{app="web"} |= error`,
+		`{app="web"} | logfmt`,
+		`{app=~"("}`,
+		`{1app="web"}`,
+		`{app="web"} extra`,
+		`count_over_time({app="web"})`,
+		`count_over_time({app="web"}[0s])`,
+		`avg_over_time({app="web"}[5m])`,
+		`sum without (level) (count_over_time({app="web"}[5m]))`,
+		`sum by (level count_over_time({app="web"}[5m]))`,
+		`{app="web`,
+	} {
+		_, err := Parse(query)
+		require.Error(t, err, query)
+	}
+}
+
+func TestParseLabels(t *testing.T) {
+	labels, err := ParseLabels(`{app="web", env="prod\"1"}`)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"app": "web", "env": `prod"1`}, labels)
+
+	_, err = ParseLabels(`{app=~"web"}`)
+	require.Error(t, err)
+	_, err = ParseLabels(`{app="web"} |= "x"`)
+	require.Error(t, err)
+}
+
+func TestMatcherValues(t *testing.T) {
+	for _, c := range []struct {
+		typ    MatchType
+		value  string
+		expect []string
+	}{
+		{MatchEqual, "web", []string{"web"}},
+		{MatchEqual, "", nil},
+		{MatchNotEqual, "web", nil},
+		{MatchRegexp, "prod|staging", []string{"prod", "staging"}},
+		{MatchRegexp, "prod|", nil},
+		{MatchRegexp, "prod.*", nil},
+		{MatchNotRegexp, "prod", nil},
+	} {
+		m, err := NewMatcher(c.typ, "env", c.value)
+		require.NoError(t, err)
+		require.Equal(t, c.expect, m.Values(), c.value)
+	}
+
+	m, err := NewMatcher(MatchRegexp, "env", "prod|staging")
+	require.NoError(t, err)
+	require.True(t, m.Matches("prod"))
+	require.False(t, m.Matches("production"))
+}
+
+func TestSelectorMatch(t *testing.T) {
+	expr, err := Parse(`{app="web", env!="dev"} |= "GET" | json | status=~"5.." | user_name="bob"`)
+	require.NoError(t, err)
+	selector := expr.(*LogSelector)
+
+	labels := map[string]string{"app": "web"}
+	require.True(t, selector.Match(`{"method":"GET","status":503,"user":{"name":"bob"},"tags":["a"]}`, labels))
+	require.Equal(t, map[string]string{"app": "web", "method": "GET", "status": "503", "user_name": "bob"}, labels)
+
+	require.False(t, selector.Match(`{"method":"GET","status":200,"user":{"name":"bob"}}`, map[string]string{"app": "web"}))
+	require.False(t, selector.Match(`{"method":"POST","status":503,"user":{"name":"bob"}}`, map[string]string{"app": "web"}))
+	require.False(t, selector.Match(`{"method":"GET","status":503,"user":{"name":"bob"}}`, map[string]string{"app": "web", "env": "dev"}))
+
+	// the line which is not json gets the error label, and the extracted labels do not override the stream labels
+	expr, err = Parse(`{app="web"} | json`)
+	require.NoError(t, err)
+	labels = map[string]string{"app": "web"}
+	require.True(t, expr.(*LogSelector).Match(`GET /index.html`, labels))
+	require.Equal(t, JSONParserErr, labels[ErrorLabel])
+	labels = map[string]string{"app": "web"}
+	require.True(t, expr.(*LogSelector).Match(`{"app":"api","a-b":true,"c":null}`, labels))
+	require.Equal(t, map[string]string{"app": "web", "app_extracted": "api", "a_b": "true", "c": ""}, labels)
+}
+
+func TestMetricEval(t *testing.T) {
+	expr, err := Parse(`count_over_time({app="web"}[10s])`)
+	require.NoError(t, err)
+	e := expr.(*MetricExpr)
+	sec := int64(time.Second)
+	samples := []Sample{
+		{Timestamp: 5 * sec, Value: 1, Labels: map[string]string{"app": "web", "level": "info"}},
+		{Timestamp: 10 * sec, Value: 1, Labels: map[string]string{"app": "web", "level": "info"}},
+		{Timestamp: 12 * sec, Value: 1, Labels: map[string]string{"app": "web", "level": "error"}},
+		{Timestamp: 1 * sec, Value: 1, Labels: map[string]string{"app": "web", "level": "info"}},
+	}
+
+	series := e.Eval(samples, 10*sec, 30*sec, 10*sec)
+	require.Len(t, series, 2)
+	require.Equal(t, map[string]string{"app": "web", "level": "error"}, series[0].Labels)
+	require.Equal(t, []Point{{T: 20 * sec, V: 1}}, series[0].Points)
+	require.Equal(t, map[string]string{"app": "web", "level": "info"}, series[1].Labels)
+	// the range of 10s is (0s, 10s] and the range of 20s is (10s, 20s]
+	require.Equal(t, []Point{{T: 10 * sec, V: 3}}, series[1].Points)
+
+	expr, err = Parse(`sum by (app) (rate({app="web"}[10s]))`)
+	require.NoError(t, err)
+	series = expr.(*MetricExpr).Eval(samples, 12*sec, 12*sec, 0)
+	require.Len(t, series, 1)
+	require.Equal(t, map[string]string{"app": "web"}, series[0].Labels)
+	require.Equal(t, []Point{{T: 12 * sec, V: 0.3}}, series[0].Points)
+
+	expr, err = Parse(`bytes_over_time({app="web"}[10s])`)
+	require.NoError(t, err)
+	require.Equal(t, float64(5), expr.(*MetricExpr).SampleValue("hello"))
+	require.Equal(t, `{app="web", level="info"}`, FormatLabels(samples[0].Labels))
+}
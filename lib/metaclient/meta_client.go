@@ -136,6 +136,7 @@ type MetaClient interface {
 	SystemManager
 	SubscriptionManager
 	ContinuousQueryManager
+	AlertManager
 	DownSampleManager
 	RepManager
 	MeasurementManager
@@ -146,8 +147,9 @@ type MetaClient interface {
 
 type MeasurementManager interface {
 	CreateMeasurement(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation, engineType config.EngineType,
-		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error)
+		colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error)
 	AlterShardKey(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo) error
+	AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error
 	MarkMeasurementDelete(database, policy, measurement string) error
 	GetMeasurementID(database string, rpName string, mstName string) (uint64, error)
 	QueryTagKeys(database string, ms influxql.Measurements, cond influxql.Expr) (map[string]map[string]struct{}, error)
@@ -174,6 +176,19 @@ type ContinuousQueryManager interface {
 	SendSql2MetaHeartbeat(host string) error
 }
 
+type AlertManager interface {
+	CreateAlertRule(rule *meta2.AlertRuleInfo) error
+	DropAlertRule(name string) error
+	AlertRules() map[string]*meta2.AlertRuleInfo
+	CreateAlertReceiver(receiver *meta2.AlertReceiverInfo) error
+	DropAlertReceiver(name string) error
+	AlertReceivers() map[string]*meta2.AlertReceiverInfo
+	CreateAlertSilence(silence *meta2.AlertSilenceInfo) error
+	DropAlertSilence(id string) error
+	AlertSilences() map[string]*meta2.AlertSilenceInfo
+	ReportAlertStates(host string, now time.Time, states []*meta2.AlertRuleState) error
+}
+
 type SubscriptionManager interface {
 	CreateSubscription(database, rp, name, mode string, destinations []string) error
 	DropSubscription(database, rp, name string) error
@@ -132,6 +132,7 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_ReShardingCommand:                applyReSharding,
 	proto2.Command_UpdateSchemaCommand:              applyUpdateSchema,
 	proto2.Command_AlterShardKeyCmd:                 applyAlterShardKey,
+	proto2.Command_AlterMeasurementTTLCmd:           applyAlterMeasurementTTL,
 	proto2.Command_PruneGroupsCommand:               applyPruneGroups,
 	proto2.Command_MarkMeasurementDeleteCommand:     applyMarkMeasurementDelete,
 	proto2.Command_DropMeasurementCommand:           applyDropMeasurement,
@@ -158,6 +159,13 @@ var applyFunc = map[proto2.Command_Type]func(c *Client, op *proto2.Command) erro
 	proto2.Command_UpdateReplicationCommand:         applyUpdateReplication,
 	proto2.Command_UpdateMeasurementCommand:         applyUpdateMeasurement,
 	proto2.Command_UpdateMetaNodeStatusCommand:      applyUpdateMetaNodeStatus,
+	proto2.Command_CreateAlertRuleCommand:           applyCreateAlertRule,
+	proto2.Command_DropAlertRuleCommand:             applyDropAlertRule,
+	proto2.Command_CreateAlertReceiverCommand:       applyCreateAlertReceiver,
+	proto2.Command_DropAlertReceiverCommand:         applyDropAlertReceiver,
+	proto2.Command_CreateAlertSilenceCommand:        applyCreateAlertSilence,
+	proto2.Command_DropAlertSilenceCommand:          applyDropAlertSilence,
+	proto2.Command_AlertStateReportCommand:          applyAlertStateReport,
 }
 
 type authRcd struct {
@@ -936,7 +944,7 @@ func (c *Client) InsertFiles(fileInfos []meta2.FileInfo) error {
 }
 
 func (c *Client) CreateMeasurement(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo, NumOfShards int32, indexR *influxql.IndexRelation,
-	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error) {
+	engineType config.EngineType, colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error) {
 	msti, err := c.Measurement(database, retentionPolicy, mst)
 	if msti != nil {
 		// check shardkey equal or not
@@ -987,6 +995,10 @@ func (c *Client) CreateMeasurement(database, retentionPolicy, mst string, shardK
 		cmd.Options = options.Marshal()
 	}
 
+	if ttl > 0 {
+		cmd.TTL = proto.Int64(int64(ttl))
+	}
+
 	err = c.retryUntilExec(proto2.Command_CreateMeasurementCommand, proto2.E_CreateMeasurementCommand_Command, cmd)
 	if err != nil {
 		return nil, err
@@ -1041,6 +1053,23 @@ func (c *Client) AlterShardKey(database, retentionPolicy, mst string, shardKey *
 	return c.retryUntilExec(proto2.Command_AlterShardKeyCmd, proto2.E_AlterShardKeyCmd_Command, cmd)
 }
 
+// AlterMeasurementTTL sets the time to live of the measurement, ttl of 0 disables it.
+func (c *Client) AlterMeasurementTTL(database, retentionPolicy, mst string, ttl time.Duration) error {
+	_, err := c.Measurement(database, retentionPolicy, mst)
+	if err != nil {
+		return err
+	}
+
+	cmd := &proto2.AlterMeasurementTTLCmd{
+		DBName: proto.String(database),
+		RpName: proto.String(retentionPolicy),
+		Name:   proto.String(mst),
+		TTL:    proto.Int64(int64(ttl)),
+	}
+
+	return c.retryUntilExec(proto2.Command_AlterMeasurementTTLCmd, proto2.E_AlterMeasurementTTLCmd_Command, cmd)
+}
+
 // CreateDatabase creates a database or returns it if it already exists.
 func (c *Client) CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta2.DatabaseInfo, error) {
 	if strings.Count(name, "") > maxDbOrRpName {
@@ -3390,6 +3419,10 @@ func applyAlterShardKey(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyAlterShardKey(c.cacheData, cmd)
 }
 
+func applyAlterMeasurementTTL(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyAlterMeasurementTTL(c.cacheData, cmd)
+}
+
 func applyPruneGroups(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyPruneGroups(c.cacheData, cmd)
 }
@@ -3530,6 +3563,34 @@ func applyUpdateMeasurement(c *Client, cmd *proto2.Command) error {
 	return meta2.ApplyUpdateMeasurement(c.cacheData, cmd)
 }
 
+func applyCreateAlertRule(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyCreateAlertRule(c.cacheData, cmd)
+}
+
+func applyDropAlertRule(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyDropAlertRule(c.cacheData, cmd)
+}
+
+func applyCreateAlertReceiver(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyCreateAlertReceiver(c.cacheData, cmd)
+}
+
+func applyDropAlertReceiver(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyDropAlertReceiver(c.cacheData, cmd)
+}
+
+func applyCreateAlertSilence(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyCreateAlertSilence(c.cacheData, cmd)
+}
+
+func applyDropAlertSilence(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyDropAlertSilence(c.cacheData, cmd)
+}
+
+func applyAlertStateReport(c *Client, cmd *proto2.Command) error {
+	return meta2.ApplyAlertStateReport(c.cacheData, cmd)
+}
+
 func (c *Client) RetryDownSampleInfo() ([]byte, error) {
 	startTime := time.Now()
 	currentServer := connectedServer
@@ -4107,7 +4168,7 @@ func (c *Client) CreateStreamMeasurement(info *meta2.StreamInfo, src, dest *infl
 	if len(srcInfo.ShardKeys) > 0 {
 		shardKeyInfo = &srcInfo.ShardKeys[0]
 	}
-	_, err = c.CreateMeasurement(dest.Database, dest.RetentionPolicy, dest.Name, shardKeyInfo, srcInfo.InitNumOfShards, nil, srcInfo.EngineType, colStoreInfo, schemaInfo, nil)
+	_, err = c.CreateMeasurement(dest.Database, dest.RetentionPolicy, dest.Name, shardKeyInfo, srcInfo.InitNumOfShards, nil, srcInfo.EngineType, colStoreInfo, schemaInfo, nil, 0)
 	if err != nil {
 		return err
 	}
@@ -676,10 +676,10 @@ func TestClient_CreateMeasurement(t *testing.T) {
 	schemaInfo := meta2.NewSchemaInfo(map[string]int32{"a": influx.Field_Type_Tag}, map[string]int32{"b": influx.Field_Type_Float})
 
 	options := &meta2.Options{Ttl: 1}
-	_, err := c.CreateMeasurement("db0", "rp0", "measurement", nil, 0, nil, config.COLUMNSTORE, colStoreInfo, nil, options)
+	_, err := c.CreateMeasurement("db0", "rp0", "measurement", nil, 0, nil, config.COLUMNSTORE, colStoreInfo, nil, options, 0)
 	require.EqualError(t, err, "execute command timeout")
 
-	_, err = c.CreateMeasurement("db0", "rp0", "measurement", nil, 0, nil, config.COLUMNSTORE, colStoreInfo, schemaInfo, options)
+	_, err = c.CreateMeasurement("db0", "rp0", "measurement", nil, 0, nil, config.COLUMNSTORE, colStoreInfo, schemaInfo, options, 0)
 	require.EqualError(t, err, "execute command timeout")
 
 	_, err = c.SimpleCreateMeasurement("db0", "rp0", "measurement", config.COLUMNSTORE)
@@ -1296,7 +1296,7 @@ func TestCreateMeasurement(t *testing.T) {
 	invalidMst := []string{"", "/111", ".", "..", "bbb\\aaa", string([]byte{'m', 's', 't', 0, '_', '0', '0'})}
 
 	for _, mst := range invalidMst {
-		_, err = c.CreateMeasurement("db0", "rp0", mst, nil, 0, nil, config.TSSTORE, nil, nil, nil)
+		_, err = c.CreateMeasurement("db0", "rp0", mst, nil, 0, nil, config.TSSTORE, nil, nil, nil, 0)
 		require.EqualError(t, err, errno.NewError(errno.InvalidMeasurement, mst).Error())
 
 		_, err = c.SimpleCreateMeasurement("db0", "rp0", mst, config.TSSTORE)
@@ -2593,6 +2593,7 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_ReShardingCommand:                newReShardingPb,
 	proto2.Command_UpdateSchemaCommand:              newUpdateSchemaPb,
 	proto2.Command_AlterShardKeyCmd:                 newAlterShardKeyPb,
+	proto2.Command_AlterMeasurementTTLCmd:           newAlterMeasurementTTLPb,
 	proto2.Command_PruneGroupsCommand:               newPruneGroupsPb,
 	proto2.Command_MarkMeasurementDeleteCommand:     newMarkMeasurementDeletePb,
 	proto2.Command_DropMeasurementCommand:           newDropMeasurementPb,
@@ -2626,6 +2627,13 @@ var newPbFunc = map[proto2.Command_Type]func() (interface{}, *proto.ExtensionDes
 	proto2.Command_UpdateMeasurementCommand:         newUpdateMeasurementPb,
 	proto2.Command_UpdateMetaNodeStatusCommand:      newUpdateMetaNodeStatusPb,
 	proto2.Command_UpdateIndexInfoTierCommand:       newUpdateIndexInfoTierPb,
+	proto2.Command_CreateAlertRuleCommand:           newCreateAlertRulePb,
+	proto2.Command_DropAlertRuleCommand:             newDropAlertRulePb,
+	proto2.Command_CreateAlertReceiverCommand:       newCreateAlertReceiverPb,
+	proto2.Command_DropAlertReceiverCommand:         newDropAlertReceiverPb,
+	proto2.Command_CreateAlertSilenceCommand:        newCreateAlertSilencePb,
+	proto2.Command_DropAlertSilenceCommand:          newDropAlertSilencePb,
+	proto2.Command_AlertStateReportCommand:          newAlertStateReportPb,
 }
 
 func newCreateDatabasePb() (interface{}, *proto.ExtensionDesc) {
@@ -2796,6 +2804,10 @@ func newAlterShardKeyPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.AlterShardKeyCmd{}, proto2.E_AlterShardKeyCmd_Command
 }
 
+func newAlterMeasurementTTLPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.AlterMeasurementTTLCmd{}, proto2.E_AlterMeasurementTTLCmd_Command
+}
+
 func newPruneGroupsPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.PruneGroupsCommand{}, proto2.E_PruneGroupsCommand_Command
 }
@@ -2937,6 +2949,34 @@ func newUpdateMeasurementPb() (interface{}, *proto.ExtensionDesc) {
 	return &proto2.UpdateMeasurementCommand{}, proto2.E_UpdateMeasurementCommand_Command
 }
 
+func newCreateAlertRulePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.CreateAlertRuleCommand{}, proto2.E_CreateAlertRuleCommand_Command
+}
+
+func newDropAlertRulePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.DropAlertRuleCommand{}, proto2.E_DropAlertRuleCommand_Command
+}
+
+func newCreateAlertReceiverPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.CreateAlertReceiverCommand{}, proto2.E_CreateAlertReceiverCommand_Command
+}
+
+func newDropAlertReceiverPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.DropAlertReceiverCommand{}, proto2.E_DropAlertReceiverCommand_Command
+}
+
+func newCreateAlertSilencePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.CreateAlertSilenceCommand{}, proto2.E_CreateAlertSilenceCommand_Command
+}
+
+func newDropAlertSilencePb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.DropAlertSilenceCommand{}, proto2.E_DropAlertSilenceCommand_Command
+}
+
+func newAlertStateReportPb() (interface{}, *proto.ExtensionDesc) {
+	return &proto2.AlertStateReportCommand{}, proto2.E_AlertStateReportCommand_Command
+}
+
 func BuildCmd(t proto2.Command_Type) *proto2.Command {
 	cmd1, ext := newPbFunc[t]()
 	cmd2 := &proto2.Command{Type: &t}
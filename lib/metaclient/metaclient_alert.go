@@ -0,0 +1,99 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metaclient
+
+import (
+	"time"
+
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// CreateAlertRule creates the alert rule or replaces the definition of the existing one.
+func (c *Client) CreateAlertRule(rule *meta2.AlertRuleInfo) error {
+	cmd := &proto2.CreateAlertRuleCommand{
+		Rule: rule.Marshal(),
+	}
+	return c.retryUntilExec(proto2.Command_CreateAlertRuleCommand, proto2.E_CreateAlertRuleCommand_Command, cmd)
+}
+
+func (c *Client) DropAlertRule(name string) error {
+	cmd := &proto2.DropAlertRuleCommand{
+		Name: proto.String(name),
+	}
+	return c.retryUntilExec(proto2.Command_DropAlertRuleCommand, proto2.E_DropAlertRuleCommand_Command, cmd)
+}
+
+// AlertRules returns a copy of the alert rules and the state of their alerts.
+func (c *Client) AlertRules() map[string]*meta2.AlertRuleInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.CloneAlertRules()
+}
+
+func (c *Client) CreateAlertReceiver(receiver *meta2.AlertReceiverInfo) error {
+	cmd := &proto2.CreateAlertReceiverCommand{
+		Receiver: receiver.Marshal(),
+	}
+	return c.retryUntilExec(proto2.Command_CreateAlertReceiverCommand, proto2.E_CreateAlertReceiverCommand_Command, cmd)
+}
+
+func (c *Client) DropAlertReceiver(name string) error {
+	cmd := &proto2.DropAlertReceiverCommand{
+		Name: proto.String(name),
+	}
+	return c.retryUntilExec(proto2.Command_DropAlertReceiverCommand, proto2.E_DropAlertReceiverCommand_Command, cmd)
+}
+
+func (c *Client) AlertReceivers() map[string]*meta2.AlertReceiverInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.CloneAlertReceivers()
+}
+
+func (c *Client) CreateAlertSilence(silence *meta2.AlertSilenceInfo) error {
+	cmd := &proto2.CreateAlertSilenceCommand{
+		Silence: silence.Marshal(),
+	}
+	return c.retryUntilExec(proto2.Command_CreateAlertSilenceCommand, proto2.E_CreateAlertSilenceCommand_Command, cmd)
+}
+
+func (c *Client) DropAlertSilence(id string) error {
+	cmd := &proto2.DropAlertSilenceCommand{
+		ID: proto.String(id),
+	}
+	return c.retryUntilExec(proto2.Command_DropAlertSilenceCommand, proto2.E_DropAlertSilenceCommand_Command, cmd)
+}
+
+func (c *Client) AlertSilences() map[string]*meta2.AlertSilenceInfo {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.cacheData.CloneAlertSilences()
+}
+
+// ReportAlertStates persists the state of the rules evaluated by the sql node at the time.
+// The state of a rule leased to another sql node is ignored by ts-meta, see meta2.Data.ReportAlertStates.
+func (c *Client) ReportAlertStates(host string, now time.Time, states []*meta2.AlertRuleState) error {
+	cmd := &proto2.AlertStateReportCommand{
+		Host: proto.String(host),
+		Time: proto.Int64(now.UnixNano()),
+	}
+	cmd.Rules = make([]*proto2.AlertRuleState, len(states))
+	for i := range states {
+		cmd.Rules[i] = states[i].Marshal()
+	}
+	return c.retryUntilExec(proto2.Command_AlertStateReportCommand, proto2.E_AlertStateReportCommand_Command, cmd)
+}
@@ -0,0 +1,485 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mocks
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const metaHeaderPrefix = "X-Amz-Meta-"
+
+type storedObject struct {
+	data     []byte
+	etag     string
+	metadata map[string]string
+	modified time.Time
+}
+
+type storedPart struct {
+	data []byte
+	etag string
+}
+
+type multipartUpload struct {
+	key       string
+	metadata  map[string]string
+	initiated time.Time
+	parts     map[int]*storedPart
+}
+
+// ObjectStorage is an in-memory S3 compatible object storage for tests, it serves path style
+// requests of a single bucket and does not check the signatures.
+type ObjectStorage struct {
+	*httptest.Server
+	Bucket string
+	// MaxKeys limits the number of the keys of a listing, to test the pagination
+	MaxKeys int
+
+	mu      sync.Mutex
+	objects map[string]*storedObject
+	uploads map[string]*multipartUpload
+	nextID  int
+	// the number of the upload part requests to accept before failing them
+	partQuota int
+	requests  map[string]int
+}
+
+func NewObjectStorage(bucket string) *ObjectStorage {
+	s := &ObjectStorage{
+		Bucket:    bucket,
+		MaxKeys:   1000,
+		objects:   make(map[string]*storedObject),
+		uploads:   make(map[string]*multipartUpload),
+		partQuota: -1,
+		requests:  make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// FailUploadPartAfter makes the upload part requests fail once n of them are accepted, to
+// simulate an interrupted upload. A negative n accepts all of them.
+func (s *ObjectStorage) FailUploadPartAfter(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.partQuota = n
+}
+
+// Requests returns the number of the requests served by the operation, such as PutObject and UploadPart
+func (s *ObjectStorage) Requests(op string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[op]
+}
+
+// Keys returns the sorted keys of the objects
+func (s *ObjectStorage) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	keys := make([]string, 0, len(s.objects))
+	for k := range s.objects {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Object returns the content of the object
+func (s *ObjectStorage) Object(key string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	o, ok := s.objects[key]
+	if !ok {
+		return nil, false
+	}
+	return o.data, true
+}
+
+// PendingUploads returns the number of the multipart uploads not completed or aborted
+func (s *ObjectStorage) PendingUploads() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.uploads)
+}
+
+func (s *ObjectStorage) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if bucket != s.Bucket {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	q := r.URL.Query()
+	_, uploads := q["uploads"]
+	_, del := q["delete"]
+	uploadID := q.Get("uploadId")
+
+	switch {
+	case key == "" && r.Method == http.MethodGet && uploads:
+		s.serve(w, "ListMultipartUploads", s.listUploads(q.Get("prefix")))
+	case key == "" && r.Method == http.MethodGet:
+		s.serve(w, "ListObjects", s.listObjects(q))
+	case key == "" && r.Method == http.MethodPost && del:
+		s.serve(w, "DeleteObjects", s.deleteObjects(r.Body))
+	case r.Method == http.MethodPost && uploads:
+		s.serve(w, "InitiateMultipartUpload", s.initiateUpload(key, r.Header))
+	case r.Method == http.MethodPut && uploadID != "":
+		s.requests["UploadPart"]++
+		s.uploadPart(w, r, key, uploadID)
+	case r.Method == http.MethodPost && uploadID != "":
+		s.serve(w, "CompleteMultipartUpload", s.completeUpload(r.Body, key, uploadID))
+	case r.Method == http.MethodGet && uploadID != "":
+		s.serve(w, "ListParts", s.listParts(key, uploadID))
+	case r.Method == http.MethodDelete && uploadID != "":
+		s.requests["AbortMultipartUpload"]++
+		delete(s.uploads, uploadID)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPut:
+		s.requests["PutObject"]++
+		s.putObject(w, r, key)
+	case r.Method == http.MethodGet || r.Method == http.MethodHead:
+		s.requests["GetObject"]++
+		s.getObject(w, r, key)
+	case r.Method == http.MethodDelete:
+		s.requests["DeleteObject"]++
+		delete(s.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeS3Error(w, http.StatusNotImplemented, "NotImplemented")
+	}
+}
+
+func (s *ObjectStorage) serve(w http.ResponseWriter, op string, v interface{}) {
+	s.requests[op]++
+	if v == nil {
+		return
+	}
+	if err, ok := v.(s3Error); ok {
+		writeS3Error(w, err.status, err.code)
+		return
+	}
+	content, err := xml.Marshal(v)
+	if err != nil {
+		writeS3Error(w, http.StatusInternalServerError, "InternalError")
+		return
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	_, _ = w.Write(content)
+}
+
+type s3Error struct {
+	status int
+	code   string
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_, _ = fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func etagOf(data []byte) string {
+	sum := md5.Sum(data)
+	return "\"" + hex.EncodeToString(sum[:]) + "\""
+}
+
+func metadataOf(h http.Header) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range h {
+		if strings.HasPrefix(k, metaHeaderPrefix) && len(v) > 0 {
+			metadata[strings.ToLower(k[len(metaHeaderPrefix):])] = v[0]
+		}
+	}
+	return metadata
+}
+
+func (s *ObjectStorage) putObject(w http.ResponseWriter, r *http.Request, key string) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+		return
+	}
+	o := &storedObject{data: data, etag: etagOf(data), metadata: metadataOf(r.Header), modified: time.Now()}
+	s.objects[key] = o
+	w.Header().Set("ETag", o.etag)
+}
+
+func (s *ObjectStorage) getObject(w http.ResponseWriter, r *http.Request, key string) {
+	o, ok := s.objects[key]
+	if !ok {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+		return
+	}
+	for k, v := range o.metadata {
+		w.Header().Set(metaHeaderPrefix+k, v)
+	}
+	w.Header().Set("ETag", o.etag)
+	w.Header().Set("Last-Modified", o.modified.UTC().Format(http.TimeFormat))
+	w.Header().Set("Content-Length", strconv.Itoa(len(o.data)))
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(o.data)
+	}
+}
+
+type listBucketResult struct {
+	XMLName        xml.Name         `xml:"ListBucketResult"`
+	Name           string           `xml:"Name"`
+	Prefix         string           `xml:"Prefix"`
+	Marker         string           `xml:"Marker"`
+	NextMarker     string           `xml:"NextMarker,omitempty"`
+	MaxKeys        int              `xml:"MaxKeys"`
+	Delimiter      string           `xml:"Delimiter,omitempty"`
+	IsTruncated    bool             `xml:"IsTruncated"`
+	Contents       []listContent    `xml:"Contents"`
+	CommonPrefixes []commonPrefixes `xml:"CommonPrefixes"`
+}
+
+type listContent struct {
+	Key          string    `xml:"Key"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         int64     `xml:"Size"`
+}
+
+type commonPrefixes struct {
+	Prefix string `xml:"Prefix"`
+}
+
+func (s *ObjectStorage) listObjects(q map[string][]string) interface{} {
+	get := func(k string) string {
+		if v := q[k]; len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+	prefix, delimiter, marker := get("prefix"), get("delimiter"), get("marker")
+	maxKeys := s.MaxKeys
+	if n, err := strconv.Atoi(get("max-keys")); err == nil && n > 0 && n < maxKeys {
+		maxKeys = n
+	}
+
+	keys := make([]string, 0, len(s.objects))
+	for k := range s.objects {
+		if strings.HasPrefix(k, prefix) && k > marker {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	res := &listBucketResult{Name: s.Bucket, Prefix: prefix, Marker: marker, MaxKeys: maxKeys, Delimiter: delimiter}
+	seen := make(map[string]bool)
+	for _, k := range keys {
+		if len(res.Contents)+len(res.CommonPrefixes) == maxKeys {
+			res.IsTruncated = true
+			break
+		}
+		res.NextMarker = k
+		if delimiter != "" {
+			if i := strings.Index(k[len(prefix):], delimiter); i >= 0 {
+				p := k[:len(prefix)+i+len(delimiter)]
+				if !seen[p] {
+					seen[p] = true
+					res.CommonPrefixes = append(res.CommonPrefixes, commonPrefixes{Prefix: p})
+				}
+				continue
+			}
+		}
+		o := s.objects[k]
+		res.Contents = append(res.Contents, listContent{Key: k, LastModified: o.modified, ETag: o.etag, Size: int64(len(o.data))})
+	}
+	if !res.IsTruncated {
+		res.NextMarker = ""
+	}
+	return res
+}
+
+type deleteRequest struct {
+	Objects []struct {
+		Key string `xml:"Key"`
+	} `xml:"Object"`
+}
+
+type deleteResult struct {
+	XMLName xml.Name `xml:"DeleteResult"`
+}
+
+func (s *ObjectStorage) deleteObjects(body io.Reader) interface{} {
+	req := &deleteRequest{}
+	if err := xml.NewDecoder(body).Decode(req); err != nil {
+		return s3Error{http.StatusBadRequest, "MalformedXML"}
+	}
+	for _, o := range req.Objects {
+		delete(s.objects, o.Key)
+	}
+	return &deleteResult{}
+}
+
+type initiateResult struct {
+	XMLName  xml.Name `xml:"InitiateMultipartUploadResult"`
+	Bucket   string   `xml:"Bucket"`
+	Key      string   `xml:"Key"`
+	UploadId string   `xml:"UploadId"`
+}
+
+func (s *ObjectStorage) initiateUpload(key string, h http.Header) interface{} {
+	s.nextID++
+	id := fmt.Sprintf("upload-%d", s.nextID)
+	s.uploads[id] = &multipartUpload{key: key, metadata: metadataOf(h), initiated: time.Now(), parts: make(map[int]*storedPart)}
+	return &initiateResult{Bucket: s.Bucket, Key: key, UploadId: id}
+}
+
+func (s *ObjectStorage) uploadPart(w http.ResponseWriter, r *http.Request, key, uploadID string) {
+	u, ok := s.uploads[uploadID]
+	if !ok || u.key != key {
+		writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+		return
+	}
+	num, err := strconv.Atoi(r.URL.Query().Get("partNumber"))
+	if err != nil || num < 1 {
+		writeS3Error(w, http.StatusBadRequest, "InvalidArgument")
+		return
+	}
+	if s.partQuota == 0 {
+		writeS3Error(w, http.StatusBadRequest, "RequestTimeout")
+		return
+	}
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeS3Error(w, http.StatusBadRequest, "IncompleteBody")
+		return
+	}
+	if s.partQuota > 0 {
+		s.partQuota--
+	}
+	p := &storedPart{data: data, etag: etagOf(data)}
+	u.parts[num] = p
+	w.Header().Set("ETag", p.etag)
+}
+
+type completeRequest struct {
+	Parts []struct {
+		PartNumber int    `xml:"PartNumber"`
+		ETag       string `xml:"ETag"`
+	} `xml:"Part"`
+}
+
+type completeResult struct {
+	XMLName xml.Name `xml:"CompleteMultipartUploadResult"`
+	Bucket  string   `xml:"Bucket"`
+	Key     string   `xml:"Key"`
+	ETag    string   `xml:"ETag"`
+}
+
+func (s *ObjectStorage) completeUpload(body io.Reader, key, uploadID string) interface{} {
+	u, ok := s.uploads[uploadID]
+	if !ok || u.key != key {
+		return s3Error{http.StatusNotFound, "NoSuchUpload"}
+	}
+	req := &completeRequest{}
+	if err := xml.NewDecoder(body).Decode(req); err != nil {
+		return s3Error{http.StatusBadRequest, "MalformedXML"}
+	}
+	var buf bytes.Buffer
+	for i, p := range req.Parts {
+		stored, ok := u.parts[p.PartNumber]
+		if !ok || stored.etag != "\""+strings.Trim(p.ETag, "\"")+"\"" {
+			return s3Error{http.StatusBadRequest, "InvalidPart"}
+		}
+		if i > 0 && p.PartNumber <= req.Parts[i-1].PartNumber {
+			return s3Error{http.StatusBadRequest, "InvalidPartOrder"}
+		}
+		buf.Write(stored.data)
+	}
+	data := buf.Bytes()
+	o := &storedObject{data: data, etag: etagOf(data), metadata: u.metadata, modified: time.Now()}
+	s.objects[key] = o
+	delete(s.uploads, uploadID)
+	return &completeResult{Bucket: s.Bucket, Key: key, ETag: o.etag}
+}
+
+type listPartsResult struct {
+	XMLName     xml.Name   `xml:"ListPartsResult"`
+	Bucket      string     `xml:"Bucket"`
+	Key         string     `xml:"Key"`
+	UploadId    string     `xml:"UploadId"`
+	IsTruncated bool       `xml:"IsTruncated"`
+	Parts       []listPart `xml:"Part"`
+}
+
+type listPart struct {
+	PartNumber   int       `xml:"PartNumber"`
+	LastModified time.Time `xml:"LastModified"`
+	ETag         string    `xml:"ETag"`
+	Size         int64     `xml:"Size"`
+}
+
+func (s *ObjectStorage) listParts(key, uploadID string) interface{} {
+	u, ok := s.uploads[uploadID]
+	if !ok || u.key != key {
+		return s3Error{http.StatusNotFound, "NoSuchUpload"}
+	}
+	res := &listPartsResult{Bucket: s.Bucket, Key: key, UploadId: uploadID}
+	for num, p := range u.parts {
+		res.Parts = append(res.Parts, listPart{PartNumber: num, LastModified: u.initiated, ETag: p.etag, Size: int64(len(p.data))})
+	}
+	sort.Slice(res.Parts, func(i, j int) bool {
+		return res.Parts[i].PartNumber < res.Parts[j].PartNumber
+	})
+	return res
+}
+
+type listUploadsResult struct {
+	XMLName xml.Name     `xml:"ListMultipartUploadsResult"`
+	Bucket  string       `xml:"Bucket"`
+	Prefix  string       `xml:"Prefix"`
+	Uploads []listUpload `xml:"Upload"`
+}
+
+type listUpload struct {
+	Key       string    `xml:"Key"`
+	UploadId  string    `xml:"UploadId"`
+	Initiated time.Time `xml:"Initiated"`
+}
+
+func (s *ObjectStorage) listUploads(prefix string) interface{} {
+	res := &listUploadsResult{Bucket: s.Bucket, Prefix: prefix}
+	for id, u := range s.uploads {
+		if strings.HasPrefix(u.key, prefix) {
+			res.Uploads = append(res.Uploads, listUpload{Key: u.key, UploadId: id, Initiated: u.initiated})
+		}
+	}
+	sort.Slice(res.Uploads, func(i, j int) bool {
+		return res.Uploads[i].UploadId < res.Uploads[j].UploadId
+	})
+	return res
+}
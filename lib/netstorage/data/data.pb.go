@@ -1492,6 +1492,122 @@ func (m *TransferLeadershipResponse) GetErr() string {
 	return ""
 }
 
+type TagValuesSketchResponse struct {
+	Sketches             map[string][]byte `protobuf:"bytes,1,rep,name=Sketches" json:"Sketches,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Err                  *string           `protobuf:"bytes,2,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TagValuesSketchResponse) Reset()         { *m = TagValuesSketchResponse{} }
+func (m *TagValuesSketchResponse) String() string { return proto.CompactTextString(m) }
+func (*TagValuesSketchResponse) ProtoMessage()    {}
+func (*TagValuesSketchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aaddb15866ce618, []int{29}
+}
+func (m *TagValuesSketchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TagValuesSketchResponse.Unmarshal(m, b)
+}
+func (m *TagValuesSketchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TagValuesSketchResponse.Marshal(b, m, deterministic)
+}
+func (m *TagValuesSketchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TagValuesSketchResponse.Merge(m, src)
+}
+func (m *TagValuesSketchResponse) XXX_Size() int {
+	return xxx_messageInfo_TagValuesSketchResponse.Size(m)
+}
+func (m *TagValuesSketchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TagValuesSketchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TagValuesSketchResponse proto.InternalMessageInfo
+
+func (m *TagValuesSketchResponse) GetSketches() map[string][]byte {
+	if m != nil {
+		return m.Sketches
+	}
+	return nil
+}
+
+func (m *TagValuesSketchResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
+	}
+	return ""
+}
+
+type SeriesQuotaResponse struct {
+	Series               map[string]uint64 `protobuf:"bytes,1,rep,name=Series" json:"Series,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	Limit                *uint64           `protobuf:"varint,2,opt,name=Limit" json:"Limit,omitempty"`
+	DatabaseSeries       *uint64           `protobuf:"varint,3,opt,name=DatabaseSeries" json:"DatabaseSeries,omitempty"`
+	DatabaseLimit        *uint64           `protobuf:"varint,4,opt,name=DatabaseLimit" json:"DatabaseLimit,omitempty"`
+	Err                  *string           `protobuf:"bytes,5,opt,name=Err" json:"Err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SeriesQuotaResponse) Reset()         { *m = SeriesQuotaResponse{} }
+func (m *SeriesQuotaResponse) String() string { return proto.CompactTextString(m) }
+func (*SeriesQuotaResponse) ProtoMessage()    {}
+func (*SeriesQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2aaddb15866ce618, []int{30}
+}
+func (m *SeriesQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SeriesQuotaResponse.Unmarshal(m, b)
+}
+func (m *SeriesQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SeriesQuotaResponse.Marshal(b, m, deterministic)
+}
+func (m *SeriesQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeriesQuotaResponse.Merge(m, src)
+}
+func (m *SeriesQuotaResponse) XXX_Size() int {
+	return xxx_messageInfo_SeriesQuotaResponse.Size(m)
+}
+func (m *SeriesQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeriesQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SeriesQuotaResponse proto.InternalMessageInfo
+
+func (m *SeriesQuotaResponse) GetSeries() map[string]uint64 {
+	if m != nil {
+		return m.Series
+	}
+	return nil
+}
+
+func (m *SeriesQuotaResponse) GetLimit() uint64 {
+	if m != nil && m.Limit != nil {
+		return *m.Limit
+	}
+	return 0
+}
+
+func (m *SeriesQuotaResponse) GetDatabaseSeries() uint64 {
+	if m != nil && m.DatabaseSeries != nil {
+		return *m.DatabaseSeries
+	}
+	return 0
+}
+
+func (m *SeriesQuotaResponse) GetDatabaseLimit() uint64 {
+	if m != nil && m.DatabaseLimit != nil {
+		return *m.DatabaseLimit
+	}
+	return 0
+}
+
+func (m *SeriesQuotaResponse) GetErr() string {
+	if m != nil && m.Err != nil {
+		return *m.Err
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SeriesKeysRequest)(nil), "netstorage.data.SeriesKeysRequest")
 	proto.RegisterType((*SeriesKeysResponse)(nil), "netstorage.data.SeriesKeysResponse")
@@ -1525,80 +1641,92 @@ func init() {
 	proto.RegisterType((*RaftMessagesResponse)(nil), "netstorage.data.RaftMessagesResponse")
 	proto.RegisterType((*TransferLeadershipRequest)(nil), "netstorage.data.TransferLeadershipRequest")
 	proto.RegisterType((*TransferLeadershipResponse)(nil), "netstorage.data.TransferLeadershipResponse")
+	proto.RegisterType((*TagValuesSketchResponse)(nil), "netstorage.data.TagValuesSketchResponse")
+	proto.RegisterMapType((map[string][]byte)(nil), "netstorage.data.TagValuesSketchResponse.SketchesEntry")
+	proto.RegisterType((*SeriesQuotaResponse)(nil), "netstorage.data.SeriesQuotaResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "netstorage.data.SeriesQuotaResponse.SeriesEntry")
 }
 
 func init() { proto.RegisterFile("lib/netstorage/data/data.proto", fileDescriptor_2aaddb15866ce618) }
 
 var fileDescriptor_2aaddb15866ce618 = []byte{
-	// 1117 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcd, 0x8a, 0x1b, 0xc7,
-	0x13, 0x67, 0x46, 0x1f, 0x7f, 0xab, 0x64, 0xc9, 0xbb, 0xb3, 0x1f, 0xcc, 0x5f, 0xeb, 0x6c, 0xc4,
-	0x90, 0x83, 0x62, 0x16, 0x09, 0x16, 0x42, 0x1c, 0x07, 0x4c, 0xac, 0x0f, 0x8c, 0xb0, 0x95, 0xc8,
-	0xad, 0x25, 0x07, 0x13, 0x02, 0xad, 0x9d, 0x5e, 0x6d, 0xe3, 0xd1, 0xcc, 0xa4, 0xbb, 0x65, 0xaf,
-	0x20, 0x87, 0x5c, 0x73, 0xf4, 0x0b, 0xe4, 0x14, 0xf2, 0x16, 0x79, 0x81, 0x3c, 0x55, 0xe8, 0x8f,
-	0x99, 0x69, 0x69, 0x57, 0x31, 0xce, 0x25, 0x17, 0xd1, 0x55, 0xd3, 0x55, 0xf5, 0xab, 0xaa, 0x5f,
-	0x55, 0x0b, 0x4e, 0x23, 0x3a, 0xef, 0xc5, 0x44, 0x70, 0x91, 0x30, 0xbc, 0x20, 0xbd, 0x10, 0x0b,
-	0xac, 0x7e, 0xba, 0x29, 0x4b, 0x44, 0xe2, 0x3d, 0x28, 0xbe, 0x75, 0xa5, 0xba, 0x75, 0x26, 0x0d,
-	0x56, 0x82, 0x46, 0xbd, 0x88, 0x5e, 0x09, 0x12, 0xf6, 0x68, 0x7c, 0x15, 0xad, 0x6e, 0x7a, 0x4b,
-	0x22, 0x70, 0x4f, 0xd9, 0xa8, 0xa3, 0x36, 0x0f, 0xde, 0x3b, 0xb0, 0x3f, 0x23, 0x8c, 0x12, 0xfe,
-	0x82, 0xac, 0x39, 0x22, 0x3f, 0xad, 0x08, 0x17, 0x5e, 0x13, 0xdc, 0xe1, 0xdc, 0x77, 0xda, 0x6e,
-	0xa7, 0x86, 0xdc, 0xe1, 0xdc, 0x3b, 0x84, 0xca, 0x54, 0x8c, 0x87, 0xdc, 0x77, 0xdb, 0xa5, 0x4e,
-	0x03, 0x69, 0xc1, 0x0b, 0xe0, 0xfe, 0x84, 0x60, 0xbe, 0x62, 0x64, 0x49, 0x62, 0xc1, 0xfd, 0x52,
-	0xbb, 0xd4, 0xa9, 0xa1, 0x0d, 0x9d, 0xf7, 0x10, 0x6a, 0x97, 0x49, 0x1c, 0x52, 0x41, 0x93, 0xd8,
-	0x2f, 0xb7, 0x9d, 0x4e, 0x0d, 0x15, 0x0a, 0xe9, 0x77, 0x74, 0x83, 0x2f, 0x85, 0x5f, 0x69, 0x3b,
-	0x9d, 0x7b, 0x48, 0x0b, 0xc1, 0x53, 0xf0, 0x6c, 0x48, 0x3c, 0x4d, 0x62, 0x4e, 0xbc, 0x63, 0xa8,
-	0x6a, 0xad, 0xef, 0xa8, 0x38, 0x46, 0xf2, 0xf6, 0xa0, 0x34, 0x62, 0xcc, 0x77, 0x95, 0x6f, 0x79,
-	0x0c, 0x7e, 0x06, 0x6f, 0x76, 0x9d, 0xbc, 0xbb, 0xc0, 0x8b, 0xff, 0x20, 0xa7, 0xe0, 0x19, 0x1c,
-	0x6c, 0x44, 0x37, 0xf0, 0x7d, 0xf8, 0x9f, 0x51, 0x19, 0xfc, 0x99, 0x78, 0x47, 0x02, 0xcf, 0xe1,
-	0x68, 0xc0, 0x08, 0x16, 0x64, 0x88, 0x05, 0xee, 0x63, 0x4e, 0x76, 0xe5, 0xd0, 0x04, 0x37, 0x15,
-	0xbe, 0xdb, 0x76, 0x3b, 0x0d, 0xe4, 0xa6, 0xea, 0x3b, 0x4b, 0xfd, 0x92, 0xfe, 0xce, 0xd2, 0xe0,
-	0x11, 0x1c, 0x6f, 0x3b, 0x32, 0x70, 0x4c, 0x50, 0xa7, 0x08, 0xfa, 0x9b, 0x03, 0xcd, 0xd9, 0x9a,
-	0x0f, 0x04, 0x8b, 0xb2, 0x70, 0x7b, 0x50, 0x9a, 0x24, 0xa1, 0x89, 0x27, 0x8f, 0xde, 0x37, 0x50,
-	0x99, 0x62, 0x86, 0x97, 0xaa, 0x68, 0xf5, 0xf3, 0x47, 0xdd, 0x2d, 0xf6, 0x75, 0x37, 0x3d, 0x74,
-	0xd5, 0xe5, 0x51, 0x2c, 0xd8, 0x1a, 0x69, 0xc3, 0xd6, 0x63, 0x80, 0x42, 0x29, 0x23, 0xbc, 0x21,
-	0xeb, 0x0c, 0xc6, 0x1b, 0xb2, 0x96, 0x6d, 0x79, 0x8b, 0xa3, 0x15, 0x31, 0xf5, 0xd0, 0xc2, 0x13,
-	0xf7, 0xb1, 0x13, 0xfc, 0xee, 0xc0, 0x83, 0xdc, 0xfd, 0x76, 0x1a, 0xae, 0x49, 0xc3, 0x1b, 0x42,
-	0x15, 0x11, 0xbe, 0x8a, 0x84, 0x81, 0x78, 0xb6, 0x1b, 0xa2, 0xf6, 0xd1, 0xd5, 0xd7, 0x35, 0x48,
-	0x63, 0xdb, 0xfa, 0x0a, 0xea, 0x96, 0xfa, 0xa3, 0x60, 0xa6, 0xd0, 0x7a, 0x4e, 0xc4, 0xec, 0x1a,
-	0xb3, 0x70, 0x96, 0x46, 0x54, 0x4c, 0x13, 0x1a, 0x8b, 0x0d, 0x16, 0xf6, 0xf3, 0x0e, 0xf6, 0x3d,
-	0x0f, 0xca, 0x92, 0x78, 0xa6, 0x87, 0xea, 0x2c, 0xa9, 0xa2, 0xcc, 0xc7, 0x43, 0xd5, 0xca, 0x32,
-	0xca, 0x44, 0x19, 0x75, 0x1c, 0xde, 0x10, 0xee, 0x97, 0xdb, 0xa5, 0x4e, 0x09, 0x69, 0x21, 0x78,
-	0x05, 0x27, 0x77, 0x46, 0x34, 0x35, 0x6a, 0x43, 0xdd, 0x52, 0x1b, 0xf6, 0xd9, 0xaa, 0x3b, 0x18,
-	0xf8, 0xde, 0x81, 0xc6, 0x90, 0x44, 0x44, 0x90, 0x5d, 0xc0, 0x9b, 0xe0, 0xa2, 0xd4, 0x98, 0xb8,
-	0x28, 0x55, 0x5c, 0xe1, 0xc2, 0x2f, 0x69, 0x1f, 0x13, 0x2e, 0xbc, 0x16, 0xdc, 0x33, 0xb8, 0x35,
-	0xde, 0x32, 0xca, 0x65, 0xef, 0x14, 0x40, 0xbb, 0xbf, 0x58, 0xa7, 0xc4, 0xaf, 0xb4, 0xdd, 0x4e,
-	0x05, 0x59, 0x1a, 0x53, 0x96, 0xd0, 0xaf, 0xb6, 0x1d, 0x53, 0x96, 0x30, 0x08, 0xa0, 0x99, 0x41,
-	0xda, 0x49, 0xe2, 0x3f, 0x1d, 0x38, 0x34, 0xd3, 0xf7, 0xbd, 0xec, 0xc8, 0x47, 0x4e, 0xff, 0x17,
-	0xc5, 0x90, 0x96, 0x14, 0x7b, 0x4e, 0x6e, 0xb1, 0x67, 0x82, 0xd3, 0x6c, 0xb4, 0xf3, 0x09, 0x7e,
-	0x08, 0xb5, 0xc1, 0xf6, 0x42, 0x18, 0xd8, 0x4b, 0xee, 0x25, 0x5d, 0x52, 0xbd, 0xe4, 0x2a, 0x48,
-	0x0b, 0xc5, 0xea, 0xab, 0xda, 0xab, 0x6f, 0x0e, 0x47, 0x5b, 0xf0, 0x77, 0xa5, 0xea, 0x7d, 0x09,
-	0x55, 0x7d, 0xc7, 0x10, 0xfd, 0xd3, 0x5b, 0x50, 0x73, 0x2f, 0xb3, 0x88, 0x5e, 0x12, 0x64, 0xae,
-	0x07, 0x7d, 0x80, 0x22, 0x09, 0xc9, 0x0e, 0x6b, 0xb9, 0x99, 0x0a, 0xd9, 0x2a, 0xd9, 0x0b, 0x55,
-	0x11, 0x57, 0x11, 0x47, 0x9d, 0x83, 0x1f, 0xa1, 0xb9, 0xe9, 0xfd, 0xdf, 0xf9, 0x91, 0x4b, 0xdd,
-	0x24, 0xa1, 0x17, 0x6d, 0x86, 0xf1, 0x2f, 0x07, 0x7c, 0x55, 0x91, 0x01, 0x66, 0x21, 0x8d, 0x71,
-	0x44, 0xc5, 0x3a, 0xaf, 0xc5, 0x0f, 0x50, 0xb7, 0xd4, 0x8a, 0xd0, 0xf5, 0xf3, 0x27, 0xb7, 0xd2,
-	0xdf, 0x65, 0xdf, 0xb5, 0x74, 0x7a, 0xea, 0x6d, 0x77, 0xb7, 0x87, 0xa1, 0xf5, 0x14, 0xf6, 0xb6,
-	0x4d, 0x3e, 0xb4, 0x11, 0xca, 0xf6, 0x46, 0xf8, 0xc5, 0x81, 0xda, 0x54, 0x64, 0x4c, 0x3c, 0x01,
-	0x77, 0xaa, 0xeb, 0x53, 0x3f, 0xaf, 0xeb, 0x57, 0xb8, 0x3b, 0x9c, 0x4f, 0x05, 0x72, 0xa7, 0x42,
-	0x55, 0x91, 0x2e, 0x18, 0x36, 0x83, 0xe1, 0xaa, 0xc1, 0xb0, 0x55, 0xb2, 0x8a, 0xdf, 0xa5, 0xe3,
-	0xd0, 0x6c, 0x06, 0x75, 0x96, 0x56, 0xcf, 0x22, 0xfa, 0x96, 0x0c, 0x92, 0x38, 0x1e, 0x87, 0x8a,
-	0x81, 0x65, 0x64, 0xab, 0x82, 0x53, 0x00, 0x89, 0x60, 0xe7, 0xdc, 0xfc, 0xe1, 0xc0, 0xfd, 0x57,
-	0x2b, 0xc2, 0xd6, 0xa3, 0x1b, 0x32, 0x8e, 0xaf, 0x12, 0xb9, 0x83, 0x94, 0x3c, 0x1e, 0x2a, 0xa8,
-	0x65, 0x94, 0x89, 0x12, 0xc0, 0x4c, 0x2c, 0xf5, 0xab, 0x53, 0x43, 0xea, 0x2c, 0x47, 0x5d, 0xbe,
-	0x30, 0x73, 0xcc, 0x89, 0x79, 0x7d, 0x72, 0x59, 0x0e, 0x47, 0x9f, 0x2c, 0x68, 0x7c, 0x41, 0x97,
-	0xc4, 0x2f, 0xb7, 0xdd, 0x4e, 0x09, 0x15, 0x0a, 0x69, 0x89, 0x56, 0xf1, 0x4c, 0x60, 0x91, 0xad,
-	0x81, 0x5c, 0xce, 0x77, 0x63, 0xb5, 0xd8, 0x8d, 0xc1, 0x6b, 0xfd, 0xba, 0x4a, 0x30, 0xd4, 0x1a,
-	0x8f, 0x01, 0x34, 0x6c, 0xf8, 0xdc, 0x90, 0xe2, 0x93, 0x5b, 0xa4, 0xb0, 0x6f, 0xa1, 0x4d, 0x9b,
-	0xe0, 0x0c, 0xf6, 0x5e, 0xd0, 0x28, 0x52, 0xca, 0xac, 0x5b, 0x3b, 0xeb, 0x10, 0x8c, 0x60, 0xdf,
-	0xba, 0x5d, 0xbc, 0xf2, 0x23, 0xc6, 0x06, 0x49, 0x48, 0x54, 0x75, 0x1b, 0x28, 0x13, 0x25, 0xd3,
-	0x47, 0x8c, 0x4d, 0xf8, 0xc2, 0x30, 0xcb, 0x48, 0x41, 0x17, 0x0e, 0x67, 0x64, 0xc1, 0xc8, 0x02,
-	0x0b, 0xf2, 0x6d, 0x12, 0xe6, 0xfb, 0xf6, 0x18, 0xaa, 0x52, 0x1c, 0x87, 0x26, 0xae, 0x91, 0x82,
-	0xcf, 0xe1, 0x68, 0xeb, 0xfe, 0xce, 0xa6, 0x52, 0x38, 0x40, 0xf8, 0x4a, 0x4c, 0x08, 0xe7, 0x78,
-	0x51, 0xac, 0x42, 0xbb, 0x59, 0xfa, 0x76, 0xd1, 0xac, 0x6c, 0xef, 0xba, 0xc5, 0xde, 0x95, 0x7f,
-	0x89, 0x6c, 0x37, 0x6a, 0xc5, 0xdf, 0x47, 0x1b, 0x3a, 0x99, 0xc5, 0x66, 0xa8, 0xe2, 0x4f, 0x9b,
-	0xc9, 0xda, 0xd9, 0xc8, 0xfa, 0x57, 0x07, 0xfe, 0x7f, 0xc1, 0x70, 0xcc, 0xaf, 0x08, 0x7b, 0x49,
-	0x70, 0x48, 0x18, 0xbf, 0xa6, 0xe9, 0x07, 0x72, 0xb7, 0xd0, 0xb9, 0x39, 0xba, 0x7f, 0xa2, 0xde,
-	0x67, 0xd0, 0x88, 0xc9, 0xbb, 0x09, 0xe6, 0x82, 0x30, 0x65, 0x58, 0x56, 0x86, 0x9b, 0xca, 0xa0,
-	0x0b, 0xad, 0xbb, 0xa0, 0xec, 0x2a, 0x6b, 0xff, 0xe0, 0xf5, 0x7e, 0xf7, 0xeb, 0x2d, 0x5e, 0xfd,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0x76, 0x49, 0x48, 0x40, 0xa7, 0x0b, 0x00, 0x00,
+	// 1236 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x5d, 0x8f, 0xdb, 0x44,
+	0x17, 0x96, 0xed, 0x24, 0x6f, 0x73, 0xb2, 0x49, 0xb7, 0xde, 0xb6, 0xaf, 0x49, 0x4b, 0x89, 0x2c,
+	0x84, 0x42, 0x55, 0x39, 0x68, 0x25, 0xa0, 0x1f, 0x52, 0x45, 0xf3, 0xa1, 0x12, 0xb5, 0x81, 0x74,
+	0xb2, 0xe2, 0xa2, 0x42, 0x48, 0x93, 0xf5, 0x6c, 0xd6, 0x5a, 0xc7, 0x36, 0xe3, 0x49, 0xbb, 0x91,
+	0xb8, 0xe0, 0x96, 0x3b, 0xfa, 0x07, 0xb8, 0x42, 0xfc, 0x0a, 0xf8, 0x03, 0xfc, 0x2a, 0x34, 0x1f,
+	0xb6, 0xc7, 0xc9, 0xa6, 0xab, 0xe5, 0x86, 0x9b, 0xc8, 0xe7, 0xc9, 0x9c, 0x33, 0xcf, 0x39, 0x73,
+	0xce, 0x33, 0x03, 0xf7, 0xc2, 0x60, 0xde, 0x8b, 0x08, 0x4b, 0x59, 0x4c, 0xf1, 0x82, 0xf4, 0x7c,
+	0xcc, 0xb0, 0xf8, 0xf1, 0x12, 0x1a, 0xb3, 0xd8, 0xbe, 0x5e, 0xfc, 0xe7, 0x71, 0xb8, 0xfd, 0x80,
+	0x3b, 0xac, 0x58, 0x10, 0xf6, 0xc2, 0xe0, 0x84, 0x11, 0xbf, 0x17, 0x44, 0x27, 0xe1, 0xea, 0xbc,
+	0xb7, 0x24, 0x0c, 0xf7, 0x84, 0x8f, 0xf8, 0x94, 0xee, 0xee, 0x3b, 0x03, 0x6e, 0xcc, 0x08, 0x0d,
+	0x48, 0xfa, 0x82, 0xac, 0x53, 0x44, 0x7e, 0x5c, 0x91, 0x94, 0xd9, 0x2d, 0x30, 0x87, 0x73, 0xc7,
+	0xe8, 0x98, 0xdd, 0x3a, 0x32, 0x87, 0x73, 0xfb, 0x26, 0x54, 0xa7, 0x6c, 0x3c, 0x4c, 0x1d, 0xb3,
+	0x63, 0x75, 0x9b, 0x48, 0x1a, 0xb6, 0x0b, 0x7b, 0x13, 0x82, 0xd3, 0x15, 0x25, 0x4b, 0x12, 0xb1,
+	0xd4, 0xb1, 0x3a, 0x56, 0xb7, 0x8e, 0x4a, 0x98, 0x7d, 0x17, 0xea, 0xc7, 0x71, 0xe4, 0x07, 0x2c,
+	0x88, 0x23, 0xa7, 0xd2, 0x31, 0xba, 0x75, 0x54, 0x00, 0x3c, 0xee, 0xe8, 0x1c, 0x1f, 0x33, 0xa7,
+	0xda, 0x31, 0xba, 0xd7, 0x90, 0x34, 0xdc, 0xa7, 0x60, 0xeb, 0x94, 0xd2, 0x24, 0x8e, 0x52, 0x62,
+	0xdf, 0x86, 0x9a, 0x44, 0x1d, 0x43, 0xec, 0xa3, 0x2c, 0x7b, 0x1f, 0xac, 0x11, 0xa5, 0x8e, 0x29,
+	0x62, 0xf3, 0x4f, 0xf7, 0x27, 0xb0, 0x67, 0xa7, 0xf1, 0xdb, 0x23, 0xbc, 0xf8, 0x0f, 0x72, 0x72,
+	0x9f, 0xc1, 0x41, 0x69, 0x77, 0x45, 0xdf, 0x81, 0xff, 0x29, 0x48, 0xf1, 0xcf, 0xcc, 0x0b, 0x12,
+	0x78, 0x0e, 0xb7, 0x06, 0x94, 0x60, 0x46, 0x86, 0x98, 0xe1, 0x3e, 0x4e, 0xc9, 0xae, 0x1c, 0x5a,
+	0x60, 0x26, 0xcc, 0x31, 0x3b, 0x66, 0xb7, 0x89, 0xcc, 0x44, 0xfc, 0x4f, 0x13, 0xc7, 0x92, 0xff,
+	0xd3, 0xc4, 0xbd, 0x0f, 0xb7, 0x37, 0x03, 0x29, 0x3a, 0x6a, 0x53, 0xa3, 0xd8, 0xf4, 0x37, 0x03,
+	0x5a, 0xb3, 0x75, 0x3a, 0x60, 0x34, 0xcc, 0xb6, 0xdb, 0x07, 0x6b, 0x12, 0xfb, 0x6a, 0x3f, 0xfe,
+	0x69, 0x7f, 0x05, 0xd5, 0x29, 0xa6, 0x78, 0x29, 0x8a, 0xd6, 0x38, 0xbc, 0xef, 0x6d, 0x74, 0x9f,
+	0x57, 0x8e, 0xe0, 0x89, 0xc5, 0xa3, 0x88, 0xd1, 0x35, 0x92, 0x8e, 0xed, 0x87, 0x00, 0x05, 0xc8,
+	0x77, 0x38, 0x23, 0xeb, 0x8c, 0xc6, 0x19, 0x59, 0xf3, 0x63, 0x79, 0x83, 0xc3, 0x15, 0x51, 0xf5,
+	0x90, 0xc6, 0x63, 0xf3, 0xa1, 0xe1, 0xfe, 0x6e, 0xc0, 0xf5, 0x3c, 0xfc, 0x66, 0x1a, 0xa6, 0x4a,
+	0xc3, 0x1e, 0x42, 0x0d, 0x91, 0x74, 0x15, 0x32, 0x45, 0xf1, 0xc1, 0x6e, 0x8a, 0x32, 0x86, 0x27,
+	0x97, 0x4b, 0x92, 0xca, 0xb7, 0xfd, 0x08, 0x1a, 0x1a, 0x7c, 0x25, 0x9a, 0x09, 0xb4, 0x9f, 0x13,
+	0x36, 0x3b, 0xc5, 0xd4, 0x9f, 0x25, 0x61, 0xc0, 0xa6, 0x71, 0x10, 0xb1, 0x52, 0x17, 0xf6, 0xf3,
+	0x13, 0xec, 0xdb, 0x36, 0x54, 0x78, 0xe3, 0xa9, 0x33, 0x14, 0xdf, 0xbc, 0x55, 0x84, 0xfb, 0x78,
+	0x28, 0x8e, 0xb2, 0x82, 0x32, 0x93, 0xef, 0x3a, 0xf6, 0xcf, 0x49, 0xea, 0x54, 0x3a, 0x56, 0xd7,
+	0x42, 0xd2, 0x70, 0x5f, 0xc1, 0x9d, 0x0b, 0x77, 0x54, 0x35, 0xea, 0x40, 0x43, 0x83, 0x55, 0xf7,
+	0xe9, 0xd0, 0x05, 0x1d, 0xf8, 0xce, 0x80, 0xe6, 0x90, 0x84, 0x84, 0x91, 0x5d, 0xc4, 0x5b, 0x60,
+	0xa2, 0x44, 0xb9, 0x98, 0x28, 0x11, 0xbd, 0x92, 0x32, 0xc7, 0x92, 0x31, 0x26, 0x29, 0xb3, 0xdb,
+	0x70, 0x4d, 0xf1, 0x96, 0x7c, 0x2b, 0x28, 0xb7, 0xed, 0x7b, 0x00, 0x32, 0xfc, 0xd1, 0x3a, 0x21,
+	0x4e, 0xb5, 0x63, 0x76, 0xab, 0x48, 0x43, 0x54, 0x59, 0x7c, 0xa7, 0xd6, 0x31, 0x54, 0x59, 0x7c,
+	0xd7, 0x85, 0x56, 0x46, 0x69, 0x67, 0x13, 0xff, 0x65, 0xc0, 0x4d, 0x35, 0x7d, 0xdf, 0xf1, 0x13,
+	0xb9, 0xe2, 0xf4, 0x7f, 0x5e, 0x0c, 0xa9, 0x25, 0xba, 0xe7, 0xce, 0x56, 0xf7, 0x4c, 0x70, 0x92,
+	0x8d, 0x76, 0x3e, 0xc1, 0x77, 0xa1, 0x3e, 0xd8, 0x14, 0x84, 0x81, 0x2e, 0x72, 0x2f, 0x83, 0x65,
+	0x20, 0x45, 0xae, 0x8a, 0xa4, 0x51, 0x48, 0x5f, 0x4d, 0x97, 0xbe, 0x39, 0xdc, 0xda, 0xa0, 0xbf,
+	0x2b, 0x55, 0xfb, 0x4b, 0xa8, 0xc9, 0x35, 0xaa, 0xd1, 0x3f, 0xda, 0xa2, 0x9a, 0x47, 0x99, 0x85,
+	0xc1, 0x31, 0x41, 0x6a, 0xb9, 0xdb, 0x07, 0x28, 0x92, 0xe0, 0xdd, 0xa1, 0x89, 0x9b, 0xaa, 0x90,
+	0x0e, 0xf1, 0xb3, 0x10, 0x15, 0x31, 0x45, 0xe3, 0x88, 0x6f, 0xf7, 0x07, 0x68, 0x95, 0xa3, 0xff,
+	0xbb, 0x38, 0x5c, 0xd4, 0x55, 0x12, 0x52, 0x68, 0x33, 0x8e, 0x7f, 0x1b, 0xe0, 0x88, 0x8a, 0x0c,
+	0x30, 0xf5, 0x83, 0x08, 0x87, 0x01, 0x5b, 0xe7, 0xb5, 0xf8, 0x1e, 0x1a, 0x1a, 0x2c, 0x1a, 0xba,
+	0x71, 0xf8, 0x78, 0x2b, 0xfd, 0x5d, 0xfe, 0x9e, 0x86, 0xc9, 0xa9, 0xd7, 0xc3, 0x6d, 0x0f, 0x43,
+	0xfb, 0x29, 0xec, 0x6f, 0xba, 0x5c, 0xa6, 0x08, 0x15, 0x5d, 0x11, 0x7e, 0x36, 0xa0, 0x3e, 0x65,
+	0x59, 0x27, 0xde, 0x01, 0x73, 0x2a, 0xeb, 0xd3, 0x38, 0x6c, 0xc8, 0x5b, 0xd8, 0x1b, 0xce, 0xa7,
+	0x0c, 0x99, 0x53, 0x26, 0xaa, 0x18, 0x2c, 0x28, 0x56, 0x83, 0x61, 0x8a, 0xc1, 0xd0, 0x21, 0x5e,
+	0xc5, 0x6f, 0x93, 0xb1, 0xaf, 0x94, 0x41, 0x7c, 0x73, 0xaf, 0x67, 0x61, 0xf0, 0x86, 0x0c, 0xe2,
+	0x28, 0x1a, 0xfb, 0xa2, 0x03, 0x2b, 0x48, 0x87, 0xdc, 0x7b, 0x00, 0x9c, 0xc1, 0xce, 0xb9, 0xf9,
+	0xc3, 0x80, 0xbd, 0x57, 0x2b, 0x42, 0xd7, 0xa3, 0x73, 0x32, 0x8e, 0x4e, 0x62, 0xae, 0x41, 0xc2,
+	0x1e, 0x0f, 0x05, 0xd5, 0x0a, 0xca, 0x4c, 0x4e, 0x60, 0xc6, 0x96, 0xf2, 0xd6, 0xa9, 0x23, 0xf1,
+	0xcd, 0x47, 0x9d, 0xdf, 0x30, 0x73, 0x9c, 0x12, 0x75, 0xfb, 0xe4, 0x36, 0x1f, 0x8e, 0x3e, 0x59,
+	0x04, 0xd1, 0x51, 0xb0, 0x24, 0x4e, 0xa5, 0x63, 0x76, 0x2d, 0x54, 0x00, 0xdc, 0x13, 0xad, 0xa2,
+	0x19, 0xc3, 0x2c, 0x93, 0x81, 0xdc, 0xce, 0xb5, 0xb1, 0x56, 0x68, 0xa3, 0xfb, 0x5a, 0xde, 0xae,
+	0x9c, 0x4c, 0xa0, 0x8d, 0xc7, 0x00, 0x9a, 0x3a, 0xfd, 0x54, 0x35, 0xc5, 0x87, 0x5b, 0x4d, 0xa1,
+	0xaf, 0x42, 0x65, 0x1f, 0xf7, 0x01, 0xec, 0xbf, 0x08, 0xc2, 0x50, 0x80, 0xd9, 0x69, 0xed, 0xac,
+	0x83, 0x3b, 0x82, 0x1b, 0xda, 0xea, 0xe2, 0x96, 0x1f, 0x51, 0x3a, 0x88, 0x7d, 0x22, 0xaa, 0xdb,
+	0x44, 0x99, 0xc9, 0x3b, 0x7d, 0x44, 0xe9, 0x24, 0x5d, 0xa8, 0xce, 0x52, 0x96, 0xeb, 0xc1, 0xcd,
+	0x19, 0x59, 0x50, 0xb2, 0xc0, 0x8c, 0x7c, 0x13, 0xfb, 0xb9, 0xde, 0xde, 0x86, 0x1a, 0x37, 0xc7,
+	0xbe, 0xda, 0x57, 0x59, 0xee, 0xa7, 0x70, 0x6b, 0x63, 0xfd, 0xce, 0x43, 0x0d, 0xe0, 0x00, 0xe1,
+	0x13, 0x36, 0x21, 0x69, 0x8a, 0x17, 0x85, 0x14, 0xea, 0x87, 0x25, 0x57, 0x17, 0x87, 0x95, 0xe9,
+	0xae, 0x59, 0xe8, 0x2e, 0x7f, 0x12, 0xe9, 0x61, 0x84, 0xc4, 0xef, 0xa1, 0x12, 0xc6, 0xb3, 0x28,
+	0x6f, 0x55, 0x3c, 0xda, 0x54, 0xd6, 0x46, 0x29, 0xeb, 0x5f, 0x0c, 0xf8, 0xe0, 0x88, 0xe2, 0x28,
+	0x3d, 0x21, 0xf4, 0x25, 0xc1, 0x3e, 0xa1, 0xe9, 0x69, 0x90, 0x5c, 0x92, 0xbb, 0xc6, 0xce, 0xcc,
+	0xd9, 0xbd, 0xaf, 0xf5, 0x3e, 0x86, 0x66, 0x44, 0xde, 0x4e, 0x70, 0xca, 0x08, 0x15, 0x8e, 0x15,
+	0xe1, 0x58, 0x06, 0x5d, 0x0f, 0xda, 0x17, 0x51, 0xd9, 0x59, 0xd6, 0x3f, 0x0d, 0xf8, 0x7f, 0x21,
+	0x7e, 0x67, 0x84, 0x1d, 0x9f, 0xe6, 0xab, 0x11, 0x5c, 0x93, 0x08, 0xc9, 0x5a, 0xf0, 0x8b, 0xf7,
+	0xc8, 0x72, 0xc9, 0xd7, 0xcb, 0x1c, 0xa5, 0x26, 0xe5, 0x71, 0x2e, 0x10, 0xa4, 0x27, 0xd0, 0x2c,
+	0x2d, 0xbe, 0x4c, 0x8d, 0xf6, 0x74, 0x35, 0xfa, 0xd5, 0x84, 0x03, 0xf9, 0x74, 0x7e, 0xb5, 0x8a,
+	0x19, 0xce, 0xa9, 0x7f, 0x5d, 0x7a, 0x5f, 0x37, 0x0e, 0x3f, 0xdb, 0x7e, 0x38, 0x6d, 0x7b, 0x29,
+	0x4c, 0x3d, 0x9e, 0xd4, 0x8b, 0x3c, 0xbf, 0xf0, 0x94, 0x12, 0xca, 0x0b, 0xef, 0x13, 0x68, 0x65,
+	0x07, 0xa3, 0xf6, 0xb1, 0xc4, 0xdf, 0x1b, 0x28, 0x3f, 0xb4, 0x0c, 0x91, 0x51, 0xa4, 0x9c, 0x95,
+	0xc1, 0xac, 0x28, 0xd5, 0xa2, 0x28, 0x8f, 0xa0, 0xa1, 0x91, 0xb9, 0x8a, 0x40, 0xf7, 0x0f, 0x5e,
+	0xdf, 0xf0, 0x9e, 0x6c, 0x64, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x64, 0x05, 0x62, 0x70,
+	0x79, 0x0d, 0x00, 0x00,
 }
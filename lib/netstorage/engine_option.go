@@ -86,8 +86,9 @@ type EngineOptions struct {
 	DownSampleWriteDrop          bool
 	MaxDownSampleTaskConcurrency int
 
-	MaxSeriesPerDatabase int
-	MaxRowsPerSegment    int
+	MaxSeriesPerDatabase    int
+	MaxSeriesPerMeasurement int
+	MaxRowsPerSegment       int
 
 	// for hierarchical storage
 	SkipRegisterColdShard bool
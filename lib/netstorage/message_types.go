@@ -60,6 +60,12 @@ const (
 
 	RaftMessagesRequestMessage
 	RaftMessagesResponseMessage
+
+	TagValuesSketchRequestMessage
+	TagValuesSketchResponseMessage
+
+	SeriesQuotaRequestMessage
+	SeriesQuotaResponseMessage
 )
 
 var MessageBinaryCodec = make(map[uint8]func() codec.BinaryCodec, 20)
@@ -92,6 +98,10 @@ func init() {
 	MessageBinaryCodec[ShowTagKeysResponseMessage] = func() codec.BinaryCodec { return &ShowTagKeysResponse{} }
 	MessageBinaryCodec[RaftMessagesRequestMessage] = func() codec.BinaryCodec { return &RaftMessagesRequest{} }
 	MessageBinaryCodec[RaftMessagesResponseMessage] = func() codec.BinaryCodec { return &RaftMessagesResponse{} }
+	MessageBinaryCodec[TagValuesSketchRequestMessage] = func() codec.BinaryCodec { return &TagValuesSketchRequest{} }
+	MessageBinaryCodec[TagValuesSketchResponseMessage] = func() codec.BinaryCodec { return &TagValuesSketchResponse{} }
+	MessageBinaryCodec[SeriesQuotaRequestMessage] = func() codec.BinaryCodec { return &SeriesQuotaRequest{} }
+	MessageBinaryCodec[SeriesQuotaResponseMessage] = func() codec.BinaryCodec { return &SeriesQuotaResponse{} }
 
 	MessageResponseTyp = map[uint8]uint8{
 		SeriesKeysRequestMessage:               SeriesKeysResponseMessage,
@@ -106,5 +116,7 @@ func init() {
 		KillQueryRequestMessage:                KillQueryResponseMessage,
 		ShowTagKeysRequestMessage:              ShowTagKeysResponseMessage,
 		RaftMessagesRequestMessage:             RaftMessagesResponseMessage,
+		TagValuesSketchRequestMessage:          TagValuesSketchResponseMessage,
+		SeriesQuotaRequestMessage:              SeriesQuotaResponseMessage,
 	}
 }
@@ -42,6 +42,8 @@ func TestMessageTypes(t *testing.T) {
 		store.ShowQueriesRequestMessage:              {&store.ShowQueriesRequest{}, &store.ShowQueriesResponse{}},
 		store.KillQueryRequestMessage:                {&store.KillQueryRequest{}, &store.KillQueryResponse{}},
 		store.ShowTagKeysRequestMessage:              {&store.ShowTagKeysRequest{}, &store.ShowTagKeysResponse{}},
+		store.TagValuesSketchRequestMessage:          {&store.TagValuesSketchRequest{}, &store.TagValuesSketchResponse{}},
+		store.SeriesQuotaRequestMessage:              {&store.SeriesQuotaRequest{}, &store.SeriesQuotaResponse{}},
 	}
 
 	for typ, items := range data {
@@ -197,6 +197,58 @@ type ShowTagValuesCardinalityResponse struct {
 	ExactCardinalityResponse
 }
 
+type TagValuesSketchRequest struct {
+	ShowTagValuesRequest
+}
+
+// TagValuesSketchResponse carries the serialized HyperLogLog sketch of the tag values of each measurement
+type TagValuesSketchResponse struct {
+	internal2.TagValuesSketchResponse
+}
+
+func (r *TagValuesSketchResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.TagValuesSketchResponse)
+}
+
+func (r *TagValuesSketchResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.TagValuesSketchResponse)
+}
+
+func (r *TagValuesSketchResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+type SeriesQuotaRequest struct {
+	SeriesKeysRequest
+}
+
+// SeriesQuotaResponse carries the usage of the series quota of a database on a store node
+type SeriesQuotaResponse struct {
+	internal2.SeriesQuotaResponse
+}
+
+func (r *SeriesQuotaResponse) MarshalBinary() ([]byte, error) {
+	return proto.Marshal(&r.SeriesQuotaResponse)
+}
+
+func (r *SeriesQuotaResponse) UnmarshalBinary(buf []byte) error {
+	return proto.Unmarshal(buf, &r.SeriesQuotaResponse)
+}
+
+func (r *SeriesQuotaResponse) Error() error {
+	return NormalizeError(r.Err)
+}
+
+// SeriesQuota is the usage of the series quota of a database on a store node.
+// Series holds the number of series of each measurement on the node,
+// DatabaseSeries holds the number of series of the database in its fullest shard.
+type SeriesQuota struct {
+	Series         map[string]uint64
+	Limit          uint64
+	DatabaseSeries uint64
+	DatabaseLimit  uint64
+}
+
 type ExactCardinalityResponse struct {
 	internal2.ExactCardinalityResponse
 }
@@ -53,12 +53,14 @@ type Storage interface {
 
 	TagValues(nodeID uint64, db string, ptIDs []uint32, tagKeys map[string]map[string]struct{}, cond influxql.Expr, limit int, exact bool) (TablesTagSets, error)
 	TagValuesCardinality(nodeID uint64, db string, ptIDs []uint32, tagKeys map[string]map[string]struct{}, cond influxql.Expr) (map[string]uint64, error)
+	TagValuesSketch(nodeID uint64, db string, ptIDs []uint32, tagKeys map[string]map[string]struct{}, cond influxql.Expr) (map[string][]byte, error)
 
 	ShowTagKeys(nodeID uint64, db string, ptId []uint32, measurements []string, condition influxql.Expr) ([]string, error)
 
 	ShowSeries(nodeID uint64, db string, ptId []uint32, measurements []string, condition influxql.Expr, exact bool) ([]string, error)
 	SeriesCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error)
 	SeriesExactCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) (map[string]uint64, error)
+	SeriesQuota(nodeID uint64, db string, dbPts []uint32, measurements []string) (*SeriesQuota, error)
 
 	SendQueryRequestOnNode(nodeID uint64, req SysCtrlRequest) (map[string]string, error)
 	SendSysCtrlOnNode(nodID uint64, req SysCtrlRequest) (map[string]string, error)
@@ -293,6 +295,30 @@ func (s *NetStorage) TagValuesCardinality(nodeID uint64, db string, ptIDs []uint
 	return resp.GetCardinality(), resp.Error()
 }
 
+func (s *NetStorage) TagValuesSketch(nodeID uint64, db string, ptIDs []uint32,
+	tagKeys map[string]map[string]struct{}, cond influxql.Expr) (map[string][]byte, error) {
+
+	req := &TagValuesSketchRequest{}
+	req.Db = proto.String(db)
+	req.PtIDs = ptIDs
+	if cond != nil {
+		req.Condition = proto.String(cond.String())
+	}
+	req.SetTagKeys(tagKeys)
+
+	v, err := s.ddlRequestWithNodeId(nodeID, TagValuesSketchRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := v.(*TagValuesSketchResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.TagValuesSketchResponse", v)
+	}
+
+	return resp.GetSketches(), resp.Error()
+}
+
 func (s *NetStorage) SeriesCardinality(nodeID uint64, db string, dbPts []uint32, measurements []string, condition influxql.Expr) ([]meta2.MeasurementCardinalityInfo, error) {
 	req := &SeriesKeysRequest{}
 	req.Db = proto.String(db)
@@ -341,6 +367,33 @@ func (s *NetStorage) SeriesExactCardinality(nodeID uint64, db string, dbPts []ui
 	return resp.Cardinality, resp.Error()
 }
 
+func (s *NetStorage) SeriesQuota(nodeID uint64, db string, dbPts []uint32, measurements []string) (*SeriesQuota, error) {
+	req := &SeriesQuotaRequest{}
+	req.Db = proto.String(db)
+	req.PtIDs = dbPts
+	req.Measurements = measurements
+
+	v, err := s.ddlRequestWithNodeId(nodeID, SeriesQuotaRequestMessage, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, ok := v.(*SeriesQuotaResponse)
+	if !ok {
+		return nil, executor.NewInvalidTypeError("*netstorage.SeriesQuotaResponse", v)
+	}
+
+	if err = resp.Error(); err != nil {
+		return nil, err
+	}
+	return &SeriesQuota{
+		Series:         resp.GetSeries(),
+		Limit:          resp.GetLimit(),
+		DatabaseSeries: resp.GetDatabaseSeries(),
+		DatabaseLimit:  resp.GetDatabaseLimit(),
+	}, nil
+}
+
 func (s *NetStorage) ShowTagKeys(nodeID uint64, db string, ptIDs []uint32, measurements []string, condition influxql.Expr) ([]string, error) {
 	req := &ShowTagKeysRequest{}
 	req.Db = proto.String(db)
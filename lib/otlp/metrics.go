@@ -0,0 +1,341 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+)
+
+// OTLP metrics are converted into prometheus time series, so that they go
+// through the same validation and write path as the remote write protocol:
+//
+//   - gauge and sum points are written as the metric itself.
+//   - histogram points are written as the classic histogram layout, which are
+//     <name>_bucket series tagged with le, <name>_count and <name>_sum.
+//   - exponential histogram points are written as native histograms.
+//   - summary points are written as <name> series tagged with quantile,
+//     <name>_count and <name>_sum.
+//
+// Resource, scope and point attributes become labels, a point attribute wins
+// over a scope attribute which wins over a resource attribute of the same name.
+//
+// Points with delta temporality are written as they are reported, each point
+// holds the increase since the previous report and has to be summed up over
+// time by the query (sum_over_time instead of rate). Delta exponential
+// histograms are marked as gauge histograms so that they are never taken as a
+// counter reset.
+
+const (
+	metricNameLabel = "__name__"
+	bucketLabel     = "le"
+	quantileLabel   = "quantile"
+
+	ScopeNameLabel    = "otel_scope_name"
+	ScopeVersionLabel = "otel_scope_version"
+
+	bucketSuffix = "_bucket"
+	countSuffix  = "_count"
+	sumSuffix    = "_sum"
+
+	// the schema range of prometheus native histograms
+	maxNativeSchema = 8
+	minNativeSchema = -4
+
+	// prometheus counter reset hint of a gauge histogram
+	gaugeResetHint = 3
+)
+
+// Converter converts OTLP metrics into prometheus time series.
+// A Converter is not safe for concurrent use.
+type Converter struct {
+	tss []prompb.TimeSeries
+
+	// Dropped is the number of data points which cannot be converted.
+	Dropped int64
+
+	resourceLabels []prompb.Label
+	scopeLabels    []prompb.Label
+	pointLabels    []prompb.Label
+}
+
+// Reset resets c, the time series returned by the previous Convert become invalid.
+func (c *Converter) Reset() {
+	c.tss = c.tss[:0]
+	c.Dropped = 0
+}
+
+// Convert appends the time series converted from rms, and returns all time
+// series converted since the last Reset.
+func (c *Converter) Convert(rms []*metricspb.ResourceMetrics) []prompb.TimeSeries {
+	for _, rm := range rms {
+		c.resourceLabels = appendAttributes(c.resourceLabels[:0], rm.GetResource().GetAttributes())
+		for _, sm := range rm.GetScopeMetrics() {
+			c.scopeLabels = c.scopeLabels[:0]
+			scope := sm.GetScope()
+			if scope.GetName() != "" {
+				c.scopeLabels = appendLabel(c.scopeLabels, ScopeNameLabel, scope.GetName())
+			}
+			if scope.GetVersion() != "" {
+				c.scopeLabels = appendLabel(c.scopeLabels, ScopeVersionLabel, scope.GetVersion())
+			}
+			c.scopeLabels = appendAttributes(c.scopeLabels, scope.GetAttributes())
+			for _, m := range sm.GetMetrics() {
+				c.convertMetric(m)
+			}
+		}
+	}
+	return c.tss
+}
+
+func (c *Converter) convertMetric(m *metricspb.Metric) {
+	name := SanitizeMetricName(m.GetName())
+	switch data := m.GetData().(type) {
+	case *metricspb.Metric_Gauge:
+		c.convertNumberPoints(name, data.Gauge.GetDataPoints())
+	case *metricspb.Metric_Sum:
+		c.convertNumberPoints(name, data.Sum.GetDataPoints())
+	case *metricspb.Metric_Histogram:
+		c.convertHistogramPoints(name, data.Histogram.GetDataPoints())
+	case *metricspb.Metric_ExponentialHistogram:
+		delta := data.ExponentialHistogram.GetAggregationTemporality() == metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA
+		c.convertExponentialHistogramPoints(name, data.ExponentialHistogram.GetDataPoints(), delta)
+	case *metricspb.Metric_Summary:
+		c.convertSummaryPoints(name, data.Summary.GetDataPoints())
+	}
+}
+
+func (c *Converter) convertNumberPoints(name string, points []*metricspb.NumberDataPoint) {
+	for _, p := range points {
+		if noRecordedValue(p.GetFlags()) {
+			c.Dropped++
+			continue
+		}
+		var v float64
+		switch value := p.GetValue().(type) {
+		case *metricspb.NumberDataPoint_AsDouble:
+			v = value.AsDouble
+		case *metricspb.NumberDataPoint_AsInt:
+			v = float64(value.AsInt)
+		default:
+			c.Dropped++
+			continue
+		}
+		c.setPointLabels(p.GetAttributes())
+		c.appendSample(name, "", "", v, p.GetTimeUnixNano())
+	}
+}
+
+func (c *Converter) convertHistogramPoints(name string, points []*metricspb.HistogramDataPoint) {
+	for _, p := range points {
+		bounds, counts := p.GetExplicitBounds(), p.GetBucketCounts()
+		if noRecordedValue(p.GetFlags()) || (len(counts) > 0 && len(counts) != len(bounds)+1) {
+			c.Dropped++
+			continue
+		}
+		c.setPointLabels(p.GetAttributes())
+		ts := p.GetTimeUnixNano()
+		c.appendSample(name+countSuffix, "", "", float64(p.GetCount()), ts)
+		if p.Sum != nil {
+			c.appendSample(name+sumSuffix, "", "", p.GetSum(), ts)
+		}
+		if len(counts) == 0 {
+			continue
+		}
+		var cumulative uint64
+		for i, bound := range bounds {
+			cumulative += counts[i]
+			c.appendSample(name+bucketSuffix, bucketLabel, formatFloat(bound), float64(cumulative), ts)
+		}
+		c.appendSample(name+bucketSuffix, bucketLabel, "+Inf", float64(p.GetCount()), ts)
+	}
+}
+
+func (c *Converter) convertExponentialHistogramPoints(name string, points []*metricspb.ExponentialHistogramDataPoint, delta bool) {
+	for _, p := range points {
+		scale := p.GetScale()
+		if noRecordedValue(p.GetFlags()) || scale < minNativeSchema {
+			c.Dropped++
+			continue
+		}
+		// a finer scale is merged down to the finest schema of native histograms
+		var scaleDown int32
+		if scale > maxNativeSchema {
+			scaleDown = scale - maxNativeSchema
+			scale = maxNativeSchema
+		}
+
+		h := prompb.Histogram{
+			CountInt:      p.GetCount(),
+			Sum:           p.GetSum(),
+			Schema:        scale,
+			ZeroThreshold: p.GetZeroThreshold(),
+			ZeroCountInt:  p.GetZeroCount(),
+			Timestamp:     unixNano2Milli(p.GetTimeUnixNano()),
+		}
+		h.PositiveSpans, h.PositiveDeltas = buckets2Spans(p.GetPositive(), scaleDown)
+		h.NegativeSpans, h.NegativeDeltas = buckets2Spans(p.GetNegative(), scaleDown)
+		if delta {
+			h.ResetHint = gaugeResetHint
+		}
+
+		c.setPointLabels(p.GetAttributes())
+		ts := c.appendSeries(name, "", "")
+		ts.Histograms = append(ts.Histograms, h)
+	}
+}
+
+func (c *Converter) convertSummaryPoints(name string, points []*metricspb.SummaryDataPoint) {
+	for _, p := range points {
+		if noRecordedValue(p.GetFlags()) {
+			c.Dropped++
+			continue
+		}
+		c.setPointLabels(p.GetAttributes())
+		ts := p.GetTimeUnixNano()
+		c.appendSample(name+countSuffix, "", "", float64(p.GetCount()), ts)
+		c.appendSample(name+sumSuffix, "", "", p.GetSum(), ts)
+		for _, q := range p.GetQuantileValues() {
+			c.appendSample(name, quantileLabel, formatFloat(q.GetQuantile()), q.GetValue(), ts)
+		}
+	}
+}
+
+// buckets2Spans converts the OTLP buckets into the spans and the count deltas
+// of a native histogram. The OTLP bucket of index i covers (base^i, base^(i+1)],
+// which is the native histogram bucket of index i+1.
+func buckets2Spans(b *metricspb.ExponentialHistogramDataPoint_Buckets, scaleDown int32) ([]prompb.BucketSpan, []int64) {
+	counts := b.GetBucketCounts()
+	if len(counts) == 0 {
+		return nil, nil
+	}
+
+	var spans []prompb.BucketSpan
+	var deltas []int64
+	var bucketCounts []uint64
+	var lastIdx int32
+	for i, count := range counts {
+		idx := (b.GetOffset()+int32(i))>>scaleDown + 1
+		switch {
+		case len(spans) == 0:
+			spans = append(spans, prompb.BucketSpan{Offset: idx, Length: 1})
+			bucketCounts = append(bucketCounts, count)
+		case idx == lastIdx:
+			bucketCounts[len(bucketCounts)-1] += count
+		case idx == lastIdx+1:
+			spans[len(spans)-1].Length++
+			bucketCounts = append(bucketCounts, count)
+		default:
+			spans = append(spans, prompb.BucketSpan{Offset: idx - lastIdx - 1, Length: 1})
+			bucketCounts = append(bucketCounts, count)
+		}
+		lastIdx = idx
+	}
+
+	deltas = make([]int64, len(bucketCounts))
+	var prev int64
+	for i, count := range bucketCounts {
+		deltas[i] = int64(count) - prev
+		prev = int64(count)
+	}
+	return spans, deltas
+}
+
+func (c *Converter) setPointLabels(attrs []*commonpb.KeyValue) {
+	c.pointLabels = appendAttributes(c.pointLabels[:0], attrs)
+}
+
+func (c *Converter) appendSample(name, extraKey, extraValue string, value float64, unixNano uint64) {
+	ts := c.appendSeries(name, extraKey, extraValue)
+	ts.Samples = append(ts.Samples, prompb.Sample{Value: value, Timestamp: unixNano2Milli(unixNano)})
+}
+
+// appendSeries appends a series named name and labeled with the current
+// resource, scope and point labels, plus the extra label if it is not empty.
+func (c *Converter) appendSeries(name, extraKey, extraValue string) *prompb.TimeSeries {
+	if cap(c.tss) > len(c.tss) {
+		c.tss = c.tss[:len(c.tss)+1]
+	} else {
+		c.tss = append(c.tss, prompb.TimeSeries{})
+	}
+	ts := &c.tss[len(c.tss)-1]
+	ts.Samples = ts.Samples[:0]
+	ts.Histograms = ts.Histograms[:0]
+
+	labels := appendLabel(ts.Labels[:0], metricNameLabel, name)
+	if extraKey != "" {
+		labels = appendLabel(labels, extraKey, extraValue)
+	}
+	// higher priority labels are added first, the later duplicates are skipped
+	for _, group := range [][]prompb.Label{c.pointLabels, c.scopeLabels, c.resourceLabels} {
+		for _, l := range group {
+			if !hasLabel(labels, l.Name) {
+				labels = append(labels, l)
+			}
+		}
+	}
+	sort.Slice(labels[1:], func(i, j int) bool {
+		return string(labels[i+1].Name) < string(labels[j+1].Name)
+	})
+	ts.Labels = labels
+	return ts
+}
+
+func hasLabel(labels []prompb.Label, name []byte) bool {
+	for i := range labels {
+		if string(labels[i].Name) == string(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendAttributes(dst []prompb.Label, attrs []*commonpb.KeyValue) []prompb.Label {
+	for _, kv := range attrs {
+		if kv.GetKey() == "" {
+			continue
+		}
+		value := AnyValueString(kv.GetValue())
+		if value == "" {
+			continue
+		}
+		dst = appendLabel(dst, SanitizeLabelName(kv.GetKey()), value)
+	}
+	return dst
+}
+
+func appendLabel(dst []prompb.Label, name, value string) []prompb.Label {
+	return append(dst, prompb.Label{Name: []byte(name), Value: []byte(value)})
+}
+
+func noRecordedValue(flags uint32) bool {
+	return flags&uint32(metricspb.DataPointFlags_DATA_POINT_FLAGS_NO_RECORDED_VALUE_MASK) != 0
+}
+
+func unixNano2Milli(ns uint64) int64 {
+	return int64(ns / 1e6)
+}
+
+func formatFloat(v float64) string {
+	if math.IsInf(v, +1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,211 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp_test
+
+import (
+	"testing"
+
+	"github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	"github.com/openGemini/openGemini/lib/otlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+const testTime = uint64(1700000000123456789)
+
+func strAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}}}
+}
+
+func newResourceMetrics(metrics ...*metricspb.Metric) []*metricspb.ResourceMetrics {
+	return []*metricspb.ResourceMetrics{{
+		Resource: &resourcepb.Resource{Attributes: []*commonpb.KeyValue{
+			strAttr("service.name", "api"),
+			strAttr("host", "resource-host"),
+		}},
+		ScopeMetrics: []*metricspb.ScopeMetrics{{
+			Scope:   &commonpb.InstrumentationScope{Name: "otel-go", Version: "1.0"},
+			Metrics: metrics,
+		}},
+	}}
+}
+
+func labelsMap(ts *prompb.TimeSeries) map[string]string {
+	m := make(map[string]string, len(ts.Labels))
+	for _, l := range ts.Labels {
+		m[string(l.Name)] = string(l.Value)
+	}
+	return m
+}
+
+func TestConvertGaugeAndSum(t *testing.T) {
+	rms := newResourceMetrics(
+		&metricspb.Metric{Name: "process.cpu.usage", Data: &metricspb.Metric_Gauge{Gauge: &metricspb.Gauge{
+			DataPoints: []*metricspb.NumberDataPoint{{
+				Attributes:   []*commonpb.KeyValue{strAttr("host", "point-host")},
+				TimeUnixNano: testTime,
+				Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: 0.5},
+			}},
+		}}},
+		&metricspb.Metric{Name: "http.requests", Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+			IsMonotonic:            true,
+			DataPoints: []*metricspb.NumberDataPoint{{
+				TimeUnixNano: testTime,
+				Value:        &metricspb.NumberDataPoint_AsInt{AsInt: 10},
+			}, {
+				TimeUnixNano: testTime,
+				Flags:        uint32(metricspb.DataPointFlags_DATA_POINT_FLAGS_NO_RECORDED_VALUE_MASK),
+			}},
+		}}},
+	)
+
+	c := &otlp.Converter{}
+	tss := c.Convert(rms)
+	require.Equal(t, 2, len(tss))
+	assert.Equal(t, int64(1), c.Dropped)
+
+	assert.Equal(t, map[string]string{
+		"__name__":           "process_cpu_usage",
+		"host":               "point-host",
+		"service_name":       "api",
+		"otel_scope_name":    "otel-go",
+		"otel_scope_version": "1.0",
+	}, labelsMap(&tss[0]))
+	assert.Equal(t, "__name__", string(tss[0].Labels[0].Name))
+	assert.Equal(t, []prompb.Sample{{Value: 0.5, Timestamp: 1700000000123}}, tss[0].Samples)
+
+	assert.Equal(t, "resource-host", labelsMap(&tss[1])["host"])
+	assert.Equal(t, []prompb.Sample{{Value: 10, Timestamp: 1700000000123}}, tss[1].Samples)
+
+	c.Reset()
+	assert.Equal(t, 0, len(c.Convert(nil)))
+	assert.Equal(t, int64(0), c.Dropped)
+}
+
+func TestConvertHistogramAndSummary(t *testing.T) {
+	sum := 12.5
+	rms := newResourceMetrics(
+		&metricspb.Metric{Name: "latency", Data: &metricspb.Metric_Histogram{Histogram: &metricspb.Histogram{
+			DataPoints: []*metricspb.HistogramDataPoint{{
+				TimeUnixNano:   testTime,
+				Count:          6,
+				Sum:            &sum,
+				ExplicitBounds: []float64{0.1, 1},
+				BucketCounts:   []uint64{1, 2, 3},
+			}},
+		}}},
+		&metricspb.Metric{Name: "rpc", Data: &metricspb.Metric_Summary{Summary: &metricspb.Summary{
+			DataPoints: []*metricspb.SummaryDataPoint{{
+				TimeUnixNano:   testTime,
+				Count:          4,
+				Sum:            2,
+				QuantileValues: []*metricspb.SummaryDataPoint_ValueAtQuantile{{Quantile: 0.99, Value: 1.5}},
+			}},
+		}}},
+	)
+
+	c := &otlp.Converter{}
+	tss := c.Convert(rms)
+	require.Equal(t, 8, len(tss))
+
+	type sample struct {
+		name, extra string
+		value       float64
+	}
+	var got []sample
+	for i := range tss {
+		labels := labelsMap(&tss[i])
+		got = append(got, sample{labels["__name__"], labels["le"] + labels["quantile"], tss[i].Samples[0].Value})
+	}
+	assert.Equal(t, []sample{
+		{"latency_count", "", 6},
+		{"latency_sum", "", 12.5},
+		{"latency_bucket", "0.1", 1},
+		{"latency_bucket", "1", 3},
+		{"latency_bucket", "+Inf", 6},
+		{"rpc_count", "", 4},
+		{"rpc_sum", "", 2},
+		{"rpc", "0.99", 1.5},
+	}, got)
+}
+
+func TestConvertExponentialHistogram(t *testing.T) {
+	sum := 3.0
+	rms := newResourceMetrics(
+		&metricspb.Metric{Name: "size", Data: &metricspb.Metric_ExponentialHistogram{ExponentialHistogram: &metricspb.ExponentialHistogram{
+			AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+			DataPoints: []*metricspb.ExponentialHistogramDataPoint{{
+				TimeUnixNano: testTime,
+				Count:        7,
+				Sum:          &sum,
+				Scale:        0,
+				ZeroCount:    1,
+				Positive:     &metricspb.ExponentialHistogramDataPoint_Buckets{Offset: -1, BucketCounts: []uint64{2, 0, 3}},
+				Negative:     &metricspb.ExponentialHistogramDataPoint_Buckets{Offset: 0, BucketCounts: []uint64{1}},
+			}, {
+				TimeUnixNano: testTime,
+				Count:        3,
+				Scale:        10,
+				Positive:     &metricspb.ExponentialHistogramDataPoint_Buckets{Offset: 0, BucketCounts: []uint64{1, 1, 1}},
+			}, {
+				TimeUnixNano: testTime,
+				Scale:        -5,
+			}},
+		}}},
+	)
+
+	c := &otlp.Converter{}
+	tss := c.Convert(rms)
+	require.Equal(t, 2, len(tss))
+	assert.Equal(t, int64(1), c.Dropped)
+
+	h := tss[0].Histograms[0]
+	assert.Equal(t, uint64(7), h.CountInt)
+	assert.Equal(t, uint64(1), h.ZeroCountInt)
+	assert.Equal(t, int32(0), h.Schema)
+	assert.Equal(t, int32(3), h.ResetHint)
+	assert.Equal(t, int64(1700000000123), h.Timestamp)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: 0, Length: 3}}, h.PositiveSpans)
+	assert.Equal(t, []int64{2, -2, 3}, h.PositiveDeltas)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: 1, Length: 1}}, h.NegativeSpans)
+	assert.Equal(t, []int64{1}, h.NegativeDeltas)
+
+	// scale 10 is merged down to schema 8, the 3 buckets fall into one
+	h = tss[1].Histograms[0]
+	assert.Equal(t, int32(8), h.Schema)
+	assert.Equal(t, []prompb.BucketSpan{{Offset: 1, Length: 1}}, h.PositiveSpans)
+	assert.Equal(t, []int64{3}, h.PositiveDeltas)
+}
+
+func TestSanitizeName(t *testing.T) {
+	assert.Equal(t, "http_server_duration", otlp.SanitizeMetricName("http.server.duration"))
+	assert.Equal(t, "ns:metric", otlp.SanitizeMetricName("ns:metric"))
+	assert.Equal(t, "_2xx", otlp.SanitizeLabelName("2xx"))
+	assert.Equal(t, "k8s_pod_name", otlp.SanitizeLabelName("k8s.pod-name"))
+}
+
+func TestAnyValueString(t *testing.T) {
+	v := &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: &commonpb.ArrayValue{Values: []*commonpb.AnyValue{
+		{Value: &commonpb.AnyValue_IntValue{IntValue: 1}},
+		{Value: &commonpb.AnyValue_BoolValue{BoolValue: true}},
+	}}}}
+	assert.Equal(t, "[1,true]", otlp.AnyValueString(v))
+	assert.Equal(t, "1.5", otlp.AnyValueString(&commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: 1.5}}))
+	assert.Equal(t, "", otlp.AnyValueString(nil))
+}
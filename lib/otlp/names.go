@@ -0,0 +1,114 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package otlp
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+)
+
+// SanitizeMetricName replaces the characters not allowed in a prometheus
+// metric name with '_', such as the '.' of "http.server.duration".
+func SanitizeMetricName(name string) string {
+	return sanitize(name, func(c byte) bool { return isLabelChar(c) || c == ':' })
+}
+
+// SanitizeLabelName replaces the characters not allowed in a prometheus label
+// name with '_', such as the '.' of "service.name".
+func SanitizeLabelName(name string) string {
+	return sanitize(name, isLabelChar)
+}
+
+func sanitize(name string, valid func(c byte) bool) string {
+	if name == "" {
+		return name
+	}
+	var sb strings.Builder
+	if name[0] >= '0' && name[0] <= '9' {
+		sb.WriteByte('_')
+	}
+	for i := 0; i < len(name); i++ {
+		if valid(name[i]) {
+			sb.WriteByte(name[i])
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	return sb.String()
+}
+
+func isLabelChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// AnyValueString returns the tag value of an attribute value, arrays and
+// key-value lists are encoded as json.
+func AnyValueString(v *commonpb.AnyValue) string {
+	switch value := v.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return value.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return strconv.FormatBool(value.BoolValue)
+	case *commonpb.AnyValue_IntValue:
+		return strconv.FormatInt(value.IntValue, 10)
+	case *commonpb.AnyValue_DoubleValue:
+		return formatFloat(value.DoubleValue)
+	case *commonpb.AnyValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(value.BytesValue)
+	case *commonpb.AnyValue_ArrayValue, *commonpb.AnyValue_KvlistValue:
+		b, err := json.Marshal(anyValue2Interface(v))
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	default:
+		return ""
+	}
+}
+
+func anyValue2Interface(v *commonpb.AnyValue) interface{} {
+	switch value := v.GetValue().(type) {
+	case *commonpb.AnyValue_StringValue:
+		return value.StringValue
+	case *commonpb.AnyValue_BoolValue:
+		return value.BoolValue
+	case *commonpb.AnyValue_IntValue:
+		return value.IntValue
+	case *commonpb.AnyValue_DoubleValue:
+		return value.DoubleValue
+	case *commonpb.AnyValue_BytesValue:
+		return value.BytesValue
+	case *commonpb.AnyValue_ArrayValue:
+		values := value.ArrayValue.GetValues()
+		arr := make([]interface{}, len(values))
+		for i := range values {
+			arr[i] = anyValue2Interface(values[i])
+		}
+		return arr
+	case *commonpb.AnyValue_KvlistValue:
+		kvs := value.KvlistValue.GetValues()
+		m := make(map[string]interface{}, len(kvs))
+		for _, kv := range kvs {
+			m[kv.GetKey()] = anyValue2Interface(kv.GetValue())
+		}
+		return m
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/parquet"
+	"github.com/apache/arrow/go/v13/parquet/compress"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/openGemini/openGemini/lib/config"
+)
+
+var exportCompressions = map[string]compress.Compression{
+	"none":   compress.Codecs.Uncompressed,
+	"snappy": compress.Codecs.Snappy,
+	"gzip":   compress.Codecs.Gzip,
+	"brotli": compress.Codecs.Brotli,
+	"zstd":   compress.Codecs.Zstd,
+}
+
+// ExportOptions controls the layout of a parquet file written from query results.
+type ExportOptions struct {
+	MaxRowGroupLen int64
+	Compression    compress.Compression
+}
+
+func NewExportOptions() ExportOptions {
+	return ExportOptions{
+		MaxRowGroupLen: config.DefaultMaxRowGroupLen,
+		Compression:    compress.Codecs.Zstd,
+	}
+}
+
+// ParseCompression returns the compression codec by name: none, snappy, gzip, brotli or zstd.
+func ParseCompression(name string) (compress.Compression, error) {
+	c, ok := exportCompressions[strings.ToLower(name)]
+	if !ok {
+		return 0, fmt.Errorf("unsupported parquet compression %q, expect none, snappy, gzip, brotli or zstd", name)
+	}
+	return c, nil
+}
+
+// NewExportWriter returns a writer which encodes arrow records of the schema into a parquet file written to sink.
+// Records are buffered into row groups of at most MaxRowGroupLen rows, the file is complete once the writer is closed.
+func NewExportWriter(sink io.Writer, schema *arrow.Schema, opt ExportOptions) (*pqarrow.FileWriter, error) {
+	if opt.MaxRowGroupLen <= 0 {
+		return nil, fmt.Errorf("invalid parquet row group size %d", opt.MaxRowGroupLen)
+	}
+	writerProps := parquet.NewWriterProperties(parquet.WithMaxRowGroupLength(opt.MaxRowGroupLen), parquet.WithDataPageSize(config.DefaultPageSize),
+		parquet.WithDictionaryPageSizeLimit(config.DefaultPageSize), parquet.WithCompression(opt.Compression), parquet.WithBatchSize(config.DefaultWriteBatchSize))
+	arrowWriterProps := pqarrow.NewArrowWriterProperties(pqarrow.WithCoerceTimestamps(arrow.Nanosecond))
+	return pqarrow.NewFileWriter(schema, sink, writerProps, arrowWriterProps)
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parquet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet/compress"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCompression(t *testing.T) {
+	c, err := ParseCompression("Snappy")
+	require.NoError(t, err)
+	require.Equal(t, compress.Codecs.Snappy, c)
+
+	_, err = ParseCompression("lz4")
+	require.Error(t, err)
+}
+
+func TestExportWriter(t *testing.T) {
+	schema := arrow.NewSchema([]arrow.Field{{Name: "value", Type: arrow.PrimitiveTypes.Float64, Nullable: true}}, nil)
+
+	opt := NewExportOptions()
+	opt.MaxRowGroupLen = 0
+	_, err := NewExportWriter(&bytes.Buffer{}, schema, opt)
+	require.Error(t, err)
+
+	opt.MaxRowGroupLen = 2
+	buf := &bytes.Buffer{}
+	w, err := NewExportWriter(buf, schema, opt)
+	require.NoError(t, err)
+
+	b := array.NewRecordBuilder(memory.NewGoAllocator(), schema)
+	defer b.Release()
+	b.Field(0).(*array.Float64Builder).AppendValues([]float64{1, 2, 3, 4, 5}, nil)
+	rec := b.NewRecord()
+	defer rec.Release()
+	require.NoError(t, w.WriteBuffered(rec))
+	require.NoError(t, w.Close())
+
+	pf, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, int64(5), pf.NumRows())
+	require.Equal(t, 3, pf.NumRowGroups())
+	col, err := pf.MetaData().RowGroup(0).ColumnChunk(0)
+	require.NoError(t, err)
+	require.Equal(t, compress.Codecs.Zstd, col.Compression())
+}
@@ -59,6 +59,7 @@ const (
 	InvalidTimeStampBuilderTemplate = "invalid builder , want TimestampBuilder, get %v"
 	InvalidStringBuilderTemplate    = "invalid builder , want StringBuilder, get %v"
 	InvalidInt64BuilderTemplate     = "invalid builder , want Int64Builder, get %v"
+	InvalidUint64BuilderTemplate    = "invalid builder , want Uint64Builder, get %v"
 	InvalidFloat64BuilderTemplate   = "invalid builder , want Float64Builder, get %v"
 	InvalidBooleanBuilderTemplate   = "invalid builder , want BooleanBuilder, get %v"
 )
@@ -226,6 +227,12 @@ func (w *Writer) appendFast(b array.Builder, typ int, col *record.ColVal, name s
 			return fmt.Errorf(InvalidInt64BuilderTemplate, b)
 		}
 		builder.AppendValues(col.IntegerValues(), nil)
+	case influx.Field_Type_UInt:
+		builder, ok := b.(*array.Uint64Builder)
+		if !ok {
+			return fmt.Errorf(InvalidUint64BuilderTemplate, b)
+		}
+		builder.AppendValues(col.UnsignedValues(), nil)
 	case influx.Field_Type_Float:
 		builder, ok := b.(*array.Float64Builder)
 		if !ok {
@@ -254,6 +261,12 @@ func iterateIntValue(callback func(int64, bool), cv *record.ColVal) {
 	}
 }
 
+func iterateUnsignedValue(callback func(uint64, bool), cv *record.ColVal) {
+	for i := 0; i < cv.Len; i++ {
+		callback(cv.UnsignedValue(i))
+	}
+}
+
 func iterateBoolValue(callback func(bool, bool), cv *record.ColVal) {
 	for i := 0; i < cv.Len; i++ {
 		callback(cv.BooleanValue(i))
@@ -303,6 +316,18 @@ func (w *Writer) append(b array.Builder, typ uint8, col *record.ColVal) error {
 				builder.Append(val)
 			}
 		}, col)
+	case influx.Field_Type_UInt:
+		builder, ok := b.(*array.Uint64Builder)
+		if !ok {
+			return fmt.Errorf(InvalidUint64BuilderTemplate, b)
+		}
+		iterateUnsignedValue(func(val uint64, isNil bool) {
+			if isNil {
+				builder.AppendNull()
+			} else {
+				builder.Append(val)
+			}
+		}, col)
 	case influx.Field_Type_Float:
 		builder, ok := b.(*array.Float64Builder)
 		if !ok {
@@ -356,6 +381,8 @@ func (w *Writer) buildSchema(schema map[string]uint8) []arrow.Field {
 			fields[idx].Type = arrow.BinaryTypes.String
 		case influx.Field_Type_Int:
 			fields[idx].Type = arrow.PrimitiveTypes.Int64
+		case influx.Field_Type_UInt:
+			fields[idx].Type = arrow.PrimitiveTypes.Uint64
 		case influx.Field_Type_Float:
 			fields[idx].Type = arrow.PrimitiveTypes.Float64
 		case influx.Field_Type_Boolean:
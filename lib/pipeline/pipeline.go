@@ -0,0 +1,215 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openGemini/openGemini/lib/errno"
+)
+
+// types of the processors
+const (
+	TypeRegex     = "regex"
+	TypeGrok      = "grok"
+	TypeKV        = "kv"
+	TypeJSON      = "json"
+	TypeDrop      = "drop"
+	TypeRename    = "rename"
+	TypeTimestamp = "timestamp"
+)
+
+const (
+	// DefaultField is the source field of the processors when the field is not given
+	DefaultField = "content"
+
+	// MaxProcessors is the max number of the processors of a pipeline
+	MaxProcessors = 32
+)
+
+var (
+	ErrFieldMissing = errors.New("pipeline: source field is missing")
+	ErrFieldType    = errors.New("pipeline: source field is not a string")
+	ErrNotMatch     = errors.New("pipeline: source field does not match the pattern")
+)
+
+// Config is the configuration of a processor, which is stored with the options of the log stream.
+// The options not used by the type of the processor are ignored.
+type Config struct {
+	Type string `json:"type"`
+	// Field is the source field of regex, grok, kv, json and timestamp processors, content by default
+	Field string `json:"field,omitempty"`
+	// RemoveSource removes the source field after it is processed successfully
+	RemoveSource bool `json:"remove_source,omitempty"`
+	// IgnoreMissing skips the processor when the source field is missing
+	IgnoreMissing bool `json:"ignore_missing,omitempty"`
+	// IgnoreFailure skips the processor when the source field can not be processed
+	IgnoreFailure bool `json:"ignore_failure,omitempty"`
+
+	// Pattern is the regular expression with named groups of the regex processor, or the grok pattern
+	Pattern string `json:"pattern,omitempty"`
+	// PatternDefinitions are the custom patterns which can be referred to by the grok pattern
+	PatternDefinitions map[string]string `json:"pattern_definitions,omitempty"`
+
+	// FieldSplit separates the pairs of the kv processor, a space by default
+	FieldSplit string `json:"field_split,omitempty"`
+	// ValueSplit separates the key and the value of the kv processor, "=" by default
+	ValueSplit string `json:"value_split,omitempty"`
+	// Prefix is added to the keys extracted by the kv and json processors
+	Prefix string `json:"prefix,omitempty"`
+
+	// Fields are the fields removed by the drop processor
+	Fields []string `json:"fields,omitempty"`
+	// Rename maps the old names to the new names of the fields
+	Rename map[string]string `json:"rename,omitempty"`
+
+	// Format is the layout of the timestamp processor, see time.Parse,
+	// or one of unix, unix_ms, unix_us and unix_ns for the epoch times
+	Format string `json:"format,omitempty"`
+	// Timezone is the location of the times without a zone, UTC by default
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// processor processes the fields of a log, the returned timestamp is 0 when the processor does not give the time.
+type processor interface {
+	process(fields map[string]interface{}) (int64, error)
+}
+
+// Pipeline is a sequence of processors run on each log before it is written.
+// A Pipeline is safe for concurrent use.
+type Pipeline struct {
+	processors []processor
+}
+
+// New compiles the processors of the configs, the pipeline is nil if there is no config.
+func New(configs []*Config) (*Pipeline, error) {
+	if len(configs) == 0 {
+		return nil, nil
+	}
+	if len(configs) > MaxProcessors {
+		return nil, errno.NewError(errno.ErrInvalidPipeline, fmt.Sprintf("at most %d processors", MaxProcessors))
+	}
+
+	p := &Pipeline{processors: make([]processor, 0, len(configs))}
+	for i, c := range configs {
+		if c == nil {
+			return nil, errno.NewError(errno.ErrInvalidPipeline, fmt.Sprintf("processor %d is empty", i))
+		}
+		proc, err := newProcessor(c)
+		if err != nil {
+			return nil, errno.NewError(errno.ErrInvalidPipeline, fmt.Sprintf("processor %d (%s): %v", i, c.Type, err))
+		}
+		p.processors = append(p.processors, proc)
+	}
+	return p, nil
+}
+
+// Validate checks the configs can be compiled.
+func Validate(configs []*Config) error {
+	_, err := New(configs)
+	return err
+}
+
+func newProcessor(c *Config) (processor, error) {
+	src := source{field: c.Field, remove: c.RemoveSource, ignoreMissing: c.IgnoreMissing, ignoreFailure: c.IgnoreFailure}
+	if src.field == "" {
+		src.field = DefaultField
+	}
+
+	switch c.Type {
+	case TypeRegex:
+		return newRegexProcessor(src, c.Pattern)
+	case TypeGrok:
+		return newGrokProcessor(src, c.Pattern, c.PatternDefinitions)
+	case TypeKV:
+		return newKVProcessor(src, c.FieldSplit, c.ValueSplit, c.Prefix)
+	case TypeJSON:
+		return &jsonProcessor{source: src, prefix: c.Prefix}, nil
+	case TypeDrop:
+		return newDropProcessor(c.Fields)
+	case TypeRename:
+		return newRenameProcessor(c.Rename)
+	case TypeTimestamp:
+		return newTimestampProcessor(src, c.Format, c.Timezone)
+	default:
+		return nil, fmt.Errorf("unknown type %q", c.Type)
+	}
+}
+
+// Process runs the processors in order on the fields, the fields are modified in place.
+// The returned timestamp is the time given by the last timestamp processor, or 0 if there is none.
+func (p *Pipeline) Process(fields map[string]interface{}) (int64, error) {
+	var timestamp int64
+	for _, proc := range p.processors {
+		ts, err := proc.process(fields)
+		if err != nil {
+			return 0, err
+		}
+		if ts != 0 {
+			timestamp = ts
+		}
+	}
+	return timestamp, nil
+}
+
+// source is the source field shared by the processors which parse a field.
+type source struct {
+	field         string
+	remove        bool
+	ignoreMissing bool
+	ignoreFailure bool
+}
+
+// value returns the source value, ok is false if the processor is skipped.
+func (s *source) value(fields map[string]interface{}) (v interface{}, ok bool, err error) {
+	v, ok = fields[s.field]
+	if !ok {
+		if s.ignoreMissing {
+			return nil, false, nil
+		}
+		return nil, false, ErrFieldMissing
+	}
+	return v, true, nil
+}
+
+// stringValue returns the source value which must be a string.
+func (s *source) stringValue(fields map[string]interface{}) (string, bool, error) {
+	v, ok, err := s.value(fields)
+	if !ok {
+		return "", false, err
+	}
+	str, ok := v.(string)
+	if !ok {
+		return "", false, s.failed(ErrFieldType)
+	}
+	return str, true, nil
+}
+
+// failed returns the error of the processor, which is nil if the failures are ignored.
+func (s *source) failed(err error) error {
+	if s.ignoreFailure {
+		return nil
+	}
+	return err
+}
+
+// consume removes the source field if needed. It is called before the outputs are set,
+// so the source is kept if it is also an output of the processor.
+func (s *source) consume(fields map[string]interface{}) {
+	if s.remove {
+		delete(fields, s.field)
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/pipeline"
+	"github.com/stretchr/testify/require"
+)
+
+func process(t *testing.T, configs []*pipeline.Config, fields map[string]interface{}) (int64, error) {
+	p, err := pipeline.New(configs)
+	require.NoError(t, err)
+	return p.Process(fields)
+}
+
+func TestNew(t *testing.T) {
+	p, err := pipeline.New(nil)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	for _, c := range []*pipeline.Config{
+		nil,
+		{Type: "unknown"},
+		{Type: pipeline.TypeRegex},
+		{Type: pipeline.TypeRegex, Pattern: `(\d+`},
+		{Type: pipeline.TypeRegex, Pattern: `(\d+)`},
+		{Type: pipeline.TypeGrok, Pattern: `%{UNKNOWN:a}`},
+		{Type: pipeline.TypeGrok, Pattern: `%{WORD:a} %{WORD:a}`},
+		{Type: pipeline.TypeGrok, Pattern: `%{LOOP:a}`, PatternDefinitions: map[string]string{"LOOP": `%{LOOP}`}},
+		{Type: pipeline.TypeKV, FieldSplit: ",", ValueSplit: ","},
+		{Type: pipeline.TypeDrop},
+		{Type: pipeline.TypeRename, Rename: map[string]string{"a": ""}},
+		{Type: pipeline.TypeTimestamp},
+		{Type: pipeline.TypeTimestamp, Format: "unix", Timezone: "Nowhere/Unknown"},
+	} {
+		require.Error(t, pipeline.Validate([]*pipeline.Config{c}), "%+v", c)
+	}
+
+	configs := make([]*pipeline.Config, pipeline.MaxProcessors+1)
+	for i := range configs {
+		configs[i] = &pipeline.Config{Type: pipeline.TypeDrop, Fields: []string{"a"}}
+	}
+	require.Error(t, pipeline.Validate(configs))
+}
+
+func TestRegexAndGrok(t *testing.T) {
+	fields := map[string]interface{}{"content": "level=ignored 2024-07-01 ERROR [main] disk full"}
+	_, err := process(t, []*pipeline.Config{
+		{Type: pipeline.TypeRegex, Pattern: `(?P<date>\d{4}-\d{2}-\d{2}) (?P<level>[A-Z]+) (?:\[(?P<thread>\w+)\])?(?P<missing>x)?`},
+	}, fields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"content": "level=ignored 2024-07-01 ERROR [main] disk full",
+		"date":    "2024-07-01",
+		"level":   "ERROR",
+		"thread":  "main",
+	}, fields)
+
+	fields = map[string]interface{}{"content": `127.0.0.1 - frank [10/Oct/2000:13:55:36 -0700] "GET /apache_pb.gif HTTP/1.0" 200 2326`}
+	_, err = process(t, []*pipeline.Config{
+		{Type: pipeline.TypeGrok, Pattern: `%{COMMONAPACHELOG}`, RemoveSource: true},
+	}, fields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"clientip":    "127.0.0.1",
+		"ident":       "-",
+		"auth":        "frank",
+		"timestamp":   "10/Oct/2000:13:55:36 -0700",
+		"verb":        "GET",
+		"request":     "/apache_pb.gif",
+		"httpversion": "1.0",
+		"response":    float64(200),
+		"bytes":       float64(2326),
+	}, fields)
+
+	// the custom patterns, and the source kept as an output
+	fields = map[string]interface{}{"msg": "user=alice took 35ms"}
+	_, err = process(t, []*pipeline.Config{{
+		Type: pipeline.TypeGrok, Field: "msg", RemoveSource: true,
+		Pattern:            `user=%{NAME:user.name} took %{INT:took_ms:int}ms%{GREEDYDATA:msg}`,
+		PatternDefinitions: map[string]string{"NAME": `[a-z]+`},
+	}}, fields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"user.name": "alice", "took_ms": float64(35)}, fields)
+
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeGrok, Pattern: `^%{IP:ip}`}},
+		map[string]interface{}{"content": "no address"})
+	require.ErrorIs(t, err, pipeline.ErrNotMatch)
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeGrok, Pattern: `^%{IP:ip}`, IgnoreFailure: true}},
+		map[string]interface{}{"content": "no address"})
+	require.NoError(t, err)
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeGrok, Pattern: `^%{IP:ip}`}},
+		map[string]interface{}{"content": 1.0})
+	require.ErrorIs(t, err, pipeline.ErrFieldType)
+}
+
+func TestKVAndJSON(t *testing.T) {
+	fields := map[string]interface{}{"content": `level=info msg="hello world" empty= novalue`}
+	_, err := process(t, []*pipeline.Config{{Type: pipeline.TypeKV, Prefix: "kv_"}}, fields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"content":  `level=info msg="hello world" empty= novalue`,
+		"kv_level": "info",
+		"kv_msg":   "hello world",
+		"kv_empty": "",
+	}, fields)
+
+	fields = map[string]interface{}{"content": "a:1; b:2"}
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeKV, FieldSplit: ";", ValueSplit: ":", RemoveSource: true}}, fields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"a": "1", "b": "2"}, fields)
+
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeKV}}, map[string]interface{}{"content": "no pairs"})
+	require.ErrorIs(t, err, pipeline.ErrNotMatch)
+
+	fields = map[string]interface{}{"payload": `{"user":"bob","code":404,"ok":false,"tags":{"a":"b"},"none":null}`}
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeJSON, Field: "payload", RemoveSource: true}}, fields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"user": "bob",
+		"code": float64(404),
+		"ok":   false,
+		"tags": map[string]interface{}{"a": "b"},
+	}, fields)
+
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeJSON}}, map[string]interface{}{"content": "[1]"})
+	require.Error(t, err)
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeJSON, Field: "payload"}}, map[string]interface{}{"content": "{}"})
+	require.ErrorIs(t, err, pipeline.ErrFieldMissing)
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeJSON, Field: "payload", IgnoreMissing: true}}, map[string]interface{}{"content": "{}"})
+	require.NoError(t, err)
+}
+
+func TestDropRenameAndTimestamp(t *testing.T) {
+	fields := map[string]interface{}{"content": "x", "a": "1", "b": "2", "secret": "s"}
+	_, err := process(t, []*pipeline.Config{
+		{Type: pipeline.TypeDrop, Fields: []string{"secret", "absent"}},
+		{Type: pipeline.TypeRename, Rename: map[string]string{"a": "b", "b": "a", "absent": "c"}},
+	}, fields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{"content": "x", "a": "2", "b": "1"}, fields)
+
+	fields = map[string]interface{}{"content": "x", "ts": "2024-07-01 19:30:12.5"}
+	ts, err := process(t, []*pipeline.Config{
+		{Type: pipeline.TypeTimestamp, Field: "ts", Format: "2006-01-02 15:04:05", Timezone: "Asia/Shanghai", RemoveSource: true},
+	}, fields)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 7, 1, 11, 30, 12, 5e8, time.UTC).UnixNano(), ts)
+	require.Equal(t, map[string]interface{}{"content": "x"}, fields)
+
+	for _, v := range []interface{}{float64(1719862212), "1719862212"} {
+		ts, err = process(t, []*pipeline.Config{{Type: pipeline.TypeTimestamp, Field: "ts", Format: "unix"}},
+			map[string]interface{}{"ts": v})
+		require.NoError(t, err)
+		require.Equal(t, int64(1719862212)*1e9, ts)
+	}
+	ts, err = process(t, []*pipeline.Config{{Type: pipeline.TypeTimestamp, Field: "ts", Format: "unix_ms"}},
+		map[string]interface{}{"ts": "1719862212771.5"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1719862212771500000), ts)
+
+	_, err = process(t, []*pipeline.Config{{Type: pipeline.TypeTimestamp, Field: "ts", Format: "unix"}},
+		map[string]interface{}{"ts": "yesterday"})
+	require.Error(t, err)
+	ts, err = process(t, []*pipeline.Config{{Type: pipeline.TypeTimestamp, Field: "ts", Format: "unix", IgnoreFailure: true}},
+		map[string]interface{}{"ts": "yesterday"})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ts)
+}
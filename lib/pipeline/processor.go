@@ -0,0 +1,349 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pipeline
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+)
+
+// capture is a named group of the pattern, the value is converted to float64 if numeric is set.
+type capture struct {
+	index   int
+	name    string
+	numeric bool
+}
+
+// regexProcessor extracts the named groups of the pattern into fields. Groups which do not participate in
+// the match or match an empty string are skipped.
+type regexProcessor struct {
+	source
+	re       *regexp.Regexp
+	captures []capture
+}
+
+func newRegexProcessor(src source, pattern string) (*regexProcessor, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern is required")
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	p := &regexProcessor{source: src, re: re}
+	for i, name := range re.SubexpNames() {
+		if name != "" {
+			p.captures = append(p.captures, capture{index: i, name: name})
+		}
+	}
+	if len(p.captures) == 0 {
+		return nil, errors.New("pattern has no named group")
+	}
+	return p, nil
+}
+
+func (p *regexProcessor) process(fields map[string]interface{}) (int64, error) {
+	s, ok, err := p.stringValue(fields)
+	if !ok {
+		return 0, err
+	}
+	loc := p.re.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return 0, p.failed(ErrNotMatch)
+	}
+	p.consume(fields)
+	for _, c := range p.captures {
+		start, end := loc[2*c.index], loc[2*c.index+1]
+		if start < 0 || start == end {
+			continue
+		}
+		v := s[start:end]
+		if !c.numeric {
+			fields[c.name] = v
+			continue
+		}
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			fields[c.name] = f
+		} else {
+			fields[c.name] = v
+		}
+	}
+	return 0, nil
+}
+
+func newGrokProcessor(src source, pattern string, definitions map[string]string) (*regexProcessor, error) {
+	if pattern == "" {
+		return nil, errors.New("pattern is required")
+	}
+	expr, captures, err := compileGrok(pattern, definitions)
+	if err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	for i := range captures {
+		captures[i].index = re.SubexpIndex(grokGroupName(i))
+	}
+	if len(captures) == 0 {
+		return nil, errors.New("pattern has no named field")
+	}
+	return &regexProcessor{source: src, re: re, captures: captures}, nil
+}
+
+// kvProcessor splits the pairs like "k1=v1 k2=v2" into fields. The separators within double quotes are ignored
+// and the quotes around the values are removed, the pairs without the value separator are skipped.
+type kvProcessor struct {
+	source
+	fieldSplit string
+	valueSplit string
+	prefix     string
+}
+
+func newKVProcessor(src source, fieldSplit, valueSplit, prefix string) (*kvProcessor, error) {
+	if fieldSplit == "" {
+		fieldSplit = " "
+	}
+	if valueSplit == "" {
+		valueSplit = "="
+	}
+	if fieldSplit == valueSplit {
+		return nil, errors.New("field_split and value_split are the same")
+	}
+	return &kvProcessor{source: src, fieldSplit: fieldSplit, valueSplit: valueSplit, prefix: prefix}, nil
+}
+
+func (p *kvProcessor) process(fields map[string]interface{}) (int64, error) {
+	s, ok, err := p.stringValue(fields)
+	if !ok {
+		return 0, err
+	}
+	pairs := splitQuoted(s, p.fieldSplit)
+	consumed := false
+	for _, pair := range pairs {
+		k, v, found := strings.Cut(pair, p.valueSplit)
+		k = strings.TrimSpace(k)
+		if !found || k == "" {
+			continue
+		}
+		if !consumed {
+			p.consume(fields)
+			consumed = true
+		}
+		v = strings.TrimSpace(v)
+		if len(v) >= 2 && v[0] == '"' && v[len(v)-1] == '"' {
+			v = v[1 : len(v)-1]
+		}
+		fields[p.prefix+k] = v
+	}
+	if !consumed {
+		return 0, p.failed(ErrNotMatch)
+	}
+	return 0, nil
+}
+
+// splitQuoted splits s by sep which is not within double quotes, the empty parts are dropped.
+func splitQuoted(s, sep string) []string {
+	var parts []string
+	quoted := false
+	start := 0
+	for i := 0; i < len(s); {
+		switch {
+		case s[i] == '"':
+			quoted = !quoted
+		case !quoted && strings.HasPrefix(s[i:], sep):
+			if i > start {
+				parts = append(parts, s[start:i])
+			}
+			i += len(sep)
+			start = i
+			continue
+		}
+		i++
+	}
+	if start < len(s) {
+		parts = append(parts, s[start:])
+	}
+	return parts
+}
+
+// jsonProcessor unpacks the json object in a string field into fields, nested objects are kept as they are.
+type jsonProcessor struct {
+	source
+	prefix string
+}
+
+func (p *jsonProcessor) process(fields map[string]interface{}) (int64, error) {
+	s, ok, err := p.stringValue(fields)
+	if !ok {
+		return 0, err
+	}
+	var object map[string]interface{}
+	if err = sonic.UnmarshalString(s, &object); err != nil || object == nil {
+		return 0, p.failed(fmt.Errorf("pipeline: source field is not a json object"))
+	}
+	p.consume(fields)
+	for k, v := range object {
+		if v == nil {
+			continue
+		}
+		fields[p.prefix+k] = v
+	}
+	return 0, nil
+}
+
+// dropProcessor removes the fields, the fields which do not exist are ignored.
+type dropProcessor struct {
+	fields []string
+}
+
+func newDropProcessor(fields []string) (*dropProcessor, error) {
+	if len(fields) == 0 {
+		return nil, errors.New("fields are required")
+	}
+	return &dropProcessor{fields: fields}, nil
+}
+
+func (p *dropProcessor) process(fields map[string]interface{}) (int64, error) {
+	for _, f := range p.fields {
+		delete(fields, f)
+	}
+	return 0, nil
+}
+
+// renameProcessor renames the fields, the fields which do not exist are ignored.
+type renameProcessor struct {
+	rename map[string]string
+}
+
+func newRenameProcessor(rename map[string]string) (*renameProcessor, error) {
+	if len(rename) == 0 {
+		return nil, errors.New("rename is required")
+	}
+	for from, to := range rename {
+		if from == "" || to == "" {
+			return nil, errors.New("empty field name")
+		}
+	}
+	return &renameProcessor{rename: rename}, nil
+}
+
+func (p *renameProcessor) process(fields map[string]interface{}) (int64, error) {
+	// take all the values first, so that the fields can be swapped
+	values := make(map[string]interface{}, len(p.rename))
+	for from := range p.rename {
+		if v, ok := fields[from]; ok {
+			values[from] = v
+			delete(fields, from)
+		}
+	}
+	for from, v := range values {
+		fields[p.rename[from]] = v
+	}
+	return 0, nil
+}
+
+// epoch formats of the timestamp processor, the value is the multiplier to nanoseconds
+var epochFormats = map[string]int64{
+	"unix":    1e9,
+	"unix_ms": 1e6,
+	"unix_us": 1e3,
+	"unix_ns": 1,
+}
+
+// timestampProcessor parses the time of the log from a field.
+type timestampProcessor struct {
+	source
+	layout     string
+	multiplier int64
+	location   *time.Location
+}
+
+func newTimestampProcessor(src source, format, timezone string) (*timestampProcessor, error) {
+	if format == "" {
+		return nil, errors.New("format is required")
+	}
+	p := &timestampProcessor{source: src, location: time.UTC}
+	if m, ok := epochFormats[format]; ok {
+		p.multiplier = m
+	} else {
+		p.layout = format
+	}
+	if timezone != "" {
+		loc, err := time.LoadLocation(timezone)
+		if err != nil {
+			return nil, err
+		}
+		p.location = loc
+	}
+	return p, nil
+}
+
+func (p *timestampProcessor) process(fields map[string]interface{}) (int64, error) {
+	v, ok, err := p.value(fields)
+	if !ok {
+		return 0, err
+	}
+	ts, err := p.parse(v)
+	if err != nil || ts == 0 {
+		return 0, p.failed(fmt.Errorf("pipeline: parse timestamp %v failed", v))
+	}
+	p.consume(fields)
+	return ts, nil
+}
+
+func (p *timestampProcessor) parse(v interface{}) (int64, error) {
+	if p.multiplier == 0 {
+		s, ok := v.(string)
+		if !ok {
+			return 0, ErrFieldType
+		}
+		t, err := time.ParseInLocation(p.layout, s, p.location)
+		if err != nil {
+			return 0, err
+		}
+		return t.UnixNano(), nil
+	}
+
+	switch n := v.(type) {
+	case float64:
+		return p.epoch(n), nil
+	case string:
+		if i, err := strconv.ParseInt(n, 10, 64); err == nil {
+			return i * p.multiplier, nil
+		}
+		f, err := strconv.ParseFloat(n, 64)
+		if err != nil {
+			return 0, err
+		}
+		return p.epoch(f), nil
+	default:
+		return 0, ErrFieldType
+	}
+}
+
+// epoch converts the integer and the fraction separately, which keeps the precision of the nanoseconds.
+func (p *timestampProcessor) epoch(f float64) int64 {
+	i, frac := math.Modf(f)
+	return int64(i)*p.multiplier + int64(math.Round(frac*float64(p.multiplier)))
+}
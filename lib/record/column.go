@@ -135,7 +135,7 @@ func (cv *ColVal) Append(value []byte, offsets []uint32, bitMap []byte, bitOffse
 	} else {
 		startOffset, endOffset = valueIndexRange(bitMap, bitOffset, start, end, pos, posValidCount)
 	}
-	if colType == influx.Field_Type_Int {
+	if colType == influx.Field_Type_Int || colType == influx.Field_Type_UInt {
 		cv.Val = append(cv.Val, value[startOffset*util.Int64SizeBytes:endOffset*util.Int64SizeBytes]...)
 	} else if colType == influx.Field_Type_Float {
 		cv.Val = append(cv.Val, value[startOffset*util.Float64SizeBytes:endOffset*util.Float64SizeBytes]...)
@@ -335,7 +335,7 @@ func (cv *ColVal) reserveValOffset(size int) {
 
 func (cv *ColVal) sliceValAndOffset(srcCol *ColVal, start, end, colType, valOffset int) (offset int, valueValidCount int) {
 	var validCount, endOffset int
-	if colType == influx.Field_Type_Int {
+	if colType == influx.Field_Type_Int || colType == influx.Field_Type_UInt {
 		validCount = srcCol.ValidCount(start, end)
 		endOffset = valOffset + util.Int64SizeBytes*validCount
 		cv.Val = srcCol.Val[valOffset:endOffset]
@@ -459,7 +459,7 @@ func (cv *ColVal) getValIndexRange(bmStart, bmEnd int) (valStart, valEnd int) {
 
 func (cv *ColVal) calcColumnOffset(ty int, start int) int {
 	var colValOffset int
-	if ty == influx.Field_Type_Int {
+	if ty == influx.Field_Type_Int || ty == influx.Field_Type_UInt {
 		colValOffset, _ = cv.getValIndexRange(start, start)
 		colValOffset = colValOffset * util.Int64SizeBytes
 	} else if ty == influx.Field_Type_Float {
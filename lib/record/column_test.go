@@ -15,6 +15,7 @@
 package record_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/openGemini/openGemini/lib/record"
@@ -409,6 +410,34 @@ func TestRemoveLastInteger(t *testing.T) {
 	require.Equal(t, 0, rows.RowNums())
 }
 
+func TestUnsignedColVal(t *testing.T) {
+	col := &record.ColVal{}
+	col.AppendUnsigned(math.MaxUint64)
+	col.AppendUnsignedNull()
+	col.AppendUnsigneds(1, 1<<63)
+	require.Equal(t, 4, col.Len)
+	require.Equal(t, 1, col.NilCount)
+	require.Equal(t, []uint64{math.MaxUint64, 1, 1 << 63}, col.UnsignedValues())
+
+	_, isNil := col.UnsignedValue(1)
+	require.True(t, isNil)
+	v, isNil := col.UnsignedValue(3)
+	require.False(t, isNil)
+	require.Equal(t, uint64(1<<63), v)
+
+	values := col.UnsignedValues()
+	maxV, maxRow := col.MaxUnsignedValue(values, 0, col.Len)
+	require.Equal(t, uint64(math.MaxUint64), maxV)
+	require.Equal(t, 0, maxRow)
+	minV, minRow := col.MinUnsignedValue(values, 0, col.Len)
+	require.Equal(t, uint64(1), minV)
+	require.Equal(t, 2, minRow)
+
+	col.RemoveLastUnsigned()
+	require.Equal(t, 3, col.Len)
+	require.Equal(t, []uint64{math.MaxUint64, 1}, col.UnsignedValues())
+}
+
 func TestColVal_PadEmptyColVal(t *testing.T) {
 	type fields struct {
 		Val          []byte
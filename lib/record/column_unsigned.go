@@ -0,0 +1,83 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+func (cv *ColVal) AppendUnsigneds(values ...uint64) {
+	appendValues(cv, values...)
+}
+
+func (cv *ColVal) AppendUnsigned(v uint64) {
+	appendValue(cv, v)
+}
+
+func (cv *ColVal) RemoveLastUnsigned() {
+	removeLastValue[uint64](cv)
+}
+
+func (cv *ColVal) AppendUnsignedNulls(count int) {
+	appendNulls(cv, count)
+}
+
+func (cv *ColVal) AppendUnsignedNull() {
+	appendNull(cv)
+}
+
+func (cv *ColVal) UnsignedValues() []uint64 {
+	return values[uint64](cv)
+}
+
+func (cv *ColVal) SubUnsignedValues(start, end int) []uint64 {
+	return subValues[uint64](cv, start, end)
+}
+
+func (cv *ColVal) UnsignedValue(i int) (uint64, bool) {
+	return value(cv, cv.UnsignedValues(), i)
+}
+
+func (cv *ColVal) AppendUnsignedNullReserve() {
+	appendNullReserve[uint64](cv)
+}
+
+func (cv *ColVal) UpdateUnsignedValue(v uint64, isNil bool, row int) {
+	updateValue(cv, v, isNil, row)
+}
+
+func (cv *ColVal) UnsignedValueWithNullReserve(index int) (uint64, bool) {
+	return cv.UnsignedValues()[index], cv.IsNil(index)
+}
+
+func (cv *ColVal) MaxUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	return maxValue(values, start, end, cv)
+}
+
+func (cv *ColVal) MinUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	return minValue(values, start, end, cv)
+}
+
+func (cv *ColVal) FirstUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	return firstValue(values, start, end, cv)
+}
+
+func (cv *ColVal) LastUnsignedValue(values []uint64, start, end int) (uint64, int) {
+	return lastValue(values, start, end, cv)
+}
+
+func (cv *ColVal) MaxUnsignedValues(values []uint64, start, end int) (uint64, []int) {
+	return maxValues(values, start, end, cv)
+}
+
+func (cv *ColVal) MinUnsignedValues(values []uint64, start, end int) (uint64, []int) {
+	return minValues(values, start, end, cv)
+}
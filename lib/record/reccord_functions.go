@@ -784,3 +784,218 @@ func UpdateMaxProm(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int
 		iRec.UpdateIntervalRecRow(rec, recRow, iRecRow)
 	}
 }
+
+func updateUnsignedFirstLastImp(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int, isFast bool, compare func(t1, t2 int64) bool) {
+	var v uint64
+	if isFast {
+		v = rec.ColVals[recColumn].UnsignedValues()[recRow]
+	} else {
+		var isNil bool
+		v, isNil = rec.ColVals[recColumn].UnsignedValue(recRow)
+		if isNil {
+			return
+		}
+	}
+
+	t1, _ := iRec.ColVals[len(iRec.Schema)-1].IntegerValueWithNullReserve(iRecRow)
+	t2, _ := rec.ColVals[len(rec.Schema)-1].IntegerValue(recRow)
+	if compare(t1, t2) {
+		iRec.UpdateIntervalRecRow(rec, recRow, iRecRow)
+		return
+	}
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if !isSrcNil && compare(t2, t1) {
+		return
+	}
+	if srcVal >= v && !isSrcNil {
+		return
+	}
+	iRec.UpdateIntervalRecRow(rec, recRow, iRecRow)
+}
+
+func UpdateUnsignedFirst(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, false, func(t1, t2 int64) bool {
+		return t1 > t2
+	})
+}
+
+func UpdateUnsignedFirstFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, true, func(t1, t2 int64) bool {
+		return t1 > t2
+	})
+}
+
+func UpdateUnsignedLast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, false, func(t1, t2 int64) bool {
+		return t1 < t2
+	})
+}
+
+func UpdateUnsignedLastFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, true, func(t1, t2 int64) bool {
+		return t1 < t2
+	})
+}
+
+func updateUnsignedColumnFirstLastImp(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int, isFast bool, compare func(t1, t2 int64) bool) {
+	var v uint64
+	if isFast {
+		v = rec.ColVals[recColumn].UnsignedValues()[recRow]
+	} else {
+		var isNil bool
+		v, isNil = rec.ColVals[recColumn].UnsignedValue(recRow)
+		if isNil {
+			return
+		}
+	}
+	if compare(iRec.RecMeta.Times[iRecColumn][iRecRow], rec.RecMeta.Times[recColumn][recRow]) {
+		iRec.RecMeta.Times[iRecColumn][iRecRow] = rec.RecMeta.Times[recColumn][recRow]
+		iRec.ColVals[iRecColumn].UpdateUnsignedValue(v, false, iRecRow)
+		return
+	}
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if !isSrcNil && compare(rec.RecMeta.Times[recColumn][recRow], iRec.RecMeta.Times[iRecColumn][iRecRow]) {
+		return
+	}
+	if srcVal >= v && !isSrcNil {
+		return
+	}
+	iRec.ColVals[iRecColumn].UpdateUnsignedValue(v, false, iRecRow)
+	iRec.RecMeta.Times[iRecColumn][iRecRow] = rec.RecMeta.Times[recColumn][recRow]
+}
+
+func UpdateUnsignedColumnFirst(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedColumnFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, false, func(t1, t2 int64) bool {
+		return t1 > t2
+	})
+}
+
+func UpdateUnsignedColumnFirstFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedColumnFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, true, func(t1, t2 int64) bool {
+		return t1 > t2
+	})
+}
+
+func UpdateUnsignedColumnLast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedColumnFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, false, func(t1, t2 int64) bool {
+		return t1 < t2
+	})
+}
+
+func UpdateUnsignedColumnLastFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	updateUnsignedColumnFirstLastImp(iRec, rec, recColumn, iRecColumn, recRow, iRecRow, true, func(t1, t2 int64) bool {
+		return t1 < t2
+	})
+}
+
+func UpdateUnsignedMin(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedMinImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
+func updateUnsignedMinImpl(v uint64, iRec, rec *Record, iRecColumn, recRow, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal < v && !isSrcNil {
+		return
+	}
+	t1, _ := iRec.ColVals[len(iRec.Schema)-1].IntegerValueWithNullReserve(iRecRow)
+	t2, _ := rec.ColVals[len(rec.Schema)-1].IntegerValue(recRow)
+	if srcVal == v && t1 <= t2 && !isSrcNil {
+		return
+	}
+	iRec.UpdateIntervalRecRow(rec, recRow, iRecRow)
+}
+
+func UpdateUnsignedMinFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedMinImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
+func UpdateUnsignedMax(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedMaxImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
+func updateUnsignedMaxImpl(v uint64, iRec, rec *Record, iRecColumn, recRow, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal > v && !isSrcNil {
+		return
+	}
+	t1, _ := iRec.ColVals[len(iRec.Schema)-1].IntegerValueWithNullReserve(iRecRow)
+	t2, _ := rec.ColVals[len(rec.Schema)-1].IntegerValue(recRow)
+	if srcVal == v && t1 <= t2 && !isSrcNil {
+		return
+	}
+	iRec.UpdateIntervalRecRow(rec, recRow, iRecRow)
+}
+
+func UpdateUnsignedMaxFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedMaxImpl(v, iRec, rec, iRecColumn, recRow, iRecRow)
+}
+
+func UpdateUnsignedColumnMin(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedColumnMinImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func updateUnsignedColumnMinImpl(v uint64, iRec *Record, iRecColumn, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal <= v && !isSrcNil {
+		return
+	}
+	iRec.ColVals[iRecColumn].UpdateUnsignedValue(v, false, iRecRow)
+}
+
+func UpdateUnsignedColumnMinFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedColumnMinImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func UpdateUnsignedColumnMax(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedColumnMaxImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func updateUnsignedColumnMaxImpl(v uint64, iRec *Record, iRecColumn, iRecRow int) {
+	srcVal, isSrcNil := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	if srcVal >= v && !isSrcNil {
+		return
+	}
+	iRec.ColVals[iRecColumn].UpdateUnsignedValue(v, false, iRecRow)
+}
+
+func UpdateUnsignedColumnMaxFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedColumnMaxImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func UpdateUnsignedSum(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v, isNil := rec.ColVals[recColumn].UnsignedValue(recRow)
+	if isNil {
+		return
+	}
+	updateUnsignedSumImpl(v, iRec, iRecColumn, iRecRow)
+}
+
+func updateUnsignedSumImpl(v uint64, iRec *Record, iRecColumn, iRecRow int) {
+	srcVal, _ := iRec.ColVals[iRecColumn].UnsignedValueWithNullReserve(iRecRow)
+	iRec.ColVals[iRecColumn].UpdateUnsignedValue(v+srcVal, false, iRecRow)
+}
+
+func UpdateUnsignedSumFast(iRec, rec *Record, recColumn, iRecColumn, recRow, iRecRow int) {
+	v := rec.ColVals[recColumn].UnsignedValues()[recRow]
+	updateUnsignedSumImpl(v, iRec, iRecColumn, iRecRow)
+}
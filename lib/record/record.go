@@ -43,12 +43,14 @@ func init() {
 	intervalRecUpdateFunctions[influx.Field_Type_String] = stringUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Tag] = stringUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Int] = integerUpdateFunction
+	intervalRecUpdateFunctions[influx.Field_Type_UInt] = unsignedUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Float] = floatUpdateFunction
 	intervalRecUpdateFunctions[influx.Field_Type_Boolean] = booleanUpdateFunction
 
 	recTransAppendFunctions[influx.Field_Type_String] = recStringAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Tag] = recStringAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Int] = recIntegerAppendFunction
+	recTransAppendFunctions[influx.Field_Type_UInt] = recUnsignedAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Float] = recFloatAppendFunction
 	recTransAppendFunctions[influx.Field_Type_Boolean] = recBooleanAppendFunction
 }
@@ -974,6 +976,8 @@ func (rec *Record) String() string {
 			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).BooleanValues())
 		case influx.Field_Type_Int:
 			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).IntegerValues())
+		case influx.Field_Type_UInt:
+			line = fmt.Sprintf("field(%v):%#v\n", f.Name, rec.Column(i).UnsignedValues())
 		}
 
 		sb.WriteString(line)
@@ -988,7 +992,7 @@ func (rec *Record) ReserveColumnRows(rows int) {
 		col := &rec.ColVals[i]
 		l := len(col.Val)
 		switch schema.Type {
-		case influx.Field_Type_Float, influx.Field_Type_Int:
+		case influx.Field_Type_Float, influx.Field_Type_Int, influx.Field_Type_UInt:
 			size := rows * 8
 			if cap(col.Val) < size {
 				newCol := make([]byte, size)
@@ -1305,6 +1309,8 @@ func (rec *Record) AppendIntervalEmptyRow(rowTime int64, initRecMeta bool, first
 			rec.ColVals[i].AppendStringNull()
 		case influx.Field_Type_Int:
 			rec.ColVals[i].AppendIntegerNullReserve()
+		case influx.Field_Type_UInt:
+			rec.ColVals[i].AppendUnsignedNullReserve()
 		case influx.Field_Type_Boolean:
 			rec.ColVals[i].AppendBooleanNullReserve()
 		default:
@@ -1449,6 +1455,12 @@ func integerUpdateFunction(rec, iRec *Record, index, row, recRow int) {
 	updateRecMeta(rec, iRec, index, row, recRow)
 }
 
+func unsignedUpdateFunction(rec, iRec *Record, index, row, recRow int) {
+	v, isNil := rec.ColVals[index].UnsignedValue(recRow)
+	iRec.ColVals[index].UpdateUnsignedValue(v, isNil, row)
+	updateRecMeta(rec, iRec, index, row, recRow)
+}
+
 func floatUpdateFunction(rec, iRec *Record, index, row, recRow int) {
 	v, isNil := rec.ColVals[index].FloatValue(recRow)
 	iRec.ColVals[index].UpdateFloatValue(v, isNil, row)
@@ -1481,6 +1493,16 @@ func recIntegerAppendFunction(rec, iRec *Record, index, row int) {
 	appendRecMeta2Rec(rec, iRec, index, row)
 }
 
+func recUnsignedAppendFunction(rec, iRec *Record, index, row int) {
+	v, isNil := iRec.ColVals[index].UnsignedValueWithNullReserve(row)
+	if isNil {
+		rec.ColVals[index].AppendUnsignedNull()
+	} else {
+		rec.ColVals[index].AppendUnsigned(v)
+	}
+	appendRecMeta2Rec(rec, iRec, index, row)
+}
+
 func recFloatAppendFunction(rec, iRec *Record, index, row int) {
 	v, isNil := iRec.ColVals[index].FloatValueWithNullReserve(row)
 	if isNil {
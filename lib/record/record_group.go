@@ -26,9 +26,12 @@ import (
 
 func AppendFieldToCol(col *ColVal, field *influx.Field, size *int64) error {
 	switch field.Type {
-	case influx.Field_Type_Int, influx.Field_Type_UInt:
+	case influx.Field_Type_Int:
 		col.AppendInteger(int64(field.NumValue))
 		*size += int64(util.Int64SizeBytes)
+	case influx.Field_Type_UInt:
+		col.AppendUnsigned(field.UintValue())
+		*size += int64(util.Uint64SizeBytes)
 	case influx.Field_Type_Float:
 		col.AppendFloat(field.NumValue)
 		*size += int64(util.Float64SizeBytes)
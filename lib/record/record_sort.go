@@ -276,7 +276,7 @@ func (cv *ColVal) AppendWithNilCount(src *ColVal, colType, start, end int, nc *N
 	switch colType {
 	case influx.Field_Type_String, influx.Field_Type_Tag:
 		cv.appendString(src, start, end)
-	case influx.Field_Type_Int, influx.Field_Type_Float, influx.Field_Type_Boolean:
+	case influx.Field_Type_Int, influx.Field_Type_UInt, influx.Field_Type_Float, influx.Field_Type_Boolean:
 		size := typeSize[colType]
 		cv.Val = append(cv.Val, src.Val[startOffset*size:endOffset*size]...)
 	default:
@@ -51,7 +51,7 @@ func ArrowColToNativeColWithoutNull(buffer []*memory.Buffer, colVal *ColVal, col
 	switch colType {
 	case influx.Field_Type_Float:
 		colVal.Val = buffer[1].Bytes()[:buffer[1].Len()]
-	case influx.Field_Type_Int:
+	case influx.Field_Type_Int, influx.Field_Type_UInt:
 		colVal.Val = buffer[1].Bytes()[:buffer[1].Len()]
 	case influx.Field_Type_String, influx.Field_Type_Tag:
 		colVal.Val = buffer[2].Bytes()[:buffer[2].Len()]
@@ -85,6 +85,15 @@ func ArrowColToNativeColWithNull(colArr arrow.Array, colVal *ColVal, colType int
 			}
 		}
 		colVal.Val = util.Int64Slice2byte(values)
+	case influx.Field_Type_UInt:
+		uintCol, _ := colArr.(*array.Uint64)
+		values := make([]uint64, 0, colVal.Len-colVal.NilCount)
+		for i := 0; i < colVal.Len; i++ {
+			if colArr.IsValid(i) {
+				values = append(values, uintCol.Value(i))
+			}
+		}
+		colVal.Val = util.Uint64Slice2byte(values)
 	case influx.Field_Type_String, influx.Field_Type_Tag:
 		strCol, _ := colArr.(*array.String)
 		for i := 0; i < colVal.Len; i++ {
@@ -140,6 +149,8 @@ func ArrowTypeToNativeType(dataType arrow.DataType) int {
 		return influx.Field_Type_Float
 	case arrow.INT64:
 		return influx.Field_Type_Int
+	case arrow.UINT64:
+		return influx.Field_Type_UInt
 	case arrow.BOOL:
 		return influx.Field_Type_Boolean
 	case arrow.STRING:
@@ -30,6 +30,7 @@ type Handler struct {
 	WriteRequests                *ItemInt64 `name:"writeReq"`                // Number of write requests serverd.
 	Write400ErrRequests          *ItemInt64 `name:"write400ErrReq"`          // Number of write 400 requests occur error.
 	Write500ErrRequests          *ItemInt64 `name:"write500ErrReq"`          // Number of write 500 requests occur error.
+	Write429ErrRequests          *ItemInt64 `name:"write429ErrReq"`          // Number of write requests rejected by the tenant limits.
 	PingRequests                 *ItemInt64 `name:"pingReq"`                 // Number of ping requests served.
 	StatusRequests               *ItemInt64 `name:"statusReq"`               // Number of status requests served.
 	WriteRequestBytesReceived    *ItemInt64 `name:"writeReqBytesIn"`         // Sum of all bytes in write requests.
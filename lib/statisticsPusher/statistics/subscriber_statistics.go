@@ -0,0 +1,152 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// SubscriberStats keeps the delivery statistics of one subscription destination
+type SubscriberStats struct {
+	Enqueued  int64 // number of write requests appended to the queue
+	Delivered int64 // number of write requests delivered to the destination
+	Retries   int64 // number of failed delivery attempts
+	Dropped   int64 // number of write requests dropped before delivery
+
+	LagBytes int64 // size of the undelivered data in the queue
+	HeadTime int64 // enqueue time (unix nano) of the oldest undelivered write request, 0 if there is none
+}
+
+func (s *SubscriberStats) AddEnqueued(n int64) {
+	atomic.AddInt64(&s.Enqueued, n)
+}
+
+func (s *SubscriberStats) AddDelivered(n int64) {
+	atomic.AddInt64(&s.Delivered, n)
+}
+
+func (s *SubscriberStats) AddRetries(n int64) {
+	atomic.AddInt64(&s.Retries, n)
+}
+
+func (s *SubscriberStats) AddDropped(n int64) {
+	atomic.AddInt64(&s.Dropped, n)
+}
+
+func (s *SubscriberStats) SetLagBytes(n int64) {
+	atomic.StoreInt64(&s.LagBytes, n)
+}
+
+func (s *SubscriberStats) SetHeadTime(t int64) {
+	atomic.StoreInt64(&s.HeadTime, t)
+}
+
+type subscriberKey struct {
+	db, rp, name, dest string
+}
+
+// SubscriberStatistics keeps statistics related to the subscriptions
+type SubscriberStatistics struct {
+	mu    sync.RWMutex
+	stats map[subscriberKey]*SubscriberStats
+}
+
+const (
+	StatSubscriberDatabase    = "database"
+	StatSubscriberRP          = "retentionPolicy"
+	StatSubscriberName        = "subscription"
+	StatSubscriberDestination = "destination"
+
+	StatSubscriberEnqueued  = "enqueued"
+	StatSubscriberDelivered = "delivered"
+	StatSubscriberRetries   = "retries"
+	StatSubscriberDropped   = "dropped"
+	StatSubscriberLagBytes  = "lagBytes"
+	StatSubscriberLagMs     = "lagMs"
+)
+
+var SubscriberStat = NewSubscriberStatistics()
+var SubscriberTagMap map[string]string
+var SubscriberStatisticsName = "subscriber"
+
+func NewSubscriberStatistics() *SubscriberStatistics {
+	return &SubscriberStatistics{
+		stats: make(map[subscriberKey]*SubscriberStats),
+	}
+}
+
+func InitSubscriberStatistics(tags map[string]string) {
+	SubscriberStat = NewSubscriberStatistics()
+	SubscriberTagMap = tags
+}
+
+// GetStats returns the statistics of the subscription destination, creating it if it does not exist
+func (s *SubscriberStatistics) GetStats(db, rp, name, dest string) *SubscriberStats {
+	key := subscriberKey{db: db, rp: rp, name: name, dest: dest}
+	s.mu.RLock()
+	stat, ok := s.stats[key]
+	s.mu.RUnlock()
+	if ok {
+		return stat
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stat, ok = s.stats[key]; !ok {
+		stat = &SubscriberStats{}
+		s.stats[key] = stat
+	}
+	return stat
+}
+
+func (s *SubscriberStatistics) Delete(db, rp, name, dest string) {
+	s.mu.Lock()
+	delete(s.stats, subscriberKey{db: db, rp: rp, name: name, dest: dest})
+	s.mu.Unlock()
+}
+
+func CollectSubscriberStatistics(buffer []byte) ([]byte, error) {
+	now := time.Now().UnixNano()
+
+	SubscriberStat.mu.RLock()
+	defer SubscriberStat.mu.RUnlock()
+	for key, stats := range SubscriberStat.stats {
+		tagMap := make(map[string]string)
+		AllocTagMap(tagMap, SubscriberTagMap)
+		tagMap[StatSubscriberDatabase] = key.db
+		tagMap[StatSubscriberRP] = key.rp
+		tagMap[StatSubscriberName] = key.name
+		tagMap[StatSubscriberDestination] = key.dest
+
+		lagMs := int64(0)
+		if headTime := atomic.LoadInt64(&stats.HeadTime); headTime > 0 && now > headTime {
+			lagMs = (now - headTime) / int64(time.Millisecond)
+		}
+		valueMap := map[string]interface{}{
+			StatSubscriberEnqueued:  atomic.LoadInt64(&stats.Enqueued),
+			StatSubscriberDelivered: atomic.LoadInt64(&stats.Delivered),
+			StatSubscriberRetries:   atomic.LoadInt64(&stats.Retries),
+			StatSubscriberDropped:   atomic.LoadInt64(&stats.Dropped),
+			StatSubscriberLagBytes:  atomic.LoadInt64(&stats.LagBytes),
+			StatSubscriberLagMs:     lagMs,
+		}
+
+		buffer = AddPointToBuffer(SubscriberStatisticsName, tagMap, valueMap, buffer)
+	}
+
+	return buffer, nil
+}
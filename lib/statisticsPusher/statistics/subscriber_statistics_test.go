@@ -0,0 +1,65 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectSubscriberStatistics(t *testing.T) {
+	tags := map[string]string{
+		"hostname": "127.0.0.1:8090",
+		"app":      "ts-sql",
+	}
+	statistics.InitSubscriberStatistics(tags)
+	stat := statistics.SubscriberStat.GetStats("db0", "rp0", "sub0", "http://127.0.0.1:8086")
+	require.Same(t, stat, statistics.SubscriberStat.GetStats("db0", "rp0", "sub0", "http://127.0.0.1:8086"))
+	stat.AddEnqueued(5)
+	stat.AddDelivered(3)
+	stat.AddRetries(2)
+	stat.AddDropped(1)
+	stat.SetLagBytes(100)
+
+	statistics.NewTimestamp().Init(time.Second)
+	buf, err := statistics.CollectSubscriberStatistics(nil)
+	require.NoError(t, err)
+
+	expTags := map[string]string{
+		"hostname":        "127.0.0.1:8090",
+		"app":             "ts-sql",
+		"database":        "db0",
+		"retentionPolicy": "rp0",
+		"subscription":    "sub0",
+		"destination":     "http://127.0.0.1:8086",
+	}
+	fields := map[string]interface{}{
+		"enqueued":  int64(5),
+		"delivered": int64(3),
+		"retries":   int64(2),
+		"dropped":   int64(1),
+		"lagBytes":  int64(100),
+		"lagMs":     int64(0),
+	}
+	require.NoError(t, compareBuffer("subscriber", expTags, fields, buf))
+
+	statistics.SubscriberStat.Delete("db0", "rp0", "sub0", "http://127.0.0.1:8086")
+	buf, err = statistics.CollectSubscriberStatistics(nil)
+	require.NoError(t, err)
+	require.Empty(t, buf)
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// TenantLimitsStats keeps the counters of the requests of one tenant rejected by its limits
+type TenantLimitsStats struct {
+	RejectedSamples int64 // number of samples rejected by the ingestion rate limit
+	RejectedBytes   int64 // number of bytes rejected by the ingestion bytes rate limit
+	RejectedSeries  int64 // number of samples of new series rejected by the active series limit
+	RejectedQueries int64 // number of queries rejected by the concurrent queries limit
+	PointsLimitHits int64 // number of queries aborted by the points per query limit
+	ActiveSeries    int64 // number of series receiving samples recently
+	RunningQueries  int64 // number of queries running now
+}
+
+func (s *TenantLimitsStats) AddRejectedSamples(n int64) {
+	atomic.AddInt64(&s.RejectedSamples, n)
+}
+
+func (s *TenantLimitsStats) AddRejectedBytes(n int64) {
+	atomic.AddInt64(&s.RejectedBytes, n)
+}
+
+func (s *TenantLimitsStats) AddRejectedSeries(n int64) {
+	atomic.AddInt64(&s.RejectedSeries, n)
+}
+
+func (s *TenantLimitsStats) AddRejectedQueries(n int64) {
+	atomic.AddInt64(&s.RejectedQueries, n)
+}
+
+func (s *TenantLimitsStats) AddPointsLimitHits(n int64) {
+	atomic.AddInt64(&s.PointsLimitHits, n)
+}
+
+func (s *TenantLimitsStats) SetActiveSeries(n int64) {
+	atomic.StoreInt64(&s.ActiveSeries, n)
+}
+
+func (s *TenantLimitsStats) SetRunningQueries(n int64) {
+	atomic.StoreInt64(&s.RunningQueries, n)
+}
+
+// TenantLimitsStatistics keeps statistics related to the per-tenant limits
+type TenantLimitsStatistics struct {
+	mu    sync.RWMutex
+	stats map[string]*TenantLimitsStats
+}
+
+const (
+	StatTenantLimitsTenant = "tenant"
+
+	StatTenantRejectedSamples = "rejectedSamples"
+	StatTenantRejectedBytes   = "rejectedBytes"
+	StatTenantRejectedSeries  = "rejectedSeries"
+	StatTenantRejectedQueries = "rejectedQueries"
+	StatTenantPointsLimitHits = "pointsLimitHits"
+	StatTenantActiveSeries    = "activeSeries"
+	StatTenantRunningQueries  = "runningQueries"
+)
+
+var TenantLimitsStat = NewTenantLimitsStatistics()
+var TenantLimitsTagMap map[string]string
+var TenantLimitsStatisticsName = "tenant_limits"
+
+func NewTenantLimitsStatistics() *TenantLimitsStatistics {
+	return &TenantLimitsStatistics{
+		stats: make(map[string]*TenantLimitsStats),
+	}
+}
+
+func InitTenantLimitsStatistics(tags map[string]string) {
+	TenantLimitsStat = NewTenantLimitsStatistics()
+	TenantLimitsTagMap = tags
+}
+
+// GetStats returns the statistics of the tenant, creating it if it does not exist
+func (s *TenantLimitsStatistics) GetStats(tenant string) *TenantLimitsStats {
+	s.mu.RLock()
+	stat, ok := s.stats[tenant]
+	s.mu.RUnlock()
+	if ok {
+		return stat
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if stat, ok = s.stats[tenant]; !ok {
+		stat = &TenantLimitsStats{}
+		s.stats[tenant] = stat
+	}
+	return stat
+}
+
+func CollectTenantLimitsStatistics(buffer []byte) ([]byte, error) {
+	TenantLimitsStat.mu.RLock()
+	defer TenantLimitsStat.mu.RUnlock()
+	for tenant, stats := range TenantLimitsStat.stats {
+		tagMap := make(map[string]string)
+		AllocTagMap(tagMap, TenantLimitsTagMap)
+		tagMap[StatTenantLimitsTenant] = tenant
+
+		valueMap := map[string]interface{}{
+			StatTenantRejectedSamples: atomic.LoadInt64(&stats.RejectedSamples),
+			StatTenantRejectedBytes:   atomic.LoadInt64(&stats.RejectedBytes),
+			StatTenantRejectedSeries:  atomic.LoadInt64(&stats.RejectedSeries),
+			StatTenantRejectedQueries: atomic.LoadInt64(&stats.RejectedQueries),
+			StatTenantPointsLimitHits: atomic.LoadInt64(&stats.PointsLimitHits),
+			StatTenantActiveSeries:    atomic.LoadInt64(&stats.ActiveSeries),
+			StatTenantRunningQueries:  atomic.LoadInt64(&stats.RunningQueries),
+		}
+
+		buffer = AddPointToBuffer(TenantLimitsStatisticsName, tagMap, valueMap, buffer)
+	}
+
+	return buffer, nil
+}
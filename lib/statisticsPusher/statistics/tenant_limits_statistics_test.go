@@ -0,0 +1,60 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectTenantLimitsStatistics(t *testing.T) {
+	tags := map[string]string{
+		"hostname": "127.0.0.1:8090",
+		"app":      "ts-sql",
+	}
+	statistics.InitTenantLimitsStatistics(tags)
+	stat := statistics.TenantLimitsStat.GetStats("team_a")
+	require.Same(t, stat, statistics.TenantLimitsStat.GetStats("team_a"))
+	stat.AddRejectedSamples(10)
+	stat.AddRejectedBytes(200)
+	stat.AddRejectedSeries(3)
+	stat.AddRejectedQueries(2)
+	stat.AddPointsLimitHits(1)
+	stat.SetActiveSeries(50)
+	stat.SetRunningQueries(4)
+
+	statistics.NewTimestamp().Init(time.Second)
+	buf, err := statistics.CollectTenantLimitsStatistics(nil)
+	require.NoError(t, err)
+
+	expTags := map[string]string{
+		"hostname": "127.0.0.1:8090",
+		"app":      "ts-sql",
+		"tenant":   "team_a",
+	}
+	fields := map[string]interface{}{
+		"rejectedSamples": int64(10),
+		"rejectedBytes":   int64(200),
+		"rejectedSeries":  int64(3),
+		"rejectedQueries": int64(2),
+		"pointsLimitHits": int64(1),
+		"activeSeries":    int64(50),
+		"runningQueries":  int64(4),
+	}
+	require.NoError(t, compareBuffer("tenant_limits", expTags, fields, buf))
+}
@@ -0,0 +1,254 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const (
+	nilValue = "-"
+
+	rfc3164TimeLayout = time.Stamp
+	rfc3164TimeLen    = len(rfc3164TimeLayout)
+)
+
+var (
+	ErrNoPriority       = errors.New("syslog: missing priority")
+	ErrInvalidPriority  = errors.New("syslog: invalid priority")
+	ErrInvalidHeader    = errors.New("syslog: invalid header")
+	ErrInvalidTimestamp = errors.New("syslog: invalid timestamp")
+	ErrInvalidSD        = errors.New("syslog: invalid structured data")
+
+	utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+)
+
+var facilities = [...]string{
+	"kern", "user", "mail", "daemon", "auth", "syslog", "lpr", "news",
+	"uucp", "cron", "authpriv", "ftp", "ntp", "security", "console", "solaris-cron",
+	"local0", "local1", "local2", "local3", "local4", "local5", "local6", "local7",
+}
+
+var severities = [...]string{
+	"emerg", "alert", "crit", "err", "warning", "notice", "info", "debug",
+}
+
+// Message is a syslog message of RFC3164 or RFC5424.
+// Fields absent from the message are left empty, and the Timestamp is zero when the message has no time.
+type Message struct {
+	Facility       int
+	Severity       int
+	Timestamp      time.Time
+	Hostname       string
+	AppName        string
+	ProcID         string
+	MsgID          string
+	StructuredData string
+	Message        string
+}
+
+// FacilityName returns the keyword of the facility, such as "user" or "local0".
+func (m *Message) FacilityName() string {
+	if m.Facility >= 0 && m.Facility < len(facilities) {
+		return facilities[m.Facility]
+	}
+	return strconv.Itoa(m.Facility)
+}
+
+// SeverityName returns the keyword of the severity, such as "err" or "info".
+func (m *Message) SeverityName() string {
+	if m.Severity >= 0 && m.Severity < len(severities) {
+		return severities[m.Severity]
+	}
+	return strconv.Itoa(m.Severity)
+}
+
+// Parse parses a syslog message, the format is detected by the version after the priority:
+// "<PRI>1 " starts an RFC5424 message, anything else is parsed as RFC3164.
+// now is used to complete the year of the RFC3164 timestamp.
+func Parse(b []byte, now time.Time) (*Message, error) {
+	b = bytes.TrimRight(b, "\r\n")
+	m := &Message{}
+	rest, err := parsePriority(b, m)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 1 && rest[0] == '1' && rest[1] == ' ' {
+		return m, parseRFC5424(rest[2:], m)
+	}
+	parseRFC3164(rest, m, now)
+	return m, nil
+}
+
+func parsePriority(b []byte, m *Message) ([]byte, error) {
+	if len(b) == 0 || b[0] != '<' {
+		return nil, ErrNoPriority
+	}
+	end := bytes.IndexByte(b, '>')
+	// PRI is 1 to 3 digits
+	if end < 2 || end > 4 {
+		return nil, ErrInvalidPriority
+	}
+	pri, err := strconv.Atoi(string(b[1:end]))
+	if err != nil || pri < 0 || pri > 191 {
+		return nil, ErrInvalidPriority
+	}
+	m.Facility = pri / 8
+	m.Severity = pri % 8
+	return b[end+1:], nil
+}
+
+// parseRFC5424 parses: TIMESTAMP SP HOSTNAME SP APP-NAME SP PROCID SP MSGID SP STRUCTURED-DATA [SP MSG]
+func parseRFC5424(b []byte, m *Message) error {
+	var headers [5]string
+	for i := range headers {
+		idx := bytes.IndexByte(b, ' ')
+		if idx <= 0 {
+			return ErrInvalidHeader
+		}
+		headers[i] = nilable(b[:idx])
+		b = b[idx+1:]
+	}
+	if headers[0] != "" {
+		ts, err := time.Parse(time.RFC3339Nano, headers[0])
+		if err != nil {
+			return ErrInvalidTimestamp
+		}
+		m.Timestamp = ts
+	}
+	m.Hostname, m.AppName, m.ProcID, m.MsgID = headers[1], headers[2], headers[3], headers[4]
+
+	sd, rest, err := splitStructuredData(b)
+	if err != nil {
+		return err
+	}
+	m.StructuredData = nilable(sd)
+	if len(rest) > 0 {
+		if rest[0] != ' ' {
+			return ErrInvalidSD
+		}
+		m.Message = string(bytes.TrimPrefix(rest[1:], utf8BOM))
+	}
+	return nil
+}
+
+// splitStructuredData splits the structured data, either "-" or a sequence of [SD-ID PARAM="VALUE" ...] elements,
+// from the message. Brackets and quotes within the quoted values are escaped by a backslash.
+func splitStructuredData(b []byte) ([]byte, []byte, error) {
+	if len(b) == 0 {
+		return nil, nil, ErrInvalidSD
+	}
+	if b[0] == '-' {
+		return b[:1], b[1:], nil
+	}
+	i := 0
+	for i < len(b) && b[i] == '[' {
+		quoted := false
+		i++
+		for ; i < len(b); i++ {
+			c := b[i]
+			if quoted && c == '\\' {
+				i++
+				continue
+			}
+			if c == '"' {
+				quoted = !quoted
+				continue
+			}
+			if c == ']' && !quoted {
+				break
+			}
+		}
+		if i >= len(b) {
+			return nil, nil, ErrInvalidSD
+		}
+		i++
+	}
+	if i == 0 {
+		return nil, nil, ErrInvalidSD
+	}
+	return b[:i], b[i:], nil
+}
+
+// parseRFC3164 parses: TIMESTAMP SP HOSTNAME SP TAG[PID]: MSG
+// The format is loosely followed by senders, the parts that can not be recognized are kept in the message.
+func parseRFC3164(b []byte, m *Message, now time.Time) {
+	if ts, n, ok := parseRFC3164Time(b, now); ok {
+		m.Timestamp = ts
+		b = b[n:]
+		if idx := bytes.IndexByte(b, ' '); idx > 0 {
+			m.Hostname = string(b[:idx])
+			b = b[idx+1:]
+		}
+	}
+
+	b = parseRFC3164Tag(b, m)
+	m.Message = string(bytes.TrimLeft(b, " "))
+}
+
+// parseRFC3164Tag parses the "TAG:" or "TAG[PID]:" in front of the message, and returns the rest of the message.
+// The tag contains at most 48 printable characters, the message is returned unchanged if there is no tag.
+func parseRFC3164Tag(b []byte, m *Message) []byte {
+	for i, c := range b {
+		switch {
+		case c == ':' && i > 0:
+			m.AppName = string(b[:i])
+			return b[i+1:]
+		case c == '[' && i > 0:
+			end := bytes.IndexByte(b[i:], ']')
+			if end < 0 {
+				return b
+			}
+			m.AppName = string(b[:i])
+			m.ProcID = string(b[i+1 : i+end])
+			return bytes.TrimPrefix(b[i+end+1:], []byte(":"))
+		case c == ' ' || c == ':' || c == '[' || c < 0x21 || c > 0x7e || i >= 48:
+			return b
+		}
+	}
+	return b
+}
+
+// parseRFC3164Time parses "Mmm dd hh:mm:ss " or an RFC3339 timestamp used by some senders.
+// The year of the former is the year of now, or the previous year when the time would be in the future.
+func parseRFC3164Time(b []byte, now time.Time) (time.Time, int, bool) {
+	if len(b) > rfc3164TimeLen && b[rfc3164TimeLen] == ' ' {
+		ts, err := time.ParseInLocation(rfc3164TimeLayout, string(b[:rfc3164TimeLen]), now.Location())
+		if err == nil {
+			ts = ts.AddDate(now.Year(), 0, 0)
+			if ts.Sub(now) > 24*time.Hour {
+				ts = ts.AddDate(-1, 0, 0)
+			}
+			return ts, rfc3164TimeLen + 1, true
+		}
+	}
+	if idx := bytes.IndexByte(b, ' '); idx > 0 {
+		ts, err := time.Parse(time.RFC3339Nano, string(b[:idx]))
+		if err == nil {
+			return ts, idx + 1, true
+		}
+	}
+	return time.Time{}, 0, false
+}
+
+func nilable(b []byte) string {
+	if string(b) == nilValue {
+		return ""
+	}
+	return string(b)
+}
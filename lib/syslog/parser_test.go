@@ -0,0 +1,98 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package syslog_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/syslog"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRFC5424(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	m, err := syslog.Parse([]byte(`<165>1 2003-10-11T22:14:15.003Z mymachine.example.com evntslog - ID47 [exampleSDID@32473 iut="3" eventSource="App\]lication"][examplePriority@32473 class="high"] `+"\xEF\xBB\xBF"+`An application event log entry`+"\r\n"), now)
+	require.NoError(t, err)
+	require.Equal(t, "local4", m.FacilityName())
+	require.Equal(t, "notice", m.SeverityName())
+	require.Equal(t, time.Date(2003, 10, 11, 22, 14, 15, 3e6, time.UTC), m.Timestamp.UTC())
+	require.Equal(t, "mymachine.example.com", m.Hostname)
+	require.Equal(t, "evntslog", m.AppName)
+	require.Equal(t, "", m.ProcID)
+	require.Equal(t, "ID47", m.MsgID)
+	require.Equal(t, `[exampleSDID@32473 iut="3" eventSource="App\]lication"][examplePriority@32473 class="high"]`, m.StructuredData)
+	require.Equal(t, "An application event log entry", m.Message)
+
+	m, err = syslog.Parse([]byte(`<34>1 - - su 123 - -`), now)
+	require.NoError(t, err)
+	require.True(t, m.Timestamp.IsZero())
+	require.Equal(t, "", m.Hostname)
+	require.Equal(t, "su", m.AppName)
+	require.Equal(t, "123", m.ProcID)
+	require.Equal(t, "", m.StructuredData)
+	require.Equal(t, "", m.Message)
+
+	for _, s := range []string{
+		`<34>1 2003-10-11T22:14:15.003Z host app`,
+		`<34>1 yesterday host app - - - msg`,
+		`<34>1 - host app - - [sd msg`,
+		`<34>1 - host app - - nosd msg`,
+	} {
+		_, err = syslog.Parse([]byte(s), now)
+		require.Error(t, err, s)
+	}
+}
+
+func TestParseRFC3164(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	m, err := syslog.Parse([]byte(`<34>Oct 11 22:14:15 mymachine su[230]: 'su root' failed for lonvick on /dev/pts/8`), now)
+	require.NoError(t, err)
+	require.Equal(t, "auth", m.FacilityName())
+	require.Equal(t, "crit", m.SeverityName())
+	// the time can not be in the future, so it is in the last year
+	require.Equal(t, time.Date(2023, 10, 11, 22, 14, 15, 0, time.UTC), m.Timestamp)
+	require.Equal(t, "mymachine", m.Hostname)
+	require.Equal(t, "su", m.AppName)
+	require.Equal(t, "230", m.ProcID)
+	require.Equal(t, "'su root' failed for lonvick on /dev/pts/8", m.Message)
+
+	m, err = syslog.Parse([]byte(`<13>Feb  5 17:32:18 10.0.0.99 sshd: Use the BFG!`), now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 2, 5, 17, 32, 18, 0, time.UTC), m.Timestamp)
+	require.Equal(t, "10.0.0.99", m.Hostname)
+	require.Equal(t, "sshd", m.AppName)
+	require.Equal(t, "Use the BFG!", m.Message)
+
+	m, err = syslog.Parse([]byte(`<13>2024-05-01T10:00:00+02:00 web nginx[1: broken tag`), now)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC), m.Timestamp.UTC())
+	require.Equal(t, "web", m.Hostname)
+	require.Equal(t, "", m.AppName)
+	require.Equal(t, "nginx[1: broken tag", m.Message)
+
+	m, err = syslog.Parse([]byte(`<191>just a message: without header`), now)
+	require.NoError(t, err)
+	require.Equal(t, "local7", m.FacilityName())
+	require.Equal(t, "debug", m.SeverityName())
+	require.True(t, m.Timestamp.IsZero())
+	require.Equal(t, "", m.AppName)
+	require.Equal(t, "just a message: without header", m.Message)
+
+	for _, s := range []string{"", "no priority", "<>msg", "<192>msg", "<1234>msg", "<ab>msg"} {
+		_, err = syslog.Parse([]byte(s), now)
+		require.Error(t, err, s)
+	}
+}
@@ -23,6 +23,8 @@ type ContextKey string
 const (
 	spanKey  ContextKey = "tracing-span-key"
 	traceKey ContextKey = "tracing-trace-key"
+
+	exportOnlyKey ContextKey = "tracing-export-only-key"
 )
 
 func NewContextWithSpan(ctx context.Context, c *Span) context.Context {
@@ -48,3 +50,14 @@ func TraceFromContext(ctx context.Context) *Trace {
 	}
 	return c
 }
+
+// NewContextWithExportOnly marks the trace of ctx as only exported to the OTLP collector,
+// the remote nodes do not return their traces as they do for EXPLAIN ANALYZE.
+func NewContextWithExportOnly(ctx context.Context) context.Context {
+	return context.WithValue(ctx, exportOnlyKey, true)
+}
+
+func ExportOnly(ctx context.Context) bool {
+	only, _ := ctx.Value(exportOnlyKey).(bool)
+	return only
+}
@@ -0,0 +1,333 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/influxdata/influxdb/pkg/tracing"
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	scopeName       = "github.com/openGemini/openGemini/lib/tracing"
+	detailAttribute = "detail"
+)
+
+var exporter atomic.Pointer[Exporter]
+
+func getExporter() *Exporter {
+	return exporter.Load()
+}
+
+// ExportEnabled reports whether the sampled traces are exported.
+func ExportEnabled() bool {
+	return getExporter() != nil
+}
+
+// Export sends the finished spans of the trace to the OTLP collector if the trace is sampled.
+// The traces returned by the remote nodes for EXPLAIN ANALYZE are not exported here,
+// each node exports its own spans under the propagated trace context.
+func Export(t *Trace) {
+	if t == nil {
+		return
+	}
+	e := getExporter()
+	if e == nil {
+		return
+	}
+	if spans := t.otlpSpans(); len(spans) > 0 {
+		e.enqueue(spans)
+	}
+}
+
+// Exporter exports the spans of the sampled traces to an OTLP/HTTP collector in batches.
+// The spans are queued and sent in background, they are dropped if the queue is full,
+// so that a slow collector never blocks the queries.
+type Exporter struct {
+	endpoint      string
+	client        *http.Client
+	resource      *resourcepb.Resource
+	sampleRatio   float64
+	batchSize     int
+	flushInterval time.Duration
+
+	queue   chan *tracepb.Span
+	dropped int64
+	done    chan struct{}
+	once    sync.Once
+	wg      sync.WaitGroup
+	logger  *logger.Logger
+}
+
+func NewExporter(conf config.TracingConfig, app config.App) *Exporter {
+	attrs := []*commonpb.KeyValue{
+		stringAttribute("service.name", conf.ServiceName),
+		stringAttribute("opengemini.app", string(app)),
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		attrs = append(attrs, stringAttribute("host.name", hostname))
+	}
+
+	return &Exporter{
+		endpoint:      conf.Endpoint,
+		client:        &http.Client{Timeout: time.Duration(conf.Timeout)},
+		resource:      &resourcepb.Resource{Attributes: attrs},
+		sampleRatio:   conf.SampleRatio,
+		batchSize:     conf.BatchSize,
+		flushInterval: time.Duration(conf.FlushInterval),
+		queue:         make(chan *tracepb.Span, conf.QueueSize),
+		done:          make(chan struct{}),
+		logger:        logger.NewLogger(errno.ModuleUnknown),
+	}
+}
+
+// Open starts the background sender and makes the exporter the one used by the traces of the process.
+func (e *Exporter) Open() error {
+	e.wg.Add(1)
+	go e.run()
+	exporter.Store(e)
+	return nil
+}
+
+// Close flushes the queued spans and stops the exporter.
+func (e *Exporter) Close() error {
+	e.once.Do(func() {
+		exporter.CompareAndSwap(e, nil)
+		close(e.done)
+		e.wg.Wait()
+	})
+	return nil
+}
+
+// Dropped returns the number of the spans dropped because the queue is full.
+func (e *Exporter) Dropped() int64 {
+	return atomic.LoadInt64(&e.dropped)
+}
+
+func (e *Exporter) sample() bool {
+	return e.sampleRatio > 0 && (e.sampleRatio >= 1 || rand.Float64() < e.sampleRatio)
+}
+
+func (e *Exporter) enqueue(spans []*tracepb.Span) {
+	for _, span := range spans {
+		select {
+		case e.queue <- span:
+		default:
+			atomic.AddInt64(&e.dropped, 1)
+		}
+	}
+}
+
+func (e *Exporter) run() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*tracepb.Span, 0, e.batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.send(batch); err != nil {
+			e.logger.Warn("failed to export spans", zap.String("endpoint", e.endpoint),
+				zap.Int("spans", len(batch)), zap.Error(err))
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case span := <-e.queue:
+			batch = append(batch, span)
+			if len(batch) >= e.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.done:
+			for {
+				select {
+				case span := <-e.queue:
+					batch = append(batch, span)
+					if len(batch) >= e.batchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *Exporter) send(spans []*tracepb.Span) error {
+	body, err := proto.Marshal(&coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{{
+			Resource: e.resource,
+			ScopeSpans: []*tracepb.ScopeSpans{{
+				Scope: &commonpb.InstrumentationScope{Name: scopeName},
+				Spans: spans,
+			}},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, e.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	return nil
+}
+
+func (t *Trace) otlpSpans() []*tracepb.Span {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if !t.sampled {
+		return nil
+	}
+
+	root := t.trace.Tree()
+	if root == nil {
+		return nil
+	}
+	var parent []byte
+	if t.remote.SpanID != [8]byte{} {
+		parent = append(parent, t.remote.SpanID[:]...)
+	}
+	traceID := append([]byte(nil), t.remote.TraceID[:]...)
+	spans, _ := t.appendOTLPSpans(nil, root, traceID, parent, tracepb.Span_SPAN_KIND_SERVER)
+	return spans
+}
+
+// appendOTLPSpans converts the span tree rooted at n, and returns the end time of n.
+// The spans which are finished before their children, such as the root spans, end with their last child.
+func (t *Trace) appendOTLPSpans(dst []*tracepb.Span, n *tracing.TreeNode, traceID, parent []byte,
+	kind tracepb.Span_SpanKind) ([]*tracepb.Span, uint64) {
+	id := make([]byte, 8)
+	binary.BigEndian.PutUint64(id, n.Raw.Context.SpanID)
+	span := &tracepb.Span{
+		TraceId:           traceID,
+		SpanId:            id,
+		ParentSpanId:      parent,
+		Name:              n.Raw.Name,
+		Kind:              kind,
+		StartTimeUnixNano: uint64(n.Raw.Start.UnixNano()),
+		Attributes:        otlpAttributes(n.Raw),
+	}
+	end := span.StartTimeUnixNano
+	if tm, ok := t.ends[n.Raw.Context.SpanID]; ok && uint64(tm.UnixNano()) > end {
+		end = uint64(tm.UnixNano())
+	}
+
+	dst = append(dst, span)
+	for _, child := range n.Children {
+		var childEnd uint64
+		dst, childEnd = t.appendOTLPSpans(dst, child, traceID, id, tracepb.Span_SPAN_KIND_INTERNAL)
+		if childEnd > end {
+			end = childEnd
+		}
+	}
+	span.EndTimeUnixNano = end
+	return dst, end
+}
+
+func otlpAttributes(raw tracing.RawSpan) []*commonpb.KeyValue {
+	attrs := make([]*commonpb.KeyValue, 0, len(raw.Labels)+len(raw.Fields))
+	for _, l := range raw.Labels {
+		attrs = append(attrs, stringAttribute(l.Key, l.Value))
+	}
+
+	var details []string
+	for _, f := range raw.Fields {
+		name, ok := strings.CutPrefix(f.Key(), nameValuePrefix)
+		if !ok {
+			attrs = append(attrs, &commonpb.KeyValue{Key: f.Key(), Value: anyValue(f.Value())})
+			continue
+		}
+
+		// the name values are rendered as a part of the span name in EXPLAIN ANALYZE,
+		// formatted as "key=value" by AppendNameValue or free text by SetNameValue
+		val := fmt.Sprint(f.Value())
+		if v, ok := strings.CutPrefix(val, name+"="); ok && name != val {
+			attrs = append(attrs, stringAttribute(name, v))
+			continue
+		}
+		if k, v, ok := strings.Cut(val, "="); ok && !strings.ContainsAny(k, ", :") && !strings.ContainsAny(v, ",=") {
+			attrs = append(attrs, stringAttribute(k, v))
+			continue
+		}
+		details = append(details, val)
+	}
+	if len(details) > 0 {
+		attrs = append(attrs, stringAttribute(detailAttribute, strings.Join(details, "; ")))
+	}
+	return attrs
+}
+
+func stringAttribute(key, val string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: key, Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: val}}}
+}
+
+func anyValue(v interface{}) *commonpb.AnyValue {
+	switch v := v.(type) {
+	case string:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v}}
+	case bool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v}}
+	case int64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v}}
+	case uint64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(v)}}
+	case float64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v}}
+	case time.Duration:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.String()}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: fmt.Sprint(v)}}
+	}
+}
@@ -0,0 +1,206 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing_test
+
+import (
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/tracing"
+	"github.com/stretchr/testify/require"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// otlpCollector is a local stand-in of the OTLP/HTTP traces receiver.
+type otlpCollector struct {
+	mu    sync.Mutex
+	spans []*tracepb.Span
+	attrs map[string]string
+}
+
+func newOTLPCollector(t *testing.T) (*otlpCollector, *httptest.Server) {
+	c := &otlpCollector{attrs: map[string]string{}}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/traces", r.URL.Path)
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		req := &coltracepb.ExportTraceServiceRequest{}
+		require.NoError(t, proto.Unmarshal(body, req))
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		for _, rs := range req.ResourceSpans {
+			for _, kv := range rs.Resource.Attributes {
+				c.attrs[kv.Key] = kv.Value.GetStringValue()
+			}
+			for _, ss := range rs.ScopeSpans {
+				c.spans = append(c.spans, ss.Spans...)
+			}
+		}
+	}))
+	return c, srv
+}
+
+func (c *otlpCollector) get() []*tracepb.Span {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.spans
+}
+
+func openExporter(t *testing.T, endpoint string, ratio float64) *tracing.Exporter {
+	conf := config.NewTracingConfig()
+	conf.Enabled = true
+	conf.Endpoint = endpoint + "/v1/traces"
+	conf.SampleRatio = ratio
+	e := tracing.NewExporter(conf, config.AppSql)
+	require.NoError(t, e.Open())
+	return e
+}
+
+func TestParseTraceParent(t *testing.T) {
+	tc, err := tracing.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	require.True(t, tc.Sampled())
+	require.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", tc.String())
+
+	tc, err = tracing.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	require.NoError(t, err)
+	require.False(t, tc.Sampled())
+
+	// the fields appended by the later versions are ignored
+	_, err = tracing.ParseTraceParent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-abc")
+	require.NoError(t, err)
+
+	for _, s := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-abc",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e47zz-00f067aa0ba902b7-01",
+	} {
+		_, err = tracing.ParseTraceParent(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestExportTrace(t *testing.T) {
+	collector, srv := newOTLPCollector(t)
+	defer srv.Close()
+
+	// nothing is traced before the exporter is opened
+	trace, _ := tracing.NewTrace("SELECT")
+	require.False(t, trace.Sample("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+
+	e := openExporter(t, srv.URL, 0)
+	trace, root := tracing.NewTrace("SELECT")
+	require.True(t, trace.Sample("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"))
+	root.AppendNameValue("statement", "SELECT * FROM cpu")
+	root.Finish()
+
+	rpc := root.StartSpan("rpc").StartPP()
+	rpc.SetNameValue("shards=2")
+	rpc.AddIntField("rows", 10)
+	rpc.SetLabels("node_id", "1")
+	time.Sleep(time.Millisecond)
+	rpc.Finish()
+
+	// the remote node continues the trace under the rpc span
+	traceParent := trace.TraceParent(rpc)
+	remote, remoteRoot := tracing.NewTrace("TS-Store")
+	require.True(t, remote.Join(traceParent))
+	remoteRoot.Finish()
+
+	tracing.Export(trace)
+	tracing.Export(remote)
+	require.NoError(t, e.Close())
+
+	spans := collector.get()
+	require.Equal(t, 3, len(spans))
+	require.Equal(t, "openGemini", collector.attrs["service.name"])
+	require.Equal(t, string(config.AppSql), collector.attrs["opengemini.app"])
+
+	byName := map[string]*tracepb.Span{}
+	for _, s := range spans {
+		require.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", hexString(s.TraceId))
+		byName[s.Name] = s
+	}
+	require.Equal(t, "00f067aa0ba902b7", hexString(byName["SELECT"].ParentSpanId))
+	require.Equal(t, tracepb.Span_SPAN_KIND_SERVER, byName["SELECT"].Kind)
+	require.Equal(t, byName["SELECT"].SpanId, byName["rpc"].ParentSpanId)
+	require.Equal(t, byName["rpc"].SpanId, byName["TS-Store"].ParentSpanId)
+	// the root span is finished at once, it ends with its children
+	require.Equal(t, byName["rpc"].EndTimeUnixNano, byName["SELECT"].EndTimeUnixNano)
+	require.True(t, byName["rpc"].EndTimeUnixNano > byName["rpc"].StartTimeUnixNano)
+
+	attrs := map[string]string{}
+	for _, kv := range append(byName["rpc"].Attributes, byName["SELECT"].Attributes...) {
+		attrs[kv.Key] = kv.Value.GetStringValue()
+		if kv.Key == "rows" {
+			require.Equal(t, int64(10), kv.Value.GetIntValue())
+		}
+	}
+	require.Equal(t, "SELECT * FROM cpu", attrs["statement"])
+	require.Equal(t, "2", attrs["shards"])
+	require.Equal(t, "1", attrs["node_id"])
+
+	// the exporter is closed
+	trace, _ = tracing.NewTrace("SELECT")
+	require.False(t, trace.Sample(""))
+}
+
+func TestExportTraceSample(t *testing.T) {
+	collector, srv := newOTLPCollector(t)
+	defer srv.Close()
+
+	e := openExporter(t, srv.URL, 1)
+	trace, root := tracing.NewTrace("SELECT")
+	require.True(t, trace.Sample(""))
+	root.Finish()
+	require.NotEqual(t, "", trace.TraceParent(root))
+
+	notSampled, root2 := tracing.NewTrace("SELECT")
+	require.False(t, notSampled.Sample("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"))
+	root2.Finish()
+	require.Equal(t, "", notSampled.TraceParent(root2))
+
+	noParent, _ := tracing.NewTrace("TS-Store")
+	require.False(t, noParent.Join(""))
+
+	tracing.Export(trace)
+	tracing.Export(notSampled)
+	tracing.Export(noParent)
+	tracing.Export(nil)
+	require.NoError(t, e.Close())
+
+	spans := collector.get()
+	require.Equal(t, 1, len(spans))
+	require.Equal(t, 0, len(spans[0].ParentSpanId))
+	require.Equal(t, int64(0), e.Dropped())
+}
+
+func hexString(b []byte) string {
+	return hex.EncodeToString(b)
+}
@@ -130,6 +130,7 @@ func (s *Span) Finish() {
 
 	s.trace.mu.Lock()
 	s.span.Finish()
+	s.trace.finishSpan(s.span.Context().SpanID)
 	s.trace.mu.Unlock()
 }
 
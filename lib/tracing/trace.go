@@ -15,7 +15,10 @@
 package tracing
 
 import (
+	"crypto/rand"
+	"encoding/binary"
 	"sync"
+	"time"
 
 	"github.com/influxdata/influxdb/pkg/tracing"
 )
@@ -24,6 +27,12 @@ type Trace struct {
 	trace *tracing.Trace
 	subs  map[uint64]*Trace
 	mu    sync.RWMutex
+
+	// remote is the W3C trace context the trace is exported under,
+	// the end time of the spans are only kept if the trace is sampled.
+	remote  TraceContext
+	sampled bool
+	ends    map[uint64]time.Time
 }
 
 func NewTrace(name string, opt ...tracing.StartSpanOption) (*Trace, *Span) {
@@ -69,3 +78,81 @@ func (t *Trace) String() string {
 	tracing.Walk(tv, tree)
 	return tv.root.String()
 }
+
+// Sample decides whether the trace is exported, it is called before any span is finished.
+// The trace joins the caller's trace if traceParent is valid, otherwise it starts a new trace
+// which is sampled by the sample ratio of the exporter.
+func (t *Trace) Sample(traceParent string) bool {
+	e := getExporter()
+	if e == nil {
+		return false
+	}
+	if tc, err := ParseTraceParent(traceParent); err == nil {
+		t.join(tc)
+		return t.sampled
+	}
+
+	if !e.sample() {
+		return false
+	}
+	var tc TraceContext
+	if _, err := rand.Read(tc.TraceID[:]); err != nil {
+		return false
+	}
+	tc.Flags = sampledFlag
+	t.join(tc)
+	return true
+}
+
+// Join makes the trace a part of the caller's trace given by traceParent,
+// the trace is exported if the caller's trace is sampled.
+func (t *Trace) Join(traceParent string) bool {
+	if traceParent == "" || getExporter() == nil {
+		return false
+	}
+	tc, err := ParseTraceParent(traceParent)
+	if err != nil {
+		return false
+	}
+	t.join(tc)
+	return t.sampled
+}
+
+func (t *Trace) join(tc TraceContext) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.remote = tc
+	t.sampled = tc.Sampled()
+	if t.sampled && t.ends == nil {
+		t.ends = make(map[uint64]time.Time)
+	}
+}
+
+func (t *Trace) Sampled() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.sampled
+}
+
+// TraceParent returns the traceparent header propagated to the work started under span,
+// or an empty string if the trace is not sampled.
+func (t *Trace) TraceParent(span *Span) string {
+	if t == nil || span == nil {
+		return ""
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if !t.sampled {
+		return ""
+	}
+	tc := t.remote
+	binary.BigEndian.PutUint64(tc.SpanID[:], span.Context().SpanID)
+	return tc.String()
+}
+
+// finishSpan is called with t.mu locked.
+func (t *Trace) finishSpan(id uint64) {
+	if t.ends != nil {
+		t.ends[id] = time.Now()
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tracing
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// TraceParentHeader is the http header which carries the W3C trace context.
+const TraceParentHeader = "traceparent"
+
+const (
+	traceParentVersion = 0
+	sampledFlag        = 0x01
+)
+
+// TraceContext is the W3C trace context (https://www.w3.org/TR/trace-context/) carried by the traceparent header,
+// formatted as "version-traceid-parentid-flags", such as 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01.
+type TraceContext struct {
+	TraceID [16]byte
+	SpanID  [8]byte
+	Flags   byte
+}
+
+func ParseTraceParent(s string) (TraceContext, error) {
+	var tc TraceContext
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 {
+		return tc, fmt.Errorf("invalid traceparent %q", s)
+	}
+
+	var version [1]byte
+	if _, err := hex.Decode(version[:], []byte(parts[0])); err != nil || version[0] == 0xff {
+		return tc, fmt.Errorf("invalid traceparent version %q", parts[0])
+	}
+	// the later versions may append fields, which are ignored
+	if version[0] == traceParentVersion && len(parts) != 4 {
+		return tc, fmt.Errorf("invalid traceparent %q", s)
+	}
+
+	var flags [1]byte
+	if _, err := hex.Decode(tc.TraceID[:], []byte(parts[1])); err != nil {
+		return tc, fmt.Errorf("invalid traceparent trace-id %q", parts[1])
+	}
+	if _, err := hex.Decode(tc.SpanID[:], []byte(parts[2])); err != nil {
+		return tc, fmt.Errorf("invalid traceparent parent-id %q", parts[2])
+	}
+	if _, err := hex.Decode(flags[:], []byte(parts[3])); err != nil {
+		return tc, fmt.Errorf("invalid traceparent trace-flags %q", parts[3])
+	}
+	tc.Flags = flags[0]
+
+	if !tc.IsValid() {
+		return tc, fmt.Errorf("invalid traceparent %q, all zero trace-id or parent-id", s)
+	}
+	return tc, nil
+}
+
+// IsValid reports whether both the trace id and the span id are set.
+func (c TraceContext) IsValid() bool {
+	return c.TraceID != [16]byte{} && c.SpanID != [8]byte{}
+}
+
+func (c TraceContext) Sampled() bool {
+	return c.Flags&sampledFlag != 0
+}
+
+func (c TraceContext) String() string {
+	return fmt.Sprintf("%02x-%x-%x-%02x", traceParentVersion, c.TraceID[:], c.SpanID[:], c.Flags)
+}
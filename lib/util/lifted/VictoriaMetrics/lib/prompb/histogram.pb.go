@@ -0,0 +1,302 @@
+// Code generated manually from types.proto
+
+package prompb
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Histogram is a native (sparse) histogram sample.
+//
+// Integer histograms keep their bucket counts as deltas in NegativeDeltas and
+// PositiveDeltas, float histograms keep absolute counts in NegativeCounts and
+// PositiveCounts.
+type Histogram struct {
+	CountInt       uint64
+	CountFloat     float64
+	Sum            float64
+	Schema         int32
+	ZeroThreshold  float64
+	ZeroCountInt   uint64
+	ZeroCountFloat float64
+	NegativeSpans  []BucketSpan
+	NegativeDeltas []int64
+	NegativeCounts []float64
+	PositiveSpans  []BucketSpan
+	PositiveDeltas []int64
+	PositiveCounts []float64
+	ResetHint      int32
+	Timestamp      int64
+
+	isFloat bool
+}
+
+// BucketSpan defines a number of consecutive buckets with their offset.
+type BucketSpan struct {
+	Offset int32
+	Length uint32
+}
+
+// IsFloatHistogram returns true if the counts of h are floats.
+func (m *Histogram) IsFloatHistogram() bool {
+	return m.isFloat
+}
+
+// Unmarshal unmarshals histogram from dAtA.
+func (m *Histogram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		wire, err := decodeVarint(dAtA, &iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Histogram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Histogram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			m.CountInt, err = decodeVarintField(dAtA, &iNdEx, wireType, "CountInt")
+		case 2:
+			m.CountFloat, err = decodeDoubleField(dAtA, &iNdEx, wireType, "CountFloat")
+			m.isFloat = true
+		case 3:
+			m.Sum, err = decodeDoubleField(dAtA, &iNdEx, wireType, "Sum")
+		case 4:
+			var v uint64
+			v, err = decodeVarintField(dAtA, &iNdEx, wireType, "Schema")
+			m.Schema = int32((uint32(v) >> 1) ^ uint32((int32(v&1)<<31)>>31))
+		case 5:
+			m.ZeroThreshold, err = decodeDoubleField(dAtA, &iNdEx, wireType, "ZeroThreshold")
+		case 6:
+			m.ZeroCountInt, err = decodeVarintField(dAtA, &iNdEx, wireType, "ZeroCountInt")
+		case 7:
+			m.ZeroCountFloat, err = decodeDoubleField(dAtA, &iNdEx, wireType, "ZeroCountFloat")
+		case 8:
+			m.NegativeSpans, err = decodeSpanField(dAtA, &iNdEx, wireType, m.NegativeSpans)
+		case 9:
+			m.NegativeDeltas, err = decodeSint64Field(dAtA, &iNdEx, wireType, m.NegativeDeltas)
+		case 10:
+			m.NegativeCounts, err = decodeDoublesField(dAtA, &iNdEx, wireType, m.NegativeCounts)
+		case 11:
+			m.PositiveSpans, err = decodeSpanField(dAtA, &iNdEx, wireType, m.PositiveSpans)
+		case 12:
+			m.PositiveDeltas, err = decodeSint64Field(dAtA, &iNdEx, wireType, m.PositiveDeltas)
+		case 13:
+			m.PositiveCounts, err = decodeDoublesField(dAtA, &iNdEx, wireType, m.PositiveCounts)
+		case 14:
+			var v uint64
+			v, err = decodeVarintField(dAtA, &iNdEx, wireType, "ResetHint")
+			m.ResetHint = int32(v)
+		case 15:
+			var v uint64
+			v, err = decodeVarintField(dAtA, &iNdEx, wireType, "Timestamp")
+			m.Timestamp = int64(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return errInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+// Unmarshal unmarshals BucketSpan from dAtA.
+func (m *BucketSpan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		wire, err := decodeVarint(dAtA, &iNdEx)
+		if err != nil {
+			return err
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BucketSpan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			var v uint64
+			v, err = decodeVarintField(dAtA, &iNdEx, wireType, "Offset")
+			m.Offset = int32((uint32(v) >> 1) ^ uint32((int32(v&1)<<31)>>31))
+		case 2:
+			var v uint64
+			v, err = decodeVarintField(dAtA, &iNdEx, wireType, "Length")
+			m.Length = uint32(v)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return errInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func decodeVarint(dAtA []byte, iNdEx *int) (uint64, error) {
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		if shift >= 64 {
+			return 0, errIntOverflowTypes
+		}
+		if *iNdEx >= len(dAtA) {
+			return 0, io.ErrUnexpectedEOF
+		}
+		b := dAtA[*iNdEx]
+		*iNdEx++
+		v |= uint64(b&0x7F) << shift
+		if b < 0x80 {
+			return v, nil
+		}
+	}
+}
+
+func decodeVarintField(dAtA []byte, iNdEx *int, wireType int, name string) (uint64, error) {
+	if wireType != 0 {
+		return 0, fmt.Errorf("proto: wrong wireType = %d for field %s", wireType, name)
+	}
+	return decodeVarint(dAtA, iNdEx)
+}
+
+func decodeDouble(dAtA []byte, iNdEx *int) (float64, error) {
+	if *iNdEx+8 > len(dAtA) {
+		return 0, io.ErrUnexpectedEOF
+	}
+	v := binary.LittleEndian.Uint64(dAtA[*iNdEx:])
+	*iNdEx += 8
+	return math.Float64frombits(v), nil
+}
+
+func decodeDoubleField(dAtA []byte, iNdEx *int, wireType int, name string) (float64, error) {
+	if wireType != 1 {
+		return 0, fmt.Errorf("proto: wrong wireType = %d for field %s", wireType, name)
+	}
+	return decodeDouble(dAtA, iNdEx)
+}
+
+// decodeBytes returns the length delimited payload starting at iNdEx.
+func decodeBytes(dAtA []byte, iNdEx *int) ([]byte, error) {
+	n, err := decodeVarint(dAtA, iNdEx)
+	if err != nil {
+		return nil, err
+	}
+	msglen := int(n)
+	if msglen < 0 {
+		return nil, errInvalidLengthTypes
+	}
+	postIndex := *iNdEx + msglen
+	if postIndex > len(dAtA) {
+		return nil, io.ErrUnexpectedEOF
+	}
+	b := dAtA[*iNdEx:postIndex]
+	*iNdEx = postIndex
+	return b, nil
+}
+
+func decodeSpanField(dAtA []byte, iNdEx *int, wireType int, dst []BucketSpan) ([]BucketSpan, error) {
+	if wireType != 2 {
+		return dst, fmt.Errorf("proto: wrong wireType = %d for field Spans", wireType)
+	}
+	b, err := decodeBytes(dAtA, iNdEx)
+	if err != nil {
+		return dst, err
+	}
+	dst = append(dst, BucketSpan{})
+	return dst, dst[len(dst)-1].Unmarshal(b)
+}
+
+// decodeSint64Field accepts both packed and unpacked encoding of a repeated sint64 field.
+func decodeSint64Field(dAtA []byte, iNdEx *int, wireType int, dst []int64) ([]int64, error) {
+	switch wireType {
+	case 0:
+		v, err := decodeVarint(dAtA, iNdEx)
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, int64(v>>1)^-int64(v&1)), nil
+	case 2:
+		b, err := decodeBytes(dAtA, iNdEx)
+		if err != nil {
+			return dst, err
+		}
+		for i := 0; i < len(b); {
+			v, err := decodeVarint(b, &i)
+			if err != nil {
+				return dst, err
+			}
+			dst = append(dst, int64(v>>1)^-int64(v&1))
+		}
+		return dst, nil
+	default:
+		return dst, fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+	}
+}
+
+// decodeDoublesField accepts both packed and unpacked encoding of a repeated double field.
+func decodeDoublesField(dAtA []byte, iNdEx *int, wireType int, dst []float64) ([]float64, error) {
+	switch wireType {
+	case 1:
+		v, err := decodeDouble(dAtA, iNdEx)
+		if err != nil {
+			return dst, err
+		}
+		return append(dst, v), nil
+	case 2:
+		b, err := decodeBytes(dAtA, iNdEx)
+		if err != nil {
+			return dst, err
+		}
+		if len(b)%8 != 0 {
+			return dst, errInvalidLengthTypes
+		}
+		for i := 0; i < len(b); {
+			v, _ := decodeDouble(b, &i)
+			dst = append(dst, v)
+		}
+		return dst, nil
+	default:
+		return dst, fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+	}
+}
@@ -17,8 +17,9 @@ type Sample struct {
 
 // TimeSeries is a timeseries.
 type TimeSeries struct {
-	Labels  []Label
-	Samples []Sample
+	Labels     []Label
+	Samples    []Sample
+	Histograms []Histogram
 }
 
 // Label is a timeseries label
@@ -113,6 +114,7 @@ func (m *Sample) Unmarshal(dAtA []byte) error {
 func (m *TimeSeries) Unmarshal(dAtA []byte, dstLabels []Label, dstSamples []Sample) ([]Label, []Sample, error) {
 	labelsStart := len(dstLabels)
 	samplesStart := len(dstSamples)
+	m.Histograms = m.Histograms[:0]
 
 	l := len(dAtA)
 	iNdEx := 0
@@ -214,6 +216,18 @@ func (m *TimeSeries) Unmarshal(dAtA []byte, dstLabels []Label, dstSamples []Samp
 				return dstLabels, dstSamples, err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return dstLabels, dstSamples, fmt.Errorf("proto: wrong wireType = %d for field Histograms", wireType)
+			}
+			b, err := decodeBytes(dAtA, &iNdEx)
+			if err != nil {
+				return dstLabels, dstSamples, err
+			}
+			m.Histograms = append(m.Histograms, Histogram{})
+			if err := m.Histograms[len(m.Histograms)-1].Unmarshal(b); err != nil {
+				return dstLabels, dstSamples, err
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -6,6 +6,7 @@ func (wr *WriteRequest) Reset() {
 		ts := &wr.Timeseries[i]
 		ts.Labels = nil
 		ts.Samples = nil
+		ts.Histograms = nil
 	}
 	wr.Timeseries = wr.Timeseries[:0]
 
@@ -50,7 +50,7 @@ func ParseStream(r io.Reader, callback func(tss []prompb.TimeSeries) error) erro
 	rows := 0
 	tss := wr.Timeseries
 	for i := range tss {
-		rows += len(tss[i].Samples)
+		rows += len(tss[i].Samples) + len(tss[i].Histograms)
 	}
 	rowsRead.Add(rows)
 
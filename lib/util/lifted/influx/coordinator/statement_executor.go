@@ -95,6 +95,9 @@ type StatementExecutor struct {
 	RetentionPolicyLimit    int
 	MaxQueryParallel        int
 
+	// TagValuesCardinalityEstimate estimates SHOW TAG VALUES CARDINALITY without EXACT by sketches
+	TagValuesCardinalityEstimate bool
+
 	StmtExecLogger *logger.Logger
 
 	// hostname for show configs statement
@@ -246,6 +249,11 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
 		}
 		err = e.executeAlterShardKeyStatement(stmt)
+	case *influxql.AlterMeasurementTTLStatement:
+		if ctx.ReadOnly {
+			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
+		}
+		err = e.executeAlterMeasurementTTLStatement(stmt)
 	case *influxql.CreateDatabaseStatement:
 		if ctx.ReadOnly {
 			messages = append(messages, query.ReadOnlyWarning(stmt.String()))
@@ -370,6 +378,8 @@ func (e *StatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query
 		rows, err = e.executeShowRetentionPoliciesStatement(stmt)
 	case *influxql.ShowSeriesCardinalityStatement:
 		rows, err = e.retryExecuteStatement(stmt, ctx, seq)
+	case *influxql.ShowSeriesQuotaStatement:
+		rows, err = e.retryExecuteStatement(stmt, ctx, seq)
 	case *influxql.ShowShardsStatement:
 		rows, err = e.executeShowShardsStatement(stmt)
 	case *influxql.ShowShardGroupsStatement:
@@ -494,6 +504,8 @@ func (e *StatementExecutor) retryExecuteStatement(stmt influxql.Statement, ctx *
 			rows, err = e.executeShowMeasurementCardinalityStatement(stmt)
 		case *influxql.ShowSeriesCardinalityStatement:
 			rows, err = e.executeShowSeriesCardinality(stmt)
+		case *influxql.ShowSeriesQuotaStatement:
+			rows, err = e.executeShowSeriesQuota(stmt)
 		case *influxql.ShowTagValuesCardinalityStatement:
 			rows, err = e.executeShowTagValuesCardinality(stmt)
 		case *influxql.ShowFieldKeysStatement:
@@ -676,7 +688,7 @@ func (e *StatementExecutor) executeCreateMeasurementStatement(stmt *influxql.Cre
 	if stmt.EngineType != "" && !ok {
 		return errors.New("ENGINETYPE \"" + stmt.EngineType + "\" IS NOT SUPPORTED!")
 	}
-	_, err = e.MetaClient.CreateMeasurement(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski, int32(stmt.NumOfShards), indexR, engineType, colStoreInfo, schemaInfo, nil)
+	_, err = e.MetaClient.CreateMeasurement(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski, int32(stmt.NumOfShards), indexR, engineType, colStoreInfo, schemaInfo, nil, stmt.TTL)
 	return err
 }
 
@@ -688,6 +700,11 @@ func (e *StatementExecutor) executeAlterShardKeyStatement(stmt *influxql.AlterSh
 	return e.MetaClient.AlterShardKey(stmt.Database, stmt.RetentionPolicy, stmt.Name, ski)
 }
 
+func (e *StatementExecutor) executeAlterMeasurementTTLStatement(stmt *influxql.AlterMeasurementTTLStatement) error {
+	e.StmtExecLogger.Info("alter measurement ttl", zap.String("name", stmt.Name), zap.Duration("ttl", stmt.TTL))
+	return e.MetaClient.AlterMeasurementTTL(stmt.Database, stmt.RetentionPolicy, stmt.Name, stmt.TTL)
+}
+
 func (e *StatementExecutor) executeCreateDatabaseStatement(stmt *influxql.CreateDatabaseStatement) error {
 	if !meta2.ValidName(stmt.Name) {
 		// TODO This should probably be in `(*meta.Data).CreateDatabase`
@@ -916,6 +933,9 @@ func (e *StatementExecutor) executeExplainStatement(q *influxql.ExplainStatement
 func (e *StatementExecutor) executeExplainAnalyzeStatement(q *influxql.ExplainStatement, ectx *query.ExecutionContext) (models.Rows, error) {
 	stmt := q.Statement
 	trace, span := tracing.NewTrace("SELECT")
+	if trace.Sample(ectx.TraceParent) {
+		defer tracing.Export(trace)
+	}
 	stmt.OmitTime = true
 	ctx := tracing.NewContextWithTrace(ectx.Context, trace)
 	ctx = tracing.NewContextWithSpan(ctx, span)
@@ -1065,7 +1085,15 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	proxy := newRowChanProxy()
 	// omit Time field for stmt
 	stmt.OmitTime = true
-	pipelineExecutor, err := e.retryCreatePipelineExecutor(ctx, stmt, ctx.ExecutionOptions, proxy.rc)
+	qctx, trace, span := startSelectTrace(ctx, stmt)
+	defer func() {
+		tracing.Finish(span)
+		tracing.Export(trace)
+	}()
+
+	pipSpan := tracing.Start(span, "create_pipeline_executor", true)
+	pipelineExecutor, err := e.retryCreatePipelineExecutor(qctx, stmt, ctx.ExecutionOptions, proxy.rc)
+	tracing.Finish(pipSpan)
 	if err == influxql.ErrDeclareEmptyCollection {
 		// skip empty collection err and return empty result set
 		err = nil
@@ -1093,6 +1121,10 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	go func() {
 		defer wg.Done()
 		ctxWithWriter = context.WithValue(context.Background(), executor.WRITER_CONTEXT, ctx.PointsWriter)
+		if trace != nil {
+			ctxWithWriter = tracing.NewContextWithTrace(ctxWithWriter, trace)
+			ctxWithWriter = tracing.NewContextWithSpan(ctxWithWriter, span)
+		}
 		var queryIndexState int32 = 0
 		ctxWithWriter = context.WithValue(ctxWithWriter, index.QueryIndexState, &queryIndexState)
 		ec <- pipelineExecutor.ExecuteExecutor(ctxWithWriter)
@@ -1122,8 +1154,9 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 				break
 			}
 			result := &query.Result{
-				Series:  rowsChan.Rows,
-				Partial: rowsChan.Partial,
+				Series:      rowsChan.Rows,
+				Partial:     rowsChan.Partial,
+				ColumnTypes: rowsChan.ColumnTypes,
 			}
 
 			// Send results or exit if closing.
@@ -1196,6 +1229,21 @@ func (e *StatementExecutor) executeSelectStatement(stmt *influxql.SelectStatemen
 	return nil
 }
 
+// startSelectTrace starts the trace of the query if it is sampled to be exported to the OTLP collector,
+// the returned context carries the trace, and the trace is nil if the query is not sampled.
+func startSelectTrace(ctx *query.ExecutionContext, stmt *influxql.SelectStatement) (context.Context, *tracing.Trace, *tracing.Span) {
+	if !tracing.ExportEnabled() {
+		return ctx, nil, nil
+	}
+	trace, span := tracing.NewTrace("SELECT")
+	if !trace.Sample(ctx.TraceParent) {
+		return ctx, nil, nil
+	}
+	span.AppendNameValue("statement", stmt.String())
+	qctx := tracing.NewContextWithTrace(tracing.NewContextWithExportOnly(ctx), trace)
+	return tracing.NewContextWithSpan(qctx, span), trace, span
+}
+
 func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan query.RowsChan) query.SelectOptions {
 	return query.SelectOptions{
 		NodeID:                  opt.NodeID,
@@ -1203,6 +1251,7 @@ func (e *StatementExecutor) GetOptions(opt query.ExecutionOptions, rowsChan chan
 		MaxFieldsN:              e.MaxSelectFieldsN,
 		MaxPointN:               e.MaxSelectPointN,
 		MaxBucketsN:             e.MaxSelectBucketsN,
+		PointLimiter:            opt.PointLimiter,
 		Authorizer:              opt.Authorizer,
 		MaxQueryMem:             e.MaxQueryMem,
 		MaxQueryParallel:        e.MaxQueryParallel,
@@ -1885,7 +1934,11 @@ func (e *StatementExecutor) executeShowTagValuesCardinality(stmt *influxql.ShowT
 		Offset:          0,
 	}
 
-	exec.Cardinality(stmt.Dimensions)
+	if !stmt.Exact && e.TagValuesCardinalityEstimate {
+		exec.ApproximateCardinality(stmt.Dimensions)
+	} else {
+		exec.Cardinality(stmt.Dimensions)
+	}
 	return exec.Execute(newStmt)
 }
 
@@ -2087,6 +2140,59 @@ func (e *StatementExecutor) showSeriesExactCardinality(stmt *influxql.ShowSeries
 	return rows, nil
 }
 
+// executeShowSeriesQuota reports the usage of the series quota of the database and its measurements.
+// The quota of a measurement is enforced by each store node over all the partitions of the database on it,
+// so the fullest node is reported.
+func (e *StatementExecutor) executeShowSeriesQuota(stmt *influxql.ShowSeriesQuotaStatement) (models.Rows, error) {
+	mis, err := e.MetaClient.MatchMeasurements(stmt.Database, stmt.Sources.Measurements())
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(mis))
+	for _, m := range mis {
+		names = append(names, m.Name)
+	}
+
+	ret := &netstorage.SeriesQuota{Series: make(map[string]uint64, len(names))}
+	lock := new(sync.Mutex)
+	err = e.MetaExecutor.EachDBNodes(stmt.Database, func(nodeID uint64, pts []uint32) error {
+		quota, err := e.NetStorage.SeriesQuota(nodeID, stmt.Database, pts, names)
+		if err != nil {
+			return err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		ret.Limit = max(ret.Limit, quota.Limit)
+		ret.DatabaseSeries = max(ret.DatabaseSeries, quota.DatabaseSeries)
+		ret.DatabaseLimit = max(ret.DatabaseLimit, quota.DatabaseLimit)
+		for name, n := range quota.Series {
+			ret.Series[name] = max(ret.Series[name], n)
+		}
+		return nil
+	})
+	if err != nil {
+		e.StmtExecLogger.Error("failed to show series quota", zap.Error(err))
+		return nil, err
+	}
+
+	mstRow := &models.Row{Name: "measurements", Columns: []string{"measurement", "series", "limit"}}
+	for name, n := range ret.Series {
+		mstRow.Values = append(mstRow.Values, []interface{}{name, n, ret.Limit})
+	}
+	sort.Slice(mstRow.Values, func(i, j int) bool {
+		return mstRow.Values[i][0].(string) < mstRow.Values[j][0].(string)
+	})
+
+	return models.Rows{
+		{
+			Name:    "database",
+			Columns: []string{"database", "series", "limit"},
+			Values:  [][]interface{}{{stmt.Database, ret.DatabaseSeries, ret.DatabaseLimit}},
+		},
+		mstRow,
+	}, nil
+}
+
 func (e *StatementExecutor) executeShowUsersStatement(q *influxql.ShowUsersStatement) (models.Rows, error) {
 	row := &models.Row{Columns: []string{"user", "admin", "rwuser"}}
 	for _, ui := range e.MetaClient.Users() {
@@ -2339,6 +2445,10 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.ShowSeriesQuotaStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.CreateMeasurementStatement:
 			if node.Database == "" {
 				node.Database = defaultDatabase
@@ -2347,6 +2457,10 @@ func (e *StatementExecutor) NormalizeStatement(stmt influxql.Statement, defaultD
 			if node.Database == "" {
 				node.Database = defaultDatabase
 			}
+		case *influxql.AlterMeasurementTTLStatement:
+			if node.Database == "" {
+				node.Database = defaultDatabase
+			}
 		case *influxql.CreateDownSampleStatement:
 			if node.DbName == "" {
 				node.DbName = defaultDatabase
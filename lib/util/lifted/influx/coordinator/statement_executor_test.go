@@ -1,16 +1,19 @@
 package coordinator
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	Logger "github.com/openGemini/openGemini/lib/logger"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
@@ -397,3 +400,36 @@ func TestStatementExecutor_NormalizeStatement(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestStartSelectTrace(t *testing.T) {
+	stmt := &influxql.SelectStatement{
+		Fields:  influxql.Fields{{Expr: &influxql.VarRef{Val: "f1"}}},
+		Sources: influxql.Sources{&influxql.Measurement{Name: "cpu"}},
+	}
+	ectx := &query.ExecutionContext{Context: context.Background()}
+	ectx.TraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	// the queries are not traced if the export is disabled
+	ctx, trace, span := startSelectTrace(ectx, stmt)
+	assert.Nil(t, trace)
+	assert.Nil(t, span)
+	assert.Nil(t, tracing.SpanFromContext(ctx))
+
+	conf := config.NewTracingConfig()
+	conf.Enabled = true
+	conf.Endpoint = "http://127.0.0.1:4318/v1/traces"
+	exporter := tracing.NewExporter(conf, config.AppSql)
+	assert.NoError(t, exporter.Open())
+	defer exporter.Close()
+
+	ctx, trace, span = startSelectTrace(ectx, stmt)
+	assert.NotNil(t, trace)
+	assert.Equal(t, span, tracing.SpanFromContext(ctx))
+	assert.Equal(t, trace, tracing.TraceFromContext(ctx))
+	assert.True(t, strings.HasPrefix(trace.TraceParent(span), "00-4bf92f3577b34da6a3ce929d0e0e4736-"))
+
+	// the caller does not sample the query
+	ectx.TraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"
+	_, trace, _ = startSelectTrace(ectx, stmt)
+	assert.Nil(t, trace)
+}
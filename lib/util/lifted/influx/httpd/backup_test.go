@@ -24,11 +24,14 @@ import (
 	"github.com/openGemini/openGemini/engine"
 	"github.com/openGemini/openGemini/engine/immutable"
 	"github.com/openGemini/openGemini/engine/index/tsi"
+	"github.com/openGemini/openGemini/lib/backup"
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/fileops"
 	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/mocks"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
 )
 
 type mockMetaClient4Backup struct {
@@ -317,6 +320,71 @@ func TestRecover2(t *testing.T) {
 	os.RemoveAll("/tmp/openGemini/backup_dir")
 }
 
+func TestRemoteBackupAndRecover(t *testing.T) {
+	isMasterPt = false
+	isInc = false
+	_ = os.MkdirAll("/tmp/openGemini/backup_dir", 0750)
+	defer os.RemoveAll("/tmp/openGemini/backup_dir")
+
+	server := mocks.NewObjectStorage("backup")
+	defer server.Close()
+	storeConf := config.NewStore()
+	storeConf.DataDir = "/tmp/openGemini/backup_dir/data"
+	storeConf.MetaDir = "/tmp/openGemini/backup_dir/meta"
+	storeConf.RemoteBackup.Enabled = true
+	storeConf.RemoteBackup.Endpoint = server.URL
+	storeConf.RemoteBackup.Bucket = "backup"
+	storeConf.RemoteBackup.BasePath = "openGemini"
+	storeConf.RemoteBackup.PathStyle = true
+	config.SetStoreConfig(storeConf)
+	defer config.SetStoreConfig(config.NewStore())
+
+	b := &engine.Backup{
+		IsRemote:   true,
+		BackupPath: "full",
+		Engine:     CreateEngine(1),
+	}
+	require.NoError(t, b.RunBackupData())
+	node := backup.RemoteNodeName(storeConf.InsertAddr())
+	_, ok := server.Object("openGemini/full/manifest/" + node + ".json")
+	require.True(t, ok)
+	_, ok = server.Object("openGemini/full/" + node + "/data_backup/tmp/openGemini/backup_dir/data/data/db0/0/rp0/0_0_0_0/tssp/a_0000/00000476-0001-00000000.tssp")
+	require.True(t, ok)
+	result, _ := server.Object("openGemini/full/result/" + node)
+	require.Equal(t, "backup success", string(result))
+
+	isInc = true
+	defer func() {
+		isInc = false
+	}()
+	b.IsInc = true
+	b.BackupPath = "inc"
+	require.NoError(t, b.RunBackupData())
+	_, ok = server.Object("openGemini/inc/" + node + "/data_backup/tmp/openGemini/backup_dir/data/data/db0/0/rp0/0_0_0_0/tssp/a_0000/00000476-0002-00000000.tssp")
+	require.True(t, ok)
+
+	b.BackupPath = "/tmp/openGemini/backup_dir/inc"
+	require.Error(t, b.RunBackupData())
+
+	stagingPath := t.TempDir()
+	recoverConfig := &recover.RecoverConfig{
+		RecoverMode:        "1",
+		FullBackupDataPath: "full",
+		IncBackupDataPath:  "inc",
+		Remote:             true,
+		StagingPath:        stagingPath,
+	}
+	require.NoError(t, recover.BackupRecover(recoverConfig, &config.TsRecover{Data: storeConf}))
+	_, err := os.Stat("/tmp/openGemini/backup_dir/data/data/db0/0/rp0/0_0_0_0/tssp/a_0000/00000476-0002-00000000.tssp")
+	require.NoError(t, err)
+	entries, err := os.ReadDir(stagingPath)
+	require.NoError(t, err)
+	require.Empty(t, entries)
+
+	recoverConfig.FullBackupDataPath = "missing"
+	require.Error(t, recover.BackupRecover(recoverConfig, &config.TsRecover{Data: storeConf}))
+}
+
 func TestBackupError(t *testing.T) {
 	fullBackupPath := "/tmp/openGemini/backup_dir/backup"
 	b := &engine.Backup{
@@ -368,6 +436,10 @@ func (ms *mockShard) GetDataPath() string {
 	return "/tmp/openGemini/backup_dir/data/data/db0/0/rp0/0_0_0_0"
 }
 
+func (ms *mockShard) GetWalPath() string {
+	return "/tmp/openGemini/backup_dir/data/wal/db0/0/rp0/0_0_0_0"
+}
+
 type mockTsspFile struct {
 	immutable.TSSPFile
 	path string
@@ -8,6 +8,7 @@ import (
 	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/influxdata/influxdb/toml"
@@ -21,6 +22,16 @@ const (
 	// DefaultFlightAddress is the default address to bind to.
 	DefaultFlightAddress = ":8087"
 
+	// DefaultOtlpGRPCAddress is the default address the OTLP/gRPC receiver binds to.
+	DefaultOtlpGRPCAddress = ":4317"
+
+	// DefaultSyslogAddress is the default address the syslog listener binds to.
+	DefaultSyslogAddress = ":5514"
+	// DefaultSyslogBatchSize is the default number of messages written to the log stream in one batch.
+	DefaultSyslogBatchSize = 1000
+	// DefaultSyslogFlushInterval is the default interval the buffered messages are written to the log stream.
+	DefaultSyslogFlushInterval = time.Second
+
 	// DefaultRealm is the default realm sent back when issuing a basic auth challenge.
 	DefaultRealm = "InfluxDB"
 
@@ -47,6 +58,8 @@ type Config struct {
 	FlightEnabled           bool              `toml:"flight-enabled"`
 	FlightAuthEnabled       bool              `toml:"flight-auth-enabled"`
 	FlightChFactor          int               `toml:"flight-ch-factor"`
+	OtlpGRPCEnabled         bool              `toml:"otlp-grpc-enabled"`
+	OtlpGRPCAddress         string            `toml:"otlp-grpc-address"`
 	Domain                  string            `toml:"domain"`
 	AuthEnabled             bool              `toml:"auth-enabled"`
 	WeakPwdPath             string            `toml:"weakpwd-path"`
@@ -90,7 +103,9 @@ type Config struct {
 	TimeFilterProtection    bool              `toml:"time-filter-protection"`
 	CPUThreshold            int               `toml:"cpu-threshold"`
 	MaxLineSize             int               `toml:"max-line-size"`
+	ParquetExportDir        string            `toml:"parquet-export-dir"`
 	ResultCache             ResultCacheConfig `toml:"result-cache"`
+	Syslog                  SyslogConfig      `toml:"syslog"`
 }
 
 func CombineDomain(domain, addr string) string {
@@ -120,6 +135,8 @@ func NewConfig() Config {
 		FlightEnabled:           false,
 		FlightAuthEnabled:       false,
 		FlightChFactor:          2,
+		OtlpGRPCEnabled:         false,
+		OtlpGRPCAddress:         DefaultOtlpGRPCAddress,
 		LogEnabled:              true,
 		PprofEnabled:            true,
 		DebugPprofEnabled:       false,
@@ -141,6 +158,7 @@ func NewConfig() Config {
 		ReadBlockSize:           toml.Size(DefaultBlockSize),
 		TimeFilterProtection:    false,
 		MaxLineSize:             DefaultMaxLineSize,
+		Syslog:                  NewSyslogConfig(),
 	}
 }
 
@@ -152,6 +170,9 @@ func (c Config) Validate() error {
 	if c.FlightAddress == "" {
 		return errors.New("http arrowflight-address must be specified")
 	}
+	if c.OtlpGRPCEnabled && c.OtlpGRPCAddress == "" {
+		return errors.New("http otlp-grpc-address must be specified")
+	}
 	if c.MaxConnectionLimit < 0 {
 		return errors.New("http max-connection-limit can not be negative")
 	}
@@ -176,7 +197,10 @@ func (c Config) Validate() error {
 	if c.MaxRowSizeLimit < 0 {
 		return errors.New("http max-row-size-limit can not be negative")
 	}
-	return nil
+	if dir, ok := strings.CutPrefix(c.ParquetExportDir, "obs://"); ok && !strings.Contains(strings.Trim(dir, "/"), "/") {
+		return errors.New("http parquet-export-dir must be obs://endpoint/bucket[/path]")
+	}
+	return c.Syslog.Validate()
 }
 
 func (c *Config) ShowConfigs() map[string]interface{} {
@@ -186,6 +210,8 @@ func (c *Config) ShowConfigs() map[string]interface{} {
 		"http.flight-enabled":                      c.FlightEnabled,
 		"http.flight-auth-enabled":                 c.FlightAuthEnabled,
 		"http.flight-ch-factor":                    c.FlightChFactor,
+		"http.otlp-grpc-enabled":                   c.OtlpGRPCEnabled,
+		"http.otlp-grpc-address":                   c.OtlpGRPCAddress,
 		"http.domain":                              c.Domain,
 		"http.auth-enabled":                        c.AuthEnabled,
 		"http.weakpwd-path":                        c.WeakPwdPath,
@@ -226,12 +252,20 @@ func (c *Config) ShowConfigs() map[string]interface{} {
 		"http.read-block-size":                     c.ReadBlockSize,
 		"http.time-filter-protection":              c.TimeFilterProtection,
 		"http.cpu-threshold":                       c.CPUThreshold,
+		"http.parquet-export-dir":                  c.ParquetExportDir,
 		"http.result-cache.enabled":                c.ResultCache.Enabled,
 		"http.result-cache.SplitQueriesByInterval": c.ResultCache.SplitQueriesByInterval,
 		"http.result-cache.MaxCacheFreshness":      c.ResultCache.MaxCacheFreshness,
 		"http.result-cache.CacheType":              c.ResultCache.CacheType,
 		"http.result-cache.MemCacheSize":           c.ResultCache.MemCacheSize,
 		"http.result-cache.MemCacheExpiration":     c.ResultCache.MemCacheExpiration,
+		"http.syslog.enabled":                      c.Syslog.Enabled,
+		"http.syslog.bind-address":                 c.Syslog.BindAddress,
+		"http.syslog.protocol":                     c.Syslog.Protocol,
+		"http.syslog.repository":                   c.Syslog.Repository,
+		"http.syslog.logstream":                    c.Syslog.LogStream,
+		"http.syslog.batch-size":                   c.Syslog.BatchSize,
+		"http.syslog.flush-interval":               c.Syslog.FlushInterval,
 	}
 }
 
@@ -342,3 +376,46 @@ type ResultCacheConfig struct {
 	MemCacheSize       int             `toml:"memcache-size"`
 	MemCacheExpiration toml.Duration   `toml:"memcache-expiration"`
 }
+
+// SyslogConfig is the configuration of the syslog listener,
+// which writes the received messages into a log stream as the syslog records of the log store.
+type SyslogConfig struct {
+	Enabled       bool          `toml:"enabled"`
+	BindAddress   string        `toml:"bind-address"`
+	Protocol      string        `toml:"protocol"`
+	Repository    string        `toml:"repository"`
+	LogStream     string        `toml:"logstream"`
+	BatchSize     int           `toml:"batch-size"`
+	FlushInterval toml.Duration `toml:"flush-interval"`
+}
+
+func NewSyslogConfig() SyslogConfig {
+	return SyslogConfig{
+		BindAddress:   DefaultSyslogAddress,
+		Protocol:      "udp",
+		BatchSize:     DefaultSyslogBatchSize,
+		FlushInterval: toml.Duration(DefaultSyslogFlushInterval),
+	}
+}
+
+func (c SyslogConfig) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.BindAddress == "" {
+		return errors.New("http syslog bind-address must be specified")
+	}
+	if c.Protocol != "udp" && c.Protocol != "tcp" {
+		return fmt.Errorf("http syslog protocol must be udp or tcp, got %q", c.Protocol)
+	}
+	if c.Repository == "" || c.LogStream == "" {
+		return errors.New("http syslog repository and logstream must be specified")
+	}
+	if c.BatchSize <= 0 {
+		return errors.New("http syslog batch-size must be positive")
+	}
+	if c.FlushInterval <= 0 {
+		return errors.New("http syslog flush-interval must be positive")
+	}
+	return nil
+}
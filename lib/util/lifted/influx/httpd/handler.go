@@ -38,6 +38,7 @@ import (
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/sysconfig"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/auth"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
@@ -157,10 +158,20 @@ type Handler struct {
 		DBPtView(database string) (meta2.DBPtInfos, error)
 		MarkRetentionPolicyDelete(database, name string) error
 		CreateMeasurement(database, retentionPolicy, mst string, shardKey *meta2.ShardKeyInfo, numOfShards int32, indexR *influxql.IndexRelation, engineType config2.EngineType,
-			colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options) (*meta2.MeasurementInfo, error)
+			colStoreInfo *meta2.ColStoreInfo, schemaInfo []*proto2.FieldSchema, options *meta2.Options, ttl time.Duration) (*meta2.MeasurementInfo, error)
 		UpdateMeasurement(db, rp, mst string, options *meta2.Options) error
 		GetShardGroupByTimeRange(repoName, streamName string, min, max time.Time) ([]*meta2.ShardGroupInfo, error)
 		RevertRetentionPolicyDelete(database, name string) error
+
+		CreateAlertRule(rule *meta2.AlertRuleInfo) error
+		DropAlertRule(name string) error
+		AlertRules() map[string]*meta2.AlertRuleInfo
+		CreateAlertReceiver(receiver *meta2.AlertReceiverInfo) error
+		DropAlertReceiver(name string) error
+		AlertReceivers() map[string]*meta2.AlertReceiverInfo
+		CreateAlertSilence(silence *meta2.AlertSilenceInfo) error
+		DropAlertSilence(id string) error
+		AlertSilences() map[string]*meta2.AlertSilenceInfo
 	}
 
 	QueryAuthorizer interface {
@@ -238,6 +249,14 @@ func NewHandler(c config.Config) *Handler {
 			"query", // Query serving route.
 			"POST", "/query", true, true, h.serveQuery,
 		},
+		Route{
+			"export-parquet", // Export select results as a parquet file.
+			"GET", "/export/parquet", true, true, h.serveExportParquet,
+		},
+		Route{
+			"export-parquet", // Export select results as a parquet file.
+			"POST", "/export/parquet", true, true, h.serveExportParquet,
+		},
 		Route{
 			"write-options", // Satisfy CORS checks.
 			"OPTIONS", "/write", false, true, h.serveOptions,
@@ -386,6 +405,58 @@ func NewHandler(c config.Config) *Handler {
 			"prometheus-metadata-query-metric-store", // Prometheus metadata query
 			"GET", "/prometheus/{metric_store}/api/v1/metadata", true, true, h.servePromQueryMetaDataWithMetricStore,
 		},
+		Route{
+			"otlp-metrics-write", // OpenTelemetry OTLP/HTTP metrics export
+			"POST", "/otlp/v1/metrics", false, true, h.serveOtlpMetricsWrite,
+		},
+		Route{
+			"otlp-metrics-write-metric-store", // OpenTelemetry OTLP/HTTP metrics export
+			"POST", "/otlp/{metric_store}/v1/metrics", false, true, h.serveOtlpMetricsWriteWithMetricStore,
+		},
+		Route{
+			"alerts", // Prometheus-compatible alerts query
+			"GET", "/api/v1/alerts", true, true, h.serveAlerts,
+		},
+		Route{
+			"alert-rules",
+			"GET", "/api/v1/alerting/rules", true, true, h.serveAlertRules,
+		},
+		Route{
+			"alert-rule",
+			"GET", "/api/v1/alerting/rules/{name}", true, true, h.serveAlertRule,
+		},
+		Route{
+			"create-alert-rule",
+			"POST", "/api/v1/alerting/rules", false, true, h.serveCreateAlertRule,
+		},
+		Route{
+			"drop-alert-rule",
+			"DELETE", "/api/v1/alerting/rules/{name}", false, true, h.serveDropAlertRule,
+		},
+		Route{
+			"alert-receivers",
+			"GET", "/api/v1/alerting/receivers", true, true, h.serveAlertReceivers,
+		},
+		Route{
+			"create-alert-receiver",
+			"POST", "/api/v1/alerting/receivers", false, true, h.serveCreateAlertReceiver,
+		},
+		Route{
+			"drop-alert-receiver",
+			"DELETE", "/api/v1/alerting/receivers/{name}", false, true, h.serveDropAlertReceiver,
+		},
+		Route{
+			"alert-silences",
+			"GET", "/api/v1/alerting/silences", true, true, h.serveAlertSilences,
+		},
+		Route{
+			"create-alert-silence",
+			"POST", "/api/v1/alerting/silences", false, true, h.serveCreateAlertSilence,
+		},
+		Route{
+			"drop-alert-silence",
+			"DELETE", "/api/v1/alerting/silences/{id}", false, true, h.serveDropAlertSilence,
+		},
 		Route{ // sysCtrl
 			"sysCtrl",
 			"POST", "/debug/ctrl", false, true, h.serveSysCtrl,
@@ -495,6 +566,34 @@ func NewHandler(c config.Config) *Handler {
 				"delete-stream-task",
 				"DELETE", "/repo/{repository}/logstreams/{logStream}/stream-task/{taskId}", false, true, h.serveDeleteStreamTask,
 			},
+			Route{
+				"es-bulk", // Elasticsearch compatible bulk ingest.
+				"POST", "/_bulk", false, true, h.serveBulk,
+			},
+			Route{
+				"es-bulk-index", // Elasticsearch compatible bulk ingest with the default index.
+				"POST", "/{index}/_bulk", false, true, h.serveBulk,
+			},
+			Route{
+				"loki-push", // Loki compatible push.
+				"POST", "/repo/{repository}/logstreams/{logStream}/loki/api/v1/push", false, true, h.serveLokiPush,
+			},
+			Route{
+				"loki-query", // Loki compatible instant query.
+				"GET", "/repo/{repository}/logstreams/{logStream}/loki/api/v1/query", true, true, h.serveLokiQuery,
+			},
+			Route{
+				"loki-query-range", // Loki compatible range query.
+				"GET", "/repo/{repository}/logstreams/{logStream}/loki/api/v1/query_range", true, true, h.serveLokiQueryRange,
+			},
+			Route{
+				"loki-labels", // Loki compatible labels.
+				"GET", "/repo/{repository}/logstreams/{logStream}/loki/api/v1/labels", true, true, h.serveLokiLabels,
+			},
+			Route{
+				"loki-label-values", // Loki compatible label values.
+				"GET", "/repo/{repository}/logstreams/{logStream}/loki/api/v1/label/{name}/values", true, true, h.serveLokiLabelValues,
+			},
 		}...)
 
 	}
@@ -571,7 +670,8 @@ func (h *Handler) AddRoutes(routes ...Route) {
 		if r.Method == http.MethodPost {
 			switch r.Pattern {
 			case "/write", "/api/v1/prom/write", "/repo/{repository}/logstreams/{logStream}/records",
-				"/api/streams/{repository}/{logStream}/upload":
+				"/api/streams/{repository}/{logStream}/upload", "/_bulk", "/{index}/_bulk",
+				"/repo/{repository}/logstreams/{logStream}/loki/api/v1/push":
 				handler = h.writeThrottler.Handler(handler)
 			case "/query", "/api/v1/prom/query":
 				handler = h.queryThrottler.Handler(handler)
@@ -585,7 +685,9 @@ func (h *Handler) AddRoutes(routes ...Route) {
 				handler = h.queryThrottler.Handler(handler)
 			case "/repo/{repository}/logstreams/{logStream}/logs", "/repo/{repository}/logstreams/{logStream}/consume/logs",
 				"/repo/{repository}/logstreams/{logStream}/context", "/repo/{repository}/logstreams/{logStream}/histogram",
-				"/repo/{repository}/logstreams/{logStream}/analytics", "/repo/{repository}/logstreams/{logStream}/logbycursor":
+				"/repo/{repository}/logstreams/{logStream}/analytics", "/repo/{repository}/logstreams/{logStream}/logbycursor",
+				"/repo/{repository}/logstreams/{logStream}/loki/api/v1/query", "/repo/{repository}/logstreams/{logStream}/loki/api/v1/query_range",
+				"/repo/{repository}/logstreams/{logStream}/loki/api/v1/label/{name}/values":
 				handler = h.queryThrottler.Handler(handler)
 			default:
 			}
@@ -1126,6 +1228,7 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		ParallelQuery:      atomic.LoadInt32(&syscontrol.ParallelQueryInBatch) == 1,
 		Quiet:              true,
 		Authorizer:         h.getAuthorizer(user),
+		TraceParent:        r.Header.Get(tracing.TraceParentHeader),
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -1147,16 +1250,27 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 		}()
 	}
 
+	release, err := acquireTenantQuery(user, db, &opts)
+	if err != nil {
+		h.httpError(rw, err.Error(), http.StatusTooManyRequests)
+		h.Logger.Warn("serveQuery: rejected by the tenant limits", zap.Error(err))
+		return
+	}
+
 	// Execute query
 	results := h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
 
 	// If we are running in async mode, open a goroutine to drain the results
 	// and return with a StatusNoContent.
 	if async {
-		go h.async(q, results)
+		go func() {
+			h.async(q, results)
+			release()
+		}()
 		h.writeHeader(w, http.StatusNoContent)
 		return
 	}
+	defer release()
 
 	// if we're not chunking, this will be the in memory buffer for all results before sending to client
 	stmtID2Result := make(map[int]*query.Result)
@@ -1164,7 +1278,10 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 	// Status header is OK once this point is reached.
 	// Attempt to flush the header immediately so the client gets the header information
 	// and knows the query was accepted.
-	if !isPipe {
+	// If the query has a points limit, the header waits for the first result, or for all the
+	// results if they are buffered, so that a query aborted by the limit is answered with 429.
+	headerPending := !isPipe && opts.PointLimiter != nil
+	if !isPipe && !headerPending {
 		h.writeHeader(rw, http.StatusOK)
 		if w, ok := w.(http.Flusher); ok {
 			w.Flush()
@@ -1185,6 +1302,19 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 			return
 		}
 
+		if headerPending {
+			if errno.Equal(r.Err, errno.SelectPointsLimitExceeded) {
+				h.httpError(rw, r.Err.Error(), http.StatusTooManyRequests)
+				h.Logger.Warn("serveQuery: rejected by the points limit", zap.Error(r.Err))
+				return
+			}
+			if chunked {
+				headerPending = false
+				h.writeHeader(rw, http.StatusOK)
+				w.(http.Flusher).Flush()
+			}
+		}
+
 		// if requested, convert result timestamps to epoch
 		if epoch != "rfc3339" {
 			convertToEpoch(r, epoch)
@@ -1223,6 +1353,9 @@ func (h *Handler) serveQuery(w http.ResponseWriter, r *http.Request, user meta2.
 	resp := h.getStmtResult(stmtID2Result)
 	// If it's not chunked we buffered everything in memory, so write it out
 	if !chunked {
+		if headerPending {
+			h.writeHeader(rw, http.StatusOK)
+		}
 		n, _ := rw.WriteResponse(resp)
 		handlerStat.QueryRequestBytesTransmitted.Add(int64(n))
 	}
@@ -1392,7 +1525,26 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 
 	readBlockSize := int(h.Config.ReadBlockSize)
 	rp := urlValues.Get("rp")
+	tenant := tenantID(user, database)
+	// the bytes limit is checked once for the whole body, so a rejected request writes nothing.
+	// The blocks are only checked one by one if the size of the body is unknown.
+	if err := validation.AllowBytes(tenant, int(r.ContentLength)); err != nil {
+		h.httpError(w, err.Error(), http.StatusTooManyRequests)
+		h.Logger.Warn("write rejected by the tenant limits", zap.Error(err), zap.String("db", database))
+		handlerStat.Write429ErrRequests.Incr()
+		return
+	}
 	for ctx.Read(readBlockSize) {
+		if r.ContentLength < 0 {
+			if err := validation.AllowBytes(tenant, len(ctx.ReqBuf)); err != nil {
+				ctx.ErrLock.Lock()
+				if ctx.CallbackErr == nil {
+					ctx.CallbackErr = err
+				}
+				ctx.ErrLock.Unlock()
+				break
+			}
+		}
 		numPtsParse++
 		uw := influx.GetUnmarshalWork()
 		uw.Callback = func(db string, rows []influx.Row, err error) {
@@ -1406,7 +1558,11 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 			if atomic.LoadInt32(&syscontrol.LogRowsRuleSwitch) == 1 {
 				h.logRowsIfNecessary(rows, uw.ReqBuf)
 			}
-			if err = h.PointsWriter.RetryWritePointRows(db, rp, rows); err != nil {
+			err = validation.AllowRows(tenant, rows)
+			if err == nil {
+				err = h.PointsWriter.RetryWritePointRows(db, rp, rows)
+			}
+			if err != nil {
 				ctx.ErrLock.Lock()
 				if ctx.CallbackErr == nil {
 					ctx.CallbackErr = err
@@ -1448,7 +1604,13 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 		return
 	}
 	if err := ctx.CallbackErr; err != nil {
-		if influxdb.IsClientError(err) {
+		if validation.IsTenantLimitError(err) {
+			handlerStat.PointsWrittenFail.Add(int64(numPtsInsert))
+			h.httpError(w, err.Error(), http.StatusTooManyRequests)
+			h.Logger.Warn("write rejected by the tenant limits", zap.Error(err), zap.String("db", database))
+			handlerStat.Write429ErrRequests.Incr()
+			return
+		} else if influxdb.IsClientError(err) {
 			handlerStat.PointsWrittenFail.Add(int64(numPtsInsert))
 			h.Logger.Error("write client error:WritePointsWithContext", zap.Error(err), zap.String("db", database))
 			h.httpError(w, err.Error(), http.StatusBadRequest)
@@ -1485,6 +1647,29 @@ func (h *Handler) serveWrite(w http.ResponseWriter, r *http.Request, user meta2.
 	h.writeHeader(w, http.StatusNoContent)
 }
 
+// tenantID returns the key of the per-tenant limits of a request to db.
+func tenantID(user meta2.User, db string) string {
+	name := ""
+	if user != nil {
+		name = user.ID()
+	}
+	return validation.Limits().TenantID(name, db)
+}
+
+// acquireTenantQuery reserves a query slot of the tenant of the request and sets the
+// points limit of the query. The returned function releases the slot.
+func acquireTenantQuery(user meta2.User, db string, opts *query.ExecutionOptions) (func(), error) {
+	tenant := tenantID(user, db)
+	release, err := validation.AcquireQuery(tenant)
+	if err != nil {
+		return nil, err
+	}
+	opts.PointLimiter = query.NewPointLimiter(validation.Limits().MaxPointsPerQuery(tenant), func() {
+		validation.PointsLimitExceeded(tenant)
+	})
+	return release, nil
+}
+
 // serveOptions returns an empty response to comply with OPTIONS pre-flight requests
 func (h *Handler) serveOptions(w http.ResponseWriter, r *http.Request) {
 	h.writeHeader(w, http.StatusNoContent)
@@ -0,0 +1,546 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/influxdata/influxdb/uuid"
+	"github.com/openGemini/openGemini/lib/logql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/prometheus/prometheus/promql/parser"
+	"go.uber.org/zap"
+)
+
+const (
+	// AlertRuleName is the path parameter of the name of the alert rule
+	AlertRuleName = "name"
+	// AlertReceiverName is the path parameter of the name of the alert receiver
+	AlertReceiverName = "name"
+	// AlertSilenceID is the path parameter of the id of the alert silence
+	AlertSilenceID = "id"
+
+	// the defaults of the receivers are the same as alertmanager
+	alertDefaultGroupWait      = 30 * time.Second
+	alertDefaultGroupInterval  = 5 * time.Minute
+	alertDefaultRepeatInterval = 4 * time.Hour
+
+	maxAlertRequestSize = 1 << 20
+)
+
+type alertRuleBody struct {
+	Name            string            `json:"name"`
+	Type            string            `json:"type"`
+	Database        string            `json:"database"`
+	RetentionPolicy string            `json:"retentionPolicy,omitempty"`
+	LogStream       string            `json:"logStream,omitempty"`
+	Query           string            `json:"query"`
+	Condition       string            `json:"condition,omitempty"`
+	Interval        string            `json:"interval,omitempty"`
+	For             string            `json:"for,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+	Receivers       []string          `json:"receivers,omitempty"`
+}
+
+type alertRuleStatus struct {
+	alertRuleBody
+	Health         string       `json:"health"`
+	LastError      string       `json:"lastError,omitempty"`
+	LastEvaluation *time.Time   `json:"lastEvaluation,omitempty"`
+	Evaluator      string       `json:"evaluator,omitempty"`
+	Alerts         []*alertBody `json:"alerts"`
+}
+
+// alertBody is compatible with the alerts api of prometheus, with the time of firing and resolution added.
+type alertBody struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	State       string            `json:"state"`
+	ActiveAt    *time.Time        `json:"activeAt,omitempty"`
+	FiredAt     *time.Time        `json:"firedAt,omitempty"`
+	ResolvedAt  *time.Time        `json:"resolvedAt,omitempty"`
+	Value       string            `json:"value"`
+}
+
+type alertReceiverBody struct {
+	Name           string   `json:"name"`
+	URL            string   `json:"url"`
+	GroupBy        []string `json:"groupBy,omitempty"`
+	GroupWait      string   `json:"groupWait,omitempty"`
+	GroupInterval  string   `json:"groupInterval,omitempty"`
+	RepeatInterval string   `json:"repeatInterval,omitempty"`
+}
+
+type alertMatcherBody struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	IsRegex bool   `json:"isRegex"`
+}
+
+type alertSilenceBody struct {
+	ID        string             `json:"id,omitempty"`
+	Matchers  []alertMatcherBody `json:"matchers"`
+	StartsAt  time.Time          `json:"startsAt"`
+	EndsAt    time.Time          `json:"endsAt"`
+	CreatedBy string             `json:"createdBy,omitempty"`
+	Comment   string             `json:"comment,omitempty"`
+	Status    string             `json:"status,omitempty"`
+}
+
+func alertTimePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func alertDurationString(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return influxql.FormatDuration(d)
+}
+
+func parseAlertDuration(s string, def time.Duration) (time.Duration, error) {
+	if s == "" {
+		return def, nil
+	}
+	return influxql.ParseDuration(s)
+}
+
+func newAlertBody(rule *meta2.AlertRuleInfo, alert *meta2.AlertInfo) *alertBody {
+	body := &alertBody{
+		Labels:      alert.Labels,
+		Annotations: rule.ExpandAnnotations(alert),
+		State:       meta2.AlertStateName(alert.State),
+		ActiveAt:    alertTimePtr(alert.ActiveAt),
+		FiredAt:     alertTimePtr(alert.FiredAt),
+		ResolvedAt:  alertTimePtr(alert.ResolvedAt),
+		Value:       strconv.FormatFloat(alert.Value, 'e', -1, 64),
+	}
+	if body.Annotations == nil {
+		body.Annotations = map[string]string{}
+	}
+	return body
+}
+
+func newAlertRuleBody(rule *meta2.AlertRuleInfo) alertRuleBody {
+	return alertRuleBody{
+		Name:            rule.Name,
+		Type:            rule.Type,
+		Database:        rule.Database,
+		RetentionPolicy: rule.RetentionPolicy,
+		LogStream:       rule.LogStream,
+		Query:           rule.Query,
+		Condition:       rule.Condition,
+		Interval:        alertDurationString(rule.Interval),
+		For:             alertDurationString(rule.For),
+		Labels:          rule.Labels,
+		Annotations:     rule.Annotations,
+		Receivers:       rule.Receivers,
+	}
+}
+
+func newAlertRuleStatus(rule *meta2.AlertRuleInfo) *alertRuleStatus {
+	status := &alertRuleStatus{
+		alertRuleBody:  newAlertRuleBody(rule),
+		Health:         "unknown",
+		LastError:      rule.LastError,
+		LastEvaluation: alertTimePtr(rule.LastEvalTime),
+		Evaluator:      rule.Owner,
+		Alerts:         make([]*alertBody, 0, len(rule.Alerts)),
+	}
+	if rule.LastError != "" {
+		status.Health = "err"
+	} else if !rule.LastEvalTime.IsZero() {
+		status.Health = "ok"
+	}
+	for _, alert := range rule.Alerts {
+		status.Alerts = append(status.Alerts, newAlertBody(rule, alert))
+	}
+	return status
+}
+
+// validateAlertQuery returns an error if the query of the rule can not be parsed by its query language.
+func validateAlertQuery(rule *meta2.AlertRuleInfo) error {
+	switch rule.Type {
+	case meta2.AlertTypeInfluxQL:
+		q, err := influxql.ParseQuery(rule.Query)
+		if err != nil {
+			return err
+		}
+		if len(q.Statements) != 1 {
+			return fmt.Errorf("the influxql alert query must be a single select statement")
+		}
+		if _, ok := q.Statements[0].(*influxql.SelectStatement); !ok {
+			return fmt.Errorf("the influxql alert query must be a single select statement")
+		}
+	case meta2.AlertTypePromQL:
+		if _, err := parser.ParseExpr(rule.Query); err != nil {
+			return err
+		}
+	case meta2.AlertTypeLogQL:
+		expr, err := logql.Parse(rule.Query)
+		if err != nil {
+			return err
+		}
+		if _, ok := expr.(*logql.MetricExpr); !ok {
+			return fmt.Errorf("the logql alert query must be a metric query, such as count_over_time")
+		}
+	}
+	return nil
+}
+
+func (r *alertRuleBody) ruleInfo() (*meta2.AlertRuleInfo, error) {
+	interval, err := parseAlertDuration(r.Interval, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid interval: %v", err)
+	}
+	forDuration, err := parseAlertDuration(r.For, 0)
+	if err != nil {
+		return nil, fmt.Errorf("invalid for: %v", err)
+	}
+	rule := &meta2.AlertRuleInfo{
+		Name:            r.Name,
+		Type:            r.Type,
+		Database:        r.Database,
+		RetentionPolicy: r.RetentionPolicy,
+		LogStream:       r.LogStream,
+		Query:           r.Query,
+		Condition:       r.Condition,
+		Interval:        interval,
+		For:             forDuration,
+		Labels:          r.Labels,
+		Annotations:     r.Annotations,
+		Receivers:       r.Receivers,
+	}
+	if err = rule.Validate(); err != nil {
+		return nil, err
+	}
+	if err = validateAlertQuery(rule); err != nil {
+		return nil, fmt.Errorf("invalid %s query: %v", rule.Type, err)
+	}
+	return rule, nil
+}
+
+func (r *alertReceiverBody) receiverInfo() (*meta2.AlertReceiverInfo, error) {
+	if r.Name == "" {
+		return nil, fmt.Errorf("alert receiver name is required")
+	}
+	u, err := url.Parse(r.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("alert receiver url %q must be a http or https url", r.URL)
+	}
+	receiver := &meta2.AlertReceiverInfo{Name: r.Name, URL: r.URL, GroupBy: r.GroupBy}
+	if receiver.GroupWait, err = parseAlertDuration(r.GroupWait, alertDefaultGroupWait); err != nil {
+		return nil, fmt.Errorf("invalid groupWait: %v", err)
+	}
+	if receiver.GroupInterval, err = parseAlertDuration(r.GroupInterval, alertDefaultGroupInterval); err != nil {
+		return nil, fmt.Errorf("invalid groupInterval: %v", err)
+	}
+	if receiver.RepeatInterval, err = parseAlertDuration(r.RepeatInterval, alertDefaultRepeatInterval); err != nil {
+		return nil, fmt.Errorf("invalid repeatInterval: %v", err)
+	}
+	return receiver, nil
+}
+
+func (s *alertSilenceBody) silenceInfo(now time.Time) (*meta2.AlertSilenceInfo, error) {
+	silence := &meta2.AlertSilenceInfo{
+		ID:        s.ID,
+		StartsAt:  s.StartsAt,
+		EndsAt:    s.EndsAt,
+		CreatedBy: s.CreatedBy,
+		Comment:   s.Comment,
+	}
+	if silence.ID == "" {
+		silence.ID = uuid.TimeUUID().String()
+	}
+	if silence.StartsAt.IsZero() {
+		silence.StartsAt = now
+	}
+	for _, m := range s.Matchers {
+		silence.Matchers = append(silence.Matchers, meta2.AlertMatcher{Name: m.Name, Value: m.Value, IsRegex: m.IsRegex})
+	}
+	if err := silence.Validate(); err != nil {
+		return nil, err
+	}
+	return silence, nil
+}
+
+func alertSilenceStatus(silence *meta2.AlertSilenceInfo, now time.Time) string {
+	switch {
+	case now.Before(silence.StartsAt):
+		return "pending"
+	case silence.Active(now):
+		return "active"
+	default:
+		return "expired"
+	}
+}
+
+// alertAPIError converts the error of ts-meta, which may have been transferred as a message.
+func alertAPIError(err error) *apiError {
+	switch err.Error() {
+	case meta2.ErrAlertRuleNotFound.Error(), meta2.ErrAlertSilenceNotFound.Error():
+		return &apiError{errorNotFound, err}
+	case meta2.ErrAlertReceiverNotFound.Error(), meta2.ErrAlertReceiverInUse.Error():
+		return &apiError{errorBadData, err}
+	}
+	return &apiError{errorInternal, err}
+}
+
+// authorizeAlerting returns true if the user is allowed to change the alerting configuration, which requires admin
+// privilege because the rules are evaluated with the privilege of the alerting service.
+func (h *Handler) authorizeAlerting(w http.ResponseWriter, user meta2.User) bool {
+	if !h.Config.AuthEnabled {
+		return true
+	}
+	if user == nil {
+		respondError(w, &apiError{errorForbidden, fmt.Errorf("error authorizing query: create admin user first or disable authentication")})
+		return false
+	}
+	if !user.AuthorizeUnrestricted() {
+		respondError(w, &apiError{errorForbidden, fmt.Errorf("error authorizing, requires admin privilege only")})
+		return false
+	}
+	return true
+}
+
+func (h *Handler) readAlertBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	b, err := io.ReadAll(io.LimitReader(r.Body, maxAlertRequestSize))
+	if err != nil {
+		respondError(w, &apiError{errorBadData, err})
+		return false
+	}
+	if err = json2.Unmarshal(b, v); err != nil {
+		respondError(w, &apiError{errorBadData, fmt.Errorf("invalid request body: %v", err)})
+		return false
+	}
+	return true
+}
+
+func (h *Handler) writeAlertResponse(w http.ResponseWriter, r *http.Request, data interface{}) {
+	rw, ok := w.(ResponseWriter)
+	if !ok {
+		rw = NewResponseWriter(w, r)
+	}
+	if _, err := rw.WritePromResponse(&promql2influxql.PromResponse{Status: string(StatusSuccess), Data: data}); err != nil {
+		h.Logger.Error("write alerting response failed", zap.Error(err))
+	}
+}
+
+func sortedAlertRules(rules map[string]*meta2.AlertRuleInfo) []*meta2.AlertRuleInfo {
+	list := make([]*meta2.AlertRuleInfo, 0, len(rules))
+	for _, rule := range rules {
+		list = append(list, rule)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
+
+// serveAlerts returns the pending and firing alerts like the alerts api of prometheus.
+func (h *Handler) serveAlerts(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	alerts := make([]*alertBody, 0)
+	for _, rule := range sortedAlertRules(h.MetaClient.AlertRules()) {
+		for _, alert := range rule.Alerts {
+			if alert.State == meta2.AlertPending || alert.State == meta2.AlertFiring {
+				alerts = append(alerts, newAlertBody(rule, alert))
+			}
+		}
+	}
+	h.writeAlertResponse(w, r, map[string]interface{}{"alerts": alerts})
+}
+
+func (h *Handler) serveAlertRules(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	rules := sortedAlertRules(h.MetaClient.AlertRules())
+	data := make([]*alertRuleStatus, len(rules))
+	for i := range rules {
+		data[i] = newAlertRuleStatus(rules[i])
+	}
+	h.writeAlertResponse(w, r, map[string]interface{}{"rules": data})
+}
+
+func (h *Handler) serveAlertRule(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	rule, ok := h.MetaClient.AlertRules()[mux.Vars(r)[AlertRuleName]]
+	if !ok {
+		respondError(w, &apiError{errorNotFound, meta2.ErrAlertRuleNotFound})
+		return
+	}
+	h.writeAlertResponse(w, r, newAlertRuleStatus(rule))
+}
+
+// serveCreateAlertRule creates the alert rule or replaces the definition of the existing one with the same name.
+func (h *Handler) serveCreateAlertRule(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeAlerting(w, user) {
+		return
+	}
+	var body alertRuleBody
+	if !h.readAlertBody(w, r, &body) {
+		return
+	}
+	rule, err := body.ruleInfo()
+	if err != nil {
+		respondError(w, &apiError{errorBadData, err})
+		return
+	}
+	if err = h.MetaClient.CreateAlertRule(rule); err != nil {
+		respondError(w, alertAPIError(err))
+		return
+	}
+	h.Logger.Info("create alert rule", zap.String("name", rule.Name), zap.String("type", rule.Type))
+	h.writeAlertResponse(w, r, newAlertRuleBody(rule))
+}
+
+func (h *Handler) serveDropAlertRule(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeAlerting(w, user) {
+		return
+	}
+	name := mux.Vars(r)[AlertRuleName]
+	if err := h.MetaClient.DropAlertRule(name); err != nil {
+		respondError(w, alertAPIError(err))
+		return
+	}
+	h.Logger.Info("drop alert rule", zap.String("name", name))
+	h.writeAlertResponse(w, r, nil)
+}
+
+func (h *Handler) serveAlertReceivers(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	receivers := h.MetaClient.AlertReceivers()
+	data := make([]*alertReceiverBody, 0, len(receivers))
+	for _, receiver := range receivers {
+		data = append(data, &alertReceiverBody{
+			Name:           receiver.Name,
+			URL:            receiver.URL,
+			GroupBy:        receiver.GroupBy,
+			GroupWait:      influxql.FormatDuration(receiver.GroupWait),
+			GroupInterval:  influxql.FormatDuration(receiver.GroupInterval),
+			RepeatInterval: influxql.FormatDuration(receiver.RepeatInterval),
+		})
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].Name < data[j].Name
+	})
+	h.writeAlertResponse(w, r, map[string]interface{}{"receivers": data})
+}
+
+// serveCreateAlertReceiver creates the receiver or replaces the existing one with the same name.
+func (h *Handler) serveCreateAlertReceiver(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeAlerting(w, user) {
+		return
+	}
+	var body alertReceiverBody
+	if !h.readAlertBody(w, r, &body) {
+		return
+	}
+	receiver, err := body.receiverInfo()
+	if err != nil {
+		respondError(w, &apiError{errorBadData, err})
+		return
+	}
+	if err = h.MetaClient.CreateAlertReceiver(receiver); err != nil {
+		respondError(w, alertAPIError(err))
+		return
+	}
+	h.Logger.Info("create alert receiver", zap.String("name", receiver.Name))
+	h.writeAlertResponse(w, r, nil)
+}
+
+func (h *Handler) serveDropAlertReceiver(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeAlerting(w, user) {
+		return
+	}
+	name := mux.Vars(r)[AlertReceiverName]
+	if err := h.MetaClient.DropAlertReceiver(name); err != nil {
+		respondError(w, alertAPIError(err))
+		return
+	}
+	h.Logger.Info("drop alert receiver", zap.String("name", name))
+	h.writeAlertResponse(w, r, nil)
+}
+
+func (h *Handler) serveAlertSilences(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	now := time.Now()
+	silences := h.MetaClient.AlertSilences()
+	data := make([]*alertSilenceBody, 0, len(silences))
+	for _, silence := range silences {
+		body := &alertSilenceBody{
+			ID:        silence.ID,
+			Matchers:  make([]alertMatcherBody, len(silence.Matchers)),
+			StartsAt:  silence.StartsAt,
+			EndsAt:    silence.EndsAt,
+			CreatedBy: silence.CreatedBy,
+			Comment:   silence.Comment,
+			Status:    alertSilenceStatus(silence, now),
+		}
+		for i, m := range silence.Matchers {
+			body.Matchers[i] = alertMatcherBody{Name: m.Name, Value: m.Value, IsRegex: m.IsRegex}
+		}
+		data = append(data, body)
+	}
+	sort.Slice(data, func(i, j int) bool {
+		return data[i].StartsAt.Before(data[j].StartsAt) || (data[i].StartsAt.Equal(data[j].StartsAt) && data[i].ID < data[j].ID)
+	})
+	h.writeAlertResponse(w, r, map[string]interface{}{"silences": data})
+}
+
+// serveCreateAlertSilence creates the silence, or replaces the existing one if the id is specified.
+func (h *Handler) serveCreateAlertSilence(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeAlerting(w, user) {
+		return
+	}
+	var body alertSilenceBody
+	if !h.readAlertBody(w, r, &body) {
+		return
+	}
+	if body.CreatedBy == "" && user != nil {
+		body.CreatedBy = user.ID()
+	}
+	silence, err := body.silenceInfo(time.Now())
+	if err != nil {
+		respondError(w, &apiError{errorBadData, err})
+		return
+	}
+	if err = h.MetaClient.CreateAlertSilence(silence); err != nil {
+		respondError(w, alertAPIError(err))
+		return
+	}
+	h.Logger.Info("create alert silence", zap.String("id", silence.ID), zap.String("createdBy", silence.CreatedBy))
+	h.writeAlertResponse(w, r, map[string]string{"silenceID": silence.ID})
+}
+
+func (h *Handler) serveDropAlertSilence(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	if !h.authorizeAlerting(w, user) {
+		return
+	}
+	id := mux.Vars(r)[AlertSilenceID]
+	if err := h.MetaClient.DropAlertSilence(id); err != nil {
+		respondError(w, alertAPIError(err))
+		return
+	}
+	h.Logger.Info("drop alert silence", zap.String("id", id))
+	h.writeAlertResponse(w, r, nil)
+}
@@ -0,0 +1,232 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type mockAlertMetaClient struct {
+	*metaclient.Client
+	data *meta2.Data
+}
+
+func (c *mockAlertMetaClient) CreateAlertRule(rule *meta2.AlertRuleInfo) error {
+	return c.data.CreateAlertRule(rule)
+}
+
+func (c *mockAlertMetaClient) DropAlertRule(name string) error {
+	return c.data.DropAlertRule(name)
+}
+
+func (c *mockAlertMetaClient) AlertRules() map[string]*meta2.AlertRuleInfo {
+	return c.data.CloneAlertRules()
+}
+
+func (c *mockAlertMetaClient) CreateAlertReceiver(receiver *meta2.AlertReceiverInfo) error {
+	return c.data.CreateAlertReceiver(receiver)
+}
+
+func (c *mockAlertMetaClient) DropAlertReceiver(name string) error {
+	return c.data.DropAlertReceiver(name)
+}
+
+func (c *mockAlertMetaClient) AlertReceivers() map[string]*meta2.AlertReceiverInfo {
+	return c.data.CloneAlertReceivers()
+}
+
+func (c *mockAlertMetaClient) CreateAlertSilence(silence *meta2.AlertSilenceInfo) error {
+	return c.data.CreateAlertSilence(silence)
+}
+
+func (c *mockAlertMetaClient) DropAlertSilence(id string) error {
+	return c.data.DropAlertSilence(id)
+}
+
+func (c *mockAlertMetaClient) AlertSilences() map[string]*meta2.AlertSilenceInfo {
+	return c.data.CloneAlertSilences()
+}
+
+type alertTestResponse struct {
+	Status    string          `json:"status"`
+	ErrorType string          `json:"errorType"`
+	Error     string          `json:"error"`
+	Data      json.RawMessage `json:"data"`
+}
+
+func serveAlertRequest(t *testing.T, fn func(http.ResponseWriter, *http.Request, meta2.User), user meta2.User,
+	method, target, body string, vars map[string]string) (int, *alertTestResponse) {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	if vars != nil {
+		req = mux.SetURLVars(req, vars)
+	}
+	w := httptest.NewRecorder()
+	fn(w, req, user)
+	resp := &alertTestResponse{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp), w.Body.String())
+	return w.Code, resp
+}
+
+func TestHandler_AlertRules(t *testing.T) {
+	h := &Handler{
+		Config:     &config.Config{},
+		Logger:     logger.NewLogger(errno.ModuleHTTP),
+		MetaClient: &mockAlertMetaClient{data: &meta2.Data{}},
+	}
+
+	code, resp := serveAlertRequest(t, h.serveCreateAlertReceiver, nil, http.MethodPost, "/api/v1/alerting/receivers",
+		`{"name":"ops","url":"http://127.0.0.1:9093/hook","groupBy":["alertname"],"groupWait":"10s"}`, nil)
+	require.Equal(t, http.StatusOK, code, resp.Error)
+
+	code, resp = serveAlertRequest(t, h.serveCreateAlertRule, nil, http.MethodPost, "/api/v1/alerting/rules",
+		`{"name":"cpu","type":"influxql","database":"db0","query":"SELECT last(usage) FROM cpu GROUP BY host","condition":"> 90",
+		"for":"5m","labels":{"severity":"critical"},"annotations":{"summary":"{{ $labels.host }} is busy"},"receivers":["ops"]}`, nil)
+	require.Equal(t, http.StatusOK, code, resp.Error)
+
+	for _, body := range []string{
+		`{"name":"cpu","type":"influxql","database":"db0","query":"SELEC last(usage) FROM cpu"}`,
+		`{"name":"cpu","type":"influxql","database":"db0","query":"SHOW DATABASES"}`,
+		`{"name":"up","type":"promql","database":"prom","query":"up ==="}`,
+		`{"name":"logs","type":"logql","database":"repo","logStream":"app","query":"{app=\"web\"}"}`,
+		`{"name":"cpu","type":"influxql","database":"db0","query":"SELECT last(usage) FROM cpu","for":"5 minutes"}`,
+		`{"name":"cpu","type":"influxql","database":"db0","query":"SELECT last(usage) FROM cpu","condition":"high"}`,
+		`{"name":"cpu"`,
+	} {
+		code, resp = serveAlertRequest(t, h.serveCreateAlertRule, nil, http.MethodPost, "/api/v1/alerting/rules", body, nil)
+		require.Equal(t, http.StatusBadRequest, code, body)
+		require.Equal(t, string(errorBadData), resp.ErrorType)
+	}
+	code, _ = serveAlertRequest(t, h.serveCreateAlertRule, nil, http.MethodPost, "/api/v1/alerting/rules",
+		`{"name":"up","type":"promql","database":"prom","query":"up == 0","receivers":["dev"]}`, nil)
+	require.Equal(t, http.StatusBadRequest, code)
+
+	now := time.Now().Truncate(time.Second)
+	h.MetaClient.(*mockAlertMetaClient).data.ReportAlertStates("sql1", now.UnixNano(), []*meta2.AlertRuleState{{Name: "cpu",
+		LastEvalTime: now, Alerts: []*meta2.AlertInfo{
+			{Labels: map[string]string{"alertname": "cpu", "host": "a"}, State: meta2.AlertFiring, ActiveAt: now, FiredAt: now, Value: 95},
+			{Labels: map[string]string{"alertname": "cpu", "host": "b"}, State: meta2.AlertResolved, ActiveAt: now, ResolvedAt: now, Value: 92},
+		}}})
+
+	code, resp = serveAlertRequest(t, h.serveAlertRule, nil, http.MethodGet, "/api/v1/alerting/rules/cpu", "", map[string]string{AlertRuleName: "cpu"})
+	require.Equal(t, http.StatusOK, code)
+	rule := &alertRuleStatus{}
+	require.NoError(t, json.Unmarshal(resp.Data, rule))
+	require.Equal(t, "5m", rule.For)
+	require.Equal(t, "ok", rule.Health)
+	require.Equal(t, "sql1", rule.Evaluator)
+	require.Len(t, rule.Alerts, 2)
+	require.Equal(t, "a is busy", rule.Alerts[0].Annotations["summary"])
+
+	code, resp = serveAlertRequest(t, h.serveAlerts, nil, http.MethodGet, "/api/v1/alerts", "", nil)
+	require.Equal(t, http.StatusOK, code)
+	var alerts struct {
+		Alerts []*alertBody `json:"alerts"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &alerts))
+	require.Len(t, alerts.Alerts, 1)
+	require.Equal(t, "firing", alerts.Alerts[0].State)
+	require.Equal(t, "9.5e+01", alerts.Alerts[0].Value)
+
+	code, resp = serveAlertRequest(t, h.serveAlertRules, nil, http.MethodGet, "/api/v1/alerting/rules", "", nil)
+	require.Equal(t, http.StatusOK, code)
+	require.Contains(t, string(resp.Data), `"name":"cpu"`)
+
+	code, resp = serveAlertRequest(t, h.serveDropAlertReceiver, nil, http.MethodDelete, "/api/v1/alerting/receivers/ops", "",
+		map[string]string{AlertReceiverName: "ops"})
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Equal(t, meta2.ErrAlertReceiverInUse.Error(), resp.Error)
+
+	code, _ = serveAlertRequest(t, h.serveDropAlertRule, nil, http.MethodDelete, "/api/v1/alerting/rules/cpu", "", map[string]string{AlertRuleName: "cpu"})
+	require.Equal(t, http.StatusOK, code)
+	code, _ = serveAlertRequest(t, h.serveDropAlertRule, nil, http.MethodDelete, "/api/v1/alerting/rules/cpu", "", map[string]string{AlertRuleName: "cpu"})
+	require.Equal(t, http.StatusNotFound, code)
+	code, _ = serveAlertRequest(t, h.serveAlertRule, nil, http.MethodGet, "/api/v1/alerting/rules/cpu", "", map[string]string{AlertRuleName: "cpu"})
+	require.Equal(t, http.StatusNotFound, code)
+
+	code, resp = serveAlertRequest(t, h.serveAlertReceivers, nil, http.MethodGet, "/api/v1/alerting/receivers", "", nil)
+	require.Equal(t, http.StatusOK, code)
+	require.JSONEq(t, `{"receivers":[{"name":"ops","url":"http://127.0.0.1:9093/hook","groupBy":["alertname"],"groupWait":"10s",
+		"groupInterval":"5m","repeatInterval":"4h"}]}`, string(resp.Data))
+	code, _ = serveAlertRequest(t, h.serveDropAlertReceiver, nil, http.MethodDelete, "/api/v1/alerting/receivers/ops", "",
+		map[string]string{AlertReceiverName: "ops"})
+	require.Equal(t, http.StatusOK, code)
+	code, _ = serveAlertRequest(t, h.serveCreateAlertReceiver, nil, http.MethodPost, "/api/v1/alerting/receivers",
+		`{"name":"ops","url":"127.0.0.1:9093"}`, nil)
+	require.Equal(t, http.StatusBadRequest, code)
+}
+
+func TestHandler_AlertSilences(t *testing.T) {
+	h := &Handler{
+		Config:     &config.Config{},
+		Logger:     logger.NewLogger(errno.ModuleHTTP),
+		MetaClient: &mockAlertMetaClient{data: &meta2.Data{}},
+	}
+	h.Config.AuthEnabled = true
+	admin := &meta2.UserInfo{Name: "admin", Admin: true}
+
+	body := `{"matchers":[{"name":"host","value":"server0[12]","isRegex":true}],"endsAt":"` +
+		time.Now().Add(time.Hour).UTC().Format(time.RFC3339) + `","comment":"maintenance"}`
+	code, _ := serveAlertRequest(t, h.serveCreateAlertSilence, nil, http.MethodPost, "/api/v1/alerting/silences", body, nil)
+	require.Equal(t, http.StatusForbidden, code)
+	code, _ = serveAlertRequest(t, h.serveCreateAlertSilence, &meta2.UserInfo{Name: "reader"}, http.MethodPost, "/api/v1/alerting/silences", body, nil)
+	require.Equal(t, http.StatusForbidden, code)
+
+	code, resp := serveAlertRequest(t, h.serveCreateAlertSilence, admin, http.MethodPost, "/api/v1/alerting/silences", body, nil)
+	require.Equal(t, http.StatusOK, code, resp.Error)
+	var created struct {
+		SilenceID string `json:"silenceID"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &created))
+	require.NotEmpty(t, created.SilenceID)
+
+	code, resp = serveAlertRequest(t, h.serveAlertSilences, admin, http.MethodGet, "/api/v1/alerting/silences", "", nil)
+	require.Equal(t, http.StatusOK, code)
+	var silences struct {
+		Silences []*alertSilenceBody `json:"silences"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Data, &silences))
+	require.Len(t, silences.Silences, 1)
+	require.Equal(t, "active", silences.Silences[0].Status)
+	require.Equal(t, "admin", silences.Silences[0].CreatedBy)
+
+	for _, invalid := range []string{
+		`{"matchers":[],"endsAt":"2099-01-01T00:00:00Z"}`,
+		`{"matchers":[{"name":"host","value":"("}],"startsAt":"2099-01-01T00:00:00Z","endsAt":"2098-01-01T00:00:00Z"}`,
+		`{"matchers":[{"name":"host","value":"(","isRegex":true}],"endsAt":"2099-01-01T00:00:00Z"}`,
+	} {
+		code, _ = serveAlertRequest(t, h.serveCreateAlertSilence, admin, http.MethodPost, "/api/v1/alerting/silences", invalid, nil)
+		require.Equal(t, http.StatusBadRequest, code, invalid)
+	}
+
+	code, _ = serveAlertRequest(t, h.serveDropAlertSilence, admin, http.MethodDelete, "/api/v1/alerting/silences/"+created.SilenceID, "",
+		map[string]string{AlertSilenceID: created.SilenceID})
+	require.Equal(t, http.StatusOK, code)
+	code, _ = serveAlertRequest(t, h.serveDropAlertSilence, admin, http.MethodDelete, "/api/v1/alerting/silences/"+created.SilenceID, "",
+		map[string]string{AlertSilenceID: created.SilenceID})
+	require.Equal(t, http.StatusNotFound, code)
+}
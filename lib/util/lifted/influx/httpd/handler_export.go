@@ -0,0 +1,391 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+)
+
+const (
+	ParquetContentType = "application/vnd.apache.parquet"
+
+	// ParquetExportObsAkEnv and ParquetExportObsSkEnv hold the credentials of an obs parquet-export-dir,
+	// so that they are never written in the config. The sk is encrypted like the sk of the obs options.
+	ParquetExportObsAkEnv = "OPENGEMINI_PARQUET_EXPORT_OBS_AK"
+	ParquetExportObsSkEnv = "OPENGEMINI_PARQUET_EXPORT_OBS_SK"
+
+	parquetExportTmpSuffix = ".tmp"
+)
+
+// ParquetExportResponse is returned when the result is exported into a file under the parquet-export-dir.
+type ParquetExportResponse struct {
+	Path string `json:"path"`
+	Rows int64  `json:"rows"`
+}
+
+// parseParquetExportOptions reads the row group size and the compression of the export request.
+func parseParquetExportOptions(r *http.Request) (parquet.ExportOptions, error) {
+	opt := parquet.NewExportOptions()
+	if s := r.FormValue("row_group_size"); s != "" {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || n <= 0 {
+			return opt, fmt.Errorf("invalid row_group_size %q", s)
+		}
+		opt.MaxRowGroupLen = n
+	}
+	if s := r.FormValue("compression"); s != "" {
+		c, err := parquet.ParseCompression(s)
+		if err != nil {
+			return opt, err
+		}
+		opt.Compression = c
+	}
+	return opt, nil
+}
+
+// parquetExportPath resolves the target of an export under the parquet-export-dir,
+// which can be a local directory or an object storage path.
+// The files of each user are kept in a directory named after the user, so that no user
+// can overwrite the exports of another one.
+// It returns the target relative to the export dir, and the full path of the file.
+func (h *Handler) parquetExportPath(user meta2.User, target string) (string, string, error) {
+	dir := h.Config.ParquetExportDir
+	if dir == "" {
+		return "", "", errors.New("export to path is disabled, parquet-export-dir is not configured")
+	}
+	// the target can never escape from the export dir
+	target = strings.TrimPrefix(path.Clean("/"+target), "/")
+	if target == "" {
+		return "", "", errors.New("invalid export path")
+	}
+	if !strings.HasSuffix(target, ".parquet") {
+		target += ".parquet"
+	}
+	if user != nil {
+		target = url.PathEscape(user.ID()) + "/" + target
+	}
+
+	dir = strings.TrimSuffix(dir, "/")
+	if obsDir, ok := strings.CutPrefix(dir, fileops.ObsPrefix); ok {
+		// obs://endpoint/bucket/path, the credentials are taken from the environment
+		endpoint, bucketPath, _ := strings.Cut(obsDir, "/")
+		bucket, basePath, _ := strings.Cut(bucketPath, "/")
+		ak, sk := os.Getenv(ParquetExportObsAkEnv), os.Getenv(ParquetExportObsSkEnv)
+		if ak == "" || sk == "" {
+			return "", "", fmt.Errorf("export to obs requires the credentials in %s and %s", ParquetExportObsAkEnv, ParquetExportObsSkEnv)
+		}
+		return target, fileops.EncodeObsPath(endpoint, bucket, path.Join(basePath, target), ak, fileops.DecryptObsSk(sk)), nil
+	}
+	return target, dir + "/" + target, nil
+}
+
+// parquetExportSink discards the writes once the export is aborted, so that the writer can be closed
+// to release its buffers without finishing the file with a footer.
+type parquetExportSink struct {
+	w       io.Writer
+	aborted bool
+}
+
+func (s *parquetExportSink) Write(p []byte) (int, error) {
+	if s.aborted {
+		return len(p), nil
+	}
+	return s.w.Write(p)
+}
+
+func (s *parquetExportSink) Close() error {
+	if c, ok := s.w.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// parquetExporter encodes the series of query results into a parquet file.
+// The schema of the file is built from the first series with values, the file is opened with it.
+type parquetExporter struct {
+	sink    *parquetExportSink
+	opt     parquet.ExportOptions
+	builder *executor.RowRecordBuilder
+	writer  *pqarrow.FileWriter
+	rows    int64
+
+	// onOpen is called right before the first bytes are written to the sink
+	onOpen func()
+}
+
+func newParquetExporter(sink io.Writer, opt parquet.ExportOptions) *parquetExporter {
+	return &parquetExporter{
+		sink:    &parquetExportSink{w: sink},
+		opt:     opt,
+		builder: executor.NewRowRecordBuilder(memory.NewGoAllocator()),
+	}
+}
+
+func (e *parquetExporter) started() bool {
+	return e.writer != nil
+}
+
+func (e *parquetExporter) open(schema *arrow.Schema) error {
+	if e.onOpen != nil {
+		e.onOpen()
+	}
+	w, err := parquet.NewExportWriter(e.sink, schema, e.opt)
+	if err != nil {
+		return err
+	}
+	e.writer = w
+	return nil
+}
+
+func (e *parquetExporter) Write(row *models.Row) error {
+	if err := e.builder.Append(row); err != nil {
+		return err
+	}
+	rec := e.builder.NewRecord()
+	if rec == nil {
+		return nil
+	}
+	defer rec.Release()
+	if !e.started() {
+		if err := e.open(e.builder.Schema()); err != nil {
+			return err
+		}
+	}
+	e.rows += rec.NumRows()
+	return e.writer.WriteBuffered(rec)
+}
+
+// Close flushes the buffered row group and writes the file footer.
+// An empty result is exported as a file without any column.
+func (e *parquetExporter) Close() error {
+	defer e.builder.Release()
+	if !e.started() {
+		if err := e.open(arrow.NewSchema(nil, nil)); err != nil {
+			return err
+		}
+	}
+	return e.writer.Close()
+}
+
+// abort releases the builder and the writer after an error, the file is left without footer.
+func (e *parquetExporter) abort() {
+	e.builder.Release()
+	if e.started() {
+		e.sink.aborted = true
+		_ = e.writer.Close()
+	}
+}
+
+// export writes all the results. It returns at the first error, the query is aborted by the caller then.
+func (e *parquetExporter) export(results <-chan *query.Result) error {
+	for r := range results {
+		if r == nil {
+			continue
+		}
+		if r.Err != nil {
+			e.abort()
+			return r.Err
+		}
+		e.builder.SetColumnTypes(r.ColumnTypes)
+		for _, row := range r.Series {
+			if err := e.Write(row); err != nil {
+				e.abort()
+				return err
+			}
+		}
+	}
+	return e.Close()
+}
+
+// serveExportParquet runs one select statement and exports the result as a parquet file.
+// The file is streamed back in the response, or written under the parquet-export-dir when the path is given.
+func (h *Handler) serveExportParquet(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	handlerStat.QueryRequests.Incr()
+	handlerStat.ActiveQueryRequests.Incr()
+	start := time.Now()
+	defer func() {
+		handlerStat.ActiveQueryRequests.Decr()
+		handlerStat.QueryRequestDuration.AddSinceNano(start)
+	}()
+
+	if syscontrol.DisableReads {
+		h.httpError(w, `disable read!`, http.StatusForbidden)
+		h.Logger.Error("read is forbidden!", zap.Bool("DisableReads", syscontrol.DisableReads))
+		return
+	}
+
+	qr, f, err := h.newQueryReader(r, nil, user)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if f != nil {
+		defer util.MustClose(f)
+	}
+	q, err, code := h.getSqlQuery(r, qr)
+	if err != nil {
+		h.httpError(w, err.Error(), code)
+		return
+	}
+	if len(q.Statements) != 1 {
+		h.httpError(w, "export requires exactly one select statement", http.StatusBadRequest)
+		return
+	}
+	if stmt, ok := q.Statements[0].(*influxql.SelectStatement); !ok || stmt.Target != nil {
+		h.httpError(w, "only select statement without INTO clause can be exported", http.StatusBadRequest)
+		return
+	}
+
+	db := r.FormValue("db")
+	if err = h.checkAuthorization(user, q, db); err != nil {
+		h.httpError(w, "error authorizing query: "+err.Error(), http.StatusForbidden)
+		return
+	}
+
+	opt, err := parseParquetExportOptions(r)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var target, dst string
+	if p := r.FormValue("path"); p != "" {
+		if target, dst, err = h.parquetExportPath(user, p); err != nil {
+			h.httpError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	_, chunkSize, innerChunkSize, err := h.parseChunkSize(r)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	closing := make(chan struct{})
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+		close(closing)
+	}()
+	opts := query.ExecutionOptions{
+		Database:        db,
+		RetentionPolicy: r.FormValue("rp"),
+		ChunkSize:       chunkSize,
+		Chunked:         true,
+		ReadOnly:        true,
+		InnerChunkSize:  innerChunkSize,
+		Quiet:           true,
+		Authorizer:      h.getAuthorizer(user),
+		AbortCh:         closing,
+	}
+	release, err := acquireTenantQuery(user, db, &opts)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusTooManyRequests)
+		return
+	}
+	defer release()
+
+	results := h.QueryExecutor.ExecuteQuery(q, opts, closing, nil)
+	if dst == "" {
+		h.streamParquetExport(w, results, opt)
+		return
+	}
+	h.writeParquetExport(w, results, opt, target, dst)
+}
+
+func (h *Handler) streamParquetExport(w http.ResponseWriter, results <-chan *query.Result, opt parquet.ExportOptions) {
+	e := newParquetExporter(w, opt)
+	e.onOpen = func() {
+		w.Header().Set("Content-Type", ParquetContentType)
+		w.Header().Set("Content-Disposition", `attachment; filename="export.parquet"`)
+		h.writeHeader(w, http.StatusOK)
+	}
+	err := e.export(results)
+	if err == nil {
+		return
+	}
+	h.Logger.Error("export parquet failed", zap.Int64("rows", e.rows), zap.Error(err))
+	if !e.started() {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+	}
+	// the response is already started, the client gets a file without footer which can not be opened
+}
+
+func (h *Handler) writeParquetExport(w http.ResponseWriter, results <-chan *query.Result, opt parquet.ExportOptions, target, dst string) {
+	// the file is written under a temporary name, it is renamed only when the export succeeds
+	tmp := dst + parquetExportTmpSuffix
+	// the errors of the file system carry the full path, which contains the obs credentials,
+	// so only the target is returned to the client
+	// path.Dir can not be used, it cleans the obs:// prefix into obs:/
+	if err := fileops.MkdirAll(dst[:strings.LastIndexByte(dst, '/')], 0750); err != nil {
+		h.Logger.Error("create parquet export dir failed", zap.String("path", target), zap.Error(err))
+		h.httpError(w, fmt.Sprintf("export parquet to %s failed", target), http.StatusInternalServerError)
+		return
+	}
+	fd, err := fileops.OpenFile(tmp, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0600)
+	if err != nil {
+		h.Logger.Error("open parquet export file failed", zap.String("path", target), zap.Error(err))
+		h.httpError(w, fmt.Sprintf("export parquet to %s failed", target), http.StatusInternalServerError)
+		return
+	}
+
+	// the file is closed with the parquet writer, it is closed here too in case the export fails before the writer is opened
+	e := newParquetExporter(fd, opt)
+	if err = e.export(results); err != nil {
+		_ = fd.Close()
+		_ = fileops.Remove(tmp)
+		h.Logger.Error("export parquet failed", zap.String("path", target), zap.Error(err))
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if err = fileops.RenameFile(tmp, dst); err != nil {
+		_ = fileops.Remove(tmp)
+		h.Logger.Error("rename parquet export file failed", zap.String("path", target), zap.Error(err))
+		h.httpError(w, fmt.Sprintf("export parquet to %s failed", target), http.StatusInternalServerError)
+		return
+	}
+
+	h.Logger.Info("export parquet", zap.String("path", target), zap.Int64("rows", e.rows))
+	w.Header().Set("Content-Type", "application/json")
+	h.writeHeader(w, http.StatusOK)
+	b, _ := json2.Marshal(ParquetExportResponse{Path: target, Rows: e.rows})
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,305 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/apache/arrow/go/v13/parquet/file"
+	"github.com/apache/arrow/go/v13/parquet/pqarrow"
+	"github.com/influxdata/influxdb/models"
+	config2 "github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/cpu"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/fileops"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/parquet"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/validation"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExportStatementExecutor struct {
+	series models.Rows
+	err    error
+}
+
+func (e *mockExportStatementExecutor) ExecuteStatement(stmt influxql.Statement, ctx *query.ExecutionContext, seq int) error {
+	if e.err != nil {
+		return e.err
+	}
+	for _, row := range e.series {
+		if err := ctx.Send(&query.Result{Series: models.Rows{row}}, seq, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *mockExportStatementExecutor) Statistics(buffer []byte) ([]byte, error) {
+	return buffer, nil
+}
+
+type mockExportRegister struct{}
+
+func (r *mockExportRegister) RetryRegisterQueryIDOffset(host string) (uint64, error) {
+	return 0, nil
+}
+
+func newExportHandler(executor query.StatementExecutor, dir string) *Handler {
+	e := query.NewExecutor(cpu.GetCpuNum())
+	e.StatementExecutor = executor
+	e.TaskManager.Register = &mockExportRegister{}
+	return &Handler{
+		Logger:        logger.NewLogger(errno.ModuleHTTP),
+		Config:        &config.Config{ParquetExportDir: dir},
+		QueryExecutor: e,
+	}
+}
+
+func newExportRequest(params url.Values) *http.Request {
+	return httptest.NewRequest(http.MethodGet, "/export/parquet?"+params.Encode(), nil)
+}
+
+func readParquetExport(t *testing.T, b []byte) arrow.Table {
+	pf, err := file.NewParquetReader(bytes.NewReader(b))
+	require.NoError(t, err)
+	reader, err := pqarrow.NewFileReader(pf, pqarrow.ArrowReadProperties{}, memory.NewGoAllocator())
+	require.NoError(t, err)
+	tbl, err := reader.ReadTable(context.Background())
+	require.NoError(t, err)
+	return tbl
+}
+
+func exportSeries() models.Rows {
+	t1 := time.Unix(1, 0).UTC()
+	t2 := time.Unix(2, 0).UTC()
+	return models.Rows{
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "a"},
+			Columns: []string{"time", "value", "status"},
+			Values:  [][]interface{}{{t1, 1.5, "ok"}, {t2, 2.5, "failed"}},
+		},
+		{
+			Name:    "cpu",
+			Tags:    map[string]string{"host": "b"},
+			Columns: []string{"time", "value", "status"},
+			Values:  [][]interface{}{{t1, 3.5, nil}},
+		},
+	}
+}
+
+func TestHandler_ServeExportParquet(t *testing.T) {
+	validation.InitOverrides(config2.NewLimits(), nil)
+	params := url.Values{"db": {"db0"}, "q": {"select value, status from cpu group by host"}}
+
+	t.Run("stream", func(t *testing.T) {
+		h := newExportHandler(&mockExportStatementExecutor{series: exportSeries()}, "")
+		w := httptest.NewRecorder()
+		h.serveExportParquet(w, newExportRequest(params), nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, ParquetContentType, w.Header().Get("Content-Type"))
+
+		tbl := readParquetExport(t, w.Body.Bytes())
+		defer tbl.Release()
+		require.Equal(t, int64(3), tbl.NumRows())
+		names := make([]string, 0, tbl.NumCols())
+		for _, f := range tbl.Schema().Fields() {
+			names = append(names, f.Name)
+		}
+		require.Equal(t, []string{"time", "host", "value", "status"}, names)
+		require.Equal(t, arrow.FixedWidthTypes.Timestamp_ns.ID(), tbl.Schema().Field(0).Type.ID())
+		require.Equal(t, arrow.PrimitiveTypes.Float64, tbl.Schema().Field(2).Type)
+	})
+
+	t.Run("empty result", func(t *testing.T) {
+		h := newExportHandler(&mockExportStatementExecutor{}, "")
+		w := httptest.NewRecorder()
+		h.serveExportParquet(w, newExportRequest(params), nil)
+		require.Equal(t, http.StatusOK, w.Code)
+
+		tbl := readParquetExport(t, w.Body.Bytes())
+		defer tbl.Release()
+		require.Equal(t, int64(0), tbl.NumRows())
+	})
+
+	t.Run("path", func(t *testing.T) {
+		dir := t.TempDir()
+		h := newExportHandler(&mockExportStatementExecutor{series: exportSeries()}, dir)
+		p := url.Values{"db": params["db"], "q": params["q"], "path": {"../../out/cpu"}, "compression": {"snappy"}, "row_group_size": {"1"}}
+		w := httptest.NewRecorder()
+		h.serveExportParquet(w, newExportRequest(p), nil)
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"path":"out/cpu.parquet","rows":3}`, w.Body.String())
+
+		b, err := os.ReadFile(filepath.Join(dir, "out", "cpu.parquet"))
+		require.NoError(t, err)
+		pf, err := file.NewParquetReader(bytes.NewReader(b))
+		require.NoError(t, err)
+		require.Equal(t, 3, pf.NumRowGroups())
+		_, err = os.Stat(filepath.Join(dir, "out", "cpu.parquet"+parquetExportTmpSuffix))
+		require.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("user dir", func(t *testing.T) {
+		dir := t.TempDir()
+		h := newExportHandler(&mockExportStatementExecutor{series: exportSeries()}, dir)
+		p := url.Values{"db": params["db"], "q": params["q"], "path": {"cpu"}}
+		for _, name := range []string{"alice", "bob"} {
+			w := httptest.NewRecorder()
+			h.serveExportParquet(w, newExportRequest(p), &meta2.UserInfo{Name: name})
+			require.Equal(t, http.StatusOK, w.Code)
+			require.JSONEq(t, `{"path":"`+name+`/cpu.parquet","rows":3}`, w.Body.String())
+		}
+		for _, name := range []string{"alice", "bob"} {
+			_, err := os.Stat(filepath.Join(dir, name, "cpu.parquet"))
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("obs", func(t *testing.T) {
+		fs := &obsExportFS{VFS: fileops.GetFs(fileops.Local), root: t.TempDir()}
+		patches := gomonkey.ApplyFunc(fileops.GetFs, func(fileops.FsType) fileops.VFS {
+			return fs
+		})
+		defer patches.Reset()
+
+		h := newExportHandler(&mockExportStatementExecutor{series: exportSeries()}, "obs://endpoint/bucket/export/")
+		p := url.Values{"db": params["db"], "q": params["q"], "path": {"cpu"}}
+
+		// the credentials are taken from the environment
+		w := httptest.NewRecorder()
+		h.serveExportParquet(w, newExportRequest(p), &meta2.UserInfo{Name: "alice"})
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), ParquetExportObsAkEnv)
+
+		t.Setenv(ParquetExportObsAkEnv, "mock_ak")
+		t.Setenv(ParquetExportObsSkEnv, "mock_sk")
+		w = httptest.NewRecorder()
+		h.serveExportParquet(w, newExportRequest(p), &meta2.UserInfo{Name: "alice"})
+		require.Equal(t, http.StatusOK, w.Code)
+		require.JSONEq(t, `{"path":"alice/cpu.parquet","rows":3}`, w.Body.String())
+		require.Contains(t, fs.paths, "obs://endpoint/mock_ak/mock_sk/bucket/export/alice/cpu.parquet")
+		_, err := os.Stat(filepath.Join(fs.root, "endpoint/mock_ak/mock_sk/bucket/export/alice/cpu.parquet"))
+		require.NoError(t, err)
+
+		// the obs credentials are never returned to the client
+		fs.err = errors.New("open obs://endpoint/mock_ak/mock_sk/bucket/export/alice/cpu.parquet.tmp failed")
+		w = httptest.NewRecorder()
+		h.serveExportParquet(w, newExportRequest(p), &meta2.UserInfo{Name: "alice"})
+		require.Equal(t, http.StatusInternalServerError, w.Code)
+		require.Contains(t, w.Body.String(), "alice/cpu.parquet")
+		require.NotContains(t, w.Body.String(), "mock_sk")
+
+		conf := config.NewConfig()
+		conf.ParquetExportDir = "obs://endpoint/bucket/export/"
+		require.NoError(t, conf.Validate())
+		conf.ParquetExportDir = "obs://endpoint/"
+		require.Error(t, conf.Validate())
+	})
+
+	t.Run("query error", func(t *testing.T) {
+		dir := t.TempDir()
+		h := newExportHandler(&mockExportStatementExecutor{err: errors.New("shard not found")}, dir)
+		p := url.Values{"db": params["db"], "q": params["q"], "path": {"cpu"}}
+		w := httptest.NewRecorder()
+		h.serveExportParquet(w, newExportRequest(p), nil)
+		require.Equal(t, http.StatusBadRequest, w.Code)
+		require.Contains(t, w.Body.String(), "shard not found")
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+	})
+
+	t.Run("aborted stream", func(t *testing.T) {
+		results := make(chan *query.Result, 2)
+		results <- &query.Result{Series: exportSeries()}
+		results <- &query.Result{Err: errors.New("shard not found")}
+		close(results)
+
+		var buf bytes.Buffer
+		e := newParquetExporter(&buf, parquet.NewExportOptions())
+		require.EqualError(t, e.export(results), "shard not found")
+		require.True(t, e.started())
+		// the writer is released without finishing the file, the client can not take it for a complete export
+		_, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+		require.Error(t, err)
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		h := newExportHandler(&mockExportStatementExecutor{}, "")
+		for _, p := range []url.Values{
+			{"db": {"db0"}, "q": {"select value from cpu; select value from mem"}},
+			{"db": {"db0"}, "q": {"select value into cpu2 from cpu"}},
+			{"db": {"db0"}, "q": {"show measurements"}},
+			{"db": {"db0"}, "q": params["q"], "compression": {"lz4"}},
+			{"db": {"db0"}, "q": params["q"], "row_group_size": {"0"}},
+			{"db": {"db0"}, "q": params["q"], "path": {"cpu"}},
+		} {
+			w := httptest.NewRecorder()
+			h.serveExportParquet(w, newExportRequest(p), nil)
+			require.Equal(t, http.StatusBadRequest, w.Code, p.Encode())
+		}
+	})
+}
+
+// obsExportFS keeps the files of the obs paths under a local root.
+type obsExportFS struct {
+	fileops.VFS
+	root  string
+	paths []string
+	err   error
+}
+
+func (fs *obsExportFS) local(p string) string {
+	fs.paths = append(fs.paths, p)
+	return filepath.Join(fs.root, strings.TrimPrefix(p, fileops.ObsPrefix))
+}
+
+func (fs *obsExportFS) OpenFile(name string, flag int, perm os.FileMode, opt ...fileops.FSOption) (fileops.File, error) {
+	if fs.err != nil {
+		return nil, fs.err
+	}
+	return fs.VFS.OpenFile(fs.local(name), flag, perm, opt...)
+}
+
+func (fs *obsExportFS) MkdirAll(path string, perm os.FileMode, opt ...fileops.FSOption) error {
+	return fs.VFS.MkdirAll(fs.local(path), perm, opt...)
+}
+
+func (fs *obsExportFS) RenameFile(oldPath, newPath string, opt ...fileops.FSOption) error {
+	return fs.VFS.RenameFile(fs.local(oldPath), fs.local(newPath), opt...)
+}
+
+func (fs *obsExportFS) Remove(name string, opt ...fileops.FSOption) error {
+	return fs.VFS.Remove(fs.local(name), opt...)
+}
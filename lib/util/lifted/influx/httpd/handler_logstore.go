@@ -46,6 +46,7 @@ import (
 	"github.com/openGemini/openGemini/lib/logstore"
 	meta "github.com/openGemini/openGemini/lib/metaclient"
 	"github.com/openGemini/openGemini/lib/obs"
+	"github.com/openGemini/openGemini/lib/pipeline"
 	"github.com/openGemini/openGemini/lib/proxy"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
@@ -77,6 +78,9 @@ var (
 	ErrInvalidRepoName      = errors.New("invalid repository name")
 	ErrInvalidLogStreamName = errors.New("invalid logstream name")
 	ErrInvalidWriteNode     = errors.New("this data node is not used for writing")
+	ErrNoLogContent         = errors.New("log has no content")
+	ErrWriteLog             = errors.New("write log error")
+	ErrWriteFailLog         = errors.New("write fail log error")
 )
 
 const (
@@ -152,6 +156,10 @@ func validateLogstreamOptions(opt *meta2.Options) error {
 		opt.SplitChar = tokenizer.CONTENT_SPLITTER
 	}
 
+	if err := pipeline.Validate(opt.Pipeline); err != nil {
+		return err
+	}
+
 	tagsSpiltChar := opt.GetTagSplitChar()
 	if len(tagsSpiltChar) != 0 {
 		err := validateSplitChar(tagsSpiltChar)
@@ -384,7 +392,7 @@ func (h *Handler) serveCreateLogstream(w http.ResponseWriter, r *http.Request, u
 	}
 	// crete measurement
 	colStoreInfo, schemaInfo, indexRelation, ski, numOfShards := h.getDefaultSchemaForLog(options)
-	if _, err := h.MetaClient.CreateMeasurement(repository, logStream, logStream, ski, numOfShards, indexRelation, config.COLUMNSTORE, colStoreInfo, schemaInfo, options); err != nil {
+	if _, err := h.MetaClient.CreateMeasurement(repository, logStream, logStream, ski, numOfShards, indexRelation, config.COLUMNSTORE, colStoreInfo, schemaInfo, options, 0); err != nil {
 		logger.GetLogger().Error("create logStream failed", zap.String("name", logStream), zap.Error(err))
 		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusInternalServerError)
 		return
@@ -526,6 +534,9 @@ type FailLogType uint8
 const (
 	JSON      LogDataType = 0
 	JSONArray LogDataType = 1
+	Text      LogDataType = 2
+	CSV       LogDataType = 3
+	Syslog    LogDataType = 4
 
 	Tags          = "tags"
 	Tag           = "tag"
@@ -546,6 +557,7 @@ const (
 	ObjectError
 	ContentFieldError
 	NoContentError
+	PipelineError
 )
 
 var (
@@ -599,6 +611,8 @@ type LogWriteRequest struct {
 	maxTime        int64
 	logTagString   *string
 	dataType       LogDataType
+	csvHeader      []string
+	pipeline       *pipeline.Pipeline
 	mapping        *JsonMapping
 	printFailLog   *PrintFailLog
 	logTags        [][]byte
@@ -640,6 +654,12 @@ func getRecordType(ty string) (LogDataType, error) {
 		logDataType = JSON
 	case "jsonarray":
 		logDataType = JSONArray
+	case "text":
+		logDataType = Text
+	case "csv":
+		logDataType = CSV
+	case "syslog":
+		logDataType = Syslog
 	default:
 		return logDataType, errno.NewError(errno.InvalidLogDataType)
 	}
@@ -776,7 +796,11 @@ func getTimestamp(i interface{}, req *LogWriteRequest) (int64, error) {
 	default:
 		return 0, errno.NewError(errno.ErrParseTimestamp)
 	}
+	return validateTimestamp(unixTimestamp, req)
+}
 
+// validateTimestamp checks the timestamp of a log is in the valid range and not expired.
+func validateTimestamp(unixTimestamp int64, req *LogWriteRequest) (int64, error) {
 	if unixTimestamp < MinUnixTimestampNs || unixTimestamp > MaxUnixTimestampNs {
 		return 0, errno.NewError(errno.ErrParseTimestamp)
 	}
@@ -936,6 +960,7 @@ type PrintFailLog struct {
 	alreadyPrintObjectError    bool
 	alreadyPrintFieldError     bool
 	alreadyPrintNoContentError bool
+	alreadyPrintPipelineError  bool
 }
 
 func getPrintFailLog() *PrintFailLog {
@@ -1072,6 +1097,12 @@ func (h *Handler) getLogWriteRequest(r *http.Request) (*LogWriteRequest, error)
 	if err != nil {
 		return nil, err
 	}
+	if req.dataType == CSV {
+		req.csvHeader, err = parseCSVHeader(r.FormValue("csv_header"))
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	precision := r.URL.Query().Get("precision")
 	switch precision {
@@ -1229,42 +1260,59 @@ func (h *Handler) parseJsonArray(body io.ReadCloser, req *LogWriteRequest, rows,
 	}
 
 	for _, jsonMap := range jsonArray {
-		unixTimestamp, err := getTimestamp(jsonMap, req)
+		unixTimestamp, err := h.processPipeline(jsonMap, req)
 		if err != nil {
-			if req.failTag != ExpiredLogTag {
-				h.printFailLog(TimestampError, req, jsonMap, nil)
-			}
 			appendFailRow(failRows, req, jsonMap)
 			continue
 		}
-
-		pf.contentCnt = 0
-		err = visitJsonMap(jsonMap, req, rows, pf)
-		if err != nil {
-			h.printFailLog(ContentFieldError, req, jsonMap, err)
-			clearFailRow(rows, pf.rowCnt+1)
-			resetSchemaNil(pf.schemasNil)
-			appendFailRow(failRows, req, jsonMap)
-			continue
+		if unixTimestamp != 0 {
+			unixTimestamp, err = validateTimestamp(unixTimestamp, req)
+		} else {
+			unixTimestamp, err = getTimestamp(jsonMap, req)
 		}
-
-		if pf.contentCnt == 0 {
-			h.printFailLog(NoContentError, req, jsonMap, err)
+		if err != nil {
+			if req.failTag != ExpiredLogTag {
+				h.printFailLog(TimestampError, req, jsonMap, nil)
+			}
 			appendFailRow(failRows, req, jsonMap)
 			continue
 		}
 
-		rows.ColVals[0].AppendBoolean(req.retry)
-		appendLogTags(rows, req)
-		appendRowAll(rows, pf, unixTimestamp)
-		getMinMaxTime(req, unixTimestamp)
-		pf.rowCnt++
+		_ = h.appendLogFields(jsonMap, unixTimestamp, req, rows, failRows, pf, jsonMap)
 	}
 	swapTimeColumnToEnd(rows, failRows)
 
 	return totalLen
 }
 
+// appendLogFields appends the fields of a log to the rows, or appends the line to the fail rows
+// and returns the error if the fields can not be appended.
+func (h *Handler) appendLogFields(fields map[string]interface{}, unixTimestamp int64, req *LogWriteRequest, rows, failRows *record.Record,
+	pf *ParseField, line interface{}) error {
+	pf.contentCnt = 0
+	err := visitJsonMap(fields, req, rows, pf)
+	if err != nil {
+		h.printFailLog(ContentFieldError, req, line, err)
+		clearFailRow(rows, pf.rowCnt+1)
+		resetSchemaNil(pf.schemasNil)
+		appendFailRow(failRows, req, line)
+		return err
+	}
+
+	if pf.contentCnt == 0 {
+		h.printFailLog(NoContentError, req, line, err)
+		appendFailRow(failRows, req, line)
+		return ErrNoLogContent
+	}
+
+	rows.ColVals[0].AppendBoolean(req.retry)
+	appendLogTags(rows, req)
+	appendRowAll(rows, pf, unixTimestamp)
+	getMinMaxTime(req, unixTimestamp)
+	pf.rowCnt++
+	return nil
+}
+
 func (h *Handler) printFailLog(failLogType FailLogType, req *LogWriteRequest, line interface{}, err error) {
 	str := Interface2str(line)
 
@@ -1299,6 +1347,12 @@ func (h *Handler) printFailLog(failLogType FailLogType, req *LogWriteRequest, li
 				zap.String("logstream", req.logStream), zap.String("line", str))
 			req.printFailLog.alreadyPrintNoContentError = true
 		}
+	case PipelineError:
+		if !req.printFailLog.alreadyPrintPipelineError {
+			h.Logger.Error("pipeline process fail", zap.Error(err), zap.String("repository", req.repository),
+				zap.String("logstream", req.logStream), zap.String("line", str))
+			req.printFailLog.alreadyPrintPipelineError = true
+		}
 	default:
 		break
 	}
@@ -1692,6 +1746,13 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 		return
 	}
 	req.mstSchema = logInfo.Measurements[req.logStream+MstSuffix].Schema
+	req.pipeline, err = logStreamPipelines.get(req.repository, req.logStream, logInfo.Measurements[req.logStream+MstSuffix].Options)
+	if err != nil {
+		h.Logger.Error("serveRecord get pipeline fail", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
 	logInfo.Measurements[req.logStream+MstSuffix].SchemaLock.RLock()
 	logTagsMap, err := parseLogTags(req)
 	logInfo.Measurements[req.logStream+MstSuffix].SchemaLock.RUnlock()
@@ -1742,10 +1803,14 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 		req.expiredTime = req.requestTime - logInfo.Duration.Nanoseconds()
 	}
 	req.printFailLog = getPrintFailLog()
-	if req.dataType == JSON {
+	switch {
+	case req.dataType == JSON && req.pipeline == nil:
 		totalLen = h.parseJson(scanner, req, rows, failRows)
-	} else {
+	case req.dataType == JSONArray:
 		totalLen = h.parseJsonArray(body, req, rows, failRows)
+	default:
+		// the json lines processed by the pipeline are decoded into maps like the other line formats
+		totalLen = h.parseLogLines(scanner, req, rows, failRows)
 	}
 
 	if scanner.Err() != nil {
@@ -1763,32 +1828,38 @@ func (h *Handler) serveRecord(w http.ResponseWriter, r *http.Request, user meta2
 		return
 	}
 
+	if err = h.writeLogRecords(rows, failRows, req, totalLen, logInfo); err != nil {
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+
+	addLogInsertStatistics(req.repository, req.logStream, totalLen)
+}
+
+// writeLogRecords writes the rows and the fail rows of the log stream, the empty records are put back to the pools.
+func (h *Handler) writeLogRecords(rows, failRows *record.Record, req *LogWriteRequest, totalLen int64, logInfo *meta2.RetentionPolicyInfo) error {
 	bulk, failBulk := getBulkRecords(rows, failRows, req, totalLen, logInfo.ShardGroupDuration)
 	if rows.RowNums() > 0 {
-		err = h.RecordWriter.RetryWriteLogRecord(bulk)
+		err := h.RecordWriter.RetryWriteLogRecord(bulk)
 		if err != nil {
 			h.Logger.Error("serve records", zap.Error(err))
-			h.httpErrorRsp(w, ErrorResponse("write log error", LogReqErr), http.StatusBadRequest)
-			handlerStat.Write400ErrRequests.Incr()
-			return
+			return ErrWriteLog
 		}
 	} else {
 		record.LogStoreRecordPool.PutBigRecord(rows)
 	}
 
 	if failRows.RowNums() > 0 {
-		err = h.RecordWriter.RetryWriteLogRecord(failBulk)
+		err := h.RecordWriter.RetryWriteLogRecord(failBulk)
 		if err != nil {
 			h.Logger.Error("serve records", zap.Error(err))
-			h.httpErrorRsp(w, ErrorResponse("write fail log error", LogReqErr), http.StatusBadRequest)
-			handlerStat.Write400ErrRequests.Incr()
-			return
+			return ErrWriteFailLog
 		}
 	} else {
 		record.LogStoreFailRecordPool.PutBigRecord(failRows)
 	}
-
-	addLogInsertStatistics(req.repository, req.logStream, totalLen)
+	return nil
 }
 
 func getBulkRecords(rows, failRows *record.Record, req *LogWriteRequest, totalLen int64, shardGroupDuration time.Duration) (*record.BulkRecords,
@@ -1857,6 +1928,13 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 		handlerStat.Write400ErrRequests.Incr()
 		return
 	}
+	convert, err := newUploadLineConverter(r)
+	if err != nil {
+		h.Logger.Error("serveUpload newUploadLineConverter fail", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
 
 	scanner := bufio.NewScanner(r.Body)
 	scanBuf := byteBufferPool.Get()
@@ -1872,6 +1950,15 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 		return
 	}
 
+	req := &LogWriteRequest{repository: repository, logStream: logStream, printFailLog: getPrintFailLog()}
+	req.pipeline, err = logStreamPipelines.get(repository, logStream, logInfo.Measurements[logStream+MstSuffix].Options)
+	if err != nil {
+		h.Logger.Error("serveUpload get pipeline fail", zap.Error(err))
+		h.httpErrorRsp(w, ErrorResponse(err.Error(), LogReqErr), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+
 	dur := logInfo.ShardGroupDuration.Nanoseconds()
 	date := start.Unix()
 	tagsStr := "date:" + strconv.FormatInt(date, 10) + tokenizer.TAGS_SPLITTER + "type:upload"
@@ -1891,6 +1978,17 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 	for scanner.Scan() {
 		curTime = getLogTimestamp(curTime)
 		t = curTime + baseTime
+		content, tags := convert(scanner.Bytes(), tagsByte)
+		if content == nil {
+			continue
+		}
+		if req.pipeline != nil {
+			var pipelineTimestamp int64
+			content, pipelineTimestamp = h.processUploadPipeline(content, req)
+			if pipelineTimestamp != 0 {
+				t = pipelineTimestamp
+			}
+		}
 		groupIdTmp := int(t / dur)
 		if groupIdTmp != groupId {
 			groupId = groupIdTmp
@@ -1916,7 +2014,7 @@ func (h *Handler) serveUpload(w http.ResponseWriter, r *http.Request, user meta2
 			rows = record.GetRecordFromPool(record.LogStoreRecordPool, uploadSchema)
 			rowCount = 0
 		}
-		appendRow(rows, tagsByte, scanner.Bytes(), t)
+		appendRow(rows, tags, content, t)
 		rowCount++
 	}
 	if rows.RowNums() > 0 {
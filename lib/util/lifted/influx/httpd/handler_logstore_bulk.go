@@ -0,0 +1,510 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/gorilla/mux"
+	compression "github.com/openGemini/openGemini/lib/compress"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/record"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+const (
+	// BulkIndex is the path parameter of the default index of the bulk request
+	BulkIndex = "index"
+	// BulkTimestamp is the field of the time of the documents written by the bulk request
+	BulkTimestamp = "@timestamp"
+
+	bulkActionIndex  = "index"
+	bulkActionCreate = "create"
+	bulkActionUpdate = "update"
+	bulkActionDelete = "delete"
+)
+
+// the types of the errors of the bulk response, which are the types used by elasticsearch
+const (
+	bulkIllegalArgument  = "illegal_argument_exception"
+	bulkParseError       = "parse_exception"
+	bulkMapperParseError = "mapper_parsing_exception"
+	bulkIndexNotFound    = "index_not_found_exception"
+	bulkWriteError       = "exception"
+)
+
+// bulkAction is the action line of a document of the bulk request.
+type bulkAction struct {
+	Index string `json:"_index"`
+	ID    string `json:"_id"`
+}
+
+type bulkError struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+type bulkShards struct {
+	Total      int `json:"total"`
+	Successful int `json:"successful"`
+	Failed     int `json:"failed"`
+}
+
+// bulkItem is the result of an action of the bulk request.
+type bulkItem struct {
+	Index   string      `json:"_index"`
+	ID      string      `json:"_id,omitempty"`
+	Version int         `json:"_version,omitempty"`
+	Result  string      `json:"result,omitempty"`
+	Shards  *bulkShards `json:"_shards,omitempty"`
+	Status  int         `json:"status"`
+	Error   *bulkError  `json:"error,omitempty"`
+}
+
+func (item *bulkItem) fail(status int, typ, reason string) {
+	item.Status = status
+	item.Error = &bulkError{Type: typ, Reason: reason}
+	item.Version, item.Result, item.Shards = 0, "", nil
+}
+
+// BulkResponse is the elasticsearch shaped response of the bulk request.
+type BulkResponse struct {
+	Took   int64                  `json:"took"`
+	Errors bool                   `json:"errors"`
+	Items  []map[string]*bulkItem `json:"items"`
+}
+
+// bulkErrorResponse is the elasticsearch shaped response of a bulk request which is rejected as a whole.
+func bulkErrorResponse(typ, reason string, status int) []byte {
+	b, _ := sonic.Marshal(map[string]interface{}{
+		"error":  &bulkError{Type: typ, Reason: reason},
+		"status": status,
+	})
+	return b
+}
+
+// bulkIndex keeps the rows of the documents of an index, an index is mapped to the log stream
+// by the name of "repository.logStream".
+type bulkIndex struct {
+	req      *LogWriteRequest
+	logInfo  *meta2.RetentionPolicyInfo
+	rows     *record.Record
+	failRows *record.Record
+	pf       *ParseField
+	// the items of the documents appended to the rows, which fail if the rows can not be written
+	items    []*bulkItem
+	totalLen int64
+	// err is the error of opening the index, all the documents of the index fail with it
+	err error
+}
+
+// bulkWriter groups the documents of a bulk request by the index.
+type bulkWriter struct {
+	base         *LogWriteRequest
+	defaultIndex string
+	logTags      string
+	indices      map[string]*bulkIndex
+	// openIndex opens the index when its first document is met
+	openIndex func(name string) *bulkIndex
+}
+
+// splitBulkIndex maps the index name to the repository and the log stream. The repository name can not
+// contain '.', so the name is split by its first '.'.
+func splitBulkIndex(name string) (string, string, error) {
+	i := strings.IndexByte(name, '.')
+	if i < 0 {
+		return "", "", fmt.Errorf("index [%s] is not in the form of repository.logstream", name)
+	}
+	repository, logStream := name[:i], name[i+1:]
+	if err := ValidateRepoAndLogStream(repository, logStream); err != nil {
+		return "", "", err
+	}
+	return repository, logStream, nil
+}
+
+// getBulkWriteRequest parses the parameters of the bulk request, which are shared by the indices of the request.
+// The time of the documents is the @timestamp field unless the mapping parameter is given.
+func getBulkWriteRequest(r *http.Request) (*LogWriteRequest, error) {
+	req := &LogWriteRequest{failTag: FailLogTag, timeMultiplier: 1e6}
+	var err error
+	if retry := r.FormValue("retry"); retry != "" {
+		req.retry, err = strconv.ParseBool(retry)
+		if err != nil {
+			return nil, errno.NewError(errno.InvalidRetryPara)
+		}
+	}
+
+	switch r.URL.Query().Get("precision") {
+	case "ns":
+		req.timeMultiplier = 1
+	case "us":
+		req.timeMultiplier = 1e3
+	case "ms", "":
+		req.timeMultiplier = 1e6
+	case "s":
+		req.timeMultiplier = 1e9
+	default:
+		return nil, errno.NewError(errno.InvalidPrecisionPara)
+	}
+
+	req.mapping = &JsonMapping{
+		timestamp:     BulkTimestamp,
+		discardFields: make(map[string]bool),
+	}
+	if mapping := r.FormValue("mapping"); mapping != "" {
+		req.mapping, err = parseMapping(mapping)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return req, nil
+}
+
+// newBulkLogWriteRequest copies the parameters of the bulk request for the log stream.
+func newBulkLogWriteRequest(base *LogWriteRequest, repository, logStream string) *LogWriteRequest {
+	return &LogWriteRequest{
+		repository:     repository,
+		logStream:      logStream,
+		failTag:        FailLogTag,
+		retry:          base.retry,
+		timeMultiplier: base.timeMultiplier,
+		requestTime:    base.requestTime,
+		expiredTime:    base.expiredTime,
+		mapping:        base.mapping,
+		printFailLog:   base.printFailLog,
+		logSchema:      append(record.Schemas{}, logSchema...),
+		mstSchema:      &meta2.CleanSchema{},
+	}
+}
+
+// openBulkIndex validates the log stream of the index and prepares the rows of its documents.
+func (h *Handler) openBulkIndex(bw *bulkWriter, name string) *bulkIndex {
+	repository, logStream, err := splitBulkIndex(name)
+	if err != nil {
+		return &bulkIndex{err: err}
+	}
+	logInfo, err := h.validateRetentionPolicy(repository, logStream)
+	if err != nil {
+		h.Logger.Error("serveBulk GetLogStreamByName fail", zap.Error(err), zap.String("repository", repository),
+			zap.String("logStream", logStream))
+		return &bulkIndex{err: err}
+	}
+
+	req := newBulkLogWriteRequest(bw.base, repository, logStream)
+	if logInfo.Duration != 0 {
+		req.expiredTime = req.requestTime - logInfo.Duration.Nanoseconds()
+	}
+	mst := logInfo.Measurements[logStream+MstSuffix]
+	req.mstSchema = mst.Schema
+	req.pipeline, err = logStreamPipelines.get(repository, logStream, mst.Options)
+	if err != nil {
+		return &bulkIndex{err: err}
+	}
+	logTags := bw.logTags
+	req.logTagString = &logTags
+	mst.SchemaLock.RLock()
+	logTagsMap, err := parseLogTags(req)
+	mst.SchemaLock.RUnlock()
+	if err != nil {
+		return &bulkIndex{err: err}
+	}
+
+	idx := newBulkIndex(req, record.LogStoreRecordPool.Get(), record.GetRecordFromPool(record.LogStoreFailRecordPool, failLogSchema), logTagsMap)
+	idx.logInfo = logInfo
+	return idx
+}
+
+func newBulkIndex(req *LogWriteRequest, rows, failRows *record.Record, logTagsMap map[string][]byte) *bulkIndex {
+	req.logTags, req.logTagsKey = addLogTagsField(failRows, logTagsMap, req)
+	rows.ReserveSchemaAndColVal(req.logSchema.Len())
+	copy(rows.Schema, req.logSchema)
+	return &bulkIndex{
+		req:      req,
+		rows:     rows,
+		failRows: failRows,
+		pf:       getParseField(len(req.logTags) + logSchema.Len()),
+	}
+}
+
+func (bw *bulkWriter) index(name string) *bulkIndex {
+	idx, ok := bw.indices[name]
+	if !ok {
+		idx = bw.openIndex(name)
+		bw.indices[name] = idx
+	}
+	return idx
+}
+
+// bulkTimestamp returns the time of the document, the string time is taken as RFC3339 unless the mapping gives
+// the time format, and the number is taken as a number of the precision. The documents without time are stamped
+// with the ingest time.
+func bulkTimestamp(doc map[string]interface{}, req *LogWriteRequest) (int64, error) {
+	switch v := doc[req.mapping.timestamp].(type) {
+	case nil:
+		unixTimestamp := req.requestTime
+		req.requestTime++
+		return unixTimestamp, nil
+	case string:
+		if req.mapping.isConvertTime {
+			return getTimestamp(doc, req)
+		}
+		t, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return 0, errno.NewError(errno.ErrParseTimestamp)
+		}
+		return validateTimestamp(t.UnixNano(), req)
+	default:
+		return getTimestamp(doc, req)
+	}
+}
+
+// parseBulk parses the action and document lines of the bulk request, the documents are appended to the rows
+// of their indices, and the items of the response are returned in the order of the actions.
+func (h *Handler) parseBulk(scanner *bufio.Scanner, bw *bulkWriter) ([]map[string]*bulkItem, error) {
+	var items []map[string]*bulkItem
+	for scanner.Scan() {
+		b := scanner.Bytes()
+		if len(b) == 0 {
+			continue
+		}
+
+		var line map[string]*bulkAction
+		if err := sonic.Unmarshal(b, &line); err != nil || len(line) != 1 {
+			return nil, fmt.Errorf("malformed action/metadata line [%d], expected a single action", len(items)+1)
+		}
+		var name string
+		var action *bulkAction
+		for k, v := range line {
+			name, action = k, v
+		}
+		if action == nil {
+			action = &bulkAction{}
+		}
+		if action.Index == "" {
+			action.Index = bw.defaultIndex
+		}
+		item := &bulkItem{Index: action.Index, ID: action.ID}
+		items = append(items, map[string]*bulkItem{name: item})
+
+		switch name {
+		case bulkActionIndex, bulkActionCreate:
+		case bulkActionDelete:
+			item.fail(http.StatusBadRequest, bulkIllegalArgument, "action [delete] is not supported by the log store")
+			continue
+		case bulkActionUpdate:
+			// the document line of the update is skipped
+			scanner.Scan()
+			item.fail(http.StatusBadRequest, bulkIllegalArgument, "action [update] is not supported by the log store")
+			continue
+		default:
+			return nil, fmt.Errorf("malformed action/metadata line [%d], unknown action [%s]", len(items), name)
+		}
+
+		if !scanner.Scan() {
+			return nil, fmt.Errorf("the bulk request must be terminated by a newline, action [%d] has no document", len(items))
+		}
+		doc := scanner.Bytes()
+		if action.Index == "" {
+			item.fail(http.StatusBadRequest, bulkIllegalArgument, "index is missing")
+			continue
+		}
+		idx := bw.index(action.Index)
+		if idx.err != nil {
+			item.fail(http.StatusNotFound, bulkIndexNotFound, idx.err.Error())
+			continue
+		}
+		h.appendBulkDoc(idx, item, doc)
+	}
+	return items, nil
+}
+
+// appendBulkDoc appends the document to the rows of the index, the documents which can not be parsed are
+// kept in the fail rows as the logs of the other formats.
+func (h *Handler) appendBulkDoc(idx *bulkIndex, item *bulkItem, doc []byte) {
+	req := idx.req
+	idx.totalLen += int64(len(doc)) + NewlineLen
+	if len(doc) > MaxContentLen {
+		appendBigLog(idx.failRows, req, doc)
+		item.fail(http.StatusRequestEntityTooLarge, bulkMapperParseError, "document exceeds the max content length")
+		return
+	}
+
+	var fields map[string]interface{}
+	if err := sonic.Unmarshal(doc, &fields); err != nil || fields == nil {
+		if err == nil {
+			err = fmt.Errorf("document is not an object")
+		}
+		h.printFailLog(ParseError, req, doc, err)
+		appendFailRow(idx.failRows, req, doc)
+		item.fail(http.StatusBadRequest, bulkParseError, err.Error())
+		return
+	}
+
+	unixTimestamp, err := h.processPipeline(fields, req)
+	if err != nil {
+		appendFailRow(idx.failRows, req, doc)
+		item.fail(http.StatusBadRequest, bulkMapperParseError, err.Error())
+		return
+	}
+	if unixTimestamp != 0 {
+		unixTimestamp, err = validateTimestamp(unixTimestamp, req)
+	} else {
+		unixTimestamp, err = bulkTimestamp(fields, req)
+	}
+	if err != nil {
+		if req.failTag != ExpiredLogTag {
+			h.printFailLog(TimestampError, req, doc, nil)
+		}
+		appendFailRow(idx.failRows, req, doc)
+		item.fail(http.StatusBadRequest, bulkMapperParseError, "failed to parse field ["+req.mapping.timestamp+"]")
+		return
+	}
+
+	if err = h.appendLogFields(fields, unixTimestamp, req, idx.rows, idx.failRows, idx.pf, doc); err != nil {
+		item.fail(http.StatusBadRequest, bulkMapperParseError, err.Error())
+		return
+	}
+	item.Status = http.StatusCreated
+	item.Result = "created"
+	item.Version = 1
+	item.Shards = &bulkShards{Total: 1, Successful: 1}
+	idx.items = append(idx.items, item)
+}
+
+// closeBulkIndices writes the rows of the indices, the records of the indices which are not written
+// are put back to the pools.
+func (h *Handler) closeBulkIndices(bw *bulkWriter, write bool) {
+	for _, idx := range bw.indices {
+		if idx.err != nil {
+			continue
+		}
+		if !write {
+			record.LogStoreRecordPool.PutBigRecord(idx.rows)
+			record.LogStoreFailRecordPool.PutBigRecord(idx.failRows)
+			continue
+		}
+		swapTimeColumnToEnd(idx.rows, idx.failRows)
+		if err := h.writeLogRecords(idx.rows, idx.failRows, idx.req, idx.totalLen, idx.logInfo); err != nil {
+			for _, item := range idx.items {
+				item.fail(http.StatusInternalServerError, bulkWriteError, err.Error())
+			}
+			continue
+		}
+		addLogInsertStatistics(idx.req.repository, idx.req.logStream, idx.totalLen)
+	}
+}
+
+// serveBulk receives the documents in the elasticsearch bulk format and writes them to the log streams
+// mapped by the index names. The response has the elasticsearch shaped result of each action.
+func (h *Handler) serveBulk(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	handlerStat.WriteRequests.Incr()
+	handlerStat.ActiveWriteRequests.Incr()
+	handlerStat.WriteRequestBytesIn.Add(r.ContentLength)
+	start := time.Now()
+	defer func() {
+		handlerStat.ActiveWriteRequests.Decr()
+		handlerStat.WriteRequestDuration.Add(time.Since(start).Nanoseconds())
+	}()
+
+	if !h.IsWriteNode() {
+		h.Logger.Error("serveBulk checkNodeRole fail", zap.Error(ErrInvalidWriteNode))
+		h.httpErrorRsp(w, bulkErrorResponse(bulkIllegalArgument, ErrInvalidWriteNode.Error(), http.StatusBadRequest), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	if r.ContentLength > MaxRequestBodyLength {
+		err := errno.NewError(errno.InvalidRequestBodyLength)
+		h.httpErrorRsp(w, bulkErrorResponse(bulkIllegalArgument, err.Error(), http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+
+	base, err := getBulkWriteRequest(r)
+	if err != nil {
+		h.Logger.Error("serveBulk getBulkWriteRequest fail", zap.Error(err))
+		h.httpErrorRsp(w, bulkErrorResponse(bulkIllegalArgument, err.Error(), http.StatusBadRequest), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	base.requestTime = start.UnixNano()
+	base.printFailLog = getPrintFailLog()
+
+	var body io.Reader = r.Body
+	// the shippers compress the body by the content encoding
+	if r.Header.Get("Content-Encoding") == "gzip" || r.Header.Get("x-log-compresstype") == "gzip" {
+		b, err := compression.GetGzipReader(r.Body)
+		if err != nil {
+			h.httpErrorRsp(w, bulkErrorResponse(bulkParseError, err.Error(), http.StatusBadRequest), http.StatusBadRequest)
+			h.Logger.Error("serveBulk: Handle gzip decoding of the body err", zap.Error(errno.NewError(errno.HttpBadRequest)))
+			handlerStat.Write400ErrRequests.Incr()
+			return
+		}
+		defer compression.PutGzipReader(b)
+		body = b
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanBuf := byteBufferPool.Get()
+	defer byteBufferPool.Put(scanBuf)
+	scanner.Buffer(scanBuf, getBufferSize(int(r.ContentLength)))
+	scanner.Split(bufio.ScanLines)
+
+	bw := &bulkWriter{
+		base:         base,
+		defaultIndex: mux.Vars(r)[BulkIndex],
+		logTags:      r.Header.Get("log-tags"),
+		indices:      make(map[string]*bulkIndex),
+	}
+	bw.openIndex = func(name string) *bulkIndex {
+		return h.openBulkIndex(bw, name)
+	}
+
+	items, err := h.parseBulk(scanner, bw)
+	if err == nil {
+		err = scanner.Err()
+	}
+	if err != nil {
+		h.closeBulkIndices(bw, false)
+		h.Logger.Error("serveBulk parse fail", zap.Error(err))
+		h.httpErrorRsp(w, bulkErrorResponse(bulkIllegalArgument, err.Error(), http.StatusBadRequest), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	h.closeBulkIndices(bw, true)
+
+	resp := &BulkResponse{Items: items}
+	for _, item := range items {
+		for _, result := range item {
+			resp.Errors = resp.Errors || result.Error != nil
+		}
+	}
+	resp.Took = time.Since(start).Milliseconds()
+	b, err := sonic.Marshal(resp)
+	if err != nil {
+		h.httpErrorRsp(w, bulkErrorResponse(bulkWriteError, err.Error(), http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	h.writeHeader(w, http.StatusOK)
+	_, _ = w.Write(b)
+}
@@ -0,0 +1,162 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bufio"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/stretchr/testify/require"
+)
+
+func parseTestBulk(t *testing.T, defaultIndex, body string) ([]map[string]*bulkItem, map[string]*bulkIndex, error) {
+	h := &Handler{
+		Logger: logger.NewLogger(errno.ModuleLogStore),
+	}
+	base, err := getBulkWriteRequest(httptest.NewRequest("POST", "/_bulk", nil))
+	require.NoError(t, err)
+	base.requestTime = 1000
+	base.printFailLog = getPrintFailLog()
+
+	bw := &bulkWriter{base: base, defaultIndex: defaultIndex, indices: make(map[string]*bulkIndex)}
+	bw.openIndex = func(name string) *bulkIndex {
+		repository, logStream, err := splitBulkIndex(name)
+		if err != nil {
+			return &bulkIndex{err: err}
+		}
+		if logStream == "missing" {
+			return &bulkIndex{err: ErrLogStreamInvalid}
+		}
+		// the records of the pool are reset without schema
+		return newBulkIndex(newBulkLogWriteRequest(base, repository, logStream), &record.Record{},
+			record.NewRecord(failLogSchema, false), nil)
+	}
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	scanner.Buffer(make([]byte, 1024), ScannerBufferSize)
+	items, err := h.parseBulk(scanner, bw)
+	for _, idx := range bw.indices {
+		if idx.err == nil {
+			swapTimeColumnToEnd(idx.rows, idx.failRows)
+		}
+	}
+	return items, bw.indices, err
+}
+
+func TestSplitBulkIndex(t *testing.T) {
+	repository, logStream, err := splitBulkIndex("repo.nginx.access")
+	require.NoError(t, err)
+	require.Equal(t, "repo", repository)
+	require.Equal(t, "nginx.access", logStream)
+
+	for _, name := range []string{"repo", ".stream", "repo.", "re:po.stream"} {
+		_, _, err = splitBulkIndex(name)
+		require.Error(t, err, name)
+	}
+}
+
+func TestParseBulk(t *testing.T) {
+	items, indices, err := parseTestBulk(t, "repo.app", strings.Join([]string{
+		`{"index":{"_id":"1"}}`,
+		`{"@timestamp":"2024-07-01T19:30:12.5Z","message":"hello","level":"info"}`,
+		`{"create":{"_index":"repo.web"}}`,
+		`{"message":"no time"}`,
+		`{"index":{}}`,
+		`{"@timestamp":1719862212771,"message":"millis"}`,
+		`{"index":{}}`,
+		`not json`,
+		`{"delete":{"_id":"1"}}`,
+		`{"update":{"_id":"1"}}`,
+		`{"doc":{"message":"skipped"}}`,
+		`{"index":{"_index":"repo.missing"}}`,
+		`{"message":"lost"}`,
+		`{"index":{"_index":"repo"}}`,
+		`{"message":"lost"}`,
+	}, "\n"))
+	require.NoError(t, err)
+	require.Len(t, items, 8)
+
+	expect := []struct {
+		action string
+		index  string
+		status int
+	}{
+		{"index", "repo.app", http.StatusCreated},
+		{"create", "repo.web", http.StatusCreated},
+		{"index", "repo.app", http.StatusCreated},
+		{"index", "repo.app", http.StatusBadRequest},
+		{"delete", "repo.app", http.StatusBadRequest},
+		{"update", "repo.app", http.StatusBadRequest},
+		{"index", "repo.missing", http.StatusNotFound},
+		{"index", "repo", http.StatusNotFound},
+	}
+	for i, e := range expect {
+		item, ok := items[i][e.action]
+		require.True(t, ok, i)
+		require.Equal(t, e.index, item.Index, i)
+		require.Equal(t, e.status, item.Status, i)
+		require.Equal(t, e.status != http.StatusCreated, item.Error != nil, i)
+	}
+	require.Equal(t, "1", items[0]["index"].ID)
+	require.Equal(t, "created", items[0]["index"].Result)
+
+	app := indices["repo.app"]
+	require.Equal(t, []string{"hello", "millis"}, stringColumn(t, app.rows, "message"))
+	require.Equal(t, []string{"info", "<nil>"}, stringColumn(t, app.rows, "level"))
+	require.Equal(t, []int64{time.Date(2024, 7, 1, 19, 30, 12, 5e8, time.UTC).UnixNano(), 1719862212771000000},
+		app.rows.ColVals[app.rows.ColNums()-1].IntegerValues())
+	require.Equal(t, 1, app.failRows.RowNums())
+	require.Equal(t, "not json", string(app.failRows.ColVals[0].Val))
+	require.Len(t, app.items, 2)
+
+	// the document without time is stamped with the ingest time
+	web := indices["repo.web"]
+	require.Equal(t, []string{"no time"}, stringColumn(t, web.rows, "message"))
+	require.Equal(t, []int64{1000}, web.rows.ColVals[web.rows.ColNums()-1].IntegerValues())
+}
+
+func TestParseBulkMalformed(t *testing.T) {
+	for _, body := range []string{
+		`{"index":{},"create":{}}` + "\n{}",
+		`{"upsert":{}}` + "\n{}",
+		`[1,2]`,
+		`{"index":{"_index":"repo.app"}}`,
+	} {
+		_, _, err := parseTestBulk(t, "", body)
+		require.Error(t, err, body)
+	}
+}
+
+func TestBulkTimestamp(t *testing.T) {
+	req := newBulkLogWriteRequest(&LogWriteRequest{timeMultiplier: 1e9, requestTime: 1000,
+		mapping: &JsonMapping{timestamp: BulkTimestamp}}, "repo", "app")
+
+	ts, err := bulkTimestamp(map[string]interface{}{BulkTimestamp: float64(1719862212)}, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(1719862212000000000), ts)
+
+	_, err = bulkTimestamp(map[string]interface{}{BulkTimestamp: "yesterday"}, req)
+	require.Error(t, err)
+
+	ts, err = bulkTimestamp(map[string]interface{}{}, req)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), ts)
+}
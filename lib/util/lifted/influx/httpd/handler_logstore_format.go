@@ -0,0 +1,309 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/syslog"
+	"github.com/openGemini/openGemini/lib/tokenizer"
+)
+
+// fields of the logs parsed from syslog messages, the facility, severity, host and app are the tags of the log
+const (
+	SyslogFacility       = "facility"
+	SyslogSeverity       = "severity"
+	SyslogHost           = "host"
+	SyslogApp            = "app"
+	SyslogProcID         = "procid"
+	SyslogMsgID          = "msgid"
+	SyslogStructuredData = "structured_data"
+)
+
+// logLineDecoder decodes one line of the text, csv, syslog or json format into the fields of a log.
+// The returned timestamp is 0 when it is not carried by the line, and the fields are nil when the line is skipped.
+type logLineDecoder func(line []byte) (map[string]interface{}, int64, error)
+
+func newLogLineDecoder(req *LogWriteRequest) logLineDecoder {
+	switch req.dataType {
+	case CSV:
+		d := &csvDecoder{header: req.csvHeader}
+		return func(line []byte) (map[string]interface{}, int64, error) {
+			return d.decode(line, req)
+		}
+	case Syslog:
+		return decodeSyslogLine
+	case JSON:
+		return decodeJsonLine
+	default:
+		return decodeTextLine
+	}
+}
+
+// decodeTextLine keeps the whole line as the content of the log.
+func decodeTextLine(line []byte) (map[string]interface{}, int64, error) {
+	return map[string]interface{}{Content: string(line)}, 0, nil
+}
+
+// decodeJsonLine decodes a json line into a map, which is used when the log stream has a pipeline.
+func decodeJsonLine(line []byte) (map[string]interface{}, int64, error) {
+	var fields map[string]interface{}
+	if err := sonic.Unmarshal(line, &fields); err != nil {
+		return nil, 0, err
+	}
+	if fields == nil {
+		return nil, 0, fmt.Errorf("json line is not an object")
+	}
+	return fields, 0, nil
+}
+
+func decodeSyslogLine(line []byte) (map[string]interface{}, int64, error) {
+	m, err := syslog.Parse(line, time.Now())
+	if err != nil {
+		return nil, 0, err
+	}
+	fields := make(map[string]interface{}, 8)
+	fields[SyslogFacility] = m.FacilityName()
+	fields[SyslogSeverity] = m.SeverityName()
+	for k, v := range map[string]string{
+		SyslogHost:           m.Hostname,
+		SyslogApp:            m.AppName,
+		SyslogProcID:         m.ProcID,
+		SyslogMsgID:          m.MsgID,
+		SyslogStructuredData: m.StructuredData,
+		Content:              m.Message,
+	} {
+		if v != "" {
+			fields[k] = v
+		}
+	}
+	var unixTimestamp int64
+	if !m.Timestamp.IsZero() {
+		unixTimestamp = m.Timestamp.UnixNano()
+	}
+	return fields, unixTimestamp, nil
+}
+
+// parseCSVHeader parses the column names given by the csv_header parameter.
+// The first line of the body is the header if the parameter is empty.
+func parseCSVHeader(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	header, err := csv.NewReader(strings.NewReader(s)).Read()
+	if err != nil {
+		return nil, errno.NewError(errno.ErrCSVHeader, err)
+	}
+	return header, checkCSVHeader(header)
+}
+
+func checkCSVHeader(header []string) error {
+	columns := make(map[string]bool, len(header))
+	for _, col := range header {
+		if col == "" {
+			return errno.NewError(errno.ErrCSVHeader, "empty column name")
+		}
+		if columns[col] {
+			return errno.NewError(errno.ErrCSVHeader, "duplicate column "+col)
+		}
+		columns[col] = true
+	}
+	return nil
+}
+
+// csvDecoder maps the values of each line to the columns of the header, the values are kept as strings.
+// A value of the timestamp column is taken as a number of the precision, unless the mapping gives the time format.
+type csvDecoder struct {
+	header []string
+}
+
+func (d *csvDecoder) decode(line []byte, req *LogWriteRequest) (map[string]interface{}, int64, error) {
+	values, err := csv.NewReader(bytes.NewReader(line)).Read()
+	if err != nil {
+		return nil, 0, err
+	}
+	if d.header == nil {
+		if err = checkCSVHeader(values); err != nil {
+			return nil, 0, err
+		}
+		d.header = values
+		return nil, 0, nil
+	}
+	if len(values) != len(d.header) {
+		return nil, 0, fmt.Errorf("csv line has %d values, but the header has %d columns", len(values), len(d.header))
+	}
+
+	fields := make(map[string]interface{}, len(values))
+	for i, col := range d.header {
+		if values[i] == "" {
+			continue
+		}
+		fields[col] = values[i]
+	}
+	if v, ok := fields[req.mapping.timestamp].(string); ok && !req.mapping.isConvertTime {
+		if f, err := strconv.ParseFloat(v, 64); err == nil {
+			fields[req.mapping.timestamp] = f
+		}
+	}
+	return fields, 0, nil
+}
+
+// lineTimestamp returns the timestamp of a log of the line formats.
+// Logs without time are stamped with the ingest time, which keeps increasing to keep the order of the lines,
+// except the json logs which must carry the time as they do without a pipeline.
+func lineTimestamp(fields map[string]interface{}, unixTimestamp int64, req *LogWriteRequest) (int64, error) {
+	if unixTimestamp != 0 {
+		return validateTimestamp(unixTimestamp, req)
+	}
+	if _, ok := fields[req.mapping.timestamp]; ok || req.dataType == JSON {
+		return getTimestamp(fields, req)
+	}
+	unixTimestamp = req.requestTime
+	req.requestTime++
+	return unixTimestamp, nil
+}
+
+// parseLogLines parses the body of the text, csv and syslog formats, and the json lines processed by a pipeline,
+// one log per line.
+func (h *Handler) parseLogLines(scanner *bufio.Scanner, req *LogWriteRequest, rows, failRows *record.Record) int64 {
+	var totalLen int64
+	decode := newLogLineDecoder(req)
+	pf := getParseField(len(req.logTags) + logSchema.Len())
+	rows.ReserveSchemaAndColVal(req.logSchema.Len())
+	copy(rows.Schema, req.logSchema)
+
+	for scanner.Scan() {
+		b := scanner.Bytes()
+		if len(b) == 0 {
+			continue
+		}
+
+		totalLen += int64(len(b)) + NewlineLen
+		if len(b) > MaxContentLen {
+			appendBigLog(failRows, req, b)
+			continue
+		}
+
+		fields, unixTimestamp, err := decode(b)
+		if err != nil {
+			h.printFailLog(ParseError, req, b, err)
+			appendFailRow(failRows, req, b)
+			continue
+		}
+		if fields == nil {
+			continue
+		}
+
+		pipelineTimestamp, err := h.processPipeline(fields, req)
+		if err != nil {
+			appendFailRow(failRows, req, b)
+			continue
+		}
+		if pipelineTimestamp != 0 {
+			unixTimestamp = pipelineTimestamp
+		}
+
+		unixTimestamp, err = lineTimestamp(fields, unixTimestamp, req)
+		if err != nil {
+			if req.failTag != ExpiredLogTag {
+				h.printFailLog(TimestampError, req, b, nil)
+			}
+			appendFailRow(failRows, req, b)
+			continue
+		}
+
+		_ = h.appendLogFields(fields, unixTimestamp, req, rows, failRows, pf, b)
+	}
+	swapTimeColumnToEnd(rows, failRows)
+
+	return totalLen
+}
+
+// uploadLineConverter converts a line of the upload into the content and the tags of the log.
+// The content is nil when the line is skipped.
+type uploadLineConverter func(line, tags []byte) ([]byte, []byte)
+
+// newUploadLineConverter returns the converter of the upload format. Json and text lines are uploaded as they are,
+// syslog messages are split into the message and the tags, and csv lines are uploaded as json objects.
+// Lines which can not be parsed are uploaded as they are.
+func newUploadLineConverter(r *http.Request) (uploadLineConverter, error) {
+	dataType, err := getRecordType(r.FormValue("type"))
+	if err != nil {
+		return nil, err
+	}
+	switch dataType {
+	case Syslog:
+		return convertSyslogUploadLine, nil
+	case CSV:
+		header, err := parseCSVHeader(r.FormValue("csv_header"))
+		if err != nil {
+			return nil, err
+		}
+		req := &LogWriteRequest{mapping: &JsonMapping{}}
+		d := &csvDecoder{header: header}
+		return func(line, tags []byte) ([]byte, []byte) {
+			fields, _, err := d.decode(line, req)
+			if err != nil {
+				return line, tags
+			}
+			if fields == nil {
+				return nil, nil
+			}
+			content, err := sonic.Marshal(fields)
+			if err != nil {
+				return line, tags
+			}
+			return content, tags
+		}, nil
+	default:
+		return func(line, tags []byte) ([]byte, []byte) {
+			return line, tags
+		}, nil
+	}
+}
+
+func convertSyslogUploadLine(line, tags []byte) ([]byte, []byte) {
+	m, err := syslog.Parse(line, time.Now())
+	if err != nil {
+		return line, tags
+	}
+	t := make([]byte, 0, len(tags)+64)
+	t = append(t, tags...)
+	t = appendUploadTag(t, SyslogFacility, m.FacilityName())
+	t = appendUploadTag(t, SyslogSeverity, m.SeverityName())
+	t = appendUploadTag(t, SyslogHost, m.Hostname)
+	t = appendUploadTag(t, SyslogApp, m.AppName)
+	return []byte(m.Message), t
+}
+
+func appendUploadTag(tags []byte, key, value string) []byte {
+	if value == "" {
+		return tags
+	}
+	tags = append(tags, tokenizer.TAGS_SPLITTER...)
+	tags = append(tags, key...)
+	tags = append(tags, ':')
+	return append(tags, value...)
+}
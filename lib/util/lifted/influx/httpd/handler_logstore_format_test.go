@@ -0,0 +1,219 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bufio"
+	"net"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/influxdata/influxdb/toml"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/tokenizer"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func parseTestLogLines(t *testing.T, req *LogWriteRequest, body string) (*record.Record, *record.Record) {
+	h := &Handler{
+		Logger: logger.NewLogger(errno.ModuleLogStore),
+	}
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	scanner.Buffer(make([]byte, 1024), ScannerBufferSize)
+
+	req.logSchema = logSchema
+	req.requestTime = 1000
+	// the records of the pool are reset without schema
+	rows := &record.Record{}
+	failRows := record.NewRecord(failLogSchema, false)
+	_ = h.parseLogLines(scanner, req, rows, failRows)
+	require.NoError(t, scanner.Err())
+	return rows, failRows
+}
+
+func stringColumn(t *testing.T, rows *record.Record, name string) []string {
+	idx := rows.Schema.FieldIndex(name)
+	require.True(t, idx >= 0, name)
+	var values []string
+	for i := 0; i < rows.RowNums(); i++ {
+		v, isNil := rows.ColVals[idx].StringValueUnsafe(i)
+		if isNil {
+			v = "<nil>"
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+func TestGetRecordType(t *testing.T) {
+	for s, expect := range map[string]LogDataType{"": JSON, "json": JSON, "JsonArray": JSONArray, "text": Text, "CSV": CSV, "syslog": Syslog} {
+		ty, err := getRecordType(s)
+		require.NoError(t, err)
+		require.Equal(t, expect, ty)
+	}
+	_, err := getRecordType("xml")
+	require.Error(t, err)
+}
+
+func TestParseTextLines(t *testing.T) {
+	req := mockLogWriteRequest(`{"timestamp":"time"}`)
+	req.dataType = Text
+	rows, failRows := parseTestLogLines(t, req, "first line\n\n{\"not\":\"parsed\"}\nthird line")
+	require.Equal(t, 0, failRows.RowNums())
+	require.Equal(t, []string{"first line", `{"not":"parsed"}`, "third line"}, stringColumn(t, rows, Content))
+	// the logs are stamped with the increasing ingest time
+	require.Equal(t, []int64{1000, 1001, 1002}, rows.ColVals[rows.ColNums()-1].IntegerValues())
+}
+
+func TestParseCSVLines(t *testing.T) {
+	req := mockLogWriteRequest(`{"timestamp":"time"}`)
+	req.dataType = CSV
+	rows, failRows := parseTestLogLines(t, req, "time,level,msg\n1719862212771,info,\"hello, world\"\n1719862212772,warn,\n1,2\n")
+	require.Equal(t, 2, rows.RowNums())
+	require.Equal(t, []string{"info", "warn"}, stringColumn(t, rows, "level"))
+	require.Equal(t, []string{"hello, world", "<nil>"}, stringColumn(t, rows, "msg"))
+	require.Equal(t, []int64{1719862212771000000, 1719862212772000000}, rows.ColVals[rows.ColNums()-1].IntegerValues())
+	// the line does not match the header
+	require.Equal(t, 1, failRows.RowNums())
+	require.Equal(t, "1,2", string(failRows.ColVals[0].Val))
+
+	// the header is given by the parameter, and the time is converted by the format of mapping
+	req = mockLogWriteRequest(`{"timestamp":"ts", "time_format":"yyyy-MM-ddTHH:mm:ssZ", "time_zone":"UTC+0"}`)
+	req.dataType = CSV
+	var err error
+	req.csvHeader, err = parseCSVHeader("ts,msg")
+	require.NoError(t, err)
+	rows, failRows = parseTestLogLines(t, req, "2024-07-01T19:30:12Z,hello\nyesterday,world")
+	require.Equal(t, []string{"hello"}, stringColumn(t, rows, "msg"))
+	require.Equal(t, []int64{time.Date(2024, 7, 1, 19, 30, 12, 0, time.UTC).UnixNano()}, rows.ColVals[rows.ColNums()-1].IntegerValues())
+	require.Equal(t, 1, failRows.RowNums())
+
+	for _, header := range []string{"a,,b", "a,b,a", `a,"b`} {
+		_, err = parseCSVHeader(header)
+		require.Error(t, err, header)
+	}
+}
+
+func TestParseSyslogLines(t *testing.T) {
+	req := mockLogWriteRequest(`{"timestamp":"time"}`)
+	req.dataType = Syslog
+	rows, failRows := parseTestLogLines(t, req, strings.Join([]string{
+		`<165>1 2024-07-01T19:30:12.5Z web01 nginx 42 ID47 - GET /index.html`,
+		`<34>Oct 11 22:14:15 mymachine su: 'su root' failed`,
+		`no priority`,
+	}, "\n"))
+	require.Equal(t, 2, rows.RowNums())
+	require.Equal(t, []string{"local4", "auth"}, stringColumn(t, rows, SyslogFacility))
+	require.Equal(t, []string{"notice", "crit"}, stringColumn(t, rows, SyslogSeverity))
+	require.Equal(t, []string{"web01", "mymachine"}, stringColumn(t, rows, SyslogHost))
+	require.Equal(t, []string{"nginx", "su"}, stringColumn(t, rows, SyslogApp))
+	require.Equal(t, []string{"42", "<nil>"}, stringColumn(t, rows, SyslogProcID))
+	require.Equal(t, []string{"GET /index.html", "'su root' failed"}, stringColumn(t, rows, Content))
+	require.Equal(t, time.Date(2024, 7, 1, 19, 30, 12, 5e8, time.UTC).UnixNano(), rows.ColVals[rows.ColNums()-1].IntegerValues()[0])
+	require.Equal(t, 1, failRows.RowNums())
+	require.Equal(t, "no priority", string(failRows.ColVals[0].Val))
+}
+
+func TestUploadLineConverter(t *testing.T) {
+	base := []byte("type:upload")
+
+	convert, err := newUploadLineConverter(httptest.NewRequest("POST", "/upload", nil))
+	require.NoError(t, err)
+	content, tags := convert([]byte("raw line"), base)
+	require.Equal(t, "raw line", string(content))
+	require.Equal(t, base, tags)
+
+	convert, err = newUploadLineConverter(httptest.NewRequest("POST", "/upload?type=syslog", nil))
+	require.NoError(t, err)
+	content, tags = convert([]byte(`<34>1 - host01 sshd - - - login failed`), base)
+	require.Equal(t, "login failed", string(content))
+	s := tokenizer.TAGS_SPLITTER
+	require.Equal(t, "type:upload"+s+"facility:auth"+s+"severity:crit"+s+"host:host01"+s+"app:sshd", string(tags))
+	content, _ = convert([]byte("not syslog"), base)
+	require.Equal(t, "not syslog", string(content))
+
+	convert, err = newUploadLineConverter(httptest.NewRequest("POST", "/upload?type=csv", nil))
+	require.NoError(t, err)
+	content, _ = convert([]byte("level,msg"), base)
+	require.Nil(t, content)
+	content, _ = convert([]byte("info,hello"), base)
+	require.JSONEq(t, `{"level":"info","msg":"hello"}`, string(content))
+
+	_, err = newUploadLineConverter(httptest.NewRequest("POST", "/upload?type=csv&csv_header=a,a", nil))
+	require.Error(t, err)
+	_, err = newUploadLineConverter(httptest.NewRequest("POST", "/upload?type=xml", nil))
+	require.Error(t, err)
+}
+
+func TestReadSyslogFrame(t *testing.T) {
+	r := bufio.NewReader(strings.NewReader("11 <13>1 - a bline framed\n5 <13>\n7 short"))
+	for _, expect := range []string{"<13>1 - a b", "line framed\n", "<13>\n"} {
+		msg, err := readSyslogFrame(r)
+		require.NoError(t, err)
+		require.Equal(t, expect, string(msg))
+	}
+	_, err := readSyslogFrame(r)
+	require.Error(t, err)
+
+	r = bufio.NewReader(strings.NewReader("99999999 too long"))
+	_, err = readSyslogFrame(r)
+	require.Error(t, err)
+}
+
+func TestSyslogListener(t *testing.T) {
+	cfg := config.NewSyslogConfig()
+	cfg.Enabled = true
+	cfg.BindAddress = "127.0.0.1:0"
+	cfg.Repository = "repo"
+	cfg.LogStream = "syslog"
+	cfg.BatchSize = 100
+	cfg.FlushInterval = 0
+	require.Error(t, cfg.Validate())
+	// the messages are taken by the test before they are flushed
+	cfg.FlushInterval = toml.Duration(time.Hour)
+	require.NoError(t, cfg.Validate())
+
+	for _, protocol := range []string{"udp", "tcp"} {
+		cfg.Protocol = protocol
+		l := newSyslogListener(cfg, &Handler{}, zap.NewNop())
+		require.NoError(t, l.Open())
+
+		conn, err := net.Dial(protocol, l.Addr().String())
+		require.NoError(t, err)
+		if protocol == "udp" {
+			_, err = conn.Write([]byte("<13>1 - a b - - - first\nline"))
+			require.NoError(t, err)
+			_, err = conn.Write([]byte("<13>1 - a b - - - second\n"))
+		} else {
+			_, err = conn.Write([]byte("28 <13>1 - a b - - - first\nline<13>1 - a b - - - second\n"))
+		}
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+
+		require.Eventually(t, func() bool {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			return l.count == 2
+		}, 5*time.Second, 10*time.Millisecond, protocol)
+		require.Equal(t, "<13>1 - a b - - - first line\n<13>1 - a b - - - second\n", string(l.take()))
+		require.NoError(t, l.Close())
+	}
+}
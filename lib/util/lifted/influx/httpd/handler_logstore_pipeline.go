@@ -0,0 +1,103 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/openGemini/openGemini/lib/pipeline"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// logStreamPipelines keeps the compiled pipelines of the log streams, so that the patterns are not compiled
+// for every request. A pipeline is compiled again when the options of the log stream are updated.
+var logStreamPipelines = &pipelineCache{pipelines: make(map[string]*cachedPipeline)}
+
+type cachedPipeline struct {
+	configs  []*pipeline.Config
+	pipeline *pipeline.Pipeline
+}
+
+type pipelineCache struct {
+	mu        sync.RWMutex
+	pipelines map[string]*cachedPipeline
+}
+
+// get returns the pipeline of the log stream, which is nil if the log stream has no pipeline.
+func (c *pipelineCache) get(repository, logStream string, options *meta2.Options) (*pipeline.Pipeline, error) {
+	key := repository + "." + logStream
+	if options == nil || len(options.Pipeline) == 0 {
+		c.mu.RLock()
+		_, ok := c.pipelines[key]
+		c.mu.RUnlock()
+		if ok {
+			c.mu.Lock()
+			delete(c.pipelines, key)
+			c.mu.Unlock()
+		}
+		return nil, nil
+	}
+
+	c.mu.RLock()
+	cached, ok := c.pipelines[key]
+	c.mu.RUnlock()
+	if ok && reflect.DeepEqual(cached.configs, options.Pipeline) {
+		return cached.pipeline, nil
+	}
+
+	p, err := pipeline.New(options.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	c.pipelines[key] = &cachedPipeline{configs: options.Pipeline, pipeline: p}
+	c.mu.Unlock()
+	return p, nil
+}
+
+// processPipeline runs the pipeline of the log stream on the fields of a log before they are appended to the rows.
+// The returned timestamp is 0 if the pipeline does not give the time of the log.
+func (h *Handler) processPipeline(fields map[string]interface{}, req *LogWriteRequest) (int64, error) {
+	if req.pipeline == nil {
+		return 0, nil
+	}
+	unixTimestamp, err := req.pipeline.Process(fields)
+	if err != nil {
+		h.printFailLog(PipelineError, req, fields, err)
+		return 0, err
+	}
+	return unixTimestamp, nil
+}
+
+// processUploadPipeline runs the pipeline of the log stream on an uploaded line. The upload keeps the content
+// of the logs only, so the processed fields are uploaded as a json object unless the content is the only field left.
+// The line is uploaded as it is if the pipeline fails, like the lines which can not be parsed.
+func (h *Handler) processUploadPipeline(line []byte, req *LogWriteRequest) ([]byte, int64) {
+	fields := map[string]interface{}{Content: string(line)}
+	unixTimestamp, err := h.processPipeline(fields, req)
+	if err != nil {
+		return line, 0
+	}
+	if content, ok := fields[Content].(string); ok && len(fields) == 1 {
+		return []byte(content), unixTimestamp
+	}
+	content, err := sonic.Marshal(fields)
+	if err != nil {
+		return line, 0
+	}
+	return content, unixTimestamp
+}
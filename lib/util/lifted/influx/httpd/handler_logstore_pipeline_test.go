@@ -0,0 +1,161 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/pipeline"
+	"github.com/openGemini/openGemini/lib/record"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+func mockPipeline(t *testing.T, configs ...*pipeline.Config) *pipeline.Pipeline {
+	p, err := pipeline.New(configs)
+	require.NoError(t, err)
+	return p
+}
+
+func TestParseLogLinesWithPipeline(t *testing.T) {
+	req := mockLogWriteRequest(`{"timestamp":"time"}`)
+	req.dataType = Text
+	req.pipeline = mockPipeline(t,
+		&pipeline.Config{Type: pipeline.TypeGrok, Pattern: `^%{TIMESTAMP_ISO8601:ts} %{LOGLEVEL:level} %{GREEDYDATA:kv}`},
+		&pipeline.Config{Type: pipeline.TypeKV, Field: "kv", RemoveSource: true},
+		&pipeline.Config{Type: pipeline.TypeTimestamp, Field: "ts", Format: time.RFC3339, RemoveSource: true},
+	)
+	rows, failRows := parseTestLogLines(t, req, strings.Join([]string{
+		`2024-07-01T19:30:12Z ERROR user=alice status=500`,
+		`unstructured line`,
+	}, "\n"))
+	require.Equal(t, 1, rows.RowNums())
+	require.Equal(t, []string{"ERROR"}, stringColumn(t, rows, "level"))
+	require.Equal(t, []string{"alice"}, stringColumn(t, rows, "user"))
+	require.Equal(t, []string{"500"}, stringColumn(t, rows, "status"))
+	require.Equal(t, -1, rows.Schema.FieldIndex("ts"))
+	require.Equal(t, -1, rows.Schema.FieldIndex("kv"))
+	require.Equal(t, []int64{time.Date(2024, 7, 1, 19, 30, 12, 0, time.UTC).UnixNano()}, rows.ColVals[rows.ColNums()-1].IntegerValues())
+	// the line not matching the pattern is written as a failed log
+	require.Equal(t, 1, failRows.RowNums())
+	require.Equal(t, "unstructured line", string(failRows.ColVals[0].Val))
+}
+
+func TestParseJsonLinesWithPipeline(t *testing.T) {
+	req := mockLogWriteRequest(`{"timestamp":"time"}`)
+	req.dataType = JSON
+	req.pipeline = mockPipeline(t,
+		&pipeline.Config{Type: pipeline.TypeJSON, Field: "payload", RemoveSource: true, Prefix: "p_"},
+		&pipeline.Config{Type: pipeline.TypeRename, Rename: map[string]string{"p_code": "code"}},
+		&pipeline.Config{Type: pipeline.TypeDrop, Fields: []string{"p_secret"}},
+	)
+	rows, failRows := parseTestLogLines(t, req, strings.Join([]string{
+		`{"time":1719862212771,"content":"request","payload":"{\"code\":200,\"secret\":\"x\"}"}`,
+		`{"content":"no time"}`,
+		`{"time":1719862212772,"content":"bad payload","payload":"not json"}`,
+	}, "\n"))
+	require.Equal(t, 1, rows.RowNums())
+	require.Equal(t, []string{"request"}, stringColumn(t, rows, Content))
+	idx := rows.Schema.FieldIndex("code")
+	require.True(t, idx >= 0)
+	require.Equal(t, []float64{200}, rows.ColVals[idx].FloatValues())
+	require.Equal(t, -1, rows.Schema.FieldIndex("p_secret"))
+	require.Equal(t, -1, rows.Schema.FieldIndex("payload"))
+	require.Equal(t, 2, failRows.RowNums())
+}
+
+func TestParseJsonArrayWithPipeline(t *testing.T) {
+	h := &Handler{
+		Logger: logger.NewLogger(errno.ModuleLogStore),
+	}
+	req := mockLogWriteRequest(`{"timestamp":"time"}`)
+	req.logSchema = logSchema
+	req.pipeline = mockPipeline(t,
+		&pipeline.Config{Type: pipeline.TypeRegex, Pattern: `took (?P<took>\d+)ms`},
+		&pipeline.Config{Type: pipeline.TypeTimestamp, Field: "ts", Format: "unix", IgnoreMissing: true},
+	)
+	body := `[{"ts":1719862212,"content":"took 35ms"},{"time":1719862212771,"content":"took 7ms"},{"time":1719862212771,"content":"slow"}]`
+	rows := &record.Record{}
+	failRows := record.NewRecord(failLogSchema, false)
+	_ = h.parseJsonArray(io.NopCloser(strings.NewReader(body)), req, rows, failRows)
+
+	require.Equal(t, 2, rows.RowNums())
+	require.Equal(t, []string{"35", "7"}, stringColumn(t, rows, "took"))
+	require.Equal(t, []int64{1719862212 * 1e9, 1719862212771 * 1e6}, rows.ColVals[rows.ColNums()-1].IntegerValues())
+	require.Equal(t, 1, failRows.RowNums())
+}
+
+func TestProcessUploadPipeline(t *testing.T) {
+	h := &Handler{
+		Logger: logger.NewLogger(errno.ModuleLogStore),
+	}
+	req := &LogWriteRequest{printFailLog: getPrintFailLog()}
+	req.pipeline = mockPipeline(t,
+		&pipeline.Config{Type: pipeline.TypeGrok, Pattern: `^%{TIMESTAMP_ISO8601:ts} %{LOGLEVEL:level} %{GREEDYDATA:content}`},
+		&pipeline.Config{Type: pipeline.TypeTimestamp, Field: "ts", Format: time.RFC3339, RemoveSource: true},
+	)
+	content, ts := h.processUploadPipeline([]byte(`2024-07-01T19:30:12Z ERROR disk full`), req)
+	require.JSONEq(t, `{"content":"disk full","level":"ERROR"}`, string(content))
+	require.Equal(t, time.Date(2024, 7, 1, 19, 30, 12, 0, time.UTC).UnixNano(), ts)
+
+	// the line not matching the pattern is uploaded as it is
+	content, ts = h.processUploadPipeline([]byte(`unstructured line`), req)
+	require.Equal(t, "unstructured line", string(content))
+	require.Equal(t, int64(0), ts)
+
+	req.pipeline = mockPipeline(t, &pipeline.Config{Type: pipeline.TypeRegex, Field: Content, Pattern: `^(?P<content>\S+)`})
+	content, _ = h.processUploadPipeline([]byte(`GET /index.html`), req)
+	require.Equal(t, "GET", string(content))
+}
+
+func TestLogStreamPipelineCache(t *testing.T) {
+	c := &pipelineCache{pipelines: make(map[string]*cachedPipeline)}
+	p, err := c.get("repo", "stream", nil)
+	require.NoError(t, err)
+	require.Nil(t, p)
+
+	options := &meta2.Options{Pipeline: []*pipeline.Config{{Type: pipeline.TypeDrop, Fields: []string{"a"}}}}
+	p1, err := c.get("repo", "stream", options)
+	require.NoError(t, err)
+	require.NotNil(t, p1)
+	// the options unmarshalled again from meta share the compiled pipeline
+	p2, err := c.get("repo", "stream", &meta2.Options{Pipeline: []*pipeline.Config{{Type: pipeline.TypeDrop, Fields: []string{"a"}}}})
+	require.NoError(t, err)
+	require.Same(t, p1, p2)
+
+	options = &meta2.Options{Pipeline: []*pipeline.Config{{Type: pipeline.TypeDrop, Fields: []string{"b"}}}}
+	p3, err := c.get("repo", "stream", options)
+	require.NoError(t, err)
+	require.NotSame(t, p1, p3)
+
+	p, err = c.get("repo", "stream", &meta2.Options{})
+	require.NoError(t, err)
+	require.Nil(t, p)
+	require.Empty(t, c.pipelines)
+}
+
+func TestValidateLogstreamPipeline(t *testing.T) {
+	opt := &meta2.Options{Ttl: 7}
+	opt.Pipeline = []*pipeline.Config{{Type: pipeline.TypeGrok, Pattern: "%{NOTHING:a}"}}
+	require.Error(t, validateLogstreamOptions(opt))
+
+	opt.Pipeline = []*pipeline.Config{{Type: pipeline.TypeGrok, Pattern: "%{WORD:a}"}}
+	require.NoError(t, validateLogstreamOptions(opt))
+}
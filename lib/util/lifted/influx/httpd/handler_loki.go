@@ -0,0 +1,434 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/golang/snappy"
+	"github.com/gorilla/mux"
+	compression "github.com/openGemini/openGemini/lib/compress"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logql"
+	"github.com/openGemini/openGemini/lib/record"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// lokiStream is a stream of the loki push request, the entries are the lines with their times and structured metadata.
+type lokiStream struct {
+	labels  map[string]string
+	entries []lokiEntry
+}
+
+type lokiEntry struct {
+	timestamp int64
+	line      string
+	metadata  map[string]string
+}
+
+type lokiPushRequest struct {
+	Streams []struct {
+		Stream map[string]string `json:"stream"`
+		// the values are [ "<unix epoch in nanoseconds>", "<log line>", {<structured metadata>} ]
+		Values [][]interface{} `json:"values"`
+	} `json:"streams"`
+}
+
+// decodeLokiPush decodes the body of the loki push request, which is the json request or the snappy compressed
+// protobuf request used by promtail by default.
+func decodeLokiPush(contentType string, b []byte) ([]*lokiStream, error) {
+	if strings.HasPrefix(contentType, "application/json") {
+		return decodeLokiPushJSON(b)
+	}
+	b, err := snappy.Decode(nil, b)
+	if err != nil {
+		return nil, fmt.Errorf("decode snappy body: %v", err)
+	}
+	return decodeLokiPushProto(b)
+}
+
+func decodeLokiPushJSON(b []byte) ([]*lokiStream, error) {
+	var req lokiPushRequest
+	if err := sonic.Unmarshal(b, &req); err != nil {
+		return nil, err
+	}
+	streams := make([]*lokiStream, 0, len(req.Streams))
+	for _, s := range req.Streams {
+		stream := &lokiStream{labels: s.Stream, entries: make([]lokiEntry, 0, len(s.Values))}
+		for _, v := range s.Values {
+			if len(v) != 2 && len(v) != 3 {
+				return nil, fmt.Errorf("the value of the stream %s must be [timestamp, line] or [timestamp, line, metadata]",
+					logql.FormatLabels(s.Stream))
+			}
+			ts, ok := v[0].(string)
+			if !ok {
+				return nil, fmt.Errorf("the timestamp of the stream %s must be a string", logql.FormatLabels(s.Stream))
+			}
+			var entry lokiEntry
+			var err error
+			if entry.timestamp, err = strconv.ParseInt(ts, 10, 64); err != nil {
+				return nil, fmt.Errorf("invalid timestamp %s: %v", ts, err)
+			}
+			if entry.line, ok = v[1].(string); !ok {
+				return nil, fmt.Errorf("the line of the stream %s must be a string", logql.FormatLabels(s.Stream))
+			}
+			if len(v) == 3 {
+				metadata, ok := v[2].(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("the metadata of the stream %s must be an object", logql.FormatLabels(s.Stream))
+				}
+				entry.metadata = make(map[string]string, len(metadata))
+				for k, mv := range metadata {
+					entry.metadata[k] = convertToString(mv)
+				}
+			}
+			stream.entries = append(stream.entries, entry)
+		}
+		streams = append(streams, stream)
+	}
+	return streams, nil
+}
+
+// decodeLokiPushProto decodes the protobuf push request of loki:
+//
+//	PushRequest { repeated StreamAdapter streams = 1; }
+//	StreamAdapter { string labels = 1; repeated EntryAdapter entries = 2; uint64 hash = 3; }
+//	EntryAdapter { google.protobuf.Timestamp timestamp = 1; string line = 2; repeated LabelPairAdapter structuredMetadata = 3; }
+//	LabelPairAdapter { string name = 1; string value = 2; }
+func decodeLokiPushProto(b []byte) ([]*lokiStream, error) {
+	var streams []*lokiStream
+	err := consumeProtoFields(b, func(num protowire.Number, v []byte, _ uint64) error {
+		if num != 1 {
+			return nil
+		}
+		stream, err := decodeLokiStream(v)
+		if err != nil {
+			return err
+		}
+		streams = append(streams, stream)
+		return nil
+	})
+	return streams, err
+}
+
+func decodeLokiStream(b []byte) (*lokiStream, error) {
+	stream := &lokiStream{}
+	var labels string
+	err := consumeProtoFields(b, func(num protowire.Number, v []byte, _ uint64) error {
+		switch num {
+		case 1:
+			labels = string(v)
+		case 2:
+			entry, err := decodeLokiEntry(v)
+			if err != nil {
+				return err
+			}
+			stream.entries = append(stream.entries, entry)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if stream.labels, err = logql.ParseLabels(labels); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func decodeLokiEntry(b []byte) (lokiEntry, error) {
+	var entry lokiEntry
+	err := consumeProtoFields(b, func(num protowire.Number, v []byte, _ uint64) error {
+		switch num {
+		case 1:
+			var seconds, nanos uint64
+			err := consumeProtoFields(v, func(num protowire.Number, _ []byte, x uint64) error {
+				switch num {
+				case 1:
+					seconds = x
+				case 2:
+					nanos = x
+				}
+				return nil
+			})
+			entry.timestamp = int64(seconds)*1e9 + int64(nanos)
+			return err
+		case 2:
+			entry.line = string(v)
+		case 3:
+			var name, value string
+			err := consumeProtoFields(v, func(num protowire.Number, v []byte, _ uint64) error {
+				switch num {
+				case 1:
+					name = string(v)
+				case 2:
+					value = string(v)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			if entry.metadata == nil {
+				entry.metadata = make(map[string]string)
+			}
+			entry.metadata[name] = value
+		}
+		return nil
+	})
+	return entry, err
+}
+
+// consumeProtoFields visits the fields of the protobuf message, the bytes fields are passed as v and
+// the varint fields are passed as x, the fields of the other types are skipped.
+func consumeProtoFields(b []byte, fn func(num protowire.Number, v []byte, x uint64) error) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+		var err error
+		switch typ {
+		case protowire.BytesType:
+			var v []byte
+			v, n = protowire.ConsumeBytes(b)
+			if n >= 0 {
+				err = fn(num, v, 0)
+			}
+		case protowire.VarintType:
+			var x uint64
+			x, n = protowire.ConsumeVarint(b)
+			if n >= 0 {
+				err = fn(num, nil, x)
+			}
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if err != nil {
+			return err
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// mergeLokiStreams merges the streams of the same labels, so that the entries of a label set are written by one request.
+func mergeLokiStreams(streams []*lokiStream) []*lokiStream {
+	merged := make(map[string]*lokiStream, len(streams))
+	keys := make([]string, 0, len(streams))
+	for _, s := range streams {
+		key := logql.FormatLabels(s.labels)
+		if m, ok := merged[key]; ok {
+			m.entries = append(m.entries, s.entries...)
+			continue
+		}
+		merged[key] = s
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := make([]*lokiStream, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, merged[key])
+	}
+	return result
+}
+
+// openLokiStream prepares the rows of the stream, the labels of the stream are the log tags of the rows.
+func (h *Handler) openLokiStream(base *LogWriteRequest, logInfo *meta2.RetentionPolicyInfo, labels map[string]string) (*bulkIndex, error) {
+	req := newBulkLogWriteRequest(base, base.repository, base.logStream)
+	mst := logInfo.Measurements[base.logStream+MstSuffix]
+	req.mstSchema = mst.Schema
+	req.pipeline = base.pipeline
+
+	logTags := ""
+	if len(labels) > 0 {
+		b, err := sonic.Marshal(labels)
+		if err != nil {
+			return nil, err
+		}
+		// the log tags are url decoded by parseLogTags
+		logTags = url.QueryEscape(string(b))
+	}
+	req.logTagString = &logTags
+	mst.SchemaLock.RLock()
+	logTagsMap, err := parseLogTags(req)
+	mst.SchemaLock.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	idx := newBulkIndex(req, record.LogStoreRecordPool.Get(), record.GetRecordFromPool(record.LogStoreFailRecordPool, failLogSchema), logTagsMap)
+	idx.logInfo = logInfo
+	return idx, nil
+}
+
+// appendLokiEntries appends the entries of the stream to the rows, the line is the content field and the structured
+// metadata are the other fields. The entries which can not be written are kept in the fail rows.
+func (h *Handler) appendLokiEntries(idx *bulkIndex, entries []lokiEntry) {
+	req := idx.req
+	for _, entry := range entries {
+		idx.totalLen += int64(len(entry.line)) + NewlineLen
+		if len(entry.line) > MaxContentLen {
+			appendBigLog(idx.failRows, req, []byte(entry.line))
+			continue
+		}
+		fields := make(map[string]interface{}, len(entry.metadata)+1)
+		for k, v := range entry.metadata {
+			fields[k] = v
+		}
+		fields[Content] = entry.line
+
+		unixTimestamp, err := h.processPipeline(fields, req)
+		if err != nil {
+			appendFailRow(idx.failRows, req, entry.line)
+			continue
+		}
+		if unixTimestamp == 0 {
+			unixTimestamp = entry.timestamp
+		}
+		if unixTimestamp, err = validateTimestamp(unixTimestamp, req); err != nil {
+			if req.failTag != ExpiredLogTag {
+				h.printFailLog(TimestampError, req, entry.line, nil)
+			}
+			appendFailRow(idx.failRows, req, entry.line)
+			continue
+		}
+		_ = h.appendLogFields(fields, unixTimestamp, req, idx.rows, idx.failRows, idx.pf, entry.line)
+	}
+}
+
+// serveLokiPush receives the streams of the loki push api and writes them to the log stream, the labels of the
+// streams are written as the log tags.
+func (h *Handler) serveLokiPush(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	handlerStat.WriteRequests.Incr()
+	handlerStat.ActiveWriteRequests.Incr()
+	handlerStat.WriteRequestBytesIn.Add(r.ContentLength)
+	start := time.Now()
+	defer func() {
+		handlerStat.ActiveWriteRequests.Decr()
+		handlerStat.WriteRequestDuration.Add(time.Since(start).Nanoseconds())
+	}()
+
+	repository := mux.Vars(r)[Repository]
+	logStream := mux.Vars(r)[LogStream]
+	if !h.IsWriteNode() {
+		h.Logger.Error("serveLokiPush checkNodeRole fail", zap.Error(ErrInvalidWriteNode))
+		h.lokiError(w, ErrInvalidWriteNode.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	if r.ContentLength > MaxRequestBodyLength {
+		h.lokiError(w, errno.NewError(errno.InvalidRequestBodyLength).Error(), http.StatusRequestEntityTooLarge)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		b, err := compression.GetGzipReader(r.Body)
+		if err != nil {
+			h.Logger.Error("serveLokiPush: Handle gzip decoding of the body err", zap.Error(err))
+			h.lokiError(w, err.Error(), http.StatusBadRequest)
+			handlerStat.Write400ErrRequests.Incr()
+			return
+		}
+		defer compression.PutGzipReader(b)
+		body = b
+	}
+	b, err := io.ReadAll(io.LimitReader(body, MaxRequestBodyLength+1))
+	if err == nil && int64(len(b)) > MaxRequestBodyLength {
+		err = errno.NewError(errno.InvalidRequestBodyLength)
+	}
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	streams, err := decodeLokiPush(r.Header.Get("Content-Type"), b)
+	if err != nil {
+		h.Logger.Error("serveLokiPush decode fail", zap.Error(err))
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+
+	logInfo, err := h.validateRetentionPolicy(repository, logStream)
+	if err != nil {
+		h.Logger.Error("serveLokiPush GetLogStreamByName fail", zap.Error(err), zap.String("repository", repository),
+			zap.String("logStream", logStream))
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	base := &LogWriteRequest{
+		repository:     repository,
+		logStream:      logStream,
+		failTag:        FailLogTag,
+		timeMultiplier: 1,
+		requestTime:    start.UnixNano(),
+		printFailLog:   getPrintFailLog(),
+		mapping:        &JsonMapping{discardFields: make(map[string]bool)},
+	}
+	if logInfo.Duration != 0 {
+		base.expiredTime = base.requestTime - logInfo.Duration.Nanoseconds()
+	}
+	base.pipeline, err = logStreamPipelines.get(repository, logStream, logInfo.Measurements[logStream+MstSuffix].Options)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+
+	for _, stream := range mergeLokiStreams(streams) {
+		idx, err := h.openLokiStream(base, logInfo, stream.labels)
+		if err != nil {
+			h.Logger.Error("serveLokiPush parseLogTags fail", zap.Error(err))
+			h.lokiError(w, fmt.Sprintf("stream %s: %v", logql.FormatLabels(stream.labels), err), http.StatusBadRequest)
+			handlerStat.Write400ErrRequests.Incr()
+			return
+		}
+		h.appendLokiEntries(idx, stream.entries)
+		// the later entries of the request are stamped after the fail rows of this stream
+		base.requestTime = idx.req.requestTime
+		swapTimeColumnToEnd(idx.rows, idx.failRows)
+		if err = h.writeLogRecords(idx.rows, idx.failRows, idx.req, idx.totalLen, logInfo); err != nil {
+			h.lokiError(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		addLogInsertStatistics(repository, logStream, idx.totalLen)
+	}
+	h.writeHeader(w, http.StatusNoContent)
+}
+
+// lokiError writes the error as the plain text body like loki.
+func (h *Handler) lokiError(w http.ResponseWriter, msg string, code int) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	h.writeHeader(w, code)
+	_, _ = io.WriteString(w, msg)
+}
@@ -0,0 +1,710 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/gorilla/mux"
+	"github.com/openGemini/openGemini/lib/logql"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/common/model"
+	"go.uber.org/zap"
+)
+
+const (
+	// LokiLabelName is the path parameter of the label of the label values api
+	LokiLabelName = "name"
+
+	lokiDefaultLimit = 100
+	lokiMaxLimit     = 5000
+	// lokiMaxScanLines is the max number of the lines scanned by a query whose filters are evaluated by the handler
+	lokiMaxScanLines = 100000
+	// lokiMaxPoints is the max number of the points of a series of the range query, which is also the limit of loki
+	lokiMaxPoints = 11000
+
+	lokiDefaultLookback      = time.Hour
+	lokiDefaultLabelLookback = 6 * time.Hour
+
+	// lokiHealthCheckQuery is the query sent by the loki datasource of grafana to test the connection
+	lokiHealthCheckQuery = "vector(1)+vector(1)"
+
+	lokiStatusSuccess    = "success"
+	lokiResultStreams    = "streams"
+	lokiResultMatrix     = "matrix"
+	lokiResultVector     = "vector"
+	lokiDirectionForward = "forward"
+)
+
+var errLokiScanLimit = fmt.Errorf("the query scans more than %d lines, narrow down the time range or the stream selector", lokiMaxScanLines)
+
+type lokiResponse struct {
+	Status string      `json:"status"`
+	Data   interface{} `json:"data"`
+}
+
+type lokiQueryData struct {
+	ResultType string      `json:"resultType"`
+	Result     interface{} `json:"result"`
+	Stats      struct{}    `json:"stats"`
+}
+
+type lokiStreamResult struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiMatrixResult struct {
+	Metric map[string]string `json:"metric"`
+	Values [][2]interface{}  `json:"values"`
+}
+
+type lokiVectorResult struct {
+	Metric map[string]string `json:"metric"`
+	Value  [2]interface{}    `json:"value"`
+}
+
+// lokiRow is a log of the query result, the line is the content field and the other fields are the labels.
+type lokiRow struct {
+	timestamp int64
+	line      string
+	labels    map[string]string
+	cursor    string
+}
+
+// lokiRows converts the rows of the query result, the logs without the content field are represented by the json
+// of their fields.
+func lokiRows(resp *Response) []lokiRow {
+	var rows []lokiRow
+	for _, result := range resp.Results {
+		for _, s := range result.Series {
+			for _, values := range s.Values {
+				row := lokiRow{labels: make(map[string]string, len(s.Columns))}
+				var shardID, seqID int64
+				var content interface{}
+				for i, c := range s.Columns {
+					v := values[i]
+					switch c {
+					case Time:
+						t, _ := v.(time.Time)
+						row.timestamp = t.UnixNano()
+					case record.SeqIDField:
+						seqID, _ = v.(int64)
+					case influxql.ShardIDField:
+						shardID, _ = v.(int64)
+					case Content:
+						content = v
+					case RetryTag:
+					default:
+						if v != nil {
+							row.labels[c] = convertToString(v)
+						}
+					}
+				}
+				if line, ok := content.(string); ok {
+					row.line = line
+				} else if content != nil {
+					row.line = Interface2str(content)
+				} else {
+					row.line, _ = sonic.MarshalString(row.labels)
+				}
+				// the cursor is the scroll id of the log like the cursor of serveQueryLog
+				row.cursor = base64.StdEncoding.EncodeToString([]byte(strconv.FormatInt(row.timestamp, 10) + "|" +
+					strconv.FormatInt(shardID, 10) + "|" + strconv.FormatInt(seqID, 10) + "^^"))
+				rows = append(rows, row)
+			}
+		}
+	}
+	return rows
+}
+
+// lokiCondition returns the condition of the matchers which select the exact values of the string fields of the
+// log stream, and reports whether all the matchers are pushed down. The query selects nothing if a field of the
+// equality matchers does not exist.
+func lokiCondition(matchers []*logql.Matcher, schema map[string]int8) (cond string, pushed bool, empty bool) {
+	var conds []string
+	pushed = true
+	for _, m := range matchers {
+		values := m.Values()
+		typ, ok := schema[m.Name]
+		if values != nil && !ok {
+			return "", false, true
+		}
+		if values == nil || typ != influx.Field_Type_String || m.Name == Content {
+			pushed = false
+			continue
+		}
+		var or []string
+		for _, v := range values {
+			// the last pipe of the query separates the sql, so the values with pipes are filtered by the handler
+			if strings.IndexByte(v, '|') >= 0 {
+				or = nil
+				break
+			}
+			or = append(or, influxql.QuoteIdent(m.Name)+" = "+influxql.QuoteString(v))
+		}
+		switch len(or) {
+		case 0:
+			pushed = false
+		case 1:
+			conds = append(conds, or[0])
+		default:
+			conds = append(conds, "("+strings.Join(or, " OR ")+")")
+		}
+	}
+	return strings.Join(conds, " AND "), pushed, false
+}
+
+func lokiWhere(cond string) string {
+	if cond == "" {
+		return ""
+	}
+	return " WHERE " + cond
+}
+
+// lokiBucket returns the interval of the buckets counted by the log store for the metric expression, which is the
+// greatest common divisor of the range, the step and the start, so that the range of every step is made up of whole
+// buckets. It returns 0 if the expression can not be evaluated by the buckets: the lines are filtered by the stages
+// or the matchers which are not pushed down, the bytes of the lines are summed up, the series are grouped by the
+// labels, or there are too many buckets.
+func lokiBucket(e *logql.MetricExpr, pushed bool, start, end, step int64) time.Duration {
+	if !pushed || len(e.Selector.Stages) > 0 || !e.Counting() || e.Aggregation == "" || len(e.Grouping) > 0 {
+		return 0
+	}
+	g := gcd(e.Range.Nanoseconds(), gcd(step, start))
+	if g < time.Second.Nanoseconds() || (end-start+e.Range.Nanoseconds())/g > lokiMaxPoints {
+		return 0
+	}
+	return time.Duration(g)
+}
+
+func gcd(a, b int64) int64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// openLokiLogStream validates the log stream of the loki api and returns the types of its fields.
+func (h *Handler) openLokiLogStream(r *http.Request) (*measurementInfo, map[string]int8, error) {
+	repository := mux.Vars(r)[Repository]
+	logStream := mux.Vars(r)[LogStream]
+	if err := h.ValidateAndCheckLogStreamExists(repository, logStream); err != nil {
+		return nil, nil, err
+	}
+	logInfo, err := h.validateRetentionPolicy(repository, logStream)
+	if err != nil {
+		return nil, nil, err
+	}
+	schema := make(map[string]int8)
+	if mst, ok := logInfo.Measurements[logStream+MstSuffix]; ok && mst.Schema != nil {
+		mst.SchemaLock.RLock()
+		for name, v := range *mst.Schema {
+			schema[name] = v.Typ
+		}
+		mst.SchemaLock.RUnlock()
+	}
+	return &measurementInfo{name: logStream, database: repository, retentionPolicy: logStream}, schema, nil
+}
+
+// scanLokiLogs runs the query in the pages of the shard groups in the order of the direction, the rows are passed
+// to fn until it returns false. It reports whether the scan is stopped by the max lines.
+func (h *Handler) scanLokiLogs(w http.ResponseWriter, r *http.Request, user meta2.User, info *measurementInfo,
+	query string, start, end int64, ascending bool, maxLines int, fn func(row *lokiRow) bool) (bool, error) {
+	sgs, err := h.MetaClient.GetShardGroupByTimeRange(info.database, info.retentionPolicy, time.Unix(0, start), time.Unix(0, end))
+	if err != nil {
+		if QuerySkippingError(err.Error()) {
+			return false, nil
+		}
+		return false, err
+	}
+	scanned := 0
+	for j := range sgs {
+		i := j
+		if !ascending {
+			i = len(sgs) - 1 - j
+		}
+		sgStart, sgEnd := max(start, sgs[i].StartTime.UnixNano()), min(end, sgs[i].EndTime.UnixNano())
+		cursor := ""
+		for {
+			pageSize := min(MaxLogLimit, maxLines-scanned)
+			if pageSize == 0 {
+				return true, nil
+			}
+			para := NewQueryPara(&QueryLogRequest{Query: query, Reverse: !ascending, Timeout: DefaultLogQueryTimeout,
+				Scroll_id: cursor, Limit: pageSize})
+			para.TimeRange = TimeRange{start: sgStart, end: sgEnd}
+			if err = para.parseScrollID(); err != nil {
+				return false, err
+			}
+			para.QueryID = para.Scroll_id
+			resp, _, _, _, err := h.serveLogQuery(w, r, para, user, info)
+			if err != nil {
+				if QuerySkippingError(err.Error()) {
+					break
+				}
+				return false, err
+			}
+			rows := lokiRows(resp)
+			scanned += len(rows)
+			for k := range rows {
+				if !fn(&rows[k]) {
+					return false, nil
+				}
+			}
+			if len(rows) < pageSize {
+				break
+			}
+			cursor = rows[len(rows)-1].cursor
+		}
+	}
+	return false, nil
+}
+
+// queryLokiStreams returns the lines of the selector grouped by their labels, the matchers which select the exact
+// values are pushed down to the log store and the others are evaluated with the stages on the scanned lines.
+func (h *Handler) queryLokiStreams(w http.ResponseWriter, r *http.Request, user meta2.User, info *measurementInfo,
+	schema map[string]int8, sel *logql.LogSelector, start, end int64, limit int, ascending bool) ([]*lokiStreamResult, error) {
+	cond, pushed, empty := lokiCondition(sel.Matchers, schema)
+	if empty {
+		return nil, nil
+	}
+	maxLines := limit
+	if !pushed || len(sel.Stages) > 0 {
+		maxLines = lokiMaxScanLines
+	}
+
+	streams := make(map[string]*lokiStreamResult)
+	var keys []string
+	count := 0
+	_, err := h.scanLokiLogs(w, r, user, info, "| SELECT *"+lokiWhere(cond), start, end, ascending, maxLines, func(row *lokiRow) bool {
+		if !sel.Match(row.line, row.labels) {
+			return true
+		}
+		key := logql.FormatLabels(row.labels)
+		s, ok := streams[key]
+		if !ok {
+			s = &lokiStreamResult{Stream: row.labels}
+			streams[key] = s
+			keys = append(keys, key)
+		}
+		s.Values = append(s.Values, [2]string{strconv.FormatInt(row.timestamp, 10), row.line})
+		count++
+		return count < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*lokiStreamResult, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, streams[key])
+	}
+	return result, nil
+}
+
+// queryLokiMetric evaluates the metric expression at the steps from start to end. The lines of the expressions
+// which can be evaluated by the buckets are counted by the log store, the others are scanned up to the max lines.
+func (h *Handler) queryLokiMetric(w http.ResponseWriter, r *http.Request, user meta2.User, info *measurementInfo,
+	schema map[string]int8, e *logql.MetricExpr, start, end, step int64) ([]*logql.Series, error) {
+	cond, pushed, empty := lokiCondition(e.Selector.Matchers, schema)
+	if empty {
+		return nil, nil
+	}
+	if g := lokiBucket(e, pushed, start, end, step); g > 0 {
+		samples, err := h.countLokiBuckets(w, r, user, info, cond, start-e.Range.Nanoseconds(), end, g)
+		if err != nil {
+			return nil, err
+		}
+		return e.Eval(samples, start, end, step), nil
+	}
+
+	var samples []logql.Sample
+	// the range of a step t is (t-range, t]
+	truncated, err := h.scanLokiLogs(w, r, user, info, "| SELECT *"+lokiWhere(cond), start-e.Range.Nanoseconds()+1, end+1,
+		true, lokiMaxScanLines, func(row *lokiRow) bool {
+			if e.Selector.Match(row.line, row.labels) {
+				samples = append(samples, logql.Sample{Timestamp: row.timestamp, Value: e.SampleValue(row.line), Labels: row.labels})
+			}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
+	if truncated {
+		return nil, errLokiScanLimit
+	}
+	return e.Eval(samples, start, end, step), nil
+}
+
+// countLokiBuckets counts the lines of the condition by the buckets from start to end. The buckets of the log store
+// are [b, b+g), they are taken as the samples at b+1 so that the range (t-range, t] of a step has the buckets in
+// [t-range, t), which differs from the lines only at the boundaries of the ranges.
+func (h *Handler) countLokiBuckets(w http.ResponseWriter, r *http.Request, user meta2.User, info *measurementInfo,
+	cond string, start, end int64, g time.Duration) ([]logql.Sample, error) {
+	query := "| SELECT count(time)" + lokiWhere(cond) + " GROUP BY time(" + influxql.FormatDuration(g) + ")"
+	sgs, err := h.MetaClient.GetShardGroupByTimeRange(info.database, info.retentionPolicy, time.Unix(0, start), time.Unix(0, end))
+	if err != nil {
+		if QuerySkippingError(err.Error()) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	counts := make(map[int64]float64)
+	for _, sg := range sgs {
+		para := NewQueryPara(&QueryLogRequest{Query: query, Timeout: DefaultLogQueryTimeout})
+		para.TimeRange = TimeRange{start: max(start, sg.StartTime.UnixNano()), end: min(end, sg.EndTime.UnixNano())}
+		if err = para.parseScrollID(); err != nil {
+			return nil, err
+		}
+		para.QueryID = para.Scroll_id
+		resp, _, _, _, err := h.serveLogQuery(w, r, para, user, info)
+		if err != nil {
+			if QuerySkippingError(err.Error()) {
+				continue
+			}
+			return nil, err
+		}
+		for _, result := range resp.Results {
+			for _, s := range result.Series {
+				for _, values := range s.Values {
+					t, ok := values[0].(time.Time)
+					if !ok || len(values) < 2 {
+						continue
+					}
+					if c := lokiNumber(values[1]); c > 0 {
+						counts[t.UnixNano()] += c
+					}
+				}
+			}
+		}
+	}
+	samples := make([]logql.Sample, 0, len(counts))
+	for b, c := range counts {
+		samples = append(samples, logql.Sample{Timestamp: b + 1, Value: c, Labels: map[string]string{}})
+	}
+	return samples, nil
+}
+
+func lokiNumber(v interface{}) float64 {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	case json.Number:
+		f, _ := v.Float64()
+		return f
+	default:
+		return 0
+	}
+}
+
+// lokiTime parses the time parameter, which is the unix epoch in nanoseconds or seconds, or the RFC3339 time.
+func lokiTime(value string, def time.Time) (int64, error) {
+	if value == "" {
+		return def.UnixNano(), nil
+	}
+	if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+		// the epoch of 10 digits or less is in seconds
+		if len(value) <= 10 {
+			return n * int64(time.Second), nil
+		}
+		return n, nil
+	}
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return int64(math.Round(f * float64(time.Second))), nil
+	}
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q to a valid timestamp", value)
+	}
+	return t.UnixNano(), nil
+}
+
+// lokiDuration parses the step parameter, which is the duration or the float number of seconds.
+func lokiDuration(value string) (int64, error) {
+	if d, err := model.ParseDuration(value); err == nil {
+		return int64(d), nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q to a valid duration", value)
+	}
+	return int64(math.Round(f * float64(time.Second))), nil
+}
+
+func lokiTimeRange(r *http.Request, lookback time.Duration) (int64, int64, error) {
+	now := time.Now()
+	end, err := lokiTime(r.FormValue("end"), now)
+	if err != nil {
+		return 0, 0, err
+	}
+	start, err := lokiTime(r.FormValue("start"), time.Unix(0, end).Add(-lookback))
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, fmt.Errorf("the end timestamp must not be before the start time")
+	}
+	return start, end, nil
+}
+
+func lokiLimit(r *http.Request) (int, error) {
+	value := r.FormValue("limit")
+	if value == "" {
+		return lokiDefaultLimit, nil
+	}
+	limit, err := strconv.Atoi(value)
+	if err != nil || limit <= 0 {
+		return 0, fmt.Errorf("limit must be a positive value")
+	}
+	if limit > lokiMaxLimit {
+		return 0, fmt.Errorf("max entries limit per query exceeded, limit > max_entries_limit (%d > %d)", limit, lokiMaxLimit)
+	}
+	return limit, nil
+}
+
+func lokiSeconds(t int64) float64 {
+	return float64(t) / float64(time.Second)
+}
+
+func lokiValue(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func lokiMatrix(series []*logql.Series) []*lokiMatrixResult {
+	result := make([]*lokiMatrixResult, 0, len(series))
+	for _, s := range series {
+		m := &lokiMatrixResult{Metric: s.Labels, Values: make([][2]interface{}, 0, len(s.Points))}
+		for _, p := range s.Points {
+			m.Values = append(m.Values, [2]interface{}{lokiSeconds(p.T), lokiValue(p.V)})
+		}
+		result = append(result, m)
+	}
+	return result
+}
+
+func lokiVector(series []*logql.Series) []*lokiVectorResult {
+	result := make([]*lokiVectorResult, 0, len(series))
+	for _, s := range series {
+		for _, p := range s.Points {
+			result = append(result, &lokiVectorResult{Metric: s.Labels, Value: [2]interface{}{lokiSeconds(p.T), lokiValue(p.V)}})
+		}
+	}
+	return result
+}
+
+func (h *Handler) lokiQueryError(w http.ResponseWriter, err error) {
+	h.Logger.Error("loki query fail", zap.Error(err))
+	if err == errLokiScanLimit || strings.Contains(err.Error(), ErrSyntax) || strings.Contains(err.Error(), ErrParsingQuery) {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.lokiError(w, err.Error(), http.StatusInternalServerError)
+}
+
+func (h *Handler) writeLokiResponse(w http.ResponseWriter, data interface{}) {
+	b, err := json2.Marshal(lokiResponse{Status: lokiStatusSuccess, Data: data})
+	if err != nil {
+		h.Logger.Error("loki query marshal res fail! ", zap.Error(err))
+		h.lokiError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	h.writeHeader(w, http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+// serveLokiQuery evaluates the metric query at a single time like the instant query api of loki.
+func (h *Handler) serveLokiQuery(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	info, schema, err := h.openLokiLogStream(r)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	ts, err := lokiTime(r.FormValue("time"), time.Now())
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	query := r.FormValue("query")
+	if strings.ReplaceAll(query, " ", "") == lokiHealthCheckQuery {
+		h.writeLokiResponse(w, &lokiQueryData{ResultType: lokiResultVector,
+			Result: []*lokiVectorResult{{Metric: map[string]string{}, Value: [2]interface{}{lokiSeconds(ts), "2"}}}})
+		return
+	}
+	expr, err := logql.Parse(query)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	e, ok := expr.(*logql.MetricExpr)
+	if !ok {
+		h.lokiError(w, "log queries are not supported as an instant query type, please change your query to a range query type",
+			http.StatusBadRequest)
+		return
+	}
+	series, err := h.queryLokiMetric(w, r, user, info, schema, e, ts, ts, 0)
+	if err != nil {
+		h.lokiQueryError(w, err)
+		return
+	}
+	h.writeLokiResponse(w, &lokiQueryData{ResultType: lokiResultVector, Result: lokiVector(series)})
+	addLogQueryStatistics(info.database, info.name)
+}
+
+// serveLokiQueryRange returns the streams of the log query or the matrix of the metric query like the range query
+// api of loki.
+func (h *Handler) serveLokiQueryRange(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	info, schema, err := h.openLokiLogStream(r)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	start, end, err := lokiTimeRange(r, lokiDefaultLookback)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	expr, err := logql.Parse(r.FormValue("query"))
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	switch e := expr.(type) {
+	case *logql.LogSelector:
+		limit, err := lokiLimit(r)
+		if err != nil {
+			h.lokiError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		// the end of the range query of the logs is exclusive
+		streams, err := h.queryLokiStreams(w, r, user, info, schema, e, start, end, limit, r.FormValue("direction") == lokiDirectionForward)
+		if err != nil {
+			h.lokiQueryError(w, err)
+			return
+		}
+		h.writeLokiResponse(w, &lokiQueryData{ResultType: lokiResultStreams, Result: streams})
+	case *logql.MetricExpr:
+		// the default step of loki has about 250 points
+		step := max(int64(math.Ceil(float64(end-start)/float64(time.Second)/250)), 1) * int64(time.Second)
+		if value := r.FormValue("step"); value != "" {
+			if step, err = lokiDuration(value); err != nil || step <= 0 {
+				h.lokiError(w, "zero or negative query resolution step widths are not accepted. Try a positive integer", http.StatusBadRequest)
+				return
+			}
+		}
+		if (end-start)/step+1 > lokiMaxPoints {
+			h.lokiError(w, fmt.Sprintf("exceeded maximum resolution of %d points per timeseries. Try decreasing the query resolution (?step=XX)",
+				lokiMaxPoints), http.StatusBadRequest)
+			return
+		}
+		series, err := h.queryLokiMetric(w, r, user, info, schema, e, start, end, step)
+		if err != nil {
+			h.lokiQueryError(w, err)
+			return
+		}
+		h.writeLokiResponse(w, &lokiQueryData{ResultType: lokiResultMatrix, Result: lokiMatrix(series)})
+	}
+	addLogQueryStatistics(info.database, info.name)
+}
+
+// serveLokiLabels returns the fields of the log stream as the labels, except the content and the internal fields.
+func (h *Handler) serveLokiLabels(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	_, schema, err := h.openLokiLogStream(r)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	labels := make([]string, 0, len(schema))
+	for name := range schema {
+		if name == Content || reservedFields[name] {
+			continue
+		}
+		labels = append(labels, name)
+	}
+	sort.Strings(labels)
+	h.writeLokiResponse(w, labels)
+}
+
+// serveLokiLabelValues returns the values of the label of the logs in the time range, the logs are selected by
+// the optional query. The values are collected from the scanned logs up to the max lines.
+func (h *Handler) serveLokiLabelValues(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	info, schema, err := h.openLokiLogStream(r)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		handlerStat.Write400ErrRequests.Incr()
+		return
+	}
+	start, end, err := lokiTimeRange(r, lokiDefaultLabelLookback)
+	if err != nil {
+		h.lokiError(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	name := mux.Vars(r)[LokiLabelName]
+	sel := &logql.LogSelector{}
+	if query := r.FormValue("query"); query != "" {
+		expr, err := logql.Parse(query)
+		if err != nil {
+			h.lokiError(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var ok bool
+		if sel, ok = expr.(*logql.LogSelector); !ok {
+			h.lokiError(w, "the query of the label values must be a log selector", http.StatusBadRequest)
+			return
+		}
+	}
+
+	values := []string{}
+	if _, ok := schema[name]; ok && name != Content {
+		cond, _, empty := lokiCondition(sel.Matchers, schema)
+		seen := make(map[string]bool)
+		if !empty {
+			_, err = h.scanLokiLogs(w, r, user, info, "| SELECT *"+lokiWhere(cond), start, end, false, lokiMaxScanLines, func(row *lokiRow) bool {
+				if v := row.labels[name]; v != "" && !seen[v] && sel.Match(row.line, row.labels) {
+					seen[v] = true
+					values = append(values, v)
+				}
+				return true
+			})
+		}
+		if err != nil {
+			h.lokiQueryError(w, err)
+			return
+		}
+	}
+	sort.Strings(values)
+	h.writeLokiResponse(w, values)
+}
@@ -0,0 +1,253 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/golang/snappy"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/logql"
+	"github.com/openGemini/openGemini/lib/pipeline"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+func TestDecodeLokiPushJSON(t *testing.T) {
+	streams, err := decodeLokiPush("application/json", []byte(`{"streams":[
+		{"stream":{"app":"web"},"values":[["1719862212000000000","GET /"],["1719862213000000000","POST /",{"trace_id":"abc"}]]},
+		{"stream":{"app":"api"},"values":[]}]}`))
+	require.NoError(t, err)
+	require.Len(t, streams, 2)
+	require.Equal(t, map[string]string{"app": "web"}, streams[0].labels)
+	require.Equal(t, []lokiEntry{
+		{timestamp: 1719862212000000000, line: "GET /"},
+		{timestamp: 1719862213000000000, line: "POST /", metadata: map[string]string{"trace_id": "abc"}},
+	}, streams[0].entries)
+
+	for _, body := range []string{
+		`{"streams":[{"stream":{"app":"web"},"values":[["1719862212000000000"]]}]}`,
+		`{"streams":[{"stream":{"app":"web"},"values":[[1719862212000000000,"GET /"]]}]}`,
+		`{"streams":[{"stream":{"app":"web"},"values":[["now","GET /"]]}]}`,
+		`{"streams":[{"stream":{"app":"web"},"values":[["1719862212000000000","GET /","x"]]}]}`,
+		`{"streams":`,
+	} {
+		_, err = decodeLokiPush("application/json; charset=utf-8", []byte(body))
+		require.Error(t, err, body)
+	}
+}
+
+func appendProtoBytes(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func TestDecodeLokiPushProto(t *testing.T) {
+	var ts []byte
+	ts = protowire.AppendTag(ts, 1, protowire.VarintType)
+	ts = protowire.AppendVarint(ts, 1719862212)
+	ts = protowire.AppendTag(ts, 2, protowire.VarintType)
+	ts = protowire.AppendVarint(ts, 5)
+
+	var metadata []byte
+	metadata = appendProtoBytes(metadata, 1, []byte("trace_id"))
+	metadata = appendProtoBytes(metadata, 2, []byte("abc"))
+
+	var entry []byte
+	entry = appendProtoBytes(entry, 1, ts)
+	entry = appendProtoBytes(entry, 2, []byte("GET /"))
+	entry = appendProtoBytes(entry, 3, metadata)
+
+	var stream []byte
+	stream = appendProtoBytes(stream, 1, []byte(`{app="web", env="prod"}`))
+	stream = appendProtoBytes(stream, 2, entry)
+	stream = protowire.AppendTag(stream, 3, protowire.VarintType)
+	stream = protowire.AppendVarint(stream, 12345)
+
+	body := snappy.Encode(nil, appendProtoBytes(nil, 1, stream))
+	streams, err := decodeLokiPush("application/x-protobuf", body)
+	require.NoError(t, err)
+	require.Len(t, streams, 1)
+	require.Equal(t, map[string]string{"app": "web", "env": "prod"}, streams[0].labels)
+	require.Equal(t, []lokiEntry{{timestamp: 1719862212000000005, line: "GET /", metadata: map[string]string{"trace_id": "abc"}}},
+		streams[0].entries)
+
+	_, err = decodeLokiPush("application/x-protobuf", []byte("not snappy"))
+	require.Error(t, err)
+	_, err = decodeLokiPush("application/x-protobuf", snappy.Encode(nil, appendProtoBytes(nil, 1, stream)[:10]))
+	require.Error(t, err)
+	_, err = decodeLokiPush("application/x-protobuf", snappy.Encode(nil, appendProtoBytes(nil, 1,
+		appendProtoBytes(nil, 1, []byte(`{app=~"web"}`)))))
+	require.Error(t, err)
+}
+
+func TestMergeLokiStreams(t *testing.T) {
+	streams := mergeLokiStreams([]*lokiStream{
+		{labels: map[string]string{"app": "web"}, entries: []lokiEntry{{line: "a"}}},
+		{labels: map[string]string{"app": "api"}, entries: []lokiEntry{{line: "b"}}},
+		{labels: map[string]string{"app": "web"}, entries: []lokiEntry{{line: "c"}}},
+	})
+	require.Len(t, streams, 2)
+	require.Equal(t, "api", streams[0].labels["app"])
+	require.Equal(t, []lokiEntry{{line: "a"}, {line: "c"}}, streams[1].entries)
+}
+
+func TestAppendLokiEntries(t *testing.T) {
+	h := &Handler{
+		Logger: logger.NewLogger(errno.ModuleLogStore),
+	}
+	base := &LogWriteRequest{failTag: FailLogTag, timeMultiplier: 1, requestTime: 1000, printFailLog: getPrintFailLog(),
+		mapping: &JsonMapping{discardFields: make(map[string]bool)}}
+	idx := newBulkIndex(newBulkLogWriteRequest(base, "repo", "app"), &record.Record{}, record.NewRecord(failLogSchema, false),
+		map[string][]byte{"app": []byte("web")})
+	h.appendLokiEntries(idx, []lokiEntry{
+		{timestamp: 1719862212000000000, line: "GET /"},
+		{timestamp: 1719862213000000000, line: "POST /", metadata: map[string]string{"trace_id": "abc"}},
+		{timestamp: 1, line: "too old"},
+	})
+	swapTimeColumnToEnd(idx.rows, idx.failRows)
+
+	require.Equal(t, []string{"GET /", "POST /"}, stringColumn(t, idx.rows, Content))
+	require.Equal(t, []string{"web", "web"}, stringColumn(t, idx.rows, "app"))
+	require.Equal(t, []string{"<nil>", "abc"}, stringColumn(t, idx.rows, "trace_id"))
+	require.Equal(t, []int64{1719862212000000000, 1719862213000000000}, idx.rows.ColVals[idx.rows.ColNums()-1].IntegerValues())
+	require.Equal(t, 1, idx.failRows.RowNums())
+}
+
+func TestAppendLokiEntriesWithPipeline(t *testing.T) {
+	h := &Handler{
+		Logger: logger.NewLogger(errno.ModuleLogStore),
+	}
+	base := &LogWriteRequest{failTag: FailLogTag, timeMultiplier: 1, requestTime: 1000, printFailLog: getPrintFailLog(),
+		mapping: &JsonMapping{discardFields: make(map[string]bool)}}
+	p, err := pipeline.New([]*pipeline.Config{{Type: pipeline.TypeKV, Field: Content}})
+	require.NoError(t, err)
+	// the pipeline of the log stream is set on the request of each stream, as openLokiStream does
+	req := newBulkLogWriteRequest(base, "repo", "app")
+	req.pipeline = p
+	idx := newBulkIndex(req, &record.Record{}, record.NewRecord(failLogSchema, false), nil)
+	h.appendLokiEntries(idx, []lokiEntry{
+		{timestamp: 1719862212000000000, line: "user=alice status=500"},
+	})
+	swapTimeColumnToEnd(idx.rows, idx.failRows)
+
+	require.Equal(t, []string{"alice"}, stringColumn(t, idx.rows, "user"))
+	require.Equal(t, []string{"500"}, stringColumn(t, idx.rows, "status"))
+}
+
+func TestLokiRows(t *testing.T) {
+	ts := time.Unix(1719862212, 5)
+	resp := &Response{Results: []*query.Result{{Series: models.Rows{{
+		Name:    "app",
+		Columns: []string{Time, Content, "app", "status", RetryTag, influxql.ShardIDField, record.SeqIDField},
+		Values: [][]interface{}{
+			{ts, "GET /", "web", int64(200), false, int64(3), int64(7)},
+			{ts, nil, "web", nil, false, int64(3), int64(8)},
+		},
+	}}}}}
+	rows := lokiRows(resp)
+	require.Len(t, rows, 2)
+	require.Equal(t, ts.UnixNano(), rows[0].timestamp)
+	require.Equal(t, "GET /", rows[0].line)
+	require.Equal(t, map[string]string{"app": "web", "status": "200"}, rows[0].labels)
+	cursor, err := base64.StdEncoding.DecodeString(rows[0].cursor)
+	require.NoError(t, err)
+	require.Equal(t, "1719862212000000005|3|7^^", string(cursor))
+	// the log without content is represented by its fields
+	require.Equal(t, `{"app":"web"}`, rows[1].line)
+}
+
+func TestLokiCondition(t *testing.T) {
+	schema := map[string]int8{"app": influx.Field_Type_String, "env": influx.Field_Type_String, "status": influx.Field_Type_Int,
+		Content: influx.Field_Type_String}
+	parse := func(query string) []*logql.Matcher {
+		expr, err := logql.Parse(query)
+		require.NoError(t, err)
+		return expr.(*logql.LogSelector).Matchers
+	}
+
+	cond, pushed, empty := lokiCondition(parse(`{app="it's", env=~"prod|staging"}`), schema)
+	require.Equal(t, `app = 'it\'s' AND (env = 'prod' OR env = 'staging')`, cond)
+	require.True(t, pushed)
+	require.False(t, empty)
+
+	for _, query := range []string{`{app="web", env!="dev"}`, `{app="web", status="200"}`, `{app="web", env="a|b"}`,
+		`{app="web", env=~"prod.*"}`, `{app="web", content="GET /"}`} {
+		cond, pushed, empty = lokiCondition(parse(query), schema)
+		require.Equal(t, `app = 'web'`, cond, query)
+		require.False(t, pushed, query)
+		require.False(t, empty, query)
+	}
+
+	_, _, empty = lokiCondition(parse(`{app="web", host="a"}`), schema)
+	require.True(t, empty)
+	_, pushed, empty = lokiCondition(parse(`{host!="a"}`), schema)
+	require.False(t, pushed)
+	require.False(t, empty)
+}
+
+func TestLokiBucket(t *testing.T) {
+	parse := func(query string) *logql.MetricExpr {
+		expr, err := logql.Parse(query)
+		require.NoError(t, err)
+		return expr.(*logql.MetricExpr)
+	}
+	sec := int64(time.Second)
+	e := parse(`sum(count_over_time({app="web"}[5m]))`)
+	require.Equal(t, time.Minute, lokiBucket(e, true, 1719862200*sec, 1719865800*sec, 60*sec))
+	require.Equal(t, 30*time.Second, lokiBucket(e, true, 1719862230*sec, 1719865830*sec, 60*sec))
+	require.Equal(t, time.Duration(0), lokiBucket(e, false, 1719862200*sec, 1719865800*sec, 60*sec))
+	require.Equal(t, time.Duration(0), lokiBucket(e, true, 1719862200*sec+1, 1719865800*sec, 60*sec))
+	require.Equal(t, time.Duration(0), lokiBucket(e, true, 0, 86400*sec, sec))
+
+	for _, query := range []string{`count_over_time({app="web"}[5m])`, `sum by (level) (count_over_time({app="web"}[5m]))`,
+		`sum(count_over_time({app="web"} |= "error" [5m]))`, `sum(bytes_over_time({app="web"}[5m]))`} {
+		require.Equal(t, time.Duration(0), lokiBucket(parse(query), true, 1719862200*sec, 1719865800*sec, 60*sec), query)
+	}
+}
+
+func TestLokiTime(t *testing.T) {
+	def := time.Unix(100, 0)
+	for value, expect := range map[string]int64{
+		"":                               100e9,
+		"1719862212":                     1719862212e9,
+		"1719862212000000005":            1719862212000000005,
+		"1719862212.5":                   1719862212500000000,
+		"2024-07-01T19:30:12.000000005Z": 1719862212000000005,
+	} {
+		ts, err := lokiTime(value, def)
+		require.NoError(t, err, value)
+		require.Equal(t, expect, ts, value)
+	}
+	_, err := lokiTime("yesterday", def)
+	require.Error(t, err)
+
+	step, err := lokiDuration("1m")
+	require.NoError(t, err)
+	require.Equal(t, int64(time.Minute), step)
+	step, err = lokiDuration("0.5")
+	require.NoError(t, err)
+	require.Equal(t, int64(500*time.Millisecond), step)
+	_, err = lokiDuration("fast")
+	require.Error(t, err)
+}
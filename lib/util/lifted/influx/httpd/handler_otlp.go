@@ -0,0 +1,327 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	compression "github.com/openGemini/openGemini/lib/compress"
+	"github.com/openGemini/openGemini/lib/netstorage"
+	"github.com/openGemini/openGemini/lib/otlp"
+	"github.com/openGemini/openGemini/lib/syscontrol"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/validation"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	otlpContentTypeJSON     = "application/json"
+	otlpContentTypeProtobuf = "application/x-protobuf"
+)
+
+var converterPool sync.Pool
+
+func getConverter() *otlp.Converter {
+	c, ok := converterPool.Get().(*otlp.Converter)
+	if !ok {
+		return &otlp.Converter{}
+	}
+	return c
+}
+
+func putConverter(c *otlp.Converter) {
+	c.Reset()
+	converterPool.Put(c)
+}
+
+// serveOtlpMetricsWrite receives metrics in the OTLP/HTTP protocol and writes into the database
+func (h *Handler) serveOtlpMetricsWrite(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	h.serveOtlpMetricsWriteBase(w, r, user, EmptyPromMst, timeSeries2Rows)
+}
+
+// serveOtlpMetricsWriteWithMetricStore receives metrics in the OTLP/HTTP protocol and writes into the metric store
+func (h *Handler) serveOtlpMetricsWriteWithMetricStore(w http.ResponseWriter, r *http.Request, user meta2.User) {
+	mst, ok := getMstByProm(h, w, r)
+	if !ok {
+		return
+	}
+	h.serveOtlpMetricsWriteBase(w, r, user, mst, timeSeries2RowsV2)
+}
+
+func (h *Handler) serveOtlpMetricsWriteBase(w http.ResponseWriter, r *http.Request, user meta2.User, mst string, tansFunc timeSeries2RowsFunc) {
+	handlerStat.WriteRequests.Incr()
+	handlerStat.ActiveWriteRequests.Incr()
+	handlerStat.WriteRequestBytesIn.Add(r.ContentLength)
+	defer func(start time.Time) {
+		d := time.Since(start).Nanoseconds()
+		handlerStat.ActiveWriteRequests.Decr()
+		handlerStat.WriteRequestDuration.Add(d)
+	}(time.Now())
+
+	db, rp, ok := h.checkPromWrite(w, r, user)
+	if !ok {
+		return
+	}
+
+	if h.Config.MaxBodySize > 0 && r.ContentLength > int64(h.Config.MaxBodySize) {
+		h.httpError(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		h.Logger.Error("serveOtlpMetricsWrite error: request entity too large")
+		return
+	}
+
+	body := r.Body
+	if h.Config.MaxBodySize > 0 {
+		body = truncateReader(body, int64(h.Config.MaxBodySize))
+	}
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		b, err := compression.GetGzipReader(body)
+		if err != nil {
+			h.httpError(w, err.Error(), http.StatusBadRequest)
+			h.Logger.Error("serveOtlpMetricsWrite error", zap.Error(err))
+			return
+		}
+		defer compression.PutGzipReader(b)
+		body = b
+	}
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusBadRequest)
+		h.Logger.Error("serveOtlpMetricsWrite error", zap.Error(err))
+		return
+	}
+	if err = validation.AllowBytes(tenantID(user, db), len(buf)); err != nil {
+		h.httpError(w, err.Error(), http.StatusTooManyRequests)
+		h.Logger.Warn("serveOtlpMetricsWrite: rejected by the tenant limits", zap.Error(err))
+		return
+	}
+
+	isJSON := strings.HasPrefix(r.Header.Get("Content-Type"), otlpContentTypeJSON)
+	req := &colmetricspb.ExportMetricsServiceRequest{}
+	if isJSON {
+		err = protojson.Unmarshal(buf, req)
+	} else {
+		err = proto.Unmarshal(buf, req)
+	}
+	if err != nil {
+		h.httpError(w, fmt.Sprintf("cannot unmarshal OTLP metrics: %s", err), http.StatusBadRequest)
+		h.Logger.Error("serveOtlpMetricsWrite error", zap.Error(err))
+		return
+	}
+
+	code, resp, err := h.writeOtlpMetrics(tenantID(user, db), db, rp, mst, req, tansFunc)
+	if err != nil {
+		h.httpError(w, err.Error(), code)
+		h.Logger.Error("serveOtlpMetricsWrite error", zap.Error(err))
+		return
+	}
+
+	var b []byte
+	if isJSON {
+		w.Header().Set("Content-Type", otlpContentTypeJSON)
+		b, err = protojson.Marshal(resp)
+	} else {
+		w.Header().Set("Content-Type", otlpContentTypeProtobuf)
+		b, err = proto.Marshal(resp)
+	}
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	h.writeHeader(w, http.StatusOK)
+	_, _ = w.Write(b)
+}
+
+// writeOtlpMetrics converts the metrics of req to prometheus time series and
+// writes them like the prometheus remote write does. The data points which cannot
+// be converted or are rejected by the limits are reported as a partial success.
+func (h *Handler) writeOtlpMetrics(tenant, db, rp, mst string, req *colmetricspb.ExportMetricsServiceRequest, tansFunc timeSeries2RowsFunc) (int, *colmetricspb.ExportMetricsServiceResponse, error) {
+	c := getConverter()
+	defer putConverter(c)
+
+	resp := &colmetricspb.ExportMetricsServiceResponse{}
+	tss := c.Convert(req.GetResourceMetrics())
+	rejected := c.Dropped
+	var partialErr error
+	code, err := h.writePromTimeSeries(tenant, db, rp, mst, tss, tansFunc)
+	if werr, ok := err.(netstorage.PartialWriteError); ok {
+		rejected += int64(werr.Dropped)
+		partialErr = werr.Reason
+	} else if err != nil {
+		if code != 0 {
+			return code, nil, err
+		}
+		if !errors.Is(err, ErrNoSamples) {
+			// every series is rejected by the limits
+			return http.StatusBadRequest, nil, err
+		}
+	}
+	if rejected > 0 {
+		resp.PartialSuccess = &colmetricspb.ExportMetricsPartialSuccess{RejectedDataPoints: rejected}
+		if partialErr != nil {
+			resp.PartialSuccess.ErrorMessage = partialErr.Error()
+		} else {
+			resp.PartialSuccess.ErrorMessage = "data points without value or with unsupported exponential histogram scale are dropped"
+		}
+	}
+	return http.StatusOK, resp, nil
+}
+
+// checkPromWrite checks whether user is allowed to write into the database of a
+// remote write request, it returns the db and rp to write.
+func (h *Handler) checkPromWrite(w http.ResponseWriter, r *http.Request, user meta2.User) (string, string, bool) {
+	db, rp, code, err := h.authorizePromWrite(r, user)
+	if err != nil {
+		h.httpError(w, err.Error(), code)
+		h.Logger.Error("remote write error", zap.Error(err), zap.String("db", db))
+		return "", "", false
+	}
+	return db, rp, true
+}
+
+// authorizePromWrite returns the db and rp of a remote write request, or the error
+// and the http status if user is not allowed to write into the database.
+func (h *Handler) authorizePromWrite(r *http.Request, user meta2.User) (string, string, int, error) {
+	db, rp := getDbRpByProm(h, r)
+	if syscontrol.DisableWrites {
+		return db, rp, http.StatusForbidden, errors.New("disable write!")
+	}
+
+	if _, err := h.MetaClient.Database(db); err != nil {
+		return db, rp, http.StatusNotFound, err
+	}
+
+	if h.Config.AuthEnabled {
+		if user == nil {
+			return db, rp, http.StatusForbidden, fmt.Errorf("user is required to write to database %q", db)
+		}
+
+		if err := h.WriteAuthorizer.AuthorizeWrite(user.ID(), db); err != nil {
+			return db, rp, http.StatusForbidden, fmt.Errorf("%q user is not authorized to write to database %q", user.ID(), db)
+		}
+	}
+	return db, rp, 0, nil
+}
+
+// otlpMetricsServer serves the OTLP/gRPC metrics export. The database, retention
+// policy and metric store are passed by the db, rp and metric_store metadata,
+// the credentials by the authorization metadata as for the http api.
+type otlpMetricsServer struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+	h *Handler
+}
+
+func newOtlpGRPCServer(h *Handler, opts ...grpc.ServerOption) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+	colmetricspb.RegisterMetricsServiceServer(srv, &otlpMetricsServer{h: h})
+	return srv
+}
+
+func (s *otlpMetricsServer) Export(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	handlerStat.WriteRequests.Incr()
+	handlerStat.ActiveWriteRequests.Incr()
+	defer func(start time.Time) {
+		d := time.Since(start).Nanoseconds()
+		handlerStat.ActiveWriteRequests.Decr()
+		handlerStat.WriteRequestDuration.Add(d)
+	}(time.Now())
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	r := &http.Request{URL: &url.URL{RawQuery: url.Values{
+		"db": md.Get("db"),
+		"rp": md.Get("rp"),
+	}.Encode()}, Header: http.Header{}}
+	if auth := md.Get("authorization"); len(auth) > 0 {
+		r.Header.Set("Authorization", auth[0])
+	}
+
+	user, err := s.authenticate(r)
+	if err != nil {
+		return nil, grpcstatus.Error(codes.Unauthenticated, err.Error())
+	}
+	db, rp, code, err := s.h.authorizePromWrite(r, user)
+	if err != nil {
+		s.h.Logger.Error("otlp grpc export error", zap.Error(err), zap.String("db", db))
+		return nil, grpcstatus.Error(httpStatus2Code(code), err.Error())
+	}
+
+	mst, tansFunc := EmptyPromMst, timeSeries2Rows
+	if v := md.Get(MetricStore); len(v) > 0 && strings.TrimSpace(v[0]) != "" {
+		mst, tansFunc = strings.TrimSpace(v[0]), timeSeries2RowsV2
+	}
+	tenant := tenantID(user, db)
+	if err = validation.AllowBytes(tenant, proto.Size(req)); err != nil {
+		return nil, grpcstatus.Error(codes.ResourceExhausted, err.Error())
+	}
+	code, resp, err := s.h.writeOtlpMetrics(tenant, db, rp, mst, req, tansFunc)
+	if err != nil {
+		s.h.Logger.Error("otlp grpc export error", zap.Error(err))
+		return nil, grpcstatus.Error(httpStatus2Code(code), err.Error())
+	}
+	return resp, nil
+}
+
+// authenticate authenticates the user of an OTLP/gRPC request if the authentication is enabled.
+func (s *otlpMetricsServer) authenticate(r *http.Request) (meta2.User, error) {
+	h := s.h
+	if !h.Config.AuthEnabled || !h.MetaClient.AdminUserExists() {
+		return nil, nil
+	}
+	creds, err := ParseCredentials(r)
+	if err == nil && (creds.Method != UserAuthentication || creds.Username == "") {
+		err = errors.New("username and password are required")
+	}
+	if err != nil {
+		handlerStat.AuthenticationFailures.Incr()
+		return nil, err
+	}
+	user, err := h.MetaClient.Authenticate(creds.Username, creds.Password)
+	if err != nil {
+		handlerStat.AuthenticationFailures.Incr()
+		return nil, errors.New("authorization failed")
+	}
+	return user, nil
+}
+
+func httpStatus2Code(code int) codes.Code {
+	switch code {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
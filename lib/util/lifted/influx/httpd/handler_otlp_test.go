@@ -0,0 +1,184 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/openGemini/openGemini/coordinator"
+	"github.com/openGemini/openGemini/lib/metaclient"
+	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func newOtlpTestRequest(ts int64) *colmetricspb.ExportMetricsServiceRequest {
+	return &colmetricspb.ExportMetricsServiceRequest{ResourceMetrics: []*metricspb.ResourceMetrics{{
+		Resource: &resourcepb.Resource{Attributes: []*commonpb.KeyValue{{
+			Key: "service.name", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: "api"}},
+		}}},
+		ScopeMetrics: []*metricspb.ScopeMetrics{{
+			Metrics: []*metricspb.Metric{{
+				Name: "http.requests",
+				Data: &metricspb.Metric_Sum{Sum: &metricspb.Sum{
+					AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_CUMULATIVE,
+					DataPoints: []*metricspb.NumberDataPoint{{
+						TimeUnixNano: uint64(ts),
+						Value:        &metricspb.NumberDataPoint_AsInt{AsInt: 3},
+					}},
+				}},
+			}},
+		}},
+	}}}
+}
+
+func mockOtlpWrite(t *testing.T, rows *[]influx.Row) func() {
+	var cli *metaclient.Client
+	mockMeta := gomonkey.ApplyMethod(reflect.TypeOf(cli), "Database", func(_ *metaclient.Client, name string) (*meta2.DatabaseInfo, error) {
+		return &meta2.DatabaseInfo{DefaultRetentionPolicy: "autogen"}, nil
+	})
+	var pw *coordinator.PointsWriter
+	mockPw := gomonkey.ApplyMethod(reflect.TypeOf(pw), "RetryWritePointRows", func(_ *coordinator.PointsWriter, database, retentionPolicy string, points []influx.Row) error {
+		assert.Equal(t, "otel", database)
+		assert.Equal(t, "autogen", retentionPolicy)
+		for i := range points {
+			r := influx.Row{}
+			r.Clone(&points[i])
+			*rows = append(*rows, r)
+		}
+		return nil
+	})
+	return func() {
+		mockMeta.Reset()
+		mockPw.Reset()
+	}
+}
+
+func TestHandlerOtlpMetricsWrite(t *testing.T) {
+	h := NewTestHandle()
+	var rows []influx.Row
+	cancel := mockOtlpWrite(t, &rows)
+	defer cancel()
+
+	now := time.Now().Truncate(time.Millisecond).UnixNano()
+	check := func() {
+		require.Equal(t, 1, len(rows))
+		assert.Equal(t, "http_requests", rows[0].Name)
+		assert.Equal(t, now, rows[0].Timestamp)
+		assert.Equal(t, "service_name", rows[0].Tags[1].Key)
+		assert.Equal(t, float64(3), rows[0].Fields[0].NumValue)
+		rows = rows[:0]
+	}
+
+	t.Run("protobuf", func(t *testing.T) {
+		body, err := proto.Marshal(newOtlpTestRequest(now))
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/otlp/v1/metrics?db=otel", bytes.NewReader(body))
+		req.Header.Set("Content-Type", otlpContentTypeProtobuf)
+		h.serveOtlpMetricsWrite(w, req, nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, otlpContentTypeProtobuf, w.Header().Get("Content-Type"))
+		check()
+	})
+
+	t.Run("json", func(t *testing.T) {
+		body, err := protojson.Marshal(newOtlpTestRequest(now))
+		require.NoError(t, err)
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/otlp/v1/metrics?db=otel", bytes.NewReader(body))
+		req.Header.Set("Content-Type", otlpContentTypeJSON)
+		h.serveOtlpMetricsWrite(w, req, nil)
+		require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Equal(t, "{}", w.Body.String())
+		check()
+	})
+
+	t.Run("invalid body", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/otlp/v1/metrics?db=otel", bytes.NewReader([]byte("{")))
+		req.Header.Set("Content-Type", otlpContentTypeJSON)
+		h.serveOtlpMetricsWrite(w, req, nil)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("grpc", func(t *testing.T) {
+		srv := &otlpMetricsServer{h: &h}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("db", "otel"))
+		resp, err := srv.Export(ctx, newOtlpTestRequest(now))
+		require.NoError(t, err)
+		assert.Nil(t, resp.GetPartialSuccess())
+		check()
+	})
+
+	t.Run("rejected by limits", func(t *testing.T) {
+		req := newOtlpTestRequest(now)
+		metrics := req.ResourceMetrics[0].ScopeMetrics[0]
+		old := proto.Clone(metrics.Metrics[0]).(*metricspb.Metric)
+		old.Name = "http.requests.old"
+		old.GetSum().DataPoints[0].TimeUnixNano = uint64(now - int64(15*24*time.Hour))
+		metrics.Metrics = append(metrics.Metrics, old)
+
+		srv := &otlpMetricsServer{h: &h}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("db", "otel"))
+		resp, err := srv.Export(ctx, req)
+		require.NoError(t, err)
+		require.NotNil(t, resp.GetPartialSuccess())
+		assert.Equal(t, int64(1), resp.GetPartialSuccess().GetRejectedDataPoints())
+		assert.Contains(t, resp.GetPartialSuccess().GetErrorMessage(), "timestamp too old")
+		check()
+	})
+
+	t.Run("grpc database not found", func(t *testing.T) {
+		var cli *metaclient.Client
+		patch := gomonkey.ApplyMethodReturn(cli, "Database", nil, errors.New("database not found"))
+		defer patch.Reset()
+
+		srv := &otlpMetricsServer{h: &h}
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("db", "otel"))
+		_, err := srv.Export(ctx, newOtlpTestRequest(now))
+		assert.Equal(t, codes.NotFound, grpcstatus.Code(err))
+	})
+
+	t.Run("grpc auth", func(t *testing.T) {
+		h := NewTestHandle()
+		h.Config.AuthEnabled = true
+		var cli *metaclient.Client
+		patch := gomonkey.ApplyMethodReturn(cli, "AdminUserExists", true)
+		defer patch.Reset()
+
+		srv := &otlpMetricsServer{h: &h}
+		_, err := srv.Export(context.Background(), newOtlpTestRequest(now))
+		assert.Equal(t, codes.Unauthenticated, grpcstatus.Code(err))
+	})
+}
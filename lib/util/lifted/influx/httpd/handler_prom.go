@@ -43,11 +43,13 @@ import (
 	"github.com/openGemini/openGemini/lib/crypto"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/netstorage"
 	"github.com/openGemini/openGemini/lib/obs"
 	"github.com/openGemini/openGemini/lib/pool"
 	"github.com/openGemini/openGemini/lib/proxy"
 	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/syscontrol"
+	"github.com/openGemini/openGemini/lib/tracing"
 	"github.com/openGemini/openGemini/lib/util/lifted/hashicorp/serf/serf"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
@@ -123,33 +125,11 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 		handlerStat.WriteRequestDuration.Add(d)
 	}(time.Now())
 
-	if syscontrol.DisableWrites {
-		h.httpError(w, `disable write!`, http.StatusForbidden)
-		h.Logger.Error("write is forbidden!", zap.Bool("DisableWrites", syscontrol.DisableWrites))
-		return
-	}
-
-	db, rp := getDbRpByProm(h, r)
-	if _, err := h.MetaClient.Database(db); err != nil {
-		h.httpError(w, fmt.Sprintf(err.Error()), http.StatusNotFound)
-		h.Logger.Error("servePromWriteBase error", zap.Error(err))
+	db, rp, ok := h.checkPromWrite(w, r, user)
+	if !ok {
 		return
 	}
 
-	if h.Config.AuthEnabled {
-		if user == nil {
-			h.httpError(w, fmt.Sprintf("user is required to write to database %q", db), http.StatusForbidden)
-			h.Logger.Error("user is required to write to database", zap.String("db", db))
-			return
-		}
-
-		if err := h.WriteAuthorizer.AuthorizeWrite(user.ID(), db); err != nil {
-			h.httpError(w, fmt.Sprintf("%q user is not authorized to write to database %q", user.ID(), db), http.StatusForbidden)
-			h.Logger.Error("servePromWriteBase error", zap.Error(err))
-			return
-		}
-	}
-
 	body := r.Body
 	if h.Config.MaxBodySize > 0 {
 		body = truncateReader(body, int64(h.Config.MaxBodySize))
@@ -168,42 +148,27 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 		return
 	}
 
+	tenant := tenantID(user, db)
+	if err := validation.AllowBytes(tenant, int(r.ContentLength)); err != nil {
+		h.httpError(w, err.Error(), http.StatusTooManyRequests)
+		h.Logger.Warn("servePromWriteBase: rejected by the tenant limits", zap.Error(err))
+		return
+	}
+
 	err := Parser.ParseStream(body, func(tss []prompb2.TimeSeries) error {
-		var maxPoints int
-		var err error
-		inValidTs, partialErr := h.FilterInvalidTimeSeries(mst, tss)
-		for i, ts := range tss {
-			if inValidTs[i] {
-				continue
-			}
-			maxPoints += len(ts.Samples)
-		}
-		if maxPoints == 0 {
-			if partialErr != nil {
-				return partialErr
-			}
-			return ErrNoSamples
+		status, err := h.writePromTimeSeries(tenant, db, rp, mst, tss, tansFunc)
+		if werr, ok := err.(netstorage.PartialWriteError); ok {
+			h.Logger.Error("servePromWriteBase partial error:", zap.Error(werr))
+			h.httpError(w, werr.Reason.Error(), status)
+			return nil
 		}
-		rs := pool.GetRows(maxPoints)
-		*rs = (*rs)[:maxPoints]
-		defer pool.PutRows(rs)
-		*rs, err = tansFunc(mst, *rs, tss, inValidTs)
 		if err != nil {
-			h.httpError(w, err.Error(), http.StatusBadRequest)
+			if status != 0 {
+				h.httpError(w, err.Error(), status)
+			}
 			return err
 		}
-
-		if err = h.PointsWriter.RetryWritePointRows(db, rp, *rs); influxdb.IsClientError(err) {
-			h.httpError(w, err.Error(), http.StatusBadRequest)
-		} else if influxdb.IsAuthorizationError(err) {
-			h.httpError(w, err.Error(), http.StatusForbidden)
-		} else if err != nil {
-			h.httpError(w, err.Error(), http.StatusInternalServerError)
-		} else if partialErr != nil {
-			h.Logger.Error("servePromWriteBase partial error:", zap.Error(partialErr))
-			h.httpError(w, partialErr.Error(), http.StatusBadRequest)
-		}
-		return err
+		return nil
 	})
 
 	if err != nil {
@@ -214,6 +179,54 @@ func (h *Handler) servePromWriteBase(w http.ResponseWriter, r *http.Request, use
 	h.writeHeader(w, http.StatusNoContent)
 }
 
+// writePromTimeSeries drops the series of tss rejected by the per-tenant limits,
+// converts the others to rows and writes them into db.rp if the rate limits of the
+// tenant allow it.
+// If some series are dropped while the others are written, a netstorage.PartialWriteError
+// is returned with the first error of the dropped series and the number of their data points.
+// If the write fails, the http status matching err is returned, the status is 0 when
+// there is nothing to write.
+func (h *Handler) writePromTimeSeries(tenant, db, rp, mst string, tss []prompb2.TimeSeries, tansFunc timeSeries2RowsFunc) (int, error) {
+	var maxPoints, dropped int
+	var err error
+	inValidTs, partialErr := h.FilterInvalidTimeSeries(mst, tss)
+	for i := range tss {
+		if inValidTs[i] {
+			dropped += len(tss[i].Samples) + len(tss[i].Histograms)
+			continue
+		}
+		maxPoints += timeSeriesRowsNum(&tss[i])
+	}
+	if maxPoints == 0 {
+		if partialErr != nil {
+			return 0, partialErr
+		}
+		return 0, ErrNoSamples
+	}
+	rs := pool.GetRows(maxPoints)
+	*rs = (*rs)[:maxPoints]
+	defer pool.PutRows(rs)
+	*rs, err = tansFunc(mst, *rs, tss, inValidTs)
+	if err != nil {
+		return http.StatusBadRequest, err
+	}
+	if err = validation.AllowRows(tenant, *rs); err != nil {
+		return http.StatusTooManyRequests, err
+	}
+
+	if err = h.PointsWriter.RetryWritePointRows(db, rp, *rs); influxdb.IsClientError(err) {
+		return http.StatusBadRequest, err
+	} else if influxdb.IsAuthorizationError(err) {
+		return http.StatusForbidden, err
+	} else if err != nil {
+		return http.StatusInternalServerError, err
+	}
+	if partialErr != nil {
+		return http.StatusBadRequest, netstorage.PartialWriteError{Reason: partialErr, Dropped: dropped}
+	}
+	return http.StatusNoContent, nil
+}
+
 func (h *Handler) FilterInvalidMetaData(mst string, metadata []prompb.MetricMetadata) (map[int]bool, error) {
 	invalidMd := make(map[int]bool)
 	if !validation.Limits().PromLimitEnabled(mst) {
@@ -459,6 +472,14 @@ func (h *Handler) servePromReadBase(w http.ResponseWriter, r *http.Request, user
 
 	h.Logger.Info("influxql", zap.String("query", q.String()))
 
+	release, err := acquireTenantQuery(user, db, &opts)
+	if err != nil {
+		h.httpError(w, err.Error(), http.StatusTooManyRequests)
+		h.Logger.Warn("servePromReadBase: rejected by the tenant limits", zap.Error(err))
+		return
+	}
+	defer release()
+
 	// Execute query
 	results := h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
 
@@ -863,6 +884,7 @@ func (h *Handler) execQuery(w ResponseWriter, r *http.Request, user meta2.User,
 		Quiet:           true,
 		Authorizer:      h.getAuthorizer(user),
 		IsPromQuery:     true,
+		TraceParent:     r.Header.Get(tracing.TraceParentHeader),
 	}
 
 	// Make sure if the client disconnects we signal the query to abort
@@ -884,17 +906,27 @@ func (h *Handler) execQuery(w ResponseWriter, r *http.Request, user meta2.User,
 		}()
 	}
 
+	release, err := acquireTenantQuery(user, db, &opts)
+	if err != nil {
+		apiErr = &apiError{errorTooMany, err}
+		return
+	}
+
 	// Execute query
 	resultCh := h.QueryExecutor.ExecuteQuery(q, opts, closing, qDuration)
 
 	// If we are running in async mode, open a goroutine to drain the results
 	// and return with a StatusNoContent.
 	if async {
-		go h.async(q, resultCh)
+		go func() {
+			h.async(q, resultCh)
+			release()
+		}()
 		h.writeHeader(w, http.StatusNoContent)
 		isRespond = true
 		return
 	}
+	defer release()
 
 	// if we're not chunking, this will be the in memory buffer for all results before sending to client
 	stmtID2Result := make(map[int]*query.Result)
@@ -0,0 +1,174 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/openGemini/openGemini/lib/validation"
+	"github.com/prometheus/prometheus/model/histogram"
+)
+
+// A native histogram sample is stored as the series itself carrying the count
+// and sum fields, plus one cumulative series per bucket upper bound tagged with
+// le, the same layout as a classic histogram. histogram_quantile and rate work
+// on the bucket series unchanged, histogram_count and histogram_sum read the
+// count and sum fields.
+//
+// The sparse representation is not kept: every populated bucket becomes a series
+// of the measurement, so the series cardinality grows with the number of buckets.
+// The buckets of a sample are capped by the max-native-histogram-buckets limit,
+// the resolution of a larger histogram is reduced by merging neighbouring buckets
+// until it fits, as Prometheus does for its bucket limit.
+
+// the lowest resolution of the exponential schemas
+const histogramSchemaMin = -4
+
+// histogramRowsNum returns the upper limit of rows a native histogram sample is
+// converted to: one count/sum row, one row per bucket, the zero bucket and +Inf.
+func histogramRowsNum(h *prompb2.Histogram) int {
+	if h.IsFloatHistogram() {
+		return len(h.NegativeCounts) + len(h.PositiveCounts) + 3
+	}
+	return len(h.NegativeDeltas) + len(h.PositiveDeltas) + 3
+}
+
+func timeSeriesRowsNum(ts *prompb2.TimeSeries) int {
+	n := len(ts.Samples)
+	for i := range ts.Histograms {
+		n += histogramRowsNum(&ts.Histograms[i])
+	}
+	return n
+}
+
+func histogramProto2FloatHistogram(h *prompb2.Histogram) *histogram.FloatHistogram {
+	fh := &histogram.FloatHistogram{
+		CounterResetHint: histogram.CounterResetHint(h.ResetHint),
+		Schema:           h.Schema,
+		ZeroThreshold:    h.ZeroThreshold,
+		Sum:              h.Sum,
+		PositiveSpans:    bucketSpans2Spans(h.PositiveSpans),
+		NegativeSpans:    bucketSpans2Spans(h.NegativeSpans),
+	}
+	if h.IsFloatHistogram() {
+		fh.Count = h.CountFloat
+		fh.ZeroCount = h.ZeroCountFloat
+		fh.PositiveBuckets = h.PositiveCounts
+		fh.NegativeBuckets = h.NegativeCounts
+		return fh
+	}
+	fh.Count = float64(h.CountInt)
+	fh.ZeroCount = float64(h.ZeroCountInt)
+	fh.PositiveBuckets = deltas2Counts(h.PositiveDeltas)
+	fh.NegativeBuckets = deltas2Counts(h.NegativeDeltas)
+	return fh
+}
+
+func bucketSpans2Spans(src []prompb2.BucketSpan) []histogram.Span {
+	spans := make([]histogram.Span, len(src))
+	for i := range src {
+		spans[i] = histogram.Span{Offset: src[i].Offset, Length: src[i].Length}
+	}
+	return spans
+}
+
+func deltas2Counts(deltas []int64) []float64 {
+	counts := make([]float64, len(deltas))
+	var cur int64
+	for i, d := range deltas {
+		cur += d
+		counts[i] = float64(cur)
+	}
+	return counts
+}
+
+// histograms2Rows converts the native histogram samples of one series into rows,
+// it returns the number of rows written to dst.
+func histograms2Rows(dst []influx.Row, mst string, tags influx.PointTags, hs []prompb2.Histogram) (int, error) {
+	var i int
+	for k := range hs {
+		fh := histogramProto2FloatHistogram(&hs[k])
+		if err := fh.Validate(); err != nil {
+			return i, err
+		}
+		fh = reduceHistogramBuckets(fh, validation.Limits().MaxNativeHistogramBuckets(mst))
+		ts := hs[k].Timestamp * int64(time.Millisecond)
+
+		row := &dst[i]
+		row.Name = mst
+		row.Timestamp = ts
+		row.CloneTags(tags)
+		row.ResizeFields(2)
+		setFloatField(&row.Fields[0], promql2influxql.HistogramCountFieldKey, fh.Count)
+		setFloatField(&row.Fields[1], promql2influxql.HistogramSumFieldKey, fh.Sum)
+		i++
+
+		var cumulative float64
+		it := fh.AllBucketIterator()
+		for it.Next() {
+			b := it.At()
+			cumulative += b.Count
+			histogramBucket2Row(&dst[i], mst, ts, tags, b.Upper, cumulative)
+			i++
+		}
+		histogramBucket2Row(&dst[i], mst, ts, tags, math.Inf(+1), fh.Count)
+		i++
+	}
+	return i, nil
+}
+
+// reduceHistogramBuckets reduces the resolution of fh until it has at most limit buckets
+func reduceHistogramBuckets(fh *histogram.FloatHistogram, limit int) *histogram.FloatHistogram {
+	if limit <= 0 || len(fh.PositiveBuckets)+len(fh.NegativeBuckets) <= limit {
+		return fh
+	}
+	// the buckets are reduced in place, they may refer to the request
+	fh = fh.Copy()
+	for len(fh.PositiveBuckets)+len(fh.NegativeBuckets) > limit && fh.Schema > histogramSchemaMin {
+		fh = fh.ReduceResolution(fh.Schema - 1)
+	}
+	return fh
+}
+
+func histogramBucket2Row(row *influx.Row, mst string, ts int64, tags influx.PointTags, upper, count float64) {
+	row.Name = mst
+	row.Timestamp = ts
+	row.ResizeTags(len(tags) + 1)
+	copy(row.Tags, tags)
+	row.Tags[len(tags)] = influx.Tag{Key: promql2influxql.HistogramBucketLabel, Value: formatBucketBound(upper)}
+	sort.Sort(&row.Tags)
+	row.ResizeFields(1)
+	setFloatField(&row.Fields[0], promql2influxql.DefaultFieldKey, count)
+}
+
+func formatBucketBound(upper float64) string {
+	if math.IsInf(upper, +1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(upper, 'g', -1, 64)
+}
+
+func setFloatField(f *influx.Field, key string, value float64) {
+	f.Reset()
+	f.Key = key
+	f.Type = influx.Field_Type_Float
+	f.NumValue = value
+}
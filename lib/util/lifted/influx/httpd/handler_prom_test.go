@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/golang/snappy"
 	"github.com/openGemini/openGemini/coordinator"
@@ -35,10 +36,13 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
 	meta2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/promql2influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/openGemini/openGemini/lib/validation"
 	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/histogram"
 	"github.com/prometheus/prometheus/prompb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -186,6 +190,45 @@ func TestHandlerPromWriteTimeSeries(t *testing.T) {
 	})
 }
 
+func TestHandlerPromWriteTenantLimits(t *testing.T) {
+	var user meta.User
+
+	h := NewTestHandle()
+	limits := config2.NewLimits()
+	limits.IngestionRate = 1
+	limits.IngestionBurstSize = 1
+	validation.InitOverrides(limits, nil)
+	defer validation.InitOverrides(config2.NewLimits(), nil)
+
+	cancel := MockValidDB()
+	defer cancel()
+
+	now := model.Now()
+	timeseries := []prompb.TimeSeries{
+		{
+			Labels:  []prompb.Label{{Name: model.MetricNameLabel, Value: "testmetric"}, {Name: "foo", Value: "bar"}},
+			Samples: []prompb.Sample{{Value: 1, Timestamp: int64(now)}, {Value: 2, Timestamp: int64(now) + 1}},
+		},
+	}
+	data, err := proto.Marshal(&prompb.WriteRequest{Timeseries: timeseries})
+	require.NoError(t, err)
+	compressed := snappy.Encode(nil, data)
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/write?db=db_tenant_limits", bytes.NewReader(compressed))
+	h.servePromWrite(w, req, user)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Contains(t, w.Body.String(), `tenant \"db_tenant_limits\" exceeded ingestion-rate limit: 2 samples rejected`)
+}
+
+func TestHandlerPromResultPointsLimit(t *testing.T) {
+	h := NewTestHandle()
+	results := map[int]*query.Result{0: {Err: query.ErrMaxSelectPointsLimitExceeded(10, 5)}}
+	_, apiErr := h.getPromResult(results, nil, promql2influxql.PromCommand{}, false, false)
+	require.NotNil(t, apiErr)
+	assert.Equal(t, errorTooMany, apiErr.typ)
+}
+
 func TestHandlerPromWriteMetadata(t *testing.T) {
 	var user meta.User
 
@@ -364,3 +407,86 @@ func TestHandlerPromQuerySeries(t *testing.T) {
 		assert.Equal(t, `{"status":"error","errorType":"bad_data","error":"invalid parameter \"start_end\": the query time range exceeds the limit (start: 56775-10-17 01:13:20 +0000 UTC, end 56942-10-21 01:13:20 +0000 UTC, query_len: 1464000h0m0s, limit: 720h0m0s)"}`, w.Body.String())
 	})
 }
+
+func TestReduceHistogramBuckets(t *testing.T) {
+	fh := &histogram.FloatHistogram{
+		Schema:          1,
+		Count:           10,
+		PositiveSpans:   []histogram.Span{{Offset: 0, Length: 4}},
+		PositiveBuckets: []float64{1, 2, 3, 4},
+	}
+	require.Same(t, fh, reduceHistogramBuckets(fh, 0))
+	require.Same(t, fh, reduceHistogramBuckets(fh, 4))
+
+	reduced := reduceHistogramBuckets(fh, 2)
+	require.LessOrEqual(t, len(reduced.PositiveBuckets), 2)
+	require.Less(t, reduced.Schema, int32(1))
+	var total float64
+	for _, c := range reduced.PositiveBuckets {
+		total += c
+	}
+	require.Equal(t, float64(10), total)
+	// the original histogram is not modified
+	require.Equal(t, []float64{1, 2, 3, 4}, fh.PositiveBuckets)
+}
+
+func TestTimeSeries2RowsNativeHistogram(t *testing.T) {
+	wr := &prompb.WriteRequest{Timeseries: []prompb.TimeSeries{{
+		Labels: []prompb.Label{
+			{Name: model.MetricNameLabel, Value: "http_request_duration_seconds"},
+			{Name: "job", Value: "api"},
+		},
+		Histograms: []prompb.Histogram{{
+			Count:          &prompb.Histogram_CountInt{CountInt: 7},
+			Sum:            10.5,
+			Schema:         0,
+			ZeroThreshold:  0.001,
+			ZeroCount:      &prompb.Histogram_ZeroCountInt{ZeroCountInt: 1},
+			NegativeSpans:  []prompb.BucketSpan{{Offset: 0, Length: 1}},
+			NegativeDeltas: []int64{1},
+			PositiveSpans:  []prompb.BucketSpan{{Offset: 0, Length: 2}},
+			PositiveDeltas: []int64{2, 1},
+			Timestamp:      1000,
+		}},
+	}}}
+	data, err := proto.Marshal(wr)
+	require.NoError(t, err)
+
+	var req prompb2.WriteRequest
+	require.NoError(t, req.Unmarshal(data))
+	require.Equal(t, 1, len(req.Timeseries))
+	require.Equal(t, 1, len(req.Timeseries[0].Histograms))
+	h := &req.Timeseries[0].Histograms[0]
+	require.False(t, h.IsFloatHistogram())
+	require.Equal(t, uint64(7), h.CountInt)
+	require.Equal(t, []int64{2, 1}, h.PositiveDeltas)
+
+	dst := make([]influx.Row, timeSeriesRowsNum(&req.Timeseries[0]))
+	rows, err := timeSeries2Rows(EmptyPromMst, dst, req.Timeseries, map[int]bool{})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(rows))
+
+	ts := int64(1000 * time.Millisecond)
+	require.Equal(t, "http_request_duration_seconds", rows[0].Name)
+	require.Equal(t, ts, rows[0].Timestamp)
+	require.Equal(t, 2, len(rows[0].Tags))
+	require.Equal(t, influx.Fields{
+		{Key: promql2influxql.HistogramCountFieldKey, NumValue: 7, Type: influx.Field_Type_Float},
+		{Key: promql2influxql.HistogramSumFieldKey, NumValue: 10.5, Type: influx.Field_Type_Float},
+	}, rows[0].Fields)
+
+	expect := []struct {
+		le    string
+		count float64
+	}{{"-0.5", 1}, {"0.001", 2}, {"1", 4}, {"2", 7}, {"+Inf", 7}}
+	for i, e := range expect {
+		row := rows[i+1]
+		require.Equal(t, ts, row.Timestamp)
+		require.Equal(t, 3, len(row.Tags))
+		le := row.Tags.FindPointTag(promql2influxql.HistogramBucketLabel)
+		require.NotNil(t, le)
+		require.Equal(t, e.le, le.Value)
+		require.Equal(t, promql2influxql.DefaultFieldKey, row.Fields[0].Key)
+		require.Equal(t, e.count, row.Fields[0].NumValue)
+	}
+}
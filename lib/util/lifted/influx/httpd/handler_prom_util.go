@@ -59,6 +59,7 @@ const (
 	errorNotFound      errorType = "not_found"
 	errorNotAcceptable errorType = "not_acceptable"
 	errorForbidden     errorType = "forbidden"
+	errorTooMany       errorType = "too_many_requests"
 )
 
 type status string
@@ -168,6 +169,9 @@ func (h *Handler) getPromResult(stmtID2Result map[int]*query.Result, expr parser
 	r := &promql2influxql.Receiver{PromCommand: cmd, DropMetric: dropMetric, DuplicateResult: duplicateResult}
 	resp := &promql2influxql.PromQueryResponse{Data: &promql2influxql.PromData{}, Status: "success"}
 	if len(stmtID2Result) > 0 {
+		if errno.Equal(stmtID2Result[0].Err, errno.SelectPointsLimitExceeded) {
+			return resp, &apiError{errorTooMany, stmtID2Result[0].Err}
+		}
 		if stmtID2Result[0].Err != nil && !isPromReportedError(stmtID2Result[0].Err) {
 			if isPromAbsentCall(expr) && (errors.Is(stmtID2Result[0].Err, meta.ErrMeasurementNotFound) || errno.Equal(stmtID2Result[0].Err, errno.DatabaseNotFound)) {
 				result, err := r.AbsentNoMstResult(expr)
@@ -298,6 +302,8 @@ func respondError(w http.ResponseWriter, apiErr *apiError) {
 		code = http.StatusNotAcceptable
 	case errorForbidden:
 		code = http.StatusForbidden
+	case errorTooMany:
+		code = http.StatusTooManyRequests
 	default:
 		code = http.StatusInternalServerError
 	}
@@ -345,8 +351,13 @@ func timeSeries2Rows(mst string, dst []influx.Row, tss []prompb2.TimeSeries, inv
 			}
 			i++
 		}
+		n, err := histograms2Rows(dst[i:], mst, tags, ts.Histograms)
+		if err != nil {
+			return dst, err
+		}
+		i += n
 	}
-	return dst, nil
+	return dst[:i], nil
 }
 
 func unmarshalPromTags(dst influx.PointTags, ts prompb2.TimeSeries) (influx.PointTags, string) {
@@ -396,8 +407,13 @@ func timeSeries2RowsV2(mst string, dst []influx.Row, tss []prompb2.TimeSeries, i
 			}
 			i++
 		}
+		n, err := histograms2Rows(dst[i:], mst, tags, ts.Histograms)
+		if err != nil {
+			return dst, err
+		}
+		i += n
 	}
-	return dst, nil
+	return dst[:i], nil
 }
 
 func unmarshalPromTagsV2(dst influx.PointTags, ts prompb2.TimeSeries) influx.PointTags {
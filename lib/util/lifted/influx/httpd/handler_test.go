@@ -6,13 +6,16 @@ import (
 	"math"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
 	"testing"
 	"time"
 
 	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
+	"github.com/agiledragon/gomonkey/v2"
 	"github.com/gorilla/mux"
+	"github.com/openGemini/openGemini/coordinator"
 	config2 "github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/cpu"
 	"github.com/openGemini/openGemini/lib/errno"
@@ -468,6 +471,57 @@ func TestHandler_ServeWrite_ReadOnly(t *testing.T) {
 	})
 }
 
+func TestHandler_ServeQuery_PointsLimit(t *testing.T) {
+	limits := config2.NewLimits()
+	limits.MaxPointsPerQuery = 5
+	validation.InitOverrides(limits, nil)
+	defer validation.InitOverrides(config2.NewLimits(), nil)
+
+	params := url.Values{"db": {"db0"}, "q": {"select value from cpu"}}
+	h := newExportHandler(&mockExportStatementExecutor{err: query.ErrMaxSelectPointsLimitExceeded(10, 5)}, "")
+	w := httptest.NewRecorder()
+	h.serveQuery(w, httptest.NewRequest(http.MethodGet, "/query?"+params.Encode(), nil), nil)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Contains(t, w.Body.String(), "max-select-point limit")
+
+	// the results within the limit are answered as usual
+	h = newExportHandler(&mockExportStatementExecutor{series: exportSeries()}, "")
+	w = httptest.NewRecorder()
+	h.serveQuery(w, httptest.NewRequest(http.MethodGet, "/query?"+params.Encode(), nil), nil)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `"name":"cpu"`)
+}
+
+func TestHandler_ServeWrite_TenantBytesLimit(t *testing.T) {
+	var user meta.User
+
+	h := NewTestHandle()
+	limits := config2.NewLimits()
+	limits.IngestionBytesRate = 1
+	limits.IngestionBytesBurstSize = 10
+	validation.InitOverrides(limits, nil)
+	defer validation.InitOverrides(config2.NewLimits(), nil)
+
+	cancel := MockValidDB()
+	defer cancel()
+	written := 0
+	var pw *coordinator.PointsWriter
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(pw), "RetryWritePointRows", func(_ *coordinator.PointsWriter, database, retentionPolicy string, points []influx.Row) error {
+		written += len(points)
+		return nil
+	})
+	defer patches.Reset()
+
+	rejected := handlerStat.Write429ErrRequests.Load()
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/write?db=db_bytes_limit", strings.NewReader("cpu v=1 1\ncpu v=2 2\n"))
+	h.serveWrite(w, req, user)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Contains(t, w.Body.String(), "exceeded ingestion-bytes-rate limit")
+	assert.Equal(t, 0, written)
+	assert.Equal(t, rejected+1, handlerStat.Write429ErrRequests.Load())
+}
+
 func TestTransYaccSyntaxErr(t *testing.T) {
 	testStr := [][2]string{
 		{"unexpected COMMA", "unexpected COMMA"},
@@ -18,6 +18,8 @@ import (
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
 	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	grpccreds "google.golang.org/grpc/credentials"
 )
 
 // Service manages the listener and handler for an HTTP endpoint.
@@ -37,6 +39,12 @@ type Service struct {
 	bindSocket         string
 	unixSocketListener net.Listener
 
+	otlpGRPCEnabled bool
+	otlpGRPCAddr    string
+	otlpGRPCServer  *grpc.Server
+
+	syslog *syslogListener
+
 	Handler *Handler
 
 	Logger    *zap.Logger
@@ -46,19 +54,24 @@ type Service struct {
 // NewService returns a new instance of Service.
 func NewService(c config.Config) *Service {
 	s := &Service{
-		addr:           c.BindAddress,
-		https:          c.HTTPSEnabled,
-		cert:           c.HTTPSCertificate,
-		key:            c.HTTPSPrivateKey,
-		limit:          c.MaxConnectionLimit,
-		tlsConfig:      c.TLS,
-		err:            make(chan error),
-		unixSocket:     c.UnixSocketEnabled,
-		unixSocketPerm: uint32(c.UnixSocketPermissions),
-		bindSocket:     c.BindSocket,
-		Logger:         logger.GetLogger().With(zap.String("service", "httpd")),
-		whiteList:      c.WhiteList,
-		Handler:        NewHandler(c),
+		addr:            c.BindAddress,
+		https:           c.HTTPSEnabled,
+		cert:            c.HTTPSCertificate,
+		key:             c.HTTPSPrivateKey,
+		limit:           c.MaxConnectionLimit,
+		tlsConfig:       c.TLS,
+		err:             make(chan error),
+		unixSocket:      c.UnixSocketEnabled,
+		unixSocketPerm:  uint32(c.UnixSocketPermissions),
+		bindSocket:      c.BindSocket,
+		otlpGRPCEnabled: c.OtlpGRPCEnabled,
+		otlpGRPCAddr:    c.OtlpGRPCAddress,
+		Logger:          logger.GetLogger().With(zap.String("service", "httpd")),
+		whiteList:       c.WhiteList,
+		Handler:         NewHandler(c),
+	}
+	if c.Syslog.Enabled {
+		s.syslog = newSyslogListener(c.Syslog, s.Handler, s.Logger)
 	}
 	if s.tlsConfig == nil {
 		s.tlsConfig = new(tls.Config)
@@ -186,13 +199,63 @@ func (s *Service) Open() error {
 	for _, ln := range s.Ln {
 		go s.serveTCP(ln)
 	}
+
+	if s.otlpGRPCEnabled {
+		if err := s.openOtlpGRPC(); err != nil {
+			return err
+		}
+	}
+	if s.syslog != nil {
+		return s.syslog.Open()
+	}
+	return nil
+}
+
+// openOtlpGRPC starts the OTLP/gRPC metrics receiver.
+func (s *Service) openOtlpGRPC() error {
+	var opts []grpc.ServerOption
+	if size := s.Handler.Config.MaxBodySize; size > 0 {
+		opts = append(opts, grpc.MaxRecvMsgSize(size))
+	}
+	if s.https {
+		cert, err := tls.X509KeyPair([]byte(crypto.DecryptFromFile(s.cert)), []byte(crypto.DecryptFromFile(s.key)))
+		if err != nil {
+			return err
+		}
+		tlsConfig := s.tlsConfig.Clone()
+		tlsConfig.Certificates = []tls.Certificate{cert}
+		opts = append(opts, grpc.Creds(grpccreds.NewTLS(tlsConfig)))
+	}
+
+	listener, err := net.Listen("tcp", s.otlpGRPCAddr)
+	if err != nil {
+		return err
+	}
+	s.otlpGRPCServer = newOtlpGRPCServer(s.Handler, opts...)
+	s.Logger.Info("Listening on OTLP/gRPC", zap.Stringer("addr", listener.Addr()), zap.Bool("https", s.https))
+
+	go func() {
+		if err := s.otlpGRPCServer.Serve(listener); err != nil && err != grpc.ErrServerStopped {
+			s.err <- fmt.Errorf("otlp grpc listener failed: addr=%s, err=%s", listener.Addr(), err)
+		}
+	}()
 	return nil
 }
 
 // Close closes the underlying listener.
 func (s *Service) Close() error {
+	// the buffered syslog messages are written before the handler is closed
+	if s.syslog != nil {
+		if err := s.syslog.Close(); err != nil {
+			s.Logger.Error("close syslog listener failed", zap.Error(err))
+		}
+	}
 	s.Handler.Close()
 
+	if s.otlpGRPCServer != nil {
+		s.otlpGRPCServer.Stop()
+	}
+
 	for _, ln := range s.Ln {
 		if ln != nil {
 			if err := ln.Close(); err != nil {
@@ -0,0 +1,315 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	"go.uber.org/zap"
+)
+
+const (
+	// syslogMaxMessageSize is the max size of a message received by the syslog listener
+	syslogMaxMessageSize = 64 * 1024
+	// syslogMaxBatchBytes is the max size of the messages written to the log stream in one batch
+	syslogMaxBatchBytes = 16 * 1024 * 1024
+)
+
+// syslogListener receives syslog messages over udp or tcp, and writes them into the log stream in batches.
+// The batches go through the same path as the syslog records posted to the log store.
+type syslogListener struct {
+	cfg     config.SyslogConfig
+	handler *Handler
+	logger  *zap.Logger
+
+	ln   net.Listener
+	conn net.PacketConn
+
+	mu    sync.Mutex
+	buf   []byte
+	count int
+	conns map[net.Conn]struct{}
+
+	closing chan struct{}
+	wg      sync.WaitGroup
+}
+
+func newSyslogListener(cfg config.SyslogConfig, h *Handler, logger *zap.Logger) *syslogListener {
+	return &syslogListener{
+		cfg:     cfg,
+		handler: h,
+		logger:  logger.With(zap.String("protocol", "syslog/"+cfg.Protocol)),
+		conns:   make(map[net.Conn]struct{}),
+		closing: make(chan struct{}),
+	}
+}
+
+func (l *syslogListener) Open() error {
+	if l.cfg.Protocol == "tcp" {
+		ln, err := net.Listen("tcp", l.cfg.BindAddress)
+		if err != nil {
+			return err
+		}
+		l.ln = ln
+		l.wg.Add(1)
+		go l.serveTCP()
+	} else {
+		conn, err := net.ListenPacket("udp", l.cfg.BindAddress)
+		if err != nil {
+			return err
+		}
+		l.conn = conn
+		l.wg.Add(1)
+		go l.serveUDP()
+	}
+	l.logger.Info("Listening on syslog", zap.Stringer("addr", l.Addr()),
+		zap.String("repository", l.cfg.Repository), zap.String("logstream", l.cfg.LogStream))
+
+	l.wg.Add(1)
+	go l.flushLoop()
+	return nil
+}
+
+func (l *syslogListener) Addr() net.Addr {
+	if l.ln != nil {
+		return l.ln.Addr()
+	}
+	return l.conn.LocalAddr()
+}
+
+// Close stops receiving messages, the buffered messages are written before it returns.
+func (l *syslogListener) Close() error {
+	close(l.closing)
+	var err error
+	if l.ln != nil {
+		err = l.ln.Close()
+	}
+	if l.conn != nil {
+		err = l.conn.Close()
+	}
+	l.mu.Lock()
+	for conn := range l.conns {
+		_ = conn.Close()
+	}
+	l.mu.Unlock()
+	l.wg.Wait()
+
+	l.flush(l.take())
+	return err
+}
+
+func (l *syslogListener) isClosing() bool {
+	select {
+	case <-l.closing:
+		return true
+	default:
+		return false
+	}
+}
+
+// serveUDP receives one message per datagram.
+func (l *syslogListener) serveUDP() {
+	defer l.wg.Done()
+	b := make([]byte, syslogMaxMessageSize)
+	for {
+		n, _, err := l.conn.ReadFrom(b)
+		if err != nil {
+			if l.isClosing() {
+				return
+			}
+			l.logger.Error("read syslog message failed", zap.Error(err))
+			continue
+		}
+		l.add(b[:n])
+	}
+}
+
+func (l *syslogListener) serveTCP() {
+	defer l.wg.Done()
+	for {
+		conn, err := l.ln.Accept()
+		if err != nil {
+			if l.isClosing() {
+				return
+			}
+			l.logger.Error("accept syslog connection failed", zap.Error(err))
+			continue
+		}
+		l.mu.Lock()
+		l.conns[conn] = struct{}{}
+		l.mu.Unlock()
+
+		l.wg.Add(1)
+		go l.serveConn(conn)
+	}
+}
+
+func (l *syslogListener) serveConn(conn net.Conn) {
+	defer func() {
+		l.mu.Lock()
+		delete(l.conns, conn)
+		l.mu.Unlock()
+		_ = conn.Close()
+		l.wg.Done()
+	}()
+
+	r := bufio.NewReaderSize(conn, syslogMaxMessageSize)
+	for {
+		msg, err := readSyslogFrame(r)
+		if err != nil {
+			if err != io.EOF && !l.isClosing() {
+				l.logger.Error("read syslog message failed", zap.Stringer("remote", conn.RemoteAddr()), zap.Error(err))
+			}
+			return
+		}
+		l.add(msg)
+	}
+}
+
+// readSyslogFrame reads a message framed by octet counting or by a trailing newline, see RFC6587.
+func readSyslogFrame(r *bufio.Reader) ([]byte, error) {
+	c, err := r.Peek(1)
+	if err != nil {
+		return nil, err
+	}
+	if c[0] < '1' || c[0] > '9' {
+		line, err := r.ReadSlice('\n')
+		if errors.Is(err, bufio.ErrBufferFull) {
+			return nil, fmt.Errorf("syslog message exceeds %d bytes", syslogMaxMessageSize)
+		}
+		if err == io.EOF && len(line) > 0 {
+			err = nil
+		}
+		return line, err
+	}
+
+	s, err := r.ReadSlice(' ')
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog message length: %w", err)
+	}
+	n, err := strconv.Atoi(string(s[:len(s)-1]))
+	if err != nil || n > syslogMaxMessageSize {
+		return nil, fmt.Errorf("invalid syslog message length %q", s[:len(s)-1])
+	}
+	msg := make([]byte, n)
+	if _, err = io.ReadFull(r, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// add buffers the message, the records are parsed by line so the newlines within the message are replaced.
+func (l *syslogListener) add(msg []byte) {
+	msg = bytes.TrimRight(msg, "\r\n\x00")
+	if len(msg) == 0 {
+		return
+	}
+
+	l.mu.Lock()
+	start := len(l.buf)
+	l.buf = append(l.buf, msg...)
+	for i := start; i < len(l.buf); i++ {
+		if l.buf[i] == '\n' || l.buf[i] == '\r' {
+			l.buf[i] = ' '
+		}
+	}
+	l.buf = append(l.buf, '\n')
+	l.count++
+	var batch []byte
+	if l.count >= l.cfg.BatchSize || len(l.buf) >= syslogMaxBatchBytes {
+		batch = l.takeLocked()
+	}
+	l.mu.Unlock()
+
+	l.flush(batch)
+}
+
+func (l *syslogListener) take() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.takeLocked()
+}
+
+func (l *syslogListener) takeLocked() []byte {
+	batch := l.buf
+	l.buf = nil
+	l.count = 0
+	return batch
+}
+
+func (l *syslogListener) flushLoop() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(time.Duration(l.cfg.FlushInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-l.closing:
+			return
+		case <-ticker.C:
+			l.flush(l.take())
+		}
+	}
+}
+
+// flush writes the batch as the syslog records of the log stream.
+func (l *syslogListener) flush(batch []byte) {
+	if len(batch) == 0 {
+		return
+	}
+	path := fmt.Sprintf("/repo/%s/logstreams/%s/records?type=syslog", url.PathEscape(l.cfg.Repository), url.PathEscape(l.cfg.LogStream))
+	r, err := http.NewRequest(http.MethodPost, path, bytes.NewReader(batch))
+	if err != nil {
+		l.logger.Error("write syslog messages failed", zap.Error(err))
+		return
+	}
+	r = mux.SetURLVars(r, map[string]string{Repository: l.cfg.Repository, LogStream: l.cfg.LogStream})
+
+	w := &syslogResponseWriter{header: make(http.Header), status: http.StatusOK}
+	l.handler.serveRecord(w, r, nil)
+	if w.status >= http.StatusMultipleChoices {
+		l.logger.Error("write syslog messages failed", zap.Int("status", w.status), zap.String("error", w.body.String()))
+	}
+}
+
+// syslogResponseWriter keeps the response of the records written by the syslog listener.
+type syslogResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *syslogResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *syslogResponseWriter) Write(b []byte) (int, error) {
+	return w.body.Write(b)
+}
+
+func (w *syslogResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
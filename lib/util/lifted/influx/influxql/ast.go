@@ -285,6 +285,7 @@ func (*CreateContinuousQueryStatement) node()      {}
 func (*CreateDatabaseStatement) node()             {}
 func (*CreateMeasurementStatement) node()          {}
 func (*AlterShardKeyStatement) node()              {}
+func (*AlterMeasurementTTLStatement) node()        {}
 func (*CreateRetentionPolicyStatement) node()      {}
 func (*CreateSubscriptionStatement) node()         {}
 func (*CreateUserStatement) node()                 {}
@@ -319,6 +320,7 @@ func (*ShowMeasurementsDetailStatement) node()     {}
 func (*ShowQueriesStatement) node()                {}
 func (*ShowSeriesStatement) node()                 {}
 func (*ShowSeriesCardinalityStatement) node()      {}
+func (*ShowSeriesQuotaStatement) node()            {}
 func (*ShowShardGroupsStatement) node()            {}
 func (*ShowShardsStatement) node()                 {}
 func (*ShowStatsStatement) node()                  {}
@@ -466,6 +468,7 @@ func (*CreateContinuousQueryStatement) stmt()      {}
 func (*CreateDatabaseStatement) stmt()             {}
 func (*CreateMeasurementStatement) stmt()          {}
 func (*AlterShardKeyStatement) stmt()              {}
+func (*AlterMeasurementTTLStatement) stmt()        {}
 func (*CreateRetentionPolicyStatement) stmt()      {}
 func (*CreateSubscriptionStatement) stmt()         {}
 func (*CreateUserStatement) stmt()                 {}
@@ -494,6 +497,7 @@ func (*ShowQueriesStatement) stmt()                {}
 func (*ShowRetentionPoliciesStatement) stmt()      {}
 func (*ShowSeriesStatement) stmt()                 {}
 func (*ShowSeriesCardinalityStatement) stmt()      {}
+func (*ShowSeriesQuotaStatement) stmt()            {}
 func (*ShowShardGroupsStatement) stmt()            {}
 func (*ShowShardsStatement) stmt()                 {}
 func (*ShowStatsStatement) stmt()                  {}
@@ -874,6 +878,7 @@ type CreateMeasurementStatement struct {
 	IndexOption         []*IndexOption
 	TimeClusterDuration time.Duration
 	CompactType         string
+	TTL                 time.Duration
 }
 
 type CreateMeasurementStatementOption struct {
@@ -888,6 +893,7 @@ type CreateMeasurementStatementOption struct {
 	Property            [][]string
 	TimeClusterDuration time.Duration
 	CompactType         string
+	TTL                 time.Duration
 }
 
 type IndexOption struct {
@@ -937,6 +943,10 @@ func (s *CreateMeasurementStatement) String() string {
 	}
 
 	_, _ = buf.WriteString(" WITH")
+	if s.TTL > 0 {
+		_, _ = buf.WriteString(" TTL ")
+		_, _ = buf.WriteString(FormatDuration(s.TTL))
+	}
 	if len(s.ShardKey) > 0 {
 		shardKey := strings.Join(s.ShardKey, ",")
 		_, _ = buf.WriteString(" SHARDKEY ")
@@ -999,6 +1009,41 @@ func (s *AlterShardKeyStatement) RequiredPrivileges() (ExecutionPrivileges, erro
 	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
 }
 
+// AlterMeasurementTTLStatement changes the time to live of a measurement, TTL of 0 disables it.
+type AlterMeasurementTTLStatement struct {
+	Database        string
+	RetentionPolicy string
+	Name            string
+	TTL             time.Duration
+}
+
+func (s *AlterMeasurementTTLStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("ALTER MEASUREMENT ")
+	if s.Database != "" {
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+		_, _ = buf.WriteString(".")
+	}
+
+	if s.RetentionPolicy != "" {
+		_, _ = buf.WriteString(QuoteIdent(s.RetentionPolicy))
+		_, _ = buf.WriteString(".")
+	}
+
+	if s.Name != "" {
+		_, _ = buf.WriteString(QuoteIdent(s.Name))
+	}
+
+	_, _ = buf.WriteString(" WITH TTL ")
+	_, _ = buf.WriteString(FormatDuration(s.TTL))
+
+	return buf.String()
+}
+
+func (s *AlterMeasurementTTLStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: true, Name: "", Rwuser: true, Privilege: AllPrivileges}}, nil
+}
+
 // DropDatabaseStatement represents a command to drop a database.
 type DropDatabaseStatement struct {
 	// Name of the database to be dropped.
@@ -1708,6 +1753,8 @@ func cloneSource(s Source) Source {
 		c.LSrc = cloneSource(s.LSrc)
 		c.RSrc = cloneSource(s.RSrc)
 		c.Condition = CloneExpr(s.Condition)
+		c.JoinType = s.JoinType
+		c.Tolerance = s.Tolerance
 		return c
 	case *Unnest:
 		return s.Clone()
@@ -2867,6 +2914,36 @@ func (s *SelectStatement) RewritePercentileOGSketch() {
 	RewriteOpsNestFunc(s, RewritePercentileOGSketchStatement)
 }
 
+// RewriteApproxCountDistinctStatement converts a query with approx_count_distinct that has a subquery to the hll_count,
+// which builds and counts the sketch in one step instead of merging the partial sketches of the stores
+func RewriteApproxCountDistinctStatement(node Node) Node {
+	s, ok := node.(*SelectStatement)
+	if !ok {
+		return s
+	}
+	var haveSubQuery bool
+	for i := range s.Sources {
+		if _, ok := s.Sources[i].(*SubQuery); ok {
+			haveSubQuery = true
+			break
+		}
+	}
+	if !haveSubQuery {
+		return s
+	}
+	for i := 0; i < len(s.Fields); i++ {
+		if call, ok := s.Fields[i].Expr.(*Call); ok && call.Name == "approx_count_distinct" {
+			call.Name = "hll_count"
+		}
+	}
+	return s
+}
+
+// RewriteApproxCountDistinct converts a query with approx_count_distinct that has a subquery to the hll_count
+func (s *SelectStatement) RewriteApproxCountDistinct() {
+	RewriteOpsNestFunc(s, RewriteApproxCountDistinctStatement)
+}
+
 // RewriteDistinct rewrites the expression to be a call for map/reduce to work correctly.
 // This method assumes all validation has passed.
 func (s *SelectStatement) RewriteDistinct() {
@@ -3651,6 +3728,42 @@ func (s *ShowSeriesCardinalityStatement) DefaultDatabase() string {
 	return s.Database
 }
 
+// ShowSeriesQuotaStatement represents a command for showing the usage of the series quota.
+type ShowSeriesQuotaStatement struct {
+	// Database to query. If blank, use the default database.
+	Database string
+
+	// Measurement(s) the quota is shown for.
+	Sources Sources
+}
+
+// String returns a string representation of the show series quota statement.
+func (s *ShowSeriesQuotaStatement) String() string {
+	var buf bytes.Buffer
+	_, _ = buf.WriteString("SHOW SERIES QUOTA")
+
+	if s.Database != "" {
+		_, _ = buf.WriteString(" ON ")
+		_, _ = buf.WriteString(QuoteIdent(s.Database))
+	}
+
+	if s.Sources != nil {
+		_, _ = buf.WriteString(" FROM ")
+		_, _ = buf.WriteString(s.Sources.String())
+	}
+	return buf.String()
+}
+
+// RequiredPrivileges returns the privilege required to execute a ShowSeriesQuotaStatement.
+func (s *ShowSeriesQuotaStatement) RequiredPrivileges() (ExecutionPrivileges, error) {
+	return ExecutionPrivileges{{Admin: false, Name: s.Database, Rwuser: true, Privilege: ReadPrivilege}}, nil
+}
+
+// DefaultDatabase returns the default database from the statement.
+func (s *ShowSeriesQuotaStatement) DefaultDatabase() string {
+	return s.Database
+}
+
 // ShowContinuousQueriesStatement represents a command for listing continuous queries.
 type ShowContinuousQueriesStatement struct{}
 
@@ -4443,7 +4556,7 @@ func (s *ShowTagValuesStatement) DefaultDatabase() string {
 // ShowTagValuesCardinalityStatement represents a command for listing tag value cardinality.
 type ShowTagValuesCardinalityStatement struct {
 	Database      string
-	Exact         bool
+	Exact         bool // If false and enabled by the config then cardinality estimation will be used.
 	Sources       Sources
 	Op            Token
 	TagKeyExpr    Literal
@@ -5053,14 +5166,46 @@ func (s *SubQuery) GetName() string {
 	return s.Alias
 }
 
+// JoinType is the type of the join between two subqueries.
+type JoinType int
+
+const (
+	FullJoin JoinType = iota
+	InnerJoin
+	LeftOuterJoin
+	RightOuterJoin
+	AsofJoin
+)
+
+func (t JoinType) String() string {
+	switch t {
+	case InnerJoin:
+		return "inner join"
+	case LeftOuterJoin:
+		return "left outer join"
+	case RightOuterJoin:
+		return "right outer join"
+	case AsofJoin:
+		return "asof join"
+	default:
+		return "full join"
+	}
+}
+
 type Join struct {
 	LSrc      Source
 	RSrc      Source
 	Condition Expr
+	JoinType  JoinType
+	// Tolerance is the max time distance of the paired rows in asof join, 0 means no limit.
+	Tolerance time.Duration
 }
 
 func (j *Join) String() string {
-	return fmt.Sprintf("%s full join %s on %s", "1", "2", j.Condition.String())
+	if j.JoinType == AsofJoin && j.Tolerance > 0 {
+		return fmt.Sprintf("%s %s %s on %s tolerance %s", "1", j.JoinType.String(), "2", j.Condition.String(), FormatDuration(j.Tolerance))
+	}
+	return fmt.Sprintf("%s %s %s on %s", "1", j.JoinType.String(), "2", j.Condition.String())
 }
 
 func (j *Join) GetName() string {
@@ -5245,6 +5390,9 @@ func (a VarRefs) Strings() []string {
 type Call struct {
 	Name string
 	Args []Expr
+
+	// Window holds the OVER clause of a window function call.
+	Window *WindowSpec
 }
 
 func (c *Call) RewriteNameSpace(alias, mst string) {
@@ -5262,6 +5410,9 @@ func (c *Call) String() string {
 	}
 
 	// Write function name and args.
+	if c.Window != nil {
+		return fmt.Sprintf("%s(%s) OVER (%s)", c.Name, strings.Join(str, ", "), c.Window.String())
+	}
 	return fmt.Sprintf("%s(%s)", c.Name, strings.Join(str, ", "))
 }
 
@@ -5281,10 +5432,81 @@ func (c *Call) WriteString(b *bytes.Buffer) {
 		b.WriteString(arg.String())
 	}
 	b.WriteString(")")
+	if c.Window != nil {
+		b.WriteString(" OVER (")
+		b.WriteString(c.Window.String())
+		b.WriteString(")")
+	}
 	// Write function name and args.
 	return
 }
 
+// WindowSpec represents the OVER clause of a window function call,
+// such as OVER (PARTITION BY host ORDER BY time DESC).
+type WindowSpec struct {
+	PartitionBy Dimensions
+	SortFields  SortFields
+}
+
+// String returns a string representation of the window specification.
+func (w *WindowSpec) String() string {
+	var buf bytes.Buffer
+	if len(w.PartitionBy) > 0 {
+		_, _ = buf.WriteString("PARTITION BY ")
+		_, _ = buf.WriteString(w.PartitionBy.String())
+	}
+	if len(w.SortFields) > 0 {
+		if buf.Len() > 0 {
+			_, _ = buf.WriteString(" ")
+		}
+		_, _ = buf.WriteString("ORDER BY ")
+		_, _ = buf.WriteString(w.SortFields.String())
+	}
+	return buf.String()
+}
+
+// Clone returns a deep copy of the window specification.
+func (w *WindowSpec) Clone() *WindowSpec {
+	if w == nil {
+		return nil
+	}
+	clone := &WindowSpec{}
+	if w.PartitionBy != nil {
+		clone.PartitionBy = make(Dimensions, 0, len(w.PartitionBy))
+		for _, d := range w.PartitionBy {
+			clone.PartitionBy = append(clone.PartitionBy, &Dimension{Expr: CloneExpr(d.Expr)})
+		}
+	}
+	if w.SortFields != nil {
+		clone.SortFields = make(SortFields, 0, len(w.SortFields))
+		for _, f := range w.SortFields {
+			clone.SortFields = append(clone.SortFields, &SortField{Name: f.Name, Ascending: f.Ascending})
+		}
+	}
+	return clone
+}
+
+// Ascending reports whether the window is ordered by ascending time.
+func (w *WindowSpec) Ascending() bool {
+	for _, f := range w.SortFields {
+		if strings.ToLower(f.Name) == "time" {
+			return f.Ascending
+		}
+	}
+	return true
+}
+
+// PartitionKeys returns the tag keys of the PARTITION BY clause.
+func (w *WindowSpec) PartitionKeys() []string {
+	keys := make([]string, 0, len(w.PartitionBy))
+	for _, d := range w.PartitionBy {
+		if ref, ok := d.Expr.(*VarRef); ok {
+			keys = append(keys, ref.Val)
+		}
+	}
+	return keys
+}
+
 // Distinct represents a DISTINCT expression.
 type Distinct struct {
 	// Identifier following DISTINCT
@@ -5618,7 +5840,7 @@ func CloneExpr(expr Expr) Expr {
 		for i, arg := range expr.Args {
 			args[i] = CloneExpr(arg)
 		}
-		return &Call{Name: expr.Name, Args: args}
+		return &Call{Name: expr.Name, Args: args, Window: expr.Window.Clone()}
 	case *Distinct:
 		return &Distinct{Val: expr.Val}
 	case *DurationLiteral:
@@ -5779,6 +6001,9 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Sources)
 		Walk(v, n.Condition)
 
+	case *ShowSeriesQuotaStatement:
+		Walk(v, n.Sources)
+
 	case *ShowMeasurementCardinalityStatement:
 		Walk(v, n.Sources)
 		Walk(v, n.Condition)
@@ -7452,7 +7677,7 @@ func reduceCall(expr *Call, valuer Valuer) Expr {
 
 	// Evaluate a function call if the valuer is a CallValuer and
 	// the arguments are only literals.
-	if literalsOnly {
+	if literalsOnly && expr.Window == nil {
 		if valuer, ok := valuer.(CallValuer); ok {
 			argVals := make([]interface{}, len(args))
 			for i := range args {
@@ -7463,7 +7688,7 @@ func reduceCall(expr *Call, valuer Valuer) Expr {
 			}
 		}
 	}
-	return &Call{Name: expr.Name, Args: args}
+	return &Call{Name: expr.Name, Args: args, Window: expr.Window}
 }
 
 func reduceParenExpr(expr *ParenExpr, valuer Valuer) Expr {
@@ -93,4 +93,9 @@ func (c *Call) WriteDigest(b *bytes.Buffer) {
 		}
 	}
 	b.WriteString(")")
+	if c.Window != nil {
+		b.WriteString(" OVER (")
+		b.WriteString(c.Window.String())
+		b.WriteString(")")
+	}
 }
@@ -27,6 +27,20 @@ func TestRewritePercentileOGSketchStatement(t *testing.T) {
 	assert.Equal(t, "max", st2.Fields[0].Expr.(*Call).Name)
 }
 
+func TestRewriteApproxCountDistinctStatement(t *testing.T) {
+	st := &SelectStatement{}
+	st.Sources = append(st.Sources, &SubQuery{})
+	st.Fields = append(st.Fields, &Field{Expr: &Call{Name: "approx_count_distinct"}})
+	RewriteApproxCountDistinctStatement(st)
+	assert.Equal(t, "hll_count", st.Fields[0].Expr.(*Call).Name)
+
+	st1 := &SelectStatement{}
+	st1.Sources = append(st1.Sources, &Measurement{Name: "mst"})
+	st1.Fields = append(st1.Fields, &Field{Expr: &Call{Name: "approx_count_distinct"}})
+	RewriteApproxCountDistinctStatement(st1)
+	assert.Equal(t, "approx_count_distinct", st1.Fields[0].Expr.(*Call).Name)
+}
+
 func Test_RewriteCondForLogKeeper(t *testing.T) {
 	schema := map[string]Expr{
 		"content": &VarRef{Val: "content", Type: String},
@@ -1035,6 +1035,11 @@ func (p *Parser) parseShowSeriesStatement() (Statement, error) {
 	}
 	p.Unscan()
 
+	if tok, _, lit := p.ScanIgnoreWhitespace(); !exactCardinality && tok == IDENT && strings.EqualFold(lit, "quota") {
+		return p.parseShowSeriesQuotaStatement()
+	}
+	p.Unscan()
+
 	// Handle SHOW SERIES statments.
 
 	stmt := &ShowSeriesStatement{}
@@ -1127,6 +1132,33 @@ func (p *Parser) parseShowSeriesCardinalityStatement(exact bool) (Statement, err
 	return stmt, nil
 }
 
+// parseShowSeriesQuotaStatement parses a string and returns a ShowSeriesQuotaStatement.
+// This function assumes the "SHOW SERIES QUOTA" tokens have already been consumed.
+func (p *Parser) parseShowSeriesQuotaStatement() (*ShowSeriesQuotaStatement, error) {
+	var err error
+	stmt := &ShowSeriesQuotaStatement{}
+
+	// Parse optional ON clause.
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == ON {
+		if stmt.Database, err = p.ParseIdent(); err != nil {
+			return nil, err
+		}
+	} else {
+		p.Unscan()
+	}
+
+	// Parse optional FROM.
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == FROM {
+		if stmt.Sources, err = p.parseSources(false); err != nil {
+			return nil, err
+		}
+	} else {
+		p.Unscan()
+	}
+
+	return stmt, nil
+}
+
 // This function assumes the "SHOW MEASUREMENT" tokens have already been consumed.
 func (p *Parser) parseShowMeasurementCardinalityStatement(exact bool) (Statement, error) {
 	stmt := &ShowMeasurementCardinalityStatement{Exact: exact}
@@ -3052,7 +3084,7 @@ func (p *Parser) parseCall(name string) (*Call, error) {
 	} else {
 		// If there's a right paren then just return immediately.
 		if tok, _, _ := p.Scan(); tok == RPAREN {
-			return &Call{Name: name}, nil
+			return p.parseWindowSpec(&Call{Name: name})
 		}
 		p.Unscan()
 
@@ -3092,7 +3124,53 @@ func (p *Parser) parseCall(name string) (*Call, error) {
 		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
 	}
 
-	return &Call{Name: name, Args: args}, nil
+	return p.parseWindowSpec(&Call{Name: name, Args: args})
+}
+
+// parseWindowSpec parses an optional "OVER ([PARTITION BY <dimensions>] [ORDER BY <fields>])"
+// clause following a function call.
+func (p *Parser) parseWindowSpec(call *Call) (*Call, error) {
+	if tok, _, lit := p.ScanIgnoreWhitespace(); tok != IDENT || !strings.EqualFold(lit, "over") {
+		p.Unscan()
+		return call, nil
+	}
+
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != LPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{"("}, pos)
+	}
+
+	spec := &WindowSpec{}
+	if tok, _, _ := p.ScanIgnoreWhitespace(); tok == PARTITION {
+		if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != BY {
+			return nil, newParseError(tokstr(tok, lit), []string{"BY"}, pos)
+		}
+		for {
+			d, err := p.parseDimension()
+			if err != nil {
+				return nil, err
+			}
+			spec.PartitionBy = append(spec.PartitionBy, d)
+
+			if tok, _, _ := p.ScanIgnoreWhitespace(); tok != COMMA {
+				p.Unscan()
+				break
+			}
+		}
+	} else {
+		p.Unscan()
+	}
+
+	fields, err := p.parseOrderBy()
+	if err != nil {
+		return nil, err
+	}
+	spec.SortFields = fields
+
+	if tok, pos, lit := p.ScanIgnoreWhitespace(); tok != RPAREN {
+		return nil, newParseError(tokstr(tok, lit), []string{")"}, pos)
+	}
+	call.Window = spec
+	return call, nil
 }
 
 // parseResample parses a RESAMPLE [EVERY <duration>] [FOR <duration>].
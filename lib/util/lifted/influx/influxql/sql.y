@@ -101,6 +101,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
     cmOption            *CreateMeasurementStatementOption
     cte                 *CTE
     ctes                CTES
+    windowSpec          *WindowSpec
 }
 
 %token <str>    FROM MEASUREMENT INTO ON SELECT WHERE AS GROUP BY ORDER LIMIT OFFSET SLIMIT SOFFSET SHOW CREATE FULL PRIVILEGES OUTER JOIN
@@ -131,6 +132,9 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %left  <int>  MUL DIV MOD BITWISE_AND
 %right UMINUS
 
+%token <str>    INNER LEFT RIGHT ASOF TOLERANCE
+%token <str>    OVER
+
 %type <stmt>                        STATEMENT SHOW_DATABASES_STATEMENT CREATE_DATABASE_STATEMENT WITH_CLAUSES CREATE_USER_STATEMENT
                                     SELECT_STATEMENT SHOW_MEASUREMENTS_STATEMENT SHOW_MEASUREMENTS_DETAIL_STATEMENT SHOW_RETENTION_POLICIES_STATEMENT
                                     CREATE_RENTRENTION_POLICY_STATEMENT RP_DURATION_OPTIONS SHOW_SERIES_STATEMENT
@@ -140,7 +144,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
                                     GRANT_ADMIN_STATEMENT REVOKE_ADMIN_STATEMENT SHOW_TAG_KEYS_STATEMENT SHOW_FIELD_KEYS_STATEMENT SHOW_TAG_VALUES_STATEMENT
                                     TAG_VALUES_WITH  EXPLAIN_STATEMENT SHOW_TAG_KEY_CARDINALITY_STATEMENT SHOW_TAG_VALUES_CARDINALITY_STATEMENT
                                     SHOW_FIELD_KEY_CARDINALITY_STATEMENT CREATE_MEASUREMENT_STATEMENT DROP_SHARD_STATEMENT SET_PASSWORD_USER_STATEMENT
-                                    SHOW_GRANTS_FOR_USER_STATEMENT SHOW_MEASUREMENT_CARDINALITY_STATEMENT SHOW_SERIES_CARDINALITY_STATEMENT SHOW_SHARDS_STATEMENT
+                                    SHOW_GRANTS_FOR_USER_STATEMENT SHOW_MEASUREMENT_CARDINALITY_STATEMENT SHOW_SERIES_CARDINALITY_STATEMENT SHOW_SERIES_QUOTA_STATEMENT SHOW_SHARDS_STATEMENT
                                     ALTER_SHARD_KEY_STATEMENT SHOW_SHARD_GROUPS_STATEMENT DROP_MEASUREMENT_STATEMENT
                                     CREATE_CONTINUOUS_QUERY_STATEMENT SHOW_CONTINUOUS_QUERIES_STATEMENT DROP_CONTINUOUS_QUERY_STATEMENT
                                     CREATE_DOWNSAMPLE_STATEMENT DOWNSAMPLE_INTERVALS DROP_DOWNSAMPLE_STATEMENT SHOW_DOWNSAMPLE_STATEMENT
@@ -155,7 +159,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <ment>                        TABLE_OPTION  TABLE_NAME_WITH_OPTION TABLE_CASE MEASUREMENT_WITH
 %type <expr>                        WHERE_CLAUSE OR_CONDITION AND_CONDITION CONDITION OPERATION_EQUAL COLUMN_VAREF COLUMN CONDITION_COLUMN TAG_KEYS
                                     CASE_WHEN_CASE CASE_WHEN_CASES
-%type <int>                         CONDITION_OPERATOR
+%type <int>                         CONDITION_OPERATOR JOIN_TYPE
 %type <dataType>                    COLUMN_VAREF_TYPE
 %type <sortfs>                      SORTFIELDS ORDER_CLAUSES
 %type <sortf>                       SORTFIELD
@@ -172,6 +176,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <cqsp>                        SAMPLE_POLICY
 %type <tdurs>                       DURATIONVALS
 %type <cqsp>                        SAMPLE_POLICY
+%type <tdur>                        JOIN_TOLERANCE CMOPTION_TTL
 %type <int64>                       INTEGERPARA CMOPTION_SHARDNUM
 %type <bool>                        ALLOW_TAG_ARRAY
 %type <fieldOption>                 FIELD_OPTION FIELD_COLUMN
@@ -182,6 +187,7 @@ func deal_Fill (fill interface{})  (FillOption , interface{},bool) {
 %type <str>                         CMOPTION_ENGINETYPE_TS CMOPTION_ENGINETYPE_CS
 %type <ctes>                        CTE_CLAUSES
 %type <cte>                         CTE_CLAUSE
+%type <windowSpec>                  WINDOW_SPEC
 
 %%
 
@@ -344,6 +350,10 @@ STATEMENT:
     {
         $$ = $1
     }
+    |SHOW_SERIES_QUOTA_STATEMENT
+    {
+        $$ = $1
+    }
     |SHOW_SHARDS_STATEMENT
     {
         $$ = $1
@@ -692,6 +702,18 @@ COLUMN:
         cols := &Call{Name: strings.ToLower($1)}
         $$ = cols
     }
+    |IDENT LPAREN COLUMN_CLAUSES RPAREN OVER LPAREN WINDOW_SPEC RPAREN
+    {
+        cols := &Call{Name: strings.ToLower($1), Args: []Expr{}, Window: $7}
+        for i := range $3 {
+            cols.Args = append(cols.Args, $3[i].Expr)
+        }
+        $$ = cols
+    }
+    |IDENT LPAREN RPAREN OVER LPAREN WINDOW_SPEC RPAREN
+    {
+        $$ = &Call{Name: strings.ToLower($1), Window: $6}
+    }
     |SUB COLUMN %prec UMINUS
     {
         switch s := $2.(type) {
@@ -725,6 +747,16 @@ COLUMN:
     	$$ = &VarRef{}
     }
 
+WINDOW_SPEC:
+    PARTITION BY DIMENSION_NAMES ORDER_CLAUSES
+    {
+        $$ = &WindowSpec{PartitionBy: $3, SortFields: $4}
+    }
+    |ORDER_CLAUSES
+    {
+        $$ = &WindowSpec{SortFields: $1}
+    }
+
 INTO_CLAUSE:
     INTO TABLE_NAMES
     {
@@ -775,7 +807,7 @@ TABLE_NAMES:
     }
 
 JOIN_CLAUSE:
-    SUBQUERY_CLAUSE FULL JOIN TABLE_NAMES ON CONDITION
+    SUBQUERY_CLAUSE JOIN_TYPE JOIN TABLE_NAMES ON CONDITION
     {
         join := &Join{}
         if len($1) != 1 || len($4) != 1{
@@ -784,8 +816,66 @@ JOIN_CLAUSE:
         join.LSrc = $1[0]
         join.RSrc = $4[0]
         join.Condition = $6
+        join.JoinType = JoinType($2)
         $$ = join
     }
+    |SUBQUERY_CLAUSE ASOF JOIN TABLE_NAMES ON CONDITION JOIN_TOLERANCE
+    {
+        join := &Join{}
+        if len($1) != 1 || len($4) != 1{
+            yylex.Error("only support one query for join")
+        }
+        join.LSrc = $1[0]
+        join.RSrc = $4[0]
+        join.Condition = $6
+        join.JoinType = AsofJoin
+        join.Tolerance = $7
+        $$ = join
+    }
+
+JOIN_TOLERANCE:
+    TOLERANCE DURATIONVAL
+    {
+        $$ = $2
+    }
+    |
+    {
+        $$ = 0
+    }
+
+JOIN_TYPE:
+    FULL
+    {
+        $$ = int(FullJoin)
+    }
+    |FULL OUTER
+    {
+        $$ = int(FullJoin)
+    }
+    |INNER
+    {
+        $$ = int(InnerJoin)
+    }
+    |LEFT
+    {
+        $$ = int(LeftOuterJoin)
+    }
+    |LEFT OUTER
+    {
+        $$ = int(LeftOuterJoin)
+    }
+    |RIGHT
+    {
+        $$ = int(RightOuterJoin)
+    }
+    |RIGHT OUTER
+    {
+        $$ = int(RightOuterJoin)
+    }
+    |
+    {
+        $$ = int(InnerJoin)
+    }
 
 SUBQUERY_CLAUSE:
     LPAREN ALL_QUERY RPAREN
@@ -2499,6 +2589,7 @@ CREATE_MEASUREMENT_STATEMENT:
         stmt.NumOfShards = $5.NumOfShards
         stmt.Type = $5.Type
         stmt.EngineType = $5.EngineType
+        stmt.TTL = $5.TTL
 
         $$ = stmt
     }
@@ -2589,6 +2680,7 @@ CREATE_MEASUREMENT_STATEMENT:
         stmt.SortKey = $5.SortKey
         stmt.Property = $5.Property
         stmt.CompactType = $5.CompactType
+        stmt.TTL = $5.TTL
         $$ = stmt
     }
 
@@ -2599,55 +2691,70 @@ CMOPTIONS_TS:
         option.EngineType = "tsstore"
         $$ = option
     }
-    | WITH CMOPTION_ENGINETYPE_TS CMOPTION_INDEXTYPE_TS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE
+    | WITH CMOPTION_TTL CMOPTION_ENGINETYPE_TS CMOPTION_INDEXTYPE_TS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE
     {
         option := &CreateMeasurementStatementOption{}
-        if $3 != nil {
-            option.IndexType = $3.types
-            option.IndexList = $3.lists
-        }
         if $4 != nil {
-            option.ShardKey = $4
+            option.IndexType = $4.types
+            option.IndexList = $4.lists
+        }
+        if $5 != nil {
+            option.ShardKey = $5
         }
-        option.NumOfShards = $5
-        option.Type = $6
-        option.EngineType = $2
+        option.NumOfShards = $6
+        option.Type = $7
+        option.EngineType = $3
+        option.TTL = $2
         $$ = option
     }
 
 CMOPTIONS_CS:
-    WITH CMOPTION_ENGINETYPE_CS CMOPTION_INDEXTYPE_CS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE CMOPTION_PRIMARYKEY CMOPTION_SORTKEY CMOPTION_PROPERTIES COMPACTION_TYPE_CLAUSE
+    WITH CMOPTION_TTL CMOPTION_ENGINETYPE_CS CMOPTION_INDEXTYPE_CS CMOPTION_SHARDKEY CMOPTION_SHARDNUM TYPE_CLAUSE CMOPTION_PRIMARYKEY CMOPTION_SORTKEY CMOPTION_PROPERTIES COMPACTION_TYPE_CLAUSE
     {
         option := &CreateMeasurementStatementOption{}
-        if $3 != nil {
-            option.IndexType = $3.types
-            option.IndexList = $3.lists
-            option.TimeClusterDuration = $3.timeClusterDuration
-        }
         if $4 != nil {
-            option.ShardKey = $4
+            option.IndexType = $4.types
+            option.IndexList = $4.lists
+            option.TimeClusterDuration = $4.timeClusterDuration
         }
-        option.NumOfShards = $5
-        option.Type = $6
-        option.EngineType = $2
-        if $7 != nil {
-            option.PrimaryKey = $7
-        } else if $8 != nil {
+        if $5 != nil {
+            option.ShardKey = $5
+        }
+        option.NumOfShards = $6
+        option.Type = $7
+        option.EngineType = $3
+        if $8 != nil {
             option.PrimaryKey = $8
+        } else if $9 != nil {
+            option.PrimaryKey = $9
         }
 
-        if $8 != nil {
+        if $9 != nil {
+            option.SortKey = $9
+        } else if $8 != nil {
             option.SortKey = $8
-        } else if $7 != nil {
-            option.SortKey = $7
         }
-        if $9 != nil {
-            option.Property = $9
+        if $10 != nil {
+            option.Property = $10
         }
-        option.CompactType = $10
+        option.CompactType = $11
+        option.TTL = $2
         $$ = option
     }
 
+CMOPTION_TTL:
+    {
+        $$ = 0
+    }
+    | IDENT DURATIONVAL
+    {
+        if strings.ToLower($1) != "ttl" {
+            yylex.Error("expect TTL")
+            return 1
+        }
+        $$ = $2
+    }
+
 CMOPTION_INDEXTYPE_TS:
     {
         $$ = nil
@@ -3133,6 +3240,30 @@ SHOW_SERIES_CARDINALITY_STATEMENT:
     }
 
 
+SHOW_SERIES_QUOTA_STATEMENT:
+    SHOW SERIES IDENT ON_DATABASE FROM_CLAUSE
+    {
+        if strings.ToLower($3) != "quota" {
+            yylex.Error("expect QUOTA")
+            return 1
+        }
+        stmt := &ShowSeriesQuotaStatement{}
+        stmt.Database = $4
+        stmt.Sources = $5
+        $$ = stmt
+    }
+    |SHOW SERIES IDENT ON_DATABASE
+    {
+        if strings.ToLower($3) != "quota" {
+            yylex.Error("expect QUOTA")
+            return 1
+        }
+        stmt := &ShowSeriesQuotaStatement{}
+        stmt.Database = $4
+        $$ = stmt
+    }
+
+
 SHOW_SHARDS_STATEMENT:
     SHOW SHARDS
     {
@@ -3167,6 +3298,19 @@ ALTER_SHARD_KEY_STATEMENT:
         stmt.Type = "hash"
         $$ = stmt
     }
+    |ALTER MEASUREMENT TABLE_CASE WITH IDENT DURATIONVAL
+    {
+        if strings.ToLower($5) != "ttl" {
+            yylex.Error("expect TTL or SHARDKEY")
+            return 1
+        }
+        stmt := &AlterMeasurementTTLStatement{}
+        stmt.Database = $3.Database
+        stmt.Name = $3.Name
+        stmt.RetentionPolicy = $3.RetentionPolicy
+        stmt.TTL = $6
+        $$ = stmt
+    }
 
 
 
@@ -18,6 +18,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
@@ -371,6 +372,177 @@ func TestSingleParser(t *testing.T) {
 	}
 }
 
+func TestJoinTypeParser(t *testing.T) {
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	cases := map[string]influxql.JoinType{
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 full join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":        influxql.FullJoin,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 full outer join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":  influxql.FullJoin,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":             influxql.InnerJoin,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 INNER JOIN (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":       influxql.InnerJoin,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 left join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":        influxql.LeftOuterJoin,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 left outer join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":  influxql.LeftOuterJoin,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 right join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":       influxql.RightOuterJoin,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 right outer join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1": influxql.RightOuterJoin,
+	}
+	for c, joinType := range cases {
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		join, ok := q.Statements[len(q.Statements)-1].(*influxql.SelectStatement).Sources[0].(*influxql.Join)
+		if !ok {
+			t.Fatalf("expect join source with sql: %s", c)
+		}
+		if join.JoinType != joinType {
+			t.Fatalf("expect %s, got %s with sql: %s", joinType, join.JoinType, c)
+		}
+	}
+
+	// join types are still identifiers out of the join clause
+	c := "select left, right, inner, asof, tolerance from mst where left = 'a' group by right"
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+	YyParser.ParseTokens()
+	if _, err := YyParser.GetQuery(); err != nil {
+		t.Fatalf("%s with sql: %s", err.Error(), c)
+	}
+}
+
+func TestAsofJoinParser(t *testing.T) {
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	cases := map[string]time.Duration{
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 asof join (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) group by tk1":               0,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 ASOF JOIN (select f1 from mst) as m2 on (m1.tk1 = m2.tk1) tolerance 5s group by tk1":  5 * time.Second,
+		"select m1.f1, m2.f1 from (select f1 from mst) as m1 asof join (select f1 from mst) as m2 on m1.tk1 = m2.tk1 TOLERANCE 1m where m1.f1 > 1": time.Minute,
+	}
+	for c, tolerance := range cases {
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		join, ok := q.Statements[len(q.Statements)-1].(*influxql.SelectStatement).Sources[0].(*influxql.Join)
+		if !ok {
+			t.Fatalf("expect join source with sql: %s", c)
+		}
+		if join.JoinType != influxql.AsofJoin || join.Tolerance != tolerance {
+			t.Fatalf("expect asof join with tolerance %s, got %s %s with sql: %s", tolerance, join.JoinType, join.Tolerance, c)
+		}
+		if clone := influxql.CloneSource(join).(*influxql.Join); clone.Tolerance != tolerance {
+			t.Fatalf("expect tolerance %s of the cloned join, got %s", tolerance, clone.Tolerance)
+		}
+	}
+}
+
+func TestMeasurementTTLParser(t *testing.T) {
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	cases := map[string]time.Duration{
+		"create measurement mst0 with ttl 7d":                                                                            7 * 24 * time.Hour,
+		"create measurement mst0 with TTL 12h shardkey tag1 type hash":                                                   12 * time.Hour,
+		"create measurement mst0 (tag1 tag, field1 int64 field) with ttl 1h indextype text indexlist tag1 shardkey tag1": time.Hour,
+		"create measurement mst0 (tag1 tag, field1 int64 field) with TTL 30m ENGINETYPE = columnstore primarykey tag1":   30 * time.Minute,
+		"create measurement mst0 with shardkey tag1":                                                                     0,
+	}
+	for c, ttl := range cases {
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		stmt, ok := q.Statements[len(q.Statements)-1].(*influxql.CreateMeasurementStatement)
+		if !ok {
+			t.Fatalf("expect create measurement statement with sql: %s", c)
+		}
+		if stmt.TTL != ttl {
+			t.Fatalf("expect ttl %s, got %s with sql: %s", ttl, stmt.TTL, c)
+		}
+	}
+
+	alters := map[string]time.Duration{
+		"alter measurement mst0 with ttl 3d": 3 * 24 * time.Hour,
+		"ALTER MEASUREMENT mst0 WITH TTL 0s": 0,
+	}
+	for c, ttl := range alters {
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		stmt, ok := q.Statements[len(q.Statements)-1].(*influxql.AlterMeasurementTTLStatement)
+		if !ok {
+			t.Fatalf("expect alter measurement ttl statement with sql: %s", c)
+		}
+		if stmt.Name != "mst0" || stmt.TTL != ttl {
+			t.Fatalf("expect mst0 with ttl %s, got %s with ttl %s", ttl, stmt.Name, stmt.TTL)
+		}
+		if stmt.String() != "ALTER MEASUREMENT mst0 WITH TTL "+influxql.FormatDuration(ttl) {
+			t.Fatalf("unexpected string %s", stmt.String())
+		}
+	}
+
+	for _, c := range []string{
+		"create measurement mst0 with ttl2 7d",
+		"alter measurement mst0 with ttl2 7d",
+	} {
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		if _, err := YyParser.GetQuery(); err == nil {
+			t.Fatalf("expect error with sql: %s", c)
+		}
+	}
+}
+
+func TestShowSeriesQuotaParser(t *testing.T) {
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	cases := map[string]string{
+		"show series quota":                   "SHOW SERIES QUOTA",
+		"SHOW SERIES QUOTA ON db0":            "SHOW SERIES QUOTA ON db0",
+		"show series quota on db0 from mst0":  "SHOW SERIES QUOTA ON db0 FROM mst0",
+		"show series quota from mst0, /^cpu/": "SHOW SERIES QUOTA FROM mst0, /^cpu/",
+	}
+	for c, expect := range cases {
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		stmt, ok := q.Statements[len(q.Statements)-1].(*influxql.ShowSeriesQuotaStatement)
+		if !ok {
+			t.Fatalf("expect show series quota statement with sql: %s", c)
+		}
+		if stmt.String() != expect {
+			t.Fatalf("expect %s, got %s", expect, stmt.String())
+		}
+
+		parsed, err := influxql.NewParser(strings.NewReader(c)).ParseStatement()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		if parsed.String() != expect {
+			t.Fatalf("expect %s, got %s", expect, parsed.String())
+		}
+	}
+
+	YyParser.Scanner = influxql.NewScanner(strings.NewReader("show series quotas"))
+	YyParser.ParseTokens()
+	if _, err := YyParser.GetQuery(); err == nil {
+		t.Fatalf("expect error with sql: show series quotas")
+	}
+}
+
 func TestSingleParserError(t *testing.T) {
 	YyParser := &influxql.YyParser{
 		Query: influxql.Query{},
@@ -480,3 +652,35 @@ func BenchmarkPreviousParser(b *testing.B) {
 		}
 	}
 }
+
+func TestWindowFunctionParser(t *testing.T) {
+	YyParser := &influxql.YyParser{
+		Query: influxql.Query{},
+	}
+	cases := map[string]string{
+		"select value, lag(value, 2) over (partition by host, region order by time desc) from mst": `SELECT value, lag(value, 2) OVER (PARTITION BY host, region ORDER BY time DESC) FROM mst`,
+		"select value, row_number() OVER (ORDER BY time) as rn from mst":                           `SELECT value, row_number() OVER (ORDER BY time ASC) AS rn FROM mst`,
+		"select value, rank() over () from mst where over = 1":                                     `SELECT value, rank() OVER () FROM mst WHERE over = 1`,
+	}
+	for c, expect := range cases {
+		YyParser.Scanner = influxql.NewScanner(strings.NewReader(c))
+		YyParser.ParseTokens()
+		q, err := YyParser.GetQuery()
+		if err != nil {
+			t.Fatalf("%s with sql: %s", err.Error(), c)
+		}
+		stmt := q.Statements[len(q.Statements)-1]
+		if stmt.String() != expect {
+			t.Fatalf("expect %s, got %s", expect, stmt.String())
+		}
+
+		// the fields are sent to the store and parsed again
+		other, err := influxql.ParseStatement(stmt.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if other.String() != expect {
+			t.Fatalf("expect %s, got %s", expect, other.String())
+		}
+	}
+}
@@ -340,6 +340,21 @@ var tokens = [...]string{
 	COMPACT:        "COMPACT",
 	AUTO:           "AUTO",
 	EXCEPT:         "EXCEPT",
+	INNER:          "INNER",
+	LEFT:           "LEFT",
+	RIGHT:          "RIGHT",
+	ASOF:           "ASOF",
+	TOLERANCE:      "TOLERANCE",
+	OVER:           "OVER",
+}
+
+// joinTypes are the join types which are only treated as keywords right after a subquery,
+// so that they are still available as identifiers everywhere else.
+var joinTypes = map[string]int{
+	"inner": INNER,
+	"left":  LEFT,
+	"right": RIGHT,
+	"asof":  ASOF,
 }
 
 var keywords map[string]int
@@ -106,6 +106,7 @@ type yySymType struct {
 	cmOption         *CreateMeasurementStatementOption
 	cte              *CTE
 	ctes             CTES
+	windowSpec       *WindowSpec
 }
 
 const FROM = 57346
@@ -265,6 +266,12 @@ const DIV = 57499
 const MOD = 57500
 const BITWISE_AND = 57501
 const UMINUS = 57502
+const INNER = 57503
+const LEFT = 57504
+const RIGHT = 57505
+const ASOF = 57506
+const TOLERANCE = 57507
+const OVER = 57508
 
 var yyToknames = [...]string{
 	"$end",
@@ -427,6 +434,12 @@ var yyToknames = [...]string{
 	"MOD",
 	"BITWISE_AND",
 	"UMINUS",
+	"INNER",
+	"LEFT",
+	"RIGHT",
+	"ASOF",
+	"TOLERANCE",
+	"OVER",
 }
 
 var yyStatenames = [...]string{}
@@ -435,327 +448,341 @@ const yyEofCode = 1
 const yyErrCode = 2
 const yyInitialStackSize = 16
 
-//line sql.y:3597
+//line sql.y:3741
 
 //line yacctab:1
 var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 73,
-	4, 94,
-	-2, 141,
-	-1, 493,
-	113, 159,
-	133, 159,
-	134, 159,
-	135, 159,
-	136, 159,
-	137, 159,
-	138, 159,
-	141, 159,
-	142, 159,
-	-2, 147,
+	-1, 74,
+	4, 99,
+	-2, 157,
+	-1, 243,
+	23, 119,
+	-2, 103,
+	-1, 507,
+	113, 175,
+	133, 175,
+	134, 175,
+	135, 175,
+	136, 175,
+	137, 175,
+	138, 175,
+	141, 175,
+	142, 175,
+	-2, 163,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1252
+const yyLast = 1298
 
 var yyAct = [...]int16{
-	521, 536, 976, 917, 814, 949, 446, 831, 940, 728,
-	842, 412, 750, 782, 732, 276, 535, 742, 876, 4,
-	681, 581, 517, 665, 756, 661, 73, 812, 582, 519,
-	403, 530, 217, 444, 239, 245, 338, 465, 256, 156,
-	241, 2, 162, 77, 335, 243, 182, 895, 365, 366,
-	83, 708, 293, 662, 707, 896, 87, 88, 663, 929,
-	250, 249, 171, 172, 176, 173, 169, 170, 174, 175,
-	142, 410, 158, 171, 172, 176, 173, 169, 170, 174,
-	175, 89, 169, 170, 174, 175, 757, 758, 152, 748,
-	759, 91, 493, 637, 593, 159, 760, 83, 160, 91,
-	60, 165, 522, 87, 88, 160, 470, 950, 365, 366,
-	469, 365, 366, 218, 947, 523, 931, 78, 295, 91,
-	641, 642, 177, 283, 181, 915, 284, 921, 223, 224,
-	79, 85, 82, 86, 84, 886, 90, 885, 829, 235,
-	80, 237, 828, 76, 163, 916, 251, 684, 252, 91,
-	159, 986, 253, 160, 809, 83, 365, 366, 91, 763,
-	604, 87, 88, 218, 247, 216, 91, 713, 600, 215,
-	214, 268, 218, 244, 712, 91, 272, 248, 85, 82,
-	86, 84, 216, 90, 711, 710, 215, 80, 257, 218,
-	577, 817, 259, 280, 159, 639, 913, 160, 640, 278,
-	911, 227, 574, 575, 898, 294, 219, 817, 279, 298,
-	332, 299, 238, 285, 286, 287, 288, 289, 290, 291,
-	292, 304, 78, 768, 91, 219, 767, 60, 219, 257,
-	302, 303, 591, 589, 580, 79, 85, 82, 86, 84,
-	159, 90, 578, 160, 457, 80, 219, 271, 76, 348,
-	562, 330, 102, 434, 561, 682, 683, 433, 306, 531,
-	532, 816, 311, 686, 685, 230, 349, 534, 533, 323,
-	149, 399, 147, 322, 158, 980, 185, 820, 918, 116,
-	843, 297, 912, 368, 784, 364, 363, 219, 743, 97,
-	92, 367, 93, 94, 583, 667, 840, 351, 104, 806,
-	805, 797, 753, 752, 738, 697, 101, 696, 95, 369,
-	370, 655, 654, 400, 636, 634, 633, 631, 98, 629,
-	100, 615, 614, 417, 613, 590, 608, 606, 115, 112,
-	113, 114, 119, 105, 592, 108, 436, 103, 579, 109,
-	564, 528, 511, 510, 468, 408, 402, 507, 506, 106,
-	486, 478, 480, 415, 107, 183, 398, 397, 483, 484,
-	396, 393, 743, 110, 111, 392, 391, 388, 386, 117,
-	118, 356, 355, 354, 498, 499, 500, 471, 150, 443,
-	148, 416, 83, 385, 420, 422, 352, 425, 87, 88,
-	120, 496, 347, 346, 485, 96, 487, 345, 340, 333,
-	441, 491, 492, 377, 378, 379, 380, 381, 382, 257,
-	257, 384, 383, 982, 178, 501, 331, 327, 516, 542,
-	257, 515, 308, 180, 179, 300, 219, 612, 541, 270,
-	231, 229, 225, 213, 548, 525, 546, 552, 566, 211,
-	650, 219, 648, 219, 474, 611, 527, 565, 167, 78,
-	178, 91, 695, 475, 529, 616, 602, 573, 872, 180,
-	179, 563, 79, 85, 82, 86, 84, 468, 90, 601,
-	482, 472, 80, 432, 353, 76, 168, 526, 344, 576,
-	871, 721, 514, 513, 442, 847, 524, 524, 846, 544,
-	545, 91, 547, 598, 588, 551, 599, 610, 72, 987,
-	489, 607, 560, 603, 597, 605, 965, 952, 951, 569,
-	571, 572, 83, 946, 621, 638, 930, 624, 87, 88,
-	904, 620, 888, 618, 880, 844, 630, 839, 838, 645,
-	171, 172, 176, 173, 169, 170, 174, 175, 628, 837,
-	835, 834, 651, 669, 744, 740, 367, 644, 673, 739,
-	675, 726, 219, 643, 219, 623, 490, 476, 883, 674,
-	671, 672, 407, 678, 664, 221, 979, 698, 925, 679,
-	219, 894, 668, 786, 727, 706, 694, 649, 646, 78,
-	622, 91, 497, 494, 401, 702, 375, 704, 705, 374,
-	373, 371, 79, 85, 82, 86, 84, 74, 90, 343,
-	751, 653, 80, 360, 362, 76, 72, 981, 966, 942,
-	709, 891, 731, 858, 670, 656, 657, 735, 171, 172,
-	176, 173, 169, 170, 174, 175, 745, 746, 747, 692,
-	693, 836, 771, 772, 830, 723, 60, 770, 700, 701,
-	647, 703, 741, 627, 626, 625, 61, 62, 617, 273,
-	166, 404, 736, 336, 339, 186, 67, 458, 64, 71,
-	810, 189, 755, 749, 153, 766, 730, 754, 65, 232,
-	220, 155, 709, 774, 775, 972, 776, 761, 889, 141,
-	725, 66, 765, 881, 219, 69, 773, 825, 222, 720,
-	63, 124, 880, 339, 718, 779, 796, 785, 778, 219,
-	206, 780, 337, 236, 801, 68, 803, 804, 794, 795,
-	877, 792, 361, 945, 207, 975, 799, 800, 970, 802,
-	962, 359, 813, 504, 437, 819, 70, 123, 824, 524,
-	121, 430, 122, 832, 811, 325, 326, 428, 807, 320,
-	321, 337, 154, 189, 203, 204, 328, 818, 312, 189,
-	860, 791, 781, 60, 827, 196, 197, 198, 244, 200,
-	790, 201, 793, 777, 690, 680, 841, 787, 788, 677,
-	798, 554, 125, 833, 188, 853, 315, 722, 281, 128,
-	282, 257, 3, 849, 459, 845, 764, 126, 848, 194,
-	762, 127, 339, 857, 852, 851, 865, 866, 854, 195,
-	859, 868, 869, 922, 870, 652, 823, 60, 185, 864,
-	226, 861, 862, 187, 867, 318, 319, 61, 62, 409,
-	879, 192, 193, 151, 301, 873, 923, 67, 269, 64,
-	71, 202, 751, 808, 729, 878, 887, 882, 715, 65,
-	587, 586, 275, 884, 585, 890, 584, 146, 258, 855,
-	228, 856, 66, 212, 892, 161, 69, 190, 893, 902,
-	897, 63, 461, 863, 733, 734, 909, 900, 901, 910,
-	596, 310, 903, 143, 453, 456, 68, 454, 455, 908,
-	143, 905, 822, 821, 144, 919, 143, 924, 145, 914,
-	143, 832, 832, 826, 789, 920, 716, 70, 926, 927,
-	688, 689, 934, 935, 928, 676, 305, 557, 609, 191,
-	939, 933, 553, 550, 932, 464, 414, 427, 387, 341,
-	941, 518, 937, 938, 372, 899, 495, 260, 389, 632,
-	508, 505, 906, 907, 948, 954, 488, 875, 874, 956,
-	957, 261, 850, 953, 262, 390, 959, 769, 941, 963,
-	958, 964, 955, 266, 83, 413, 264, 967, 143, 406,
-	87, 88, 659, 660, 537, 538, 971, 413, 539, 405,
-	265, 978, 973, 277, 83, 619, 936, 274, 144, 210,
-	87, 88, 978, 985, 984, 983, 134, 143, 164, 635,
-	164, 144, 144, 419, 421, 60, 424, 426, 395, 737,
-	189, 394, 307, 503, 435, 481, 479, 313, 314, 440,
-	316, 317, 477, 473, 324, 460, 139, 358, 329, 357,
-	350, 78, 132, 91, 309, 129, 267, 131, 263, 234,
-	233, 209, 133, 208, 79, 85, 82, 86, 84, 540,
-	90, 502, 130, 91, 80, 411, 512, 509, 143, 205,
-	199, 157, 595, 594, 79, 85, 82, 86, 84, 463,
-	90, 462, 467, 466, 80, 724, 719, 135, 717, 815,
-	968, 969, 977, 960, 140, 943, 961, 449, 450, 944,
-	974, 99, 136, 137, 783, 445, 138, 658, 447, 451,
-	453, 456, 520, 454, 455, 666, 296, 376, 543, 448,
-	184, 81, 255, 549, 254, 246, 240, 242, 1, 556,
-	75, 559, 59, 55, 54, 53, 58, 57, 568, 570,
-	452, 56, 52, 51, 50, 342, 418, 49, 48, 423,
-	47, 46, 45, 429, 44, 431, 43, 42, 41, 40,
-	438, 39, 439, 38, 37, 36, 35, 34, 33, 32,
-	31, 30, 29, 28, 27, 26, 25, 24, 21, 20,
-	22, 19, 23, 18, 17, 16, 14, 15, 13, 12,
-	714, 7, 11, 10, 9, 8, 334, 6, 5, 0,
+	536, 553, 1014, 981, 974, 456, 900, 969, 862, 278,
+	749, 772, 552, 942, 598, 764, 850, 4, 753, 898,
+	683, 687, 532, 534, 778, 807, 599, 703, 74, 671,
+	547, 247, 454, 475, 411, 338, 341, 219, 258, 157,
+	243, 2, 163, 245, 183, 542, 367, 241, 373, 374,
+	416, 84, 170, 171, 175, 176, 922, 88, 89, 252,
+	251, 958, 730, 918, 923, 779, 780, 295, 729, 781,
+	655, 544, 941, 159, 143, 782, 373, 374, 90, 172,
+	173, 177, 174, 170, 171, 175, 176, 153, 172, 173,
+	177, 174, 170, 171, 175, 176, 84, 160, 92, 419,
+	161, 684, 88, 89, 166, 770, 685, 507, 673, 537,
+	160, 1024, 161, 161, 285, 610, 939, 286, 79, 297,
+	92, 926, 538, 982, 373, 374, 373, 374, 979, 225,
+	226, 80, 86, 83, 87, 85, 978, 91, 960, 956,
+	237, 81, 239, 190, 77, 253, 911, 254, 706, 164,
+	910, 255, 848, 78, 364, 172, 173, 177, 174, 170,
+	171, 175, 176, 249, 169, 92, 616, 847, 834, 216,
+	270, 659, 660, 785, 972, 274, 250, 86, 83, 87,
+	85, 92, 91, 742, 373, 374, 81, 368, 369, 370,
+	366, 261, 620, 735, 973, 220, 282, 61, 246, 734,
+	92, 733, 732, 648, 221, 594, 229, 218, 296, 281,
+	793, 217, 335, 280, 220, 193, 194, 240, 306, 903,
+	92, 591, 592, 221, 792, 92, 221, 480, 903, 304,
+	305, 479, 218, 178, 220, 182, 217, 61, 160, 220,
+	606, 161, 597, 595, 221, 160, 657, 467, 161, 658,
+	608, 333, 84, 351, 518, 273, 704, 705, 88, 89,
+	232, 548, 549, 308, 708, 707, 668, 313, 352, 551,
+	550, 579, 393, 407, 1018, 578, 159, 186, 300, 195,
+	301, 376, 975, 150, 84, 148, 221, 372, 371, 902,
+	88, 89, 385, 386, 387, 388, 389, 390, 906, 259,
+	392, 391, 666, 354, 377, 378, 172, 173, 177, 174,
+	170, 171, 175, 176, 444, 408, 519, 863, 443, 79,
+	600, 92, 940, 809, 287, 288, 289, 290, 291, 292,
+	293, 294, 80, 86, 83, 87, 85, 326, 91, 765,
+	259, 325, 81, 607, 689, 77, 860, 478, 831, 830,
+	299, 79, 410, 92, 488, 822, 184, 775, 774, 760,
+	719, 493, 494, 718, 80, 86, 83, 87, 85, 75,
+	91, 677, 765, 453, 81, 676, 654, 77, 652, 651,
+	649, 646, 512, 513, 514, 481, 631, 425, 630, 629,
+	429, 151, 432, 149, 435, 624, 622, 510, 612, 609,
+	596, 581, 545, 375, 526, 505, 506, 451, 525, 179,
+	495, 522, 497, 521, 496, 490, 424, 421, 181, 180,
+	531, 406, 426, 515, 405, 404, 221, 530, 559, 401,
+	400, 399, 396, 394, 359, 358, 446, 540, 357, 563,
+	355, 221, 350, 221, 349, 348, 343, 336, 583, 334,
+	330, 310, 302, 272, 233, 231, 227, 417, 215, 213,
+	179, 590, 628, 61, 168, 717, 484, 632, 618, 181,
+	180, 580, 492, 62, 63, 485, 482, 478, 442, 617,
+	627, 356, 347, 68, 1020, 65, 72, 894, 593, 541,
+	893, 539, 539, 841, 529, 66, 528, 452, 867, 92,
+	1021, 866, 561, 562, 605, 626, 564, 996, 67, 568,
+	613, 614, 70, 623, 615, 995, 577, 64, 619, 73,
+	621, 503, 984, 586, 588, 589, 638, 259, 259, 641,
+	656, 983, 69, 647, 558, 959, 946, 637, 259, 932,
+	913, 565, 869, 864, 569, 859, 634, 635, 645, 858,
+	857, 669, 855, 71, 582, 854, 84, 789, 766, 221,
+	691, 221, 88, 89, 762, 695, 662, 697, 761, 747,
+	949, 693, 694, 546, 661, 640, 686, 221, 221, 504,
+	486, 415, 701, 223, 720, 246, 1011, 716, 690, 953,
+	921, 811, 728, 748, 670, 667, 724, 664, 726, 727,
+	639, 543, 511, 508, 409, 383, 382, 381, 379, 346,
+	773, 73, 1019, 997, 991, 731, 363, 916, 880, 856,
+	796, 797, 675, 79, 795, 92, 665, 644, 752, 678,
+	679, 643, 642, 756, 633, 692, 80, 86, 83, 87,
+	85, 663, 91, 767, 768, 769, 81, 275, 167, 77,
+	714, 715, 412, 849, 339, 190, 744, 763, 187, 722,
+	723, 468, 725, 342, 835, 154, 234, 222, 156, 125,
+	375, 751, 1005, 696, 914, 422, 777, 700, 844, 771,
+	84, 757, 731, 746, 947, 776, 88, 89, 840, 783,
+	946, 838, 208, 238, 342, 799, 800, 221, 801, 943,
+	788, 209, 798, 1013, 787, 124, 224, 1002, 122, 142,
+	123, 340, 221, 804, 1009, 994, 899, 447, 821, 843,
+	190, 328, 329, 440, 819, 820, 826, 803, 828, 829,
+	805, 810, 824, 825, 362, 827, 323, 324, 836, 438,
+	817, 331, 340, 155, 539, 205, 206, 79, 314, 92,
+	126, 198, 199, 200, 851, 61, 882, 129, 832, 816,
+	80, 86, 83, 87, 85, 127, 91, 135, 189, 128,
+	81, 672, 202, 815, 203, 802, 712, 846, 806, 702,
+	699, 571, 318, 283, 791, 284, 812, 813, 818, 743,
+	852, 853, 321, 322, 469, 196, 823, 140, 786, 784,
+	875, 3, 342, 133, 871, 197, 130, 865, 132, 950,
+	868, 674, 842, 134, 870, 873, 418, 874, 303, 186,
+	895, 887, 888, 131, 951, 152, 890, 891, 886, 892,
+	876, 463, 466, 889, 464, 465, 271, 881, 773, 204,
+	833, 228, 750, 883, 884, 737, 604, 905, 136, 188,
+	603, 602, 601, 260, 230, 141, 912, 214, 191, 904,
+	909, 471, 147, 137, 138, 754, 755, 139, 915, 539,
+	908, 907, 919, 277, 920, 162, 741, 144, 877, 145,
+	878, 930, 144, 952, 144, 861, 845, 814, 937, 738,
+	711, 938, 885, 144, 698, 936, 625, 570, 474, 423,
+	395, 307, 312, 146, 710, 344, 931, 509, 945, 574,
+	933, 567, 259, 259, 944, 879, 851, 851, 948, 533,
+	437, 380, 262, 397, 650, 523, 520, 499, 498, 957,
+	963, 964, 954, 955, 502, 501, 263, 961, 968, 264,
+	398, 500, 897, 896, 966, 967, 192, 970, 681, 682,
+	268, 927, 976, 266, 971, 872, 554, 555, 934, 935,
+	977, 422, 794, 980, 986, 144, 790, 267, 988, 989,
+	985, 556, 413, 279, 422, 987, 970, 924, 990, 925,
+	144, 459, 460, 636, 276, 145, 928, 929, 145, 998,
+	212, 414, 457, 461, 463, 466, 999, 464, 465, 1003,
+	61, 1006, 1004, 458, 759, 758, 165, 1010, 965, 145,
+	1016, 403, 190, 517, 402, 1017, 491, 489, 487, 483,
+	1016, 1023, 1022, 103, 462, 428, 430, 431, 470, 434,
+	436, 361, 360, 353, 311, 269, 962, 445, 265, 309,
+	236, 235, 450, 211, 315, 316, 317, 210, 319, 320,
+	117, 165, 327, 557, 420, 653, 332, 527, 524, 144,
+	98, 93, 207, 94, 95, 201, 158, 740, 739, 105,
+	473, 472, 477, 61, 476, 611, 917, 102, 745, 96,
+	839, 837, 901, 62, 63, 1007, 1008, 1015, 1000, 99,
+	992, 101, 1001, 68, 993, 65, 72, 1012, 100, 116,
+	113, 114, 115, 120, 106, 66, 109, 808, 104, 84,
+	110, 455, 680, 535, 688, 88, 89, 298, 67, 365,
+	107, 384, 70, 185, 82, 108, 257, 64, 256, 248,
+	242, 244, 1, 76, 111, 112, 60, 560, 56, 55,
+	118, 119, 69, 566, 54, 59, 58, 57, 53, 573,
+	52, 576, 51, 345, 50, 49, 48, 47, 585, 587,
+	46, 121, 45, 71, 427, 44, 97, 43, 433, 42,
+	41, 40, 439, 39, 441, 38, 516, 37, 92, 448,
+	36, 449, 35, 34, 33, 32, 31, 30, 29, 80,
+	86, 83, 87, 85, 28, 91, 27, 26, 25, 81,
+	24, 21, 20, 22, 19, 23, 18, 17, 16, 14,
+	15, 13, 12, 736, 7, 11, 10, 9, 8, 337,
+	6, 5, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 687, 0, 0, 691, 0,
-	0, 0, 555, 0, 558, 0, 0, 699, 0, 0,
-	0, 567,
+	0, 0, 709, 0, 0, 713, 0, 0, 572, 0,
+	575, 0, 0, 0, 721, 0, 0, 584,
 }
 
 var yyPact = [...]int16{
-	799, -1000, 477, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1065, -1000, 482, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	449, 247, 686, 981, 983, 842, 237, 235, 745, 627,
-	563, -48, 799, 982, 319, 522, 308, 466, 891, 320,
-	891, -1000, -1000, 212, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 535, 654, 810, 742, 728, -1000, 681, 1046,
-	685, 773, 665, 1045, 606, 626, 1026, 1024, -1000, -1000,
-	-1000, 970, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 296, 805, 290, 26, 562, 558, -48, -48, 289,
-	983, 802, 288, 121, 287, 561, 1023, 1022, -48, 611,
-	-48, 969, -1000, 43, 34, 800, 26, 920, 1021, 949,
-	1019, 987, -1000, 770, 286, 103, 987, 521, 967, -1000,
-	-1000, -1000, 1044, 962, 43, 984, 319, 707, -20, 891,
-	891, 891, 891, 891, 891, 891, 891, -79, -13, 138,
-	282, -1000, 758, 744, 744, 34, -1000, 875, 993, 279,
-	1017, 983, 668, 993, 993, 701, 993, 736, 660, 130,
-	993, 656, 274, 666, 993, 26, -1000, -1000, 273, -48,
-	256, 622, 255, 888, 469, 339, 254, -1000, -1000, -1000,
-	250, 249, 319, 984, 1013, -1000, 969, -1000, 243, -1000,
-	-1000, 335, 230, 229, 228, -1000, 1012, 1010, -1000, -1000,
-	593, 584, -1000, -1000, 628, -102, -1000, 34, 284, 461,
-	897, 460, 459, 456, -1000, -1000, 270, -90, 225, 887,
-	224, 921, 223, 222, 218, 994, 217, 214, -1000, 213,
-	-48, -1000, -1000, -48, 454, 969, 526, 957, -1000, 1044,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -74, -74, -74,
-	-1000, -1000, -74, -1000, 431, -1000, -1000, -1000, -1000, -1000,
-	-1000, 891, 753, -1000, 6, 1040, 942, 885, -1000, 210,
-	969, 942, 993, 983, 983, 993, 983, 886, 657, 993,
-	651, 993, 334, 114, 954, 644, 993, -1000, 993, 983,
-	-1000, -1000, -1000, 351, 583, -1000, 1039, 100, 538, 712,
-	1008, 825, 884, -48, -33, 332, 1006, 314, 426, 1005,
-	-48, -1000, 999, 209, 998, 331, -1000, -48, -48, 43,
-	207, 43, 913, 369, 425, 34, 34, -79, -39, 453,
-	901, 987, 452, -48, -48, -48, 911, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 996, 642, 907, 205,
-	204, -1000, 906, 1043, 200, 199, -1000, 1042, 350, 349,
-	-1000, 987, 962, 892, -41, -41, 969, -1000, 378, 198,
-	891, 126, 950, 956, 1034, -1000, 942, 950, 983, 969,
-	962, 969, 942, 882, 969, 942, 881, 695, 993, 876,
-	993, 983, 111, 322, 197, 942, 950, 993, 983, 983,
-	969, 962, 59, -1000, -1000, 1039, -1000, 45, 98, 195,
-	90, -1000, 151, 797, 795, 792, 791, 721, 89, 182,
-	191, -52, -1000, -1000, 838, -1000, -48, 365, 97, 317,
-	17, -1000, 17, 184, 319, 183, 877, 987, 306, 181,
-	-1000, 179, 178, -1000, 316, -1000, 520, -1000, 43, 965,
-	-1000, -1000, -1000, -1000, 92, 450, 424, 987, 517, 516,
-	515, -1000, 34, 176, 151, 174, 905, -1000, 173, 172,
-	985, -1000, 171, -53, 51, 422, 526, 942, 448, -1000,
-	512, 302, 447, 300, -1000, -1000, 962, -1000, 737, -90,
-	969, 169, 168, 359, 359, -1000, 946, -91, -91, 152,
-	126, 950, -1000, 969, 962, 962, 950, 942, 950, 874,
-	693, 942, 950, 689, 122, 869, 870, 688, 983, 969,
-	962, 313, 164, 162, -1000, 950, -1000, 983, 969, 962,
-	969, 962, 962, 950, -96, -99, -1000, -1000, -1000, -1000,
-	-1000, 482, -1000, -1000, 40, 39, 29, 22, -1000, -1000,
-	-1000, -1000, 789, 865, 599, 594, 348, -1000, -1000, -1000,
-	-1000, 704, 17, -1000, -1000, -1000, 580, 420, 444, 785,
-	560, -48, 829, -1000, -1000, -1000, -48, 43, 992, 161,
-	418, 414, 219, -1000, 413, -48, -48, -48, -42, 1039,
-	544, -1000, 160, -1000, -1000, 159, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 892, 950, -57, -41, 719, 14,
-	715, 526, -1000, 942, -1000, -1000, -1000, -1000, -1000, 82,
-	79, 932, -1000, -1000, -1000, -1000, 509, 506, -1000, -1000,
-	962, 950, 950, -1000, 950, -1000, 687, 122, 950, -1000,
-	122, 969, 141, 141, 443, 359, 359, 863, 684, 675,
-	122, 969, 962, 962, 950, 158, -1000, -1000, -1000, 969,
-	962, 962, 950, 962, 950, 950, -1000, 157, 156, 151,
-	-1000, -1000, -1000, -1000, 783, 9, 625, 641, 118, 641,
-	134, 849, -1000, -1000, 739, 629, 862, 319, -1000, -3,
-	-7, 513, -48, -1000, -1000, -1000, -1000, 34, -1000, -1000,
-	-1000, 410, 409, 503, -1000, 408, 397, 396, -1000, -1000,
-	-1000, 153, -1000, -1000, 942, 137, 394, -1000, -1000, -1000,
-	-57, -1000, -1000, 357, -1000, 892, 950, 925, -1000, -91,
-	152, -1000, -1000, 950, -1000, -1000, -1000, 122, 969, -1000,
-	969, 942, -1000, 485, -1000, -1000, 141, -1000, -1000, 674,
-	122, 122, 969, 962, 950, 950, -1000, -1000, 962, 950,
-	950, -1000, 950, -1000, -1000, 347, 325, -1000, -1000, 765,
-	917, 916, 620, 151, -1000, 118, 596, 587, 620, -1000,
-	428, -1000, -1000, 987, -8, -10, 785, 391, 575, -1000,
-	829, -1000, 483, -102, -1000, -1000, 145, -1000, -1000, -1000,
-	-1000, 950, -1000, 441, -1000, -1000, -1000, -98, 942, -1000,
-	60, -1000, -1000, -1000, 969, 942, 942, 950, 141, 389,
-	122, 969, 969, 962, 950, -1000, -1000, 950, -1000, -1000,
-	-1000, 56, 139, 52, -1000, -1000, 776, 1, 482, -1000,
-	135, 135, 776, -18, 735, 768, -1000, -1000, 856, 438,
-	-48, -48, -1000, 137, -87, 385, -29, 950, -1000, 942,
-	950, 950, -1000, -1000, -1000, 969, 962, 962, 950, -1000,
-	-1000, -1000, -1000, 823, -1000, -1000, -1000, -1000, 481, -1000,
-	631, 382, -1000, -31, 785, -38, -1000, -1000, -1000, 377,
-	-1000, 376, 137, 950, -1000, -1000, 962, 950, 950, -1000,
-	-1000, 823, 135, 637, -1000, 135, 118, -1000, -1000, 375,
-	480, -1000, -1000, -1000, -1000, 950, -1000, -1000, -1000, -1000,
-	634, -1000, 135, -1000, -1000, 571, -38, -1000, 630, -1000,
-	-48, -1000, 436, -1000, -1000, 132, -1000, 479, 280, -38,
-	-1000, -48, 7, 368, -1000, -1000, -1000, -1000,
+	-1000, 221, 1018, 664, 762, 976, 857, 250, 248, 747,
+	628, 560, -46, 1065, 1000, 493, 520, 324, 154, 617,
+	330, 617, -1000, -1000, 213, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 538, 648, 811, 136, 734, -1000, 677,
+	1061, 698, 781, 666, 1058, 598, 613, 1040, 1036, -1000,
+	-1000, -1000, 981, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 316, 809, 315, 93, 559, 576, -46, -46,
+	313, 976, 806, 312, 116, 311, 558, 1034, 1033, -46,
+	601, -46, 979, -1000, 68, 33, 805, 93, 915, 1031,
+	946, 1028, 992, -1000, 778, 310, 111, 992, 519, 974,
+	-1000, -1000, -1000, 1055, 962, 68, 1045, 493, 712, -29,
+	617, 617, 617, 617, 617, 617, 617, 617, -64, -12,
+	207, 309, -1000, 752, 755, 755, 33, -1000, 870, 1005,
+	308, 1027, 976, 668, 1005, 1005, 1005, 707, 1005, 713,
+	657, 198, 1005, 642, 307, 661, 1005, 93, -1000, -1000,
+	306, -46, 304, 623, 303, 874, 479, 343, 302, -1000,
+	-1000, -1000, 301, 299, 493, 1045, 1026, -1000, 979, -1000,
+	297, -1000, -1000, 342, 295, 292, 291, -1000, 1025, 1024,
+	-1000, -1000, 606, 26, -1000, -1000, 455, -74, -1000, 33,
+	279, 478, 894, 477, 476, 475, -1000, -1000, 159, -73,
+	290, 869, 289, 916, 288, 287, 286, 1007, 282, 281,
+	-1000, 278, -46, -1000, -1000, -46, 474, 979, 527, 960,
+	-1000, 1055, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -104,
+	-104, -104, -1000, -1000, -104, -1000, 450, -116, -1000, -1000,
+	-1000, -1000, -1000, 617, 750, -1000, 34, 1049, 948, 868,
+	-1000, 273, 979, 948, 1005, 976, 1055, 976, 1005, 976,
+	889, 659, 1005, 643, 1005, 339, 175, 961, 637, 1005,
+	-1000, 1005, 976, -1000, -1000, -1000, 364, 592, -1000, 943,
+	103, 542, 722, 1021, 824, 867, -46, 88, 337, 1012,
+	336, 449, 1011, -46, -1000, 1010, 272, 1009, 333, -1000,
+	-46, -46, 68, 271, 68, 905, 904, 919, -1000, 913,
+	912, 390, 448, 33, 33, -64, -24, 473, 882, 992,
+	472, -46, -46, -46, 1046, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1006, 173, 902, 270, 268, -1000,
+	901, 1054, 265, 261, -1000, 1053, 363, 361, -1000, 992,
+	962, 890, -34, -34, 979, -121, 471, 3, 259, 617,
+	128, 942, 959, 1048, -1000, 948, 942, 976, 979, 962,
+	-1000, 979, 948, 880, 979, 948, 866, 705, 1005, 878,
+	1005, 976, 132, 332, 258, 948, 942, 1005, 976, 976,
+	979, 962, 78, -1000, -1000, 943, -1000, 60, 99, 257,
+	98, -1000, 177, 803, 802, 801, 797, 731, 96, 200,
+	256, -31, -1000, -1000, 255, -1000, -46, 383, 95, 329,
+	49, -1000, 49, 253, 493, 252, 865, 992, 341, 246,
+	-1000, 245, 243, -1000, 328, -1000, 506, -1000, 68, 68,
+	-1000, -1000, -1000, 973, -1000, -1000, -1000, -1000, 189, 470,
+	444, 992, 504, 503, 499, -1000, 33, 238, 177, 58,
+	237, 900, -1000, 236, 235, 1051, -1000, 233, -76, 102,
+	443, 527, 948, 467, -1000, 498, 162, 465, 126, -1000,
+	-1000, 962, 464, 662, -1000, 743, -73, 979, 232, 228,
+	367, 367, -1000, 932, -43, -43, 201, 128, 942, -1000,
+	979, 962, 962, 942, 948, 942, 863, 704, 948, 942,
+	703, 123, 873, 859, 700, 976, 979, 962, 326, 220,
+	217, -1000, 942, -1000, 976, 979, 962, 979, 962, 962,
+	942, -82, -88, -1000, -1000, -1000, -1000, -1000, 487, -1000,
+	-1000, 57, 56, 54, 48, -1000, -1000, -1000, -1000, 796,
+	858, 844, 38, -1000, -1000, -1000, -1000, 716, 49, -1000,
+	-1000, -1000, 583, 438, 463, 793, 565, -46, 830, -1000,
+	-1000, -1000, -46, 68, 998, 997, 216, 437, 433, 229,
+	-1000, 427, -46, -46, -46, -26, 943, 554, -1000, -1000,
+	215, -1000, -1000, 214, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 890, 942, -78, -34, 728, 28, 727, 527,
+	662, 426, 954, -1000, -1000, 948, -1000, -1000, -1000, -1000,
+	-1000, 80, 66, 947, -1000, -1000, -1000, -1000, 496, 494,
+	-1000, -1000, 962, 942, 942, -1000, 942, -1000, 699, 123,
+	942, -1000, 123, 979, 180, 180, 461, 367, 367, 856,
+	697, 683, 123, 979, 962, 962, 942, 212, -1000, -1000,
+	-1000, 979, 962, 962, 942, 962, 942, 942, -1000, 206,
+	205, 177, -1000, -1000, -1000, -1000, 790, 23, 629, 596,
+	593, 360, -1000, -1000, -1000, 745, 620, 855, 493, -1000,
+	22, 7, 532, -46, -1000, -1000, -1000, -1000, 33, 33,
+	-1000, -1000, -1000, 424, 421, 491, -1000, 419, 418, 414,
+	-1000, -1000, -1000, 203, -1000, -1000, 948, 174, 412, -1000,
+	-1000, -1000, -78, -1000, -1000, 370, -1000, 890, 411, -1000,
+	-34, 942, 938, -1000, -43, 201, -1000, -1000, 942, -1000,
+	-1000, -1000, 123, 979, -1000, 979, 948, -1000, 490, -1000,
+	-1000, 180, -1000, -1000, 680, 123, 123, 979, 962, 942,
+	942, -1000, -1000, 962, 942, 942, -1000, 942, -1000, -1000,
+	357, 354, -1000, -1000, 760, 922, 921, 635, 146, 635,
+	155, 837, 992, 5, 1, 793, 409, 571, -1000, 830,
+	-1000, 489, -74, -102, -1000, -1000, 196, -1000, -1000, -1000,
+	-1000, 942, -1000, 460, -1000, -1000, -1000, -89, 948, -1000,
+	948, -1000, -23, -1000, -1000, -1000, 979, 948, 948, 942,
+	180, 408, 123, 979, 979, 962, 942, -1000, -1000, 942,
+	-1000, -1000, -1000, -28, 179, -72, -1000, -1000, 609, 177,
+	-1000, 146, 594, 588, 609, -1000, 440, -1000, -1000, 741,
+	766, -1000, -1000, 852, 459, -46, -46, -1000, -6, -1000,
+	174, -85, 404, -7, 942, -1000, -1000, 948, 942, 942,
+	-1000, -1000, -1000, 979, 962, 962, 942, -1000, -1000, -1000,
+	-1000, 780, 782, 50, 487, -1000, 139, 139, 782, -9,
+	-1000, -17, 793, -22, -1000, -1000, -1000, -1000, 400, -1000,
+	391, 174, 942, -1000, -1000, 962, 942, 942, -1000, -1000,
+	780, -1000, -1000, -1000, -1000, 486, -1000, 633, 384, -1000,
+	-1000, 376, 485, -1000, -1000, -1000, -1000, 942, -1000, -1000,
+	-1000, 139, 624, -1000, 139, 146, 568, -22, -1000, -1000,
+	630, -1000, 139, -1000, -1000, 456, -1000, 618, -1000, -46,
+	-1000, -22, -1000, 131, -1000, 484, 351, 369, -1000, -46,
+	-33, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int16{
-	0, 782, 1178, 1177, 1176, 1175, 19, 1174, 1173, 1172,
-	1171, 1170, 1169, 1168, 1167, 1166, 1165, 1164, 1163, 1162,
-	1161, 1160, 1159, 1158, 1157, 1156, 1155, 20, 1154, 1153,
-	1152, 1151, 1150, 1149, 1148, 1147, 1146, 1145, 1144, 1143,
-	1141, 1139, 1138, 1137, 1136, 1134, 9, 1132, 1131, 1130,
-	1128, 1127, 1125, 1124, 1123, 1122, 1121, 1117, 1116, 1115,
-	1114, 1113, 1112, 26, 17, 1110, 1108, 41, 679, 34,
-	40, 42, 1107, 32, 1106, 45, 31, 70, 1105, 1104,
-	35, 1102, 1101, 43, 38, 13, 1100, 46, 1097, 1096,
-	23, 11, 1095, 15, 30, 29, 1092, 16, 1, 1087,
-	22, 24, 8, 6, 1085, 33, 81, 1084, 813, 12,
-	28, 0, 1081, 14, 1080, 21, 27, 3, 1079, 1076,
-	7, 1075, 1073, 2, 1072, 1071, 1070, 10, 1069, 4,
-	1068, 1066, 1065, 5, 25, 18, 36, 1063, 1062, 37,
-	44, 1061, 1059, 1053, 1052, 39, 1051,
+	0, 801, 1221, 1220, 1219, 1218, 17, 1217, 1216, 1215,
+	1214, 1213, 1212, 1211, 1210, 1209, 1208, 1207, 1206, 1205,
+	1204, 1203, 1202, 1201, 1200, 1198, 1197, 27, 1196, 1194,
+	1188, 1187, 1186, 1185, 1184, 1183, 1182, 1180, 1177, 1175,
+	1173, 1171, 1170, 1169, 1167, 1165, 1162, 10, 1160, 1157,
+	1156, 1155, 1154, 1153, 1152, 1150, 1148, 1147, 1146, 1145,
+	1144, 1139, 1138, 1136, 28, 15, 1133, 1132, 41, 709,
+	47, 40, 42, 1131, 37, 1130, 43, 30, 74, 1129,
+	1128, 31, 1126, 1124, 153, 38, 25, 1123, 44, 1121,
+	1119, 1117, 21, 108, 1114, 9, 34, 23, 1113, 12,
+	1, 1112, 22, 24, 7, 5, 1111, 32, 78, 1107,
+	849, 11, 26, 0, 1098, 18, 1097, 14, 19, 4,
+	1094, 1092, 16, 1090, 1088, 2, 1087, 1086, 1085, 8,
+	1082, 6, 1081, 1080, 1078, 3, 1076, 1075, 20, 13,
+	36, 1074, 1072, 33, 35, 1071, 1070, 1068, 1067, 39,
+	1066, 29,
 }
 
 var yyR1 = [...]uint8{
-	0, 66, 67, 67, 67, 1, 1, 1, 1, 1,
+	0, 67, 68, 68, 68, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 6, 6, 6, 63, 63, 65, 65, 65, 65,
-	65, 65, 87, 87, 86, 64, 64, 83, 83, 83,
-	83, 83, 83, 83, 83, 83, 83, 83, 83, 83,
-	83, 83, 83, 71, 71, 68, 69, 69, 69, 69,
-	69, 69, 69, 72, 70, 70, 70, 74, 75, 75,
-	75, 75, 75, 73, 73, 73, 93, 93, 94, 94,
-	95, 95, 111, 111, 96, 96, 96, 96, 96, 96,
-	96, 96, 127, 127, 100, 100, 101, 101, 101, 101,
-	77, 77, 79, 79, 78, 78, 80, 80, 80, 80,
-	80, 80, 80, 80, 80, 80, 80, 81, 84, 84,
-	88, 88, 88, 88, 88, 88, 88, 88, 88, 106,
-	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
-	89, 89, 89, 91, 91, 90, 90, 92, 92, 92,
-	97, 134, 134, 98, 98, 98, 98, 99, 99, 99,
-	99, 2, 2, 3, 3, 140, 140, 140, 140, 140,
-	136, 136, 4, 105, 105, 104, 104, 104, 104, 104,
-	104, 104, 7, 7, 8, 8, 76, 76, 76, 76,
-	9, 9, 10, 10, 5, 5, 5, 11, 11, 102,
-	102, 103, 103, 103, 103, 12, 12, 12, 12, 13,
-	15, 14, 14, 16, 16, 17, 18, 20, 20, 20,
-	22, 22, 21, 21, 21, 23, 23, 19, 24, 24,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 53,
-	53, 53, 53, 53, 108, 108, 25, 25, 26, 26,
-	26, 26, 27, 27, 27, 27, 27, 85, 85, 107,
-	28, 28, 29, 29, 29, 29, 30, 30, 30, 30,
-	31, 31, 31, 31, 32, 32, 141, 141, 142, 130,
-	130, 131, 131, 131, 116, 116, 135, 135, 135, 143,
-	143, 144, 121, 121, 122, 122, 126, 126, 114, 114,
-	52, 52, 139, 139, 137, 137, 138, 138, 138, 128,
-	128, 129, 129, 117, 117, 109, 109, 118, 119, 123,
-	123, 125, 124, 124, 124, 115, 115, 110, 33, 34,
-	35, 36, 36, 36, 36, 37, 37, 37, 37, 38,
-	38, 39, 39, 40, 41, 41, 42, 132, 132, 132,
-	132, 43, 44, 45, 45, 45, 47, 47, 47, 47,
-	48, 48, 46, 133, 133, 49, 49, 50, 50, 51,
-	54, 55, 120, 120, 113, 113, 59, 59, 60, 61,
-	61, 61, 61, 56, 57, 57, 57, 57, 57, 58,
-	58, 58, 58, 58, 62, 145, 145, 146,
+	1, 1, 6, 6, 6, 64, 64, 66, 66, 66,
+	66, 66, 66, 88, 88, 87, 65, 65, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 151, 151, 72, 72,
+	69, 70, 70, 70, 70, 70, 70, 70, 73, 73,
+	136, 136, 90, 90, 90, 90, 90, 90, 90, 90,
+	71, 71, 71, 75, 76, 76, 76, 76, 76, 74,
+	74, 74, 95, 95, 96, 96, 97, 97, 113, 113,
+	98, 98, 98, 98, 98, 98, 98, 98, 129, 129,
+	102, 102, 103, 103, 103, 103, 78, 78, 80, 80,
+	79, 79, 81, 81, 81, 81, 81, 81, 81, 81,
+	81, 81, 81, 82, 85, 85, 89, 89, 89, 89,
+	89, 89, 89, 89, 89, 108, 83, 83, 83, 83,
+	83, 83, 83, 83, 83, 83, 91, 91, 91, 93,
+	93, 92, 92, 94, 94, 94, 99, 138, 138, 100,
+	100, 100, 100, 101, 101, 101, 101, 2, 2, 3,
+	3, 144, 144, 144, 144, 144, 140, 140, 4, 107,
+	107, 106, 106, 106, 106, 106, 106, 106, 7, 7,
+	8, 8, 77, 77, 77, 77, 9, 9, 10, 10,
+	5, 5, 5, 11, 11, 104, 104, 105, 105, 105,
+	105, 12, 12, 12, 12, 13, 15, 14, 14, 16,
+	16, 17, 18, 20, 20, 20, 22, 22, 21, 21,
+	21, 23, 23, 19, 24, 24, 114, 114, 114, 114,
+	114, 114, 114, 114, 114, 54, 54, 54, 54, 54,
+	110, 110, 25, 25, 26, 26, 26, 26, 27, 27,
+	27, 27, 27, 86, 86, 109, 28, 28, 29, 29,
+	29, 29, 30, 30, 30, 30, 31, 31, 31, 31,
+	32, 32, 145, 145, 146, 137, 137, 132, 132, 133,
+	133, 133, 118, 118, 139, 139, 139, 147, 147, 148,
+	123, 123, 124, 124, 128, 128, 116, 116, 53, 53,
+	143, 143, 141, 141, 142, 142, 142, 130, 130, 131,
+	131, 119, 119, 111, 111, 120, 121, 125, 125, 127,
+	126, 126, 126, 117, 117, 112, 33, 34, 35, 36,
+	36, 36, 36, 37, 37, 37, 37, 38, 38, 39,
+	39, 40, 40, 40, 41, 42, 42, 43, 134, 134,
+	134, 134, 44, 45, 46, 46, 46, 48, 48, 48,
+	48, 49, 49, 47, 135, 135, 50, 50, 51, 51,
+	52, 55, 56, 122, 122, 115, 115, 60, 60, 61,
+	62, 62, 62, 62, 57, 58, 58, 58, 58, 58,
+	59, 59, 59, 59, 59, 63, 149, 149, 150,
 }
 
 var yyR2 = [...]int8{
@@ -765,146 +792,152 @@ var yyR2 = [...]int8{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 11, 12, 9, 1, 3, 1, 3, 3, 1,
-	3, 3, 1, 2, 4, 1, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 4, 3, 2, 1,
-	1, 5, 6, 2, 0, 2, 1, 3, 1, 3,
-	3, 5, 1, 6, 3, 5, 3, 1, 5, 4,
-	4, 3, 1, 1, 1, 1, 3, 0, 2, 0,
-	1, 3, 1, 1, 1, 3, 4, 6, 7, 1,
-	3, 1, 4, 0, 4, 0, 1, 1, 1, 2,
-	2, 0, 1, 3, 1, 3, 1, 3, 5, 5,
-	4, 6, 6, 5, 6, 6, 6, 3, 1, 3,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 3, 1, 1, 1, 1, 1, 1, 3, 1,
-	1, 1, 1, 3, 0, 1, 3, 1, 2, 2,
-	2, 1, 1, 4, 2, 2, 0, 4, 2, 2,
-	0, 2, 3, 5, 4, 2, 1, 3, 3, 0,
-	3, 3, 2, 1, 2, 1, 2, 2, 2, 2,
-	1, 2, 9, 6, 7, 4, 2, 2, 2, 2,
-	5, 3, 7, 8, 6, 9, 9, 5, 4, 1,
-	2, 3, 3, 3, 3, 7, 6, 8, 7, 2,
-	3, 4, 3, 3, 2, 7, 6, 6, 7, 6,
-	5, 4, 6, 7, 6, 5, 4, 3, 8, 7,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 4,
-	8, 7, 7, 6, 2, 0, 7, 6, 11, 10,
-	12, 11, 2, 2, 4, 2, 2, 1, 3, 1,
-	3, 2, 10, 9, 9, 8, 13, 12, 12, 11,
-	10, 9, 9, 8, 5, 5, 0, 6, 10, 0,
-	2, 0, 2, 6, 0, 2, 0, 2, 2, 0,
-	3, 3, 0, 1, 0, 1, 0, 1, 0, 2,
-	2, 0, 2, 1, 2, 2, 2, 3, 2, 3,
-	3, 2, 0, 1, 3, 2, 0, 2, 2, 3,
-	1, 2, 3, 3, 0, 1, 3, 1, 3, 6,
-	4, 9, 8, 8, 7, 9, 8, 8, 7, 2,
-	4, 7, 3, 3, 3, 5, 10, 3, 3, 5,
-	0, 3, 6, 9, 11, 7, 4, 6, 2, 4,
-	2, 4, 10, 1, 3, 8, 6, 2, 4, 3,
-	2, 3, 1, 3, 1, 1, 10, 8, 2, 3,
-	5, 7, 5, 2, 6, 6, 6, 6, 6, 2,
-	6, 6, 10, 10, 3, 1, 3, 5,
+	1, 1, 11, 12, 9, 1, 3, 1, 3, 3,
+	1, 3, 3, 1, 2, 4, 1, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 4, 3, 8,
+	7, 2, 1, 1, 5, 6, 4, 1, 2, 0,
+	2, 1, 3, 1, 3, 3, 5, 1, 6, 7,
+	2, 0, 1, 2, 1, 1, 2, 1, 2, 0,
+	3, 5, 3, 1, 5, 4, 4, 3, 1, 1,
+	1, 1, 3, 0, 2, 0, 1, 3, 1, 1,
+	1, 3, 4, 6, 7, 1, 3, 1, 4, 0,
+	4, 0, 1, 1, 1, 2, 2, 0, 1, 3,
+	1, 3, 1, 3, 5, 5, 4, 6, 6, 5,
+	6, 6, 6, 3, 1, 3, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 1, 1,
+	1, 1, 1, 1, 3, 1, 1, 1, 1, 3,
+	0, 1, 3, 1, 2, 2, 2, 1, 1, 4,
+	2, 2, 0, 4, 2, 2, 0, 2, 3, 5,
+	4, 2, 1, 3, 3, 0, 3, 3, 2, 1,
+	2, 1, 2, 2, 2, 2, 1, 2, 9, 6,
+	7, 4, 2, 2, 2, 2, 5, 3, 7, 8,
+	6, 9, 9, 5, 4, 1, 2, 3, 3, 3,
+	3, 7, 6, 8, 7, 2, 3, 4, 3, 3,
+	2, 7, 6, 6, 7, 6, 5, 4, 6, 7,
+	6, 5, 4, 3, 8, 7, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 4, 8, 7, 7, 6,
+	2, 0, 7, 6, 11, 10, 12, 11, 2, 2,
+	4, 2, 2, 1, 3, 1, 3, 2, 10, 9,
+	9, 8, 13, 12, 12, 11, 10, 9, 9, 8,
+	5, 5, 0, 7, 11, 0, 2, 0, 2, 0,
+	2, 6, 0, 2, 0, 2, 2, 0, 3, 3,
+	0, 1, 0, 1, 0, 1, 0, 2, 2, 0,
+	2, 1, 2, 2, 2, 3, 2, 3, 3, 2,
+	0, 1, 3, 2, 0, 2, 2, 3, 1, 2,
+	3, 3, 0, 1, 3, 1, 3, 6, 4, 9,
+	8, 8, 7, 9, 8, 8, 7, 5, 4, 2,
+	4, 7, 3, 6, 3, 3, 5, 10, 3, 3,
+	5, 0, 3, 6, 9, 11, 7, 4, 6, 2,
+	4, 2, 4, 10, 1, 3, 8, 6, 2, 4,
+	3, 2, 3, 1, 3, 1, 1, 10, 8, 2,
+	3, 5, 7, 5, 2, 6, 6, 6, 6, 6,
+	2, 6, 6, 10, 10, 3, 1, 3, 5,
 }
 
 var yyChk = [...]int16{
-	-1000, -66, -67, -1, -6, -2, -3, -10, -5, -7,
+	-1000, -67, -68, -1, -6, -2, -3, -10, -5, -7,
 	-8, -9, -12, -13, -15, -14, -16, -17, -18, -20,
 	-22, -23, -21, -19, -24, -25, -26, -28, -29, -30,
 	-31, -32, -33, -34, -35, -36, -37, -38, -39, -40,
-	-41, -42, -43, -44, -45, -47, -48, -49, -50, -51,
-	-53, -54, -55, -59, -60, -61, -56, -57, -58, -62,
-	8, 18, 19, 62, 30, 40, 53, 28, 77, 57,
-	98, 31, 129, -63, 148, -65, 156, -83, 130, 143,
-	153, -82, 145, 63, 147, 144, 146, 69, 70, -106,
-	149, 132, 43, 45, 46, 61, 148, 42, 71, -112,
-	73, 59, 5, 90, 51, 86, 102, 107, 88, 92,
-	116, 117, 82, 83, 84, 81, 32, 122, 123, 85,
-	143, 44, 46, 41, 5, 86, 101, 105, 93, 44,
-	61, 46, 41, 51, 5, 86, 101, 102, 105, 35,
-	93, -68, -77, 4, 9, 46, 5, 35, 143, 35,
-	143, 78, -6, 37, 115, 108, -145, -146, -111, 143,
-	146, -1, -71, -77, 6, -63, 128, 140, 10, 156,
-	157, 152, 153, 155, 158, 159, 154, -83, 130, 140,
-	139, -83, -87, 143, -86, 64, 120, -108, 120, 7,
-	47, -108, 79, 80, 61, 71, 74, 75, 76, 4,
-	74, 76, 58, 79, 80, 4, 94, 88, 7, 7,
-	9, 143, 48, 143, -75, 143, 139, -73, 146, -106,
-	108, 7, 130, -111, -111, 143, -68, -77, 48, 143,
-	144, 143, 108, 7, 7, -111, 92, -111, -77, -69,
-	-74, -70, -72, -75, 130, -80, -78, 130, 143, 27,
-	26, 112, 114, 118, -79, -81, -84, -83, 48, -75,
-	7, 21, 24, 7, 7, 21, 4, 7, -6, 58,
-	143, 144, -6, 128, 10, -68, -93, 11, -69, -71,
-	-63, 71, 73, 143, 146, -83, -83, -83, -83, -83,
-	-83, -83, -83, 131, -63, 131, -89, 143, 71, 73,
-	143, 66, -87, -87, -80, 31, -77, -108, 143, 7,
-	-68, -77, 80, -108, -108, 75, -108, -108, 79, 80,
-	79, 80, 143, 139, -108, 79, 80, 143, 80, -108,
-	-75, 143, -111, 143, -4, -140, 31, 119, -136, 71,
-	143, 31, -52, 130, 139, 143, 143, 143, -63, -71,
-	7, -77, 143, 139, 143, 143, 143, 7, 7, 128,
-	10, 128, 20, -67, -70, 150, 151, -83, -80, 25,
-	26, 130, 27, 130, 130, 130, -88, 133, 134, 135,
-	136, 137, 138, 142, 141, 113, 143, 31, 143, 7,
-	24, 143, 143, 143, 7, 4, 143, 143, 143, -111,
-	-145, 130, -77, -94, 125, 12, -68, 131, -83, 66,
-	65, 5, -91, 13, 31, 143, -77, -91, -108, -68,
-	-77, -68, -77, -108, -68, -77, -68, 31, 80, -108,
-	80, -108, 139, 143, 139, -68, -91, 80, -108, -108,
-	-68, -77, 133, -140, -105, -104, -103, 49, 60, 38,
+	-41, -42, -43, -44, -45, -46, -48, -49, -50, -51,
+	-52, -54, -55, -56, -60, -61, -62, -57, -58, -59,
+	-63, 8, 18, 19, 62, 30, 40, 53, 28, 77,
+	57, 98, 31, 129, -64, 148, -66, 156, -84, 130,
+	143, 153, -83, 145, 63, 147, 144, 146, 69, 70,
+	-108, 149, 132, 43, 45, 46, 61, 148, 42, 71,
+	-114, 73, 59, 5, 90, 51, 86, 102, 107, 88,
+	92, 116, 117, 82, 83, 84, 81, 32, 122, 123,
+	85, 143, 44, 46, 41, 5, 86, 101, 105, 93,
+	44, 61, 46, 41, 51, 5, 86, 101, 102, 105,
+	35, 93, -69, -78, 4, 9, 46, 5, 35, 143,
+	35, 143, 78, -6, 37, 115, 108, -149, -150, -113,
+	143, 146, -1, -72, -78, 6, -64, 128, 140, 10,
+	156, 157, 152, 153, 155, 158, 159, 154, -84, 130,
+	140, 139, -84, -88, 143, -87, 64, 120, -110, 120,
+	7, 47, -110, 79, 80, 143, 61, 71, 74, 75,
+	76, 4, 74, 76, 58, 79, 80, 4, 94, 88,
+	7, 7, 9, 143, 48, 143, -76, 143, 139, -74,
+	146, -108, 108, 7, 130, -113, -113, 143, -69, -78,
+	48, 143, 144, 143, 108, 7, 7, -113, 92, -113,
+	-78, -70, -75, -71, -73, -76, 130, -81, -79, 130,
+	143, 27, 26, 112, 114, 118, -80, -82, -85, -84,
+	48, -76, 7, 21, 24, 7, 7, 21, 4, 7,
+	-6, 58, 143, 144, -6, 128, 10, -69, -95, 11,
+	-70, -72, -64, 71, 73, 143, 146, -84, -84, -84,
+	-84, -84, -84, -84, -84, 131, -64, 131, -91, 143,
+	71, 73, 143, 66, -88, -88, -81, 31, -78, -110,
+	143, 7, -69, -78, 80, -110, -110, -110, 75, -110,
+	-110, 79, 80, 79, 80, 143, 139, -110, 79, 80,
+	143, 80, -110, -76, 143, -113, 143, -4, -144, 31,
+	119, -140, 71, 143, 31, -53, 130, 139, 143, 143,
+	143, -64, -72, 7, -78, 143, 139, 143, 143, 143,
+	7, 7, 128, 10, 128, -90, 164, 20, 161, 162,
+	163, -68, -71, 150, 151, -84, -81, 25, 26, 130,
+	27, 130, 130, 130, -89, 133, 134, 135, 136, 137,
+	138, 142, 141, 113, 143, 31, 143, 7, 24, 143,
+	143, 143, 7, 4, 143, 143, 143, -113, -149, 130,
+	-78, -96, 125, 12, -69, 131, 166, -84, 66, 65,
+	5, -93, 13, 31, 143, -78, -93, -110, -69, -78,
+	-69, -69, -78, -110, -69, -78, -69, 31, 80, -110,
+	80, -110, 139, 143, 139, -69, -93, 80, -110, -110,
+	-69, -78, 133, -144, -107, -106, -105, 49, 60, 38,
 	39, 50, 81, 51, 54, 55, 52, 144, 119, 72,
-	7, 37, -141, -142, 31, -139, -137, -138, -111, 143,
-	139, -73, 139, 7, 130, 139, 131, 7, -111, 7,
-	143, 7, 139, -111, -111, -69, 143, -69, 23, 131,
-	131, -80, -80, 131, 130, 25, -6, 130, -111, -111,
-	-111, -84, 130, 7, 81, 24, 143, 143, 24, 4,
-	143, 143, 4, 133, 133, -6, -93, -100, 29, -95,
-	-96, -111, 143, 156, -106, -95, -77, 68, 143, -83,
-	-76, 133, 134, 142, 141, -97, -98, 14, 15, 12,
-	5, -91, -98, -68, -77, -77, -93, -77, -91, -68,
-	31, -77, -91, 31, 76, -108, -68, 31, -108, -68,
-	-77, 143, 139, 139, 143, -91, -98, -108, -68, -77,
-	-68, -77, -77, -93, 143, 144, -105, 145, 144, 143,
-	144, -115, -110, 143, 49, 49, 49, 49, -136, 144,
-	143, 50, 143, 146, -143, -144, 32, -139, 128, 131,
-	71, -111, 139, -73, 143, -73, 143, -63, 143, 31,
-	-6, 139, 121, 143, 143, 143, 139, 128, -69, 10,
-	-63, -6, 130, 131, -6, 128, 128, 128, -80, 143,
-	-115, 143, 24, 143, 143, 4, 143, 146, -111, 144,
-	147, 69, 70, 131, -94, -91, 130, 128, 140, 130,
-	140, -93, 68, -77, 143, 143, -106, -106, -99, 16,
-	17, -134, 144, 149, -134, -90, -92, 143, -76, -98,
-	-77, -93, -93, -98, -91, -98, 31, 76, -91, -97,
-	76, -27, 133, 134, 25, 142, 141, -68, 31, 31,
-	76, -68, -77, -77, -93, 139, 143, 143, -98, -68,
-	-77, -77, -93, -77, -93, -93, -98, 150, 150, 128,
-	145, 145, 145, 145, -11, 49, 31, -130, 95, -131,
-	95, 133, 73, -73, -132, 100, 131, 130, -46, 49,
-	106, -111, -113, 35, 36, -111, -69, 7, 143, 131,
-	131, -6, -64, 143, 131, -111, -111, -111, 131, -105,
-	-109, 56, 143, 143, -100, -97, -101, 143, 144, 147,
-	153, -95, 71, 145, 71, -94, -91, 144, 144, 15,
-	128, 126, 127, -93, -98, -98, -98, 76, -27, -97,
-	-27, -77, -85, -107, 143, -85, 130, -106, -106, 31,
-	76, 76, -27, -77, -93, -93, -98, 143, -77, -93,
-	-93, -98, -93, -98, -98, 143, 143, -110, 50, 145,
-	35, 109, -116, 81, -129, -128, 143, 73, -116, -129,
-	143, 34, 33, 67, 99, 58, 31, -63, 145, 145,
-	121, -120, -111, -80, 131, 131, 128, 131, 131, 131,
-	143, -91, -127, 143, 131, -101, 131, 128, -100, -97,
-	17, -134, -90, -98, -27, -77, -77, -91, 128, -85,
-	76, -27, -27, -77, -93, -98, -98, -93, -98, -98,
-	-98, 133, 133, 60, 21, 21, -135, 90, -115, -129,
-	96, 96, -135, 130, -6, 145, 145, -46, 131, 103,
-	-113, 128, -64, -97, 130, 145, 153, -91, 144, -77,
-	-91, -91, -98, -85, 131, -27, -77, -77, -93, -98,
-	-98, 144, 143, 144, -109, 124, 144, -117, 143, -117,
-	-109, 145, 68, 58, 31, 130, -120, -120, -127, 146,
-	131, 145, -97, -91, -98, -98, -77, -93, -93, -98,
-	-102, -103, 128, -121, -118, 82, 131, 145, -46, -133,
-	145, 131, 131, -127, -98, -93, -98, -98, -102, -117,
-	-122, -119, 83, -117, -129, 131, 128, -98, -126, -125,
-	84, -117, 104, -133, -114, 85, -123, -124, -111, 130,
-	143, 128, 133, -133, -123, -111, 144, 131,
+	7, 37, -145, -146, 31, -143, -141, -142, -113, 143,
+	139, -74, 139, 7, 130, 139, 131, 7, -113, 7,
+	143, 7, 139, -113, -113, -70, 143, -70, 23, 23,
+	22, 22, 22, 131, 131, -81, -81, 131, 130, 25,
+	-6, 130, -113, -113, -113, -85, 130, 7, 81, 143,
+	24, 143, 143, 24, 4, 143, 143, 4, 133, 133,
+	-6, -95, -102, 29, -97, -98, -113, 143, 156, -108,
+	-97, -78, 166, 130, 68, 143, -84, -77, 133, 134,
+	142, 141, -99, -100, 14, 15, 12, 5, -93, -100,
+	-69, -78, -78, -95, -78, -93, -69, 31, -78, -93,
+	31, 76, -110, -69, 31, -110, -69, -78, 143, 139,
+	139, 143, -93, -100, -110, -69, -78, -69, -78, -78,
+	-95, 143, 144, -107, 145, 144, 143, 144, -117, -112,
+	143, 49, 49, 49, 49, -140, 144, 143, 50, 143,
+	146, -137, 143, -143, 128, 131, 71, -113, 139, -74,
+	143, -74, 143, -64, 143, 31, -6, 139, 121, 143,
+	143, 143, 139, 128, -70, -70, 10, -64, -6, 130,
+	131, -6, 128, 128, 128, -81, 143, -117, 145, 143,
+	24, 143, 143, 4, 143, 146, -113, 144, 147, 69,
+	70, 131, -96, -93, 130, 128, 140, 130, 140, -95,
+	130, -151, 109, -93, 68, -78, 143, 143, -108, -108,
+	-101, 16, 17, -138, 144, 149, -138, -92, -94, 143,
+	-77, -100, -78, -95, -95, -100, -93, -100, 31, 76,
+	-93, -99, 76, -27, 133, 134, 25, 142, 141, -69,
+	31, 31, 76, -69, -78, -78, -95, 139, 143, 143,
+	-100, -69, -78, -78, -95, -78, -95, -95, -100, 150,
+	150, 128, 145, 145, 145, 145, -11, 49, 31, -147,
+	-148, 32, 145, 73, -74, -134, 100, 131, 130, -47,
+	49, 106, -113, -115, 35, 36, -113, -70, 7, 7,
+	143, 131, 131, -6, -65, 143, 131, -113, -113, -113,
+	131, -107, -111, 56, 143, 143, -102, -99, -103, 143,
+	144, 147, 153, -97, 71, 145, 71, -96, -151, 131,
+	12, -93, 144, 144, 15, 128, 126, 127, -95, -100,
+	-100, -100, 76, -27, -99, -27, -78, -86, -109, 143,
+	-86, 130, -108, -108, 31, 76, 76, -27, -78, -95,
+	-95, -100, 143, -78, -95, -95, -100, -95, -100, -100,
+	143, 143, -112, 50, 145, 35, 109, -132, 95, -133,
+	95, 133, 67, 99, 58, 31, -64, 145, 145, 121,
+	-122, -113, -81, -81, 131, 131, 128, 131, 131, 131,
+	143, -93, -129, 143, 131, -103, 131, 128, -102, 131,
+	-97, -99, 17, -138, -92, -100, -27, -78, -78, -93,
+	128, -86, 76, -27, -27, -78, -95, -100, -100, -95,
+	-100, -100, -100, 133, 133, 60, 21, 21, -118, 81,
+	-131, -130, 143, 73, -118, -131, 143, 34, 33, -6,
+	145, 145, -47, 131, 103, -115, 128, -136, 165, -65,
+	-99, 130, 145, 153, -93, -93, 144, -78, -93, -93,
+	-100, -86, 131, -27, -78, -78, -95, -100, -100, 144,
+	143, 144, -139, 90, -117, -131, 96, 96, -139, 130,
+	68, 58, 31, 130, -122, -122, 145, -129, 146, 131,
+	145, -99, -93, -100, -100, -78, -95, -95, -100, -104,
+	-105, -111, 124, 144, -119, 143, -119, -111, 145, 145,
+	-47, -135, 145, 131, 131, -129, -100, -95, -100, -100,
+	-104, 128, -123, -120, 82, 131, 131, 128, -100, -119,
+	-124, -121, 83, -119, -131, 104, -135, -128, -127, 84,
+	-119, 130, -116, 85, -125, -126, -113, -135, 143, 128,
+	133, 131, -125, -113, 144,
 }
 
 var yyDef = [...]int16{
@@ -914,99 +947,103 @@ var yyDef = [...]int16{
 	31, 32, 33, 34, 35, 36, 37, 38, 39, 40,
 	41, 42, 43, 44, 45, 46, 47, 48, 49, 50,
 	51, 52, 53, 54, 55, 56, 57, 58, 59, 60,
-	0, 0, 0, 0, 141, 0, 0, 0, 0, 0,
-	0, 0, 3, -2, 0, 64, 66, 69, 0, 170,
-	0, 89, 90, 0, 172, 173, 174, 175, 176, 177,
-	179, 169, 201, 285, 0, 285, 0, 249, 0, 0,
-	0, 0, 0, 379, 0, 0, 400, 407, 410, 418,
-	423, 429, 270, 271, 272, 273, 274, 275, 276, 277,
-	278, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	141, 0, 0, 0, 0, 0, 0, 398, 0, 0,
-	0, 141, 254, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 301, 0, 0, 0, 0, 435, 0, 122,
-	123, 4, 0, 117, 0, 94, 0, 0, 0, 0,
+	61, 0, 0, 0, 0, 157, 0, 0, 0, 0,
+	0, 0, 0, 3, -2, 0, 65, 67, 70, 0,
+	186, 0, 92, 93, 0, 188, 189, 190, 191, 192,
+	193, 195, 185, 217, 301, 0, 301, 0, 265, 0,
+	0, 0, 0, 0, 399, 0, 0, 421, 428, 431,
+	439, 444, 450, 286, 287, 288, 289, 290, 291, 292,
+	293, 294, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 157, 0, 0, 0, 0, 0, 0, 419, 0,
+	0, 0, 157, 270, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 317, 0, 0, 0, 0, 456, 0,
+	138, 139, 4, 0, 133, 0, 99, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 88, 0, 0, 72, 0, 202, 141, 285, 0,
-	231, 141, 0, 285, 285, 0, 285, 285, 0, 0,
-	285, 0, 0, 0, 285, 0, 383, 391, 0, 0,
-	0, 209, 0, 0, 341, 113, 0, 112, 114, 115,
-	0, 0, 0, 94, 0, 250, 141, 252, 0, 267,
-	368, 384, 0, 0, 0, 409, 419, 0, 253, 95,
-	96, 98, 102, 107, 0, 140, 146, 0, 170, 0,
-	0, 0, 0, 0, 144, 142, 0, 158, 0, 382,
-	0, 0, 0, 0, 0, 0, 0, 0, 300, 0,
-	0, 411, 434, 0, 0, 141, 119, 0, 93, 0,
-	65, 67, 68, 70, 71, 77, 78, 79, 80, 81,
-	82, 83, 84, 85, 0, 87, 171, 180, 181, 182,
-	178, 0, 0, 73, 0, 0, 184, 225, 284, 0,
-	141, 184, 285, 141, 141, 285, 141, 0, 0, 285,
-	0, 285, 279, 0, 184, 0, 285, 370, 285, 141,
-	380, 401, 408, 0, 209, 204, 0, 0, 206, 0,
-	0, 0, 316, 0, 0, 0, 0, 0, 0, 0,
-	0, 251, 0, 0, 0, 396, 399, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 158, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 160, 161, 162,
-	163, 164, 165, 166, 167, 168, 0, 0, 0, 0,
-	0, 261, 0, 0, 0, 0, 266, 0, 0, 0,
-	436, 0, 117, 135, 0, 0, 141, 86, 0, 0,
-	0, 0, 196, 0, 0, 230, 184, 196, 141, 141,
-	117, 141, 184, 0, 141, 184, 0, 0, 285, 0,
-	285, 141, 0, 0, 0, 184, 196, 285, 141, 141,
-	141, 117, 0, 203, 212, 213, 215, 0, 0, 0,
-	0, 220, 0, 0, 0, 0, 0, 205, 0, 0,
-	0, 0, 314, 315, 329, 340, 343, 0, 0, 113,
-	0, 111, 0, 0, 0, 0, 0, 0, 0, 0,
-	385, 0, 0, 420, 422, 97, 100, 99, 0, 104,
-	106, 143, 145, -2, 0, 0, 0, 0, 0, 0,
-	0, 157, 0, 0, 0, 0, 0, 260, 0, 0,
-	0, 265, 0, 0, 0, 0, 119, 184, 0, 118,
-	120, 124, 122, 129, 131, 116, 117, 91, 0, 74,
-	141, 0, 0, 0, 0, 223, 200, 0, 0, 0,
-	0, 196, 246, 141, 117, 117, 196, 184, 196, 0,
-	0, 184, 196, 0, 0, 0, 0, 0, 141, 141,
-	117, 0, 0, 0, 283, 196, 287, 141, 141, 117,
-	141, 117, 117, 196, 430, 431, 214, 216, 217, 218,
-	219, 221, 365, 367, 0, 0, 0, 0, 207, 208,
-	210, 211, 0, 234, 319, 321, 0, 342, 344, 345,
-	346, 348, 0, 110, 113, 109, 390, 0, 0, 0,
-	406, 0, 0, 256, 392, 397, 0, 0, 0, 0,
-	0, 0, 0, 150, 0, 0, 0, 0, 0, 0,
-	356, 257, 0, 259, 262, 0, 264, 369, 424, 425,
-	426, 427, 428, 437, 135, 196, 0, 0, 0, 0,
-	0, 119, 92, 184, 226, 227, 228, 229, 190, 0,
-	0, 194, 191, 192, 195, 183, 185, 187, 224, 245,
-	117, 196, 196, 378, 196, 248, 0, 0, 196, 269,
-	0, 141, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 141, 117, 117, 196, 0, 281, 282, 286, 141,
-	117, 117, 196, 117, 196, 196, 374, 0, 0, 0,
-	241, 242, 243, 244, 232, 0, 0, 324, 352, 324,
-	352, 0, 347, 108, 0, 0, 0, 0, 395, 0,
-	0, 0, 0, 414, 415, 421, 101, 0, 105, 148,
-	149, 0, 0, 75, 153, 0, 0, 0, 159, 255,
-	381, 0, 258, 263, 184, 133, 0, 136, 137, 138,
-	0, 121, 125, 0, 130, 135, 196, 198, 199, 0,
-	0, 188, 189, 196, 376, 377, 247, 0, 141, 268,
-	141, 184, 292, 297, 299, 293, 0, 295, 296, 0,
-	0, 0, 141, 117, 196, 196, 305, 280, 117, 196,
-	196, 313, 196, 372, 373, 0, 0, 366, 233, 0,
-	0, 0, 326, 0, 320, 352, 0, 0, 326, 322,
-	0, 330, 331, 0, 0, 0, 0, 0, 0, 405,
-	0, 417, 412, 103, 151, 152, 0, 154, 155, 156,
-	355, 196, 63, 0, 134, 139, 126, 0, 184, 222,
-	0, 193, 186, 375, 141, 184, 184, 196, 0, 0,
-	0, 141, 141, 117, 196, 303, 304, 196, 311, 312,
-	371, 0, 0, 0, 235, 236, 356, 0, 325, 351,
-	0, 0, 356, 0, 0, 387, 388, 393, 0, 0,
-	0, 0, 76, 133, 0, 0, 0, 196, 197, 184,
-	196, 196, 289, 298, 294, 141, 117, 117, 196, 302,
-	310, 433, 432, 238, 317, 327, 328, 349, 353, 350,
-	332, 0, 386, 0, 0, 0, 416, 413, 61, 0,
-	127, 0, 133, 196, 291, 288, 117, 196, 196, 309,
-	237, 239, 0, 334, 333, 0, 352, 389, 394, 0,
-	403, 132, 128, 62, 290, 196, 307, 308, 240, 354,
-	336, 335, 0, 357, 323, 0, 0, 306, 338, 337,
-	364, 358, 0, 404, 318, 0, 361, 360, 0, 0,
-	339, 364, 0, 0, 359, 362, 363, 402,
+	0, 0, 91, 0, 0, 73, 0, 218, 157, 301,
+	0, 247, 157, 0, 301, 301, 301, 0, 301, 301,
+	0, 0, 301, 0, 0, 0, 301, 0, 404, 412,
+	0, 0, 0, 225, 0, 0, 359, 129, 0, 128,
+	130, 131, 0, 0, 0, 99, 0, 266, 157, 268,
+	0, 283, 386, 405, 0, 0, 0, 430, 440, 0,
+	269, 100, 101, -2, 107, 123, 0, 156, 162, 0,
+	186, 0, 0, 0, 0, 0, 160, 158, 0, 174,
+	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	316, 0, 0, 432, 455, 0, 0, 157, 135, 0,
+	98, 0, 66, 68, 69, 71, 72, 78, 79, 80,
+	81, 82, 83, 84, 85, 86, 0, 88, 187, 196,
+	197, 198, 194, 0, 0, 74, 0, 0, 200, 241,
+	300, 0, 157, 200, 301, 157, 398, 157, 301, 157,
+	0, 0, 301, 0, 301, 295, 0, 200, 0, 301,
+	388, 301, 157, 400, 422, 429, 0, 225, 220, 0,
+	0, 222, 0, 0, 0, 332, 0, 0, 0, 0,
+	0, 0, 0, 0, 267, 0, 0, 0, 417, 420,
+	0, 0, 0, 0, 0, 0, 0, 112, 114, 115,
+	117, 0, 0, 0, 0, 174, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 176, 177, 178, 179, 180,
+	181, 182, 183, 184, 0, 0, 0, 0, 0, 277,
+	0, 0, 0, 0, 282, 0, 0, 0, 457, 0,
+	133, 151, 0, 0, 157, 87, 0, 0, 0, 0,
+	0, 212, 0, 0, 246, 200, 212, 157, 157, 133,
+	397, 157, 200, 0, 157, 200, 0, 0, 301, 0,
+	301, 157, 0, 0, 0, 200, 212, 301, 157, 157,
+	157, 133, 0, 219, 228, 229, 231, 0, 0, 0,
+	0, 236, 0, 0, 0, 0, 0, 221, 0, 0,
+	0, 0, 330, 331, 335, 358, 361, 0, 0, 129,
+	0, 127, 0, 0, 0, 0, 0, 0, 0, 0,
+	406, 0, 0, 441, 443, 102, 105, 104, 0, 0,
+	113, 116, 118, 120, 122, 159, 161, -2, 0, 0,
+	0, 0, 0, 0, 0, 173, 0, 0, 0, 0,
+	0, 0, 276, 0, 0, 0, 281, 0, 0, 0,
+	0, 135, 200, 0, 134, 136, 140, 138, 145, 147,
+	132, 133, 0, 200, 94, 0, 75, 157, 0, 0,
+	0, 0, 239, 216, 0, 0, 0, 0, 212, 262,
+	157, 133, 133, 212, 200, 212, 0, 0, 200, 212,
+	0, 0, 0, 0, 0, 157, 157, 133, 0, 0,
+	0, 299, 212, 303, 157, 157, 133, 157, 133, 133,
+	212, 451, 452, 230, 232, 233, 234, 235, 237, 383,
+	385, 0, 0, 0, 0, 223, 224, 226, 227, 0,
+	250, 347, 0, 360, 362, 363, 364, 366, 0, 126,
+	129, 125, 411, 0, 0, 0, 427, 0, 0, 272,
+	413, 418, 0, 0, 0, 0, 0, 0, 0, 0,
+	166, 0, 0, 0, 0, 0, 0, 374, 403, 273,
+	0, 275, 278, 0, 280, 387, 445, 446, 447, 448,
+	449, 458, 151, 212, 0, 0, 0, 0, 0, 135,
+	200, 0, 0, 97, 95, 200, 242, 243, 244, 245,
+	206, 0, 0, 210, 207, 208, 211, 199, 201, 203,
+	240, 261, 133, 212, 212, 396, 212, 264, 0, 0,
+	212, 285, 0, 157, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 157, 133, 133, 212, 0, 297, 298,
+	302, 157, 133, 133, 212, 133, 212, 212, 392, 0,
+	0, 0, 257, 258, 259, 260, 248, 0, 0, 337,
+	339, 0, 336, 365, 124, 0, 0, 0, 0, 416,
+	0, 0, 0, 0, 435, 436, 442, 106, 0, 0,
+	121, 164, 165, 0, 0, 76, 169, 0, 0, 0,
+	175, 271, 401, 0, 274, 279, 200, 149, 0, 152,
+	153, 154, 0, 137, 141, 0, 146, 151, 0, 90,
+	0, 212, 214, 215, 0, 0, 204, 205, 212, 394,
+	395, 263, 0, 157, 284, 157, 200, 308, 313, 315,
+	309, 0, 311, 312, 0, 0, 0, 157, 133, 212,
+	212, 321, 296, 133, 212, 212, 329, 212, 390, 391,
+	0, 0, 384, 249, 0, 0, 0, 342, 370, 342,
+	370, 0, 0, 0, 0, 0, 0, 0, 426, 0,
+	438, 433, 108, 111, 167, 168, 0, 170, 171, 172,
+	373, 212, 64, 0, 150, 155, 142, 0, 200, 89,
+	200, 238, 0, 209, 202, 393, 157, 200, 200, 212,
+	0, 0, 0, 157, 157, 133, 212, 319, 320, 212,
+	327, 328, 389, 0, 0, 0, 251, 252, 344, 0,
+	338, 370, 0, 0, 344, 340, 0, 348, 349, 0,
+	408, 409, 414, 0, 0, 0, 0, 109, 0, 77,
+	149, 0, 0, 0, 212, 96, 213, 200, 212, 212,
+	305, 314, 310, 157, 133, 133, 212, 318, 326, 454,
+	453, 254, 374, 0, 343, 369, 0, 0, 374, 0,
+	407, 0, 0, 0, 437, 434, 110, 62, 0, 143,
+	0, 149, 212, 307, 304, 133, 212, 212, 325, 253,
+	255, 333, 345, 346, 367, 371, 368, 350, 0, 410,
+	415, 0, 424, 148, 144, 63, 306, 212, 323, 324,
+	256, 0, 352, 351, 0, 370, 0, 0, 322, 372,
+	354, 353, 0, 375, 341, 0, 425, 356, 355, 382,
+	376, 0, 334, 0, 379, 378, 0, 0, 357, 382,
+	0, 423, 377, 380, 381,
 }
 
 var yyTok1 = [...]int8{
@@ -1029,7 +1066,8 @@ var yyTok2 = [...]uint8{
 	122, 123, 124, 125, 126, 127, 128, 129, 130, 131,
 	132, 133, 134, 135, 136, 137, 138, 139, 140, 141,
 	142, 143, 144, 145, 146, 147, 148, 149, 150, 151,
-	152, 153, 154, 155, 156, 157, 158, 159, 160,
+	152, 153, 154, 155, 156, 157, 158, 159, 160, 161,
+	162, 163, 164, 165, 166,
 }
 
 var yyTok3 = [...]int8{
@@ -1375,19 +1413,19 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:190
+//line sql.y:196
 		{
 			setParseTree(yylex, yyDollar[1].stmts)
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:196
+//line sql.y:202
 		{
 			yyVAL.stmts = []Statement{yyDollar[1].stmt}
 		}
 	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:200
+//line sql.y:206
 		{
 			if len(yyDollar[1].stmts) >= 1 {
 				yyVAL.stmts = yyDollar[1].stmts
@@ -1397,349 +1435,355 @@ yydefault:
 		}
 	case 4:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:208
+//line sql.y:214
 		{
 			yyVAL.stmts = append(yyDollar[1].stmts, yyDollar[3].stmt)
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:216
+//line sql.y:222
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:220
+//line sql.y:226
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:224
+//line sql.y:230
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:228
+//line sql.y:234
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:232
+//line sql.y:238
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:236
+//line sql.y:242
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:240
+//line sql.y:246
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 12:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:244
+//line sql.y:250
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 13:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:248
+//line sql.y:254
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:252
+//line sql.y:258
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 15:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:256
+//line sql.y:262
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 16:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:260
+//line sql.y:266
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 17:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:264
+//line sql.y:270
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 18:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:268
+//line sql.y:274
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 19:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:272
+//line sql.y:278
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 20:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:276
+//line sql.y:282
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 21:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:280
+//line sql.y:286
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:284
+//line sql.y:290
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:288
+//line sql.y:294
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:292
+//line sql.y:298
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 25:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:296
+//line sql.y:302
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 26:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:300
+//line sql.y:306
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 27:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:304
+//line sql.y:310
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 28:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:308
+//line sql.y:314
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 29:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:312
+//line sql.y:318
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 30:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:316
+//line sql.y:322
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 31:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:320
+//line sql.y:326
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 32:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:324
+//line sql.y:330
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 33:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:328
+//line sql.y:334
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:332
+//line sql.y:338
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:336
+//line sql.y:342
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:340
+//line sql.y:346
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:344
+//line sql.y:350
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:348
+//line sql.y:354
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 39:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:352
+//line sql.y:358
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:356
+//line sql.y:362
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 41:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:360
+//line sql.y:366
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:364
+//line sql.y:370
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 43:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:368
+//line sql.y:374
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:372
+//line sql.y:378
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:376
+//line sql.y:382
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:380
+//line sql.y:386
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:384
+//line sql.y:390
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 48:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:388
+//line sql.y:394
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 49:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:392
+//line sql.y:398
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 50:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:396
+//line sql.y:402
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 51:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:400
+//line sql.y:406
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:404
+//line sql.y:410
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:408
+//line sql.y:414
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:412
+//line sql.y:418
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:416
+//line sql.y:422
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:420
+//line sql.y:426
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:424
+//line sql.y:430
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:428
+//line sql.y:434
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:432
+//line sql.y:438
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:436
+//line sql.y:442
 		{
 			yyVAL.stmt = yyDollar[1].stmt
 		}
 	case 61:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:446
+		{
+			yyVAL.stmt = yyDollar[1].stmt
+		}
+	case 62:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:442
+//line sql.y:452
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1780,9 +1824,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:483
+//line sql.y:493
 		{
 			stmt := &SelectStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -1824,9 +1868,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:525
+//line sql.y:535
 		{
 			stmt := &SelectStatement{}
 			stmt.Fields = yyDollar[2].fields
@@ -1854,147 +1898,147 @@ yydefault:
 			stmt.Location = yyDollar[9].location
 			yyVAL.stmt = stmt
 		}
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:556
+//line sql.y:566
 		{
 			yyVAL.fields = []*Field{yyDollar[1].field}
 		}
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:560
+//line sql.y:570
 		{
 			yyVAL.fields = append([]*Field{yyDollar[1].field}, yyDollar[3].fields...)
 		}
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:566
+//line sql.y:576
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:570
+//line sql.y:580
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: TAG}}
 		}
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:574
+//line sql.y:584
 		{
 			yyVAL.field = &Field{Expr: &Wildcard{Type: FIELD}}
 		}
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:578
+//line sql.y:588
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr}
 		}
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:582
+//line sql.y:592
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:586
+//line sql.y:596
 		{
 			yyVAL.field = &Field{Expr: yyDollar[1].expr, Alias: yyDollar[3].str}
 		}
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:592
+//line sql.y:602
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:596
+//line sql.y:606
 		{
 			c := yyDollar[1].expr.(*CaseWhenExpr)
 			c.Conditions = append(c.Conditions, yyDollar[2].expr.(*CaseWhenExpr).Conditions...)
 			c.Assigners = append(c.Assigners, yyDollar[2].expr.(*CaseWhenExpr).Assigners...)
 			yyVAL.expr = c
 		}
-	case 74:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:605
+//line sql.y:615
 		{
 			c := &CaseWhenExpr{}
 			c.Conditions = []Expr{yyDollar[2].expr}
 			c.Assigners = []Expr{yyDollar[4].expr}
 			yyVAL.expr = c
 		}
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:614
+//line sql.y:624
 		{
 			yyVAL.fields = []*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}
 		}
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:618
+//line sql.y:628
 		{
 			yyVAL.fields = append([]*Field{&Field{Expr: &VarRef{Val: yyDollar[1].str}}}, yyDollar[3].fields...)
 		}
-	case 77:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:624
+//line sql.y:634
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MUL), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:628
+//line sql.y:638
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(DIV), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:632
+//line sql.y:642
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(ADD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:636
+//line sql.y:646
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(SUB), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:640
+//line sql.y:650
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_XOR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:644
+//line sql.y:654
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(MOD), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:648
+//line sql.y:658
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_AND), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:652
+//line sql.y:662
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(BITWISE_OR), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:656
+//line sql.y:666
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:660
+//line sql.y:670
 		{
 			if strings.ToLower(yyDollar[1].str) == "cast" {
 				if len(yyDollar[3].fields) != 1 {
@@ -2025,16 +2069,32 @@ yydefault:
 				yyVAL.expr = cols
 			}
 		}
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:691
+//line sql.y:701
 		{
 			cols := &Call{Name: strings.ToLower(yyDollar[1].str)}
 			yyVAL.expr = cols
 		}
-	case 88:
+	case 89:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line sql.y:706
+		{
+			cols := &Call{Name: strings.ToLower(yyDollar[1].str), Args: []Expr{}, Window: yyDollar[7].windowSpec}
+			for i := range yyDollar[3].fields {
+				cols.Args = append(cols.Args, yyDollar[3].fields[i].Expr)
+			}
+			yyVAL.expr = cols
+		}
+	case 90:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:714
+		{
+			yyVAL.expr = &Call{Name: strings.ToLower(yyDollar[1].str), Window: yyDollar[6].windowSpec}
+		}
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:696
+//line sql.y:718
 		{
 			switch s := yyDollar[2].expr.(type) {
 			case *NumberLiteral:
@@ -2048,98 +2108,110 @@ yydefault:
 			}
 
 		}
-	case 89:
+	case 92:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:710
+//line sql.y:732
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 90:
+	case 93:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:714
+//line sql.y:736
 		{
 			yyVAL.expr = &DurationLiteral{Val: yyDollar[1].tdur}
 		}
-	case 91:
+	case 94:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:718
+//line sql.y:740
 		{
 			c := yyDollar[2].expr.(*CaseWhenExpr)
 			c.Assigners = append(c.Assigners, yyDollar[4].expr)
 			yyVAL.expr = c
 		}
-	case 92:
+	case 95:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:724
+//line sql.y:746
 		{
 			yyVAL.expr = &VarRef{}
 		}
-	case 93:
+	case 96:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:752
+		{
+			yyVAL.windowSpec = &WindowSpec{PartitionBy: yyDollar[3].dimens, SortFields: yyDollar[4].sortfs}
+		}
+	case 97:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:756
+		{
+			yyVAL.windowSpec = &WindowSpec{SortFields: yyDollar[1].sortfs}
+		}
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:730
+//line sql.y:762
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 94:
+	case 99:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:734
+//line sql.y:766
 		{
 			yyVAL.sources = nil
 		}
-	case 95:
+	case 100:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:740
+//line sql.y:772
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 96:
+	case 101:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:746
+//line sql.y:778
 		{
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 97:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:750
+//line sql.y:782
 		{
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[3].sources...)
 		}
-	case 98:
+	case 103:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:754
+//line sql.y:786
 		{
 			yyVAL.sources = yyDollar[1].sources
 
 		}
-	case 99:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:759
+//line sql.y:791
 		{
 			yyVAL.sources = append(yyDollar[1].sources, yyDollar[3].sources...)
 		}
-	case 100:
+	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:763
+//line sql.y:795
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = []Source{yyDollar[1].ment}
 		}
-	case 101:
+	case 106:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:768
+//line sql.y:800
 		{
 			yyDollar[1].ment.Alias = yyDollar[3].str
 			yyVAL.sources = append([]Source{yyDollar[1].ment}, yyDollar[5].sources...)
 		}
-	case 102:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:773
+//line sql.y:805
 		{
 			yyVAL.sources = []Source{yyDollar[1].source}
 		}
-	case 103:
+	case 108:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:779
+//line sql.y:811
 		{
 			join := &Join{}
 			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
@@ -2148,11 +2220,87 @@ yydefault:
 			join.LSrc = yyDollar[1].sources[0]
 			join.RSrc = yyDollar[4].sources[0]
 			join.Condition = yyDollar[6].expr
+			join.JoinType = JoinType(yyDollar[2].int)
 			yyVAL.source = join
 		}
-	case 104:
+	case 109:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:823
+		{
+			join := &Join{}
+			if len(yyDollar[1].sources) != 1 || len(yyDollar[4].sources) != 1 {
+				yylex.Error("only support one query for join")
+			}
+			join.LSrc = yyDollar[1].sources[0]
+			join.RSrc = yyDollar[4].sources[0]
+			join.Condition = yyDollar[6].expr
+			join.JoinType = AsofJoin
+			join.Tolerance = yyDollar[7].tdur
+			yyVAL.source = join
+		}
+	case 110:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:838
+		{
+			yyVAL.tdur = yyDollar[2].tdur
+		}
+	case 111:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:842
+		{
+			yyVAL.tdur = 0
+		}
+	case 112:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:848
+		{
+			yyVAL.int = int(FullJoin)
+		}
+	case 113:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:852
+		{
+			yyVAL.int = int(FullJoin)
+		}
+	case 114:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:856
+		{
+			yyVAL.int = int(InnerJoin)
+		}
+	case 115:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:860
+		{
+			yyVAL.int = int(LeftOuterJoin)
+		}
+	case 116:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:864
+		{
+			yyVAL.int = int(LeftOuterJoin)
+		}
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:868
+		{
+			yyVAL.int = int(RightOuterJoin)
+		}
+	case 118:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:872
+		{
+			yyVAL.int = int(RightOuterJoin)
+		}
+	case 119:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:876
+		{
+			yyVAL.int = int(InnerJoin)
+		}
+	case 120:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:792
+//line sql.y:882
 		{
 			all_subquerys := []Source{}
 			for _, temp_stmt := range yyDollar[2].stmts {
@@ -2165,9 +2313,9 @@ yydefault:
 			}
 			yyVAL.sources = all_subquerys
 		}
-	case 105:
+	case 121:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:805
+//line sql.y:895
 		{
 			if len(yyDollar[2].stmts) != 1 {
 				yylex.Error("expexted SelectStatement length")
@@ -2184,72 +2332,72 @@ yydefault:
 			all_subquerys = append(all_subquerys, build_SubQuery)
 			yyVAL.sources = all_subquerys
 		}
-	case 106:
+	case 122:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:822
+//line sql.y:912
 		{
 			yyVAL.sources = yyDollar[2].sources
 		}
-	case 107:
+	case 123:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:828
+//line sql.y:918
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 108:
+	case 124:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:834
+//line sql.y:924
 		{
 			mst := yyDollar[5].ment
 			mst.Database = yyDollar[1].str
 			mst.RetentionPolicy = yyDollar[3].str
 			yyVAL.ment = mst
 		}
-	case 109:
+	case 125:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:841
+//line sql.y:931
 		{
 			mst := yyDollar[4].ment
 			mst.RetentionPolicy = yyDollar[2].str
 			yyVAL.ment = mst
 		}
-	case 110:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:847
+//line sql.y:937
 		{
 			mst := yyDollar[4].ment
 			mst.Database = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 111:
+	case 127:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:853
+//line sql.y:943
 		{
 			mst := yyDollar[3].ment
 			mst.RetentionPolicy = yyDollar[1].str
 			yyVAL.ment = mst
 		}
-	case 112:
+	case 128:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:949
 		{
 			yyVAL.ment = yyDollar[1].ment
 		}
-	case 113:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:865
+//line sql.y:955
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 114:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:869
+//line sql.y:959
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[1].str}
 		}
-	case 115:
+	case 131:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:873
+//line sql.y:963
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2258,69 +2406,69 @@ yydefault:
 
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 116:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:884
+//line sql.y:974
 		{
 			yyVAL.dimens = yyDollar[3].dimens
 		}
-	case 117:
+	case 133:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:888
+//line sql.y:978
 		{
 			yyVAL.dimens = nil
 		}
-	case 118:
+	case 134:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:894
+//line sql.y:984
 		{
 			yyVAL.dimens = yyDollar[2].dimens
 		}
-	case 119:
+	case 135:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:898
+//line sql.y:988
 		{
 			yyVAL.dimens = nil
 		}
-	case 120:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:904
+//line sql.y:994
 		{
 			yyVAL.dimens = []*Dimension{yyDollar[1].dimen}
 		}
-	case 121:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:908
+//line sql.y:998
 		{
 			yyVAL.dimens = append([]*Dimension{yyDollar[1].dimen}, yyDollar[3].dimens...)
 		}
-	case 122:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:914
+//line sql.y:1004
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 123:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:918
+//line sql.y:1008
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 124:
+	case 140:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:924
+//line sql.y:1014
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 125:
+	case 141:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:928
+//line sql.y:1018
 		{
 			yyVAL.dimen = &Dimension{Expr: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 126:
+	case 142:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:932
+//line sql.y:1022
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2328,9 +2476,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}}}}
 		}
-	case 127:
+	case 143:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:940
+//line sql.y:1030
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2338,9 +2486,9 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: yyDollar[5].tdur}}}}
 		}
-	case 128:
+	case 144:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:948
+//line sql.y:1038
 		{
 			if strings.ToLower(yyDollar[1].str) != "time" {
 				yylex.Error("Invalid group by combination for no-time tag and time duration")
@@ -2348,21 +2496,21 @@ yydefault:
 
 			yyVAL.dimen = &Dimension{Expr: &Call{Name: "time", Args: []Expr{&DurationLiteral{Val: yyDollar[3].tdur}, &DurationLiteral{Val: time.Duration(-yyDollar[6].tdur)}}}}
 		}
-	case 129:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:956
+//line sql.y:1046
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 130:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:960
+//line sql.y:1050
 		{
 			yyVAL.dimen = &Dimension{Expr: &Wildcard{Type: Token(yyDollar[1].int)}}
 		}
-	case 131:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:964
+//line sql.y:1054
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2370,9 +2518,9 @@ yydefault:
 			}
 			yyVAL.dimen = &Dimension{Expr: &RegexLiteral{Val: re}}
 		}
-	case 132:
+	case 148:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:975
+//line sql.y:1065
 		{
 			if strings.ToLower(yyDollar[1].str) != "tz" {
 				yylex.Error("Expect tz")
@@ -2383,45 +2531,45 @@ yydefault:
 			}
 			yyVAL.location = loc
 		}
-	case 133:
+	case 149:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:986
+//line sql.y:1076
 		{
 			yyVAL.location = nil
 		}
-	case 134:
+	case 150:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:992
+//line sql.y:1082
 		{
 			yyVAL.inter = yyDollar[3].inter
 		}
-	case 135:
+	case 151:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:996
+//line sql.y:1086
 		{
 			yyVAL.inter = "null"
 		}
-	case 136:
+	case 152:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1002
+//line sql.y:1092
 		{
 			yyVAL.inter = yyDollar[1].str
 		}
-	case 137:
+	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1006
+//line sql.y:1096
 		{
 			yyVAL.inter = yyDollar[1].int64
 		}
-	case 138:
+	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1010
+//line sql.y:1100
 		{
 			yyVAL.inter = yyDollar[1].float64
 		}
-	case 139:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1014
+//line sql.y:1104
 		{
 			switch s := yyDollar[2].inter.(type) {
 			case int64:
@@ -2432,57 +2580,57 @@ yydefault:
 				yyVAL.inter = yyDollar[2].inter
 			}
 		}
-	case 140:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1027
+//line sql.y:1117
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 141:
+	case 157:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1031
+//line sql.y:1121
 		{
 			yyVAL.expr = nil
 		}
-	case 142:
+	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1037
+//line sql.y:1127
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 143:
+	case 159:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1041
+//line sql.y:1131
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 144:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1047
+//line sql.y:1137
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 145:
+	case 161:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1051
+//line sql.y:1141
 		{
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 146:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1057
+//line sql.y:1147
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 147:
+	case 163:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1061
+//line sql.y:1151
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 148:
+	case 164:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1065
+//line sql.y:1155
 		{
 			ident := &VarRef{Val: yyDollar[1].str}
 			var expr, e Expr
@@ -2496,39 +2644,39 @@ yydefault:
 			}
 			yyVAL.expr = e
 		}
-	case 149:
+	case 165:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1079
+//line sql.y:1169
 		{
 			yyVAL.expr = &InCondition{Stmt: yyDollar[4].stmt.(*SelectStatement), Column: &VarRef{Val: yyDollar[1].str}}
 		}
-	case 150:
+	case 166:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1083
+//line sql.y:1173
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 151:
+	case 167:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1087
+//line sql.y:1177
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 152:
+	case 168:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1091
+//line sql.y:1181
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 153:
+	case 169:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1095
+//line sql.y:1185
 		{
 			yyVAL.expr = &BinaryExpr{}
 		}
-	case 154:
+	case 170:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1099
+//line sql.y:1189
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2536,9 +2684,9 @@ yydefault:
 				Op:  MATCH,
 			}
 		}
-	case 155:
+	case 171:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1107
+//line sql.y:1197
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2546,9 +2694,9 @@ yydefault:
 				Op:  MATCHPHRASE,
 			}
 		}
-	case 156:
+	case 172:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1115
+//line sql.y:1205
 		{
 			yyVAL.expr = &BinaryExpr{
 				LHS: &VarRef{Val: yyDollar[3].str},
@@ -2556,9 +2704,9 @@ yydefault:
 				Op:  IPINRANGE,
 			}
 		}
-	case 157:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1125
+//line sql.y:1215
 		{
 			if yyDollar[2].int == NEQREGEX {
 				switch yyDollar[3].expr.(type) {
@@ -2569,123 +2717,123 @@ yydefault:
 			}
 			yyVAL.expr = &BinaryExpr{Op: Token(yyDollar[2].int), LHS: yyDollar[1].expr, RHS: yyDollar[3].expr}
 		}
-	case 158:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1138
+//line sql.y:1228
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 159:
+	case 175:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1142
+//line sql.y:1232
 		{
 			yyVAL.expr = &ParenExpr{Expr: yyDollar[2].expr}
 		}
-	case 160:
+	case 176:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1148
+//line sql.y:1238
 		{
 			yyVAL.int = EQ
 		}
-	case 161:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1152
+//line sql.y:1242
 		{
 			yyVAL.int = NEQ
 		}
-	case 162:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1156
+//line sql.y:1246
 		{
 			yyVAL.int = LT
 		}
-	case 163:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1160
+//line sql.y:1250
 		{
 			yyVAL.int = LTE
 		}
-	case 164:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1164
+//line sql.y:1254
 		{
 			yyVAL.int = GT
 		}
-	case 165:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1168
+//line sql.y:1258
 		{
 			yyVAL.int = GTE
 		}
-	case 166:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1172
+//line sql.y:1262
 		{
 			yyVAL.int = EQREGEX
 		}
-	case 167:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1176
+//line sql.y:1266
 		{
 			yyVAL.int = NEQREGEX
 		}
-	case 168:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1180
+//line sql.y:1270
 		{
 			yyVAL.int = LIKE
 		}
-	case 169:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1186
+//line sql.y:1276
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 170:
+	case 186:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1192
+//line sql.y:1282
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str}
 		}
-	case 171:
+	case 187:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1196
+//line sql.y:1286
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str, Type: yyDollar[3].dataType}
 		}
-	case 172:
+	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1200
+//line sql.y:1290
 		{
 			yyVAL.expr = &NumberLiteral{Val: yyDollar[1].float64}
 		}
-	case 173:
+	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1204
+//line sql.y:1294
 		{
 			yyVAL.expr = &IntegerLiteral{Val: yyDollar[1].int64}
 		}
-	case 174:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1208
+//line sql.y:1298
 		{
 			yyVAL.expr = &StringLiteral{Val: yyDollar[1].str}
 		}
-	case 175:
+	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1212
+//line sql.y:1302
 		{
 			yyVAL.expr = &BooleanLiteral{Val: true}
 		}
-	case 176:
+	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1216
+//line sql.y:1306
 		{
 			yyVAL.expr = &BooleanLiteral{Val: false}
 		}
-	case 177:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1220
+//line sql.y:1310
 		{
 			re, err := regexp.Compile(yyDollar[1].str)
 			if err != nil {
@@ -2693,21 +2841,21 @@ yydefault:
 			}
 			yyVAL.expr = &RegexLiteral{Val: re}
 		}
-	case 178:
+	case 194:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1228
+//line sql.y:1318
 		{
 			yyVAL.expr = &VarRef{Val: yyDollar[1].str + "." + yyDollar[3].str, Type: Tag}
 		}
-	case 179:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1232
+//line sql.y:1322
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 180:
+	case 196:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1238
+//line sql.y:1328
 		{
 			switch strings.ToLower(yyDollar[1].str) {
 			case "float":
@@ -2728,75 +2876,75 @@ yydefault:
 				yylex.Error("wrong field dataType")
 			}
 		}
-	case 181:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1259
+//line sql.y:1349
 		{
 			yyVAL.dataType = Tag
 		}
-	case 182:
+	case 198:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1263
+//line sql.y:1353
 		{
 			yyVAL.dataType = AnyField
 		}
-	case 183:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1269
+//line sql.y:1359
 		{
 			yyVAL.sortfs = yyDollar[3].sortfs
 		}
-	case 184:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1273
+//line sql.y:1363
 		{
 			yyVAL.sortfs = nil
 		}
-	case 185:
+	case 201:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1369
 		{
 			yyVAL.sortfs = []*SortField{yyDollar[1].sortf}
 		}
-	case 186:
+	case 202:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1283
+//line sql.y:1373
 		{
 			yyVAL.sortfs = append([]*SortField{yyDollar[1].sortf}, yyDollar[3].sortfs...)
 		}
-	case 187:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1289
+//line sql.y:1379
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 188:
+	case 204:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1293
+//line sql.y:1383
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: false}
 		}
-	case 189:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1297
+//line sql.y:1387
 		{
 			yyVAL.sortf = &SortField{Name: yyDollar[1].str, Ascending: true}
 		}
-	case 190:
+	case 206:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1303
+//line sql.y:1393
 		{
 			yyVAL.intSlice = append(yyDollar[1].intSlice, yyDollar[2].intSlice...)
 		}
-	case 191:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1309
+//line sql.y:1399
 		{
 			yyVAL.int64 = yyDollar[1].int64
 		}
-	case 192:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1314
+//line sql.y:1404
 		{
 			if n, ok := yyDollar[1].expr.(*IntegerLiteral); ok {
 				yyVAL.int64 = n.Val
@@ -2804,69 +2952,69 @@ yydefault:
 				yylex.Error("unsupported type, expect integer type")
 			}
 		}
-	case 193:
+	case 209:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1324
+//line sql.y:1414
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 194:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1328
+//line sql.y:1418
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 195:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1332
+//line sql.y:1422
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 196:
+	case 212:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1336
+//line sql.y:1426
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 197:
+	case 213:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1342
+//line sql.y:1432
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), int(yyDollar[4].int64)}
 		}
-	case 198:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1346
+//line sql.y:1436
 		{
 			yyVAL.intSlice = []int{int(yyDollar[2].int64), 0}
 		}
-	case 199:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1350
+//line sql.y:1440
 		{
 			yyVAL.intSlice = []int{0, int(yyDollar[2].int64)}
 		}
-	case 200:
+	case 216:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1354
+//line sql.y:1444
 		{
 			yyVAL.intSlice = []int{0, 0}
 		}
-	case 201:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1360
+//line sql.y:1450
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: false}
 		}
-	case 202:
+	case 218:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1364
+//line sql.y:1454
 		{
 			yyVAL.stmt = &ShowDatabasesStatement{ShowDetail: true}
 		}
-	case 203:
+	case 219:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1370
+//line sql.y:1460
 		{
 			sms := yyDollar[4].stmt
 
@@ -2874,9 +3022,9 @@ yydefault:
 			sms.(*CreateDatabaseStatement).DatabaseAttr = yyDollar[5].databasePolicy
 			yyVAL.stmt = sms
 		}
-	case 204:
+	case 220:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1378
+//line sql.y:1468
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = false
@@ -2884,54 +3032,54 @@ yydefault:
 			stmt.DatabaseAttr = yyDollar[4].databasePolicy
 			yyVAL.stmt = stmt
 		}
-	case 205:
+	case 221:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1388
+//line sql.y:1478
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: false}
 		}
-	case 206:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1393
+//line sql.y:1483
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: yyDollar[1].bool}
 		}
-	case 207:
+	case 223:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1398
+//line sql.y:1488
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[2].int64), EnableTagArray: yyDollar[3].bool}
 		}
-	case 208:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1403
+//line sql.y:1493
 		{
 			yyVAL.databasePolicy = DatabasePolicy{Replicas: uint32(yyDollar[3].int64), EnableTagArray: yyDollar[1].bool}
 		}
-	case 209:
+	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1407
+//line sql.y:1497
 		{
 			yyVAL.databasePolicy = DatabasePolicy{EnableTagArray: false}
 		}
-	case 210:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1413
+//line sql.y:1503
 		{
 			if strings.ToLower(yyDollar[3].str) != "array" {
 				yylex.Error("unsupport type")
 			}
 			yyVAL.bool = true
 		}
-	case 211:
+	case 227:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1420
+//line sql.y:1510
 		{
 			yyVAL.bool = false
 		}
-	case 212:
+	case 228:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1427
+//line sql.y:1517
 		{
 			stmt := &CreateDatabaseStatement{}
 			stmt.RetentionPolicyCreate = true
@@ -2970,15 +3118,15 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 213:
+	case 229:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1470
+//line sql.y:1560
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 214:
+	case 230:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1474
+//line sql.y:1564
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3051,56 +3199,56 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 215:
+	case 231:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1549
+//line sql.y:1639
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 216:
+	case 232:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1553
+//line sql.y:1643
 		{
 			duration := yyDollar[2].tdur
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyDuration: &duration}
 		}
-	case 217:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1558
+//line sql.y:1648
 		{
 			replicaN := int(yyDollar[2].int64)
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, Replication: &replicaN}
 		}
-	case 218:
+	case 234:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1563
+//line sql.y:1653
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, PolicyName: yyDollar[2].str}
 		}
-	case 219:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1567
+//line sql.y:1657
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, ReplicaNum: uint32(yyDollar[2].int64)}
 		}
-	case 220:
+	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1571
+//line sql.y:1661
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: true}
 		}
-	case 221:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1575
+//line sql.y:1665
 		{
 			if len(yyDollar[2].strSlice) == 0 {
 				yylex.Error("ShardKey should not be nil")
 			}
 			yyVAL.durations = &Durations{ShardKey: yyDollar[2].strSlice, ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1, rpdefault: false}
 		}
-	case 222:
+	case 238:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1586
+//line sql.y:1676
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3111,9 +3259,9 @@ yydefault:
 			sms.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 223:
+	case 239:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1597
+//line sql.y:1687
 		{
 			sms := &ShowMeasurementsStatement{}
 			sms.Database = yyDollar[3].str
@@ -3123,38 +3271,38 @@ yydefault:
 			sms.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = sms
 		}
-	case 224:
+	case 240:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1609
+//line sql.y:1699
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			sms.Source = yyDollar[7].ment
 			yyVAL.stmt = sms
 		}
-	case 225:
+	case 241:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1616
+//line sql.y:1706
 		{
 			sms := &ShowMeasurementsDetailStatement{}
 			sms.Database = yyDollar[4].str
 			yyVAL.stmt = sms
 		}
-	case 226:
+	case 242:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1625
+//line sql.y:1715
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 227:
+	case 243:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1629
+//line sql.y:1719
 		{
 			yyVAL.ment = &Measurement{Name: yyDollar[2].str}
 		}
-	case 228:
+	case 244:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1633
+//line sql.y:1723
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3162,9 +3310,9 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 229:
+	case 245:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1641
+//line sql.y:1731
 		{
 			re, err := regexp.Compile(yyDollar[2].str)
 			if err != nil {
@@ -3172,32 +3320,32 @@ yydefault:
 			}
 			yyVAL.ment = &Measurement{Regex: &RegexLiteral{Val: re}}
 		}
-	case 230:
+	case 246:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1653
+//line sql.y:1743
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{
 				Database: yyDollar[5].str,
 			}
 		}
-	case 231:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1659
+//line sql.y:1749
 		{
 			yyVAL.stmt = &ShowRetentionPoliciesStatement{}
 		}
-	case 232:
+	case 248:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1666
+//line sql.y:1756
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 233:
+	case 249:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1673
+//line sql.y:1763
 		{
 			stmt := yyDollar[7].stmt.(*CreateRetentionPolicyStatement)
 			stmt.Name = yyDollar[4].str
@@ -3205,18 +3353,18 @@ yydefault:
 			stmt.Default = true
 			yyVAL.stmt = stmt
 		}
-	case 234:
+	case 250:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1683
+//line sql.y:1773
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 235:
+	case 251:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1690
+//line sql.y:1780
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3224,9 +3372,9 @@ yydefault:
 			stmt.Admin = true
 			yyVAL.stmt = stmt
 		}
-	case 236:
+	case 252:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1698
+//line sql.y:1788
 		{
 			stmt := &CreateUserStatement{}
 			stmt.Name = yyDollar[3].str
@@ -3234,9 +3382,9 @@ yydefault:
 			stmt.Rwuser = true
 			yyVAL.stmt = stmt
 		}
-	case 237:
+	case 253:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1709
+//line sql.y:1799
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
@@ -3268,24 +3416,24 @@ yydefault:
 
 			yyVAL.stmt = stmt
 		}
-	case 238:
+	case 254:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1741
+//line sql.y:1831
 		{
 			stmt := &CreateRetentionPolicyStatement{}
 			stmt.Duration = yyDollar[2].tdur
 			stmt.Replication = int(yyDollar[4].int64)
 			yyVAL.stmt = stmt
 		}
-	case 239:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1751
+//line sql.y:1841
 		{
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 240:
+	case 256:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1755
+//line sql.y:1845
 		{
 			if yyDollar[1].durations.ShardGroupDuration < 0 || yyDollar[2].durations.ShardGroupDuration < 0 {
 				if yyDollar[2].durations.ShardGroupDuration >= 0 {
@@ -3320,33 +3468,33 @@ yydefault:
 			}
 			yyVAL.durations = yyDollar[1].durations
 		}
-	case 241:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1793
+//line sql.y:1883
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: yyDollar[3].tdur, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 242:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1797
+//line sql.y:1887
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: yyDollar[3].tdur, WarmDuration: -1, IndexGroupDuration: -1}
 		}
-	case 243:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1801
+//line sql.y:1891
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: yyDollar[3].tdur, IndexGroupDuration: -1}
 		}
-	case 244:
+	case 260:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1805
+//line sql.y:1895
 		{
 			yyVAL.durations = &Durations{ShardGroupDuration: -1, HotDuration: -1, WarmDuration: -1, IndexGroupDuration: yyDollar[3].tdur}
 		}
-	case 245:
+	case 261:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1813
+//line sql.y:1903
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3357,9 +3505,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 246:
+	case 262:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1824
+//line sql.y:1914
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Database = yyDollar[3].str
@@ -3369,9 +3517,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 247:
+	case 263:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1834
+//line sql.y:1924
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -3383,9 +3531,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 248:
+	case 264:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1846
+//line sql.y:1936
 		{
 			stmt := &ShowSeriesStatement{}
 			stmt.Hints = yyDollar[2].hints
@@ -3396,57 +3544,57 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 249:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1859
+//line sql.y:1949
 		{
 			yyVAL.stmt = &ShowUsersStatement{}
 		}
-	case 250:
+	case 266:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1865
+//line sql.y:1955
 		{
 			stmt := &DropDatabaseStatement{}
 			stmt.Name = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 251:
+	case 267:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1873
+//line sql.y:1963
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Sources = yyDollar[3].sources
 			stmt.Condition = yyDollar[4].expr
 			yyVAL.stmt = stmt
 		}
-	case 252:
+	case 268:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1880
+//line sql.y:1970
 		{
 			stmt := &DropSeriesStatement{}
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 253:
+	case 269:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1888
+//line sql.y:1978
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Sources = yyDollar[2].sources
 			stmt.Condition = yyDollar[3].expr
 			yyVAL.stmt = stmt
 		}
-	case 254:
+	case 270:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1895
+//line sql.y:1985
 		{
 			stmt := &DeleteSeriesStatement{}
 			stmt.Condition = yyDollar[2].expr
 			yyVAL.stmt = stmt
 		}
-	case 255:
+	case 271:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1904
+//line sql.y:1994
 		{
 			stmt := &AlterRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
@@ -3480,18 +3628,18 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 256:
+	case 272:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1942
+//line sql.y:2032
 		{
 			stmt := &DropRetentionPolicyStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Database = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 257:
+	case 273:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1951
+//line sql.y:2041
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3499,9 +3647,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 258:
+	case 274:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1959
+//line sql.y:2049
 		{
 			stmt := &GrantStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3509,9 +3657,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 259:
+	case 275:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1967
+//line sql.y:2057
 		{
 			stmt := &GrantStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3526,21 +3674,21 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 260:
+	case 276:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1984
+//line sql.y:2074
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[5].str}
 		}
-	case 261:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1988
+//line sql.y:2078
 		{
 			yyVAL.stmt = &GrantAdminStatement{User: yyDollar[4].str}
 		}
-	case 262:
+	case 278:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1994
+//line sql.y:2084
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3548,9 +3696,9 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 263:
+	case 279:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2002
+//line sql.y:2092
 		{
 			stmt := &RevokeStatement{}
 			stmt.Privilege = AllPrivileges
@@ -3558,9 +3706,9 @@ yydefault:
 			stmt.User = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 264:
+	case 280:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2010
+//line sql.y:2100
 		{
 			stmt := &RevokeStatement{}
 			switch strings.ToLower(yyDollar[2].str) {
@@ -3575,27 +3723,27 @@ yydefault:
 			stmt.User = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 265:
+	case 281:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2027
+//line sql.y:2117
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[5].str}
 		}
-	case 266:
+	case 282:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2031
+//line sql.y:2121
 		{
 			yyVAL.stmt = &RevokeAdminStatement{User: yyDollar[4].str}
 		}
-	case 267:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2037
+//line sql.y:2127
 		{
 			yyVAL.stmt = &DropUserStatement{Name: yyDollar[3].str}
 		}
-	case 268:
+	case 284:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2043
+//line sql.y:2133
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3609,9 +3757,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 269:
+	case 285:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2057
+//line sql.y:2147
 		{
 			stmt := &ShowTagKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3623,72 +3771,72 @@ yydefault:
 			stmt.SOffset = yyDollar[7].intSlice[3]
 			yyVAL.stmt = stmt
 		}
-	case 270:
+	case 286:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2071
+//line sql.y:2161
 		{
 			yyVAL.str = "PRIMARYKEY"
 		}
-	case 271:
+	case 287:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2075
+//line sql.y:2165
 		{
 			yyVAL.str = "SORTKEY"
 		}
-	case 272:
+	case 288:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2079
+//line sql.y:2169
 		{
 			yyVAL.str = "PROPERTY"
 		}
-	case 273:
+	case 289:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2083
+//line sql.y:2173
 		{
 			yyVAL.str = "SHARDKEY"
 		}
-	case 274:
+	case 290:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2087
+//line sql.y:2177
 		{
 			yyVAL.str = "ENGINETYPE"
 		}
-	case 275:
+	case 291:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2091
+//line sql.y:2181
 		{
 			yyVAL.str = "SCHEMA"
 		}
-	case 276:
+	case 292:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2095
+//line sql.y:2185
 		{
 			yyVAL.str = "INDEXES"
 		}
-	case 277:
+	case 293:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2099
+//line sql.y:2189
 		{
 			yyVAL.str = "COMPACT"
 		}
-	case 278:
+	case 294:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2103
+//line sql.y:2193
 		{
 			yylex.Error("SHOW command error, only support PRIMARYKEY, SORTKEY, SHARDKEY, ENGINETYPE, INDEXES, SCHEMA, COMPACT")
 		}
-	case 279:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2109
+//line sql.y:2199
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 280:
+	case 296:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2116
+//line sql.y:2206
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3697,9 +3845,9 @@ yydefault:
 			stmt.Measurement = yyDollar[8].str
 			yyVAL.stmt = stmt
 		}
-	case 281:
+	case 297:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2125
+//line sql.y:2215
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3707,9 +3855,9 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 282:
+	case 298:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2133
+//line sql.y:2223
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
@@ -3717,30 +3865,30 @@ yydefault:
 			stmt.Measurement = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 283:
+	case 299:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2141
+//line sql.y:2231
 		{
 			stmt := &ShowMeasurementKeysStatement{}
 			stmt.Name = yyDollar[2].str
 			stmt.Measurement = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 284:
+	case 300:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2150
+//line sql.y:2240
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 285:
+	case 301:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2154
+//line sql.y:2244
 		{
 			yyVAL.str = ""
 		}
-	case 286:
+	case 302:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2160
+//line sql.y:2250
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3750,9 +3898,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 287:
+	case 303:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2170
+//line sql.y:2260
 		{
 			stmt := &ShowFieldKeysStatement{}
 			stmt.Database = yyDollar[4].str
@@ -3761,9 +3909,9 @@ yydefault:
 			stmt.Offset = yyDollar[6].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 288:
+	case 304:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2182
+//line sql.y:2272
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3776,9 +3924,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 289:
+	case 305:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2195
+//line sql.y:2285
 		{
 			stmt := yyDollar[7].stmt.(*ShowTagValuesStatement)
 			stmt.TagKeyCondition = nil
@@ -3789,9 +3937,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 290:
+	case 306:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2206
+//line sql.y:2296
 		{
 			stmt := yyDollar[9].stmt.(*ShowTagValuesStatement)
 			stmt.Hints = yyDollar[2].hints
@@ -3804,9 +3952,9 @@ yydefault:
 			stmt.Offset = yyDollar[12].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 291:
+	case 307:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2219
+//line sql.y:2309
 		{
 			stmt := yyDollar[8].stmt.(*ShowTagValuesStatement)
 			stmt.Hints = yyDollar[2].hints
@@ -3818,36 +3966,36 @@ yydefault:
 			stmt.Offset = yyDollar[11].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 292:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2233
+//line sql.y:2323
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 293:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2240
+//line sql.y:2330
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQ
 			stmt.TagKeyExpr = yyDollar[2].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 294:
+	case 310:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2247
+//line sql.y:2337
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = IN
 			stmt.TagKeyExpr = yyDollar[3].expr.(*ListLiteral)
 			yyVAL.stmt = stmt
 		}
-	case 295:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2254
+//line sql.y:2344
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = EQREGEX
@@ -3858,9 +4006,9 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 296:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2265
+//line sql.y:2355
 		{
 			stmt := &ShowTagValuesStatement{}
 			stmt.Op = NEQREGEX
@@ -3871,47 +4019,47 @@ yydefault:
 			stmt.TagKeyExpr = &RegexLiteral{Val: re}
 			yyVAL.stmt = stmt
 		}
-	case 297:
+	case 313:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2279
+//line sql.y:2369
 		{
 			temp := []string{yyDollar[1].str}
 			yyVAL.expr = &ListLiteral{Vals: temp}
 		}
-	case 298:
+	case 314:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2284
+//line sql.y:2374
 		{
 			yyDollar[3].expr.(*ListLiteral).Vals = append(yyDollar[3].expr.(*ListLiteral).Vals, yyDollar[1].str)
 			yyVAL.expr = yyDollar[3].expr
 		}
-	case 299:
+	case 315:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2291
+//line sql.y:2381
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 300:
+	case 316:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2299
+//line sql.y:2389
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[3].stmt.(*SelectStatement)
 			stmt.Analyze = true
 			yyVAL.stmt = stmt
 		}
-	case 301:
+	case 317:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2306
+//line sql.y:2396
 		{
 			stmt := &ExplainStatement{}
 			stmt.Statement = yyDollar[2].stmt.(*SelectStatement)
 			stmt.Analyze = false
 			yyVAL.stmt = stmt
 		}
-	case 302:
+	case 318:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2316
+//line sql.y:2406
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3923,9 +4071,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 303:
+	case 319:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2328
+//line sql.y:2418
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3936,9 +4084,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 304:
+	case 320:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2339
+//line sql.y:2429
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3950,9 +4098,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 305:
+	case 321:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2351
+//line sql.y:2441
 		{
 			stmt := &ShowTagKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -3963,9 +4111,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 306:
+	case 322:
 		yyDollar = yyS[yypt-13 : yypt+1]
-//line sql.y:2367
+//line sql.y:2457
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3982,9 +4130,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 307:
+	case 323:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2384
+//line sql.y:2474
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -3999,9 +4147,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 308:
+	case 324:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2399
+//line sql.y:2489
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4018,9 +4166,9 @@ yydefault:
 			yyVAL.stmt = stmt
 
 		}
-	case 309:
+	case 325:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:2416
+//line sql.y:2506
 		{
 			stmt := &ShowTagValuesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4035,9 +4183,9 @@ yydefault:
 			stmt.TagKeyCondition = nil
 			yyVAL.stmt = stmt
 		}
-	case 310:
+	case 326:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2434
+//line sql.y:2524
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -4049,9 +4197,9 @@ yydefault:
 			stmt.Offset = yyDollar[10].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 311:
+	case 327:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2446
+//line sql.y:2536
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[6].str
@@ -4062,9 +4210,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 312:
+	case 328:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2457
+//line sql.y:2547
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4076,9 +4224,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 313:
+	case 329:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2469
+//line sql.y:2559
 		{
 			stmt := &ShowFieldKeyCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4089,9 +4237,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 314:
+	case 330:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2483
+//line sql.y:2573
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4111,12 +4259,13 @@ yydefault:
 			stmt.NumOfShards = yyDollar[5].cmOption.NumOfShards
 			stmt.Type = yyDollar[5].cmOption.Type
 			stmt.EngineType = yyDollar[5].cmOption.EngineType
+			stmt.TTL = yyDollar[5].cmOption.TTL
 
 			yyVAL.stmt = stmt
 		}
-	case 315:
+	case 331:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2506
+//line sql.y:2597
 		{
 			stmt := &CreateMeasurementStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4203,76 +4352,95 @@ yydefault:
 			stmt.SortKey = yyDollar[5].cmOption.SortKey
 			stmt.Property = yyDollar[5].cmOption.Property
 			stmt.CompactType = yyDollar[5].cmOption.CompactType
+			stmt.TTL = yyDollar[5].cmOption.TTL
 			yyVAL.stmt = stmt
 		}
-	case 316:
+	case 332:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2596
+//line sql.y:2688
 		{
 			option := &CreateMeasurementStatementOption{}
 			option.Type = "hash"
 			option.EngineType = "tsstore"
 			yyVAL.cmOption = option
 		}
-	case 317:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2603
+	case 333:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line sql.y:2695
 		{
 			option := &CreateMeasurementStatementOption{}
-			if yyDollar[3].indexType != nil {
-				option.IndexType = yyDollar[3].indexType.types
-				option.IndexList = yyDollar[3].indexType.lists
+			if yyDollar[4].indexType != nil {
+				option.IndexType = yyDollar[4].indexType.types
+				option.IndexList = yyDollar[4].indexType.lists
 			}
-			if yyDollar[4].strSlice != nil {
-				option.ShardKey = yyDollar[4].strSlice
+			if yyDollar[5].strSlice != nil {
+				option.ShardKey = yyDollar[5].strSlice
 			}
-			option.NumOfShards = yyDollar[5].int64
-			option.Type = yyDollar[6].str
-			option.EngineType = yyDollar[2].str
+			option.NumOfShards = yyDollar[6].int64
+			option.Type = yyDollar[7].str
+			option.EngineType = yyDollar[3].str
+			option.TTL = yyDollar[2].tdur
 			yyVAL.cmOption = option
 		}
-	case 318:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2620
+	case 334:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:2713
 		{
 			option := &CreateMeasurementStatementOption{}
-			if yyDollar[3].indexType != nil {
-				option.IndexType = yyDollar[3].indexType.types
-				option.IndexList = yyDollar[3].indexType.lists
-				option.TimeClusterDuration = yyDollar[3].indexType.timeClusterDuration
-			}
-			if yyDollar[4].strSlice != nil {
-				option.ShardKey = yyDollar[4].strSlice
-			}
-			option.NumOfShards = yyDollar[5].int64
-			option.Type = yyDollar[6].str
-			option.EngineType = yyDollar[2].str
-			if yyDollar[7].strSlice != nil {
-				option.PrimaryKey = yyDollar[7].strSlice
-			} else if yyDollar[8].strSlice != nil {
+			if yyDollar[4].indexType != nil {
+				option.IndexType = yyDollar[4].indexType.types
+				option.IndexList = yyDollar[4].indexType.lists
+				option.TimeClusterDuration = yyDollar[4].indexType.timeClusterDuration
+			}
+			if yyDollar[5].strSlice != nil {
+				option.ShardKey = yyDollar[5].strSlice
+			}
+			option.NumOfShards = yyDollar[6].int64
+			option.Type = yyDollar[7].str
+			option.EngineType = yyDollar[3].str
+			if yyDollar[8].strSlice != nil {
 				option.PrimaryKey = yyDollar[8].strSlice
+			} else if yyDollar[9].strSlice != nil {
+				option.PrimaryKey = yyDollar[9].strSlice
 			}
 
-			if yyDollar[8].strSlice != nil {
+			if yyDollar[9].strSlice != nil {
+				option.SortKey = yyDollar[9].strSlice
+			} else if yyDollar[8].strSlice != nil {
 				option.SortKey = yyDollar[8].strSlice
-			} else if yyDollar[7].strSlice != nil {
-				option.SortKey = yyDollar[7].strSlice
 			}
-			if yyDollar[9].strSlices != nil {
-				option.Property = yyDollar[9].strSlices
+			if yyDollar[10].strSlices != nil {
+				option.Property = yyDollar[10].strSlices
 			}
-			option.CompactType = yyDollar[10].str
+			option.CompactType = yyDollar[11].str
+			option.TTL = yyDollar[2].tdur
 			yyVAL.cmOption = option
 		}
-	case 319:
+	case 335:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2652
+//line sql.y:2746
+		{
+			yyVAL.tdur = 0
+		}
+	case 336:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:2750
+		{
+			if strings.ToLower(yyDollar[1].str) != "ttl" {
+				yylex.Error("expect TTL")
+				return 1
+			}
+			yyVAL.tdur = yyDollar[2].tdur
+		}
+	case 337:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:2759
 		{
 			yyVAL.indexType = nil
 		}
-	case 320:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2656
+//line sql.y:2763
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["text"] = struct{}{}
@@ -4288,15 +4456,15 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 321:
+	case 339:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2673
+//line sql.y:2780
 		{
 			yyVAL.indexType = nil
 		}
-	case 322:
+	case 340:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2677
+//line sql.y:2784
 		{
 			validIndexType := map[string]struct{}{}
 			validIndexType["bloomfilter"] = struct{}{}
@@ -4314,9 +4482,9 @@ yydefault:
 				yyVAL.indexType = yyDollar[2].indexType
 			}
 		}
-	case 323:
+	case 341:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2695
+//line sql.y:2802
 		{
 			indexType := strings.ToLower(yyDollar[2].str)
 			if indexType != "timecluster" {
@@ -4345,104 +4513,104 @@ yydefault:
 				yyVAL.indexType = indextype
 			}
 		}
-	case 324:
+	case 342:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2725
+//line sql.y:2832
 		{
 			yyVAL.strSlice = nil
 		}
-	case 325:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2729
+//line sql.y:2836
 		{
 			shardKey := yyDollar[2].strSlice
 			sort.Strings(shardKey)
 			yyVAL.strSlice = shardKey
 		}
-	case 326:
+	case 344:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2736
+//line sql.y:2843
 		{
 			yyVAL.int64 = 0
 		}
-	case 327:
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2740
+//line sql.y:2847
 		{
 			yyVAL.int64 = -1
 		}
-	case 328:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2744
+//line sql.y:2851
 		{
 			if yyDollar[2].int64 == 0 {
 				yylex.Error("syntax error: NUM OF SHARDS SHOULD LARGER THAN 0")
 			}
 			yyVAL.int64 = yyDollar[2].int64
 		}
-	case 329:
+	case 347:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2752
+//line sql.y:2859
 		{
 			yyVAL.str = "tsstore" // default engine type
 		}
-	case 330:
+	case 348:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2756
+//line sql.y:2863
 		{
 			yyVAL.str = "tsstore"
 		}
-	case 331:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2762
+//line sql.y:2869
 		{
 			yyVAL.str = "columnstore"
 		}
-	case 332:
+	case 350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2767
+//line sql.y:2874
 		{
 			yyVAL.strSlice = nil
 		}
-	case 333:
+	case 351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2770
+//line sql.y:2877
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 334:
+	case 352:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2775
+//line sql.y:2882
 		{
 			yyVAL.strSlice = nil
 		}
-	case 335:
+	case 353:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2778
+//line sql.y:2885
 		{
 			yyVAL.strSlice = yyDollar[1].strSlice
 		}
-	case 336:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2783
+//line sql.y:2890
 		{
 			yyVAL.strSlices = nil
 		}
-	case 337:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2786
+//line sql.y:2893
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 338:
+	case 356:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2791
+//line sql.y:2898
 		{
 			yyVAL.str = "row"
 		}
-	case 339:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2795
+//line sql.y:2902
 		{
 			compactionType := strings.ToLower(yyDollar[2].str)
 			if compactionType != "row" && compactionType != "block" {
@@ -4451,9 +4619,9 @@ yydefault:
 			}
 			yyVAL.str = compactionType
 		}
-	case 340:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2806
+//line sql.y:2913
 		{
 			stmt := &CreateMeasurementStatement{
 				Tags:   make(map[string]int32),
@@ -4482,40 +4650,40 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 341:
+	case 359:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2835
+//line sql.y:2942
 		{
 			yyVAL.stmt = nil
 		}
-	case 342:
+	case 360:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2841
+//line sql.y:2948
 		{
 			fields := []*fieldList{yyDollar[1].fieldOption}
 			yyVAL.fieldOptions = append(fields, yyDollar[2].fieldOptions...)
 		}
-	case 343:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2847
+//line sql.y:2954
 		{
 			yyVAL.fieldOptions = []*fieldList{yyDollar[1].fieldOption}
 		}
-	case 344:
+	case 362:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2853
+//line sql.y:2960
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 345:
+	case 363:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2858
+//line sql.y:2965
 		{
 			yyVAL.fieldOption = yyDollar[1].fieldOption
 		}
-	case 346:
+	case 364:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2864
+//line sql.y:2971
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4523,9 +4691,9 @@ yydefault:
 				tagOrField: "tag",
 			}
 		}
-	case 347:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2873
+//line sql.y:2980
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4533,9 +4701,9 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 348:
+	case 366:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2882
+//line sql.y:2989
 		{
 			yyVAL.fieldOption = &fieldList{
 				fieldName:  yyDollar[1].str,
@@ -4543,27 +4711,27 @@ yydefault:
 				tagOrField: "field",
 			}
 		}
-	case 349:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2892
+//line sql.y:2999
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{yyDollar[1].str},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 350:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2900
+//line sql.y:3007
 		{
 			yyVAL.indexType = &IndexType{
 				types: []string{"field"},
 				lists: [][]string{yyDollar[3].strSlice},
 			}
 		}
-	case 351:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2909
+//line sql.y:3016
 		{
 			indextype := yyDollar[1].indexType
 			if yyDollar[2].indexType != nil {
@@ -4572,28 +4740,28 @@ yydefault:
 			}
 			yyVAL.indexType = indextype
 		}
-	case 352:
+	case 370:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2918
+//line sql.y:3025
 		{
 			yyVAL.indexType = nil
 		}
-	case 353:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2924
+//line sql.y:3031
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 354:
+	case 372:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2928
+//line sql.y:3035
 		{
 
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 355:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2935
+//line sql.y:3042
 		{
 			shardType := strings.ToLower(yyDollar[2].str)
 			if shardType != "hash" && shardType != "range" {
@@ -4602,27 +4770,27 @@ yydefault:
 			}
 			yyVAL.str = shardType
 		}
-	case 356:
+	case 374:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2944
+//line sql.y:3051
 		{
 			yyVAL.str = "hash"
 		}
-	case 357:
+	case 375:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2950
+//line sql.y:3057
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 358:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2956
+//line sql.y:3063
 		{
 			yyVAL.strSlice = yyDollar[2].strSlice
 		}
-	case 359:
+	case 377:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2962
+//line sql.y:3069
 		{
 			m := yyDollar[1].strSlices
 			if yyDollar[3].strSlices != nil {
@@ -4631,82 +4799,82 @@ yydefault:
 			}
 			yyVAL.strSlices = m
 		}
-	case 360:
+	case 378:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2972
+//line sql.y:3079
 		{
 			yyVAL.strSlices = yyDollar[1].strSlices
 		}
-	case 361:
+	case 379:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2978
+//line sql.y:3085
 		{
 			yyVAL.strSlices = yyDollar[2].strSlices
 		}
-	case 362:
+	case 380:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2984
+//line sql.y:3091
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {yyDollar[3].str}}
 		}
-	case 363:
+	case 381:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2988
+//line sql.y:3095
 		{
 			yyVAL.strSlices = [][]string{{yyDollar[1].str}, {fmt.Sprintf("%d", yyDollar[3].int64)}}
 		}
-	case 364:
+	case 382:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2992
+//line sql.y:3099
 		{
 			yyVAL.strSlices = nil
 		}
-	case 365:
+	case 383:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2998
+//line sql.y:3105
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 366:
+	case 384:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3002
+//line sql.y:3109
 		{
 			yyVAL.strSlice = append(yyDollar[1].strSlice, yyDollar[3].str)
 		}
-	case 367:
+	case 385:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3007
+//line sql.y:3114
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 368:
+	case 386:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3013
+//line sql.y:3120
 		{
 			stmt := &DropShardStatement{}
 			stmt.ID = uint64(yyDollar[3].int64)
 			yyVAL.stmt = stmt
 		}
-	case 369:
+	case 387:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3021
+//line sql.y:3128
 		{
 			stmt := &SetPasswordUserStatement{}
 			stmt.Name = yyDollar[4].str
 			stmt.Password = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 370:
+	case 388:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3032
+//line sql.y:3139
 		{
 			stmt := &ShowGrantsForUserStatement{}
 			stmt.Name = yyDollar[4].str
 			yyVAL.stmt = stmt
 		}
-	case 371:
+	case 389:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3040
+//line sql.y:3147
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4718,9 +4886,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 372:
+	case 390:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3052
+//line sql.y:3159
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4731,9 +4899,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 373:
+	case 391:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3063
+//line sql.y:3170
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4745,9 +4913,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 374:
+	case 392:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3075
+//line sql.y:3182
 		{
 			stmt := &ShowMeasurementCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4758,9 +4926,9 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 375:
+	case 393:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3089
+//line sql.y:3196
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4772,9 +4940,9 @@ yydefault:
 			stmt.Offset = yyDollar[9].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 376:
+	case 394:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3101
+//line sql.y:3208
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[5].str
@@ -4785,9 +4953,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 377:
+	case 395:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3112
+//line sql.y:3219
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4799,9 +4967,9 @@ yydefault:
 			stmt.Offset = yyDollar[8].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 378:
+	case 396:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3124
+//line sql.y:3231
 		{
 			stmt := &ShowSeriesCardinalityStatement{}
 			stmt.Database = yyDollar[4].str
@@ -4812,23 +4980,48 @@ yydefault:
 			stmt.Offset = yyDollar[7].intSlice[1]
 			yyVAL.stmt = stmt
 		}
-	case 379:
+	case 397:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3245
+		{
+			if strings.ToLower(yyDollar[3].str) != "quota" {
+				yylex.Error("expect QUOTA")
+				return 1
+			}
+			stmt := &ShowSeriesQuotaStatement{}
+			stmt.Database = yyDollar[4].str
+			stmt.Sources = yyDollar[5].sources
+			yyVAL.stmt = stmt
+		}
+	case 398:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3256
+		{
+			if strings.ToLower(yyDollar[3].str) != "quota" {
+				yylex.Error("expect QUOTA")
+				return 1
+			}
+			stmt := &ShowSeriesQuotaStatement{}
+			stmt.Database = yyDollar[4].str
+			yyVAL.stmt = stmt
+		}
+	case 399:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3138
+//line sql.y:3269
 		{
 			stmt := &ShowShardsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 380:
+	case 400:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3143
+//line sql.y:3274
 		{
 			stmt := &ShowShardsStatement{mstInfo: yyDollar[4].ment}
 			yyVAL.stmt = stmt
 		}
-	case 381:
+	case 401:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3151
+//line sql.y:3282
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4839,9 +5032,9 @@ yydefault:
 			stmt.Type = yyDollar[7].str
 			yyVAL.stmt = stmt
 		}
-	case 382:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3162
+//line sql.y:3293
 		{
 			stmt := &AlterShardKeyStatement{}
 			stmt.Database = yyDollar[3].ment.Database
@@ -4850,34 +5043,49 @@ yydefault:
 			stmt.Type = "hash"
 			yyVAL.stmt = stmt
 		}
-	case 383:
+	case 403:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:3302
+		{
+			if strings.ToLower(yyDollar[5].str) != "ttl" {
+				yylex.Error("expect TTL or SHARDKEY")
+				return 1
+			}
+			stmt := &AlterMeasurementTTLStatement{}
+			stmt.Database = yyDollar[3].ment.Database
+			stmt.Name = yyDollar[3].ment.Name
+			stmt.RetentionPolicy = yyDollar[3].ment.RetentionPolicy
+			stmt.TTL = yyDollar[6].tdur
+			yyVAL.stmt = stmt
+		}
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3176
+//line sql.y:3320
 		{
 			stmt := &ShowShardGroupsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 384:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3183
+//line sql.y:3327
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[3].str
 			stmt.RpName = ""
 			yyVAL.stmt = stmt
 		}
-	case 385:
+	case 406:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3190
+//line sql.y:3334
 		{
 			stmt := &DropMeasurementStatement{}
 			stmt.Name = yyDollar[5].str
 			stmt.RpName = yyDollar[3].str
 			yyVAL.stmt = stmt
 		}
-	case 386:
+	case 407:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3200
+//line sql.y:3344
 		{
 			stmt := &CreateContinuousQueryStatement{
 				Name:     yyDollar[4].str,
@@ -4890,64 +5098,64 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 387:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3215
+//line sql.y:3359
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 			}
 		}
-	case 388:
+	case 409:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3221
+//line sql.y:3365
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleFor: yyDollar[3].tdur,
 			}
 		}
-	case 389:
+	case 410:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3227
+//line sql.y:3371
 		{
 			yyVAL.cqsp = &cqSamplePolicyInfo{
 				ResampleEvery: yyDollar[3].tdur,
 				ResampleFor:   yyDollar[5].tdur,
 			}
 		}
-	case 390:
+	case 411:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3234
+//line sql.y:3378
 		{
 			yyVAL.cqsp = nil
 		}
-	case 391:
+	case 412:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3240
+//line sql.y:3384
 		{
 			yyVAL.stmt = &ShowContinuousQueriesStatement{}
 		}
-	case 392:
+	case 413:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3246
+//line sql.y:3390
 		{
 			yyVAL.stmt = &DropContinuousQueryStatement{
 				Name:     yyDollar[4].str,
 				Database: yyDollar[6].str,
 			}
 		}
-	case 393:
+	case 414:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3254
+//line sql.y:3398
 		{
 			stmt := yyDollar[9].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[4].str
 			stmt.Ops = yyDollar[6].fields
 			yyVAL.stmt = stmt
 		}
-	case 394:
+	case 415:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:3261
+//line sql.y:3405
 		{
 			stmt := yyDollar[11].stmt.(*CreateDownSampleStatement)
 			stmt.RpName = yyDollar[6].str
@@ -4955,65 +5163,65 @@ yydefault:
 			stmt.Ops = yyDollar[8].fields
 			yyVAL.stmt = stmt
 		}
-	case 395:
+	case 416:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3269
+//line sql.y:3413
 		{
 			stmt := yyDollar[7].stmt.(*CreateDownSampleStatement)
 			stmt.Ops = yyDollar[4].fields
 			yyVAL.stmt = stmt
 		}
-	case 396:
+	case 417:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3277
+//line sql.y:3421
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				RpName: yyDollar[4].str,
 			}
 		}
-	case 397:
+	case 418:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3283
+//line sql.y:3427
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName: yyDollar[4].str,
 				RpName: yyDollar[6].str,
 			}
 		}
-	case 398:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3290
+//line sql.y:3434
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DropAll: true,
 			}
 		}
-	case 399:
+	case 420:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3296
+//line sql.y:3440
 		{
 			yyVAL.stmt = &DropDownSampleStatement{
 				DbName:  yyDollar[4].str,
 				DropAll: true,
 			}
 		}
-	case 400:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3305
+//line sql.y:3449
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{}
 		}
-	case 401:
+	case 422:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3309
+//line sql.y:3453
 		{
 			yyVAL.stmt = &ShowDownSampleStatement{
 				DbName: yyDollar[4].str,
 			}
 		}
-	case 402:
+	case 423:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3317
+//line sql.y:3461
 		{
 			yyVAL.stmt = &CreateDownSampleStatement{
 				Duration:       yyDollar[2].tdur,
@@ -5021,21 +5229,21 @@ yydefault:
 				TimeInterval:   yyDollar[9].tdurs,
 			}
 		}
-	case 403:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3327
+//line sql.y:3471
 		{
 			yyVAL.tdurs = []time.Duration{yyDollar[1].tdur}
 		}
-	case 404:
+	case 425:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3331
+//line sql.y:3475
 		{
 			yyVAL.tdurs = append([]time.Duration{yyDollar[1].tdur}, yyDollar[3].tdurs...)
 		}
-	case 405:
+	case 426:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3338
+//line sql.y:3482
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -5057,9 +5265,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 406:
+	case 427:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3360
+//line sql.y:3504
 		{
 			stmt := &CreateStreamStatement{
 				Name:  yyDollar[3].str,
@@ -5080,112 +5288,112 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 407:
+	case 428:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3383
+//line sql.y:3527
 		{
 			yyVAL.stmt = &ShowStreamsStatement{}
 		}
-	case 408:
+	case 429:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3387
+//line sql.y:3531
 		{
 			yyVAL.stmt = &ShowStreamsStatement{Database: yyDollar[4].str}
 		}
-	case 409:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3393
+//line sql.y:3537
 		{
 			yyVAL.stmt = &DropStreamsStatement{Name: yyDollar[3].str}
 		}
-	case 410:
+	case 431:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3398
+//line sql.y:3542
 		{
 			yyVAL.stmt = &ShowQueriesStatement{}
 		}
-	case 411:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3403
+//line sql.y:3547
 		{
 			yyVAL.stmt = &KillQueryStatement{QueryID: uint64(yyDollar[3].int64)}
 		}
-	case 412:
+	case 433:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3409
+//line sql.y:3553
 		{
 			yyVAL.strSlice = []string{yyDollar[1].str}
 		}
-	case 413:
+	case 434:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3413
+//line sql.y:3557
 		{
 			yyVAL.strSlice = append([]string{yyDollar[1].str}, yyDollar[3].strSlice...)
 		}
-	case 414:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3419
+//line sql.y:3563
 		{
 			yyVAL.str = "ALL"
 		}
-	case 415:
+	case 436:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3423
+//line sql.y:3567
 		{
 			yyVAL.str = "ANY"
 		}
-	case 416:
+	case 437:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3429
+//line sql.y:3573
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str, Destinations: yyDollar[10].strSlice, Mode: yyDollar[9].str}
 		}
-	case 417:
+	case 438:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3433
+//line sql.y:3577
 		{
 			yyVAL.stmt = &CreateSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: "", Destinations: yyDollar[8].strSlice, Mode: yyDollar[7].str}
 		}
-	case 418:
+	case 439:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3439
+//line sql.y:3583
 		{
 			yyVAL.stmt = &ShowSubscriptionsStatement{}
 		}
-	case 419:
+	case 440:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3445
+//line sql.y:3589
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: "", RetentionPolicy: ""}
 		}
-	case 420:
+	case 441:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3449
+//line sql.y:3593
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: "", Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 421:
+	case 442:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3453
+//line sql.y:3597
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: yyDollar[7].str}
 		}
-	case 422:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3457
+//line sql.y:3601
 		{
 			yyVAL.stmt = &DropSubscriptionStatement{Name: yyDollar[3].str, Database: yyDollar[5].str, RetentionPolicy: ""}
 		}
-	case 423:
+	case 444:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3463
+//line sql.y:3607
 		{
 			stmt := &ShowConfigsStatement{}
 			yyVAL.stmt = stmt
 		}
-	case 424:
+	case 445:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3470
+//line sql.y:3614
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5193,9 +5401,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 425:
+	case 446:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3478
+//line sql.y:3622
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5203,9 +5411,9 @@ yydefault:
 			stmt.Value = yyDollar[6].int64
 			yyVAL.stmt = stmt
 		}
-	case 426:
+	case 447:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3486
+//line sql.y:3630
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5213,9 +5421,9 @@ yydefault:
 			stmt.Value = yyDollar[6].float64
 			yyVAL.stmt = stmt
 		}
-	case 427:
+	case 448:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3494
+//line sql.y:3638
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5223,9 +5431,9 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 428:
+	case 449:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3502
+//line sql.y:3646
 		{
 			stmt := &SetConfigStatement{}
 			stmt.Component = yyDollar[3].str
@@ -5233,17 +5441,17 @@ yydefault:
 			stmt.Value = yyDollar[6].str
 			yyVAL.stmt = stmt
 		}
-	case 429:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3512
+//line sql.y:3656
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
 			yyVAL.stmt = stmt
 		}
-	case 430:
+	case 451:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3518
+//line sql.y:3662
 		{
 			stmt := &ShowClusterStatement{}
 			stmt.NodeID = 0
@@ -5254,9 +5462,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 431:
+	case 452:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3529
+//line sql.y:3673
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5266,9 +5474,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 432:
+	case 453:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3539
+//line sql.y:3683
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodeid" {
@@ -5283,9 +5491,9 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 433:
+	case 454:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:3554
+//line sql.y:3698
 		{
 			stmt := &ShowClusterStatement{}
 			if strings.ToLower(yyDollar[4].str) == "nodetype" {
@@ -5300,30 +5508,30 @@ yydefault:
 			}
 			yyVAL.stmt = stmt
 		}
-	case 434:
+	case 455:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3571
+//line sql.y:3715
 		{
 			yyVAL.stmt = &WithSelectStatement{
 				CTEs:  yyDollar[2].ctes,
 				Query: yyDollar[3].stmt.(*SelectStatement),
 			}
 		}
-	case 435:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3580
+//line sql.y:3724
 		{
 			yyVAL.ctes = []*CTE{yyDollar[1].cte}
 		}
-	case 436:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3584
+//line sql.y:3728
 		{
 			yyVAL.ctes = append([]*CTE{yyDollar[1].cte}, yyDollar[3].ctes...)
 		}
-	case 437:
+	case 458:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3590
+//line sql.y:3734
 		{
 			yyVAL.cte = &CTE{
 				Alias: yyDollar[1].str,
@@ -26,6 +26,8 @@ type YyParser struct {
 	Scanner *Scanner
 	error   YyParserError
 	Params  map[string]interface{}
+	prevTyp [2]Token
+	asof    bool
 }
 
 type YyParserError string
@@ -62,6 +64,8 @@ func (p *YyParser) Lex(lval *yySymType) int {
 		case ILLEGAL:
 			p.Error("unexpected " + string(val) + ", it's ILLEGAL")
 		case EOF:
+			p.prevTyp = [2]Token{}
+			p.asof = false
 			return 0
 		case MUL:
 			{
@@ -150,9 +154,31 @@ func (p *YyParser) Lex(lval *yySymType) int {
 			break
 		}
 	}
+	if typ == IDENT && p.afterSubQuery() {
+		if joinType, ok := joinTypes[strings.ToLower(val)]; ok {
+			typ = Token(joinType)
+		}
+	}
+	if typ == ASOF {
+		p.asof = true
+	} else if typ == IDENT && p.asof && strings.EqualFold(val, "tolerance") && (p.prevTyp[1] == RPAREN || p.prevTyp[1] == IDENT) {
+		// the tolerance of asof join follows the join condition
+		typ = TOLERANCE
+		p.asof = false
+	} else if typ == IDENT && p.prevTyp[1] == RPAREN && strings.EqualFold(val, "over") {
+		// the window clause of a function call, such as lag(value, 1) OVER (...)
+		typ = OVER
+	}
+	p.prevTyp[0], p.prevTyp[1] = p.prevTyp[1], typ
 	lval.str = val
 	return int(typ)
 }
+
+// afterSubQuery reports whether the previous tokens end a subquery, such as "(...)" or "(...) AS alias".
+func (p *YyParser) afterSubQuery() bool {
+	return p.prevTyp[1] == RPAREN || (p.prevTyp[1] == IDENT && p.prevTyp[0] == AS)
+}
+
 func (p *YyParser) Error(err string) {
 	p.error = YyParserError(err)
 }
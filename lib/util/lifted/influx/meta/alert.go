@@ -0,0 +1,760 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+)
+
+// The query languages of the alert rules.
+const (
+	AlertTypeInfluxQL = "influxql"
+	AlertTypePromQL   = "promql"
+	AlertTypeLogQL    = "logql"
+)
+
+// The states of an alert. An alert is pending until its condition holds for the `for` duration of the rule,
+// and it is resolved once the condition does not hold anymore.
+const (
+	AlertPending int32 = iota + 1
+	AlertFiring
+	AlertResolved
+)
+
+// AlertStateName returns the name of the alert state.
+func AlertStateName(state int32) string {
+	switch state {
+	case AlertPending:
+		return "pending"
+	case AlertFiring:
+		return "firing"
+	case AlertResolved:
+		return "resolved"
+	default:
+		return "inactive"
+	}
+}
+
+var alertConditionOps = []string{">=", "<=", "==", "!=", ">", "<"}
+
+// AlertCondition is the threshold which the value of a series is compared with, such as "> 90".
+type AlertCondition struct {
+	Op        string
+	Threshold float64
+}
+
+// ParseAlertCondition parses the condition of an alert rule, the empty condition returns nil.
+func ParseAlertCondition(s string) (*AlertCondition, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+	for _, op := range alertConditionOps {
+		if !strings.HasPrefix(s, op) {
+			continue
+		}
+		threshold, err := strconv.ParseFloat(strings.TrimSpace(s[len(op):]), 64)
+		if err != nil || math.IsNaN(threshold) {
+			return nil, fmt.Errorf("invalid alert condition %q: the threshold must be a number", s)
+		}
+		return &AlertCondition{Op: op, Threshold: threshold}, nil
+	}
+	return nil, fmt.Errorf("invalid alert condition %q: the operator must be one of %s", s, strings.Join(alertConditionOps, ", "))
+}
+
+// Match returns true if the value satisfies the condition, the nil condition matches every value except NaN.
+func (c *AlertCondition) Match(v float64) bool {
+	if math.IsNaN(v) {
+		return false
+	}
+	if c == nil {
+		return true
+	}
+	switch c.Op {
+	case ">":
+		return v > c.Threshold
+	case ">=":
+		return v >= c.Threshold
+	case "<":
+		return v < c.Threshold
+	case "<=":
+		return v <= c.Threshold
+	case "==":
+		return v == c.Threshold
+	case "!=":
+		return v != c.Threshold
+	}
+	return false
+}
+
+// AlertRuleInfo represents an alert rule and the state of its alerts.
+type AlertRuleInfo struct {
+	Name            string
+	Type            string // influxql, promql or logql
+	Database        string // the database, or the repository of the logql rule
+	RetentionPolicy string
+	LogStream       string
+	Query           string
+	Condition       string
+	Interval        time.Duration // 0 means the default evaluation interval of the alert service
+	For             time.Duration
+	Labels          map[string]string
+	Annotations     map[string]string
+	Receivers       []string
+
+	// The state below is reported by the sql node which evaluates the rule.
+	Owner        string
+	LeaseExpire  time.Time
+	LastEvalTime time.Time
+	LastError    string
+	Alerts       []*AlertInfo
+}
+
+// Validate returns an error if the definition of the rule is invalid.
+func (r *AlertRuleInfo) Validate() error {
+	if r.Name == "" {
+		return fmt.Errorf("alert rule name is required")
+	}
+	switch r.Type {
+	case AlertTypeInfluxQL, AlertTypePromQL:
+	case AlertTypeLogQL:
+		if r.LogStream == "" {
+			return fmt.Errorf("log stream is required by the %s alert rule", r.Type)
+		}
+	default:
+		return fmt.Errorf("invalid alert rule type %q: must be one of %s, %s, %s", r.Type, AlertTypeInfluxQL, AlertTypePromQL, AlertTypeLogQL)
+	}
+	if r.Database == "" {
+		return fmt.Errorf("database is required by the alert rule")
+	}
+	if r.Query == "" {
+		return fmt.Errorf("query is required by the alert rule")
+	}
+	if r.Interval < 0 || r.For < 0 {
+		return fmt.Errorf("interval and for of the alert rule must not be negative")
+	}
+	_, err := ParseAlertCondition(r.Condition)
+	return err
+}
+
+// Marshal serializes the rule into a protobuf message.
+func (r *AlertRuleInfo) Marshal() *proto2.AlertRuleInfo {
+	pb := &proto2.AlertRuleInfo{
+		Name:            proto.String(r.Name),
+		Type:            proto.String(r.Type),
+		Database:        proto.String(r.Database),
+		RetentionPolicy: proto.String(r.RetentionPolicy),
+		LogStream:       proto.String(r.LogStream),
+		Query:           proto.String(r.Query),
+		Condition:       proto.String(r.Condition),
+		Interval:        proto.Int64(int64(r.Interval)),
+		For:             proto.Int64(int64(r.For)),
+		Labels:          r.Labels,
+		Annotations:     r.Annotations,
+		Receivers:       r.Receivers,
+		Owner:           proto.String(r.Owner),
+		LeaseExpire:     proto.Int64(alertNano(r.LeaseExpire)),
+		LastEvalTime:    proto.Int64(alertNano(r.LastEvalTime)),
+		LastError:       proto.String(r.LastError),
+	}
+	pb.Alerts = marshalAlerts(r.Alerts)
+	return pb
+}
+
+// Unmarshal deserializes the rule from a protobuf message.
+func (r *AlertRuleInfo) Unmarshal(pb *proto2.AlertRuleInfo) {
+	r.Name = pb.GetName()
+	r.Type = pb.GetType()
+	r.Database = pb.GetDatabase()
+	r.RetentionPolicy = pb.GetRetentionPolicy()
+	r.LogStream = pb.GetLogStream()
+	r.Query = pb.GetQuery()
+	r.Condition = pb.GetCondition()
+	r.Interval = time.Duration(pb.GetInterval())
+	r.For = time.Duration(pb.GetFor())
+	r.Labels = pb.GetLabels()
+	r.Annotations = pb.GetAnnotations()
+	r.Receivers = pb.GetReceivers()
+	r.Owner = pb.GetOwner()
+	r.LeaseExpire = alertTime(pb.GetLeaseExpire())
+	r.LastEvalTime = alertTime(pb.GetLastEvalTime())
+	r.LastError = pb.GetLastError()
+	r.Alerts = unmarshalAlerts(pb.GetAlerts())
+}
+
+// Clone returns a deep copy of the rule.
+func (r *AlertRuleInfo) Clone() *AlertRuleInfo {
+	other := *r
+	other.Labels = cloneLabels(r.Labels)
+	other.Annotations = cloneLabels(r.Annotations)
+	other.Receivers = append([]string(nil), r.Receivers...)
+	other.Alerts = make([]*AlertInfo, len(r.Alerts))
+	for i := range r.Alerts {
+		other.Alerts[i] = r.Alerts[i].Clone()
+	}
+	return &other
+}
+
+// ExpandAnnotations expands the annotations of the rule for the alert, the annotations are text/template
+// templates which refer to the labels and the value of the alert as $labels and $value, such as
+// "{{ $labels.host }} is down". The annotation which fails to expand is returned as it is.
+func (r *AlertRuleInfo) ExpandAnnotations(alert *AlertInfo) map[string]string {
+	if len(r.Annotations) == 0 {
+		return nil
+	}
+	data := struct {
+		Labels map[string]string
+		Value  float64
+	}{Labels: alert.Labels, Value: alert.Value}
+	annotations := make(map[string]string, len(r.Annotations))
+	for name, text := range r.Annotations {
+		annotations[name] = text
+		if !strings.Contains(text, "{{") {
+			continue
+		}
+		tmpl, err := template.New(name).Option("missingkey=zero").Parse("{{$labels := .Labels}}{{$value := .Value}}" + text)
+		if err != nil {
+			continue
+		}
+		var b strings.Builder
+		if tmpl.Execute(&b, data) == nil {
+			annotations[name] = b.String()
+		}
+	}
+	return annotations
+}
+
+// AlertInfo represents one alert of a rule, which is identified by its labels.
+type AlertInfo struct {
+	Labels     map[string]string
+	State      int32
+	ActiveAt   time.Time
+	FiredAt    time.Time
+	ResolvedAt time.Time
+	Value      float64
+
+	// Notifications are the last notifications of the alert sent to each receiver, so that the sql node
+	// which takes over the rule does not notify the alert again.
+	Notifications []AlertNotification
+}
+
+// AlertNotification is the state of an alert in the last notification sent to a receiver.
+type AlertNotification struct {
+	Receiver string
+	State    int32
+	SentAt   time.Time
+}
+
+// Notification returns the last notification of the alert sent to the receiver.
+func (a *AlertInfo) Notification(receiver string) (AlertNotification, bool) {
+	for _, n := range a.Notifications {
+		if n.Receiver == receiver {
+			return n, true
+		}
+	}
+	return AlertNotification{}, false
+}
+
+// Fingerprint returns the identity of the alert, which is the sorted labels.
+func (a *AlertInfo) Fingerprint() string {
+	return AlertLabelsString(a.Labels)
+}
+
+func (a *AlertInfo) marshal() *proto2.AlertInfo {
+	return &proto2.AlertInfo{
+		Labels:     a.Labels,
+		State:      proto.Int32(a.State),
+		ActiveAt:   proto.Int64(alertNano(a.ActiveAt)),
+		FiredAt:    proto.Int64(alertNano(a.FiredAt)),
+		ResolvedAt: proto.Int64(alertNano(a.ResolvedAt)),
+		Value:      proto.Float64(a.Value),
+
+		Notifications: marshalAlertNotifications(a.Notifications),
+	}
+}
+
+func (a *AlertInfo) unmarshal(pb *proto2.AlertInfo) {
+	a.Labels = pb.GetLabels()
+	a.State = pb.GetState()
+	a.ActiveAt = alertTime(pb.GetActiveAt())
+	a.FiredAt = alertTime(pb.GetFiredAt())
+	a.ResolvedAt = alertTime(pb.GetResolvedAt())
+	a.Value = pb.GetValue()
+	a.Notifications = unmarshalAlertNotifications(pb.GetNotifications())
+}
+
+// Clone returns a deep copy of the alert.
+func (a *AlertInfo) Clone() *AlertInfo {
+	other := *a
+	other.Labels = cloneLabels(a.Labels)
+	if a.Notifications != nil {
+		other.Notifications = append([]AlertNotification(nil), a.Notifications...)
+	}
+	return &other
+}
+
+func marshalAlertNotifications(notifications []AlertNotification) []*proto2.AlertNotification {
+	if len(notifications) == 0 {
+		return nil
+	}
+	pb := make([]*proto2.AlertNotification, len(notifications))
+	for i, n := range notifications {
+		pb[i] = &proto2.AlertNotification{
+			Receiver: proto.String(n.Receiver),
+			State:    proto.Int32(n.State),
+			SentAt:   proto.Int64(alertNano(n.SentAt)),
+		}
+	}
+	return pb
+}
+
+func unmarshalAlertNotifications(pb []*proto2.AlertNotification) []AlertNotification {
+	if len(pb) == 0 {
+		return nil
+	}
+	notifications := make([]AlertNotification, len(pb))
+	for i := range pb {
+		notifications[i] = AlertNotification{
+			Receiver: pb[i].GetReceiver(),
+			State:    pb[i].GetState(),
+			SentAt:   alertTime(pb[i].GetSentAt()),
+		}
+	}
+	return notifications
+}
+
+func marshalAlerts(alerts []*AlertInfo) []*proto2.AlertInfo {
+	if len(alerts) == 0 {
+		return nil
+	}
+	pb := make([]*proto2.AlertInfo, len(alerts))
+	for i := range alerts {
+		pb[i] = alerts[i].marshal()
+	}
+	return pb
+}
+
+func unmarshalAlerts(pb []*proto2.AlertInfo) []*AlertInfo {
+	if len(pb) == 0 {
+		return nil
+	}
+	alerts := make([]*AlertInfo, len(pb))
+	for i := range pb {
+		alerts[i] = &AlertInfo{}
+		alerts[i].unmarshal(pb[i])
+	}
+	return alerts
+}
+
+// AlertReceiverInfo represents a webhook which the notifications of alerts are posted to.
+// The alerts with the same values of the GroupBy labels are sent in one notification.
+type AlertReceiverInfo struct {
+	Name           string
+	URL            string
+	GroupBy        []string
+	GroupWait      time.Duration // how long to wait before sending the first notification of a new group
+	GroupInterval  time.Duration // how long to wait before sending the changes of a group
+	RepeatInterval time.Duration // how long to wait before sending a group again if nothing changed
+}
+
+// Marshal serializes the receiver into a protobuf message.
+func (r *AlertReceiverInfo) Marshal() *proto2.AlertReceiverInfo {
+	return &proto2.AlertReceiverInfo{
+		Name:           proto.String(r.Name),
+		URL:            proto.String(r.URL),
+		GroupBy:        r.GroupBy,
+		GroupWait:      proto.Int64(int64(r.GroupWait)),
+		GroupInterval:  proto.Int64(int64(r.GroupInterval)),
+		RepeatInterval: proto.Int64(int64(r.RepeatInterval)),
+	}
+}
+
+// Unmarshal deserializes the receiver from a protobuf message.
+func (r *AlertReceiverInfo) Unmarshal(pb *proto2.AlertReceiverInfo) {
+	r.Name = pb.GetName()
+	r.URL = pb.GetURL()
+	r.GroupBy = pb.GetGroupBy()
+	r.GroupWait = time.Duration(pb.GetGroupWait())
+	r.GroupInterval = time.Duration(pb.GetGroupInterval())
+	r.RepeatInterval = time.Duration(pb.GetRepeatInterval())
+}
+
+// Clone returns a deep copy of the receiver.
+func (r *AlertReceiverInfo) Clone() *AlertReceiverInfo {
+	other := *r
+	other.GroupBy = append([]string(nil), r.GroupBy...)
+	return &other
+}
+
+// AlertMatcher matches the value of a label, either equally or by an anchored regular expression.
+type AlertMatcher struct {
+	Name    string
+	Value   string
+	IsRegex bool
+}
+
+// Matches returns true if the labels satisfy the matcher, the missing label is treated as empty.
+func (m *AlertMatcher) Matches(labels map[string]string) bool {
+	v := labels[m.Name]
+	if !m.IsRegex {
+		return v == m.Value
+	}
+	re, err := regexp.Compile("^(?:" + m.Value + ")$")
+	return err == nil && re.MatchString(v)
+}
+
+// AlertSilenceInfo mutes the notifications of the alerts which satisfy all the matchers between StartsAt and EndsAt.
+type AlertSilenceInfo struct {
+	ID        string
+	Matchers  []AlertMatcher
+	StartsAt  time.Time
+	EndsAt    time.Time
+	CreatedBy string
+	Comment   string
+}
+
+// Validate returns an error if the silence is invalid.
+func (s *AlertSilenceInfo) Validate() error {
+	if s.ID == "" {
+		return fmt.Errorf("alert silence id is required")
+	}
+	if len(s.Matchers) == 0 {
+		return fmt.Errorf("at least one matcher is required by the alert silence")
+	}
+	for _, m := range s.Matchers {
+		if m.Name == "" {
+			return fmt.Errorf("matcher name of the alert silence is required")
+		}
+		if m.IsRegex {
+			if _, err := regexp.Compile("^(?:" + m.Value + ")$"); err != nil {
+				return fmt.Errorf("invalid matcher %s of the alert silence: %v", m.Name, err)
+			}
+		}
+	}
+	if !s.EndsAt.After(s.StartsAt) {
+		return fmt.Errorf("the end of the alert silence must be after its start")
+	}
+	return nil
+}
+
+// Active returns true if the silence takes effect at the time.
+func (s *AlertSilenceInfo) Active(t time.Time) bool {
+	return !t.Before(s.StartsAt) && t.Before(s.EndsAt)
+}
+
+// Matches returns true if the labels satisfy all the matchers.
+func (s *AlertSilenceInfo) Matches(labels map[string]string) bool {
+	for i := range s.Matchers {
+		if !s.Matchers[i].Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+// Marshal serializes the silence into a protobuf message.
+func (s *AlertSilenceInfo) Marshal() *proto2.AlertSilenceInfo {
+	pb := &proto2.AlertSilenceInfo{
+		ID:        proto.String(s.ID),
+		StartsAt:  proto.Int64(alertNano(s.StartsAt)),
+		EndsAt:    proto.Int64(alertNano(s.EndsAt)),
+		CreatedBy: proto.String(s.CreatedBy),
+		Comment:   proto.String(s.Comment),
+	}
+	pb.Matchers = make([]*proto2.AlertMatcher, len(s.Matchers))
+	for i, m := range s.Matchers {
+		pb.Matchers[i] = &proto2.AlertMatcher{
+			Name:    proto.String(m.Name),
+			Value:   proto.String(m.Value),
+			IsRegex: proto.Bool(m.IsRegex),
+		}
+	}
+	return pb
+}
+
+// Unmarshal deserializes the silence from a protobuf message.
+func (s *AlertSilenceInfo) Unmarshal(pb *proto2.AlertSilenceInfo) {
+	s.ID = pb.GetID()
+	s.StartsAt = alertTime(pb.GetStartsAt())
+	s.EndsAt = alertTime(pb.GetEndsAt())
+	s.CreatedBy = pb.GetCreatedBy()
+	s.Comment = pb.GetComment()
+	s.Matchers = make([]AlertMatcher, len(pb.GetMatchers()))
+	for i, m := range pb.GetMatchers() {
+		s.Matchers[i] = AlertMatcher{Name: m.GetName(), Value: m.GetValue(), IsRegex: m.GetIsRegex()}
+	}
+}
+
+// Clone returns a deep copy of the silence.
+func (s *AlertSilenceInfo) Clone() *AlertSilenceInfo {
+	other := *s
+	other.Matchers = append([]AlertMatcher(nil), s.Matchers...)
+	return &other
+}
+
+// AlertRuleState is the result of one evaluation of a rule reported by the sql node.
+type AlertRuleState struct {
+	Name         string
+	LeaseExpire  time.Time // the other sql nodes do not evaluate the rule until the lease expires
+	LastEvalTime time.Time
+	LastError    string
+	Alerts       []*AlertInfo
+}
+
+// Marshal serializes the state into a protobuf message.
+func (s *AlertRuleState) Marshal() *proto2.AlertRuleState {
+	return &proto2.AlertRuleState{
+		Name:         proto.String(s.Name),
+		LeaseExpire:  proto.Int64(alertNano(s.LeaseExpire)),
+		LastEvalTime: proto.Int64(alertNano(s.LastEvalTime)),
+		LastError:    proto.String(s.LastError),
+		Alerts:       marshalAlerts(s.Alerts),
+	}
+}
+
+func (s *AlertRuleState) unmarshal(pb *proto2.AlertRuleState) {
+	s.Name = pb.GetName()
+	s.LeaseExpire = alertTime(pb.GetLeaseExpire())
+	s.LastEvalTime = alertTime(pb.GetLastEvalTime())
+	s.LastError = pb.GetLastError()
+	s.Alerts = unmarshalAlerts(pb.GetAlerts())
+}
+
+// AlertLabelsString formats the labels sorted by name, such as {alertname="cpu", host="a"}.
+func AlertLabelsString(labels map[string]string) string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(name)
+		b.WriteByte('=')
+		b.WriteString(strconv.Quote(labels[name]))
+	}
+	b.WriteByte('}')
+	return b.String()
+}
+
+func cloneLabels(labels map[string]string) map[string]string {
+	if labels == nil {
+		return nil
+	}
+	other := make(map[string]string, len(labels))
+	for k, v := range labels {
+		other[k] = v
+	}
+	return other
+}
+
+func alertNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+func alertTime(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
+// CreateAlertRule creates the rule or replaces the definition of the existing one, and the state of the existing rule is kept.
+func (data *Data) CreateAlertRule(rule *AlertRuleInfo) error {
+	if rule == nil {
+		return nil
+	}
+	for _, name := range rule.Receivers {
+		if _, ok := data.AlertReceivers[name]; !ok {
+			return ErrAlertReceiverNotFound
+		}
+	}
+	if data.AlertRules == nil {
+		data.AlertRules = make(map[string]*AlertRuleInfo)
+	}
+	if old, ok := data.AlertRules[rule.Name]; ok {
+		rule.Owner = old.Owner
+		rule.LeaseExpire = old.LeaseExpire
+		rule.LastEvalTime = old.LastEvalTime
+		rule.LastError = old.LastError
+		rule.Alerts = old.Alerts
+	}
+	data.AlertRules[rule.Name] = rule
+	return nil
+}
+
+// DropAlertRule removes the rule and its alerts.
+func (data *Data) DropAlertRule(name string) error {
+	if _, ok := data.AlertRules[name]; !ok {
+		return ErrAlertRuleNotFound
+	}
+	delete(data.AlertRules, name)
+	return nil
+}
+
+// CreateAlertReceiver creates the receiver or replaces the existing one.
+func (data *Data) CreateAlertReceiver(receiver *AlertReceiverInfo) error {
+	if receiver == nil {
+		return nil
+	}
+	if data.AlertReceivers == nil {
+		data.AlertReceivers = make(map[string]*AlertReceiverInfo)
+	}
+	data.AlertReceivers[receiver.Name] = receiver
+	return nil
+}
+
+// DropAlertReceiver removes the receiver which is not used by any rule.
+func (data *Data) DropAlertReceiver(name string) error {
+	if _, ok := data.AlertReceivers[name]; !ok {
+		return ErrAlertReceiverNotFound
+	}
+	for _, rule := range data.AlertRules {
+		for _, receiver := range rule.Receivers {
+			if receiver == name {
+				return ErrAlertReceiverInUse
+			}
+		}
+	}
+	delete(data.AlertReceivers, name)
+	return nil
+}
+
+// CreateAlertSilence creates the silence or replaces the existing one with the same id.
+func (data *Data) CreateAlertSilence(silence *AlertSilenceInfo) error {
+	if silence == nil {
+		return nil
+	}
+	if data.AlertSilences == nil {
+		data.AlertSilences = make(map[string]*AlertSilenceInfo)
+	}
+	data.AlertSilences[silence.ID] = silence
+	return nil
+}
+
+// DropAlertSilence removes the silence.
+func (data *Data) DropAlertSilence(id string) error {
+	if _, ok := data.AlertSilences[id]; !ok {
+		return ErrAlertSilenceNotFound
+	}
+	delete(data.AlertSilences, id)
+	return nil
+}
+
+// ReportAlertStates updates the state of the rules evaluated by the sql node.
+// The state is ignored if the rule is leased to another sql node whose lease has not expired at the time of the report,
+// so that only one sql node evaluates a rule and sends its notifications.
+func (data *Data) ReportAlertStates(host string, t int64, states []*AlertRuleState) {
+	for _, state := range states {
+		rule, ok := data.AlertRules[state.Name]
+		if !ok {
+			continue
+		}
+		if rule.Owner != "" && rule.Owner != host && t < alertNano(rule.LeaseExpire) {
+			continue
+		}
+		rule.Owner = host
+		rule.LeaseExpire = state.LeaseExpire
+		rule.LastEvalTime = state.LastEvalTime
+		rule.LastError = state.LastError
+		rule.Alerts = state.Alerts
+	}
+}
+
+// CloneAlertRules returns a copy of the alert rules.
+func (data *Data) CloneAlertRules() map[string]*AlertRuleInfo {
+	if data.AlertRules == nil {
+		return nil
+	}
+	rules := make(map[string]*AlertRuleInfo, len(data.AlertRules))
+	for name, rule := range data.AlertRules {
+		rules[name] = rule.Clone()
+	}
+	return rules
+}
+
+// CloneAlertReceivers returns a copy of the alert receivers.
+func (data *Data) CloneAlertReceivers() map[string]*AlertReceiverInfo {
+	if data.AlertReceivers == nil {
+		return nil
+	}
+	receivers := make(map[string]*AlertReceiverInfo, len(data.AlertReceivers))
+	for name, receiver := range data.AlertReceivers {
+		receivers[name] = receiver.Clone()
+	}
+	return receivers
+}
+
+// CloneAlertSilences returns a copy of the alert silences.
+func (data *Data) CloneAlertSilences() map[string]*AlertSilenceInfo {
+	if data.AlertSilences == nil {
+		return nil
+	}
+	silences := make(map[string]*AlertSilenceInfo, len(data.AlertSilences))
+	for id, silence := range data.AlertSilences {
+		silences[id] = silence.Clone()
+	}
+	return silences
+}
+
+func (data *Data) marshalAlerting(pb *proto2.Data) {
+	for _, rule := range data.AlertRules {
+		pb.AlertRules = append(pb.AlertRules, rule.Marshal())
+	}
+	for _, receiver := range data.AlertReceivers {
+		pb.AlertReceivers = append(pb.AlertReceivers, receiver.Marshal())
+	}
+	for _, silence := range data.AlertSilences {
+		pb.AlertSilences = append(pb.AlertSilences, silence.Marshal())
+	}
+}
+
+func (data *Data) unmarshalAlerting(pb *proto2.Data) {
+	data.AlertRules = make(map[string]*AlertRuleInfo, len(pb.GetAlertRules()))
+	for _, x := range pb.GetAlertRules() {
+		rule := &AlertRuleInfo{}
+		rule.Unmarshal(x)
+		data.AlertRules[rule.Name] = rule
+	}
+	data.AlertReceivers = make(map[string]*AlertReceiverInfo, len(pb.GetAlertReceivers()))
+	for _, x := range pb.GetAlertReceivers() {
+		receiver := &AlertReceiverInfo{}
+		receiver.Unmarshal(x)
+		data.AlertReceivers[receiver.Name] = receiver
+	}
+	data.AlertSilences = make(map[string]*AlertSilenceInfo, len(pb.GetAlertSilences()))
+	for _, x := range pb.GetAlertSilences() {
+		silence := &AlertSilenceInfo{}
+		silence.Unmarshal(x)
+		data.AlertSilences[silence.ID] = silence
+	}
+}
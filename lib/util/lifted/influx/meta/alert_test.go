@@ -0,0 +1,226 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meta
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
+	"github.com/openGemini/openGemini/lib/util/lifted/protobuf/proto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAlertCondition(t *testing.T) {
+	cond, err := ParseAlertCondition("")
+	require.NoError(t, err)
+	require.Nil(t, cond)
+	require.True(t, cond.Match(-1))
+	require.False(t, cond.Match(math.NaN()))
+
+	for s, expect := range map[string]AlertCondition{
+		"> 90":   {Op: ">", Threshold: 90},
+		">=90.5": {Op: ">=", Threshold: 90.5},
+		" < -1 ": {Op: "<", Threshold: -1},
+		"<= 0":   {Op: "<=", Threshold: 0},
+		"== 1":   {Op: "==", Threshold: 1},
+		"!= 1":   {Op: "!=", Threshold: 1},
+	} {
+		cond, err = ParseAlertCondition(s)
+		require.NoError(t, err, s)
+		require.Equal(t, expect, *cond, s)
+	}
+
+	cond, _ = ParseAlertCondition(">= 90")
+	require.True(t, cond.Match(90))
+	require.False(t, cond.Match(89.9))
+	require.False(t, cond.Match(math.NaN()))
+
+	for _, s := range []string{"90", "> high", "=> 90", "> NaN"} {
+		_, err = ParseAlertCondition(s)
+		require.Error(t, err, s)
+	}
+}
+
+func TestAlertRuleInfo_Validate(t *testing.T) {
+	rule := &AlertRuleInfo{Name: "cpu", Type: AlertTypeInfluxQL, Database: "db0", Query: "SELECT last(usage) FROM cpu", Condition: "> 90"}
+	require.NoError(t, rule.Validate())
+
+	for _, modify := range []func(r *AlertRuleInfo){
+		func(r *AlertRuleInfo) { r.Name = "" },
+		func(r *AlertRuleInfo) { r.Type = "sql" },
+		func(r *AlertRuleInfo) { r.Type = AlertTypeLogQL },
+		func(r *AlertRuleInfo) { r.Database = "" },
+		func(r *AlertRuleInfo) { r.Query = "" },
+		func(r *AlertRuleInfo) { r.For = -time.Second },
+		func(r *AlertRuleInfo) { r.Condition = "~ 1" },
+	} {
+		other := rule.Clone()
+		modify(other)
+		require.Error(t, other.Validate())
+	}
+}
+
+func TestAlertRuleInfo_ExpandAnnotations(t *testing.T) {
+	rule := &AlertRuleInfo{Annotations: map[string]string{
+		"summary":     "{{ $labels.host }} cpu usage is {{ $value }}",
+		"description": "high cpu usage",
+		"invalid":     "{{ $labels.host ",
+	}}
+	annotations := rule.ExpandAnnotations(&AlertInfo{Labels: map[string]string{"host": "server01"}, Value: 95.5})
+	require.Equal(t, map[string]string{
+		"summary":     "server01 cpu usage is 95.5",
+		"description": "high cpu usage",
+		"invalid":     "{{ $labels.host ",
+	}, annotations)
+	require.Nil(t, (&AlertRuleInfo{}).ExpandAnnotations(&AlertInfo{}))
+}
+
+func TestAlertSilenceInfo(t *testing.T) {
+	now := time.Now()
+	silence := &AlertSilenceInfo{
+		ID:       "s1",
+		Matchers: []AlertMatcher{{Name: "alertname", Value: "cpu"}, {Name: "host", Value: "server0[12]", IsRegex: true}},
+		StartsAt: now,
+		EndsAt:   now.Add(time.Hour),
+	}
+	require.NoError(t, silence.Validate())
+	require.True(t, silence.Active(now))
+	require.False(t, silence.Active(now.Add(time.Hour)))
+	require.True(t, silence.Matches(map[string]string{"alertname": "cpu", "host": "server02"}))
+	require.False(t, silence.Matches(map[string]string{"alertname": "cpu", "host": "server020"}))
+	require.False(t, silence.Matches(map[string]string{"alertname": "mem", "host": "server01"}))
+
+	for _, modify := range []func(s *AlertSilenceInfo){
+		func(s *AlertSilenceInfo) { s.ID = "" },
+		func(s *AlertSilenceInfo) { s.Matchers = nil },
+		func(s *AlertSilenceInfo) { s.Matchers = []AlertMatcher{{Value: "cpu"}} },
+		func(s *AlertSilenceInfo) { s.Matchers = []AlertMatcher{{Name: "host", Value: "(", IsRegex: true}} },
+		func(s *AlertSilenceInfo) { s.EndsAt = s.StartsAt },
+	} {
+		other := silence.Clone()
+		modify(other)
+		require.Error(t, other.Validate())
+	}
+}
+
+func TestData_AlertRules(t *testing.T) {
+	data := &Data{}
+	rule := &AlertRuleInfo{Name: "cpu", Type: AlertTypePromQL, Database: "prom", Query: "up == 0", Receivers: []string{"ops"}}
+	require.EqualError(t, data.CreateAlertRule(rule), ErrAlertReceiverNotFound.Error())
+
+	require.NoError(t, data.CreateAlertReceiver(&AlertReceiverInfo{Name: "ops", URL: "http://127.0.0.1:9093/hook"}))
+	require.NoError(t, data.CreateAlertRule(rule))
+	require.EqualError(t, data.DropAlertReceiver("ops"), ErrAlertReceiverInUse.Error())
+	require.EqualError(t, data.DropAlertReceiver("dev"), ErrAlertReceiverNotFound.Error())
+
+	now := time.Unix(1719862212, 0)
+	alerts := []*AlertInfo{{Labels: map[string]string{"alertname": "cpu", "job": "node"}, State: AlertFiring, ActiveAt: now, FiredAt: now, Value: 0}}
+	data.ReportAlertStates("sql1", now.UnixNano(), []*AlertRuleState{
+		{Name: "cpu", LeaseExpire: now.Add(time.Minute), LastEvalTime: now, Alerts: alerts},
+		{Name: "dropped", LastEvalTime: now},
+	})
+	require.Equal(t, "sql1", data.AlertRules["cpu"].Owner)
+	require.Len(t, data.AlertRules, 1)
+
+	// the state reported by another node is ignored until the lease expires
+	data.ReportAlertStates("sql2", now.Add(time.Second).UnixNano(), []*AlertRuleState{{Name: "cpu", LastEvalTime: now.Add(time.Second)}})
+	require.Equal(t, "sql1", data.AlertRules["cpu"].Owner)
+	require.Equal(t, alerts, data.AlertRules["cpu"].Alerts)
+	data.ReportAlertStates("sql2", now.Add(time.Minute).UnixNano(), []*AlertRuleState{{Name: "cpu", LastEvalTime: now.Add(time.Minute), LastError: "timeout", Alerts: alerts}})
+	require.Equal(t, "sql2", data.AlertRules["cpu"].Owner)
+	require.Equal(t, "timeout", data.AlertRules["cpu"].LastError)
+
+	// replacing the definition keeps the state of the rule
+	require.NoError(t, data.CreateAlertRule(&AlertRuleInfo{Name: "cpu", Type: AlertTypePromQL, Database: "prom", Query: "up < 1"}))
+	require.Equal(t, "up < 1", data.AlertRules["cpu"].Query)
+	require.Equal(t, "sql2", data.AlertRules["cpu"].Owner)
+	require.Equal(t, alerts, data.AlertRules["cpu"].Alerts)
+
+	require.NoError(t, data.DropAlertReceiver("ops"))
+	require.NoError(t, data.DropAlertRule("cpu"))
+	require.EqualError(t, data.DropAlertRule("cpu"), ErrAlertRuleNotFound.Error())
+}
+
+func TestData_AlertingMarshal(t *testing.T) {
+	now := time.Unix(1719862212, 5)
+	data := &Data{}
+	require.NoError(t, data.CreateAlertReceiver(&AlertReceiverInfo{Name: "ops", URL: "http://127.0.0.1:9093/hook",
+		GroupBy: []string{"alertname"}, GroupWait: time.Second, GroupInterval: time.Minute, RepeatInterval: time.Hour}))
+	require.NoError(t, data.CreateAlertRule(&AlertRuleInfo{Name: "errors", Type: AlertTypeLogQL, Database: "repo", LogStream: "app",
+		Query: `sum(count_over_time({app="web"} |= "error" [5m]))`, Condition: "> 10", Interval: time.Minute, For: 5 * time.Minute,
+		Labels: map[string]string{"severity": "critical"}, Annotations: map[string]string{"summary": "too many errors"},
+		Receivers: []string{"ops"}}))
+	data.ReportAlertStates("sql1", now.UnixNano(), []*AlertRuleState{{Name: "errors", LeaseExpire: now.Add(time.Minute), LastEvalTime: now,
+		Alerts: []*AlertInfo{{Labels: map[string]string{"alertname": "errors"}, State: AlertFiring, ActiveAt: now, Value: 12,
+			Notifications: []AlertNotification{{Receiver: "ops", State: AlertFiring, SentAt: now}}}}}})
+	require.NoError(t, data.CreateAlertSilence(&AlertSilenceInfo{ID: "s1", Matchers: []AlertMatcher{{Name: "alertname", Value: "err.*", IsRegex: true}},
+		StartsAt: now, EndsAt: now.Add(time.Hour), CreatedBy: "admin", Comment: "maintenance"}))
+
+	other := &Data{}
+	other.Unmarshal(data.Marshal())
+	require.Equal(t, data.AlertRules, other.AlertRules)
+	require.Equal(t, data.AlertReceivers, other.AlertReceivers)
+	require.Equal(t, data.AlertSilences, other.AlertSilences)
+
+	clone := data.Clone()
+	require.Equal(t, data.AlertRules, clone.AlertRules)
+	clone.AlertRules["errors"].Alerts[0].Labels["alertname"] = "changed"
+	require.Equal(t, "errors", data.AlertRules["errors"].Alerts[0].Labels["alertname"])
+	clone.AlertRules["errors"].Alerts[0].Notifications[0].State = AlertResolved
+	require.Equal(t, AlertFiring, data.AlertRules["errors"].Alerts[0].Notifications[0].State)
+
+	require.NoError(t, other.DropAlertSilence("s1"))
+	require.EqualError(t, other.DropAlertSilence("s1"), ErrAlertSilenceNotFound.Error())
+}
+
+func TestApplyAlertCommands(t *testing.T) {
+	data := &Data{}
+	apply := func(typ proto2.Command_Type, desc *proto.ExtensionDesc, value interface{}, fn func(*Data, *proto2.Command) error) error {
+		cmd := &proto2.Command{Type: &typ}
+		require.NoError(t, proto.SetExtension(cmd, desc, value))
+		return fn(data, cmd)
+	}
+
+	receiver := &AlertReceiverInfo{Name: "ops", URL: "http://127.0.0.1:9093/hook"}
+	require.NoError(t, apply(proto2.Command_CreateAlertReceiverCommand, proto2.E_CreateAlertReceiverCommand_Command,
+		&proto2.CreateAlertReceiverCommand{Receiver: receiver.Marshal()}, ApplyCreateAlertReceiver))
+	rule := &AlertRuleInfo{Name: "up", Type: AlertTypePromQL, Database: "prom", Query: "up == 0", Receivers: []string{"ops"}}
+	require.NoError(t, apply(proto2.Command_CreateAlertRuleCommand, proto2.E_CreateAlertRuleCommand_Command,
+		&proto2.CreateAlertRuleCommand{Rule: rule.Marshal()}, ApplyCreateAlertRule))
+	silence := &AlertSilenceInfo{ID: "s1", Matchers: []AlertMatcher{{Name: "job", Value: "node"}}, StartsAt: time.Unix(1, 0), EndsAt: time.Unix(2, 0)}
+	require.NoError(t, apply(proto2.Command_CreateAlertSilenceCommand, proto2.E_CreateAlertSilenceCommand_Command,
+		&proto2.CreateAlertSilenceCommand{Silence: silence.Marshal()}, ApplyCreateAlertSilence))
+
+	state := &AlertRuleState{Name: "up", LeaseExpire: time.Unix(100, 0), LastEvalTime: time.Unix(40, 0)}
+	require.NoError(t, apply(proto2.Command_AlertStateReportCommand, proto2.E_AlertStateReportCommand_Command,
+		&proto2.AlertStateReportCommand{Host: proto.String("sql1"), Time: proto.Int64(int64(40 * time.Second)),
+			Rules: []*proto2.AlertRuleState{state.Marshal()}}, ApplyAlertStateReport))
+	require.Equal(t, "sql1", data.AlertRules["up"].Owner)
+	require.Equal(t, time.Unix(100, 0), data.AlertRules["up"].LeaseExpire)
+
+	require.Error(t, apply(proto2.Command_DropAlertReceiverCommand, proto2.E_DropAlertReceiverCommand_Command,
+		&proto2.DropAlertReceiverCommand{Name: proto.String("ops")}, ApplyDropAlertReceiver))
+	require.NoError(t, apply(proto2.Command_DropAlertRuleCommand, proto2.E_DropAlertRuleCommand_Command,
+		&proto2.DropAlertRuleCommand{Name: proto.String("up")}, ApplyDropAlertRule))
+	require.NoError(t, apply(proto2.Command_DropAlertReceiverCommand, proto2.E_DropAlertReceiverCommand_Command,
+		&proto2.DropAlertReceiverCommand{Name: proto.String("ops")}, ApplyDropAlertReceiver))
+	require.NoError(t, apply(proto2.Command_DropAlertSilenceCommand, proto2.E_DropAlertSilenceCommand_Command,
+		&proto2.DropAlertSilenceCommand{ID: proto.String("s1")}, ApplyDropAlertSilence))
+	require.Empty(t, data.AlertRules)
+	require.Empty(t, data.AlertReceivers)
+	require.Empty(t, data.AlertSilences)
+}
@@ -273,8 +273,13 @@ func ApplyCreateMeasurement(data *Data, cmd *proto2.Command) error {
 	if !ok {
 		panic(fmt.Errorf("%s is not a CreateMeasurementCommand", ext))
 	}
-	return data.CreateMeasurement(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetSki(), v.GetInitNumOfShards(), v.GetIR(), config.EngineType(v.GetEngineType()),
+	err := data.CreateMeasurement(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetSki(), v.GetInitNumOfShards(), v.GetIR(), config.EngineType(v.GetEngineType()),
 		v.GetColStoreInfo(), v.GetSchemaInfo(), v.GetOptions())
+	if err != nil || v.GetTTL() <= 0 {
+		return err
+	}
+	// the ttl is applied by the same command, so the measurement is never visible without it
+	return data.AlterMeasurementTTL(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetTTL())
 }
 
 func ApplyReSharding(data *Data, cmd *proto2.Command) error {
@@ -312,6 +317,15 @@ func ApplyAlterShardKey(data *Data, cmd *proto2.Command) error {
 	return data.AlterShardKey(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetSki())
 }
 
+func ApplyAlterMeasurementTTL(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_AlterMeasurementTTLCmd_Command)
+	v, ok := ext.(*proto2.AlterMeasurementTTLCmd)
+	if !ok {
+		panic(fmt.Errorf("%s is not a AlterMeasurementTTLCmd", ext))
+	}
+	return data.AlterMeasurementTTL(v.GetDBName(), v.GetRpName(), v.GetName(), v.GetTTL())
+}
+
 func ApplyPruneGroups(data *Data, cmd *proto2.Command) error {
 	ext, _ := proto.GetExtension(cmd, proto2.E_PruneGroupsCommand_Command)
 	v, ok := ext.(*proto2.PruneGroupsCommand)
@@ -539,3 +553,87 @@ func ApplyUpdateMeasurement(data *Data, cmd *proto2.Command) error {
 	}
 	return data.UpdateMeasurement(v.GetDb(), v.GetRp(), v.GetMst(), v.GetOptions())
 }
+
+func ApplyCreateAlertRule(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateAlertRuleCommand_Command)
+	v, ok := ext.(*proto2.CreateAlertRuleCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a CreateAlertRuleCommand", ext))
+	}
+	if v.GetRule() == nil {
+		return nil
+	}
+	rule := &AlertRuleInfo{}
+	rule.Unmarshal(v.GetRule())
+	return data.CreateAlertRule(rule)
+}
+
+func ApplyDropAlertRule(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropAlertRuleCommand_Command)
+	v, ok := ext.(*proto2.DropAlertRuleCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a DropAlertRuleCommand", ext))
+	}
+	return data.DropAlertRule(v.GetName())
+}
+
+func ApplyCreateAlertReceiver(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateAlertReceiverCommand_Command)
+	v, ok := ext.(*proto2.CreateAlertReceiverCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a CreateAlertReceiverCommand", ext))
+	}
+	if v.GetReceiver() == nil {
+		return nil
+	}
+	receiver := &AlertReceiverInfo{}
+	receiver.Unmarshal(v.GetReceiver())
+	return data.CreateAlertReceiver(receiver)
+}
+
+func ApplyDropAlertReceiver(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropAlertReceiverCommand_Command)
+	v, ok := ext.(*proto2.DropAlertReceiverCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a DropAlertReceiverCommand", ext))
+	}
+	return data.DropAlertReceiver(v.GetName())
+}
+
+func ApplyCreateAlertSilence(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_CreateAlertSilenceCommand_Command)
+	v, ok := ext.(*proto2.CreateAlertSilenceCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a CreateAlertSilenceCommand", ext))
+	}
+	if v.GetSilence() == nil {
+		return nil
+	}
+	silence := &AlertSilenceInfo{}
+	silence.Unmarshal(v.GetSilence())
+	return data.CreateAlertSilence(silence)
+}
+
+func ApplyDropAlertSilence(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_DropAlertSilenceCommand_Command)
+	v, ok := ext.(*proto2.DropAlertSilenceCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a DropAlertSilenceCommand", ext))
+	}
+	return data.DropAlertSilence(v.GetID())
+}
+
+func ApplyAlertStateReport(data *Data, cmd *proto2.Command) error {
+	ext, _ := proto.GetExtension(cmd, proto2.E_AlertStateReportCommand_Command)
+	v, ok := ext.(*proto2.AlertStateReportCommand)
+	if !ok {
+		panic(fmt.Errorf("%s is not a AlertStateReportCommand", ext))
+	}
+	states := make([]*AlertRuleState, len(v.GetRules()))
+	for i, x := range v.GetRules() {
+		states[i] = &AlertRuleState{}
+		states[i].unmarshal(x)
+	}
+	data.ReportAlertStates(v.GetHost(), v.GetTime(), states)
+	return nil
+}
@@ -195,6 +195,10 @@ type Data struct {
 	Users         []UserInfo
 	MigrateEvents map[string]*MigrateEventInfo
 
+	AlertRules     map[string]*AlertRuleInfo
+	AlertReceivers map[string]*AlertReceiverInfo
+	AlertSilences  map[string]*AlertSilenceInfo
+
 	// Query ID range segment allocated by all sql nodes
 	QueryIDInit map[SQLHost]uint64 // {"127.0.0.1:8086": 0, "127.0.0.2:8086": 10w, "127.0.0.3:8086": 20w}, span is QueryIDSpan
 
@@ -363,6 +367,7 @@ func init() {
 		proto2.Command_ReShardingCommand:                {},
 		proto2.Command_UpdateSchemaCommand:              {},
 		proto2.Command_AlterShardKeyCmd:                 {},
+		proto2.Command_AlterMeasurementTTLCmd:           {},
 		proto2.Command_PruneGroupsCommand:               {},
 		proto2.Command_MarkMeasurementDeleteCommand:     {},
 		proto2.Command_DropMeasurementCommand:           {},
@@ -388,6 +393,13 @@ func init() {
 		proto2.Command_UpdateReplicationCommand:         {},
 		proto2.Command_UpdateMeasurementCommand:         {},
 		proto2.Command_UpdateMetaNodeStatusCommand:      {},
+		proto2.Command_CreateAlertRuleCommand:           {},
+		proto2.Command_DropAlertRuleCommand:             {},
+		proto2.Command_CreateAlertReceiverCommand:       {},
+		proto2.Command_DropAlertReceiverCommand:         {},
+		proto2.Command_CreateAlertSilenceCommand:        {},
+		proto2.Command_DropAlertSilenceCommand:          {},
+		proto2.Command_AlertStateReportCommand:          {},
 	}
 }
 
@@ -1010,6 +1022,24 @@ func (data *Data) AlterShardKey(database string, rpName string, mst string, shar
 	return nil
 }
 
+// AlterMeasurementTTL sets the time to live of a measurement, ttl of 0 disables it.
+func (data *Data) AlterMeasurementTTL(database string, rpName string, mst string, ttl int64) error {
+	rp, err := data.RetentionPolicy(database, rpName)
+	if err != nil {
+		return err
+	}
+
+	msti := rp.Measurement(mst)
+	if msti == nil || msti.MarkDeleted {
+		return ErrMeasurementNotFound
+	}
+	if ttl < 0 {
+		ttl = 0
+	}
+	msti.TTL = ttl
+	return nil
+}
+
 func (data *Data) GetNodeIndex(nodeId uint64) (uint64, error) {
 	for i, value := range data.DataNodes {
 		if value.ID == nodeId {
@@ -2962,6 +2992,9 @@ func (data *Data) Clone() *Data {
 	other.Users = data.CloneUsers()
 	other.PtView = data.CloneDBPtView()
 	other.MigrateEvents = data.CloneMigrateEvents()
+	other.AlertRules = data.CloneAlertRules()
+	other.AlertReceivers = data.CloneAlertReceivers()
+	other.AlertSilences = data.CloneAlertSilences()
 
 	other.QueryIDInit = data.CloneQueryIDInit()
 
@@ -3062,6 +3095,8 @@ func (data *Data) MarshalBase() *proto2.Data {
 		pb.QueryIDInit[string(host)] = data.QueryIDInit[host]
 	}
 
+	data.marshalAlerting(pb)
+
 	if len(data.ReplicaGroups) > 0 {
 		pb.ReplicaGroups = make(map[string]*proto2.Replications, len(data.ReplicaGroups))
 		for dbname, repls := range data.ReplicaGroups {
@@ -3168,6 +3203,8 @@ func (data *Data) Unmarshal(pb *proto2.Data) {
 		data.QueryIDInit[SQLHost(host)] = pb.QueryIDInit[host]
 	}
 
+	data.unmarshalAlerting(pb)
+
 	if len(pb.ReplicaGroups) == 0 {
 		return
 	}
@@ -31,6 +31,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	logger1 "github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/pipeline"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	proto2 "github.com/openGemini/openGemini/lib/util/lifted/influx/meta/proto"
@@ -184,6 +185,92 @@ func Test_Data_AlterShardKey(t *testing.T) {
 	}
 }
 
+func Test_Data_AlterMeasurementTTL(t *testing.T) {
+	data := initData()
+
+	dbName := "foo"
+	rpName := "bar"
+	err := data.CreateDatabase(dbName, &RetentionPolicyInfo{
+		Name:     rpName,
+		ReplicaN: 1,
+		Duration: 24 * time.Hour,
+	}, nil, false, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mstName := "cpu"
+	if err = data.AlterMeasurementTTL(dbName, rpName, mstName, int64(time.Hour)); err != ErrMeasurementNotFound {
+		t.Fatalf("unexpected error.  got: %v, exp: %s", err, ErrMeasurementNotFound)
+	}
+
+	err = data.CreateMeasurement(dbName, rpName, mstName,
+		&proto2.ShardKeyInfo{ShardKey: []string{"hostName"}, Type: proto.String(influxql.HASH)}, 0, nil, 0, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = data.AlterMeasurementTTL(dbName, rpName, mstName, int64(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+
+	mst, err := data.Measurement(dbName, rpName, mstName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mst.TTL != int64(time.Hour) {
+		t.Fatalf("got ttl %d, expected %d", mst.TTL, int64(time.Hour))
+	}
+
+	other := &MeasurementInfo{}
+	other.unmarshal(mst.marshal())
+	if other.TTL != mst.TTL || mst.clone().TTL != mst.TTL {
+		t.Fatalf("ttl is lost after marshal or clone")
+	}
+
+	if err = data.AlterMeasurementTTL(dbName, rpName, mstName, 0); err != nil {
+		t.Fatal(err)
+	}
+	if mst.TTL != 0 {
+		t.Fatalf("got ttl %d, expected 0", mst.TTL)
+	}
+}
+
+func Test_ApplyCreateMeasurement_TTL(t *testing.T) {
+	data := initData()
+	err := data.CreateDatabase("foo", &RetentionPolicyInfo{
+		Name:     "bar",
+		ReplicaN: 1,
+		Duration: 24 * time.Hour,
+	}, nil, false, 1, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := &proto2.Command{Type: proto2.Command_CreateMeasurementCommand.Enum()}
+	err = proto.SetExtension(cmd, proto2.E_CreateMeasurementCommand_Command, &proto2.CreateMeasurementCommand{
+		DBName:     proto.String("foo"),
+		RpName:     proto.String("bar"),
+		Name:       proto.String("cpu"),
+		Ski:        &proto2.ShardKeyInfo{ShardKey: []string{"hostName"}, Type: proto.String(influxql.HASH)},
+		EngineType: proto.Uint32(0),
+		TTL:        proto.Int64(int64(time.Hour)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = ApplyCreateMeasurement(data, cmd); err != nil {
+		t.Fatal(err)
+	}
+
+	mst, err := data.Measurement("foo", "bar", "cpu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mst.TTL != int64(time.Hour) {
+		t.Fatalf("got ttl %d, expected %d", mst.TTL, int64(time.Hour))
+	}
+}
+
 func Test_Data_ReSharding(t *testing.T) {
 	data := initData()
 	DataLogger = logger.New(os.Stderr)
@@ -1575,6 +1662,29 @@ func TestUpdateMeasurement(t *testing.T) {
 	}
 }
 
+func TestUpdateMeasurementPipeline(t *testing.T) {
+	data := initData()
+	dbName := "testDb"
+	logStream := "testLogstream"
+	require.NoError(t, data.CreateDatabase(dbName, nil, nil, false, 1, nil))
+	require.NoError(t, data.CreateRetentionPolicy(dbName, NewRetentionPolicyInfo(logStream), false))
+	options := &Options{Pipeline: []*pipeline.Config{
+		{Type: pipeline.TypeGrok, Pattern: "%{LOGLEVEL:level} %{GREEDYDATA:msg}"},
+		{Type: pipeline.TypeDrop, Fields: []string{"msg"}},
+	}}
+	require.NoError(t, data.CreateMeasurement(dbName, logStream, logStream, nil, 0, nil, 0, nil, nil, options.Marshal()))
+
+	msti, err := data.Measurement(dbName, logStream, logStream)
+	require.NoError(t, err)
+	require.Equal(t, options.Pipeline, msti.Options.Pipeline)
+
+	// the pipeline is removed by the update without it
+	require.NoError(t, data.UpdateMeasurement(dbName, logStream, logStream, (&Options{}).Marshal()))
+	msti, err = data.Measurement(dbName, logStream, logStream)
+	require.NoError(t, err)
+	require.Nil(t, msti.Options.Pipeline)
+}
+
 func TestInitDataNodePtView(t *testing.T) {
 	data := &Data{}
 	data.PtNumPerNode = 1
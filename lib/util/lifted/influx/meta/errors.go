@@ -160,6 +160,20 @@ var (
 	ErrContinuosQueryConflict = errors.New("continuous query conflicts with an existing continuous query")
 )
 
+var (
+	// ErrAlertRuleNotFound is returned when removing an alert rule that doesn't exist.
+	ErrAlertRuleNotFound = errors.New("alert rule not found")
+
+	// ErrAlertReceiverNotFound is returned when removing or referring to an alert receiver that doesn't exist.
+	ErrAlertReceiverNotFound = errors.New("alert receiver not found")
+
+	// ErrAlertReceiverInUse is returned when removing an alert receiver that is still used by alert rules.
+	ErrAlertReceiverInUse = errors.New("alert receiver is used by alert rules")
+
+	// ErrAlertSilenceNotFound is returned when removing an alert silence that doesn't exist.
+	ErrAlertSilenceNotFound = errors.New("alert silence not found")
+)
+
 var (
 	// ErrSubscriptionExists is returned when creating an already existing subscription.
 	ErrSubscriptionExists = errors.New("subscription already exists")
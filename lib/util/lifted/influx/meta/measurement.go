@@ -16,6 +16,7 @@ package meta
 
 import (
 	"bytes"
+	"encoding/json"
 	"sort"
 	"sync"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/index"
 	"github.com/openGemini/openGemini/lib/obs"
+	"github.com/openGemini/openGemini/lib/pipeline"
 	"github.com/openGemini/openGemini/lib/record"
 	"github.com/openGemini/openGemini/lib/tokenizer"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
@@ -82,6 +84,9 @@ type Options struct {
 	SplitChar       string `json:"split_char"`
 	TagsSplit       string `json:"tag_split_char"`
 	Ttl             int64  `json:"ttl"`
+
+	// Pipeline processes the logs of the log stream before they are written
+	Pipeline []*pipeline.Config `json:"pipeline,omitempty"`
 }
 
 func (mo *Options) InitDefault() {
@@ -99,7 +104,7 @@ func (mo *Options) Marshal() *proto2.Options {
 	if mo == nil {
 		mo = &Options{}
 	}
-	return &proto2.Options{
+	pb := &proto2.Options{
 		CaseInSensitive: proto.Bool(mo.CaseInSensitive),
 		AppendMeta:      proto.Bool(mo.AppendMeta),
 		WriteThreshold:  proto.Int(mo.WriteThreshold),
@@ -109,6 +114,13 @@ func (mo *Options) Marshal() *proto2.Options {
 		TagsSplit:       proto.String(mo.TagsSplit),
 		Ttl:             proto.Int64(mo.Ttl),
 	}
+	// the pipeline is stored as json, it is validated when the log stream is created or updated
+	if len(mo.Pipeline) > 0 {
+		if b, err := json.Marshal(mo.Pipeline); err == nil {
+			pb.Pipeline = proto.String(string(b))
+		}
+	}
+	return pb
 }
 
 func (mo *Options) Unmarshal(pb *proto2.Options) {
@@ -120,6 +132,10 @@ func (mo *Options) Unmarshal(pb *proto2.Options) {
 	mo.TagsSplit = pb.GetTagsSplit()
 	mo.AppendMeta = pb.GetAppendMeta()
 	mo.Ttl = pb.GetTtl()
+	mo.Pipeline = nil
+	if p := pb.GetPipeline(); p != "" {
+		_ = json.Unmarshal([]byte(p), &mo.Pipeline)
+	}
 }
 
 func (mo *Options) GetSplitChar() string {
@@ -250,6 +266,7 @@ type MeasurementInfo struct {
 	ObsOptions      *obs.ObsOptions // assign DatabaseInfo's ObsOptions to it when obatining MeasurementInfo
 	tagKeysTotal    int
 	ID              uint64
+	TTL             int64        // nanoseconds, rows older than now-TTL are expired, 0 means no ttl
 	SchemaLock      sync.RWMutex //ts-meta not use
 }
 
@@ -364,6 +381,9 @@ func (msti *MeasurementInfo) marshal() *proto2.MeasurementInfo {
 	if msti.ObsOptions != nil {
 		pb.ObsOptions = MarshalObsOptions(msti.ObsOptions)
 	}
+	if msti.TTL > 0 {
+		pb.TTL = proto.Int64(msti.TTL)
+	}
 
 	return pb
 }
@@ -374,6 +394,7 @@ func (msti *MeasurementInfo) unmarshal(pb *proto2.MeasurementInfo) {
 	msti.MarkDeleted = pb.GetMarkDeleted()
 	msti.EngineType = config.EngineType(pb.GetEngineType())
 	msti.ID = pb.GetID()
+	msti.TTL = pb.GetTTL()
 	if pb.GetShardKeys() != nil {
 		msti.ShardKeys = make([]ShardKeyInfo, len(pb.GetShardKeys()))
 		for i := range pb.GetShardKeys() {
@@ -435,6 +456,7 @@ func (msti *MeasurementInfo) clone() *MeasurementInfo {
 	other.MarkDeleted = msti.MarkDeleted
 	other.EngineType = msti.EngineType
 	other.tagKeysTotal = msti.tagKeysTotal
+	other.TTL = msti.TTL
 
 	other.Schema = msti.CloneSchema()
 	other.ShardIdexes = msti.CloneShardIdexes()
@@ -101,6 +101,14 @@ const (
 	Command_ShowClusterCommand                    Command_Type = 103
 	Command_IndexDurationCommand                  Command_Type = 104
 	Command_UpdateIndexInfoTierCommand            Command_Type = 105
+	Command_AlterMeasurementTTLCmd                Command_Type = 106
+	Command_CreateAlertRuleCommand                Command_Type = 107
+	Command_DropAlertRuleCommand                  Command_Type = 108
+	Command_CreateAlertReceiverCommand            Command_Type = 109
+	Command_DropAlertReceiverCommand              Command_Type = 110
+	Command_CreateAlertSilenceCommand             Command_Type = 111
+	Command_DropAlertSilenceCommand               Command_Type = 112
+	Command_AlertStateReportCommand               Command_Type = 113
 )
 
 var Command_Type_name = map[int32]string{
@@ -182,6 +190,14 @@ var Command_Type_name = map[int32]string{
 	103: "ShowClusterCommand",
 	104: "IndexDurationCommand",
 	105: "UpdateIndexInfoTierCommand",
+	106: "AlterMeasurementTTLCmd",
+	107: "CreateAlertRuleCommand",
+	108: "DropAlertRuleCommand",
+	109: "CreateAlertReceiverCommand",
+	110: "DropAlertReceiverCommand",
+	111: "CreateAlertSilenceCommand",
+	112: "DropAlertSilenceCommand",
+	113: "AlertStateReportCommand",
 }
 
 var Command_Type_value = map[string]int32{
@@ -263,6 +279,14 @@ var Command_Type_value = map[string]int32{
 	"ShowClusterCommand":                    103,
 	"IndexDurationCommand":                  104,
 	"UpdateIndexInfoTierCommand":            105,
+	"AlterMeasurementTTLCmd":                106,
+	"CreateAlertRuleCommand":                107,
+	"DropAlertRuleCommand":                  108,
+	"CreateAlertReceiverCommand":            109,
+	"DropAlertReceiverCommand":              110,
+	"CreateAlertSilenceCommand":             111,
+	"DropAlertSilenceCommand":               112,
+	"AlertStateReportCommand":               113,
 }
 
 func (x Command_Type) Enum() *Command_Type {
@@ -321,6 +345,9 @@ type Data struct {
 	IsSQLiteEnabled      *bool                    `protobuf:"varint,32,opt,name=IsSQLiteEnabled" json:"IsSQLiteEnabled,omitempty"`
 	SqlNodes             []*DataNode              `protobuf:"bytes,33,rep,name=SqlNodes" json:"SqlNodes,omitempty"`
 	MaxMstID             *uint64                  `protobuf:"varint,34,opt,name=MaxMstID" json:"MaxMstID,omitempty"`
+	AlertRules           []*AlertRuleInfo         `protobuf:"bytes,35,rep,name=AlertRules" json:"AlertRules,omitempty"`
+	AlertReceivers       []*AlertReceiverInfo     `protobuf:"bytes,36,rep,name=AlertReceivers" json:"AlertReceivers,omitempty"`
+	AlertSilences        []*AlertSilenceInfo      `protobuf:"bytes,37,rep,name=AlertSilences" json:"AlertSilences,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -574,6 +601,27 @@ func (m *Data) GetMaxMstID() uint64 {
 	return 0
 }
 
+func (m *Data) GetAlertRules() []*AlertRuleInfo {
+	if m != nil {
+		return m.AlertRules
+	}
+	return nil
+}
+
+func (m *Data) GetAlertReceivers() []*AlertReceiverInfo {
+	if m != nil {
+		return m.AlertReceivers
+	}
+	return nil
+}
+
+func (m *Data) GetAlertSilences() []*AlertSilenceInfo {
+	if m != nil {
+		return m.AlertSilences
+	}
+	return nil
+}
+
 type Replications struct {
 	Groups               []*ReplicaGroup `protobuf:"bytes,1,rep,name=Groups" json:"Groups,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -1224,6 +1272,7 @@ type MeasurementInfo struct {
 	ID                   *uint64               `protobuf:"varint,11,opt,name=ID" json:"ID,omitempty"`
 	Options              *Options              `protobuf:"bytes,21,opt,name=Options" json:"Options,omitempty"`
 	SchemaUseForClean    map[string]*SchemaVal `protobuf:"bytes,22,rep,name=SchemaUseForClean" json:"SchemaUseForClean,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TTL                  *int64                `protobuf:"varint,23,opt,name=TTL" json:"TTL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
 	XXX_unrecognized     []byte                `json:"-"`
 	XXX_sizecache        int32                 `json:"-"`
@@ -1344,6 +1393,13 @@ func (m *MeasurementInfo) GetSchemaUseForClean() map[string]*SchemaVal {
 	return nil
 }
 
+func (m *MeasurementInfo) GetTTL() int64 {
+	if m != nil && m.TTL != nil {
+		return *m.TTL
+	}
+	return 0
+}
+
 type SchemaVal struct {
 	Typ                  *int32   `protobuf:"varint,1,req,name=Typ" json:"Typ,omitempty"`
 	EndTime              *int32   `protobuf:"varint,2,opt,name=EndTime" json:"EndTime,omitempty"`
@@ -4523,6 +4579,7 @@ type CreateMeasurementCommand struct {
 	SchemaInfo           []*FieldSchema `protobuf:"bytes,8,rep,name=SchemaInfo" json:"SchemaInfo,omitempty"`
 	Options              *Options       `protobuf:"bytes,9,opt,name=Options" json:"Options,omitempty"`
 	InitNumOfShards      *int32         `protobuf:"varint,10,opt,name=InitNumOfShards" json:"InitNumOfShards,omitempty"`
+	TTL                  *int64         `protobuf:"varint,11,opt,name=TTL" json:"TTL,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -4622,6 +4679,13 @@ func (m *CreateMeasurementCommand) GetInitNumOfShards() int32 {
 	return 0
 }
 
+func (m *CreateMeasurementCommand) GetTTL() int64 {
+	if m != nil && m.TTL != nil {
+		return *m.TTL
+	}
+	return 0
+}
+
 var E_CreateMeasurementCommand_Command = &proto.ExtensionDesc{
 	ExtendedType:  (*Command)(nil),
 	ExtensionType: (*CreateMeasurementCommand)(nil),
@@ -8932,6 +8996,7 @@ type Options struct {
 	SplitChar            *string  `protobuf:"bytes,6,opt,name=SplitChar" json:"SplitChar,omitempty"`
 	Ttl                  *int64   `protobuf:"varint,7,opt,name=Ttl" json:"Ttl,omitempty"`
 	TagsSplit            *string  `protobuf:"bytes,10,opt,name=TagsSplit" json:"TagsSplit,omitempty"`
+	Pipeline             *string  `protobuf:"bytes,11,opt,name=Pipeline" json:"Pipeline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -9017,6 +9082,13 @@ func (m *Options) GetTagsSplit() string {
 	return ""
 }
 
+func (m *Options) GetPipeline() string {
+	if m != nil && m.Pipeline != nil {
+		return *m.Pipeline
+	}
+	return ""
+}
+
 type UpdateMeasurementCommand struct {
 	Db                   *string  `protobuf:"bytes,1,req,name=Db" json:"Db,omitempty"`
 	Rp                   *string  `protobuf:"bytes,2,req,name=Rp" json:"Rp,omitempty"`
@@ -9990,6 +10062,1008 @@ var E_UpdateIndexInfoTierCommand_Command = &proto.ExtensionDesc{
 	Filename:      "meta.proto",
 }
 
+type AlterMeasurementTTLCmd struct {
+	DBName               *string  `protobuf:"bytes,1,req,name=DBName" json:"DBName,omitempty"`
+	RpName               *string  `protobuf:"bytes,2,req,name=RpName" json:"RpName,omitempty"`
+	Name                 *string  `protobuf:"bytes,3,req,name=Name" json:"Name,omitempty"`
+	TTL                  *int64   `protobuf:"varint,4,req,name=TTL" json:"TTL,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlterMeasurementTTLCmd) Reset()         { *m = AlterMeasurementTTLCmd{} }
+func (m *AlterMeasurementTTLCmd) String() string { return proto.CompactTextString(m) }
+func (*AlterMeasurementTTLCmd) ProtoMessage()    {}
+func (*AlterMeasurementTTLCmd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{154}
+}
+func (m *AlterMeasurementTTLCmd) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterMeasurementTTLCmd.Unmarshal(m, b)
+}
+func (m *AlterMeasurementTTLCmd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterMeasurementTTLCmd.Marshal(b, m, deterministic)
+}
+func (m *AlterMeasurementTTLCmd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterMeasurementTTLCmd.Merge(m, src)
+}
+func (m *AlterMeasurementTTLCmd) XXX_Size() int {
+	return xxx_messageInfo_AlterMeasurementTTLCmd.Size(m)
+}
+func (m *AlterMeasurementTTLCmd) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterMeasurementTTLCmd.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterMeasurementTTLCmd proto.InternalMessageInfo
+
+func (m *AlterMeasurementTTLCmd) GetDBName() string {
+	if m != nil && m.DBName != nil {
+		return *m.DBName
+	}
+	return ""
+}
+
+func (m *AlterMeasurementTTLCmd) GetRpName() string {
+	if m != nil && m.RpName != nil {
+		return *m.RpName
+	}
+	return ""
+}
+
+func (m *AlterMeasurementTTLCmd) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *AlterMeasurementTTLCmd) GetTTL() int64 {
+	if m != nil && m.TTL != nil {
+		return *m.TTL
+	}
+	return 0
+}
+
+var E_AlterMeasurementTTLCmd_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*AlterMeasurementTTLCmd)(nil),
+	Field:         203,
+	Name:          "proto.AlterMeasurementTTLCmd.command",
+	Tag:           "bytes,203,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type AlertRuleInfo struct {
+	Name                 *string           `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Type                 *string           `protobuf:"bytes,2,req,name=Type" json:"Type,omitempty"`
+	Database             *string           `protobuf:"bytes,3,req,name=Database" json:"Database,omitempty"`
+	RetentionPolicy      *string           `protobuf:"bytes,4,opt,name=RetentionPolicy" json:"RetentionPolicy,omitempty"`
+	LogStream            *string           `protobuf:"bytes,5,opt,name=LogStream" json:"LogStream,omitempty"`
+	Query                *string           `protobuf:"bytes,6,req,name=Query" json:"Query,omitempty"`
+	Condition            *string           `protobuf:"bytes,7,opt,name=Condition" json:"Condition,omitempty"`
+	Interval             *int64            `protobuf:"varint,8,opt,name=Interval" json:"Interval,omitempty"`
+	For                  *int64            `protobuf:"varint,9,opt,name=For" json:"For,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,10,rep,name=Labels" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations          map[string]string `protobuf:"bytes,11,rep,name=Annotations" json:"Annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Receivers            []string          `protobuf:"bytes,12,rep,name=Receivers" json:"Receivers,omitempty"`
+	Owner                *string           `protobuf:"bytes,13,opt,name=Owner" json:"Owner,omitempty"`
+	LeaseExpire          *int64            `protobuf:"varint,14,opt,name=LeaseExpire" json:"LeaseExpire,omitempty"`
+	LastEvalTime         *int64            `protobuf:"varint,15,opt,name=LastEvalTime" json:"LastEvalTime,omitempty"`
+	LastError            *string           `protobuf:"bytes,16,opt,name=LastError" json:"LastError,omitempty"`
+	Alerts               []*AlertInfo      `protobuf:"bytes,17,rep,name=Alerts" json:"Alerts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AlertRuleInfo) Reset()         { *m = AlertRuleInfo{} }
+func (m *AlertRuleInfo) String() string { return proto.CompactTextString(m) }
+func (*AlertRuleInfo) ProtoMessage()    {}
+func (*AlertRuleInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{155}
+}
+func (m *AlertRuleInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertRuleInfo.Unmarshal(m, b)
+}
+func (m *AlertRuleInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertRuleInfo.Marshal(b, m, deterministic)
+}
+func (m *AlertRuleInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertRuleInfo.Merge(m, src)
+}
+func (m *AlertRuleInfo) XXX_Size() int {
+	return xxx_messageInfo_AlertRuleInfo.Size(m)
+}
+func (m *AlertRuleInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertRuleInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertRuleInfo proto.InternalMessageInfo
+
+func (m *AlertRuleInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetType() string {
+	if m != nil && m.Type != nil {
+		return *m.Type
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetDatabase() string {
+	if m != nil && m.Database != nil {
+		return *m.Database
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetRetentionPolicy() string {
+	if m != nil && m.RetentionPolicy != nil {
+		return *m.RetentionPolicy
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetLogStream() string {
+	if m != nil && m.LogStream != nil {
+		return *m.LogStream
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetQuery() string {
+	if m != nil && m.Query != nil {
+		return *m.Query
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetCondition() string {
+	if m != nil && m.Condition != nil {
+		return *m.Condition
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetInterval() int64 {
+	if m != nil && m.Interval != nil {
+		return *m.Interval
+	}
+	return 0
+}
+
+func (m *AlertRuleInfo) GetFor() int64 {
+	if m != nil && m.For != nil {
+		return *m.For
+	}
+	return 0
+}
+
+func (m *AlertRuleInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *AlertRuleInfo) GetAnnotations() map[string]string {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+func (m *AlertRuleInfo) GetReceivers() []string {
+	if m != nil {
+		return m.Receivers
+	}
+	return nil
+}
+
+func (m *AlertRuleInfo) GetOwner() string {
+	if m != nil && m.Owner != nil {
+		return *m.Owner
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetLeaseExpire() int64 {
+	if m != nil && m.LeaseExpire != nil {
+		return *m.LeaseExpire
+	}
+	return 0
+}
+
+func (m *AlertRuleInfo) GetLastEvalTime() int64 {
+	if m != nil && m.LastEvalTime != nil {
+		return *m.LastEvalTime
+	}
+	return 0
+}
+
+func (m *AlertRuleInfo) GetLastError() string {
+	if m != nil && m.LastError != nil {
+		return *m.LastError
+	}
+	return ""
+}
+
+func (m *AlertRuleInfo) GetAlerts() []*AlertInfo {
+	if m != nil {
+		return m.Alerts
+	}
+	return nil
+}
+
+type AlertInfo struct {
+	Labels               map[string]string    `protobuf:"bytes,1,rep,name=Labels" json:"Labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	State                *int32               `protobuf:"varint,2,req,name=State" json:"State,omitempty"`
+	ActiveAt             *int64               `protobuf:"varint,3,opt,name=ActiveAt" json:"ActiveAt,omitempty"`
+	FiredAt              *int64               `protobuf:"varint,4,opt,name=FiredAt" json:"FiredAt,omitempty"`
+	ResolvedAt           *int64               `protobuf:"varint,5,opt,name=ResolvedAt" json:"ResolvedAt,omitempty"`
+	Value                *float64             `protobuf:"fixed64,6,opt,name=Value" json:"Value,omitempty"`
+	Notifications        []*AlertNotification `protobuf:"bytes,7,rep,name=Notifications" json:"Notifications,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AlertInfo) Reset()         { *m = AlertInfo{} }
+func (m *AlertInfo) String() string { return proto.CompactTextString(m) }
+func (*AlertInfo) ProtoMessage()    {}
+func (*AlertInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{156}
+}
+func (m *AlertInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertInfo.Unmarshal(m, b)
+}
+func (m *AlertInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertInfo.Marshal(b, m, deterministic)
+}
+func (m *AlertInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertInfo.Merge(m, src)
+}
+func (m *AlertInfo) XXX_Size() int {
+	return xxx_messageInfo_AlertInfo.Size(m)
+}
+func (m *AlertInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertInfo proto.InternalMessageInfo
+
+func (m *AlertInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *AlertInfo) GetState() int32 {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return 0
+}
+
+func (m *AlertInfo) GetActiveAt() int64 {
+	if m != nil && m.ActiveAt != nil {
+		return *m.ActiveAt
+	}
+	return 0
+}
+
+func (m *AlertInfo) GetFiredAt() int64 {
+	if m != nil && m.FiredAt != nil {
+		return *m.FiredAt
+	}
+	return 0
+}
+
+func (m *AlertInfo) GetResolvedAt() int64 {
+	if m != nil && m.ResolvedAt != nil {
+		return *m.ResolvedAt
+	}
+	return 0
+}
+
+func (m *AlertInfo) GetValue() float64 {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return 0
+}
+
+func (m *AlertInfo) GetNotifications() []*AlertNotification {
+	if m != nil {
+		return m.Notifications
+	}
+	return nil
+}
+
+type AlertNotification struct {
+	Receiver             *string  `protobuf:"bytes,1,req,name=Receiver" json:"Receiver,omitempty"`
+	State                *int32   `protobuf:"varint,2,req,name=State" json:"State,omitempty"`
+	SentAt               *int64   `protobuf:"varint,3,opt,name=SentAt" json:"SentAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertNotification) Reset()         { *m = AlertNotification{} }
+func (m *AlertNotification) String() string { return proto.CompactTextString(m) }
+func (*AlertNotification) ProtoMessage()    {}
+func (*AlertNotification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{157}
+}
+func (m *AlertNotification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertNotification.Unmarshal(m, b)
+}
+func (m *AlertNotification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertNotification.Marshal(b, m, deterministic)
+}
+func (m *AlertNotification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertNotification.Merge(m, src)
+}
+func (m *AlertNotification) XXX_Size() int {
+	return xxx_messageInfo_AlertNotification.Size(m)
+}
+func (m *AlertNotification) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertNotification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertNotification proto.InternalMessageInfo
+
+func (m *AlertNotification) GetReceiver() string {
+	if m != nil && m.Receiver != nil {
+		return *m.Receiver
+	}
+	return ""
+}
+
+func (m *AlertNotification) GetState() int32 {
+	if m != nil && m.State != nil {
+		return *m.State
+	}
+	return 0
+}
+
+func (m *AlertNotification) GetSentAt() int64 {
+	if m != nil && m.SentAt != nil {
+		return *m.SentAt
+	}
+	return 0
+}
+
+type AlertReceiverInfo struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	URL                  *string  `protobuf:"bytes,2,req,name=URL" json:"URL,omitempty"`
+	GroupBy              []string `protobuf:"bytes,3,rep,name=GroupBy" json:"GroupBy,omitempty"`
+	GroupWait            *int64   `protobuf:"varint,4,opt,name=GroupWait" json:"GroupWait,omitempty"`
+	GroupInterval        *int64   `protobuf:"varint,5,opt,name=GroupInterval" json:"GroupInterval,omitempty"`
+	RepeatInterval       *int64   `protobuf:"varint,6,opt,name=RepeatInterval" json:"RepeatInterval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertReceiverInfo) Reset()         { *m = AlertReceiverInfo{} }
+func (m *AlertReceiverInfo) String() string { return proto.CompactTextString(m) }
+func (*AlertReceiverInfo) ProtoMessage()    {}
+func (*AlertReceiverInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{158}
+}
+func (m *AlertReceiverInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertReceiverInfo.Unmarshal(m, b)
+}
+func (m *AlertReceiverInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertReceiverInfo.Marshal(b, m, deterministic)
+}
+func (m *AlertReceiverInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertReceiverInfo.Merge(m, src)
+}
+func (m *AlertReceiverInfo) XXX_Size() int {
+	return xxx_messageInfo_AlertReceiverInfo.Size(m)
+}
+func (m *AlertReceiverInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertReceiverInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertReceiverInfo proto.InternalMessageInfo
+
+func (m *AlertReceiverInfo) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *AlertReceiverInfo) GetURL() string {
+	if m != nil && m.URL != nil {
+		return *m.URL
+	}
+	return ""
+}
+
+func (m *AlertReceiverInfo) GetGroupBy() []string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return nil
+}
+
+func (m *AlertReceiverInfo) GetGroupWait() int64 {
+	if m != nil && m.GroupWait != nil {
+		return *m.GroupWait
+	}
+	return 0
+}
+
+func (m *AlertReceiverInfo) GetGroupInterval() int64 {
+	if m != nil && m.GroupInterval != nil {
+		return *m.GroupInterval
+	}
+	return 0
+}
+
+func (m *AlertReceiverInfo) GetRepeatInterval() int64 {
+	if m != nil && m.RepeatInterval != nil {
+		return *m.RepeatInterval
+	}
+	return 0
+}
+
+type AlertMatcher struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	Value                *string  `protobuf:"bytes,2,req,name=Value" json:"Value,omitempty"`
+	IsRegex              *bool    `protobuf:"varint,3,opt,name=IsRegex" json:"IsRegex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AlertMatcher) Reset()         { *m = AlertMatcher{} }
+func (m *AlertMatcher) String() string { return proto.CompactTextString(m) }
+func (*AlertMatcher) ProtoMessage()    {}
+func (*AlertMatcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{159}
+}
+func (m *AlertMatcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertMatcher.Unmarshal(m, b)
+}
+func (m *AlertMatcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertMatcher.Marshal(b, m, deterministic)
+}
+func (m *AlertMatcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertMatcher.Merge(m, src)
+}
+func (m *AlertMatcher) XXX_Size() int {
+	return xxx_messageInfo_AlertMatcher.Size(m)
+}
+func (m *AlertMatcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertMatcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertMatcher proto.InternalMessageInfo
+
+func (m *AlertMatcher) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *AlertMatcher) GetValue() string {
+	if m != nil && m.Value != nil {
+		return *m.Value
+	}
+	return ""
+}
+
+func (m *AlertMatcher) GetIsRegex() bool {
+	if m != nil && m.IsRegex != nil {
+		return *m.IsRegex
+	}
+	return false
+}
+
+type AlertSilenceInfo struct {
+	ID                   *string         `protobuf:"bytes,1,req,name=ID" json:"ID,omitempty"`
+	Matchers             []*AlertMatcher `protobuf:"bytes,2,rep,name=Matchers" json:"Matchers,omitempty"`
+	StartsAt             *int64          `protobuf:"varint,3,req,name=StartsAt" json:"StartsAt,omitempty"`
+	EndsAt               *int64          `protobuf:"varint,4,req,name=EndsAt" json:"EndsAt,omitempty"`
+	CreatedBy            *string         `protobuf:"bytes,5,opt,name=CreatedBy" json:"CreatedBy,omitempty"`
+	Comment              *string         `protobuf:"bytes,6,opt,name=Comment" json:"Comment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AlertSilenceInfo) Reset()         { *m = AlertSilenceInfo{} }
+func (m *AlertSilenceInfo) String() string { return proto.CompactTextString(m) }
+func (*AlertSilenceInfo) ProtoMessage()    {}
+func (*AlertSilenceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{160}
+}
+func (m *AlertSilenceInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertSilenceInfo.Unmarshal(m, b)
+}
+func (m *AlertSilenceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertSilenceInfo.Marshal(b, m, deterministic)
+}
+func (m *AlertSilenceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertSilenceInfo.Merge(m, src)
+}
+func (m *AlertSilenceInfo) XXX_Size() int {
+	return xxx_messageInfo_AlertSilenceInfo.Size(m)
+}
+func (m *AlertSilenceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertSilenceInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertSilenceInfo proto.InternalMessageInfo
+
+func (m *AlertSilenceInfo) GetID() string {
+	if m != nil && m.ID != nil {
+		return *m.ID
+	}
+	return ""
+}
+
+func (m *AlertSilenceInfo) GetMatchers() []*AlertMatcher {
+	if m != nil {
+		return m.Matchers
+	}
+	return nil
+}
+
+func (m *AlertSilenceInfo) GetStartsAt() int64 {
+	if m != nil && m.StartsAt != nil {
+		return *m.StartsAt
+	}
+	return 0
+}
+
+func (m *AlertSilenceInfo) GetEndsAt() int64 {
+	if m != nil && m.EndsAt != nil {
+		return *m.EndsAt
+	}
+	return 0
+}
+
+func (m *AlertSilenceInfo) GetCreatedBy() string {
+	if m != nil && m.CreatedBy != nil {
+		return *m.CreatedBy
+	}
+	return ""
+}
+
+func (m *AlertSilenceInfo) GetComment() string {
+	if m != nil && m.Comment != nil {
+		return *m.Comment
+	}
+	return ""
+}
+
+type AlertRuleState struct {
+	Name                 *string      `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	LeaseExpire          *int64       `protobuf:"varint,2,opt,name=LeaseExpire" json:"LeaseExpire,omitempty"`
+	LastEvalTime         *int64       `protobuf:"varint,3,opt,name=LastEvalTime" json:"LastEvalTime,omitempty"`
+	LastError            *string      `protobuf:"bytes,4,opt,name=LastError" json:"LastError,omitempty"`
+	Alerts               []*AlertInfo `protobuf:"bytes,5,rep,name=Alerts" json:"Alerts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AlertRuleState) Reset()         { *m = AlertRuleState{} }
+func (m *AlertRuleState) String() string { return proto.CompactTextString(m) }
+func (*AlertRuleState) ProtoMessage()    {}
+func (*AlertRuleState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{161}
+}
+func (m *AlertRuleState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertRuleState.Unmarshal(m, b)
+}
+func (m *AlertRuleState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertRuleState.Marshal(b, m, deterministic)
+}
+func (m *AlertRuleState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertRuleState.Merge(m, src)
+}
+func (m *AlertRuleState) XXX_Size() int {
+	return xxx_messageInfo_AlertRuleState.Size(m)
+}
+func (m *AlertRuleState) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertRuleState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertRuleState proto.InternalMessageInfo
+
+func (m *AlertRuleState) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *AlertRuleState) GetLeaseExpire() int64 {
+	if m != nil && m.LeaseExpire != nil {
+		return *m.LeaseExpire
+	}
+	return 0
+}
+
+func (m *AlertRuleState) GetLastEvalTime() int64 {
+	if m != nil && m.LastEvalTime != nil {
+		return *m.LastEvalTime
+	}
+	return 0
+}
+
+func (m *AlertRuleState) GetLastError() string {
+	if m != nil && m.LastError != nil {
+		return *m.LastError
+	}
+	return ""
+}
+
+func (m *AlertRuleState) GetAlerts() []*AlertInfo {
+	if m != nil {
+		return m.Alerts
+	}
+	return nil
+}
+
+type CreateAlertRuleCommand struct {
+	Rule                 *AlertRuleInfo `protobuf:"bytes,1,req,name=Rule" json:"Rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *CreateAlertRuleCommand) Reset()         { *m = CreateAlertRuleCommand{} }
+func (m *CreateAlertRuleCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateAlertRuleCommand) ProtoMessage()    {}
+func (*CreateAlertRuleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{162}
+}
+func (m *CreateAlertRuleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAlertRuleCommand.Unmarshal(m, b)
+}
+func (m *CreateAlertRuleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAlertRuleCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateAlertRuleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAlertRuleCommand.Merge(m, src)
+}
+func (m *CreateAlertRuleCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateAlertRuleCommand.Size(m)
+}
+func (m *CreateAlertRuleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAlertRuleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAlertRuleCommand proto.InternalMessageInfo
+
+func (m *CreateAlertRuleCommand) GetRule() *AlertRuleInfo {
+	if m != nil {
+		return m.Rule
+	}
+	return nil
+}
+
+var E_CreateAlertRuleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateAlertRuleCommand)(nil),
+	Field:         204,
+	Name:          "proto.CreateAlertRuleCommand.command",
+	Tag:           "bytes,204,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type DropAlertRuleCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropAlertRuleCommand) Reset()         { *m = DropAlertRuleCommand{} }
+func (m *DropAlertRuleCommand) String() string { return proto.CompactTextString(m) }
+func (*DropAlertRuleCommand) ProtoMessage()    {}
+func (*DropAlertRuleCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{163}
+}
+func (m *DropAlertRuleCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropAlertRuleCommand.Unmarshal(m, b)
+}
+func (m *DropAlertRuleCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropAlertRuleCommand.Marshal(b, m, deterministic)
+}
+func (m *DropAlertRuleCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropAlertRuleCommand.Merge(m, src)
+}
+func (m *DropAlertRuleCommand) XXX_Size() int {
+	return xxx_messageInfo_DropAlertRuleCommand.Size(m)
+}
+func (m *DropAlertRuleCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropAlertRuleCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropAlertRuleCommand proto.InternalMessageInfo
+
+func (m *DropAlertRuleCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_DropAlertRuleCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropAlertRuleCommand)(nil),
+	Field:         205,
+	Name:          "proto.DropAlertRuleCommand.command",
+	Tag:           "bytes,205,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type CreateAlertReceiverCommand struct {
+	Receiver             *AlertReceiverInfo `protobuf:"bytes,1,req,name=Receiver" json:"Receiver,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CreateAlertReceiverCommand) Reset()         { *m = CreateAlertReceiverCommand{} }
+func (m *CreateAlertReceiverCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateAlertReceiverCommand) ProtoMessage()    {}
+func (*CreateAlertReceiverCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{164}
+}
+func (m *CreateAlertReceiverCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAlertReceiverCommand.Unmarshal(m, b)
+}
+func (m *CreateAlertReceiverCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAlertReceiverCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateAlertReceiverCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAlertReceiverCommand.Merge(m, src)
+}
+func (m *CreateAlertReceiverCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateAlertReceiverCommand.Size(m)
+}
+func (m *CreateAlertReceiverCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAlertReceiverCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAlertReceiverCommand proto.InternalMessageInfo
+
+func (m *CreateAlertReceiverCommand) GetReceiver() *AlertReceiverInfo {
+	if m != nil {
+		return m.Receiver
+	}
+	return nil
+}
+
+var E_CreateAlertReceiverCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateAlertReceiverCommand)(nil),
+	Field:         206,
+	Name:          "proto.CreateAlertReceiverCommand.command",
+	Tag:           "bytes,206,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type DropAlertReceiverCommand struct {
+	Name                 *string  `protobuf:"bytes,1,req,name=Name" json:"Name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropAlertReceiverCommand) Reset()         { *m = DropAlertReceiverCommand{} }
+func (m *DropAlertReceiverCommand) String() string { return proto.CompactTextString(m) }
+func (*DropAlertReceiverCommand) ProtoMessage()    {}
+func (*DropAlertReceiverCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{165}
+}
+func (m *DropAlertReceiverCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropAlertReceiverCommand.Unmarshal(m, b)
+}
+func (m *DropAlertReceiverCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropAlertReceiverCommand.Marshal(b, m, deterministic)
+}
+func (m *DropAlertReceiverCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropAlertReceiverCommand.Merge(m, src)
+}
+func (m *DropAlertReceiverCommand) XXX_Size() int {
+	return xxx_messageInfo_DropAlertReceiverCommand.Size(m)
+}
+func (m *DropAlertReceiverCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropAlertReceiverCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropAlertReceiverCommand proto.InternalMessageInfo
+
+func (m *DropAlertReceiverCommand) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+var E_DropAlertReceiverCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropAlertReceiverCommand)(nil),
+	Field:         207,
+	Name:          "proto.DropAlertReceiverCommand.command",
+	Tag:           "bytes,207,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type CreateAlertSilenceCommand struct {
+	Silence              *AlertSilenceInfo `protobuf:"bytes,1,req,name=Silence" json:"Silence,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CreateAlertSilenceCommand) Reset()         { *m = CreateAlertSilenceCommand{} }
+func (m *CreateAlertSilenceCommand) String() string { return proto.CompactTextString(m) }
+func (*CreateAlertSilenceCommand) ProtoMessage()    {}
+func (*CreateAlertSilenceCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{166}
+}
+func (m *CreateAlertSilenceCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAlertSilenceCommand.Unmarshal(m, b)
+}
+func (m *CreateAlertSilenceCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAlertSilenceCommand.Marshal(b, m, deterministic)
+}
+func (m *CreateAlertSilenceCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAlertSilenceCommand.Merge(m, src)
+}
+func (m *CreateAlertSilenceCommand) XXX_Size() int {
+	return xxx_messageInfo_CreateAlertSilenceCommand.Size(m)
+}
+func (m *CreateAlertSilenceCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAlertSilenceCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAlertSilenceCommand proto.InternalMessageInfo
+
+func (m *CreateAlertSilenceCommand) GetSilence() *AlertSilenceInfo {
+	if m != nil {
+		return m.Silence
+	}
+	return nil
+}
+
+var E_CreateAlertSilenceCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*CreateAlertSilenceCommand)(nil),
+	Field:         208,
+	Name:          "proto.CreateAlertSilenceCommand.command",
+	Tag:           "bytes,208,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type DropAlertSilenceCommand struct {
+	ID                   *string  `protobuf:"bytes,1,req,name=ID" json:"ID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DropAlertSilenceCommand) Reset()         { *m = DropAlertSilenceCommand{} }
+func (m *DropAlertSilenceCommand) String() string { return proto.CompactTextString(m) }
+func (*DropAlertSilenceCommand) ProtoMessage()    {}
+func (*DropAlertSilenceCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{167}
+}
+func (m *DropAlertSilenceCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropAlertSilenceCommand.Unmarshal(m, b)
+}
+func (m *DropAlertSilenceCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropAlertSilenceCommand.Marshal(b, m, deterministic)
+}
+func (m *DropAlertSilenceCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropAlertSilenceCommand.Merge(m, src)
+}
+func (m *DropAlertSilenceCommand) XXX_Size() int {
+	return xxx_messageInfo_DropAlertSilenceCommand.Size(m)
+}
+func (m *DropAlertSilenceCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropAlertSilenceCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DropAlertSilenceCommand proto.InternalMessageInfo
+
+func (m *DropAlertSilenceCommand) GetID() string {
+	if m != nil && m.ID != nil {
+		return *m.ID
+	}
+	return ""
+}
+
+var E_DropAlertSilenceCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*DropAlertSilenceCommand)(nil),
+	Field:         209,
+	Name:          "proto.DropAlertSilenceCommand.command",
+	Tag:           "bytes,209,opt,name=command",
+	Filename:      "meta.proto",
+}
+
+type AlertStateReportCommand struct {
+	Host                 *string           `protobuf:"bytes,1,req,name=Host" json:"Host,omitempty"`
+	Time                 *int64            `protobuf:"varint,2,req,name=Time" json:"Time,omitempty"`
+	Rules                []*AlertRuleState `protobuf:"bytes,3,rep,name=Rules" json:"Rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AlertStateReportCommand) Reset()         { *m = AlertStateReportCommand{} }
+func (m *AlertStateReportCommand) String() string { return proto.CompactTextString(m) }
+func (*AlertStateReportCommand) ProtoMessage()    {}
+func (*AlertStateReportCommand) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3b5ea8fe65782bcc, []int{168}
+}
+func (m *AlertStateReportCommand) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlertStateReportCommand.Unmarshal(m, b)
+}
+func (m *AlertStateReportCommand) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlertStateReportCommand.Marshal(b, m, deterministic)
+}
+func (m *AlertStateReportCommand) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlertStateReportCommand.Merge(m, src)
+}
+func (m *AlertStateReportCommand) XXX_Size() int {
+	return xxx_messageInfo_AlertStateReportCommand.Size(m)
+}
+func (m *AlertStateReportCommand) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlertStateReportCommand.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlertStateReportCommand proto.InternalMessageInfo
+
+func (m *AlertStateReportCommand) GetHost() string {
+	if m != nil && m.Host != nil {
+		return *m.Host
+	}
+	return ""
+}
+
+func (m *AlertStateReportCommand) GetTime() int64 {
+	if m != nil && m.Time != nil {
+		return *m.Time
+	}
+	return 0
+}
+
+func (m *AlertStateReportCommand) GetRules() []*AlertRuleState {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+var E_AlertStateReportCommand_Command = &proto.ExtensionDesc{
+	ExtendedType:  (*Command)(nil),
+	ExtensionType: (*AlertStateReportCommand)(nil),
+	Field:         210,
+	Name:          "proto.AlertStateReportCommand.command",
+	Tag:           "bytes,210,opt,name=command",
+	Filename:      "meta.proto",
+}
+
 func init() {
 	proto.RegisterEnum("proto.Command_Type", Command_Type_name, Command_Type_value)
 	proto.RegisterType((*Data)(nil), "proto.Data")
@@ -10231,479 +11305,562 @@ func init() {
 	proto.RegisterType((*IndexDurationCommand)(nil), "proto.IndexDurationCommand")
 	proto.RegisterExtension(E_UpdateIndexInfoTierCommand_Command)
 	proto.RegisterType((*UpdateIndexInfoTierCommand)(nil), "proto.UpdateIndexInfoTierCommand")
+	proto.RegisterExtension(E_AlterMeasurementTTLCmd_Command)
+	proto.RegisterType((*AlterMeasurementTTLCmd)(nil), "proto.AlterMeasurementTTLCmd")
+	proto.RegisterType((*AlertRuleInfo)(nil), "proto.AlertRuleInfo")
+	proto.RegisterMapType((map[string]string)(nil), "proto.AlertRuleInfo.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "proto.AlertRuleInfo.LabelsEntry")
+	proto.RegisterType((*AlertInfo)(nil), "proto.AlertInfo")
+	proto.RegisterMapType((map[string]string)(nil), "proto.AlertInfo.LabelsEntry")
+	proto.RegisterType((*AlertNotification)(nil), "proto.AlertNotification")
+	proto.RegisterType((*AlertReceiverInfo)(nil), "proto.AlertReceiverInfo")
+	proto.RegisterType((*AlertMatcher)(nil), "proto.AlertMatcher")
+	proto.RegisterType((*AlertSilenceInfo)(nil), "proto.AlertSilenceInfo")
+	proto.RegisterType((*AlertRuleState)(nil), "proto.AlertRuleState")
+	proto.RegisterExtension(E_CreateAlertRuleCommand_Command)
+	proto.RegisterType((*CreateAlertRuleCommand)(nil), "proto.CreateAlertRuleCommand")
+	proto.RegisterExtension(E_DropAlertRuleCommand_Command)
+	proto.RegisterType((*DropAlertRuleCommand)(nil), "proto.DropAlertRuleCommand")
+	proto.RegisterExtension(E_CreateAlertReceiverCommand_Command)
+	proto.RegisterType((*CreateAlertReceiverCommand)(nil), "proto.CreateAlertReceiverCommand")
+	proto.RegisterExtension(E_DropAlertReceiverCommand_Command)
+	proto.RegisterType((*DropAlertReceiverCommand)(nil), "proto.DropAlertReceiverCommand")
+	proto.RegisterExtension(E_CreateAlertSilenceCommand_Command)
+	proto.RegisterType((*CreateAlertSilenceCommand)(nil), "proto.CreateAlertSilenceCommand")
+	proto.RegisterExtension(E_DropAlertSilenceCommand_Command)
+	proto.RegisterType((*DropAlertSilenceCommand)(nil), "proto.DropAlertSilenceCommand")
+	proto.RegisterExtension(E_AlertStateReportCommand_Command)
+	proto.RegisterType((*AlertStateReportCommand)(nil), "proto.AlertStateReportCommand")
 }
 
 func init() { proto.RegisterFile("meta.proto", fileDescriptor_3b5ea8fe65782bcc) }
 
 var fileDescriptor_3b5ea8fe65782bcc = []byte{
-	// 7497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x6b, 0x8c, 0x64, 0xc7,
-	0x55, 0xb0, 0x6e, 0x3f, 0x66, 0x7a, 0x6a, 0xb6, 0x67, 0x67, 0x6b, 0x1f, 0x6e, 0x8f, 0xd7, 0xeb,
-	0xd9, 0x9b, 0xb5, 0xbd, 0xb1, 0xe3, 0x75, 0x3c, 0x4a, 0x6c, 0xc7, 0x49, 0x9c, 0xec, 0x4c, 0xef,
-	0xa3, 0xe3, 0x9d, 0x9d, 0xf6, 0xed, 0xf1, 0xee, 0xf7, 0xc5, 0x21, 0xf8, 0xce, 0x74, 0xcd, 0xcc,
-	0xcd, 0xf4, 0x74, 0xb7, 0xef, 0xbd, 0xb3, 0xbb, 0x63, 0x05, 0xc5, 0x49, 0xa4, 0xa0, 0x80, 0x10,
-	0x42, 0x88, 0xbc, 0x04, 0x01, 0x42, 0x12, 0x20, 0x10, 0x20, 0x21, 0x21, 0x0f, 0x9c, 0x80, 0xf3,
-	0x80, 0x10, 0x21, 0xfe, 0x20, 0x10, 0xbf, 0x90, 0xf8, 0x85, 0x84, 0x00, 0xc1, 0x1f, 0x1e, 0x02,
-	0x24, 0x74, 0x4e, 0xbd, 0xef, 0xad, 0x7b, 0x67, 0x77, 0x93, 0x8d, 0xf8, 0xd5, 0xb7, 0x4e, 0xbd,
-	0xce, 0x39, 0x75, 0xea, 0xd4, 0xa9, 0x3a, 0xa7, 0xaa, 0x09, 0xd9, 0x61, 0x69, 0x78, 0x66, 0x1c,
-	0x8f, 0xd2, 0x11, 0xad, 0xe3, 0x8f, 0xff, 0x1f, 0xd3, 0xa4, 0xd6, 0x0e, 0xd3, 0x90, 0x52, 0x52,
-	0x5b, 0x65, 0xf1, 0x4e, 0xcb, 0x9b, 0xaf, 0x9c, 0xae, 0x05, 0xf8, 0x4d, 0x8f, 0x90, 0x7a, 0x67,
-	0xd8, 0x67, 0x37, 0x5a, 0x15, 0x04, 0xf2, 0x04, 0x3d, 0x4e, 0xa6, 0x96, 0x06, 0xbb, 0x49, 0xca,
-	0xe2, 0x4e, 0xbb, 0x55, 0xc5, 0x1c, 0x0d, 0xa0, 0xf7, 0x93, 0xfa, 0xe5, 0x51, 0x9f, 0x25, 0xad,
-	0xda, 0x7c, 0xf5, 0xf4, 0xf4, 0xc2, 0x41, 0xde, 0xdd, 0x19, 0x80, 0x75, 0x86, 0x1b, 0xa3, 0x80,
-	0xe7, 0xd2, 0xc7, 0xc8, 0x14, 0x74, 0xbb, 0x16, 0x26, 0x2c, 0x69, 0xd5, 0xb1, 0xe8, 0x61, 0x51,
-	0x54, 0xc2, 0xb1, 0xb8, 0x2e, 0x05, 0x2d, 0x3f, 0x97, 0xb0, 0x38, 0x69, 0x4d, 0x58, 0x2d, 0x03,
-	0x8c, 0xb7, 0x8c, 0xb9, 0x80, 0xde, 0x72, 0x78, 0x03, 0xfb, 0x6b, 0xb7, 0x26, 0x39, 0x7a, 0x0a,
-	0x40, 0x4f, 0x93, 0x83, 0xcb, 0xe1, 0x8d, 0xde, 0x56, 0x18, 0xf7, 0x2f, 0xc4, 0xa3, 0xdd, 0x71,
-	0xa7, 0xdd, 0x6a, 0x60, 0x99, 0x2c, 0x98, 0x9e, 0x20, 0x44, 0x82, 0x3a, 0xed, 0xd6, 0x14, 0x16,
-	0x32, 0x20, 0xf4, 0x11, 0x4e, 0x01, 0x27, 0x96, 0x58, 0x28, 0x49, 0x78, 0xa0, 0x4b, 0x40, 0xf1,
-	0x65, 0x26, 0x8b, 0x4f, 0xbb, 0x79, 0xa3, 0x4b, 0x50, 0x9f, 0x1c, 0x10, 0x3c, 0xed, 0xa6, 0x97,
-	0x77, 0x77, 0x5a, 0x33, 0xf3, 0x95, 0xd3, 0xcd, 0xc0, 0x82, 0xd1, 0x47, 0xc9, 0x44, 0x37, 0xbd,
-	0x12, 0xb1, 0xeb, 0xad, 0x83, 0xd8, 0xde, 0x5d, 0x46, 0xf7, 0x67, 0x78, 0xce, 0xb9, 0x61, 0x1a,
-	0xef, 0x05, 0xa2, 0x18, 0x34, 0x8a, 0x35, 0xbb, 0x2c, 0x86, 0x5e, 0x5a, 0xb3, 0xf3, 0x1e, 0x34,
-	0x6a, 0xc2, 0x04, 0x83, 0x70, 0xa4, 0x25, 0x83, 0x0e, 0x29, 0x06, 0x99, 0x60, 0xc1, 0x20, 0x04,
-	0x75, 0xda, 0x2d, 0xaa, 0x18, 0x24, 0x20, 0xd0, 0xdb, 0x72, 0x78, 0xe3, 0xdc, 0x35, 0x36, 0x4c,
-	0x57, 0xc6, 0x9d, 0x7e, 0xeb, 0xf0, 0xbc, 0x77, 0xba, 0x16, 0x58, 0x30, 0xe8, 0x6d, 0x35, 0xdc,
-	0x66, 0x2b, 0xd7, 0x58, 0x7c, 0x6e, 0x18, 0xae, 0x0d, 0x58, 0xbf, 0x75, 0x64, 0xde, 0x3b, 0xdd,
-	0x08, 0xb2, 0x60, 0xfa, 0x56, 0xd2, 0x5c, 0x8e, 0x36, 0xe3, 0x30, 0x65, 0x58, 0x3b, 0x69, 0x1d,
-	0xb5, 0x68, 0x36, 0xf3, 0x90, 0x97, 0x76, 0x69, 0xe8, 0x68, 0x31, 0x1c, 0x84, 0xc3, 0x75, 0xdd,
-	0xd1, 0x31, 0xde, 0x51, 0x06, 0x2c, 0x18, 0xd0, 0x1e, 0x5d, 0x1f, 0xf6, 0xc2, 0x9d, 0xf1, 0x00,
-	0xa4, 0xe8, 0x2e, 0xc4, 0x3c, 0x0b, 0xa6, 0x0f, 0x93, 0xc9, 0x5e, 0x1a, 0xb3, 0x70, 0x27, 0x69,
-	0xb5, 0x10, 0x99, 0x43, 0x02, 0x19, 0x0e, 0x45, 0x34, 0x64, 0x09, 0x3a, 0x4f, 0xa6, 0x41, 0x78,
-	0x78, 0x4e, 0xbb, 0x75, 0x37, 0x36, 0x69, 0x82, 0x84, 0xe0, 0x2e, 0x8d, 0x86, 0xc3, 0x4e, 0xbf,
-	0x35, 0x87, 0xf9, 0x1a, 0x40, 0x9f, 0x26, 0xd3, 0xcf, 0xee, 0xb2, 0x78, 0xaf, 0xd3, 0xee, 0x0c,
-	0xa3, 0xb4, 0x75, 0x0f, 0x76, 0x78, 0xdc, 0x1c, 0x71, 0x23, 0x9b, 0x0f, 0xbb, 0x59, 0x81, 0xb6,
-	0x49, 0x33, 0x60, 0xe3, 0x41, 0xb4, 0x1e, 0xe2, 0xf8, 0x25, 0xad, 0xe3, 0xd8, 0xc2, 0x09, 0xb3,
-	0x05, 0xab, 0x00, 0x6f, 0xc3, 0xae, 0x44, 0x5f, 0x47, 0x0e, 0x01, 0xca, 0xbb, 0x6b, 0xc9, 0x7a,
-	0x1c, 0x8d, 0xd3, 0x68, 0x34, 0xec, 0xb4, 0x5b, 0xf7, 0x22, 0xae, 0xf9, 0x0c, 0x7a, 0x8a, 0x34,
-	0x81, 0x80, 0x67, 0x97, 0xb6, 0xc2, 0xe1, 0x26, 0x30, 0xf2, 0x04, 0x96, 0xb4, 0x81, 0xc0, 0x99,
-	0xcb, 0xbb, 0x3b, 0x2b, 0x1b, 0x38, 0xb1, 0x92, 0xd6, 0x7d, 0xf3, 0xde, 0xe9, 0x7a, 0x60, 0x82,
-	0x60, 0x48, 0x3a, 0x49, 0xef, 0xd9, 0x4b, 0x51, 0xca, 0xe4, 0xe0, 0xcd, 0xf3, 0xc1, 0xcb, 0x80,
-	0xe9, 0xc3, 0xa4, 0xd1, 0x7b, 0x71, 0xc0, 0x27, 0xd9, 0x49, 0xf7, 0x9c, 0x54, 0x05, 0xe8, 0x1c,
-	0x69, 0x2c, 0x87, 0x37, 0x96, 0x93, 0xb4, 0xd3, 0x6e, 0xf9, 0x88, 0x99, 0x4a, 0xcf, 0xbd, 0x83,
-	0x4c, 0x1b, 0x33, 0x88, 0xce, 0x92, 0xea, 0x36, 0xdb, 0x6b, 0x79, 0xf3, 0xde, 0xe9, 0xa9, 0x00,
-	0x3e, 0x41, 0x1b, 0x5d, 0x0b, 0x07, 0xbb, 0xac, 0x55, 0x99, 0xf7, 0xcc, 0x6e, 0x16, 0xbb, 0x5c,
-	0xfe, 0x78, 0xee, 0x53, 0x95, 0x27, 0xbd, 0xb9, 0xa7, 0xc9, 0x6c, 0x76, 0x6c, 0x1c, 0x0d, 0x1e,
-	0x31, 0x1b, 0xac, 0x99, 0xf5, 0x9f, 0x23, 0x34, 0x3f, 0x32, 0x8e, 0x16, 0x5e, 0x6b, 0xa3, 0x24,
-	0xf5, 0xa9, 0xa8, 0x0b, 0x63, 0x92, 0x18, 0xcd, 0xfa, 0x6f, 0x26, 0x07, 0xcc, 0x2c, 0xfa, 0x30,
-	0x99, 0x10, 0xa2, 0xe1, 0x59, 0xfa, 0xd8, 0xec, 0x3b, 0x10, 0x45, 0xfc, 0x0f, 0x7b, 0xaa, 0x36,
-	0x42, 0xe8, 0x0c, 0xa9, 0x74, 0xda, 0xb8, 0x7a, 0x34, 0x83, 0x4a, 0xa7, 0xcd, 0x99, 0x2b, 0x16,
-	0x89, 0x0a, 0x42, 0x55, 0x9a, 0x9e, 0x24, 0xf5, 0x2e, 0x03, 0x4d, 0x5e, 0xc5, 0x8e, 0xa6, 0x45,
-	0x47, 0x00, 0x0b, 0x78, 0x0e, 0x3d, 0x46, 0x26, 0x7a, 0x69, 0x98, 0xee, 0xc2, 0x3a, 0x02, 0x95,
-	0x45, 0x4a, 0x2d, 0x53, 0x75, 0xbd, 0x4c, 0xf9, 0x0f, 0x91, 0x1a, 0x54, 0xca, 0xa1, 0x40, 0x49,
-	0x2d, 0x18, 0x0d, 0x98, 0xe8, 0x1e, 0xbf, 0xfd, 0x93, 0x64, 0xb2, 0x9b, 0xae, 0x5c, 0x1f, 0xb2,
-	0x18, 0xba, 0x10, 0xab, 0x04, 0x5f, 0xf3, 0x44, 0xca, 0x7f, 0xd9, 0x03, 0xbd, 0x0a, 0x83, 0x48,
-	0x4f, 0x91, 0x3a, 0x96, 0xc5, 0x12, 0xd3, 0x0b, 0x33, 0x12, 0x51, 0xde, 0x42, 0x50, 0x57, 0x0d,
-	0x09, 0x5c, 0x2b, 0x59, 0x5c, 0xbb, 0x69, 0xa7, 0x8f, 0x6b, 0x64, 0x33, 0xc0, 0x6f, 0x18, 0xb5,
-	0x2b, 0x2c, 0x6e, 0xd5, 0x70, 0x8c, 0xe1, 0x13, 0xb1, 0xbc, 0xd0, 0x69, 0xb7, 0xea, 0xa8, 0x8c,
-	0xf1, 0xdb, 0x7f, 0x84, 0x34, 0xa4, 0x20, 0xd1, 0x93, 0xa4, 0xd6, 0x5e, 0xeb, 0xa6, 0x62, 0x50,
-	0x9a, 0x0a, 0x05, 0x94, 0x32, 0xcc, 0xf2, 0xff, 0xd9, 0x23, 0x0d, 0xb9, 0x88, 0x18, 0x5c, 0xa8,
-	0x49, 0x2e, 0x5c, 0x1c, 0x25, 0x29, 0xe2, 0x36, 0x15, 0xe0, 0x37, 0x6d, 0x91, 0xc9, 0xa0, 0xbb,
-	0x74, 0xb6, 0xdf, 0x8f, 0xb1, 0xdb, 0xa9, 0x40, 0x26, 0x21, 0x67, 0x75, 0xa9, 0x8b, 0x15, 0xaa,
-	0x3c, 0x47, 0x24, 0x33, 0x23, 0x52, 0x55, 0x54, 0x1e, 0x21, 0xf5, 0x4b, 0xab, 0xd1, 0x0e, 0x6b,
-	0x4d, 0x70, 0x23, 0x01, 0x13, 0xb0, 0x38, 0x5c, 0x18, 0x25, 0x49, 0x34, 0xc6, 0x4e, 0x26, 0xb1,
-	0x6f, 0x03, 0x02, 0x53, 0xba, 0xc7, 0x36, 0x63, 0xb6, 0x19, 0xa6, 0x4c, 0x34, 0xdb, 0xe0, 0x5a,
-	0x36, 0x03, 0x56, 0xa3, 0x48, 0x10, 0x1d, 0x3e, 0x8a, 0xbb, 0xa4, 0x21, 0xe7, 0x33, 0xbd, 0x8f,
-	0x54, 0x2e, 0x47, 0x62, 0x80, 0x72, 0x2b, 0x6a, 0xe5, 0x72, 0x04, 0x88, 0xa3, 0x0e, 0x6d, 0x8b,
-	0x99, 0x25, 0x52, 0xa0, 0x77, 0xce, 0x0e, 0xa2, 0x6b, 0x4c, 0x64, 0x56, 0xb9, 0x46, 0x36, 0x40,
-	0xc0, 0xca, 0xb3, 0x2f, 0xe1, 0x58, 0x4d, 0x05, 0x95, 0xb3, 0x2f, 0xf9, 0x5f, 0xac, 0x92, 0x03,
-	0xa6, 0x75, 0x02, 0xb8, 0x5d, 0x0e, 0x77, 0x18, 0xf6, 0x3e, 0x15, 0xe0, 0x37, 0x7d, 0x9c, 0x1c,
-	0x6b, 0xb3, 0x8d, 0x70, 0x77, 0x90, 0x06, 0x2c, 0x65, 0x43, 0x98, 0x5b, 0xdd, 0xd1, 0x20, 0x5a,
-	0xdf, 0x13, 0x23, 0x50, 0x90, 0x4b, 0x2f, 0x92, 0x43, 0x36, 0x28, 0x62, 0x72, 0x82, 0xcc, 0xa9,
-	0x99, 0x68, 0x55, 0x41, 0x0a, 0xf3, 0x95, 0xa0, 0xa5, 0xa5, 0xd1, 0x30, 0x8d, 0x86, 0xbb, 0xa3,
-	0xdd, 0x04, 0x34, 0x4f, 0xa4, 0xcc, 0x31, 0xd9, 0x92, 0x9d, 0x2f, 0x5a, 0xca, 0x55, 0xe2, 0x8b,
-	0x56, 0xbc, 0xdd, 0x66, 0x03, 0x96, 0xb2, 0x3e, 0xca, 0x4a, 0x23, 0x30, 0x41, 0xf4, 0x51, 0xd2,
-	0x40, 0x25, 0xfd, 0x0c, 0xdb, 0x6b, 0x4d, 0x58, 0x6a, 0x47, 0x82, 0xb1, 0x6d, 0x55, 0x88, 0x3e,
-	0x40, 0x66, 0xb8, 0xb2, 0x5e, 0x0d, 0x37, 0xcf, 0xc6, 0x71, 0xb8, 0xd7, 0x9a, 0xc4, 0x56, 0x33,
-	0x50, 0xd0, 0x1f, 0x42, 0xbf, 0x5c, 0x46, 0xc9, 0xa8, 0x06, 0x2a, 0x0d, 0x0b, 0xef, 0x0a, 0xae,
-	0x31, 0x60, 0x05, 0x78, 0xc6, 0xc2, 0xbb, 0xb2, 0x96, 0x88, 0x8c, 0x40, 0x96, 0xf0, 0xbf, 0xec,
-	0x91, 0xc3, 0x19, 0xc6, 0xf5, 0xc6, 0x6c, 0xdd, 0x18, 0x3b, 0x4f, 0x8d, 0xdd, 0x1c, 0x69, 0xb4,
-	0x77, 0x63, 0xd4, 0x87, 0x28, 0x2c, 0xd5, 0x40, 0xa5, 0xe9, 0x19, 0x42, 0xb5, 0x7d, 0xa8, 0x4a,
-	0x55, 0xb1, 0x94, 0x23, 0xc7, 0x22, 0xa0, 0x86, 0x73, 0x5b, 0x13, 0xe0, 0x93, 0x03, 0x57, 0xc3,
-	0x78, 0x47, 0xb5, 0x52, 0xc7, 0x56, 0x2c, 0x98, 0xff, 0xf7, 0x13, 0xe4, 0xe0, 0x32, 0x0b, 0x93,
-	0xdd, 0x98, 0xed, 0x08, 0xa3, 0xc6, 0x29, 0x6f, 0x8f, 0x91, 0x29, 0xc9, 0x5c, 0x50, 0x40, 0xd5,
-	0xa2, 0x21, 0xd0, 0xa5, 0xe8, 0x53, 0x64, 0xa2, 0xb7, 0xbe, 0xc5, 0x76, 0x42, 0x21, 0x5f, 0xbe,
-	0x34, 0xa2, 0xec, 0xee, 0xce, 0xf0, 0x42, 0xc2, 0x86, 0xe4, 0x89, 0xac, 0x48, 0xd4, 0xf2, 0x22,
-	0xf1, 0x14, 0x69, 0x46, 0x60, 0x02, 0x06, 0x6c, 0xa0, 0xa9, 0x9b, 0x5e, 0x38, 0x22, 0x3a, 0xe9,
-	0x98, 0x79, 0x81, 0x5d, 0x14, 0xd4, 0xc6, 0xb9, 0xe1, 0x66, 0x34, 0x64, 0xab, 0x7b, 0x63, 0x86,
-	0x02, 0xd5, 0x0c, 0x0c, 0x08, 0x7d, 0x82, 0x1c, 0x58, 0x1a, 0x0d, 0x7a, 0xe9, 0x28, 0xc6, 0x09,
-	0x88, 0xb2, 0xa3, 0xe9, 0x35, 0xb3, 0x02, 0xab, 0x20, 0x7d, 0x8c, 0x10, 0x2d, 0x1c, 0x28, 0x50,
-	0x4e, 0xa9, 0x31, 0x0a, 0xd1, 0xf3, 0x84, 0x70, 0x5b, 0xbf, 0x7f, 0x83, 0x25, 0xad, 0x29, 0xe4,
-	0xd4, 0x03, 0x45, 0x9c, 0x52, 0x05, 0x39, 0xb7, 0x8c, 0x9a, 0x68, 0xbd, 0x0c, 0xa3, 0xd4, 0xb4,
-	0x71, 0x08, 0xda, 0x38, 0x59, 0xb0, 0x50, 0xdd, 0xd3, 0xa8, 0x88, 0x2a, 0xb8, 0x59, 0xc9, 0xc8,
-	0xb9, 0x5c, 0x80, 0xb2, 0x42, 0x4e, 0x9f, 0x27, 0x87, 0xf8, 0xf8, 0x3c, 0x97, 0xb0, 0xf3, 0xa3,
-	0x78, 0x69, 0xc0, 0xc2, 0x61, 0xeb, 0x18, 0xa2, 0xfc, 0x48, 0xe9, 0xe0, 0x1a, 0xe5, 0x39, 0xe6,
-	0xf9, 0x76, 0xe6, 0xde, 0x44, 0xa6, 0x0d, 0x49, 0xd8, 0xcf, 0x74, 0xa9, 0x9b, 0xa6, 0xcb, 0x33,
-	0xe4, 0x60, 0x86, 0x35, 0x66, 0xf5, 0x1a, 0xaf, 0xee, 0xdb, 0x76, 0xcb, 0x01, 0x29, 0x28, 0x50,
-	0xc7, 0x6c, 0xec, 0x0a, 0x39, 0xe6, 0x46, 0xda, 0x81, 0xd2, 0x03, 0x76, 0x9b, 0xb3, 0x72, 0x46,
-	0x60, 0xfd, 0x2b, 0xe1, 0xc0, 0x34, 0x84, 0x9e, 0x20, 0x53, 0x0a, 0x0e, 0x4d, 0xad, 0xee, 0x8d,
-	0x71, 0x86, 0xd5, 0x03, 0xf8, 0x84, 0x25, 0xf1, 0xdc, 0xb0, 0x8f, 0x4b, 0x1c, 0xa7, 0x4f, 0x26,
-	0xfd, 0x7f, 0xab, 0xe7, 0x54, 0x4b, 0xe1, 0x34, 0xb5, 0x55, 0x4b, 0xe5, 0xa6, 0x54, 0x4b, 0xe5,
-	0xa6, 0x54, 0x4b, 0xc5, 0x52, 0x2d, 0x4f, 0x91, 0x03, 0xc6, 0x48, 0xcb, 0xbd, 0xf5, 0x31, 0xb7,
-	0x10, 0x04, 0x56, 0x59, 0xba, 0x4c, 0xa6, 0x97, 0x93, 0xf4, 0x0a, 0x8b, 0x13, 0x94, 0xb9, 0x19,
-	0xac, 0xfa, 0x70, 0xf1, 0xe2, 0x73, 0xc6, 0x28, 0x2d, 0xb6, 0x1c, 0x06, 0x84, 0x3e, 0x41, 0xa6,
-	0x35, 0xf2, 0x72, 0xdb, 0x7e, 0xd4, 0xd4, 0x4d, 0x7c, 0x2b, 0x09, 0x88, 0x98, 0x25, 0x61, 0xaf,
-	0x67, 0xee, 0x24, 0x92, 0xd6, 0xa4, 0xb5, 0xd7, 0xb3, 0x76, 0x19, 0xb8, 0xd7, 0xb3, 0x4a, 0x67,
-	0x55, 0x54, 0x23, 0xaf, 0xa2, 0xe6, 0xc9, 0xf4, 0xc5, 0x51, 0xaa, 0x38, 0x3d, 0x85, 0x9c, 0x36,
-	0x41, 0x39, 0x0d, 0x4d, 0xb0, 0x88, 0x05, 0x83, 0x61, 0xd3, 0x1b, 0x62, 0x55, 0x72, 0x9a, 0x0f,
-	0x5b, 0x3e, 0x07, 0xf8, 0xa1, 0xa1, 0x49, 0xeb, 0x80, 0xc5, 0x0f, 0x63, 0x6b, 0x8d, 0xfc, 0x30,
-	0x4a, 0xd2, 0x15, 0x72, 0x44, 0x6f, 0x3c, 0x35, 0xfb, 0x5b, 0x4d, 0x94, 0xed, 0x7b, 0xe4, 0xd6,
-	0xc3, 0x51, 0x24, 0x70, 0x56, 0x84, 0x1d, 0x49, 0x76, 0xe8, 0xf6, 0x9b, 0xd6, 0x4d, 0x73, 0xc6,
-	0x84, 0xe4, 0xb0, 0xc3, 0x82, 0x70, 0xca, 0xfd, 0x11, 0x52, 0xc7, 0x02, 0xc2, 0xfa, 0xe1, 0x09,
-	0x18, 0x80, 0x4b, 0x61, 0x92, 0x06, 0xbb, 0x43, 0x9c, 0x57, 0x7c, 0x15, 0x35, 0x41, 0xfe, 0x7f,
-	0x7b, 0x64, 0xc6, 0x96, 0x91, 0x9c, 0x65, 0x7b, 0x9c, 0x4c, 0xf5, 0xd2, 0x30, 0x4e, 0xc5, 0xd4,
-	0x04, 0xb6, 0x6b, 0x80, 0x39, 0x6d, 0xf9, 0x4c, 0x92, 0x49, 0xa8, 0x27, 0x04, 0xe1, 0x6c, 0x2a,
-	0x8c, 0x59, 0x0d, 0xa0, 0xa7, 0xc9, 0x84, 0xd0, 0xd2, 0x7c, 0xea, 0xcc, 0x9a, 0x02, 0x8b, 0x3c,
-	0x15, 0xf9, 0x40, 0xc4, 0x6a, 0xbc, 0x3b, 0x5c, 0x0f, 0x79, 0x4b, 0x13, 0x9c, 0x08, 0x03, 0x94,
-	0x59, 0xce, 0x26, 0x73, 0xcb, 0x59, 0x8b, 0x4c, 0x5e, 0xe3, 0x83, 0xd0, 0x3a, 0x80, 0x99, 0x32,
-	0xe9, 0x7f, 0xb4, 0x22, 0x96, 0x75, 0x27, 0xe5, 0x27, 0x48, 0x03, 0xb7, 0x1e, 0x9d, 0x36, 0x5f,
-	0xf2, 0x9b, 0x8b, 0x95, 0x96, 0x17, 0x28, 0x18, 0x8c, 0xe5, 0x72, 0xc4, 0x35, 0xc8, 0x54, 0x00,
-	0x9f, 0x08, 0x09, 0x6f, 0x20, 0xb5, 0x00, 0x09, 0x6f, 0xe0, 0x4e, 0x2a, 0x62, 0xb1, 0xda, 0x49,
-	0x45, 0x0c, 0xad, 0x7f, 0x79, 0x9e, 0xc3, 0xad, 0x79, 0x99, 0x84, 0x45, 0x4c, 0x4b, 0xd2, 0x25,
-	0x76, 0x8d, 0x0d, 0xd0, 0xa8, 0xaf, 0x06, 0x59, 0x30, 0xcc, 0x1c, 0xeb, 0xf0, 0x84, 0x9b, 0xf5,
-	0x16, 0x8c, 0x2b, 0xb0, 0xb0, 0xbf, 0x32, 0x1c, 0xec, 0xb5, 0xa6, 0x70, 0x7a, 0xaa, 0x34, 0x3f,
-	0x56, 0x92, 0x53, 0x15, 0x57, 0xca, 0x46, 0x60, 0x40, 0xfc, 0x80, 0x1c, 0x30, 0xed, 0x1a, 0x68,
-	0x4b, 0x59, 0xa0, 0xb0, 0x47, 0x9a, 0x32, 0x8c, 0x4d, 0xa0, 0x11, 0x38, 0x5f, 0xe1, 0x36, 0x1e,
-	0xf2, 0x9c, 0x92, 0x5a, 0x6f, 0x53, 0xd9, 0xfb, 0xf8, 0xed, 0xdf, 0x4d, 0xea, 0x7c, 0xad, 0x9e,
-	0x25, 0xd5, 0x4e, 0xff, 0x06, 0xb6, 0x53, 0x0f, 0xe0, 0xd3, 0x7f, 0x37, 0x99, 0xcd, 0xea, 0x1b,
-	0xa7, 0x9c, 0x53, 0x52, 0x5b, 0x1e, 0xf5, 0x99, 0xdc, 0x66, 0xc1, 0x37, 0xb2, 0x82, 0x25, 0x69,
-	0x34, 0xe4, 0x3b, 0x6c, 0xb4, 0xb6, 0xa6, 0x02, 0x0b, 0xe6, 0x9f, 0x12, 0x56, 0x46, 0xf9, 0x9e,
-	0xf4, 0x23, 0x1e, 0x69, 0xc8, 0x83, 0xce, 0xa2, 0xee, 0x2f, 0x86, 0xc9, 0x96, 0xda, 0xe5, 0x85,
-	0xc9, 0x16, 0x4c, 0xbd, 0xb3, 0xfd, 0x1d, 0x21, 0x07, 0x8d, 0x80, 0x27, 0xa0, 0x8b, 0xe0, 0x3a,
-	0xb4, 0x25, 0x6c, 0x37, 0x91, 0xa2, 0x6f, 0x20, 0xa4, 0x1b, 0x47, 0xd7, 0xa2, 0x01, 0xdb, 0x54,
-	0x47, 0xb2, 0x47, 0x8c, 0x33, 0x56, 0x95, 0x19, 0x18, 0xe5, 0xfc, 0x0e, 0x69, 0x5a, 0x99, 0xb8,
-	0xce, 0x89, 0x2d, 0x92, 0x40, 0x50, 0xa5, 0x61, 0xe2, 0xa9, 0x82, 0x88, 0x69, 0x3d, 0xd0, 0x00,
-	0xff, 0x15, 0x8f, 0x34, 0x2d, 0xe3, 0x10, 0x46, 0x23, 0x88, 0xfa, 0x62, 0x47, 0x0f, 0x9f, 0x00,
-	0x59, 0x89, 0xfa, 0x5c, 0xe6, 0x03, 0xf8, 0x84, 0x36, 0xb1, 0x12, 0x72, 0x84, 0x33, 0x58, 0x03,
-	0xe8, 0xeb, 0x09, 0xc1, 0xc4, 0xa5, 0x28, 0x49, 0xe5, 0x1e, 0x68, 0xd6, 0xd4, 0xb8, 0x90, 0x11,
-	0x18, 0x65, 0xc0, 0xc2, 0xc4, 0x94, 0x34, 0xbc, 0xec, 0xb3, 0x69, 0x33, 0x2b, 0xb0, 0x0a, 0xfa,
-	0x27, 0x05, 0x22, 0xd0, 0x0c, 0x9e, 0x9c, 0xc3, 0x87, 0x90, 0x48, 0x9e, 0xf0, 0xfb, 0xa4, 0x15,
-	0x8c, 0xcd, 0x15, 0xf7, 0x7c, 0xc4, 0x06, 0xfd, 0x04, 0x07, 0xf5, 0x22, 0x99, 0xcd, 0x2c, 0xce,
-	0xf2, 0x1c, 0xe6, 0x78, 0x7e, 0xed, 0xd6, 0xf5, 0x82, 0x5c, 0x2d, 0x7f, 0x44, 0x8e, 0x3a, 0x8b,
-	0xc2, 0xec, 0x5e, 0x4e, 0x52, 0x43, 0x74, 0x64, 0x92, 0xbe, 0x85, 0x10, 0x98, 0x1b, 0xbc, 0xac,
-	0xd8, 0x44, 0x38, 0xba, 0xd5, 0x65, 0x02, 0xa3, 0xbc, 0xbf, 0x64, 0x75, 0xa8, 0x33, 0x40, 0xd4,
-	0x44, 0x93, 0x9c, 0x0d, 0x22, 0x65, 0x4c, 0x4b, 0xd0, 0x20, 0xf8, 0xed, 0xbf, 0x5a, 0x21, 0x44,
-	0x9f, 0x9b, 0x3a, 0x65, 0x9c, 0x6b, 0xc1, 0x8a, 0xd2, 0x82, 0x6f, 0x20, 0x13, 0xbd, 0x78, 0x7d,
-	0x19, 0x8f, 0x2a, 0x2a, 0x06, 0xc6, 0xbc, 0x99, 0xac, 0xa9, 0x23, 0xca, 0x42, 0xad, 0x36, 0x4b,
-	0xa0, 0x56, 0xed, 0x66, 0x6a, 0xf1, 0xb2, 0x20, 0xd6, 0x9d, 0x61, 0xca, 0xe2, 0x6b, 0xe1, 0x00,
-	0x35, 0x66, 0x35, 0x50, 0x69, 0x18, 0xec, 0x36, 0x1b, 0x84, 0x7b, 0xa8, 0x33, 0xab, 0x01, 0x4f,
-	0x00, 0x05, 0xed, 0x68, 0x87, 0xdb, 0x2e, 0x53, 0x01, 0x7e, 0xd3, 0x07, 0x49, 0x7d, 0x29, 0x1c,
-	0x0c, 0x60, 0x03, 0x92, 0x3f, 0x2f, 0x86, 0x9c, 0x80, 0xe7, 0x43, 0xe5, 0xa5, 0xd1, 0xb0, 0x8f,
-	0xca, 0x71, 0x2a, 0xc0, 0x6f, 0x58, 0x6e, 0x3a, 0x49, 0x8f, 0x0d, 0xd8, 0x7a, 0x7a, 0x76, 0x30,
-	0x10, 0x9a, 0xd1, 0x04, 0xf9, 0x8f, 0x93, 0x69, 0xcd, 0x42, 0xec, 0xcd, 0x94, 0x23, 0xc7, 0xe9,
-	0x34, 0xcf, 0xf7, 0x5f, 0x24, 0x47, 0x9d, 0xd4, 0x17, 0x1a, 0xb2, 0x72, 0x82, 0x57, 0x32, 0x13,
-	0xfc, 0x34, 0x39, 0x98, 0x3d, 0xf4, 0xe0, 0x6b, 0x50, 0x16, 0xec, 0x5f, 0x92, 0xa3, 0x0d, 0xf4,
-	0x22, 0xb9, 0xe1, 0x60, 0x20, 0xfb, 0x41, 0xd8, 0x11, 0x52, 0x47, 0x71, 0x91, 0x86, 0x03, 0x26,
-	0x50, 0xa7, 0x0d, 0xa2, 0x30, 0x11, 0xed, 0xf2, 0x84, 0xff, 0x0f, 0x9e, 0xbd, 0x2f, 0x84, 0x45,
-	0xa4, 0x1b, 0x47, 0x3b, 0x61, 0xbc, 0xa7, 0x97, 0x05, 0x03, 0x02, 0x53, 0xa1, 0x37, 0x8a, 0x53,
-	0xc8, 0xac, 0x60, 0xa6, 0x4c, 0x02, 0x97, 0xbb, 0xf1, 0x68, 0xcc, 0xe2, 0x14, 0xab, 0x72, 0x8d,
-	0x62, 0x82, 0xe8, 0x29, 0xd2, 0x94, 0xc9, 0x2b, 0x68, 0x1e, 0xd5, 0xb0, 0x8c, 0x0d, 0xa4, 0xaf,
-	0x27, 0x87, 0xc1, 0xd8, 0x10, 0x0e, 0x9b, 0xcc, 0x4e, 0xdf, 0x95, 0x45, 0x1f, 0x20, 0x33, 0x4b,
-	0xa3, 0x9d, 0x71, 0xb8, 0x0e, 0x29, 0xb5, 0xff, 0xad, 0x07, 0x19, 0xa8, 0x7f, 0x5d, 0x98, 0x91,
-	0x5c, 0xf1, 0xc0, 0x24, 0x5b, 0x1d, 0x6d, 0xb3, 0x61, 0x22, 0x4c, 0x37, 0x91, 0x02, 0x16, 0xe0,
-	0x57, 0xf4, 0x12, 0x8b, 0x13, 0xb1, 0x02, 0x1a, 0x90, 0x22, 0x04, 0xab, 0x85, 0x08, 0xfa, 0x4f,
-	0xda, 0xaa, 0x91, 0x9e, 0xb6, 0xe5, 0x8b, 0xe6, 0x75, 0xa4, 0x14, 0xb0, 0x97, 0x29, 0x99, 0x5c,
-	0x1a, 0xed, 0xec, 0x84, 0xc3, 0x3e, 0x7d, 0x90, 0xd4, 0x52, 0x20, 0x0e, 0xc6, 0x7a, 0xc6, 0xd8,
-	0xba, 0x63, 0xee, 0x19, 0xa0, 0x30, 0xc0, 0x02, 0xfe, 0xab, 0x87, 0xb8, 0x9a, 0xa0, 0x77, 0x93,
-	0xa3, 0x4b, 0x31, 0x0b, 0x53, 0x26, 0xe5, 0x4c, 0x14, 0x9e, 0xad, 0xd2, 0xbb, 0xc8, 0xe1, 0x76,
-	0x3c, 0x1a, 0x67, 0x33, 0x6a, 0x74, 0x9e, 0x1c, 0xe7, 0x75, 0x32, 0x82, 0x27, 0x4b, 0xd4, 0xe9,
-	0x09, 0x32, 0x07, 0x55, 0x0b, 0xf2, 0x27, 0xe8, 0x29, 0x32, 0xdf, 0x63, 0xa9, 0xfb, 0xb0, 0x4e,
-	0x96, 0x9a, 0x84, 0x7e, 0x9e, 0x1b, 0xf7, 0x8b, 0xfb, 0x69, 0xd0, 0x7b, 0xc8, 0x5d, 0x1c, 0x13,
-	0x6d, 0xcd, 0xca, 0xcc, 0x29, 0xc8, 0xe4, 0x66, 0x4d, 0x3e, 0x93, 0xd0, 0xa3, 0xe4, 0x10, 0xaf,
-	0x09, 0x2b, 0xac, 0x04, 0x37, 0xe9, 0x61, 0x72, 0x10, 0x10, 0x37, 0x81, 0x33, 0x50, 0x96, 0xe3,
-	0x61, 0x82, 0x0f, 0x02, 0x7f, 0x7a, 0x2c, 0x55, 0x6b, 0xac, 0xcc, 0x98, 0xa5, 0x94, 0xcc, 0x00,
-	0x75, 0x61, 0x1a, 0x4a, 0xd8, 0x21, 0x7a, 0x9c, 0xb4, 0x7a, 0x2c, 0x45, 0x2b, 0x21, 0x57, 0x83,
-	0xd2, 0x7b, 0xc9, 0xdd, 0x82, 0x0e, 0xc3, 0x1c, 0x92, 0xd9, 0x47, 0x91, 0x92, 0x78, 0x34, 0x76,
-	0x65, 0x1e, 0xd3, 0x23, 0x28, 0x1d, 0x9c, 0x32, 0xab, 0x65, 0x0f, 0xae, 0x99, 0x75, 0x37, 0x64,
-	0x71, 0x9a, 0xb2, 0x59, 0x73, 0x90, 0xc5, 0xf9, 0x96, 0x6d, 0xf0, 0x1e, 0x9d, 0x95, 0xad, 0x75,
-	0x9c, 0x1e, 0x23, 0xb4, 0xc7, 0xd2, 0x6c, 0x95, 0x7b, 0xe9, 0x11, 0x32, 0x8b, 0xb8, 0xc3, 0x18,
-	0x48, 0xe8, 0x09, 0x20, 0x18, 0xcd, 0x4e, 0x21, 0x5b, 0xbc, 0x51, 0x99, 0x7d, 0x1f, 0x10, 0xcc,
-	0xb1, 0xd3, 0xe6, 0x9b, 0xcc, 0x7c, 0x0d, 0x08, 0x0f, 0xd4, 0xcd, 0x08, 0x85, 0xdd, 0xc4, 0x83,
-	0xc0, 0x70, 0xc9, 0x16, 0xa5, 0x77, 0x65, 0xee, 0x63, 0x80, 0xd5, 0xd9, 0x41, 0xca, 0x62, 0x69,
-	0xcd, 0x2e, 0xed, 0xf4, 0x67, 0x17, 0x60, 0xa0, 0x03, 0xde, 0x65, 0x34, 0xdc, 0x94, 0x85, 0xdf,
-	0x00, 0x03, 0x2d, 0xb0, 0xc1, 0x93, 0x0c, 0x99, 0xf1, 0x46, 0xc8, 0x08, 0xd8, 0x78, 0x14, 0xa7,
-	0x7c, 0xd3, 0x22, 0x33, 0x1e, 0x07, 0x66, 0x74, 0xe3, 0xdd, 0x21, 0xe3, 0x7b, 0x4c, 0x09, 0x7f,
-	0x13, 0x48, 0x34, 0xa0, 0x6e, 0xa0, 0x64, 0xa3, 0xfd, 0x14, 0x9d, 0x23, 0xc7, 0x80, 0x5d, 0x0e,
-	0xa4, 0xdf, 0x0c, 0x48, 0x83, 0xea, 0x08, 0xc2, 0xa1, 0x96, 0x9d, 0xb7, 0xd0, 0x16, 0x39, 0x82,
-	0xdd, 0x4b, 0x55, 0x22, 0x73, 0xde, 0xaa, 0x27, 0x80, 0xde, 0xef, 0xca, 0xcc, 0xa7, 0x61, 0x8a,
-	0x1a, 0x2c, 0x06, 0x55, 0x02, 0xbb, 0x14, 0x99, 0xff, 0x36, 0x3d, 0x04, 0x30, 0x9c, 0xdc, 0x5d,
-	0x20, 0x33, 0xdf, 0x0e, 0xf4, 0x71, 0xe6, 0xa2, 0x07, 0x58, 0xc2, 0xcf, 0x02, 0x9c, 0x57, 0xb2,
-	0xe0, 0x8b, 0x9a, 0x83, 0xdc, 0xb5, 0x22, 0x33, 0x96, 0xa0, 0x42, 0xc0, 0x76, 0x46, 0xd7, 0xec,
-	0x0a, 0x6d, 0x7a, 0x92, 0xdc, 0x2b, 0x24, 0x37, 0xb3, 0xc5, 0x96, 0x45, 0xce, 0xd1, 0xfb, 0xc8,
-	0x3d, 0xa8, 0x9e, 0x0a, 0x0a, 0x9c, 0x07, 0x0a, 0x2f, 0xb0, 0xb4, 0x28, 0xff, 0x82, 0x31, 0x3b,
-	0xd6, 0xb8, 0x3b, 0x52, 0x66, 0x5d, 0xa4, 0xaf, 0x25, 0xf7, 0x5f, 0x00, 0x61, 0xb6, 0x56, 0xec,
-	0xab, 0x51, 0xba, 0x15, 0x41, 0x5b, 0x2c, 0x50, 0x7c, 0xec, 0x80, 0x34, 0x1a, 0x7c, 0x34, 0x76,
-	0x62, 0x06, 0x9d, 0xef, 0x00, 0x06, 0xc0, 0xc0, 0xaf, 0x86, 0xdb, 0x6c, 0x74, 0x4d, 0xb3, 0xf9,
-	0x19, 0x99, 0x21, 0x1d, 0xe5, 0x32, 0xe3, 0x12, 0x64, 0x08, 0x95, 0xc0, 0x97, 0x72, 0x91, 0xb1,
-	0x0c, 0x42, 0x8a, 0x13, 0xca, 0x02, 0x5f, 0xa6, 0x3e, 0x39, 0x91, 0x47, 0x19, 0x17, 0x6d, 0x59,
-	0x66, 0x05, 0x28, 0xbe, 0xc2, 0xe2, 0x68, 0x63, 0x2f, 0x3b, 0x7d, 0xbb, 0xd0, 0xdd, 0xb9, 0x1b,
-	0xe3, 0x70, 0xd8, 0xb7, 0x45, 0xf6, 0x59, 0x10, 0x48, 0x39, 0x74, 0xe2, 0x4c, 0x43, 0xe6, 0x05,
-	0xd0, 0x1e, 0x70, 0x78, 0x71, 0x31, 0x8e, 0xd8, 0x86, 0x49, 0x70, 0x4f, 0x30, 0xdf, 0xb4, 0xc7,
-	0xcd, 0xfc, 0x55, 0x98, 0x09, 0x01, 0xdb, 0x8c, 0x60, 0x0d, 0x14, 0xfe, 0xdb, 0x95, 0x8d, 0x8d,
-	0x84, 0x29, 0x11, 0x78, 0x4e, 0xaf, 0x32, 0x99, 0xd3, 0x10, 0x59, 0xe2, 0x0a, 0xea, 0xd4, 0x17,
-	0x07, 0x0b, 0xa0, 0x73, 0x2e, 0xb2, 0x30, 0x4e, 0xd7, 0x58, 0xa8, 0xea, 0x5f, 0xc5, 0xfa, 0x76,
-	0x4d, 0x3e, 0x57, 0x65, 0x89, 0xff, 0x27, 0x58, 0x96, 0x29, 0x74, 0x89, 0x19, 0x6b, 0xdd, 0xff,
-	0x97, 0x2b, 0x59, 0x01, 0x0e, 0xef, 0x04, 0x29, 0xbc, 0x3c, 0x4a, 0xa3, 0x8d, 0xbd, 0xa5, 0x67,
-	0x79, 0x4d, 0xf4, 0xbc, 0x2b, 0x4d, 0xf7, 0x3c, 0x48, 0x72, 0x8f, 0xa5, 0x38, 0x89, 0x6c, 0xe7,
-	0x9b, 0x2c, 0xf2, 0x2e, 0xae, 0x76, 0x60, 0x12, 0x98, 0x43, 0xf2, 0x63, 0x40, 0x9e, 0x5c, 0xfe,
-	0x94, 0x27, 0x59, 0xe6, 0xbe, 0x1b, 0x34, 0xa8, 0x9e, 0x9f, 0xab, 0x3b, 0x63, 0x9c, 0xe3, 0x32,
-	0xfb, 0xc7, 0x41, 0x2b, 0x08, 0xf1, 0xe1, 0x1e, 0x79, 0x99, 0xf3, 0x82, 0x31, 0xf1, 0x79, 0x8e,
-	0x8d, 0x4d, 0x08, 0x53, 0xb2, 0x33, 0x4c, 0x58, 0x9c, 0x9e, 0x8f, 0x06, 0x4c, 0xc1, 0xd7, 0x34,
-	0x3a, 0x0e, 0xdd, 0xc4, 0x80, 0x0f, 0x32, 0x97, 0x8b, 0x96, 0xdd, 0xec, 0x06, 0xae, 0x0f, 0x5b,
-	0xa3, 0xeb, 0xc2, 0xee, 0x91, 0xf0, 0x4d, 0x40, 0x14, 0x51, 0xcf, 0xaa, 0xaf, 0x2d, 0x8d, 0x28,
-	0x3f, 0x27, 0xc9, 0x68, 0xa8, 0xe8, 0xa1, 0x46, 0xa3, 0x3f, 0xfb, 0xf2, 0xcb, 0x2f, 0xbf, 0x5c,
-	0xf1, 0xff, 0xaa, 0x52, 0x60, 0xc5, 0x38, 0x8d, 0xec, 0x76, 0xde, 0x90, 0xe6, 0x07, 0xd9, 0x65,
-	0xae, 0xc0, 0x6c, 0x15, 0x30, 0x01, 0xe5, 0xb9, 0xf0, 0xee, 0x0e, 0x5a, 0x76, 0xcd, 0xc0, 0x80,
-	0xd0, 0xfb, 0x49, 0xb5, 0xb7, 0x1d, 0xe1, 0x39, 0x40, 0x81, 0xd3, 0x08, 0xf2, 0x1d, 0x2e, 0xbb,
-	0xba, 0xd3, 0x65, 0x77, 0x2b, 0x6e, 0xb9, 0x85, 0xf3, 0x64, 0x72, 0x5d, 0x30, 0x60, 0xc6, 0xb6,
-	0x01, 0x5b, 0x9b, 0x58, 0x59, 0xee, 0xcb, 0x9c, 0x4c, 0x0b, 0x64, 0x65, 0x7f, 0xe4, 0xb4, 0x00,
-	0x5d, 0x4c, 0x5d, 0x68, 0x17, 0x77, 0xb9, 0x65, 0x31, 0xd7, 0xd1, 0xa0, 0xee, 0xf0, 0x9f, 0xbc,
-	0x72, 0xd3, 0xb2, 0xf4, 0x04, 0xc4, 0x39, 0xae, 0x95, 0x5b, 0x1d, 0x57, 0x3c, 0xc0, 0xe4, 0x76,
-	0x69, 0x57, 0x1c, 0xee, 0x68, 0xc0, 0xc2, 0x72, 0x31, 0x99, 0x11, 0x92, 0xf9, 0x1a, 0x8b, 0xb3,
-	0x6e, 0x2a, 0x34, 0xbd, 0x1f, 0xf7, 0xca, 0x0c, 0xe5, 0x52, 0x6a, 0xe5, 0x20, 0x54, 0x8c, 0x41,
-	0x78, 0xa6, 0x18, 0xbb, 0xf7, 0x20, 0x76, 0x27, 0x8d, 0x41, 0xd8, 0x0f, 0xb7, 0xcf, 0x78, 0xfb,
-	0x1b, 0xe9, 0xb7, 0x8c, 0xe1, 0xb3, 0xc5, 0x18, 0x6e, 0x23, 0x86, 0x0f, 0xca, 0x99, 0xb2, 0x4f,
-	0xcf, 0x1a, 0xcf, 0xaf, 0x54, 0xcb, 0xb7, 0x09, 0xb7, 0x8a, 0x23, 0xec, 0x5f, 0x2f, 0xb3, 0xeb,
-	0xe2, 0xcc, 0x0b, 0xc3, 0x34, 0x44, 0xd2, 0xf2, 0x33, 0xd5, 0x32, 0x2e, 0x6c, 0xd3, 0x6f, 0x54,
-	0xcf, 0xb8, 0xa4, 0xdd, 0x3e, 0xa8, 0x89, 0x42, 0xf7, 0x36, 0x3a, 0x59, 0xb6, 0x99, 0x60, 0x00,
-	0x1e, 0x06, 0xa3, 0x93, 0x45, 0x81, 0xf2, 0x4e, 0x16, 0x6f, 0x7f, 0x27, 0x8b, 0x77, 0xd3, 0x4e,
-	0x16, 0xcf, 0xed, 0x64, 0x29, 0x93, 0xfe, 0x81, 0x25, 0xfd, 0x65, 0xe3, 0xa1, 0x47, 0xee, 0x67,
-	0x2a, 0x85, 0xdb, 0xb7, 0xd2, 0x41, 0x3b, 0x46, 0x26, 0xac, 0xa8, 0x8f, 0x09, 0x3d, 0x75, 0xc1,
-	0x3e, 0x4e, 0xd2, 0x70, 0x67, 0x2c, 0xfc, 0x12, 0x1a, 0x80, 0x1e, 0x0d, 0xe8, 0x06, 0x0f, 0xe6,
-	0x6b, 0x3c, 0x76, 0x55, 0x01, 0x32, 0xde, 0x84, 0xba, 0xcb, 0x9b, 0x20, 0xcc, 0x1f, 0xe4, 0x4f,
-	0x33, 0x90, 0xc9, 0x85, 0x8b, 0xc5, 0x4c, 0xd9, 0x41, 0xa6, 0x9c, 0xb0, 0x54, 0x42, 0x8e, 0x54,
-	0xcd, 0x8f, 0xff, 0xf4, 0x0a, 0x77, 0xac, 0xb7, 0xc5, 0x0f, 0x5f, 0x9c, 0xe6, 0xcb, 0x58, 0x53,
-	0x1e, 0x4f, 0x6c, 0xc1, 0x6c, 0x7f, 0x0d, 0x97, 0x48, 0xc3, 0x5f, 0x73, 0x82, 0x10, 0x9e, 0x50,
-	0x3e, 0x96, 0x7a, 0x60, 0x40, 0xca, 0x68, 0x1f, 0x5a, 0xb4, 0x17, 0x90, 0xa5, 0x69, 0xff, 0xbc,
-	0xe7, 0xd8, 0x90, 0xdf, 0x99, 0xd3, 0xf8, 0x85, 0xc5, 0x62, 0xac, 0x5f, 0x44, 0xac, 0x5b, 0xd6,
-	0x88, 0x19, 0x08, 0x69, 0x7c, 0x37, 0x73, 0x07, 0x05, 0xce, 0x65, 0xf1, 0xed, 0xc5, 0x5d, 0xc5,
-	0xd8, 0xd5, 0x31, 0x43, 0x23, 0x3b, 0x3b, 0x7a, 0x9f, 0xe3, 0xf0, 0xe1, 0x66, 0xf9, 0x52, 0x46,
-	0x69, 0x62, 0x51, 0x9a, 0xeb, 0x42, 0x23, 0xf0, 0x05, 0xcf, 0x79, 0xce, 0x01, 0x12, 0x09, 0xe5,
-	0x87, 0x1a, 0x0f, 0x95, 0x2e, 0x3d, 0xc7, 0xb4, 0x1c, 0x15, 0xd5, 0x8c, 0xa3, 0xa2, 0xcc, 0x8e,
-	0x48, 0x2d, 0x3b, 0xc2, 0x81, 0x92, 0xc6, 0x39, 0xce, 0x9e, 0xc0, 0xd0, 0xfb, 0x78, 0x28, 0xbe,
-	0x88, 0x65, 0x9b, 0x36, 0x02, 0x57, 0x03, 0xcc, 0x58, 0x78, 0x5b, 0x71, 0xc7, 0xbb, 0xd8, 0xf1,
-	0x51, 0x63, 0x65, 0xd2, 0x0d, 0xeb, 0x3e, 0x3f, 0xea, 0x15, 0x1f, 0xf1, 0x94, 0x32, 0x4b, 0x09,
-	0x6f, 0xc5, 0x10, 0xde, 0x85, 0x4e, 0x31, 0x3e, 0xd7, 0x10, 0x9f, 0xfb, 0x34, 0x3e, 0xce, 0x3e,
-	0x2d, 0xbd, 0x52, 0x7c, 0xbc, 0x74, 0xe7, 0xce, 0xa1, 0x95, 0xdb, 0xae, 0x56, 0xe2, 0xb6, 0xab,
-	0xe7, 0xdd, 0x76, 0x0b, 0xef, 0x28, 0x26, 0x7d, 0x0f, 0x49, 0x9f, 0xb7, 0x35, 0x6a, 0x9e, 0x28,
-	0x4d, 0xfb, 0x37, 0xbc, 0xc2, 0xb3, 0xb3, 0x3b, 0x47, 0x79, 0x99, 0x5e, 0x7c, 0xc9, 0xd6, 0x8b,
-	0x6e, 0xd4, 0x34, 0xfe, 0xdf, 0xf6, 0x0a, 0x8e, 0xf7, 0x00, 0xd3, 0x8b, 0xab, 0xab, 0x5d, 0x8c,
-	0x01, 0x15, 0x22, 0x25, 0xd3, 0x66, 0x0c, 0x2a, 0x67, 0x7e, 0x26, 0x06, 0x15, 0x73, 0x38, 0x79,
-	0x32, 0x89, 0xb1, 0xa0, 0x80, 0x20, 0x5f, 0x25, 0xf0, 0xbb, 0x6c, 0x23, 0xf1, 0x5e, 0xc7, 0x46,
-	0x22, 0x83, 0xa2, 0xa6, 0xe2, 0x6b, 0x5e, 0xc1, 0x49, 0xe4, 0x7e, 0x54, 0x94, 0xe0, 0x9a, 0x89,
-	0x5b, 0x15, 0x01, 0xa5, 0xd3, 0x32, 0xa0, 0xb4, 0x0c, 0xf7, 0x9f, 0x28, 0xd8, 0x04, 0x39, 0x71,
-	0xbf, 0x4a, 0x9a, 0x32, 0x0f, 0x0f, 0xa9, 0x54, 0xd0, 0x2f, 0xa0, 0x7b, 0x40, 0x04, 0xfd, 0x1e,
-	0x27, 0x53, 0x98, 0x69, 0xb8, 0xde, 0x34, 0x40, 0x87, 0xf1, 0x56, 0x8d, 0x30, 0x5e, 0x7f, 0x54,
-	0x70, 0xce, 0x9a, 0x8d, 0x48, 0x28, 0xa3, 0xe4, 0x7d, 0x16, 0x25, 0xce, 0xe6, 0x34, 0x25, 0xe3,
-	0x82, 0xd3, 0xdb, 0x5c, 0x87, 0x17, 0x8a, 0x3b, 0x7c, 0xd9, 0x73, 0xf4, 0x58, 0xc8, 0xbb, 0xf3,
-	0x60, 0x14, 0x27, 0xe3, 0xd1, 0x30, 0xc1, 0xf1, 0x59, 0x79, 0x06, 0x3b, 0x69, 0x04, 0x95, 0x95,
-	0x67, 0x80, 0x29, 0xe7, 0xe2, 0x78, 0x14, 0x0b, 0xf7, 0x09, 0x4f, 0xe8, 0x6b, 0x51, 0x3c, 0x84,
-	0x80, 0x27, 0xfc, 0x6f, 0x7a, 0xae, 0xd3, 0xe5, 0x1f, 0xc9, 0x14, 0x28, 0x59, 0x90, 0xde, 0xcf,
-	0x79, 0x71, 0xb7, 0x56, 0xc4, 0x85, 0xac, 0xdf, 0xc8, 0x9f, 0x82, 0xe7, 0xb8, 0x5e, 0xb2, 0x58,
-	0x7f, 0x80, 0xf7, 0x74, 0x97, 0xa9, 0x35, 0x8c, 0xa6, 0x74, 0x3f, 0xef, 0x2d, 0x39, 0x57, 0x77,
-	0x1a, 0x28, 0x25, 0x5b, 0xc6, 0x0f, 0x7a, 0x96, 0xb2, 0x2d, 0x6c, 0x57, 0xf7, 0xfe, 0x3d, 0xaf,
-	0xf0, 0xdc, 0x1e, 0xbd, 0x82, 0x3c, 0x5a, 0x11, 0xfb, 0xaf, 0x06, 0x32, 0x09, 0x39, 0x3c, 0xb8,
-	0xa6, 0x2f, 0x66, 0x8e, 0x4c, 0x82, 0x01, 0xd7, 0x5e, 0x13, 0x1b, 0x31, 0x34, 0x6c, 0x79, 0x0a,
-	0x0d, 0xbb, 0x31, 0xc2, 0xf9, 0xd0, 0x8a, 0x54, 0xd9, 0x9a, 0xf9, 0x93, 0x9e, 0xa5, 0x77, 0x0b,
-	0xb0, 0xd4, 0xa4, 0x7c, 0xd6, 0xdb, 0xdf, 0xcb, 0x70, 0xcb, 0xbb, 0xdf, 0xa0, 0x18, 0xbf, 0x9f,
-	0xf6, 0xac, 0xed, 0xef, 0x7e, 0x5d, 0x6b, 0x44, 0xff, 0xa6, 0x5a, 0xec, 0xe8, 0x40, 0x06, 0x2e,
-	0x1a, 0x63, 0x2e, 0x52, 0x06, 0x03, 0x2b, 0x26, 0x03, 0x15, 0xd2, 0x55, 0x63, 0x45, 0xbc, 0xc9,
-	0x83, 0xac, 0x53, 0xa4, 0xd2, 0x09, 0x4a, 0xc3, 0x91, 0x2b, 0x9d, 0xe0, 0xce, 0xc5, 0x20, 0x2f,
-	0x10, 0xc2, 0xbd, 0x33, 0x58, 0xad, 0x61, 0x39, 0x4d, 0xd1, 0xbb, 0xcd, 0x73, 0x03, 0xa3, 0x94,
-	0x19, 0x02, 0x3c, 0x55, 0x1e, 0x02, 0x7c, 0xd3, 0x61, 0xc6, 0x65, 0xb6, 0xcb, 0x2f, 0x78, 0x96,
-	0xdd, 0x56, 0x34, 0x68, 0x7a, 0x68, 0x5f, 0xf5, 0xf2, 0x5e, 0xaa, 0x1f, 0xe1, 0x90, 0x96, 0x29,
-	0xa4, 0x8f, 0xd8, 0x0a, 0x29, 0x8b, 0xa5, 0xa6, 0xe1, 0xfb, 0x4a, 0x25, 0xb4, 0xd7, 0xba, 0xa9,
-	0x75, 0x28, 0x8c, 0xde, 0xf5, 0x30, 0xd9, 0xd6, 0x01, 0x59, 0x3c, 0xa5, 0x02, 0xb5, 0xfa, 0x22,
-	0x1e, 0x45, 0xa4, 0x40, 0x61, 0xb6, 0x17, 0x05, 0x21, 0x95, 0xf6, 0x22, 0xa4, 0xbb, 0xab, 0x22,
-	0x48, 0xb7, 0xd2, 0x5d, 0xd5, 0x2b, 0x4a, 0xdd, 0x58, 0x51, 0xca, 0x94, 0xc2, 0x47, 0x5d, 0x4a,
-	0x21, 0x87, 0xa7, 0x26, 0xe6, 0x5f, 0x3c, 0x87, 0x83, 0x70, 0xbf, 0xad, 0xb9, 0x73, 0x54, 0x6e,
-	0x72, 0x6b, 0xde, 0x1b, 0x0f, 0x22, 0x1e, 0x82, 0x29, 0x42, 0x29, 0x15, 0x80, 0xce, 0x8b, 0x00,
-	0xe0, 0xc5, 0xd1, 0xee, 0xb0, 0x2f, 0xed, 0x68, 0x13, 0xb4, 0xb0, 0x54, 0x4c, 0xf8, 0xc7, 0x3c,
-	0x6b, 0xf7, 0x97, 0xa3, 0x49, 0x93, 0xfc, 0x8f, 0x9e, 0xd3, 0xf9, 0x79, 0x5b, 0x44, 0xcf, 0x93,
-	0x69, 0x43, 0xdc, 0xc5, 0x40, 0x9a, 0x20, 0xfa, 0x24, 0x69, 0xe2, 0x64, 0x5d, 0x1d, 0xf1, 0xd9,
-	0x21, 0xa2, 0xca, 0x5c, 0x13, 0xd9, 0x2e, 0xb8, 0x70, 0xae, 0x98, 0xd8, 0x8f, 0x7b, 0xd6, 0xc6,
-	0xd1, 0x41, 0x8d, 0x26, 0x77, 0x9d, 0x4c, 0x1b, 0x9d, 0xc0, 0x10, 0x60, 0xd2, 0x98, 0x6f, 0x1a,
-	0xa0, 0x72, 0x95, 0xd1, 0x57, 0x0f, 0x34, 0xc0, 0x8e, 0x91, 0xb5, 0x42, 0xdb, 0xaf, 0x8a, 0x68,
-	0x36, 0x67, 0xf8, 0xe9, 0x5c, 0x36, 0xfc, 0xd4, 0x08, 0x3d, 0xb5, 0xc3, 0x37, 0xab, 0xb9, 0xf0,
-	0xcd, 0x6f, 0x79, 0x64, 0xc6, 0x8e, 0x75, 0xfe, 0x11, 0xc5, 0xf5, 0x3e, 0x24, 0x62, 0x5b, 0x59,
-	0x36, 0xb0, 0x57, 0xd1, 0x19, 0xc8, 0x02, 0xfb, 0x2d, 0x01, 0xfe, 0xfb, 0x3d, 0x21, 0xd9, 0xe2,
-	0x8e, 0x9a, 0x32, 0x1c, 0x24, 0x19, 0x32, 0xa9, 0x4e, 0xf4, 0x7a, 0xd1, 0x4b, 0x4c, 0xa8, 0x0a,
-	0x0d, 0xc0, 0x09, 0x82, 0x37, 0xad, 0x96, 0x46, 0xbb, 0x42, 0xda, 0xea, 0x81, 0x09, 0xc2, 0x98,
-	0xbd, 0xf0, 0x86, 0x31, 0xbd, 0x64, 0xd2, 0x7f, 0x9e, 0x34, 0x83, 0xb1, 0x89, 0x84, 0x16, 0x69,
-	0xcf, 0x12, 0xe9, 0x05, 0x11, 0x61, 0x0a, 0xc5, 0x12, 0xe1, 0x6e, 0xa0, 0xa6, 0x42, 0xe5, 0xf5,
-	0x03, 0xa3, 0x94, 0xff, 0x02, 0x21, 0xed, 0x45, 0xa9, 0x63, 0x84, 0x52, 0xf3, 0x94, 0x52, 0xe3,
-	0x17, 0x1b, 0xe5, 0xbd, 0x4e, 0xfc, 0xa6, 0x67, 0xc8, 0x64, 0x30, 0xe6, 0x5d, 0x54, 0xad, 0xd8,
-	0x51, 0x0b, 0xc9, 0x40, 0x16, 0xf2, 0x7f, 0xde, 0x23, 0x77, 0x99, 0x81, 0x09, 0x97, 0x46, 0xa1,
-	0xb2, 0x3a, 0xf9, 0xf5, 0xc7, 0x55, 0x28, 0x98, 0x89, 0x5d, 0xd3, 0x48, 0x05, 0xaa, 0x48, 0x99,
-	0xf6, 0xfc, 0x84, 0xad, 0x3d, 0x0b, 0x3a, 0xd4, 0x73, 0xeb, 0xbb, 0x9e, 0x3b, 0xd4, 0x9e, 0xbe,
-	0x5e, 0x46, 0xee, 0x79, 0xd6, 0x3d, 0x3a, 0x5d, 0x76, 0x65, 0xcc, 0xe2, 0x30, 0x1d, 0xc5, 0x89,
-	0x0c, 0xe1, 0xbb, 0x40, 0x68, 0xa6, 0xa5, 0x88, 0xc9, 0xd8, 0xca, 0xbb, 0x0a, 0x42, 0xf6, 0x03,
-	0x47, 0x15, 0xeb, 0x44, 0xbf, 0x9a, 0xb9, 0x39, 0xa2, 0x97, 0x27, 0x7e, 0xa3, 0x54, 0xa4, 0xfc,
-	0xf7, 0x92, 0xd9, 0x6c, 0xdb, 0xf4, 0x01, 0x32, 0x23, 0xdd, 0xfe, 0x22, 0x90, 0x91, 0x1b, 0xb9,
-	0x19, 0x28, 0xe8, 0x7d, 0x10, 0x30, 0x55, 0x8a, 0xcf, 0x40, 0x0b, 0x06, 0x62, 0x7d, 0x35, 0x4c,
-	0x59, 0x0c, 0x13, 0x5b, 0x1e, 0x63, 0x2b, 0x80, 0xdf, 0x21, 0x87, 0x1d, 0x8c, 0x01, 0x64, 0xcf,
-	0x6e, 0x6e, 0xae, 0x8c, 0x55, 0x38, 0x28, 0x4f, 0x49, 0x3d, 0x6d, 0xec, 0x4b, 0x55, 0xda, 0x7f,
-	0x1f, 0x39, 0xee, 0x1a, 0x8f, 0xab, 0x51, 0xba, 0xd5, 0x5e, 0x0b, 0xc6, 0xf4, 0x51, 0x52, 0x43,
-	0x6b, 0x8a, 0x9f, 0x99, 0x95, 0x5e, 0x85, 0xc0, 0x82, 0x86, 0xbd, 0x5e, 0x29, 0xb0, 0xd7, 0xab,
-	0xe6, 0xec, 0xf1, 0x9f, 0x27, 0x27, 0xf2, 0x63, 0x62, 0xa1, 0xf0, 0x26, 0x3b, 0x0c, 0xee, 0x35,
-	0x25, 0x38, 0xc8, 0x3a, 0x32, 0x2e, 0x6e, 0x95, 0xcc, 0x65, 0x42, 0x32, 0xa4, 0x37, 0x79, 0x63,
-	0x94, 0xd0, 0xc7, 0xed, 0x86, 0xe7, 0xcd, 0x39, 0xeb, 0xaa, 0x21, 0x5b, 0x1d, 0x91, 0xbb, 0x0b,
-	0xcb, 0xd0, 0xd7, 0x91, 0x7a, 0xa7, 0x0f, 0x4b, 0x1b, 0xe7, 0xd8, 0x31, 0xeb, 0x76, 0x03, 0x64,
-	0x44, 0x1b, 0x11, 0x8b, 0x03, 0x5e, 0x88, 0x9e, 0x22, 0x4d, 0x23, 0xbe, 0xff, 0x9a, 0x14, 0x06,
-	0x1b, 0xe8, 0xff, 0x94, 0xe7, 0x8a, 0x25, 0x02, 0x2d, 0xaa, 0x8d, 0x05, 0xb1, 0xab, 0x36, 0x20,
-	0x2a, 0x9e, 0x57, 0x5c, 0x77, 0x2b, 0xdb, 0xc6, 0xfe, 0x92, 0xbd, 0x8d, 0xcd, 0x77, 0xa6, 0xa7,
-	0xf0, 0x77, 0xbc, 0xf2, 0x00, 0xa6, 0xdb, 0x72, 0x53, 0xec, 0x6b, 0x16, 0x2c, 0x5c, 0x2e, 0x46,
-	0xfe, 0x93, 0x9e, 0xe5, 0x78, 0x2a, 0x43, 0x4e, 0x93, 0xf1, 0x55, 0xaf, 0x28, 0xca, 0xea, 0x0e,
-	0x11, 0x50, 0x72, 0x1e, 0xf8, 0xcb, 0x9c, 0x80, 0x7b, 0x8d, 0xad, 0x7d, 0xd9, 0x9e, 0xe0, 0x7f,
-	0x3c, 0xd2, 0x14, 0xe1, 0x15, 0x31, 0x8f, 0x23, 0x3e, 0xce, 0xdf, 0x4a, 0xe1, 0xa7, 0x26, 0x7c,
-	0x85, 0xd4, 0x00, 0xe3, 0xd2, 0x83, 0x69, 0x4b, 0xb7, 0xc1, 0x56, 0xee, 0xa6, 0x9d, 0x3e, 0x5f,
-	0x50, 0x9a, 0x01, 0x4f, 0xd0, 0xc7, 0xc9, 0x94, 0x54, 0x7f, 0x32, 0xa2, 0xbf, 0x65, 0xcd, 0x0c,
-	0x91, 0x29, 0x9e, 0x8f, 0x91, 0x45, 0xf5, 0x01, 0x57, 0xdd, 0xbc, 0xa7, 0xfe, 0x14, 0x99, 0x36,
-	0x62, 0x83, 0xc4, 0x1d, 0xb5, 0x56, 0xe6, 0x25, 0x1a, 0x95, 0x1f, 0x98, 0x85, 0x01, 0xef, 0x75,
-	0xfe, 0x5a, 0xc7, 0x24, 0x57, 0xbe, 0x3c, 0xe5, 0x7f, 0xda, 0xcb, 0x07, 0xc1, 0xdd, 0xd6, 0xa0,
-	0x19, 0x66, 0x45, 0xd5, 0x32, 0x2b, 0xca, 0xb6, 0x3d, 0xbf, 0x62, 0x6f, 0x7b, 0xb2, 0x88, 0xe8,
-	0x61, 0xfa, 0xa4, 0xe7, 0x8e, 0xca, 0xd3, 0xe7, 0x5b, 0x9e, 0xf9, 0xec, 0xcf, 0x2c, 0xa9, 0x76,
-	0x53, 0x69, 0xef, 0xc1, 0x27, 0xa0, 0x3d, 0xe4, 0x7b, 0x20, 0x7e, 0x10, 0x26, 0x52, 0x65, 0x67,
-	0x81, 0xbf, 0xea, 0x59, 0x57, 0xd6, 0x5c, 0xdd, 0x9b, 0x67, 0x81, 0x54, 0xe6, 0xb5, 0x19, 0x3f,
-	0x7e, 0x1e, 0xc5, 0xc0, 0xc8, 0xd5, 0x88, 0xc5, 0xab, 0x32, 0x86, 0xb8, 0x16, 0xa8, 0x34, 0x5f,
-	0xba, 0x8c, 0x60, 0x66, 0xb5, 0x74, 0x19, 0x61, 0xd6, 0x25, 0xcb, 0xa9, 0xff, 0x5f, 0x15, 0x75,
-	0x5f, 0x55, 0x6a, 0xc2, 0x12, 0xdb, 0x2e, 0xbb, 0x41, 0xaa, 0x38, 0x36, 0x48, 0xf2, 0xe0, 0xa8,
-	0xbd, 0x26, 0xe6, 0x9c, 0x4c, 0xaa, 0x9c, 0x6e, 0x2a, 0xb6, 0x87, 0x32, 0x69, 0x88, 0x43, 0x3d,
-	0xeb, 0x3b, 0xe6, 0xce, 0x60, 0x6e, 0x94, 0xa2, 0xa5, 0xaf, 0x00, 0xee, 0x1b, 0x5a, 0xde, 0x1d,
-	0xba, 0xa1, 0x65, 0x58, 0xc7, 0x24, 0x77, 0x40, 0x62, 0xd9, 0xef, 0xdc, 0x53, 0xef, 0xb6, 0xdf,
-	0x0f, 0x60, 0x9e, 0xda, 0x73, 0xfc, 0xa5, 0x47, 0x0e, 0x72, 0x63, 0xdc, 0xe2, 0xbe, 0xbc, 0x91,
-	0xe6, 0xd9, 0x37, 0xd2, 0x7c, 0x11, 0x8d, 0x9e, 0xe1, 0xbe, 0xf5, 0x44, 0xd1, 0x0f, 0x9b, 0xfb,
-	0x8a, 0xaa, 0x89, 0x12, 0xaa, 0x26, 0x6d, 0xaa, 0x2e, 0x90, 0xa6, 0x9a, 0x83, 0x52, 0x19, 0xea,
-	0x86, 0xbc, 0x92, 0xed, 0x4d, 0xc5, 0xda, 0xde, 0xf8, 0x1f, 0x94, 0xec, 0x31, 0x26, 0xc3, 0x0f,
-	0xc6, 0x9e, 0x05, 0x1e, 0x8c, 0x80, 0xa8, 0x89, 0x3b, 0x34, 0x47, 0xb2, 0x6a, 0x83, 0xab, 0x51,
-	0x95, 0x84, 0xfd, 0xdb, 0xa1, 0x9c, 0x9e, 0x35, 0xad, 0x0a, 0x6f, 0x7f, 0xab, 0xe2, 0xad, 0xe4,
-	0x80, 0x59, 0x5b, 0xec, 0x49, 0xe4, 0xe2, 0x9e, 0x9f, 0xf3, 0x81, 0x55, 0x9c, 0xbe, 0x3d, 0xf7,
-	0x30, 0x82, 0xd8, 0x72, 0x14, 0xdd, 0x72, 0xce, 0x16, 0x47, 0x22, 0xac, 0x88, 0xbe, 0x32, 0x22,
-	0x32, 0x22, 0xf9, 0x7f, 0x86, 0x88, 0xbf, 0xf5, 0x44, 0xc8, 0x8e, 0x2d, 0x5e, 0xd6, 0xa0, 0x7a,
-	0x37, 0x35, 0xa8, 0xf4, 0x71, 0x42, 0xf8, 0x06, 0x5e, 0xbd, 0xf6, 0x96, 0x21, 0xdf, 0x20, 0xc3,
-	0x28, 0x49, 0x9f, 0x26, 0x4d, 0x4b, 0x16, 0x84, 0x10, 0x15, 0xaf, 0xc7, 0x76, 0x71, 0x5b, 0xa3,
-	0xf1, 0x77, 0x56, 0x34, 0xc0, 0xdf, 0x21, 0x47, 0xad, 0xe2, 0xca, 0x4d, 0x53, 0x6e, 0x4e, 0x58,
-	0x06, 0x42, 0xe5, 0xa6, 0x0d, 0x04, 0xe8, 0xce, 0x92, 0x89, 0x1f, 0xbc, 0xbb, 0x9c, 0x88, 0x99,
-	0xdd, 0xbd, 0xe2, 0x15, 0x86, 0xbe, 0xdf, 0x6e, 0x24, 0x8d, 0x35, 0xe1, 0xab, 0xf9, 0x09, 0x5f,
-	0xb6, 0x53, 0xfe, 0x94, 0xe7, 0x08, 0x86, 0xc9, 0x61, 0x66, 0xf9, 0x51, 0x4a, 0x82, 0xf3, 0x4b,
-	0x56, 0x4d, 0x79, 0xef, 0xb8, 0x62, 0xdc, 0x3b, 0xbe, 0x55, 0x27, 0xca, 0xa5, 0x62, 0x3a, 0x7e,
-	0xcd, 0xb3, 0xa2, 0x08, 0x8b, 0x51, 0xb4, 0xe2, 0x64, 0x96, 0xf0, 0x68, 0x31, 0x1c, 0x44, 0xe9,
-	0xde, 0x6d, 0x4f, 0xa2, 0x79, 0x32, 0x6d, 0x34, 0x23, 0xe8, 0x33, 0x41, 0xfe, 0x7b, 0xc8, 0x9c,
-	0x69, 0x37, 0x67, 0xfa, 0x74, 0xb9, 0xfa, 0x9f, 0xcc, 0xb6, 0x69, 0x6a, 0x88, 0x4c, 0x03, 0x76,
-	0x5f, 0x2f, 0x90, 0xc3, 0x46, 0x52, 0xc9, 0xf2, 0x13, 0xf6, 0x9e, 0xf2, 0x64, 0x5e, 0xd9, 0x64,
-	0x5b, 0xe5, 0xe5, 0xc1, 0xfc, 0x3b, 0x17, 0x4b, 0x47, 0x28, 0x7c, 0x82, 0x12, 0x2d, 0xba, 0x7e,
-	0x91, 0x3b, 0xd2, 0xb3, 0x9f, 0xc8, 0xaa, 0x5b, 0x8f, 0x47, 0xa5, 0xa6, 0xd7, 0x39, 0xcd, 0x3f,
-	0x1e, 0x55, 0xcb, 0x3e, 0x1e, 0x55, 0x26, 0xc6, 0x9f, 0x76, 0x1d, 0x97, 0xe7, 0xf0, 0xd3, 0x63,
-	0xff, 0xef, 0x1e, 0x7f, 0x5e, 0x0b, 0xcf, 0xb8, 0xd6, 0xd4, 0x19, 0xd7, 0x1a, 0xbd, 0x97, 0x54,
-	0xba, 0xa9, 0x50, 0x85, 0x99, 0x47, 0xb7, 0x2a, 0xdd, 0x94, 0x3e, 0xaa, 0x5e, 0x09, 0xa8, 0xda,
-	0x27, 0x3a, 0x6b, 0xdd, 0x94, 0xab, 0x99, 0x44, 0xbe, 0x9b, 0xc3, 0x1f, 0x0b, 0xc8, 0x6c, 0x34,
-	0x6a, 0xd6, 0xe1, 0x76, 0xf9, 0x46, 0x63, 0xae, 0x27, 0x4e, 0x1b, 0x0b, 0x5f, 0x50, 0x39, 0x63,
-	0xbf, 0x76, 0x52, 0xac, 0xee, 0x8c, 0x37, 0x1c, 0x3e, 0x53, 0x21, 0xb3, 0xd9, 0x57, 0x13, 0x61,
-	0xda, 0x32, 0x4c, 0xf4, 0xc5, 0x6d, 0x42, 0x99, 0x04, 0x25, 0xc8, 0x8c, 0xe8, 0x01, 0xef, 0x74,
-	0x3d, 0xd0, 0x00, 0x90, 0xdd, 0xd1, 0x58, 0x6d, 0x04, 0xf0, 0x9b, 0xde, 0x4b, 0xaa, 0xe3, 0x54,
-	0x7a, 0x70, 0xa6, 0x0d, 0xfe, 0x04, 0x00, 0x87, 0x06, 0xd7, 0x77, 0xe3, 0x18, 0xc6, 0x85, 0x07,
-	0x33, 0xd6, 0x03, 0x0d, 0x00, 0x0d, 0x38, 0x8e, 0x19, 0xcf, 0xe4, 0xd7, 0x20, 0x55, 0x1a, 0xe8,
-	0x4f, 0xe2, 0x75, 0xb1, 0xe9, 0x82, 0x4f, 0xe8, 0xbe, 0xcf, 0x92, 0x54, 0x58, 0xb2, 0xf8, 0x4d,
-	0x4f, 0x91, 0xe6, 0xfa, 0x16, 0x5b, 0xdf, 0x5e, 0x1a, 0x0d, 0x37, 0x06, 0xd1, 0x7a, 0x2a, 0xcc,
-	0x58, 0x1b, 0x08, 0x93, 0x36, 0x54, 0x2f, 0x7e, 0xf5, 0xd1, 0x98, 0xad, 0x05, 0x26, 0xc8, 0xff,
-	0x39, 0xcf, 0x75, 0x91, 0x88, 0xbe, 0x51, 0xf0, 0xc3, 0x38, 0x7d, 0x2a, 0x7c, 0x8b, 0x52, 0x97,
-	0x2c, 0x3b, 0xe3, 0xf8, 0x8c, 0x7d, 0xc6, 0x91, 0xef, 0x53, 0x4b, 0x2d, 0xe0, 0x94, 0xbf, 0xc4,
-	0x74, 0x07, 0x70, 0xfa, 0xac, 0x8d, 0x53, 0xbe, 0x4f, 0xcb, 0x13, 0xe8, 0xba, 0x40, 0x75, 0xab,
-	0x13, 0xeb, 0x38, 0x99, 0x42, 0x03, 0x03, 0x1f, 0x28, 0xe5, 0xe2, 0xa4, 0x01, 0xd6, 0x23, 0x74,
-	0x9e, 0x7e, 0x6a, 0xaf, 0xcc, 0xb5, 0xf2, 0xeb, 0x2e, 0xd7, 0x8a, 0x85, 0xa2, 0xa6, 0x21, 0x75,
-	0x5d, 0xf5, 0xb2, 0x27, 0x45, 0xc5, 0x98, 0x14, 0x65, 0x9c, 0xfb, 0x0d, 0x9b, 0x73, 0xf9, 0x66,
-	0x75, 0xaf, 0xff, 0xea, 0xed, 0x73, 0x93, 0xac, 0xf0, 0x01, 0x98, 0x9b, 0x38, 0xf5, 0x74, 0x1f,
-	0x67, 0x97, 0x85, 0x90, 0x51, 0x52, 0x1b, 0x1a, 0xde, 0x58, 0xf8, 0x5e, 0x58, 0x29, 0x26, 0xf4,
-	0x37, 0x39, 0xa1, 0xa7, 0xec, 0x48, 0x25, 0x37, 0x21, 0x9a, 0xe6, 0xaf, 0x7b, 0xa5, 0x57, 0xe3,
-	0xf6, 0xb3, 0x80, 0x62, 0xcb, 0x77, 0xc7, 0x53, 0x30, 0x4e, 0xfd, 0x78, 0x34, 0x3e, 0x3b, 0x18,
-	0x08, 0xbf, 0x93, 0x4c, 0x96, 0x05, 0x85, 0x7f, 0x8e, 0xa3, 0xef, 0x9b, 0x57, 0x3f, 0xf6, 0x43,
-	0xfe, 0x3d, 0x65, 0xb7, 0xf6, 0xca, 0x8c, 0x93, 0xdf, 0xb2, 0x8d, 0x93, 0xe2, 0x46, 0x74, 0x5f,
-	0x1f, 0xf3, 0x0a, 0xae, 0x00, 0x1a, 0x46, 0x93, 0x67, 0x19, 0x4d, 0x27, 0x08, 0x89, 0xf5, 0xad,
-	0x1f, 0xfe, 0x76, 0x8f, 0x01, 0x29, 0x8b, 0x9c, 0xfa, 0x6d, 0xcf, 0x15, 0x75, 0x66, 0xf7, 0xab,
-	0x51, 0xfb, 0x0b, 0xef, 0x26, 0xaf, 0x20, 0x16, 0xa2, 0x5a, 0xe4, 0x85, 0x15, 0x16, 0x37, 0x2c,
-	0x2d, 0x7c, 0x81, 0xad, 0x06, 0x1a, 0xb0, 0x70, 0xb5, 0x98, 0x80, 0xcf, 0x73, 0x02, 0x5e, 0xa7,
-	0x19, 0xbc, 0x3f, 0x76, 0x9a, 0xa0, 0x4f, 0x7b, 0xfb, 0x5f, 0x94, 0xbc, 0xb5, 0x03, 0xf4, 0xb2,
-	0x70, 0x9a, 0xdf, 0xb1, 0xc3, 0x69, 0xf6, 0xeb, 0xd8, 0xd4, 0x52, 0xae, 0x8b, 0x9a, 0xc0, 0x4c,
-	0x86, 0x17, 0xb2, 0xc4, 0x51, 0xbb, 0x48, 0x95, 0xe9, 0xc6, 0xdf, 0xb5, 0x75, 0xa3, 0xa3, 0xd5,
-	0x5c, 0xaf, 0x99, 0x5b, 0xa0, 0xb7, 0xd3, 0xeb, 0xef, 0xe5, 0x7b, 0xcd, 0xb4, 0xaa, 0x7b, 0xfd,
-	0x59, 0xcf, 0x79, 0xc7, 0x94, 0x3e, 0x66, 0xbe, 0x16, 0x22, 0x86, 0xc2, 0xf1, 0xc0, 0x85, 0x51,
-	0xa8, 0x0c, 0xa3, 0x2f, 0xd8, 0x18, 0x39, 0x3a, 0xd4, 0x18, 0x0d, 0x1c, 0x77, 0x5b, 0x9d, 0x61,
-	0x6b, 0x25, 0xb1, 0x0d, 0x5f, 0xb4, 0x63, 0x1b, 0x72, 0xed, 0xe9, 0xde, 0x5e, 0xf1, 0xf6, 0xbb,
-	0x33, 0x7b, 0xcb, 0x93, 0xcb, 0x78, 0x06, 0xa6, 0x6a, 0x3d, 0x03, 0xb3, 0xd0, 0x2d, 0xc6, 0xf8,
-	0xf7, 0x39, 0xc6, 0xf7, 0x17, 0x4e, 0x2c, 0x13, 0x25, 0x8d, 0xfe, 0x8d, 0x82, 0xdb, 0xbc, 0x45,
-	0x0f, 0x1d, 0x95, 0x29, 0xa7, 0x2f, 0xd9, 0xca, 0xc9, 0xd9, 0xae, 0xee, 0xf9, 0x5d, 0xce, 0xcb,
-	0xc2, 0x65, 0x42, 0xf0, 0x65, 0x5b, 0x08, 0x1c, 0xb5, 0x75, 0xeb, 0x1f, 0xf0, 0x8a, 0xae, 0x1c,
-	0xe7, 0xec, 0x9d, 0x19, 0x65, 0xef, 0x34, 0xc1, 0xc0, 0x29, 0xf3, 0xb3, 0xfc, 0x81, 0xed, 0x67,
-	0x71, 0x77, 0xa0, 0x91, 0xf8, 0x84, 0x57, 0x76, 0x81, 0xf9, 0x56, 0xe5, 0xa2, 0x6c, 0xdd, 0xfa,
-	0x4a, 0x6e, 0xdd, 0x2a, 0xe8, 0x54, 0x23, 0xb7, 0x4d, 0x0e, 0xe5, 0x76, 0x35, 0xce, 0x2d, 0x6e,
-	0xfe, 0x76, 0x29, 0xbf, 0x63, 0xe0, 0x78, 0x10, 0x56, 0x2c, 0x62, 0x89, 0x08, 0x56, 0x51, 0x69,
-	0xff, 0x8a, 0xf5, 0x78, 0x12, 0x7f, 0xed, 0x68, 0x31, 0x0f, 0x13, 0x9b, 0xde, 0xa2, 0x13, 0xb6,
-	0x5c, 0x79, 0x18, 0xe6, 0xd2, 0x2b, 0xe0, 0x56, 0x9c, 0xb5, 0x78, 0x5c, 0xb9, 0xcc, 0x13, 0xf8,
-	0x55, 0xdb, 0x13, 0x58, 0xd6, 0xb4, 0xe6, 0xe4, 0x97, 0xbc, 0xf2, 0x5b, 0xe6, 0xb7, 0x7c, 0x79,
-	0x50, 0x3d, 0xc9, 0x57, 0x35, 0x9e, 0xe4, 0x2b, 0x43, 0xfb, 0x6b, 0x9e, 0xe3, 0xde, 0xa8, 0x1b,
-	0x19, 0x8d, 0xf6, 0x4b, 0xc5, 0x37, 0xdf, 0x9d, 0x6c, 0x2b, 0x89, 0x4a, 0xfc, 0xba, 0x1d, 0x95,
-	0x58, 0xd4, 0xac, 0x35, 0x33, 0x4a, 0x2f, 0xd6, 0xd3, 0x87, 0x48, 0x63, 0xe9, 0x59, 0xdc, 0x4d,
-	0xca, 0x93, 0x10, 0xd5, 0x27, 0x07, 0x07, 0x2a, 0xbf, 0x8c, 0x31, 0x7f, 0x98, 0x61, 0x4c, 0x49,
-	0x97, 0x1a, 0xb9, 0xb7, 0x91, 0x49, 0xd1, 0xb6, 0x73, 0x3e, 0x64, 0x9e, 0x46, 0xe4, 0x4e, 0x00,
-	0xeb, 0x69, 0xc4, 0x0f, 0x79, 0xfb, 0x3d, 0x0a, 0xe0, 0x64, 0x70, 0x89, 0x76, 0x7f, 0x25, 0xa7,
-	0xdd, 0x4b, 0x1a, 0xb7, 0x15, 0x50, 0xf1, 0xcb, 0x03, 0xb7, 0x7a, 0x77, 0xa5, 0x4c, 0x01, 0x7d,
-	0xc3, 0xcb, 0xdd, 0x0d, 0xde, 0x4f, 0xfe, 0x06, 0xa5, 0xaf, 0x1e, 0x94, 0x6d, 0x09, 0xbe, 0x69,
-	0x6f, 0x09, 0x4a, 0x5a, 0xd1, 0xbd, 0x7d, 0xca, 0xdb, 0xe7, 0x0d, 0x05, 0x50, 0xbb, 0x09, 0xdf,
-	0xba, 0x82, 0xc0, 0xd5, 0x02, 0x91, 0x82, 0xe5, 0x98, 0xfb, 0x4d, 0xf9, 0xe9, 0x71, 0x2d, 0x90,
-	0xc9, 0xb2, 0x4d, 0xd7, 0x1f, 0xd9, 0x9b, 0xae, 0xd2, 0x9e, 0xcd, 0x2b, 0x67, 0xf9, 0x47, 0x1c,
-	0xcc, 0xfe, 0x3d, 0xbb, 0xff, 0x12, 0x03, 0xe6, 0x8f, 0xb3, 0xc1, 0x99, 0x99, 0x56, 0x75, 0x9f,
-	0x7f, 0xe7, 0x15, 0x3f, 0x11, 0x01, 0xd2, 0xd0, 0xcf, 0x68, 0x2e, 0x99, 0x16, 0xdb, 0x18, 0x7e,
-	0x72, 0xdd, 0x17, 0xeb, 0xa7, 0x01, 0x81, 0xba, 0x3b, 0xfc, 0x0f, 0x05, 0xfa, 0xe2, 0x69, 0x03,
-	0x95, 0xd6, 0x7f, 0x30, 0x50, 0x2b, 0xfa, 0x83, 0x81, 0x32, 0x75, 0xf3, 0xaa, 0xad, 0x6e, 0x8a,
-	0xb0, 0xb7, 0x3c, 0xe9, 0xe6, 0xc3, 0xd1, 0xe8, 0xc2, 0xe3, 0x7f, 0x53, 0xe1, 0xf1, 0x7d, 0xa8,
-	0xfc, 0x7b, 0x8a, 0x13, 0x84, 0x2c, 0xee, 0xae, 0x6f, 0xb3, 0x54, 0xe8, 0x64, 0x7c, 0x93, 0x4b,
-	0x43, 0xf0, 0x7e, 0xd0, 0xb6, 0xb8, 0xd1, 0x5d, 0x39, 0xbb, 0x0d, 0xe9, 0xde, 0xb6, 0x7c, 0x80,
-	0xbe, 0xb7, 0x0d, 0x34, 0x9f, 0x1b, 0xf6, 0xc7, 0xa3, 0x68, 0x98, 0x8a, 0x00, 0x62, 0x95, 0x86,
-	0xbc, 0xc5, 0x30, 0x61, 0xdd, 0x30, 0xdd, 0xc2, 0x13, 0xb3, 0xa9, 0x40, 0xa5, 0xfd, 0x8f, 0x57,
-	0x88, 0x19, 0x27, 0xbe, 0x84, 0xef, 0xd7, 0xf7, 0xd8, 0x30, 0x89, 0xd2, 0xe8, 0x1a, 0x13, 0x58,
-	0x66, 0xc1, 0x80, 0xed, 0xd9, 0xf1, 0x98, 0x0d, 0xfb, 0xa0, 0x6c, 0x11, 0xdb, 0x46, 0x60, 0x40,
-	0x60, 0xe5, 0xbe, 0x1a, 0x47, 0x29, 0x5b, 0xdd, 0x8a, 0x59, 0xb2, 0x35, 0x1a, 0xf4, 0xc5, 0xba,
-	0x9c, 0x81, 0xd2, 0x53, 0xa4, 0x19, 0xb0, 0xb0, 0xaf, 0x8b, 0xd5, 0xb0, 0x98, 0x0d, 0xc4, 0x7f,
-	0x04, 0x48, 0x47, 0x71, 0xb8, 0xc9, 0x96, 0xc2, 0x71, 0xb8, 0x1e, 0xa5, 0x7b, 0xe2, 0x54, 0x30,
-	0x0b, 0x56, 0x41, 0xc7, 0x4b, 0x5b, 0x61, 0x2c, 0x48, 0xd5, 0x00, 0x7c, 0xc0, 0x39, 0x95, 0xbe,
-	0x6f, 0xf8, 0xc4, 0x3b, 0xd7, 0xe1, 0x66, 0x82, 0x45, 0xc4, 0x75, 0x2c, 0x0d, 0xf0, 0xbf, 0xe5,
-	0x15, 0x3f, 0x1a, 0xe2, 0x32, 0xe6, 0x82, 0xb1, 0x50, 0x5c, 0x95, 0x60, 0x8c, 0xcf, 0xaa, 0x26,
-	0xa9, 0x7a, 0x68, 0x35, 0x49, 0xcd, 0x80, 0xfd, 0x9a, 0xf5, 0xa7, 0x11, 0xb9, 0x17, 0x30, 0x4a,
-	0x24, 0xf0, 0x5b, 0x2e, 0x09, 0x2c, 0x0b, 0xb9, 0xf9, 0x45, 0x8f, 0x4c, 0x82, 0x1e, 0x5d, 0x19,
-	0x63, 0xb4, 0xe6, 0xca, 0x58, 0x84, 0xd8, 0x55, 0x56, 0xc6, 0x20, 0x18, 0x43, 0x76, 0x5d, 0xba,
-	0xf6, 0xf0, 0x45, 0x00, 0x99, 0xce, 0xff, 0x43, 0x0b, 0x7f, 0xfe, 0x2d, 0xf3, 0x0f, 0x2d, 0x27,
-	0x08, 0xb9, 0xc0, 0xd2, 0x95, 0x31, 0x3f, 0x8e, 0xe5, 0xa3, 0x67, 0x40, 0xd4, 0xc5, 0xd5, 0xba,
-	0x7d, 0xd4, 0xab, 0x2e, 0xae, 0xc2, 0x42, 0xe1, 0x7c, 0xea, 0xa5, 0xf4, 0x76, 0x94, 0xed, 0x05,
-	0x10, 0x93, 0xc5, 0xf0, 0x02, 0x94, 0x84, 0x99, 0x7c, 0xdb, 0x0e, 0x33, 0x71, 0x75, 0xed, 0xf4,
-	0x64, 0x39, 0x5e, 0x9b, 0xf9, 0x21, 0xbb, 0x32, 0xb2, 0x44, 0x94, 0xac, 0x79, 0xdf, 0x71, 0x7a,
-	0xb2, 0x1c, 0x28, 0x6a, 0x52, 0x3e, 0xe7, 0x95, 0xbc, 0xb8, 0xa3, 0x6e, 0x24, 0xf2, 0xb7, 0xcd,
-	0xf9, 0x8d, 0x44, 0xf7, 0x5f, 0x7c, 0xe9, 0xdb, 0x0d, 0x55, 0xf3, 0x76, 0x43, 0xd9, 0x4d, 0xac,
-	0xef, 0xda, 0x37, 0xb1, 0x0a, 0xb1, 0xd0, 0xc8, 0xfe, 0x79, 0x85, 0x34, 0xce, 0x47, 0xfc, 0x8c,
-	0x03, 0x04, 0x21, 0x61, 0x2f, 0xee, 0xb2, 0xe1, 0x3a, 0x13, 0x8e, 0x0d, 0x95, 0x06, 0x1c, 0x07,
-	0x18, 0xcf, 0x22, 0xde, 0xa1, 0xc6, 0x04, 0x40, 0x77, 0x58, 0xbc, 0xc9, 0x84, 0xf2, 0xe7, 0x09,
-	0x3c, 0x8e, 0xb8, 0x91, 0xb2, 0x61, 0x2a, 0x0f, 0x88, 0x79, 0x0a, 0x4b, 0xe3, 0x1f, 0xfd, 0xd4,
-	0xf9, 0x9d, 0x3d, 0x4c, 0x80, 0xa6, 0x4e, 0x84, 0x97, 0x72, 0x02, 0xe1, 0x32, 0x09, 0x3a, 0xa3,
-	0xaf, 0x62, 0xc9, 0xb9, 0x2e, 0xd1, 0x00, 0xf4, 0x5d, 0xa0, 0x4c, 0x41, 0x2e, 0xff, 0x77, 0x0a,
-	0x0d, 0x80, 0x56, 0x77, 0x22, 0x6e, 0xbd, 0xf1, 0x47, 0x2f, 0x64, 0x12, 0x73, 0x44, 0x34, 0x37,
-	0x11, 0x39, 0x3c, 0x89, 0xbb, 0x9b, 0xd1, 0x75, 0x1e, 0x06, 0xce, 0x43, 0x66, 0x54, 0x1a, 0x26,
-	0xe9, 0x46, 0x34, 0x60, 0xbd, 0xe8, 0x25, 0xb6, 0xb8, 0x07, 0x16, 0x2b, 0x8f, 0x9b, 0xb1, 0x81,
-	0xfe, 0x87, 0x3d, 0xd7, 0xa3, 0x48, 0xf4, 0x11, 0x32, 0x25, 0x99, 0x2c, 0x4d, 0xdd, 0x83, 0xea,
-	0xaa, 0xc2, 0x40, 0x38, 0x31, 0x55, 0x89, 0xb2, 0x13, 0xed, 0x3f, 0xb1, 0x4f, 0xb4, 0xf3, 0x7d,
-	0x59, 0x37, 0x6a, 0xca, 0x9e, 0x5a, 0xba, 0xc3, 0x73, 0xaa, 0xc4, 0xb4, 0xfb, 0x53, 0xdb, 0xb4,
-	0x2b, 0xc1, 0x51, 0x13, 0xf3, 0x21, 0xcf, 0xf5, 0x2c, 0x14, 0xaa, 0x55, 0x10, 0x6f, 0x19, 0x87,
-	0x36, 0x15, 0xa8, 0x74, 0xf6, 0xa5, 0xda, 0x32, 0xae, 0x7e, 0x2f, 0x73, 0x41, 0x33, 0xd7, 0x91,
-	0x75, 0x16, 0x36, 0x89, 0x7f, 0x61, 0x35, 0xba, 0x0e, 0x12, 0x98, 0xaa, 0x77, 0x44, 0x44, 0x10,
-	0x91, 0x02, 0x18, 0xb6, 0xa6, 0xd8, 0xe2, 0x0b, 0x5b, 0x73, 0x8e, 0x34, 0xb6, 0x46, 0xd6, 0xd9,
-	0x8f, 0x4a, 0xab, 0x68, 0xbe, 0xb6, 0x78, 0x78, 0x44, 0xa4, 0x2c, 0x3a, 0xeb, 0x36, 0x9d, 0xfe,
-	0x5f, 0x7b, 0xa4, 0x81, 0x3e, 0x0d, 0x40, 0x49, 0xfa, 0x00, 0xc5, 0x3f, 0x08, 0xa2, 0x0f, 0x30,
-	0xe3, 0x35, 0xc4, 0x90, 0x35, 0xed, 0x35, 0x9c, 0x21, 0x95, 0xbe, 0x8c, 0xcc, 0xaa, 0xf4, 0xd7,
-	0xa0, 0x85, 0x71, 0xda, 0xe9, 0x8b, 0x88, 0x2c, 0xfc, 0x86, 0x16, 0x92, 0x78, 0x5d, 0x68, 0x23,
-	0x1e, 0xba, 0xa9, 0x01, 0x38, 0x4d, 0x93, 0x54, 0xe4, 0xf2, 0x27, 0xcb, 0x35, 0xc0, 0x76, 0x31,
-	0xf2, 0xff, 0x20, 0x2a, 0x70, 0x31, 0x36, 0x38, 0x61, 0x32, 0xed, 0xbf, 0x40, 0x0e, 0x1a, 0x23,
-	0x21, 0xff, 0x0b, 0x6a, 0x88, 0xff, 0x2b, 0x66, 0xef, 0x17, 0xc5, 0x80, 0x04, 0x3c, 0x93, 0x3e,
-	0x48, 0x26, 0x18, 0xff, 0x7f, 0xba, 0x8a, 0x35, 0xd7, 0x24, 0x97, 0x02, 0x91, 0x8d, 0xd1, 0x97,
-	0xae, 0x47, 0xc5, 0xee, 0x64, 0xf4, 0xe5, 0x9f, 0xd9, 0xcb, 0xa2, 0xab, 0x7b, 0xd7, 0xb2, 0xe8,
-	0x7a, 0xdb, 0xac, 0x24, 0xf2, 0xec, 0x0e, 0x07, 0x78, 0x7c, 0xdf, 0xb5, 0x2c, 0xba, 0x50, 0x54,
-	0xa4, 0x2c, 0x92, 0x77, 0x36, 0xce, 0x9c, 0x79, 0x14, 0xcb, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x17, 0x8d, 0x90, 0x3b, 0x04, 0x73, 0x00, 0x00,
+	// 8403 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x59, 0x70, 0x24, 0xc7,
+	0x75, 0x60, 0x54, 0x1f, 0x38, 0x12, 0x68, 0x0c, 0x26, 0xe7, 0x60, 0x13, 0x1c, 0x0e, 0x31, 0xc5,
+	0x21, 0x39, 0x22, 0xc5, 0xa1, 0x88, 0xa0, 0x78, 0x49, 0xa2, 0x08, 0xa0, 0xe7, 0x68, 0x11, 0x18,
+	0x80, 0xd5, 0xe0, 0xcc, 0xae, 0xa8, 0xd5, 0xb2, 0x80, 0xce, 0x01, 0x8a, 0x68, 0x74, 0x37, 0xab,
+	0x0a, 0x98, 0x01, 0x43, 0x1b, 0xa2, 0xa4, 0x08, 0x6d, 0x68, 0x8f, 0xd8, 0xd8, 0xd8, 0x58, 0x5d,
+	0xbb, 0xab, 0xd5, 0x6a, 0x25, 0xd9, 0x96, 0x2d, 0xcb, 0x92, 0x25, 0xeb, 0x30, 0x25, 0x59, 0x97,
+	0x2d, 0xcb, 0xb2, 0x7c, 0x85, 0xfd, 0xe9, 0x08, 0x7f, 0x5a, 0x61, 0x3b, 0xec, 0x1f, 0x3b, 0x14,
+	0xb6, 0x23, 0x1c, 0xef, 0xe5, 0x5d, 0x95, 0x55, 0x98, 0xa1, 0x34, 0x0a, 0x7f, 0x75, 0xe7, 0x7b,
+	0x79, 0xbc, 0xf7, 0xf2, 0xe5, 0xcb, 0x97, 0x99, 0x2f, 0xb3, 0x08, 0xd9, 0x61, 0x69, 0x78, 0x76,
+	0x18, 0x0f, 0xd2, 0x01, 0xad, 0xe3, 0x8f, 0xff, 0xb9, 0x06, 0xa9, 0xb5, 0xc2, 0x34, 0xa4, 0x94,
+	0xd4, 0xd6, 0x58, 0xbc, 0xd3, 0xf4, 0x66, 0x2b, 0x67, 0x6a, 0x01, 0xfe, 0xa7, 0x47, 0x49, 0xbd,
+	0xdd, 0xef, 0xb2, 0xeb, 0xcd, 0x0a, 0x02, 0x79, 0x82, 0x9e, 0x20, 0xe3, 0x8b, 0xbd, 0xdd, 0x24,
+	0x65, 0x71, 0xbb, 0xd5, 0xac, 0x22, 0x46, 0x03, 0xe8, 0x3d, 0xa4, 0x7e, 0x69, 0xd0, 0x65, 0x49,
+	0xb3, 0x36, 0x5b, 0x3d, 0x33, 0x31, 0x77, 0x88, 0x37, 0x77, 0x16, 0x60, 0xed, 0xfe, 0xd5, 0x41,
+	0xc0, 0xb1, 0xf4, 0x61, 0x32, 0x0e, 0xcd, 0xae, 0x87, 0x09, 0x4b, 0x9a, 0x75, 0xcc, 0x7a, 0x44,
+	0x64, 0x95, 0x70, 0xcc, 0xae, 0x73, 0x41, 0xcd, 0xcf, 0x25, 0x2c, 0x4e, 0x9a, 0x23, 0x56, 0xcd,
+	0x00, 0xe3, 0x35, 0x23, 0x16, 0xc8, 0x5b, 0x0e, 0xaf, 0x63, 0x7b, 0xad, 0xe6, 0x28, 0x27, 0x4f,
+	0x01, 0xe8, 0x19, 0x72, 0x68, 0x39, 0xbc, 0xde, 0xd9, 0x0a, 0xe3, 0xee, 0x85, 0x78, 0xb0, 0x3b,
+	0x6c, 0xb7, 0x9a, 0x63, 0x98, 0x27, 0x0b, 0xa6, 0x27, 0x09, 0x91, 0xa0, 0x76, 0xab, 0x39, 0x8e,
+	0x99, 0x0c, 0x08, 0x7d, 0x90, 0x73, 0xc0, 0x99, 0x25, 0x16, 0x49, 0x12, 0x1e, 0xe8, 0x1c, 0x90,
+	0x7d, 0x99, 0xc9, 0xec, 0x13, 0x6e, 0xd9, 0xe8, 0x1c, 0xd4, 0x27, 0x93, 0x42, 0xa6, 0xab, 0xe9,
+	0xa5, 0xdd, 0x9d, 0xe6, 0xd4, 0x6c, 0xe5, 0x4c, 0x23, 0xb0, 0x60, 0xf4, 0x21, 0x32, 0xb2, 0x9a,
+	0x5e, 0x8e, 0xd8, 0xb5, 0xe6, 0x21, 0xac, 0xef, 0x36, 0xa3, 0xf9, 0xb3, 0x1c, 0x73, 0xae, 0x9f,
+	0xc6, 0xfb, 0x81, 0xc8, 0x06, 0x95, 0x62, 0xc9, 0x55, 0x16, 0x43, 0x2b, 0xcd, 0xe9, 0x59, 0x0f,
+	0x2a, 0x35, 0x61, 0x42, 0x40, 0xd8, 0xd3, 0x52, 0x40, 0x87, 0x95, 0x80, 0x4c, 0xb0, 0x10, 0x10,
+	0x82, 0xda, 0xad, 0x26, 0x55, 0x02, 0x12, 0x10, 0x68, 0x6d, 0x39, 0xbc, 0x7e, 0x6e, 0x8f, 0xf5,
+	0xd3, 0x95, 0x61, 0xbb, 0xdb, 0x3c, 0x32, 0xeb, 0x9d, 0xa9, 0x05, 0x16, 0x0c, 0x5a, 0x5b, 0x0b,
+	0xb7, 0xd9, 0xca, 0x1e, 0x8b, 0xcf, 0xf5, 0xc3, 0xf5, 0x1e, 0xeb, 0x36, 0x8f, 0xce, 0x7a, 0x67,
+	0xc6, 0x82, 0x2c, 0x98, 0xbe, 0x85, 0x34, 0x96, 0xa3, 0xcd, 0x38, 0x4c, 0x19, 0x96, 0x4e, 0x9a,
+	0xc7, 0x2c, 0x9e, 0x4d, 0x1c, 0xca, 0xd2, 0xce, 0x0d, 0x0d, 0x2d, 0x84, 0xbd, 0xb0, 0xbf, 0xa1,
+	0x1b, 0x3a, 0xce, 0x1b, 0xca, 0x80, 0x85, 0x00, 0x5a, 0x83, 0x6b, 0xfd, 0x4e, 0xb8, 0x33, 0xec,
+	0x81, 0x16, 0xdd, 0x86, 0x94, 0x67, 0xc1, 0xf4, 0x01, 0x32, 0xda, 0x49, 0x63, 0x16, 0xee, 0x24,
+	0xcd, 0x26, 0x12, 0x73, 0x58, 0x10, 0xc3, 0xa1, 0x48, 0x86, 0xcc, 0x41, 0x67, 0xc9, 0x04, 0x28,
+	0x0f, 0xc7, 0xb4, 0x9a, 0xb7, 0x63, 0x95, 0x26, 0x48, 0x28, 0xee, 0xe2, 0xa0, 0xdf, 0x6f, 0x77,
+	0x9b, 0x33, 0x88, 0xd7, 0x00, 0xfa, 0x14, 0x99, 0x78, 0x76, 0x97, 0xc5, 0xfb, 0xed, 0x56, 0xbb,
+	0x1f, 0xa5, 0xcd, 0x3b, 0xb0, 0xc1, 0x13, 0x66, 0x8f, 0x1b, 0x68, 0xde, 0xed, 0x66, 0x01, 0xda,
+	0x22, 0x8d, 0x80, 0x0d, 0x7b, 0xd1, 0x46, 0x88, 0xfd, 0x97, 0x34, 0x4f, 0x60, 0x0d, 0x27, 0xcd,
+	0x1a, 0xac, 0x0c, 0xbc, 0x0e, 0xbb, 0x10, 0x7d, 0x3d, 0x39, 0x0c, 0x24, 0xef, 0xae, 0x27, 0x1b,
+	0x71, 0x34, 0x4c, 0xa3, 0x41, 0xbf, 0xdd, 0x6a, 0xde, 0x89, 0xb4, 0xe6, 0x11, 0xf4, 0x34, 0x69,
+	0x00, 0x03, 0xcf, 0x2e, 0x6e, 0x85, 0xfd, 0x4d, 0x10, 0xe4, 0x49, 0xcc, 0x69, 0x03, 0x41, 0x32,
+	0x97, 0x76, 0x77, 0x56, 0xae, 0xe2, 0xc0, 0x4a, 0x9a, 0x77, 0xcd, 0x7a, 0x67, 0xea, 0x81, 0x09,
+	0x82, 0x2e, 0x69, 0x27, 0x9d, 0x67, 0x97, 0xa2, 0x94, 0xc9, 0xce, 0x9b, 0xe5, 0x9d, 0x97, 0x01,
+	0xd3, 0x07, 0xc8, 0x58, 0xe7, 0xa5, 0x1e, 0x1f, 0x64, 0xa7, 0xdc, 0x63, 0x52, 0x65, 0xa0, 0x33,
+	0x64, 0x6c, 0x39, 0xbc, 0xbe, 0x9c, 0xa4, 0xed, 0x56, 0xd3, 0x47, 0xca, 0x54, 0x9a, 0x3e, 0x42,
+	0xc8, 0x7c, 0x8f, 0xc5, 0x69, 0xb0, 0xdb, 0x63, 0x49, 0xf3, 0x6e, 0xac, 0xea, 0xa8, 0xa8, 0x4a,
+	0x21, 0xb0, 0x87, 0x8d, 0x7c, 0xf4, 0x69, 0x32, 0xc5, 0x53, 0x6c, 0x83, 0x45, 0x7b, 0x60, 0xab,
+	0x4e, 0x63, 0xc9, 0xa6, 0x55, 0x52, 0x20, 0xb1, 0x74, 0x26, 0x3f, 0xa8, 0x39, 0x42, 0x3a, 0x51,
+	0x8f, 0xf5, 0x37, 0x58, 0xd2, 0xbc, 0xc7, 0x52, 0x73, 0x13, 0xc7, 0xd5, 0xdc, 0xca, 0x3d, 0xf3,
+	0x36, 0x32, 0x61, 0x0c, 0x7c, 0x3a, 0x4d, 0xaa, 0xdb, 0x6c, 0xbf, 0xe9, 0xcd, 0x7a, 0x67, 0xc6,
+	0x03, 0xf8, 0x0b, 0x46, 0x74, 0x2f, 0xec, 0xed, 0xb2, 0x66, 0x65, 0xd6, 0x33, 0xa5, 0xb3, 0xb0,
+	0xca, 0x87, 0x0d, 0xc7, 0x3e, 0x59, 0x79, 0xdc, 0x9b, 0x79, 0x8a, 0x4c, 0x67, 0x55, 0xca, 0x51,
+	0xe1, 0x51, 0xb3, 0xc2, 0x9a, 0x59, 0xfe, 0x39, 0x42, 0xf3, 0x0a, 0xe5, 0xa8, 0xe1, 0x75, 0x36,
+	0x49, 0x72, 0x1a, 0x10, 0x65, 0x41, 0x95, 0x12, 0xa3, 0x5a, 0xff, 0x4d, 0x64, 0xd2, 0x44, 0xd1,
+	0x07, 0xc8, 0x88, 0xd0, 0x68, 0xcf, 0x9a, 0x46, 0xcc, 0xb6, 0x03, 0x91, 0xc5, 0xff, 0x80, 0xa7,
+	0x4a, 0x23, 0x84, 0x4e, 0x91, 0x4a, 0xbb, 0x85, 0x93, 0x5e, 0x23, 0xa8, 0xb4, 0x5b, 0x5c, 0x27,
+	0xc4, 0xdc, 0x56, 0x41, 0xa8, 0x4a, 0xd3, 0x53, 0xa4, 0xbe, 0xca, 0xa0, 0x53, 0xab, 0xd8, 0xd0,
+	0x84, 0x68, 0x08, 0x60, 0x01, 0xc7, 0xd0, 0xe3, 0x64, 0xa4, 0x93, 0x86, 0xe9, 0x2e, 0x4c, 0x7f,
+	0x50, 0x58, 0xa4, 0xd4, 0xec, 0x5a, 0xd7, 0xb3, 0xab, 0x7f, 0x3f, 0xa9, 0x41, 0xa1, 0x1c, 0x09,
+	0x94, 0xd4, 0x82, 0x41, 0x8f, 0x89, 0xe6, 0xf1, 0xbf, 0x7f, 0x8a, 0x8c, 0xae, 0xa6, 0x2b, 0xd7,
+	0xfa, 0x2c, 0x86, 0x26, 0xc4, 0xe4, 0xc6, 0xa7, 0x6a, 0x91, 0xf2, 0x5f, 0xf1, 0x60, 0x3a, 0x80,
+	0x4e, 0xa4, 0xa7, 0x49, 0x1d, 0xf3, 0x62, 0x8e, 0x89, 0xb9, 0x29, 0x49, 0x28, 0xaf, 0x21, 0xa8,
+	0xab, 0x8a, 0x04, 0xad, 0x95, 0x2c, 0xad, 0xab, 0x69, 0xbb, 0x8b, 0x53, 0x7b, 0x23, 0xc0, 0xff,
+	0xd0, 0x6b, 0x97, 0x59, 0xdc, 0xac, 0x61, 0x1f, 0xc3, 0x5f, 0xa4, 0xf2, 0x42, 0xbb, 0xd5, 0xac,
+	0xe3, 0x1c, 0x82, 0xff, 0xfd, 0x07, 0xc9, 0x98, 0x54, 0x24, 0x7a, 0x8a, 0xd4, 0x5a, 0xeb, 0xab,
+	0xa9, 0xe8, 0x94, 0x86, 0x22, 0x01, 0xb5, 0x0c, 0x51, 0xfe, 0xdf, 0x78, 0x64, 0x4c, 0xce, 0x7d,
+	0x86, 0x14, 0x6a, 0x52, 0x0a, 0x17, 0x07, 0x49, 0x8a, 0xb4, 0x8d, 0x07, 0xf8, 0x9f, 0x36, 0xc9,
+	0x68, 0xb0, 0xba, 0x38, 0xdf, 0xed, 0xc6, 0xd8, 0xec, 0x78, 0x20, 0x93, 0x80, 0x59, 0x5b, 0x5c,
+	0xc5, 0x02, 0x55, 0x8e, 0x11, 0xc9, 0x4c, 0x8f, 0x54, 0x15, 0x97, 0x47, 0x49, 0x7d, 0x69, 0x2d,
+	0xda, 0x61, 0xcd, 0x11, 0xee, 0xdb, 0x60, 0x02, 0xe6, 0xb4, 0x0b, 0x83, 0x24, 0x89, 0x86, 0xd8,
+	0xc8, 0x28, 0xb6, 0x6d, 0x40, 0xc0, 0x12, 0x75, 0xd8, 0x66, 0xcc, 0x36, 0xc3, 0x94, 0x89, 0x6a,
+	0xc7, 0xf8, 0xe4, 0x90, 0x01, 0xab, 0x5e, 0x24, 0x48, 0x0e, 0xef, 0xc5, 0x5d, 0x32, 0x26, 0xcd,
+	0x10, 0xbd, 0x8b, 0x54, 0x2e, 0x45, 0xa2, 0x83, 0x72, 0x8e, 0x40, 0xe5, 0x52, 0x04, 0x84, 0xa3,
+	0xe9, 0x6f, 0x89, 0x91, 0x25, 0x52, 0x60, 0x2e, 0xe7, 0x7b, 0xd1, 0x1e, 0x13, 0xc8, 0x2a, 0x9f,
+	0x48, 0x0c, 0x10, 0x88, 0x72, 0xfe, 0x65, 0xec, 0xab, 0xf1, 0xa0, 0x32, 0xff, 0xb2, 0xff, 0x85,
+	0x2a, 0x99, 0x34, 0x9d, 0x2a, 0xa0, 0xed, 0x52, 0xb8, 0xc3, 0xb0, 0xf5, 0xf1, 0x00, 0xff, 0xd3,
+	0x47, 0xc9, 0xf1, 0x16, 0xbb, 0x1a, 0xee, 0xf6, 0xd2, 0x80, 0xa5, 0xac, 0x0f, 0x63, 0x6b, 0x75,
+	0xd0, 0x8b, 0x36, 0xf6, 0x45, 0x0f, 0x14, 0x60, 0xe9, 0x45, 0x72, 0xd8, 0x06, 0x45, 0x4c, 0x0e,
+	0x90, 0x19, 0x35, 0x12, 0xad, 0x22, 0xc8, 0x61, 0xbe, 0x10, 0xd4, 0xb4, 0x38, 0xe8, 0xa7, 0x51,
+	0x7f, 0x77, 0xb0, 0x9b, 0x80, 0xe5, 0x89, 0x94, 0x17, 0x29, 0x6b, 0xb2, 0xf1, 0xa2, 0xa6, 0x5c,
+	0x21, 0x3e, 0xd7, 0xc6, 0xdb, 0x2d, 0xd6, 0x63, 0x29, 0xeb, 0xa2, 0xae, 0x8c, 0x05, 0x26, 0x88,
+	0x3e, 0x44, 0xc6, 0x70, 0x6e, 0x79, 0x86, 0xed, 0x37, 0x47, 0x2c, 0xb3, 0x23, 0xc1, 0x58, 0xb7,
+	0xca, 0x44, 0xef, 0x25, 0x53, 0x7c, 0x8e, 0x59, 0x0b, 0x37, 0xe7, 0xe3, 0x38, 0xdc, 0x6f, 0x8e,
+	0x62, 0xad, 0x19, 0x28, 0xd8, 0x0f, 0x61, 0x5f, 0x2e, 0xa1, 0x66, 0x54, 0x03, 0x95, 0x06, 0x7f,
+	0x61, 0x05, 0xa7, 0x46, 0x70, 0x5e, 0x3c, 0xc3, 0x5f, 0x58, 0x59, 0x4f, 0x04, 0x22, 0x90, 0x39,
+	0xfc, 0x2f, 0x79, 0xe4, 0x48, 0x46, 0x70, 0x9d, 0x21, 0xdb, 0x30, 0xfa, 0xce, 0x53, 0x7d, 0x37,
+	0x43, 0xc6, 0x5a, 0xbb, 0x31, 0xda, 0x43, 0x54, 0x96, 0x6a, 0xa0, 0xd2, 0xf4, 0x2c, 0xa1, 0xda,
+	0xad, 0x55, 0xb9, 0xaa, 0x98, 0xcb, 0x81, 0xb1, 0x18, 0xa8, 0xe1, 0xd8, 0xd6, 0x0c, 0xf8, 0x64,
+	0xf2, 0x4a, 0x18, 0xef, 0xa8, 0x5a, 0xea, 0x58, 0x8b, 0x05, 0xf3, 0x7f, 0x32, 0x42, 0x0e, 0x2d,
+	0xb3, 0x30, 0xd9, 0x8d, 0xd9, 0x8e, 0xf0, 0xc5, 0x9c, 0xfa, 0xf6, 0x30, 0x19, 0x97, 0xc2, 0x05,
+	0x03, 0x54, 0x2d, 0xea, 0x02, 0x9d, 0x8b, 0x3e, 0x49, 0x46, 0x3a, 0x1b, 0x5b, 0x6c, 0x27, 0x14,
+	0xfa, 0xe5, 0x4b, 0xdf, 0xcf, 0x6e, 0xee, 0x2c, 0xcf, 0x24, 0x5c, 0x5f, 0x9e, 0xc8, 0xaa, 0x44,
+	0x2d, 0xaf, 0x12, 0x4f, 0x92, 0x46, 0x04, 0x9e, 0x6b, 0xc0, 0x7a, 0x9a, 0x3b, 0x3d, 0xe9, 0xb7,
+	0x4d, 0x5c, 0x60, 0x67, 0x05, 0xb3, 0x71, 0xae, 0xbf, 0x19, 0xf5, 0xd9, 0xda, 0xfe, 0x90, 0xa1,
+	0x42, 0x35, 0x02, 0x03, 0x42, 0x1f, 0x23, 0x93, 0x8b, 0x83, 0x5e, 0x27, 0x1d, 0xc4, 0x38, 0x00,
+	0x51, 0x77, 0x34, 0xbf, 0x26, 0x2a, 0xb0, 0x32, 0xd2, 0x87, 0x09, 0xd1, 0xca, 0x81, 0x0a, 0xe5,
+	0xd4, 0x1a, 0x23, 0x13, 0x3d, 0x4f, 0x08, 0x5f, 0xa2, 0x74, 0xaf, 0xb3, 0xa4, 0x39, 0x8e, 0x92,
+	0xba, 0xb7, 0x48, 0x52, 0x2a, 0x23, 0x97, 0x96, 0x51, 0x12, 0x9d, 0xae, 0x7e, 0x94, 0x9a, 0xae,
+	0x19, 0x41, 0xd7, 0x2c, 0x0b, 0x16, 0xa6, 0x7b, 0x02, 0x0d, 0x51, 0x05, 0xd7, 0x58, 0x19, 0x3d,
+	0x97, 0x13, 0x50, 0x56, 0xc9, 0xe9, 0xf3, 0xe4, 0x30, 0xef, 0x9f, 0xe7, 0x12, 0x76, 0x7e, 0x10,
+	0x2f, 0xf6, 0x58, 0xd8, 0x6f, 0x1e, 0x47, 0x92, 0x1f, 0x2c, 0xed, 0x5c, 0x23, 0x3f, 0xa7, 0x3c,
+	0x5f, 0x0f, 0xcc, 0x59, 0x6b, 0x6b, 0x4b, 0xe8, 0xbc, 0x57, 0x03, 0xf8, 0x3b, 0xf3, 0x04, 0x99,
+	0x30, 0x74, 0xe3, 0x20, 0x67, 0xa6, 0x6e, 0x3a, 0x33, 0xcf, 0x90, 0x43, 0x19, 0x61, 0x99, 0xc5,
+	0x6b, 0xbc, 0xb8, 0x6f, 0x7b, 0x32, 0x93, 0x52, 0x75, 0xa0, 0x8c, 0x59, 0xd9, 0x65, 0x72, 0xdc,
+	0xcd, 0x86, 0x83, 0xa4, 0x7b, 0xed, 0x3a, 0xa7, 0xe5, 0x18, 0xc1, 0xf2, 0x97, 0xc3, 0x9e, 0xe9,
+	0x1a, 0x3d, 0x46, 0xc6, 0x15, 0x1c, 0xd9, 0xdf, 0x1f, 0xe2, 0x98, 0xab, 0x07, 0xf0, 0x17, 0x26,
+	0xc9, 0x73, 0xfd, 0x2e, 0x4e, 0x7a, 0x9c, 0x3f, 0x99, 0xf4, 0xff, 0xbe, 0x9e, 0x33, 0x36, 0x85,
+	0x03, 0xd7, 0x36, 0x36, 0x95, 0x1b, 0x32, 0x36, 0x95, 0x1b, 0x32, 0x36, 0x15, 0xcb, 0xd8, 0x3c,
+	0x49, 0x26, 0x8d, 0xbe, 0x97, 0x9b, 0x04, 0xc7, 0xdd, 0x6a, 0x11, 0x58, 0x79, 0xe9, 0x32, 0x99,
+	0x58, 0x4e, 0xd2, 0xcb, 0x2c, 0x4e, 0x50, 0x0b, 0xa7, 0xb0, 0xe8, 0x03, 0xc5, 0xd3, 0xd1, 0x59,
+	0x23, 0xb7, 0x58, 0x3b, 0x19, 0x10, 0xfa, 0x18, 0x99, 0xd0, 0xc4, 0xcb, 0xfd, 0x87, 0x63, 0xa6,
+	0xb5, 0xe2, 0x6b, 0x62, 0x20, 0xc4, 0xcc, 0x09, 0xde, 0xbc, 0xb9, 0x24, 0x4a, 0x9a, 0xa3, 0x96,
+	0x37, 0x6f, 0x2d, 0x97, 0xd0, 0x9b, 0xb7, 0x72, 0x67, 0x8d, 0xd6, 0x58, 0xde, 0x68, 0xcd, 0x92,
+	0x89, 0x8b, 0x83, 0x54, 0x49, 0x7a, 0x1c, 0x25, 0x6d, 0x82, 0x72, 0x36, 0x9b, 0x60, 0x16, 0x0b,
+	0x06, 0xdd, 0xa6, 0x57, 0xf6, 0x2a, 0xe7, 0x04, 0xef, 0xb6, 0x3c, 0x06, 0xe4, 0xa1, 0xa1, 0x49,
+	0x73, 0xd2, 0x92, 0x87, 0xb1, 0x47, 0x80, 0xf2, 0x30, 0x72, 0xd2, 0x15, 0x72, 0x54, 0xaf, 0xa0,
+	0xb5, 0xf8, 0x9b, 0x0d, 0xd4, 0xed, 0x3b, 0xe4, 0x62, 0xc4, 0x91, 0x25, 0x70, 0x16, 0x84, 0x35,
+	0x4a, 0xb6, 0xeb, 0x0e, 0x1a, 0xd6, 0x0d, 0x73, 0xc4, 0x84, 0xe4, 0x88, 0xc3, 0xa7, 0x70, 0xea,
+	0xfd, 0x51, 0x52, 0xc7, 0x0c, 0xc2, 0x1f, 0xe2, 0x09, 0xe8, 0x80, 0xa5, 0x30, 0x49, 0x83, 0xdd,
+	0x3e, 0x8e, 0x2b, 0x3e, 0xaf, 0x9a, 0x20, 0xff, 0x9f, 0x3c, 0x32, 0x65, 0xeb, 0x48, 0xce, 0xd7,
+	0x3d, 0x41, 0xc6, 0x3b, 0x69, 0x18, 0xa7, 0x62, 0x68, 0x82, 0xd8, 0x35, 0xc0, 0x1c, 0xb6, 0x7c,
+	0x24, 0xc9, 0x24, 0x94, 0x13, 0x8a, 0x30, 0x9f, 0x0a, 0xf7, 0x56, 0x03, 0xe8, 0x19, 0x32, 0x22,
+	0xec, 0x36, 0x1f, 0x3a, 0xd3, 0xa6, 0xc2, 0xa2, 0x4c, 0x05, 0x1e, 0x98, 0x58, 0x8b, 0x77, 0xfb,
+	0x1b, 0x21, 0xaf, 0x69, 0x84, 0x33, 0x61, 0x80, 0x32, 0x13, 0xdc, 0x68, 0x6e, 0x82, 0x6b, 0x92,
+	0xd1, 0x3d, 0xde, 0x09, 0xcd, 0x49, 0x44, 0xca, 0xa4, 0xff, 0xa1, 0x8a, 0x98, 0xe8, 0x9d, 0x9c,
+	0x9f, 0x24, 0x63, 0xb8, 0x18, 0x69, 0xb7, 0xb8, 0x13, 0xd0, 0x58, 0xa8, 0x34, 0xbd, 0x40, 0xc1,
+	0xa0, 0x2f, 0x97, 0x23, 0x6e, 0x41, 0xc6, 0x03, 0xf8, 0x8b, 0x90, 0xf0, 0x3a, 0x72, 0x0b, 0x90,
+	0xf0, 0x3a, 0xae, 0xad, 0x22, 0x16, 0xab, 0xb5, 0x55, 0xc4, 0x70, 0x3d, 0x20, 0x37, 0xa6, 0xb8,
+	0x7f, 0x2f, 0x93, 0x30, 0xad, 0x69, 0x4d, 0x5a, 0x62, 0x7b, 0xac, 0x87, 0x6e, 0x7e, 0x35, 0xc8,
+	0x82, 0x61, 0xe4, 0x58, 0xbb, 0x40, 0xdc, 0xd1, 0xb7, 0x60, 0xdc, 0x80, 0x85, 0xdd, 0x95, 0x7e,
+	0x6f, 0xbf, 0x39, 0x8e, 0xc3, 0x53, 0xa5, 0xf9, 0xfe, 0x98, 0x1c, 0xaa, 0x38, 0x77, 0x8e, 0x05,
+	0x06, 0xc4, 0x0f, 0xc8, 0xa4, 0xe9, 0xe9, 0x40, 0x5d, 0xca, 0x27, 0x85, 0x55, 0xd3, 0xb8, 0xe1,
+	0x7e, 0x02, 0x8f, 0x20, 0xf9, 0x0a, 0xf7, 0xfa, 0x50, 0xe6, 0x94, 0xd4, 0x3a, 0x9b, 0x6a, 0x05,
+	0x80, 0xff, 0xfd, 0xdb, 0x49, 0x9d, 0xcf, 0xde, 0xd3, 0xa4, 0xda, 0xee, 0x5e, 0xc7, 0x7a, 0xea,
+	0x01, 0xfc, 0xf5, 0xdf, 0x49, 0xa6, 0xb3, 0xf6, 0xc6, 0xa9, 0xe7, 0x94, 0xd4, 0x96, 0x07, 0x5d,
+	0x26, 0x17, 0x5e, 0xf0, 0x1f, 0x45, 0xc1, 0x92, 0x34, 0xea, 0xf3, 0x35, 0x37, 0xfa, 0x5f, 0xe3,
+	0x81, 0x05, 0xf3, 0x4f, 0x0b, 0xbf, 0xa3, 0x7c, 0x95, 0xfa, 0x41, 0x8f, 0x8c, 0xc9, 0x1d, 0xdb,
+	0xa2, 0xe6, 0x2f, 0x86, 0xc9, 0x96, 0x5a, 0xf7, 0x85, 0xc9, 0x16, 0x0c, 0xbd, 0xf9, 0xee, 0x8e,
+	0xd0, 0x83, 0xb1, 0x80, 0x27, 0xa0, 0x89, 0xe0, 0x1a, 0xd4, 0x25, 0xbc, 0x39, 0x91, 0xa2, 0x8f,
+	0x10, 0xb2, 0x1a, 0x47, 0x7b, 0x51, 0x8f, 0x6d, 0xaa, 0xbd, 0xe5, 0xa3, 0xc6, 0x66, 0xb1, 0x42,
+	0x06, 0x46, 0x3e, 0xbf, 0x4d, 0x1a, 0x16, 0x12, 0xe7, 0x39, 0xb1, 0x68, 0x12, 0x04, 0xaa, 0x34,
+	0x0c, 0x3c, 0x95, 0x11, 0x29, 0xad, 0x07, 0x1a, 0xe0, 0xbf, 0xea, 0x91, 0x86, 0xe5, 0x2e, 0x42,
+	0x6f, 0x04, 0x51, 0x57, 0xac, 0xf1, 0xe1, 0x2f, 0x40, 0x56, 0xa2, 0x2e, 0xd7, 0xf9, 0x00, 0xfe,
+	0x42, 0x9d, 0x58, 0x08, 0x25, 0xc2, 0x05, 0xac, 0x01, 0xf4, 0x0d, 0x84, 0x60, 0x62, 0x29, 0x4a,
+	0x52, 0xb9, 0x2a, 0x9a, 0x36, 0x2d, 0x2e, 0x20, 0x02, 0x23, 0x0f, 0xf8, 0x9c, 0x98, 0x92, 0xae,
+	0x98, 0xbd, 0xc9, 0x6e, 0xa2, 0x02, 0x2b, 0xa3, 0x7f, 0x4a, 0x10, 0x02, 0xd5, 0xe0, 0x11, 0x00,
+	0xfc, 0x11, 0x1a, 0xc9, 0x13, 0x7e, 0x97, 0x34, 0x83, 0xa1, 0x39, 0xe3, 0x9e, 0x8f, 0x58, 0xaf,
+	0x9b, 0x60, 0xa7, 0x5e, 0x24, 0xd3, 0x99, 0xc9, 0x59, 0xee, 0xcc, 0x9c, 0xc8, 0xcf, 0xdd, 0xba,
+	0x5c, 0x90, 0x2b, 0xe5, 0x0f, 0xc8, 0x31, 0x67, 0x56, 0x18, 0xdd, 0xcb, 0x49, 0x6a, 0xa8, 0x8e,
+	0x4c, 0xd2, 0x37, 0x13, 0x02, 0x63, 0x83, 0xe7, 0x15, 0xcb, 0x0a, 0x47, 0xb3, 0x3a, 0x4f, 0x60,
+	0xe4, 0xf7, 0x17, 0xad, 0x06, 0x35, 0x02, 0x54, 0x4d, 0x54, 0xc9, 0xc5, 0x20, 0x52, 0xc6, 0xb0,
+	0x04, 0x0b, 0x82, 0xff, 0xfd, 0x6f, 0x55, 0x08, 0xd1, 0x1b, 0xc0, 0x4e, 0x1d, 0xe7, 0x56, 0xb0,
+	0xa2, 0xac, 0xe0, 0x23, 0x64, 0xa4, 0x13, 0x6f, 0x2c, 0xe3, 0xe6, 0x45, 0xc5, 0xa0, 0x98, 0x57,
+	0x93, 0x75, 0x75, 0x44, 0x5e, 0x28, 0xd5, 0x62, 0x09, 0x94, 0xaa, 0xdd, 0x48, 0x29, 0x9e, 0x17,
+	0xd4, 0xba, 0xdd, 0x4f, 0x59, 0xbc, 0x17, 0xf6, 0xd0, 0x62, 0x56, 0x03, 0x95, 0x86, 0xce, 0x6e,
+	0xb1, 0x5e, 0xb8, 0x8f, 0x36, 0xb3, 0x1a, 0xf0, 0x04, 0x70, 0xd0, 0x8a, 0x76, 0xb8, 0xef, 0x32,
+	0x1e, 0xe0, 0x7f, 0x7a, 0x1f, 0xa9, 0x2f, 0x86, 0xbd, 0x1e, 0x2c, 0x49, 0xf2, 0x1b, 0xdf, 0x80,
+	0x09, 0x38, 0x1e, 0x0a, 0x2f, 0x0e, 0xfa, 0x5d, 0x34, 0x8e, 0xe3, 0x01, 0xfe, 0x87, 0xe9, 0xa6,
+	0x9d, 0x74, 0x58, 0x8f, 0x6d, 0xa4, 0xf3, 0xbd, 0x9e, 0xb0, 0x8c, 0x26, 0xc8, 0x7f, 0x94, 0x4c,
+	0x68, 0x11, 0x62, 0x6b, 0xa6, 0x1e, 0x39, 0xb6, 0xd9, 0x39, 0xde, 0x7f, 0x89, 0x1c, 0x73, 0x72,
+	0x5f, 0xe8, 0xc8, 0xca, 0x01, 0x5e, 0xc9, 0x0c, 0xf0, 0x33, 0xe4, 0x50, 0x76, 0x1b, 0x84, 0xcf,
+	0x41, 0x59, 0xb0, 0xbf, 0x24, 0x7b, 0x1b, 0xf8, 0x45, 0x76, 0xc3, 0x5e, 0x4f, 0xb6, 0x83, 0xb0,
+	0xa3, 0xa4, 0x8e, 0xea, 0x22, 0x1d, 0x07, 0x4c, 0xa0, 0x4d, 0xeb, 0x45, 0x61, 0x22, 0xea, 0xe5,
+	0x09, 0xff, 0xc7, 0x9e, 0xbd, 0x52, 0x84, 0x49, 0x64, 0x35, 0x8e, 0x76, 0xc2, 0x78, 0x5f, 0x4f,
+	0x0b, 0x06, 0x04, 0x86, 0x42, 0x67, 0x10, 0xa7, 0x80, 0xac, 0x20, 0x52, 0x26, 0x41, 0xca, 0xab,
+	0xf1, 0x60, 0xc8, 0xe2, 0x14, 0x8b, 0x72, 0x8b, 0x62, 0x82, 0xe8, 0x69, 0xd2, 0x90, 0xc9, 0xcb,
+	0xe8, 0x1e, 0xd5, 0x30, 0x8f, 0x0d, 0xa4, 0x6f, 0x20, 0x47, 0xc0, 0xd9, 0x10, 0x27, 0x4f, 0x99,
+	0xb5, 0xbf, 0x0b, 0x45, 0xef, 0x25, 0x53, 0x8b, 0x83, 0x9d, 0x61, 0xb8, 0x01, 0x29, 0xb5, 0x22,
+	0xae, 0x07, 0x19, 0xa8, 0x7f, 0x4d, 0xb8, 0x91, 0xdc, 0xf0, 0xc0, 0x20, 0x5b, 0x1b, 0x6c, 0xb3,
+	0x7e, 0x22, 0x5c, 0x37, 0x91, 0x02, 0x11, 0xe0, 0xbf, 0xe8, 0x65, 0x16, 0x27, 0x62, 0x06, 0x34,
+	0x20, 0x45, 0x04, 0x56, 0x0b, 0x09, 0xf4, 0x1f, 0xb7, 0x4d, 0x23, 0x3d, 0x63, 0xeb, 0x17, 0xcd,
+	0xdb, 0x48, 0xa9, 0x60, 0x7f, 0x79, 0x84, 0x8c, 0x2e, 0x0e, 0x76, 0x76, 0xc2, 0x7e, 0x97, 0xde,
+	0x47, 0x6a, 0x29, 0x30, 0x07, 0x7d, 0x3d, 0x65, 0x2c, 0xe6, 0x11, 0x7b, 0x16, 0x38, 0x0c, 0x30,
+	0x83, 0xff, 0x99, 0x23, 0xdc, 0x4c, 0xd0, 0xdb, 0xc9, 0xb1, 0xc5, 0x98, 0x85, 0x29, 0x93, 0x7a,
+	0x26, 0x32, 0x4f, 0x57, 0xe9, 0x6d, 0xe4, 0x48, 0x2b, 0x1e, 0x0c, 0xb3, 0x88, 0x1a, 0x9d, 0x25,
+	0x27, 0x78, 0x99, 0x8c, 0xe2, 0xc9, 0x1c, 0x75, 0x7a, 0x92, 0xcc, 0x40, 0xd1, 0x02, 0xfc, 0x08,
+	0x3d, 0x4d, 0x66, 0x3b, 0x2c, 0x75, 0x6f, 0xdf, 0xc9, 0x5c, 0xa3, 0xd0, 0xce, 0x73, 0xc3, 0x6e,
+	0x71, 0x3b, 0x63, 0xf4, 0x0e, 0x72, 0x1b, 0xa7, 0x44, 0x7b, 0xb3, 0x12, 0x39, 0x0e, 0x48, 0xee,
+	0xd6, 0xe4, 0x91, 0x84, 0x1e, 0x23, 0x87, 0x79, 0x49, 0x98, 0x61, 0x25, 0xb8, 0x41, 0x8f, 0x90,
+	0x43, 0x40, 0xb8, 0x09, 0x9c, 0x82, 0xbc, 0x9c, 0x0e, 0x13, 0x7c, 0x08, 0xe4, 0xd3, 0x61, 0xa9,
+	0x9a, 0x63, 0x25, 0x62, 0x9a, 0x52, 0x32, 0x05, 0xdc, 0x85, 0x69, 0x28, 0x61, 0x87, 0xe9, 0x09,
+	0xd2, 0xec, 0xb0, 0x14, 0xbd, 0x84, 0x5c, 0x09, 0x4a, 0xef, 0x24, 0xb7, 0x0b, 0x3e, 0x0c, 0x77,
+	0x48, 0xa2, 0x8f, 0x21, 0x27, 0xf1, 0x60, 0xe8, 0x42, 0x1e, 0xd7, 0x3d, 0x28, 0x4f, 0x6a, 0x25,
+	0xaa, 0x69, 0x77, 0xae, 0x89, 0xba, 0x1d, 0x50, 0x9c, 0xa7, 0x2c, 0x6a, 0x06, 0x50, 0x5c, 0x6e,
+	0xd9, 0x0a, 0xef, 0xd0, 0xa8, 0x6c, 0xa9, 0x13, 0xf4, 0x38, 0xa1, 0x1d, 0x96, 0x66, 0x8b, 0xdc,
+	0x49, 0x8f, 0x92, 0x69, 0xa4, 0x1d, 0xfa, 0x40, 0x42, 0x4f, 0x02, 0xc3, 0xe8, 0x76, 0x0a, 0xdd,
+	0xe2, 0x95, 0x4a, 0xf4, 0x5d, 0xc0, 0x30, 0xa7, 0x4e, 0xbb, 0x6f, 0x12, 0x79, 0x37, 0x28, 0x0f,
+	0x94, 0xcd, 0x28, 0x85, 0x5d, 0xc5, 0x7d, 0x20, 0x70, 0x29, 0x16, 0x65, 0x77, 0x25, 0xf6, 0x61,
+	0xa0, 0x6a, 0xbe, 0x97, 0xb2, 0x58, 0x7a, 0xb3, 0x8b, 0x3b, 0xdd, 0xe9, 0x39, 0xe8, 0xe8, 0x80,
+	0x37, 0x19, 0xf5, 0x37, 0x65, 0xe6, 0x47, 0xa0, 0xa3, 0x05, 0x35, 0xb8, 0x93, 0x21, 0x11, 0x6f,
+	0x04, 0x44, 0xc0, 0x86, 0x83, 0x38, 0xe5, 0x8b, 0x16, 0x89, 0x78, 0x14, 0x84, 0xb1, 0x1a, 0xef,
+	0xf6, 0x19, 0x5f, 0x63, 0x4a, 0xf8, 0x13, 0xa0, 0xd1, 0x40, 0xba, 0x41, 0x92, 0x4d, 0xf6, 0x93,
+	0x74, 0x86, 0x1c, 0x07, 0x71, 0x39, 0x88, 0x7e, 0x13, 0x10, 0x0d, 0xa6, 0x23, 0x08, 0xfb, 0x5a,
+	0x77, 0xde, 0x4c, 0x9b, 0xe4, 0x28, 0x36, 0x2f, 0x4d, 0x89, 0xc4, 0xbc, 0x45, 0x0f, 0x00, 0xbd,
+	0xde, 0x95, 0xc8, 0xa7, 0x60, 0x88, 0x1a, 0x22, 0x06, 0x53, 0x02, 0xab, 0x14, 0x89, 0x7f, 0xab,
+	0xee, 0x02, 0xe8, 0x4e, 0x7e, 0x80, 0x20, 0x91, 0x4f, 0x03, 0x7f, 0x5c, 0xb8, 0x78, 0x94, 0x2d,
+	0xe1, 0xf3, 0x00, 0xe7, 0x85, 0x2c, 0xf8, 0x82, 0x96, 0x20, 0x3f, 0x6c, 0x91, 0x88, 0x45, 0x28,
+	0x10, 0xb0, 0x9d, 0xc1, 0x9e, 0x5d, 0xa0, 0x45, 0x4f, 0x91, 0x3b, 0x85, 0xe6, 0x66, 0x96, 0xd8,
+	0x32, 0xcb, 0x39, 0x7a, 0x17, 0xb9, 0x03, 0xcd, 0x53, 0x41, 0x86, 0xf3, 0xc0, 0xe1, 0x05, 0x96,
+	0x16, 0xe1, 0x2f, 0x18, 0xa3, 0x63, 0x9d, 0x1f, 0x50, 0x4a, 0xd4, 0x45, 0xfa, 0x3a, 0x72, 0xcf,
+	0x05, 0x50, 0x66, 0x6b, 0xc6, 0xbe, 0x12, 0xa5, 0x5b, 0x11, 0xd4, 0xc5, 0x02, 0x25, 0xc7, 0x36,
+	0x68, 0xa3, 0x21, 0x47, 0x63, 0x25, 0x66, 0xf0, 0xf9, 0x36, 0x10, 0x00, 0x74, 0xfc, 0x5a, 0xb8,
+	0xcd, 0x06, 0x7b, 0x5a, 0xcc, 0xcf, 0x48, 0x84, 0x3c, 0xf1, 0x97, 0x88, 0x25, 0x40, 0x08, 0x93,
+	0xc0, 0xa7, 0x72, 0x81, 0x58, 0x06, 0x25, 0xc5, 0x01, 0x65, 0x81, 0x2f, 0x51, 0x9f, 0x9c, 0xcc,
+	0x93, 0x8c, 0x93, 0xb6, 0xcc, 0xb3, 0x02, 0x1c, 0x5f, 0x66, 0x71, 0x74, 0x75, 0x3f, 0x3b, 0x7c,
+	0x57, 0xa1, 0xb9, 0x73, 0xd7, 0x87, 0x61, 0xbf, 0x6b, 0xab, 0xec, 0xb3, 0xa0, 0x90, 0xb2, 0xeb,
+	0xc4, 0x9e, 0x86, 0xc4, 0x05, 0x50, 0x1f, 0x48, 0x78, 0x61, 0x21, 0x8e, 0xd8, 0x55, 0x93, 0xe1,
+	0x8e, 0x10, 0xbe, 0xe9, 0x8f, 0x9b, 0xf8, 0x35, 0x18, 0x09, 0x01, 0xdb, 0x8c, 0x60, 0x0e, 0x14,
+	0x27, 0xba, 0x2b, 0x57, 0xaf, 0x26, 0x4c, 0xa9, 0xc0, 0x73, 0x7a, 0x96, 0xc9, 0xec, 0x86, 0xc8,
+	0x1c, 0x97, 0xd1, 0xa6, 0xbe, 0xd4, 0x9b, 0x03, 0x9b, 0x73, 0x91, 0x85, 0x71, 0xba, 0xce, 0x42,
+	0x55, 0xfe, 0x0a, 0x96, 0xb7, 0x4b, 0xf2, 0xb1, 0x2a, 0x73, 0xfc, 0x1b, 0x21, 0xb2, 0x4c, 0xa6,
+	0x25, 0x66, 0xcc, 0x75, 0xff, 0x56, 0xce, 0x64, 0x05, 0x34, 0xbc, 0x1d, 0xb4, 0xf0, 0xd2, 0x20,
+	0x8d, 0xae, 0xee, 0x2f, 0x3e, 0xcb, 0x4b, 0x62, 0x08, 0x81, 0xb2, 0x74, 0xcf, 0x83, 0x26, 0x77,
+	0x58, 0x8a, 0x83, 0xc8, 0x3e, 0x8e, 0x93, 0x59, 0xde, 0xc1, 0xcd, 0x0e, 0x0c, 0x02, 0xb3, 0x4b,
+	0xfe, 0x1d, 0xb0, 0x27, 0xa7, 0x3f, 0x75, 0xb6, 0x2c, 0xb1, 0xef, 0x04, 0x0b, 0xaa, 0xc7, 0xe7,
+	0xda, 0xce, 0x10, 0xc7, 0xb8, 0x44, 0xff, 0x7b, 0xb0, 0x0a, 0x42, 0x7d, 0x78, 0x68, 0x81, 0xc4,
+	0xbc, 0x60, 0x0c, 0x7c, 0x8e, 0xb1, 0xa9, 0x09, 0x61, 0x48, 0xb6, 0xfb, 0x09, 0x8b, 0xd3, 0xf3,
+	0x51, 0x8f, 0x29, 0xf8, 0xba, 0x26, 0xc7, 0x61, 0x9b, 0x18, 0xc8, 0x41, 0x62, 0xb9, 0x6a, 0xd9,
+	0xd5, 0x5e, 0xc5, 0xf9, 0x61, 0x6b, 0x70, 0x4d, 0xf8, 0x3d, 0x12, 0xbe, 0x09, 0x84, 0x22, 0xe9,
+	0x59, 0xf3, 0xb5, 0xa5, 0x09, 0xe5, 0xfb, 0x24, 0x19, 0x0b, 0x15, 0x81, 0x66, 0xa2, 0x0d, 0x37,
+	0xd7, 0x47, 0x6b, 0x4b, 0x60, 0xc9, 0x5f, 0x04, 0x1c, 0x67, 0x5f, 0x45, 0x42, 0xc8, 0x72, 0xdb,
+	0xd0, 0x22, 0x74, 0x69, 0x0e, 0xd3, 0x83, 0x16, 0xcd, 0x52, 0x22, 0x02, 0x42, 0xe2, 0x77, 0x40,
+	0x04, 0xba, 0x64, 0x06, 0xdb, 0xd7, 0x93, 0xb8, 0x19, 0xff, 0x20, 0xd1, 0x03, 0x39, 0x89, 0xbb,
+	0x90, 0x43, 0x40, 0x72, 0x44, 0xca, 0xfb, 0xdb, 0xd0, 0xd3, 0x97, 0xee, 0x1f, 0x1b, 0xeb, 0x4e,
+	0xbf, 0xf2, 0xca, 0x2b, 0xaf, 0x54, 0xfc, 0x3f, 0xad, 0x14, 0xb8, 0x6b, 0xce, 0xd5, 0x44, 0x2b,
+	0xbf, 0x62, 0xe0, 0x3b, 0xf6, 0x65, 0xa7, 0xa0, 0xd9, 0x22, 0xe0, 0xeb, 0xca, 0x0d, 0xf0, 0xdd,
+	0x1d, 0x74, 0x61, 0x1b, 0x81, 0x01, 0xa1, 0xf7, 0x90, 0x6a, 0x67, 0x3b, 0xc2, 0x0d, 0x8f, 0x82,
+	0xf3, 0x32, 0xc0, 0x3b, 0x4e, 0x2b, 0xeb, 0xce, 0xd3, 0xca, 0x9b, 0x39, 0x91, 0x9c, 0x3b, 0x4f,
+	0x46, 0x37, 0x84, 0x00, 0xa6, 0x6c, 0x67, 0xb7, 0xb9, 0x89, 0x85, 0xe5, 0x02, 0xd4, 0x29, 0xb4,
+	0x40, 0x16, 0xf6, 0x07, 0x4e, 0x57, 0xd7, 0x25, 0xd4, 0xb9, 0x56, 0x71, 0x93, 0x5b, 0x96, 0x70,
+	0x1d, 0x15, 0xea, 0x06, 0xff, 0xda, 0x2b, 0xf7, 0xa1, 0x4b, 0xb7, 0x7a, 0x9c, 0xfd, 0x5a, 0xb9,
+	0xd9, 0x7e, 0xc5, 0x9d, 0x5a, 0xee, 0x80, 0xaf, 0x8a, 0x5d, 0x2c, 0x0d, 0x98, 0x5b, 0x2e, 0x66,
+	0x33, 0x42, 0x36, 0xef, 0xb6, 0x24, 0xeb, 0xe6, 0x42, 0xf3, 0xfb, 0x11, 0xaf, 0x6c, 0x45, 0x50,
+	0xca, 0xad, 0xec, 0x84, 0x8a, 0xd1, 0x09, 0xcf, 0x14, 0x53, 0xf7, 0x22, 0x52, 0x77, 0xca, 0xe8,
+	0x84, 0x83, 0x68, 0xfb, 0x94, 0x77, 0xf0, 0x6a, 0xe4, 0xa6, 0x29, 0x7c, 0xb6, 0x98, 0xc2, 0x6d,
+	0xa4, 0xf0, 0x3e, 0x39, 0x52, 0x0e, 0x68, 0x59, 0xd3, 0xf9, 0xe5, 0x6a, 0xf9, 0x7a, 0xe8, 0x66,
+	0x69, 0x84, 0x85, 0xfa, 0x25, 0x76, 0x4d, 0x6c, 0xee, 0x61, 0x84, 0x8a, 0x48, 0x5a, 0x07, 0x6a,
+	0xb5, 0xcc, 0xe9, 0xbd, 0x79, 0x40, 0x56, 0xcf, 0x9c, 0xc6, 0xbb, 0x0f, 0xdb, 0x46, 0x0a, 0x4f,
+	0xf6, 0xf1, 0x34, 0x69, 0x9b, 0x09, 0x01, 0xe0, 0xae, 0x37, 0x9e, 0x26, 0x29, 0x50, 0xfe, 0x34,
+	0xc9, 0x3b, 0xf8, 0x34, 0xc9, 0xbb, 0xe1, 0xd3, 0x24, 0xcf, 0x7d, 0x9a, 0x54, 0xa6, 0xfd, 0x3d,
+	0x4b, 0xfb, 0xcb, 0xfa, 0x43, 0xf7, 0xdc, 0x7f, 0xad, 0x14, 0xae, 0x53, 0x4b, 0x3b, 0xed, 0x38,
+	0x19, 0xb1, 0x02, 0x5e, 0x46, 0xf4, 0xd0, 0x85, 0x85, 0x40, 0x92, 0x86, 0x3b, 0x43, 0x71, 0x00,
+	0xa3, 0x01, 0x78, 0x74, 0x03, 0xcd, 0xe0, 0x09, 0x44, 0x8d, 0x47, 0x1b, 0x2b, 0x40, 0xe6, 0xd8,
+	0xa4, 0xee, 0x3a, 0x36, 0x11, 0x7e, 0x1e, 0xca, 0xa7, 0x11, 0xc8, 0xe4, 0xdc, 0xc5, 0x62, 0xa1,
+	0xec, 0xa0, 0x50, 0x4e, 0x5a, 0x26, 0x21, 0xc7, 0xaa, 0x96, 0xc7, 0x4f, 0xbc, 0xc2, 0xa5, 0xf9,
+	0x6b, 0x92, 0x87, 0x2f, 0x8e, 0x2d, 0x64, 0x74, 0x30, 0x8f, 0x00, 0xb7, 0x60, 0xf6, 0xc1, 0x14,
+	0xd7, 0x48, 0xe3, 0x60, 0xea, 0x24, 0x21, 0x3c, 0xa1, 0x0e, 0x93, 0xea, 0x81, 0x01, 0x29, 0xe3,
+	0xbd, 0x6f, 0xf1, 0x5e, 0xc0, 0x96, 0xe6, 0xfd, 0xb3, 0x9e, 0x63, 0xe7, 0xe1, 0xd6, 0x1c, 0x3b,
+	0xcc, 0x2d, 0x14, 0x53, 0xfd, 0x12, 0x52, 0xdd, 0xb4, 0x7a, 0xcc, 0x20, 0x48, 0xd3, 0xbb, 0x99,
+	0xdb, 0x11, 0x71, 0x4e, 0x8b, 0x4f, 0x17, 0x37, 0x15, 0x63, 0x53, 0xc7, 0x0d, 0x8b, 0xec, 0x6c,
+	0xe8, 0xdd, 0x8e, 0x5d, 0x96, 0x1b, 0x95, 0x4b, 0x19, 0xa7, 0x89, 0xc5, 0x69, 0xae, 0x09, 0x4d,
+	0xc0, 0xe7, 0x3d, 0xe7, 0x86, 0x0e, 0x68, 0x24, 0xe4, 0xef, 0x6b, 0x3a, 0x54, 0xba, 0x74, 0xc3,
+	0xd6, 0x3a, 0x91, 0xa9, 0x66, 0x4e, 0x64, 0xca, 0xfc, 0x88, 0xd4, 0xf2, 0x23, 0x1c, 0x24, 0x69,
+	0x9a, 0xe3, 0xec, 0x56, 0x13, 0xbd, 0x8b, 0x5f, 0x9e, 0x10, 0x61, 0x7c, 0x13, 0x46, 0xa8, 0x71,
+	0x80, 0x88, 0xb9, 0xb7, 0x16, 0x37, 0xbc, 0x8b, 0x0d, 0x1f, 0x33, 0x66, 0x26, 0x5d, 0xb1, 0x6e,
+	0xf3, 0x43, 0x5e, 0xf1, 0x5e, 0x56, 0xa9, 0xb0, 0x94, 0xf2, 0x56, 0x0c, 0xe5, 0x9d, 0x6b, 0x17,
+	0xd3, 0xb3, 0x87, 0xf4, 0xdc, 0xa5, 0xe9, 0x71, 0xb6, 0x69, 0xd9, 0x95, 0xe2, 0x7d, 0xb4, 0x5b,
+	0xb7, 0xe1, 0xae, 0xce, 0x27, 0x6b, 0x25, 0xe7, 0x93, 0xf5, 0xfc, 0xf9, 0xe4, 0xdc, 0xdb, 0x8a,
+	0x59, 0xdf, 0x47, 0xd6, 0x67, 0x6d, 0x8b, 0x9a, 0x67, 0x4a, 0xf3, 0xfe, 0x75, 0xaf, 0x70, 0x93,
+	0xf0, 0xd6, 0x71, 0x5e, 0x66, 0x17, 0x5f, 0xb6, 0xed, 0xa2, 0x9b, 0x34, 0x4d, 0xff, 0x77, 0xbc,
+	0x82, 0x7d, 0x4c, 0xa0, 0xf4, 0xe2, 0xda, 0xda, 0x2a, 0x86, 0xbf, 0x0a, 0x95, 0x92, 0x69, 0x33,
+	0xfc, 0x96, 0x0b, 0x3f, 0x13, 0x7e, 0x8b, 0x18, 0xce, 0x9e, 0x4c, 0x62, 0x18, 0x2c, 0x10, 0xc8,
+	0x67, 0x09, 0xfc, 0x5f, 0xb6, 0x90, 0x78, 0x97, 0x63, 0x21, 0x91, 0x21, 0x51, 0x73, 0xf1, 0x55,
+	0xaf, 0x60, 0xcb, 0xf5, 0x20, 0x2e, 0x4a, 0x68, 0xcd, 0x84, 0xec, 0x8a, 0x58, 0xda, 0x09, 0x19,
+	0x4b, 0x5b, 0x46, 0xfb, 0x7f, 0x28, 0x58, 0x04, 0x39, 0x69, 0xbf, 0x42, 0x1a, 0x12, 0x87, 0xbb,
+	0x71, 0x2a, 0xde, 0x19, 0xc8, 0x9d, 0x14, 0xf1, 0xce, 0x27, 0xc8, 0x38, 0x22, 0x8d, 0x33, 0x46,
+	0x0d, 0xd0, 0x11, 0xcc, 0x55, 0x23, 0x82, 0xd9, 0x1f, 0x14, 0x6c, 0x28, 0x67, 0x43, 0x2f, 0xca,
+	0x38, 0x79, 0xb7, 0xc5, 0x89, 0xb3, 0x3a, 0xcd, 0xc9, 0xb0, 0x60, 0x9b, 0x3a, 0xd7, 0xe0, 0x85,
+	0xe2, 0x06, 0x5f, 0xf1, 0x1c, 0x2d, 0x16, 0xca, 0xee, 0x3c, 0x38, 0xc5, 0xc9, 0x70, 0xd0, 0x4f,
+	0xb0, 0x7f, 0x56, 0x9e, 0xc1, 0x46, 0xc6, 0x82, 0xca, 0xca, 0x33, 0x20, 0x94, 0x73, 0x71, 0x3c,
+	0x88, 0xc5, 0x39, 0x11, 0x4f, 0xe8, 0x8b, 0x6c, 0x3c, 0x56, 0x82, 0x27, 0xfc, 0x6f, 0x78, 0xae,
+	0x6d, 0xf4, 0x9f, 0xcb, 0x10, 0x28, 0x99, 0x90, 0xde, 0xc3, 0x65, 0x71, 0xbb, 0x36, 0xc4, 0x85,
+	0xa2, 0xbf, 0x9a, 0xdf, 0xee, 0xcf, 0x49, 0xbd, 0x64, 0xb2, 0x7e, 0x2f, 0x6f, 0xe9, 0x36, 0xd3,
+	0x6a, 0x18, 0x55, 0xe9, 0x76, 0xde, 0x55, 0x72, 0x80, 0xe0, 0x74, 0x50, 0x4a, 0x96, 0x8c, 0xef,
+	0xf3, 0x2c, 0x63, 0x5b, 0x58, 0xaf, 0x6e, 0xfd, 0xfb, 0x5e, 0xe1, 0x01, 0x05, 0x1e, 0x7f, 0xf2,
+	0xb0, 0x4c, 0x6c, 0xbf, 0x1a, 0xc8, 0x24, 0x60, 0x78, 0x14, 0x51, 0x57, 0x8c, 0x1c, 0x99, 0x04,
+	0x07, 0xae, 0xb5, 0x2e, 0x16, 0x62, 0xe8, 0xd8, 0xf2, 0x14, 0x3a, 0x76, 0x43, 0x84, 0xf3, 0xae,
+	0x15, 0xa9, 0xb2, 0x39, 0xf3, 0x3f, 0x7a, 0x96, 0xdd, 0x2d, 0xa0, 0x52, 0xb3, 0xf2, 0x69, 0xef,
+	0xe0, 0xe3, 0x94, 0x9b, 0x5e, 0xfd, 0x06, 0xc5, 0xf4, 0xfd, 0x67, 0xcf, 0x5a, 0xfe, 0x1e, 0xd4,
+	0xb4, 0x26, 0xf4, 0xc7, 0xd5, 0xe2, 0x13, 0x1d, 0x14, 0xe0, 0x82, 0xd1, 0xe7, 0x22, 0x65, 0x08,
+	0xb0, 0x62, 0x0a, 0x50, 0x11, 0x5d, 0x35, 0x66, 0xc4, 0x1b, 0xdc, 0xc8, 0x3a, 0x4d, 0x2a, 0xed,
+	0xa0, 0x34, 0x12, 0xbb, 0xd2, 0x0e, 0x6e, 0x5d, 0xf8, 0xf5, 0x1c, 0x21, 0xfc, 0x18, 0x0a, 0x8b,
+	0x8d, 0x59, 0xa7, 0xc3, 0x78, 0x8c, 0xcf, 0xb1, 0x81, 0x91, 0xcb, 0x8c, 0x7e, 0x1e, 0x2f, 0x8f,
+	0x7e, 0xbe, 0xf1, 0x08, 0x6b, 0x11, 0xca, 0x3c, 0xa1, 0x42, 0x99, 0xcb, 0xbc, 0x99, 0xff, 0xe9,
+	0x59, 0x9e, 0x5c, 0x51, 0x37, 0xea, 0xce, 0xfe, 0x96, 0x97, 0x3f, 0xa0, 0xfb, 0x39, 0x76, 0x72,
+	0x99, 0x89, 0xfa, 0xa0, 0x6d, 0xa2, 0xb2, 0x54, 0x6a, 0x1e, 0x7e, 0xa0, 0x8c, 0x44, 0x6b, 0x7d,
+	0x35, 0xb5, 0xf6, 0xc3, 0x31, 0xb0, 0x20, 0x4c, 0xb6, 0x75, 0x2c, 0x1a, 0x4f, 0xa9, 0x18, 0xb5,
+	0xae, 0x08, 0xc5, 0x11, 0x29, 0x30, 0xa1, 0xad, 0x05, 0xc1, 0x48, 0xa5, 0xb5, 0x00, 0xe9, 0xd5,
+	0x35, 0x11, 0x9f, 0x5c, 0x59, 0x5d, 0xd3, 0x73, 0x4c, 0xdd, 0x98, 0x63, 0xca, 0xcc, 0xc4, 0x87,
+	0x5c, 0x66, 0x22, 0x47, 0xa7, 0x66, 0xe6, 0x6f, 0x3d, 0xc7, 0xd9, 0xe8, 0x41, 0x8b, 0x75, 0x67,
+	0xaf, 0xdc, 0xe0, 0x62, 0xbd, 0x33, 0xec, 0x45, 0x3c, 0xfa, 0x54, 0x44, 0x91, 0x2a, 0x00, 0x9d,
+	0x15, 0xb1, 0xcf, 0x0b, 0x83, 0xdd, 0x7e, 0x57, 0x7a, 0xd6, 0x26, 0x68, 0x6e, 0xb1, 0x98, 0xf1,
+	0x0f, 0x7b, 0xd6, 0x7a, 0x30, 0xc7, 0x93, 0x66, 0xf9, 0xaf, 0x3c, 0xe7, 0xb9, 0xef, 0x6b, 0x62,
+	0x7a, 0x96, 0x4c, 0x18, 0xea, 0x2e, 0x3a, 0xd2, 0x04, 0xd1, 0xc7, 0x49, 0x03, 0x87, 0xef, 0xda,
+	0x80, 0x8f, 0x0e, 0x11, 0x50, 0xe7, 0x1a, 0xda, 0x76, 0xc6, 0xb9, 0x73, 0xc5, 0xcc, 0x7e, 0xc4,
+	0xb3, 0x96, 0x92, 0x0e, 0x6e, 0x34, 0xbb, 0x1b, 0x64, 0xc2, 0x68, 0x04, 0xba, 0x00, 0x93, 0xc6,
+	0x78, 0xd3, 0x00, 0x85, 0x55, 0x6e, 0x60, 0x3d, 0xd0, 0x00, 0x3b, 0x3c, 0xd8, 0x8a, 0xea, 0xbf,
+	0x22, 0x02, 0xf9, 0x9c, 0x91, 0xb7, 0x33, 0xd9, 0xc8, 0x5b, 0x23, 0xea, 0xd6, 0x8e, 0x5c, 0xad,
+	0xe6, 0x22, 0x57, 0xbf, 0xed, 0x91, 0x29, 0x3b, 0xcc, 0xfb, 0xe7, 0x14, 0xd2, 0x7c, 0xbf, 0x08,
+	0xeb, 0x65, 0xd9, 0x98, 0x66, 0xc5, 0x67, 0x20, 0x33, 0x1c, 0x34, 0x29, 0xf8, 0xef, 0xf1, 0x84,
+	0x66, 0x8b, 0x0b, 0x7b, 0xca, 0x95, 0x90, 0x6c, 0xc8, 0xa4, 0xda, 0xe3, 0xeb, 0x44, 0x2f, 0x33,
+	0x61, 0x2a, 0x34, 0x00, 0x07, 0x08, 0x5e, 0x3b, 0x5b, 0x1c, 0xec, 0x0a, 0x6d, 0xab, 0x07, 0x26,
+	0x08, 0xc3, 0x15, 0xc3, 0xeb, 0xc6, 0xf0, 0x92, 0x49, 0xff, 0x79, 0xd2, 0x08, 0x86, 0x26, 0x11,
+	0x5a, 0xa5, 0x3d, 0x4b, 0xa5, 0xe7, 0x44, 0x70, 0x2d, 0x64, 0x4b, 0xc4, 0x01, 0x04, 0x35, 0x0d,
+	0x2a, 0x2f, 0x1f, 0x18, 0xb9, 0xfc, 0x17, 0x08, 0x69, 0x2d, 0x48, 0x1b, 0x23, 0x8c, 0x9a, 0xa7,
+	0x8c, 0x1a, 0xbf, 0xe5, 0x29, 0x2f, 0xb9, 0xe2, 0x7f, 0x7a, 0x96, 0x8c, 0x06, 0x43, 0xde, 0x44,
+	0xd5, 0x0a, 0x9b, 0xb5, 0x88, 0x0c, 0x64, 0x26, 0xff, 0x7f, 0x78, 0xe4, 0x36, 0x33, 0x26, 0x63,
+	0x69, 0x10, 0x2a, 0x3f, 0x94, 0xdf, 0x05, 0x5d, 0x83, 0x8c, 0x99, 0xb0, 0x3d, 0x4d, 0x54, 0xa0,
+	0xb2, 0x94, 0x59, 0xcf, 0x8f, 0xda, 0xd6, 0xb3, 0xa0, 0x41, 0x3d, 0xb6, 0xbe, 0xe7, 0xb9, 0x6f,
+	0x19, 0xd0, 0x37, 0xc8, 0xa0, 0x45, 0xcf, 0xba, 0x54, 0xa8, 0xf3, 0xae, 0x0c, 0x59, 0x1c, 0xa6,
+	0x83, 0x38, 0x91, 0xd1, 0x8b, 0x17, 0x08, 0xcd, 0xd4, 0x14, 0x31, 0x19, 0x56, 0x7a, 0x5b, 0xc1,
+	0x6d, 0x85, 0xc0, 0x51, 0xc4, 0xda, 0xe3, 0xaf, 0x66, 0x2e, 0xcd, 0xe8, 0xe9, 0x89, 0x5f, 0xaf,
+	0x15, 0x29, 0xff, 0x5d, 0x64, 0x3a, 0x5b, 0x37, 0xbd, 0x97, 0x4c, 0xc9, 0x88, 0x07, 0x11, 0xc3,
+	0xc9, 0xdd, 0xde, 0x0c, 0x14, 0xec, 0x3e, 0x28, 0x98, 0xca, 0xc5, 0x47, 0xa0, 0x05, 0x03, 0xb5,
+	0xbe, 0x12, 0xa6, 0x2c, 0x86, 0x81, 0x2d, 0x37, 0xb6, 0x15, 0xc0, 0x6f, 0x93, 0x23, 0x0e, 0xc1,
+	0x00, 0xb1, 0xf3, 0x9b, 0x9b, 0x2b, 0x43, 0x15, 0x09, 0xcb, 0x53, 0xd2, 0x4e, 0x1b, 0x2b, 0x55,
+	0x95, 0xf6, 0xdf, 0x4d, 0x4e, 0xb8, 0xfa, 0xe3, 0x4a, 0x94, 0x6e, 0xb5, 0xd6, 0x83, 0x21, 0x7d,
+	0x88, 0xd4, 0xd0, 0xbf, 0xe2, 0xbb, 0x68, 0xa5, 0xb7, 0x40, 0x30, 0xa3, 0xe1, 0xc1, 0x57, 0x0a,
+	0x3c, 0xf8, 0xaa, 0x39, 0x7a, 0xfc, 0xe7, 0xc9, 0xc9, 0x7c, 0x9f, 0x58, 0x24, 0x3c, 0x61, 0x47,
+	0x00, 0xde, 0x5d, 0x42, 0x83, 0x2c, 0x23, 0x43, 0x02, 0xd7, 0xc8, 0x4c, 0x26, 0x1a, 0x45, 0x1e,
+	0xa4, 0x5f, 0x1d, 0x24, 0xf4, 0x51, 0xbb, 0xe2, 0x59, 0x73, 0xcc, 0xba, 0x4a, 0xc8, 0x5a, 0x07,
+	0xe4, 0xf6, 0xc2, 0x3c, 0xf4, 0xf5, 0xa4, 0xde, 0xee, 0xc2, 0xd4, 0xc6, 0x25, 0x76, 0xdc, 0xba,
+	0xd8, 0x01, 0x88, 0xe8, 0x6a, 0xc4, 0xe2, 0x80, 0x67, 0xa2, 0xa7, 0x49, 0xc3, 0xb8, 0xda, 0xb0,
+	0x27, 0x95, 0xc1, 0x06, 0xfa, 0xff, 0xc9, 0x73, 0x85, 0x51, 0x81, 0x15, 0xd5, 0xce, 0x82, 0x58,
+	0x67, 0x1b, 0x10, 0x15, 0xca, 0x2c, 0xee, 0xfe, 0x95, 0x2d, 0x6c, 0xff, 0x8f, 0xbd, 0xb0, 0xcd,
+	0x37, 0xa6, 0x87, 0xf0, 0x77, 0xbd, 0xf2, 0xd8, 0xad, 0xd7, 0x74, 0x70, 0x71, 0xa0, 0x5b, 0x30,
+	0x77, 0xa9, 0x98, 0xf8, 0x8f, 0x79, 0xd6, 0x51, 0x54, 0x19, 0x71, 0x9a, 0x8d, 0xaf, 0x78, 0x45,
+	0x01, 0x66, 0xb7, 0x88, 0x81, 0x92, 0x1d, 0xc2, 0xff, 0xcb, 0x19, 0xb8, 0xd3, 0x58, 0xec, 0x97,
+	0xad, 0x09, 0xfe, 0xd9, 0x23, 0x0d, 0x11, 0x59, 0x12, 0xf3, 0x10, 0xea, 0x13, 0xfc, 0xbd, 0x1b,
+	0xbe, 0x8f, 0xc2, 0x67, 0x48, 0x0d, 0x30, 0xee, 0x7b, 0x98, 0xbe, 0x74, 0x0b, 0x7c, 0xe5, 0xd5,
+	0xb4, 0xdd, 0xe5, 0x13, 0x4a, 0x23, 0xe0, 0x09, 0xfa, 0x28, 0x19, 0x97, 0xe6, 0x4f, 0x5e, 0x66,
+	0x68, 0x5a, 0x23, 0x43, 0x20, 0xc5, 0x13, 0x40, 0x32, 0xab, 0xde, 0xf2, 0xaa, 0x9b, 0x97, 0xf6,
+	0x9f, 0x24, 0x13, 0x46, 0x58, 0x94, 0xb8, 0x9e, 0xd7, 0xcc, 0xbc, 0x26, 0xa4, 0xf0, 0x81, 0x99,
+	0x19, 0xe8, 0xde, 0xe0, 0x2f, 0xae, 0x8c, 0x72, 0xe3, 0xcb, 0x53, 0xfe, 0x27, 0xbd, 0x7c, 0xfc,
+	0xdf, 0x6b, 0xea, 0x34, 0xc3, 0xad, 0xa8, 0x5a, 0x6e, 0x45, 0xd9, 0xb2, 0xe7, 0xe3, 0xf6, 0xb2,
+	0x27, 0x4b, 0x88, 0xee, 0xa6, 0x8f, 0x79, 0xee, 0x80, 0x44, 0xbd, 0xe3, 0xe5, 0x99, 0x4f, 0x37,
+	0x4d, 0x93, 0xea, 0x6a, 0x2a, 0xfd, 0x3d, 0xf8, 0x0b, 0x64, 0xf7, 0xf9, 0x1a, 0x88, 0x6f, 0x8d,
+	0x89, 0x54, 0xd9, 0xee, 0xe0, 0xff, 0xf3, 0xac, 0xdb, 0x7a, 0xae, 0xe6, 0xcd, 0xdd, 0x41, 0x2a,
+	0x71, 0x2d, 0xc6, 0x37, 0xa4, 0x07, 0x31, 0x08, 0x72, 0x2d, 0x62, 0xf1, 0x9a, 0x0c, 0x9f, 0xae,
+	0x05, 0x2a, 0xcd, 0xa7, 0x2e, 0x23, 0x8e, 0x5b, 0x4d, 0x5d, 0x46, 0x84, 0x79, 0xc9, 0x74, 0xea,
+	0xff, 0x63, 0x45, 0x5d, 0xd5, 0x95, 0x96, 0xb0, 0xc4, 0xb7, 0xcb, 0x2e, 0x90, 0x2a, 0x8e, 0x05,
+	0x92, 0xdc, 0x4a, 0x6a, 0xad, 0x8b, 0x31, 0x27, 0x93, 0x0a, 0xb3, 0x9a, 0x8a, 0xe5, 0xa1, 0x4c,
+	0x1a, 0xea, 0x50, 0xcf, 0x9e, 0x26, 0xf3, 0xe3, 0x61, 0xee, 0x94, 0xa2, 0xa7, 0xaf, 0x00, 0xee,
+	0xcb, 0x69, 0xde, 0x2d, 0xba, 0x9c, 0x66, 0x78, 0xc7, 0x24, 0xb7, 0x65, 0x62, 0xf9, 0xef, 0x7c,
+	0xdf, 0xc1, 0xed, 0xbf, 0x4f, 0x22, 0x4e, 0xad, 0x39, 0xfe, 0xc4, 0x23, 0x87, 0xb8, 0x33, 0x6e,
+	0x49, 0x5f, 0x5e, 0xc6, 0xf3, 0xec, 0xcb, 0x78, 0xbe, 0x08, 0xc4, 0xcf, 0x48, 0xdf, 0x7a, 0x66,
+	0xea, 0x67, 0x2d, 0x7d, 0xc5, 0xd5, 0x48, 0x09, 0x57, 0xa3, 0x36, 0x57, 0x17, 0x48, 0x43, 0x8d,
+	0x41, 0x69, 0x0c, 0x75, 0x45, 0x5e, 0xc9, 0xf2, 0xa6, 0x62, 0x2d, 0x6f, 0xfc, 0xf7, 0x49, 0xf1,
+	0x18, 0x83, 0xe1, 0xa7, 0x13, 0xcf, 0x1c, 0x0f, 0x4f, 0x40, 0xd2, 0xc4, 0xf5, 0xa1, 0xa3, 0x59,
+	0xb3, 0xc1, 0xcd, 0xa8, 0x4a, 0xc2, 0xfa, 0xed, 0x70, 0xce, 0xce, 0x9a, 0x5e, 0x85, 0x77, 0xb0,
+	0x57, 0xf1, 0x16, 0x32, 0x69, 0x96, 0x16, 0x6b, 0x12, 0x39, 0xb9, 0xe7, 0xc7, 0x7c, 0x60, 0x65,
+	0xa7, 0x4f, 0xe7, 0x5e, 0x89, 0x10, 0x4b, 0x8e, 0xa2, 0x0b, 0xde, 0xd9, 0xec, 0xc8, 0x84, 0x15,
+	0xcc, 0x58, 0xc6, 0x44, 0x46, 0x25, 0xff, 0xd5, 0x30, 0xf1, 0xe7, 0x9e, 0x08, 0xe2, 0xb1, 0xd5,
+	0xcb, 0xea, 0x54, 0xef, 0x86, 0x3a, 0x95, 0x3e, 0x4a, 0x08, 0x5f, 0xc0, 0xab, 0x17, 0xfb, 0x32,
+	0xec, 0x1b, 0x6c, 0x18, 0x39, 0xe9, 0x53, 0xa4, 0x61, 0xe9, 0x82, 0x50, 0xa2, 0xe2, 0xf9, 0xd8,
+	0xce, 0x6e, 0x5b, 0x34, 0xfe, 0xe8, 0x8c, 0x06, 0xf8, 0x3b, 0xe4, 0x98, 0x95, 0x5d, 0x1d, 0xdc,
+	0x94, 0xbb, 0x13, 0x96, 0x83, 0x50, 0xb9, 0x61, 0x07, 0x01, 0x9a, 0xb3, 0x74, 0xe2, 0xa7, 0x6f,
+	0x2e, 0xa7, 0x62, 0x66, 0x73, 0xaf, 0x7a, 0x85, 0x51, 0xff, 0xaf, 0x35, 0xb6, 0xc6, 0x1a, 0xf0,
+	0xd5, 0xfc, 0x80, 0x2f, 0x5b, 0x29, 0x7f, 0xc2, 0x73, 0x84, 0xc7, 0xe4, 0x28, 0xb3, 0x4e, 0x56,
+	0x4a, 0xee, 0x25, 0x94, 0xcc, 0x9a, 0xf2, 0xca, 0x75, 0xc5, 0xb8, 0x72, 0x7d, 0xb3, 0xc7, 0x2a,
+	0x4b, 0xc5, 0x7c, 0xfc, 0x7f, 0xcf, 0x8a, 0x2b, 0x2c, 0x26, 0xd1, 0x8a, 0x9c, 0x59, 0xc4, 0xad,
+	0xc5, 0xb0, 0x17, 0xa5, 0xfb, 0xaf, 0x79, 0x10, 0xcd, 0x92, 0x09, 0xa3, 0x1a, 0xc1, 0x9f, 0x09,
+	0xf2, 0x5f, 0x24, 0x33, 0xa6, 0xdf, 0x9c, 0x69, 0xd3, 0x75, 0xf8, 0xff, 0x78, 0xb6, 0x4e, 0xd3,
+	0x42, 0x64, 0x2a, 0xb0, 0xdb, 0x7a, 0x81, 0x1c, 0x31, 0x92, 0x4a, 0x97, 0x1f, 0xb3, 0xd7, 0x94,
+	0xa7, 0xf2, 0xc6, 0x26, 0x5b, 0x2b, 0xcf, 0x0f, 0xee, 0xdf, 0xb9, 0x58, 0x1e, 0x8d, 0xc2, 0x5f,
+	0x30, 0xa2, 0x45, 0x37, 0x4f, 0x72, 0x5b, 0x7a, 0xf6, 0x7b, 0x61, 0x75, 0xeb, 0x25, 0xad, 0xd4,
+	0x3c, 0x87, 0x4e, 0xf3, 0x2f, 0x69, 0xd5, 0xb2, 0x2f, 0x69, 0x95, 0xa9, 0xf1, 0x27, 0x5d, 0xdb,
+	0xe5, 0x39, 0xfa, 0x74, 0xdf, 0xff, 0x83, 0xc7, 0xdf, 0x1a, 0xc3, 0x3d, 0xae, 0x75, 0xb5, 0xc7,
+	0xb5, 0x4e, 0xef, 0x24, 0x95, 0xd5, 0x54, 0x98, 0xc2, 0xcc, 0x0b, 0x64, 0x95, 0xd5, 0x94, 0x3e,
+	0xa4, 0x1e, 0x48, 0xa8, 0xda, 0x3b, 0x3a, 0xeb, 0xab, 0x29, 0x37, 0x33, 0x89, 0x7c, 0x44, 0x88,
+	0x1f, 0xc3, 0x64, 0x16, 0x1a, 0x35, 0x6b, 0x73, 0xbb, 0x7c, 0xa1, 0x31, 0xd3, 0x11, 0xbb, 0x8d,
+	0x85, 0x8f, 0xc7, 0x9c, 0xb5, 0x1f, 0x7a, 0x29, 0x36, 0x77, 0xc6, 0xf3, 0x15, 0x9f, 0xaa, 0x90,
+	0xe9, 0xec, 0xcb, 0x97, 0x30, 0x6c, 0x19, 0x26, 0xba, 0xe2, 0x22, 0xa5, 0x4c, 0x82, 0x11, 0x64,
+	0x46, 0x3c, 0x81, 0x77, 0xa6, 0x1e, 0x68, 0x00, 0xe8, 0xee, 0x60, 0xa8, 0x16, 0x02, 0xf8, 0x9f,
+	0xde, 0x49, 0xaa, 0xc3, 0x54, 0x9e, 0xe0, 0x4c, 0x18, 0xf2, 0x09, 0x00, 0x0e, 0x15, 0x6e, 0xec,
+	0xc6, 0x31, 0x06, 0xd2, 0xe3, 0x69, 0x48, 0x3d, 0xd0, 0x00, 0xb0, 0x80, 0xc3, 0x98, 0x71, 0x24,
+	0xbf, 0x01, 0xaa, 0xd2, 0xc0, 0x7f, 0x12, 0x6f, 0x88, 0x45, 0x17, 0xfc, 0x85, 0xe6, 0xbb, 0x2c,
+	0x49, 0x85, 0x27, 0x8b, 0xff, 0xe9, 0x69, 0xd2, 0xd8, 0xd8, 0x62, 0x1b, 0xdb, 0x8b, 0x83, 0xfe,
+	0xd5, 0x5e, 0xb4, 0x91, 0x0a, 0x37, 0xd6, 0x06, 0xc2, 0xa0, 0x0d, 0xd5, 0xf3, 0x67, 0x5d, 0x74,
+	0x66, 0x6b, 0x81, 0x09, 0xf2, 0xff, 0xbb, 0xe7, 0xba, 0x43, 0x45, 0xdf, 0x28, 0xe4, 0x61, 0xec,
+	0x3e, 0x15, 0xbe, 0x27, 0xaa, 0x73, 0x96, 0xed, 0x71, 0x7c, 0xca, 0xde, 0xe3, 0xc8, 0xb7, 0xa9,
+	0xb5, 0x16, 0x68, 0xca, 0xdf, 0xdf, 0xba, 0x05, 0x34, 0x7d, 0xda, 0xa6, 0x29, 0xdf, 0xa6, 0x75,
+	0x12, 0xe8, 0xba, 0x3b, 0x76, 0xb3, 0x03, 0xeb, 0x04, 0x19, 0x47, 0x07, 0x03, 0x1f, 0x99, 0xe5,
+	0xea, 0xa4, 0x01, 0xd6, 0x8b, 0x7c, 0x9e, 0x7e, 0x77, 0xb0, 0xec, 0x68, 0xe5, 0x17, 0x5c, 0x47,
+	0x2b, 0x16, 0x89, 0x9a, 0x87, 0xd4, 0x75, 0xcb, 0xcd, 0x1e, 0x14, 0x15, 0x63, 0x50, 0x94, 0x49,
+	0xee, 0x17, 0x6d, 0xc9, 0xe5, 0xab, 0xd5, 0xad, 0xfe, 0x9d, 0x77, 0xc0, 0x25, 0xba, 0xc2, 0xb7,
+	0x6f, 0x6e, 0x60, 0xd7, 0xd3, 0xbd, 0x9d, 0x5d, 0x16, 0x54, 0x46, 0x49, 0xad, 0x6f, 0x9c, 0xc6,
+	0xc2, 0xff, 0xb9, 0x95, 0x62, 0x46, 0x7f, 0x89, 0x33, 0x7a, 0xda, 0x8e, 0x5d, 0x72, 0x33, 0xa2,
+	0x79, 0xfe, 0x9a, 0x57, 0x7a, 0x2b, 0xf0, 0x20, 0x0f, 0x28, 0xb6, 0xce, 0xee, 0x78, 0x0a, 0xfa,
+	0xa9, 0x8b, 0x17, 0x77, 0x7a, 0xe2, 0xdc, 0x49, 0x26, 0xcb, 0xc2, 0xc4, 0x3f, 0xc3, 0xc9, 0xf7,
+	0xcd, 0xcb, 0x20, 0x07, 0x11, 0xff, 0x62, 0xd9, 0x85, 0xc5, 0x32, 0xe7, 0xe4, 0x97, 0x6d, 0xe7,
+	0xa4, 0xb8, 0x12, 0xdd, 0xd6, 0x87, 0xbd, 0x82, 0xdb, 0x8f, 0x86, 0xd3, 0xe4, 0x59, 0x4e, 0xd3,
+	0x49, 0x42, 0x62, 0x7d, 0x0f, 0x88, 0x3f, 0x5b, 0x64, 0x40, 0xca, 0x62, 0xa9, 0x7e, 0xc5, 0x73,
+	0xc5, 0xa1, 0xd9, 0xed, 0x6a, 0xd2, 0x7e, 0xe4, 0xdd, 0xe0, 0xed, 0xcb, 0x42, 0x52, 0x8b, 0x4e,
+	0x61, 0x85, 0xc7, 0x0d, 0x53, 0x0b, 0x9f, 0x60, 0xab, 0x81, 0x06, 0xcc, 0x5d, 0x29, 0x66, 0xe0,
+	0xb3, 0x9c, 0x81, 0xd7, 0x6b, 0x01, 0x1f, 0x4c, 0x9d, 0x66, 0xe8, 0x93, 0xde, 0xc1, 0x77, 0x44,
+	0x6f, 0x6e, 0x03, 0xbd, 0x2c, 0xc0, 0xe6, 0x57, 0xed, 0x00, 0x9b, 0x83, 0x1a, 0x36, 0xad, 0x94,
+	0xeb, 0x8e, 0x2a, 0x08, 0x93, 0xe1, 0x15, 0x2d, 0xb1, 0xd5, 0x2e, 0x52, 0x65, 0xb6, 0xf1, 0x73,
+	0xb6, 0x6d, 0x74, 0xd4, 0x9a, 0x6b, 0x35, 0x73, 0x01, 0xf6, 0xb5, 0xb4, 0xfa, 0x6b, 0xf9, 0x56,
+	0x33, 0xb5, 0xea, 0x56, 0xff, 0x9b, 0xe7, 0xbc, 0x5e, 0x4b, 0x1f, 0x36, 0x1f, 0x4a, 0x11, 0x5d,
+	0xe1, 0x78, 0xdb, 0xc3, 0xc8, 0x54, 0x46, 0xd1, 0xe7, 0x6d, 0x8a, 0x1c, 0x0d, 0x6a, 0x8a, 0x7a,
+	0x8e, 0x6b, 0xbd, 0xce, 0x40, 0xb6, 0x92, 0xd8, 0x86, 0x2f, 0xd8, 0xb1, 0x0d, 0xb9, 0xfa, 0x74,
+	0x6b, 0xaf, 0x7a, 0x07, 0x5d, 0x17, 0xbe, 0xe9, 0xc1, 0x65, 0xbc, 0x80, 0x53, 0xb5, 0x5e, 0xc0,
+	0x99, 0x5b, 0x2d, 0xa6, 0xf8, 0xd7, 0x39, 0xc5, 0xf7, 0x14, 0x0e, 0x2c, 0x93, 0x24, 0x4d, 0xfe,
+	0xf5, 0x82, 0x8b, 0xcc, 0x45, 0x6f, 0x3c, 0x95, 0x19, 0xa7, 0x2f, 0xda, 0xc6, 0xc9, 0x59, 0xaf,
+	0x6e, 0xf9, 0x1d, 0xce, 0x7b, 0xd2, 0x65, 0x4a, 0xf0, 0x25, 0x5b, 0x09, 0x1c, 0xa5, 0x75, 0xed,
+	0xef, 0xf5, 0x8a, 0x6e, 0x5b, 0xe7, 0xfc, 0x9d, 0x29, 0xe5, 0xef, 0x34, 0xc0, 0xc1, 0x29, 0x3b,
+	0x67, 0xf9, 0x0d, 0xfb, 0x9c, 0xc5, 0xdd, 0x80, 0x26, 0xe2, 0xa3, 0x5e, 0xd9, 0xdd, 0xed, 0x9b,
+	0xd5, 0x8b, 0xb2, 0x79, 0xeb, 0xcb, 0xb9, 0x79, 0xab, 0xa0, 0x51, 0x4d, 0xdc, 0x36, 0x39, 0x9c,
+	0x5b, 0xd5, 0x38, 0x97, 0xb8, 0xf9, 0xfb, 0xa6, 0xfc, 0xd6, 0x81, 0xe3, 0x75, 0x5c, 0x31, 0x89,
+	0x25, 0x22, 0x58, 0x45, 0xa5, 0xfd, 0xcb, 0xd6, 0xbb, 0x51, 0xfc, 0xa1, 0xa7, 0x85, 0x3c, 0x4c,
+	0x2c, 0x7a, 0x8b, 0x76, 0xd8, 0x72, 0xf9, 0xa1, 0x9b, 0x4b, 0x6f, 0xbf, 0x5b, 0x91, 0xd7, 0xe2,
+	0xa5, 0xe9, 0xb2, 0x93, 0xc0, 0xaf, 0xd8, 0x27, 0x81, 0x65, 0x55, 0x6b, 0x49, 0x7e, 0xd1, 0x2b,
+	0xbf, 0x60, 0x7f, 0xd3, 0xd7, 0x09, 0xd5, 0x6b, 0x84, 0x55, 0xe3, 0x35, 0xc2, 0x32, 0xb2, 0xbf,
+	0xea, 0x39, 0x6e, 0x92, 0xba, 0x89, 0xd1, 0x64, 0xbf, 0x5c, 0x7c, 0xe9, 0xdf, 0x29, 0xb6, 0x92,
+	0xa8, 0xc4, 0xaf, 0xd9, 0x51, 0x89, 0x45, 0xd5, 0x5a, 0x23, 0xa3, 0xf4, 0x4d, 0x01, 0x7a, 0x3f,
+	0x19, 0x5b, 0x7c, 0x16, 0x57, 0x93, 0x72, 0x27, 0x44, 0xb5, 0xc9, 0xc1, 0x81, 0xc2, 0x97, 0x09,
+	0xe6, 0x37, 0x33, 0x82, 0x29, 0x69, 0x52, 0x13, 0xf7, 0x56, 0x32, 0x2a, 0xea, 0x76, 0x8e, 0x87,
+	0xcc, 0xab, 0x90, 0xfc, 0x10, 0xc0, 0x7a, 0x15, 0xf2, 0xfd, 0xde, 0x41, 0xef, 0x21, 0x38, 0x05,
+	0x5c, 0x62, 0xdd, 0x5f, 0xcd, 0x59, 0xf7, 0x92, 0xca, 0x6d, 0x03, 0x54, 0xfc, 0xe8, 0xc2, 0xcd,
+	0xde, 0x66, 0x29, 0x33, 0x40, 0x5f, 0xf7, 0x72, 0xb7, 0x85, 0x0f, 0xd2, 0xbf, 0x5e, 0xe9, 0x83,
+	0x0f, 0x65, 0x4b, 0x82, 0x6f, 0xd8, 0x4b, 0x82, 0x92, 0x5a, 0x74, 0x6b, 0x9f, 0xf0, 0x0e, 0x78,
+	0x3e, 0x02, 0xcc, 0x6e, 0xc2, 0x97, 0xae, 0xa0, 0x70, 0xb5, 0x40, 0xa4, 0x60, 0x3a, 0xe6, 0xe7,
+	0xa6, 0x7c, 0xf7, 0xb8, 0x16, 0xc8, 0x64, 0xd9, 0xa2, 0xeb, 0x9b, 0xf6, 0xa2, 0xab, 0xb4, 0x65,
+	0xf3, 0x12, 0x5a, 0xfe, 0xfd, 0x0a, 0xb3, 0x7d, 0xcf, 0x6e, 0xbf, 0xc4, 0x81, 0xf9, 0xad, 0x6c,
+	0x70, 0x66, 0xa6, 0x56, 0xdd, 0xe6, 0x5f, 0x78, 0xc5, 0xaf, 0x63, 0x80, 0x36, 0x74, 0x33, 0x96,
+	0x4b, 0xa6, 0xc5, 0x32, 0x86, 0xef, 0x5c, 0x77, 0xc5, 0xfc, 0x69, 0x40, 0xa0, 0xec, 0x0e, 0xff,
+	0xba, 0x42, 0x57, 0x3c, 0x76, 0xa0, 0xd2, 0xfa, 0x6b, 0x0b, 0xb5, 0xa2, 0xaf, 0x2d, 0x94, 0x99,
+	0x9b, 0x6f, 0xd9, 0xe6, 0xa6, 0x88, 0x7a, 0xeb, 0x24, 0xdd, 0x7c, 0x45, 0x1b, 0x8f, 0xf0, 0xf8,
+	0xa7, 0x46, 0x3c, 0xbe, 0x0e, 0x95, 0x9f, 0x18, 0x39, 0x49, 0xc8, 0xc2, 0xee, 0xc6, 0x36, 0x4b,
+	0x85, 0x4d, 0xc6, 0xe7, 0xc8, 0x34, 0x04, 0x6f, 0x0c, 0x6d, 0x8b, 0x3b, 0xde, 0x95, 0xf9, 0x6d,
+	0x48, 0x77, 0xb6, 0xe5, 0x6b, 0xfc, 0x9d, 0x6d, 0xe0, 0xf9, 0x5c, 0xbf, 0x3b, 0x1c, 0x44, 0xfd,
+	0x54, 0x04, 0x10, 0xab, 0x34, 0xe0, 0x16, 0xc2, 0x84, 0xad, 0x86, 0xe9, 0x16, 0xee, 0x98, 0x8d,
+	0x07, 0x2a, 0xed, 0x7f, 0xa9, 0x42, 0xcc, 0xc8, 0xf1, 0x45, 0x7c, 0xcc, 0xbf, 0xc3, 0xfa, 0x49,
+	0x94, 0x46, 0x7b, 0x4c, 0x50, 0x99, 0x05, 0x03, 0xb5, 0xf3, 0xc3, 0x21, 0xeb, 0x77, 0xc1, 0xd8,
+	0x22, 0xb5, 0x63, 0x81, 0x01, 0x81, 0x99, 0xfb, 0x4a, 0x1c, 0xa5, 0x6c, 0x6d, 0x2b, 0x66, 0xc9,
+	0xd6, 0xa0, 0xd7, 0x15, 0xf3, 0x72, 0x06, 0x4a, 0x4f, 0x93, 0x46, 0xc0, 0xc2, 0xae, 0xce, 0x56,
+	0xc3, 0x6c, 0x36, 0x10, 0x3f, 0x8f, 0x90, 0x0e, 0xe2, 0x70, 0x93, 0x2d, 0x86, 0xc3, 0x70, 0x23,
+	0x4a, 0xf7, 0xc5, 0xae, 0x60, 0x16, 0xac, 0x82, 0x8e, 0x17, 0xb7, 0xc2, 0x58, 0xb0, 0xaa, 0x01,
+	0x18, 0xef, 0x9e, 0xca, 0xb3, 0x6f, 0xf8, 0x8b, 0xb7, 0xb0, 0xc3, 0xcd, 0x04, 0xb3, 0x88, 0x0b,
+	0x5a, 0x1a, 0x00, 0x72, 0x5b, 0x8d, 0x86, 0xac, 0x17, 0xf5, 0x99, 0xb8, 0xab, 0xa5, 0xd2, 0xfe,
+	0xb7, 0xbd, 0xe2, 0xb7, 0x54, 0x5c, 0x8e, 0x5e, 0x30, 0x14, 0x46, 0xad, 0x12, 0x0c, 0xf1, 0xb5,
+	0xd9, 0x24, 0x55, 0xef, 0xcf, 0x26, 0xa9, 0x19, 0xde, 0x5f, 0xb3, 0xbe, 0xae, 0x91, 0x7b, 0x2f,
+	0xa3, 0x44, 0x3b, 0xbf, 0xed, 0xd2, 0xce, 0xb2, 0x70, 0x9c, 0xff, 0xed, 0x91, 0x51, 0xb0, 0xb1,
+	0x2b, 0x43, 0x8c, 0xe4, 0x5c, 0x19, 0x8a, 0xf0, 0xbb, 0xca, 0xca, 0x10, 0x98, 0xef, 0xb3, 0x6b,
+	0xf2, 0xd8, 0x0f, 0xdf, 0x0f, 0x90, 0xe9, 0xfc, 0x17, 0x78, 0xf8, 0xab, 0x78, 0x99, 0x2f, 0xf0,
+	0x9c, 0x24, 0xe4, 0x02, 0x4b, 0x57, 0x86, 0x7c, 0xab, 0x96, 0xf7, 0xac, 0x01, 0x51, 0xd7, 0x5c,
+	0xeb, 0xf6, 0x36, 0xb0, 0xba, 0xe6, 0x0a, 0x93, 0x88, 0xf3, 0x05, 0x9c, 0xd2, 0xbb, 0x54, 0xf6,
+	0x09, 0x81, 0x18, 0x48, 0xc6, 0x09, 0x41, 0x49, 0x08, 0xca, 0x77, 0xec, 0x10, 0x14, 0x57, 0xd3,
+	0xce, 0x53, 0x2e, 0xc7, 0x23, 0x3c, 0x3f, 0xe3, 0x63, 0x8e, 0x2c, 0x13, 0x25, 0xf3, 0xe1, 0x77,
+	0x9d, 0xa7, 0x5c, 0x0e, 0x12, 0x35, 0x2b, 0x9f, 0xf1, 0x4a, 0x1e, 0x22, 0x52, 0xf7, 0x17, 0xf9,
+	0x93, 0xef, 0xfc, 0xfe, 0xa2, 0xfb, 0x13, 0x6e, 0xfa, 0xe6, 0x43, 0xd5, 0xbc, 0xf9, 0x50, 0x76,
+	0x6f, 0xeb, 0x7b, 0xf6, 0xbd, 0xad, 0x42, 0x2a, 0x34, 0xb1, 0x3f, 0xac, 0x90, 0xb1, 0xf3, 0x11,
+	0xdf, 0xff, 0x00, 0x45, 0x48, 0xd8, 0x4b, 0xbb, 0xac, 0xbf, 0xc1, 0xc4, 0xa1, 0x87, 0x4a, 0x03,
+	0x8d, 0x3d, 0x8c, 0x75, 0x11, 0xcf, 0x73, 0x63, 0x02, 0xa0, 0x3b, 0x2c, 0xde, 0x64, 0x62, 0x62,
+	0xe0, 0x09, 0xdc, 0xaa, 0xb8, 0x9e, 0xb2, 0x7e, 0x2a, 0x37, 0x8f, 0x79, 0x0a, 0x73, 0xe3, 0x87,
+	0x9c, 0xea, 0xfc, 0x86, 0x1f, 0x26, 0xc0, 0x8a, 0x27, 0xe2, 0x04, 0x73, 0x04, 0xe1, 0x32, 0x09,
+	0xf6, 0xa4, 0xab, 0xe2, 0xcc, 0xb9, 0x9d, 0xd1, 0x00, 0x3c, 0xd7, 0x40, 0x9d, 0x02, 0x2c, 0xff,
+	0x8c, 0x87, 0x06, 0x40, 0xad, 0x3b, 0x11, 0xf7, 0xec, 0xf8, 0x13, 0x19, 0x32, 0x89, 0x18, 0x11,
+	0xe9, 0x4d, 0x04, 0x86, 0x27, 0x71, 0xe5, 0x33, 0xb8, 0xc6, 0x43, 0xc4, 0x79, 0x38, 0x8d, 0x4a,
+	0xc3, 0x20, 0xbd, 0x1a, 0xf5, 0x58, 0x27, 0x7a, 0x99, 0x2d, 0xec, 0x83, 0x37, 0xcb, 0x63, 0x6a,
+	0x6c, 0xa0, 0xff, 0x01, 0xcf, 0xf5, 0x56, 0x14, 0x7d, 0x90, 0x8c, 0x4b, 0x21, 0x4b, 0x37, 0xf8,
+	0x90, 0xba, 0xc6, 0x20, 0x3e, 0x51, 0xa5, 0x73, 0x94, 0xed, 0x76, 0xff, 0xb6, 0xbd, 0xdb, 0x9d,
+	0x6f, 0xcb, 0xba, 0x6d, 0x53, 0xf6, 0x02, 0xd5, 0x2d, 0x1e, 0x53, 0x25, 0x6e, 0xdf, 0xef, 0xd8,
+	0x6e, 0x5f, 0x09, 0x8d, 0x9a, 0x99, 0xf7, 0x7b, 0xae, 0xd7, 0xb2, 0xd0, 0xac, 0x82, 0x7a, 0xcb,
+	0x18, 0xb5, 0xf1, 0x40, 0xa5, 0xb3, 0x0f, 0xf8, 0x96, 0x49, 0xf5, 0xfb, 0x99, 0xeb, 0x9c, 0xb9,
+	0x86, 0xac, 0x7d, 0xb2, 0x51, 0xfc, 0x44, 0xd9, 0xe0, 0x1a, 0x68, 0x60, 0xaa, 0x5e, 0x1d, 0x11,
+	0x01, 0x46, 0x0a, 0x60, 0xf8, 0xa1, 0x62, 0xf9, 0x2f, 0xfc, 0xd0, 0x19, 0x32, 0xb6, 0x35, 0xb0,
+	0xf6, 0x85, 0x54, 0x5a, 0x45, 0xfa, 0xb5, 0xc4, 0x33, 0x25, 0x22, 0x65, 0xf1, 0x59, 0xb7, 0xf9,
+	0xf4, 0xff, 0xcc, 0x23, 0x63, 0x78, 0xde, 0x01, 0x24, 0xc9, 0xf3, 0x41, 0xf1, 0x85, 0x48, 0x3c,
+	0x1f, 0xcc, 0x9c, 0x28, 0x62, 0x38, 0x9b, 0x3e, 0x51, 0x9c, 0x22, 0x95, 0xae, 0x8c, 0xda, 0xaa,
+	0x74, 0xd7, 0xa1, 0x86, 0x61, 0xda, 0xee, 0x8a, 0x68, 0x2d, 0xfc, 0x0f, 0x35, 0x24, 0xf1, 0x86,
+	0xb0, 0x46, 0x3c, 0xac, 0x53, 0x03, 0x70, 0x98, 0x26, 0xa9, 0xc0, 0xf2, 0x97, 0xdc, 0x35, 0xc0,
+	0x3e, 0x7e, 0xe4, 0x1f, 0x6b, 0x2a, 0x38, 0x7e, 0x1c, 0xe3, 0x8c, 0xc9, 0xb4, 0xff, 0x02, 0x39,
+	0x64, 0xf4, 0x84, 0xfc, 0x68, 0x56, 0x1f, 0xbf, 0x1b, 0x67, 0xaf, 0x25, 0x45, 0x87, 0x04, 0x1c,
+	0x49, 0xef, 0x23, 0x23, 0x8c, 0x7f, 0x7f, 0xb0, 0x62, 0x8d, 0x35, 0x29, 0xa5, 0x40, 0xa0, 0x31,
+	0x32, 0xd3, 0xf5, 0xd6, 0xda, 0xad, 0x8c, 0xcc, 0xfc, 0x5d, 0x7b, 0x5a, 0x74, 0x35, 0xef, 0x9a,
+	0x16, 0x5d, 0x4f, 0xbe, 0x95, 0x44, 0xa5, 0xdd, 0xe2, 0xe0, 0x8f, 0x1f, 0xb8, 0xa6, 0x45, 0x17,
+	0x89, 0x76, 0x9c, 0xb5, 0xfb, 0x75, 0xba, 0x9f, 0xc9, 0x35, 0x46, 0x71, 0xfb, 0x92, 0xdf, 0xdd,
+	0xc1, 0xdb, 0x97, 0x25, 0xfb, 0x7f, 0xbf, 0x67, 0xef, 0xff, 0xb9, 0xc9, 0x32, 0x5e, 0x92, 0xa8,
+	0x8b, 0x0f, 0xfe, 0xc9, 0xef, 0x09, 0x16, 0xbd, 0xc2, 0x62, 0x8c, 0x30, 0xfe, 0x98, 0xb0, 0xb9,
+	0x0a, 0xaf, 0x1e, 0xfc, 0xa6, 0x04, 0x37, 0xa3, 0xae, 0x87, 0xc9, 0x96, 0x06, 0x9b, 0x7c, 0xe3,
+	0x5a, 0x2c, 0x47, 0x34, 0x40, 0xef, 0x32, 0x8d, 0x98, 0xdf, 0xbc, 0x38, 0x41, 0xc6, 0x17, 0x07,
+	0xfd, 0x6e, 0x84, 0xd1, 0x62, 0xa3, 0xbc, 0x8c, 0x02, 0x58, 0x0f, 0x8c, 0x8b, 0x2f, 0x60, 0xa9,
+	0x2b, 0x27, 0xd3, 0xa4, 0x7a, 0x7e, 0x10, 0x8b, 0x59, 0x13, 0xfe, 0xd2, 0xc7, 0xc9, 0xc8, 0x52,
+	0xb8, 0xce, 0x7a, 0xf2, 0x13, 0xaa, 0xb3, 0xae, 0x6f, 0x2c, 0x9e, 0xe5, 0x59, 0x44, 0x30, 0x06,
+	0x4f, 0xd0, 0x0b, 0x64, 0x62, 0xbe, 0xdf, 0x1f, 0xa4, 0x22, 0x6a, 0x8b, 0x7f, 0x52, 0xf5, 0x1e,
+	0x67, 0x71, 0x23, 0x9f, 0xf8, 0xba, 0x8b, 0x01, 0x01, 0x76, 0xf4, 0xf7, 0x1a, 0x27, 0xf9, 0xc3,
+	0xfb, 0xfa, 0x83, 0x8c, 0x47, 0xe5, 0xb7, 0xf4, 0x1a, 0xfc, 0xb2, 0x27, 0xff, 0xbc, 0xc1, 0x2c,
+	0x99, 0xc0, 0xad, 0x84, 0x73, 0xd7, 0x87, 0x51, 0xcc, 0x9a, 0x53, 0xe2, 0xb3, 0x1f, 0x1a, 0x44,
+	0x7d, 0x32, 0xb9, 0x14, 0x26, 0xe9, 0xb9, 0xbd, 0xb0, 0x87, 0xfe, 0xc0, 0x21, 0xfe, 0x52, 0x96,
+	0x09, 0x43, 0xe1, 0x43, 0x1a, 0x2f, 0x93, 0x4e, 0x0b, 0xe1, 0x4b, 0x00, 0x3d, 0x43, 0x46, 0x90,
+	0x8d, 0xa4, 0x79, 0xd8, 0xba, 0xeb, 0x86, 0x40, 0xfe, 0xa6, 0x3b, 0xc7, 0xcf, 0x3c, 0x41, 0x26,
+	0x0c, 0x09, 0x1d, 0xf4, 0x01, 0x94, 0xf1, 0xcc, 0x47, 0x1e, 0xb3, 0xd2, 0xb9, 0x99, 0xf2, 0xfe,
+	0xe7, 0x2a, 0x64, 0x5c, 0x11, 0x44, 0x1f, 0x51, 0xbd, 0x69, 0xbf, 0xf8, 0xaf, 0x72, 0x38, 0x7b,
+	0xf2, 0x28, 0xa9, 0x73, 0x2b, 0xcd, 0x9d, 0x83, 0xba, 0x32, 0xdf, 0xf3, 0x1b, 0xb0, 0x86, 0x9d,
+	0x4f, 0xc5, 0xaa, 0x45, 0xa5, 0xc1, 0x38, 0x9d, 0x8f, 0x62, 0x71, 0x47, 0x10, 0xfd, 0x2c, 0x91,
+	0xe4, 0x0f, 0x28, 0x26, 0x83, 0xde, 0x1e, 0x22, 0xf9, 0x23, 0xe5, 0x06, 0x04, 0xda, 0xe2, 0x6f,
+	0x9d, 0x83, 0x3f, 0xe8, 0x05, 0x3c, 0x41, 0x1f, 0x22, 0x0d, 0xdc, 0x21, 0x92, 0x1f, 0x95, 0x14,
+	0xdf, 0xe9, 0xb1, 0x3e, 0xdb, 0x69, 0x66, 0xf8, 0x29, 0x24, 0xee, 0xb7, 0xc8, 0xe1, 0x5c, 0x7d,
+	0x74, 0x9a, 0x8c, 0x49, 0x95, 0xe3, 0x03, 0x9f, 0x36, 0x2c, 0xa1, 0xd0, 0x29, 0x32, 0xd2, 0x61,
+	0xfd, 0x54, 0xca, 0xc2, 0xff, 0xa6, 0x27, 0xaa, 0x31, 0xbf, 0x26, 0xea, 0xb4, 0x1d, 0xd3, 0xa4,
+	0xfa, 0x5c, 0xb0, 0x24, 0x4c, 0x07, 0xfc, 0x05, 0xe9, 0xe1, 0x06, 0xcc, 0x82, 0x7c, 0x15, 0x5e,
+	0x26, 0x41, 0x21, 0xf1, 0xef, 0x95, 0x30, 0x92, 0x92, 0xd5, 0x00, 0xf0, 0x53, 0xc5, 0x85, 0x4f,
+	0xf5, 0xfd, 0x00, 0xf4, 0x53, 0x2d, 0x20, 0xbd, 0x97, 0x4c, 0x05, 0x6c, 0xc8, 0xc2, 0x54, 0x65,
+	0xe3, 0x81, 0xd6, 0x19, 0xa8, 0x1f, 0x90, 0x49, 0x64, 0x60, 0x39, 0x4c, 0x37, 0xb6, 0xf8, 0x07,
+	0x25, 0x5d, 0xdf, 0xdc, 0xb9, 0x2c, 0xa4, 0x88, 0xf6, 0x87, 0xf7, 0x16, 0x4c, 0x4d, 0x49, 0xc0,
+	0x36, 0xc5, 0x7b, 0x1e, 0x63, 0x81, 0x4c, 0x82, 0x54, 0xa6, 0xb3, 0x9f, 0x48, 0x35, 0x9c, 0xd1,
+	0x71, 0x74, 0x46, 0x1f, 0x22, 0x63, 0xa2, 0xcd, 0xec, 0x87, 0xe7, 0x4c, 0x7a, 0x02, 0x95, 0x09,
+	0x3f, 0xcc, 0x92, 0x86, 0x71, 0x9a, 0xcc, 0xa7, 0xf2, 0xb6, 0x81, 0x4c, 0xc3, 0x3c, 0x72, 0xae,
+	0xdf, 0x4d, 0xd4, 0x65, 0x55, 0x91, 0x42, 0x1b, 0xc9, 0x17, 0x0c, 0x0b, 0xfb, 0xd2, 0xae, 0x2a,
+	0x00, 0x70, 0x00, 0xf3, 0x05, 0x2c, 0x63, 0xf8, 0xde, 0x87, 0x4c, 0xfa, 0x5f, 0xf0, 0xe4, 0x27,
+	0x64, 0x77, 0x7b, 0xac, 0x7c, 0x83, 0xd9, 0xb0, 0x3f, 0x95, 0x83, 0xed, 0x4f, 0xf5, 0x20, 0xfb,
+	0x53, 0x2b, 0xb6, 0x3f, 0xf5, 0x72, 0xfb, 0xe3, 0xff, 0x17, 0xaf, 0xe8, 0x05, 0x58, 0x7a, 0x86,
+	0xd4, 0x20, 0x99, 0x89, 0xbf, 0xb4, 0xbf, 0xa0, 0x8b, 0x39, 0xca, 0xe6, 0xd4, 0x1f, 0xda, 0x73,
+	0xaa, 0xbb, 0x29, 0x73, 0x8b, 0xd4, 0xf9, 0xe6, 0xac, 0xf3, 0x80, 0xb7, 0xc4, 0x9b, 0xfa, 0x7d,
+	0xdb, 0x9b, 0x72, 0x55, 0xa9, 0xdb, 0xfc, 0xb8, 0x57, 0xf6, 0x9c, 0x2d, 0x7d, 0x24, 0x33, 0xbe,
+	0xcb, 0x3e, 0x09, 0xac, 0x72, 0x96, 0x79, 0x49, 0x3f, 0xb2, 0xbd, 0xa4, 0xe2, 0xa6, 0xad, 0xc3,
+	0x9c, 0xa2, 0x07, 0x75, 0x9d, 0xa2, 0x29, 0xd9, 0xbf, 0xfa, 0x03, 0x7b, 0xff, 0xaa, 0xa8, 0x5a,
+	0xdd, 0xf6, 0xff, 0xf2, 0x4a, 0xde, 0xeb, 0xa5, 0x0f, 0x93, 0x51, 0x01, 0xc9, 0x44, 0xbc, 0xe5,
+	0x3e, 0x77, 0x2c, 0xf3, 0x95, 0xed, 0x54, 0xfc, 0xa1, 0xe7, 0x78, 0xce, 0xcb, 0xd1, 0xac, 0x19,
+	0x16, 0x51, 0xf4, 0x5a, 0x70, 0xd6, 0x78, 0x94, 0x85, 0xad, 0xfe, 0x91, 0x97, 0x7b, 0x84, 0xab,
+	0xb4, 0xd5, 0xaf, 0x7a, 0x85, 0xef, 0x10, 0xbb, 0xce, 0x7e, 0xb8, 0xdf, 0xbd, 0xa3, 0x3f, 0x36,
+	0x03, 0x23, 0xf8, 0x01, 0x52, 0xe7, 0x5f, 0xa8, 0xae, 0x5a, 0xdf, 0x60, 0xb3, 0x2d, 0x48, 0xc0,
+	0xf3, 0x94, 0xd1, 0xfe, 0xc7, 0x36, 0xed, 0x05, 0x64, 0x29, 0xda, 0x17, 0xc8, 0xdb, 0xc7, 0xce,
+	0x9e, 0x7d, 0x08, 0x33, 0xff, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0xd9, 0x02, 0xc9, 0x56,
+	0x80, 0x00, 0x00,
 }
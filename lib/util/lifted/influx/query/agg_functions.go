@@ -95,6 +95,12 @@ var (
 			mergeCall: true,
 		},
 	})
+	_ = RegistryAggregateFunction("approx_count_distinct", &ApproxCountDistinctFunc{
+		BaseInfo: BaseInfo{FuncType: AGG_SPECIAL},
+		BaseAgg: BaseAgg{
+			canPushDown: true,
+		},
+	})
 	_ = RegistryAggregateFunction("histogram", &HistogramFunc{
 		BaseInfo: BaseInfo{FuncType: AGG_SPECIAL},
 		BaseAgg:  BaseAgg{},
@@ -681,6 +687,27 @@ func (f *PercentileApproxFunc) CallTypeFunc(name string, args []influxql.DataTyp
 	return args[0], nil
 }
 
+type ApproxCountDistinctFunc struct {
+	BaseInfo
+	BaseAgg
+}
+
+func (f *ApproxCountDistinctFunc) GetRules(name string) []CheckRule {
+	return []CheckRule{
+		&ArgNumberCheckRule{Name: name, Min: 1, Max: 1},
+	}
+}
+
+func (f *ApproxCountDistinctFunc) CompileFunc(expr *influxql.Call, c *compiledField) error {
+	c.global.OnlySelectors = false
+	c.global.ApproxCountDistinctFunction = expr.Name
+	return c.compileSymbol(expr.Name, expr.Args[0])
+}
+
+func (f *ApproxCountDistinctFunc) CallTypeFunc(name string, args []influxql.DataType) (influxql.DataType, error) {
+	return influxql.Integer, nil
+}
+
 type HistogramFunc struct {
 	BaseInfo
 	BaseAgg
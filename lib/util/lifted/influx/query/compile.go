@@ -85,9 +85,17 @@ type compiledStatement struct {
 	// used in the statement.
 	PercentileOGSketchFunction string
 
+	// ApproxCountDistinctFunction is set to approx_count_distinct when the function is
+	// used in the statement.
+	ApproxCountDistinctFunction string
+
 	// HasAuxiliaryFields is true when the function requires auxiliary fields.
 	HasAuxiliaryFields bool
 
+	// WindowCalls holds a reference to the call expression of every window
+	// function call (e.g. lag() OVER (...)) that has been encountered.
+	WindowCalls []*influxql.Call
+
 	// Fields holds all of the fields that will be used.
 	Fields []*compiledField
 
@@ -149,6 +157,9 @@ func Compile(stmt *influxql.SelectStatement, opt CompileOptions) (Statement, err
 	// Convert PERCENTILE_OGSKETCH into the PERCENTILE_APPROX
 	c.stmt.RewritePercentileOGSketch()
 
+	// Convert APPROX_COUNT_DISTINCT into the HLL_COUNT which is not distributed
+	c.stmt.RewriteApproxCountDistinct()
+
 	if inCond, ok := c.stmt.Condition.(*influxql.InCondition); ok {
 		st, err := Compile(inCond.Stmt, CompileOptions{})
 		if err != nil {
@@ -185,6 +196,12 @@ func (c *compiledStatement) RewriteTimeRange(stmt *influxql.SelectStatement) err
 
 // preprocess retrieves and records the global attributes of the current statement.
 func (c *compiledStatement) preprocess(stmt *influxql.SelectStatement) error {
+	// The OVER clause of window functions may rewrite the dimensions and
+	// the ordering of the statement, so it must be applied first.
+	if err := c.compileWindowSpec(stmt); err != nil {
+		return err
+	}
+
 	c.Ascending = stmt.TimeAscending()
 	c.Limit = stmt.Limit
 	c.HasTarget = stmt.Target != nil
@@ -337,6 +354,10 @@ func (c *compiledField) compileExpr(expr influxql.Expr) error {
 		c.global.HasAuxiliaryFields = true
 		return nil
 	case *influxql.Call:
+		if expr.Window != nil || IsWindowFunction(expr.Name) {
+			return c.compileWindowFunction(expr)
+		}
+
 		if op.IsProjectOp(expr) {
 			return c.compileProjectOp(expr)
 		}
@@ -772,6 +793,140 @@ func (c *compiledField) GetFunction(expr *influxql.Call, addToGlobalFunctionCall
 	return nil
 }
 
+// windowFunctions are the functions which are evaluated over the rows of a partition
+// and must be followed by an OVER clause.
+var windowFunctions = map[string]struct{}{
+	"lag":         {},
+	"lead":        {},
+	"row_number":  {},
+	"rank":        {},
+	"first_value": {},
+	"last_value":  {},
+}
+
+// IsWindowFunction reports whether the function is a window function.
+func IsWindowFunction(name string) bool {
+	_, ok := windowFunctions[name]
+	return ok
+}
+
+func (c *compiledField) compileWindowFunction(expr *influxql.Call) error {
+	if !IsWindowFunction(expr.Name) {
+		return fmt.Errorf("%s() is not a window function and cannot be used with OVER", expr.Name)
+	}
+	if expr.Window == nil {
+		return fmt.Errorf("window function %s() requires an OVER clause", expr.Name)
+	}
+	if c.Field.Expr != expr {
+		return fmt.Errorf("window function %s() must be used as a top-level field", expr.Name)
+	}
+
+	switch expr.Name {
+	case "row_number", "rank":
+		if got := len(expr.Args); got != 0 {
+			return fmt.Errorf("invalid number of arguments for %s, expected 0, got %d", expr.Name, got)
+		}
+	case "first_value", "last_value":
+		if got := len(expr.Args); got != 1 {
+			return fmt.Errorf("invalid number of arguments for %s, expected 1, got %d", expr.Name, got)
+		}
+	case "lag", "lead":
+		if got := len(expr.Args); got < 1 || got > 2 {
+			return fmt.Errorf("invalid number of arguments for %s, expected at least 1 but no more than 2, got %d", expr.Name, got)
+		}
+		if len(expr.Args) == 2 {
+			n, ok := expr.Args[1].(*influxql.IntegerLiteral)
+			if !ok {
+				return fmt.Errorf("%s offset must be an integer", expr.Name)
+			} else if n.Val < 1 {
+				return fmt.Errorf("%s offset must be greater than or equal to 1", expr.Name)
+			}
+		}
+	}
+
+	if len(expr.Args) > 0 {
+		if _, ok := expr.Args[0].(*influxql.VarRef); !ok {
+			return fmt.Errorf("expected field argument in %s()", expr.Name)
+		}
+	}
+
+	c.global.HasAuxiliaryFields = true
+	c.global.WindowCalls = append(c.global.WindowCalls, expr)
+	return nil
+}
+
+// compileWindowSpec validates the OVER clauses of the window functions. The partitions
+// of the window are the series groups of the statement, so PARTITION BY is applied as
+// GROUP BY and ORDER BY time as the ordering of the statement.
+func (c *compiledStatement) compileWindowSpec(stmt *influxql.SelectStatement) error {
+	var spec *influxql.WindowSpec
+	var err error
+	for _, f := range stmt.Fields {
+		influxql.WalkFunc(f.Expr, func(n influxql.Node) {
+			call, ok := n.(*influxql.Call)
+			if !ok || call.Window == nil || err != nil {
+				return
+			}
+			if spec == nil {
+				spec = call.Window
+			} else if spec.String() != call.Window.String() {
+				err = errors.New("all window functions must use the same OVER clause")
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if spec == nil {
+		return nil
+	}
+
+	for _, f := range spec.SortFields {
+		if f.Name != "" && strings.ToLower(f.Name) != "time" {
+			return fmt.Errorf("window functions only support ORDER BY time, got %s", f.Name)
+		}
+	}
+
+	keys := spec.PartitionKeys()
+	if len(keys) != len(spec.PartitionBy) {
+		return errors.New("window functions only support PARTITION BY tag keys")
+	}
+	if len(keys) > 0 {
+		if len(stmt.Dimensions) == 0 {
+			for _, key := range keys {
+				stmt.Dimensions = append(stmt.Dimensions, &influxql.Dimension{Expr: &influxql.VarRef{Val: key}})
+			}
+		} else if _, tags := stmt.Dimensions.Normalize(); !equalStringSet(tags, keys) {
+			return errors.New("PARTITION BY of the OVER clause must match the GROUP BY clause")
+		}
+	}
+
+	if len(spec.SortFields) > 0 {
+		if len(stmt.SortFields) == 0 {
+			stmt.SortFields = influxql.SortFields{{Name: "time", Ascending: spec.Ascending()}}
+		} else if stmt.TimeAscending() != spec.Ascending() {
+			return errors.New("ORDER BY of the OVER clause must match the ORDER BY clause")
+		}
+	}
+	return nil
+}
+
+func equalStringSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]struct{}, len(a))
+	for _, s := range a {
+		set[s] = struct{}{}
+	}
+	for _, s := range b {
+		if _, ok := set[s]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
 func (c *compiledStatement) compileDimensions(stmt *influxql.SelectStatement) error {
 	for _, d := range stmt.Dimensions {
 		// Reduce the expression before attempting anything. Do not evaluate the call.
@@ -875,6 +1030,30 @@ func (c *compiledStatement) validateFields() error {
 			}
 		}
 	}
+	// Ensure there are not different calls if approx_count_distinct is present.
+	if len(c.FunctionCalls) > 1 && c.ApproxCountDistinctFunction != "" {
+		for _, call := range c.FunctionCalls {
+			if call.Name != "approx_count_distinct" {
+				return fmt.Errorf("aggregate function %s() cannot be combined with other functions", c.ApproxCountDistinctFunction)
+			}
+		}
+	}
+	// Window functions are evaluated over the raw rows of each partition.
+	if len(c.WindowCalls) > 0 {
+		if len(c.FunctionCalls) > 0 {
+			return errors.New("window functions cannot be combined with aggregate or selector functions")
+		}
+		hasField := false
+		for _, f := range c.Fields {
+			if len(influxql.ExprNames(f.Field.Expr)) > 0 {
+				hasField = true
+				break
+			}
+		}
+		if !hasField {
+			return fmt.Errorf("window function %s() requires at least one field to be selected", c.WindowCalls[0].Name)
+		}
+	}
 	// Validate we are using a selector or raw query if auxiliary fields are required.
 	if c.HasAuxiliaryFields {
 		if !c.OnlySelectors {
@@ -15,6 +15,7 @@ import (
 	"runtime/debug"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/influxdata/influxdb/models"
@@ -83,7 +84,56 @@ func ErrDatabaseNotFound(name string) error { return fmt.Errorf("database not fo
 
 // ErrMaxSelectPointsLimitExceeded is an error when a query hits the maximum number of points.
 func ErrMaxSelectPointsLimitExceeded(n, limit int) error {
-	return fmt.Errorf("max-select-point limit exceeed: (%d/%d)", n, limit)
+	return errno.NewError(errno.SelectPointsLimitExceeded, n, limit)
+}
+
+// PointLimiter counts the points read by a query. It is shared by all the readers of the query.
+// The limit is marshaled with the ProcessorOptions, so every store node counts the points it
+// scans against the same limit before they are aggregated.
+type PointLimiter struct {
+	limit      int64
+	n          int64
+	exceeded   int32
+	onExceeded func()
+}
+
+// NewPointLimiter returns nil if there is no limit. onExceeded is called once when the limit is exceeded.
+func NewPointLimiter(limit int, onExceeded func()) *PointLimiter {
+	if limit <= 0 {
+		return nil
+	}
+	return &PointLimiter{limit: int64(limit), onExceeded: onExceeded}
+}
+
+// Add counts n points and returns an error once the limit is exceeded.
+func (l *PointLimiter) Add(n int) error {
+	if l == nil {
+		return nil
+	}
+	total := atomic.AddInt64(&l.n, int64(n))
+	if total <= l.limit {
+		return nil
+	}
+	l.Exceeded()
+	return ErrMaxSelectPointsLimitExceeded(int(total), int(l.limit))
+}
+
+// Exceeded marks the limit as exceeded, it is called when a store node rejects the query.
+func (l *PointLimiter) Exceeded() {
+	if l == nil {
+		return
+	}
+	if atomic.CompareAndSwapInt32(&l.exceeded, 0, 1) && l.onExceeded != nil {
+		l.onExceeded()
+	}
+}
+
+// Limit returns the maximum number of points, 0 means unlimited.
+func (l *PointLimiter) Limit() int {
+	if l == nil {
+		return 0
+	}
+	return int(l.limit)
 }
 
 // ErrMaxConcurrentQueriesLimitExceeded is an error when a query cannot be run
@@ -158,8 +208,9 @@ func (a openAuthorizer) AuthorizeQuery(_ string, _ *influxql.Query) error { retu
 //}
 
 type RowsChan struct {
-	Rows    models.Rows // models.Rows of data
-	Partial bool        // is partial of rows
+	Rows        models.Rows         // models.Rows of data
+	Partial     bool                // is partial of rows
+	ColumnTypes []influxql.DataType // data types of the columns of Rows, nil if unknown
 }
 
 // ExecutionOptions contains the options for executing a query.
@@ -218,6 +269,12 @@ type ExecutionOptions struct {
 
 	// IterID indicates the number of iteration in incremental query, starting from 0.
 	IterID int32
+
+	// PointLimiter limits the number of points read by the query, nil means unlimited.
+	PointLimiter *PointLimiter
+
+	// TraceParent is the W3C traceparent header of the request, the query joins the caller's trace if it is set.
+	TraceParent string
 }
 
 func NewExecutionOptions(db, rp string, nodeID uint64, chunkSize, innerChunkSize int, chunked, readOnly, quiet, parallelQuery bool) *ExecutionOptions {
@@ -87,3 +87,106 @@ func Test_TimerangePushDown(t *testing.T) {
 		}
 	}
 }
+
+func Test_CompileWindowFunctions(t *testing.T) {
+	protection := query.TimeFilterProtection
+	query.TimeFilterProtection = false
+	defer func() {
+		query.TimeFilterProtection = protection
+	}()
+
+	cases := []struct {
+		query string
+		err   string
+	}{
+		{"SELECT value, lag(value, 2) OVER (PARTITION BY host ORDER BY time DESC) FROM cpu", ""},
+		{"SELECT lead(value) OVER (), row_number() OVER (), rank() OVER () FROM cpu", ""},
+		{"SELECT first_value(value) OVER (ORDER BY time), last_value(value) OVER (ORDER BY time) FROM cpu GROUP BY host", ""},
+		{"SELECT lag(value) FROM cpu", "window function lag() requires an OVER clause"},
+		{"SELECT count(value) OVER () FROM cpu", "count() is not a window function and cannot be used with OVER"},
+		{"SELECT lag(value, 0) OVER () FROM cpu", "lag offset must be greater than or equal to 1"},
+		{"SELECT lag(value, 'a') OVER () FROM cpu", "lag offset must be an integer"},
+		{"SELECT row_number(value) OVER () FROM cpu", "invalid number of arguments for row_number, expected 0, got 1"},
+		{"SELECT row_number() OVER () FROM cpu", "window function row_number() requires at least one field to be selected"},
+		{"SELECT value - lag(value) OVER () FROM cpu", "window function lag() must be used as a top-level field"},
+		{"SELECT lag(value) OVER (), count(value) FROM cpu", "window functions cannot be combined with aggregate or selector functions"},
+		{"SELECT lag(value) OVER (PARTITION BY host), lead(value) OVER () FROM cpu", "all window functions must use the same OVER clause"},
+		{"SELECT lag(value) OVER (ORDER BY value) FROM cpu", "window functions only support ORDER BY time, got value"},
+		{"SELECT lag(value) OVER (PARTITION BY host) FROM cpu GROUP BY region", "PARTITION BY of the OVER clause must match the GROUP BY clause"},
+		{"SELECT lag(value) OVER (ORDER BY time DESC) FROM cpu ORDER BY time ASC", "ORDER BY of the OVER clause must match the ORDER BY clause"},
+	}
+	for _, c := range cases {
+		q, err := influxql.ParseQuery(c.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = query.Compile(q.Statements[0].(*influxql.SelectStatement), query.CompileOptions{})
+		if c.err == "" {
+			if err != nil {
+				t.Fatalf("unexpected error %v with sql: %s", err, c.query)
+			}
+			continue
+		}
+		if err == nil || err.Error() != c.err {
+			t.Fatalf("expect error %q, got %v with sql: %s", c.err, err, c.query)
+		}
+	}
+
+	// the OVER clause is applied as the dimensions and the ordering of the statement
+	q, err := influxql.ParseQuery("SELECT value, lag(value) OVER (PARTITION BY host ORDER BY time DESC) FROM cpu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stmt := q.Statements[0].(*influxql.SelectStatement)
+	if _, err = query.Compile(stmt, query.CompileOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if stmt.Dimensions.String() != "host" || stmt.TimeAscending() {
+		t.Fatalf("unexpected dimensions %s and ascending %v", stmt.Dimensions, stmt.TimeAscending())
+	}
+}
+
+func TestPointLimiter(t *testing.T) {
+	var nilLimiter *query.PointLimiter
+	if err := nilLimiter.Add(100); err != nil {
+		t.Fatal(err)
+	}
+	if query.NewPointLimiter(0, nil) != nil {
+		t.Fatal("expect no limiter without limit")
+	}
+
+	exceeded := 0
+	l := query.NewPointLimiter(10, func() { exceeded++ })
+	if err := l.Add(6); err != nil {
+		t.Fatal(err)
+	}
+	if err := l.Add(4); err != nil {
+		t.Fatal(err)
+	}
+	err := l.Add(1)
+	if err == nil || err.Error() != "max-select-point limit exceeed: (11/10)" {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err = l.Add(1); err == nil {
+		t.Fatal("expect the limit exceeded")
+	}
+	if exceeded != 1 {
+		t.Fatalf("onExceeded is called %d times", exceeded)
+	}
+	if !errno.Equal(err, errno.SelectPointsLimitExceeded) {
+		t.Fatalf("unexpected error code: %v", err)
+	}
+	if l.Limit() != 10 || nilLimiter.Limit() != 0 {
+		t.Fatalf("unexpected limit %d", l.Limit())
+	}
+
+	// a store node rejects the query
+	exceeded = 0
+	l = query.NewPointLimiter(10, func() { exceeded++ })
+	l.Exceeded()
+	l.Exceeded()
+	nilLimiter.Exceeded()
+	if exceeded != 1 {
+		t.Fatalf("onExceeded is called %d times", exceeded)
+	}
+}
@@ -81,8 +81,10 @@ func (m FunctionTypeMapper) CallType(name string, args []influxql.DataType) (inf
 		"holt_winters", "holt_winters_with_fit",
 		"rate", "irate":
 		return influxql.Float, nil
-	case "elapsed", "absent":
+	case "elapsed", "absent", "row_number", "rank":
 		return influxql.Integer, nil
+	case "lag", "lead", "first_value", "last_value":
+		return args[0], nil
 	case "percentile", "percentile_ogsketch", "percentile_approx", "histogram", "distinct", "top", "bottom",
 		"difference", "non_negative_difference", "mode", "spread", "sample", "cumulative_sum":
 		return args[0], nil
@@ -90,6 +92,10 @@ func (m FunctionTypeMapper) CallType(name string, args []influxql.DataType) (inf
 		return influxql.Float, nil
 	case "ogsketch_insert", "ogsketch_merge":
 		return influxql.FloatTuple, nil
+	case "hll_count":
+		return influxql.Integer, nil
+	case "hll_insert", "hll_merge":
+		return influxql.FloatTuple, nil
 	default:
 		// TODO(jsternberg): Do not use default for this.
 		return influxql.Unknown, nil
@@ -86,6 +86,7 @@ func encodeProcessorOptions(opt *ProcessorOptions) *internal.ProcessorOptions {
 		Range:                 int64(opt.Range),
 		LookBackDelta:         int64(opt.LookBackDelta),
 		QueryOffset:           int64(opt.QueryOffset),
+		MaxScanPointN:         int64(opt.PointLimiter.Limit()),
 	}
 
 	// Set expression, if set.
@@ -165,6 +166,7 @@ func decodeProcessorOptions(pb *internal.ProcessorOptions) (*ProcessorOptions, e
 		Range:                 time.Duration(pb.Range),
 		LookBackDelta:         time.Duration(pb.LookBackDelta),
 		QueryOffset:           time.Duration(pb.QueryOffset),
+		PointLimiter:          NewPointLimiter(int(pb.GetMaxScanPointN()), nil),
 	}
 
 	// Set expression, if set.
@@ -16,6 +16,7 @@ import (
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxql"
 	json "github.com/json-iterator/go"
+	influxql2 "github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 )
 
 const (
@@ -100,6 +101,10 @@ type Result struct {
 	Messages    []*Message
 	Partial     bool
 	Err         error
+
+	// ColumnTypes are the data types of the columns of Series, nil if unknown.
+	// They are not encoded, the consumers building a typed schema, such as arrow, rely on them.
+	ColumnTypes []influxql2.DataType
 }
 
 // MarshalJSON encodes the result into JSON.
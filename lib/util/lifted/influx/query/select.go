@@ -46,6 +46,9 @@ type SelectOptions struct {
 	// Maximum number of buckets for a statement.
 	MaxBucketsN int
 
+	// PointLimiter limits the number of points read by the query, nil means unlimited.
+	PointLimiter *PointLimiter
+
 	// Maximum number of memory a query can use
 	MaxQueryMem int64
 
@@ -204,6 +207,9 @@ type ProcessorOptions struct {
 	// Limits on the creation of iterators.
 	MaxSeriesN int
 
+	// PointLimiter counts the points read by the query, only its limit is marshaled.
+	PointLimiter *PointLimiter
+
 	// If this channel is set and is closed, the iterator should try to exit
 	// and close as soon as possible.
 	InterruptCh <-chan struct{}
@@ -355,6 +361,7 @@ func NewProcessorOptionsStmt(stmt *influxql.SelectStatement, sopt SelectOptions)
 	opt.Limit, opt.Offset = stmt.Limit, stmt.Offset
 	opt.SLimit, opt.SOffset = stmt.SLimit, stmt.SOffset
 	opt.MaxSeriesN = sopt.MaxSeriesN
+	opt.PointLimiter = sopt.PointLimiter
 	opt.Authorizer = sopt.Authorizer
 
 	opt.ChunkedSize = sopt.ChunkedSize
@@ -125,6 +125,12 @@ var instantVectorFunctions = map[string]aggregateFn{
 	},
 }
 
+// histogramFieldFunctions read a native histogram field of the series instead of its buckets.
+var histogramFieldFunctions = map[string]string{
+	"histogram_count": HistogramCountFieldKey,
+	"histogram_sum":   HistogramSumFieldKey,
+}
+
 var vectorMathFunctions = map[string]aggregateFn{
 	"abs": {
 		name:         "abs",
@@ -575,8 +581,32 @@ func (t *Transpiler) transpileSort(aggFn aggregateFn, inArgs []influxql.Node) (i
 	return table, nil
 }
 
+// transpileHistogramFieldFunc transpiles histogram_count and histogram_sum. The native histogram
+// count and sum are stored as fields of the series, so the argument is transpiled as usual
+// with its vector selectors reading that field.
+func (t *Transpiler) transpileHistogramFieldFunc(a *parser.Call, field string) (influxql.Node, error) {
+	prev := t.valueField
+	t.valueField = field
+	defer func() {
+		t.valueField = prev
+	}()
+
+	unwrapParenExpr(&a.Args[0])
+	a.Args[0] = unwrapStepInvariantExpr(a.Args[0])
+	node, err := t.transpileExpr(a.Args[0])
+	if err != nil {
+		return nil, errno.NewError(errno.TranspileFunctionFail, err.Error())
+	}
+	t.dropMetric = true
+	return node, nil
+}
+
 // transpileCall transpiles PromQL Call expression
 func (t *Transpiler) transpileCall(a *parser.Call) (influxql.Node, error) {
+	if field, ok := histogramFieldFunctions[a.Func.Name]; ok {
+		return t.transpileHistogramFieldFunc(a, field)
+	}
+
 	// The PromQL parser already verifies argument counts and types, so we don't have to check this here.
 	args := make([]influxql.Node, len(a.Args))
 	for i := range a.Args {
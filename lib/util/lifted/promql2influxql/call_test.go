@@ -110,6 +110,28 @@ func TestTranspiler_transpileCall(t1 *testing.T) {
 			want:    parseInfluxqlByYacc(`SELECT mad_over_time_prom(value) AS value FROM go_gc_duration_seconds_count WHERE time >= '2023-01-06T06:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`),
 			wantErr: false,
 		},
+		{
+			name: "7",
+			fields: fields{
+				Evaluation: &endTime2,
+			},
+			args: args{
+				a: CallExpr(`histogram_count(http_request_duration_seconds)`),
+			},
+			want:    parseInfluxqlByYacc(`SELECT count AS value FROM http_request_duration_seconds WHERE time >= '2023-01-06T07:00:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *`),
+			wantErr: false,
+		},
+		{
+			name: "8",
+			fields: fields{
+				Start: &startTime2, End: &endTime2, Step: step,
+			},
+			args: args{
+				a: CallExpr(`histogram_sum(rate(http_request_duration_seconds[5m]))`),
+			},
+			want:    parseInfluxqlByYacc(`SELECT rate_prom(sum) AS value FROM http_request_duration_seconds WHERE time >= '2023-01-06T03:55:00Z' AND time <= '2023-01-06T07:00:00Z' GROUP BY *, time(1m,0s) fill(none)`),
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t1.Run(tt.name, func(t1 *testing.T) {
@@ -25,6 +25,13 @@ const (
 	PromSuffix                 string = "_prom"
 )
 
+// native histograms are stored as cumulative le buckets plus the count and sum fields
+const (
+	HistogramBucketLabel   string = "le"
+	HistogramCountFieldKey string = "count"
+	HistogramSumFieldKey   string = "sum"
+)
+
 const DefaultLookBackDelta = 5 * time.Minute
 const FieldCountForKeepMetric = 2
 
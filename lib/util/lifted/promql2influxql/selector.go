@@ -339,6 +339,9 @@ func (t *Transpiler) transpileInstantVectorSelector(v *parser.VectorSelector) (i
 		selectStatement.Sources = []influxql.Source{mst}
 	}
 	valueFieldKey := DefaultFieldKey
+	if len(t.valueField) > 0 {
+		valueFieldKey = t.valueField
+	}
 	if len(t.ValueFieldKey) == 0 {
 		t.ValueFieldKey = valueFieldKey
 	}
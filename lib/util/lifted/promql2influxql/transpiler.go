@@ -25,6 +25,8 @@ type Transpiler struct {
 	upperSubquery      int
 	subStartT, subEndT int64
 	lowerStepInvariant bool
+	// valueField overrides the field read by vector selectors, histogram_count and histogram_sum set it.
+	valueField string
 }
 
 func (t *Transpiler) rewriteMinMaxTime() {
@@ -291,6 +291,7 @@ func (r *Row) Copy(p *Row) {
 	}
 	for i := range p.Fields {
 		r.Fields[i].NumValue = p.Fields[i].NumValue
+		r.Fields[i].UnsignedValue = p.Fields[i].UnsignedValue
 		r.Fields[i].StrValue = p.Fields[i].StrValue
 		r.Fields[i].Type = p.Fields[i].Type
 		r.Fields[i].Key = p.Fields[i].Key
@@ -660,6 +661,8 @@ func (r *Row) marshalFields(dst []byte) ([]byte, error) {
 		if fields[i].Type == Field_Type_String {
 			dst = encoding.MarshalUint64(dst, uint64(len(fields[i].StrValue)))
 			dst = append(dst, fields[i].StrValue...)
+		} else if fields[i].Type == Field_Type_UInt {
+			dst = numberenc.MarshalUint64Append(dst, fields[i].UnsignedValue)
 		} else {
 			dst = numberenc.MarshalFloat64(dst, fields[i].NumValue)
 		}
@@ -717,7 +720,11 @@ func (r *Row) unmarshalFields(src []byte, fieldpool []Field) ([]byte, []Field, e
 				fieldpool = fieldpool[:len(fieldpool)-1]
 				return nil, fieldpool, errors.New("too small for field")
 			}
-			fd.NumValue = numberenc.UnmarshalFloat64(src[:8])
+			if fd.Type == Field_Type_UInt {
+				fd.SetUintValue(numberenc.UnmarshalUint64(src[:8]))
+			} else {
+				fd.NumValue = numberenc.UnmarshalFloat64(src[:8])
+			}
 			src = src[8:]
 		}
 	}
@@ -1378,6 +1385,8 @@ func FieldType2Val(fieldType int) (interface{}, error) {
 		return (*float64)(nil), nil
 	case Field_Type_Int:
 		return (*int64)(nil), nil
+	case Field_Type_UInt:
+		return (*uint64)(nil), nil
 	case Field_Type_String:
 		return (*string)(nil), nil
 	case Field_Type_Boolean:
@@ -1412,6 +1421,9 @@ type Field struct {
 	NumValue float64
 	StrValue string
 	Type     int32
+	// UnsignedValue is the exact value of an unsigned field, NumValue keeps
+	// the value converted to float64 for the consumers of numeric fields
+	UnsignedValue uint64
 }
 
 type Fields []Field
@@ -1434,9 +1446,34 @@ func (fs *Fields) Reset() {
 	}
 }
 
+// UintValue returns the exact value of an unsigned field.
+func (f *Field) UintValue() uint64 {
+	return f.UnsignedValue
+}
+
+func (f *Field) SetUintValue(v uint64) {
+	f.UnsignedValue = v
+	f.NumValue = float64(v)
+}
+
+// Float64Value returns the value of a numeric field as float64.
+func (f *Field) Float64Value() float64 {
+	return f.NumValue
+}
+
+// SetFloat64Value sets the value of a numeric field from float64.
+func (f *Field) SetFloat64Value(v float64) {
+	if f.Type == Field_Type_UInt {
+		f.SetUintValue(uint64(v))
+		return
+	}
+	f.NumValue = v
+}
+
 func (f *Field) Reset() {
 	f.Key = ""
 	f.NumValue = 0
+	f.UnsignedValue = 0
 	f.StrValue = ""
 	f.Type = Field_Type_Unknown
 }
@@ -1460,6 +1497,16 @@ func (f *Field) unmarshal(s string, noEscapeChars, hasQuotedFields bool) error {
 		f.Type = Field_Type_String
 		return nil
 	}
+	if vs := s[n+1:]; len(vs) > 1 && vs[len(vs)-1] == 'u' {
+		// unsigned integer values above 2^53 can not be kept in float64
+		v, err := fastfloat.ParseUint64(vs[:len(vs)-1])
+		if err != nil {
+			return fmt.Errorf("cannot parse field value for %q: %w", f.Key, err)
+		}
+		f.SetUintValue(v)
+		f.Type = Field_Type_UInt
+		return nil
+	}
 	v, t, err := parseFieldNumValue(s[n+1:])
 	if err != nil {
 		return fmt.Errorf("cannot parse field value for %q: %w", f.Key, err)
@@ -1610,7 +1657,12 @@ func parseFieldNumValue(s string) (float64, int32, error) {
 	}
 	if ch == 'u' {
 		// Unsigned integer value
-		return 0, Field_Type_Unknown, fmt.Errorf("invalid number")
+		ss := s[:len(s)-1]
+		n, err := fastfloat.ParseUint64(ss)
+		if err != nil {
+			return 0, Field_Type_Unknown, err
+		}
+		return float64(n), Field_Type_UInt, nil
 	}
 	if ch == 'f' {
 		// Unsigned integer value
@@ -107,6 +107,36 @@ func TestUnmarshalRows_error(t *testing.T) {
 
 }
 
+func TestUnmarshalRows_Unsigned(t *testing.T) {
+	rows, _, _, err := unmarshalRows(nil, "cpu,host=server01 u1=18446744073709551615u,u2=0u,i=-1i 1610467200000000000", nil, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rows))
+
+	fields := rows[0].Fields
+	require.Equal(t, 3, len(fields))
+	require.Equal(t, int32(Field_Type_UInt), fields[0].Type)
+	require.Equal(t, uint64(18446744073709551615), fields[0].UintValue())
+	require.Equal(t, float64(18446744073709551615), fields[0].Float64Value())
+	// the consumers of NumValue see the numeric value instead of the raw bits
+	require.Equal(t, float64(18446744073709551615), fields[0].NumValue)
+	require.Equal(t, int32(Field_Type_UInt), fields[1].Type)
+	require.Equal(t, uint64(0), fields[1].UintValue())
+	require.Equal(t, int32(Field_Type_Int), fields[2].Type)
+
+	// the unsigned values are kept exactly in the wal binary
+	data, err := FastMarshalMultiRows(nil, rows)
+	require.NoError(t, err)
+	decoded, _, _, _, _, err := FastUnmarshalMultiRows(data, nil, nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(18446744073709551615), decoded[0].Fields[0].UintValue())
+	require.Equal(t, float64(18446744073709551615), decoded[0].Fields[0].NumValue)
+
+	_, _, _, err = unmarshalRows(nil, "cpu,host=server01 u=-1u 1610467200000000000", nil, nil, false)
+	require.Error(t, err)
+	_, _, _, err = unmarshalRows(nil, "cpu,host=server01 u=18446744073709551616u 1610467200000000000", nil, nil, false)
+	require.Error(t, err)
+}
+
 func TestNextUnquotedChar(t *testing.T) {
 	f := func(s string, ch byte, noUnescape bool, nExpected int) {
 		t.Helper()
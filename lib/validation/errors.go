@@ -169,6 +169,24 @@ func newSampleTimestampTooNewError(metricName string, timestamp int64) Validatio
 	}
 }
 
+type invalidHistogramError struct {
+	metricName string
+	timestamp  int64
+	cause      string
+}
+
+func newInvalidHistogramError(metricName string, timestamp int64, cause string) ValidationError {
+	return &invalidHistogramError{
+		metricName: metricName,
+		timestamp:  timestamp,
+		cause:      cause,
+	}
+}
+
+func (e *invalidHistogramError) Error() string {
+	return fmt.Sprintf("invalid native histogram: %s timestamp: %d metric: %.200q", e.cause, e.timestamp, e.metricName)
+}
+
 type noSampleError struct{}
 
 func newNoSampleError() ValidationError {
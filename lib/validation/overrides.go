@@ -134,3 +134,57 @@ func (o *Overrides) EnforceMetadataMetricName(userID string) bool {
 func (o *Overrides) MaxQueryLength(userID string) time.Duration {
 	return time.Duration(o.getOverridesForUser(userID).MaxQueryLength)
 }
+
+// MaxConcurrentQueries returns the maximum number of queries running at the same time.
+func (o *Overrides) MaxConcurrentQueries(userID string) int {
+	return o.getOverridesForUser(userID).MaxConcurrentQueries
+}
+
+// MaxPointsPerQuery returns the maximum number of points a query can read.
+func (o *Overrides) MaxPointsPerQuery(userID string) int {
+	return o.getOverridesForUser(userID).MaxPointsPerQuery
+}
+
+// IngestionRate returns the limit of ingested samples per second.
+func (o *Overrides) IngestionRate(userID string) float64 {
+	return o.getOverridesForUser(userID).IngestionRate
+}
+
+// IngestionBurstSize returns the number of samples that can be ingested at once.
+func (o *Overrides) IngestionBurstSize(userID string) int {
+	return o.getOverridesForUser(userID).IngestionBurstSize
+}
+
+// IngestionBytesRate returns the limit of ingested bytes per second.
+func (o *Overrides) IngestionBytesRate(userID string) float64 {
+	return o.getOverridesForUser(userID).IngestionBytesRate
+}
+
+// IngestionBytesBurstSize returns the number of bytes that can be ingested at once.
+func (o *Overrides) IngestionBytesBurstSize(userID string) int {
+	return o.getOverridesForUser(userID).IngestionBytesBurstSize
+}
+
+// MaxNativeHistogramBuckets returns the maximum number of buckets of a native histogram sample.
+func (o *Overrides) MaxNativeHistogramBuckets(userID string) int {
+	return o.getOverridesForUser(userID).MaxNativeHistogramBuckets
+}
+
+// MaxActiveSeries returns the maximum number of series receiving samples.
+func (o *Overrides) MaxActiveSeries(userID string) int {
+	return o.getOverridesForUser(userID).MaxActiveSeries
+}
+
+// ActiveSeriesIdleTimeout returns the duration after which a series without samples is no longer active.
+func (o *Overrides) ActiveSeriesIdleTimeout(userID string) time.Duration {
+	return time.Duration(o.getOverridesForUser(userID).ActiveSeriesIdleTimeout)
+}
+
+// TenantID returns the key of the limits of a request: the user if it has its own limits,
+// otherwise the database.
+func (o *Overrides) TenantID(user, db string) string {
+	if user != "" && !o.isTenantLimitsNil() && o.tenantLimits.ByUserID(user) != nil {
+		return user
+	}
+	return db
+}
@@ -0,0 +1,282 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cespare/xxhash/v2"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"golang.org/x/time/rate"
+)
+
+// TenantLimitError is returned when a request is over the limits of its tenant.
+// The HTTP handlers respond to it with 429 Too Many Requests.
+type TenantLimitError struct {
+	Tenant string
+	Limit  string
+	Reason string
+}
+
+func (e *TenantLimitError) Error() string {
+	return fmt.Sprintf("tenant %q exceeded %s limit: %s", e.Tenant, e.Limit, e.Reason)
+}
+
+func newTenantLimitError(tenant, limit string, format string, a ...interface{}) error {
+	return &TenantLimitError{Tenant: tenant, Limit: limit, Reason: fmt.Sprintf(format, a...)}
+}
+
+// IsTenantLimitError reports whether err is caused by the limits of a tenant.
+func IsTenantLimitError(err error) bool {
+	var e *TenantLimitError
+	return errors.As(err, &e)
+}
+
+// tenantState is the state of the limits of one tenant on this node.
+type tenantState struct {
+	stats *statistics.TenantLimitsStats
+
+	mu      sync.Mutex
+	samples *rate.Limiter
+	bytes   *rate.Limiter
+
+	// series keeps the last time (unix nano) a sample of the series is received, by the hash of the series key
+	series    map[uint64]int64
+	lastPurge int64
+
+	queries int64
+}
+
+// TenantLimiter enforces the ingestion and query limits of the tenants. The limits
+// are read from the Overrides on every request, so the runtime config reloads take
+// effect without a restart.
+type TenantLimiter struct {
+	mu      sync.RWMutex
+	tenants map[string]*tenantState
+}
+
+var tenantLimiter = NewTenantLimiter()
+
+func NewTenantLimiter() *TenantLimiter {
+	return &TenantLimiter{tenants: make(map[string]*tenantState)}
+}
+
+func (tl *TenantLimiter) get(tenant string) *tenantState {
+	tl.mu.RLock()
+	st, ok := tl.tenants[tenant]
+	tl.mu.RUnlock()
+	if ok {
+		return st
+	}
+
+	tl.mu.Lock()
+	defer tl.mu.Unlock()
+	if st, ok = tl.tenants[tenant]; !ok {
+		st = &tenantState{stats: statistics.TenantLimitsStat.GetStats(tenant)}
+		tl.tenants[tenant] = st
+	}
+	return st
+}
+
+// updateLimiter applies the current rate and burst to the limiter, a rate of 0 disables it.
+func updateLimiter(l *rate.Limiter, r float64, burst int) *rate.Limiter {
+	if r <= 0 {
+		return nil
+	}
+	if burst <= 0 {
+		burst = int(math.Ceil(r))
+	}
+	if l == nil {
+		return rate.NewLimiter(rate.Limit(r), burst)
+	}
+	if l.Limit() != rate.Limit(r) {
+		l.SetLimit(rate.Limit(r))
+	}
+	if l.Burst() != burst {
+		l.SetBurst(burst)
+	}
+	return l
+}
+
+// AllowBytes checks the ingestion bytes rate limit of the tenant for a write request of size bytes.
+func (tl *TenantLimiter) AllowBytes(o *Overrides, tenant string, size int) error {
+	if size <= 0 {
+		return nil
+	}
+	st := tl.get(tenant)
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.bytes = updateLimiter(st.bytes, o.IngestionBytesRate(tenant), o.IngestionBytesBurstSize(tenant))
+	if st.bytes != nil && !st.bytes.AllowN(time.Now(), size) {
+		st.stats.AddRejectedBytes(int64(size))
+		return newTenantLimitError(tenant, "ingestion-bytes-rate", "%d bytes rejected, the limit is %g bytes/s with burst %d",
+			size, float64(st.bytes.Limit()), st.bytes.Burst())
+	}
+	return nil
+}
+
+// AllowRows checks the ingestion rate limit and the active series limit of the tenant
+// before the rows are written.
+func (tl *TenantLimiter) AllowRows(o *Overrides, tenant string, rows []influx.Row) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	st := tl.get(tenant)
+	now := time.Now()
+
+	samples := 0
+	for i := range rows {
+		samples += len(rows[i].Fields)
+	}
+
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	// the series are checked first, the rows rejected by the series limit do not take the tokens of the rate limit
+	hashes, err := st.checkSeries(o, tenant, rows, now.UnixNano())
+	if err != nil {
+		return err
+	}
+
+	st.samples = updateLimiter(st.samples, o.IngestionRate(tenant), o.IngestionBurstSize(tenant))
+	if st.samples != nil && !st.samples.AllowN(now, samples) {
+		st.dropNewSeries(hashes)
+		st.stats.AddRejectedSamples(int64(samples))
+		return newTenantLimitError(tenant, "ingestion-rate", "%d samples rejected, the limit is %g samples/s with burst %d",
+			samples, float64(st.samples.Limit()), st.samples.Burst())
+	}
+
+	st.activateSeries(hashes, now.UnixNano())
+	return nil
+}
+
+// checkSeries rejects the rows if their new series would exceed the active series limit.
+// The new series are marked until the rows are accepted by activateSeries or rejected by dropNewSeries.
+func (st *tenantState) checkSeries(o *Overrides, tenant string, rows []influx.Row, now int64) ([]uint64, error) {
+	maxSeries := o.MaxActiveSeries(tenant)
+	if maxSeries <= 0 {
+		st.series = nil
+		st.stats.SetActiveSeries(0)
+		return nil, nil
+	}
+	if st.series == nil {
+		st.series = make(map[uint64]int64)
+	}
+
+	idleTimeout := int64(o.ActiveSeriesIdleTimeout(tenant))
+	if now-st.lastPurge > idleTimeout/4 {
+		for h, lastSeen := range st.series {
+			if now-lastSeen > idleTimeout {
+				delete(st.series, h)
+			}
+		}
+		st.lastPurge = now
+	}
+
+	hashes := make([]uint64, len(rows))
+	newSeries := 0
+	for i := range rows {
+		hashes[i] = seriesHash(&rows[i])
+		if _, ok := st.series[hashes[i]]; !ok {
+			// mark it to count the series repeated in the rows once
+			st.series[hashes[i]] = 0
+			newSeries++
+		}
+	}
+
+	if newSeries > 0 && len(st.series) > maxSeries {
+		st.dropNewSeries(hashes)
+		st.stats.AddRejectedSeries(int64(newSeries))
+		return nil, newTenantLimitError(tenant, "max-active-series", "%d new series rejected, %d of %d active series are in use",
+			newSeries, len(st.series), maxSeries)
+	}
+	return hashes, nil
+}
+
+// dropNewSeries removes the new series marked by checkSeries of the rejected rows.
+func (st *tenantState) dropNewSeries(hashes []uint64) {
+	for _, h := range hashes {
+		if st.series[h] == 0 {
+			delete(st.series, h)
+		}
+	}
+}
+
+// activateSeries records the series of the accepted rows as active.
+func (st *tenantState) activateSeries(hashes []uint64, now int64) {
+	if st.series == nil {
+		return
+	}
+	for _, h := range hashes {
+		st.series[h] = now
+	}
+	st.stats.SetActiveSeries(int64(len(st.series)))
+}
+
+// seriesHash hashes the measurement and the tags of the row, the order of the tags does not matter.
+func seriesHash(row *influx.Row) uint64 {
+	h := xxhash.Sum64String(row.Name)
+	var tags uint64
+	for i := range row.Tags {
+		d := xxhash.New()
+		_, _ = d.WriteString(row.Tags[i].Key)
+		_, _ = d.Write([]byte{0})
+		_, _ = d.WriteString(row.Tags[i].Value)
+		tags += d.Sum64()
+	}
+	return h ^ (tags * 0x9E3779B97F4A7C15)
+}
+
+// AcquireQuery reserves a query slot of the tenant, the returned function releases it.
+func (tl *TenantLimiter) AcquireQuery(o *Overrides, tenant string) (func(), error) {
+	st := tl.get(tenant)
+	n := atomic.AddInt64(&st.queries, 1)
+	if maxQueries := o.MaxConcurrentQueries(tenant); maxQueries > 0 && n > int64(maxQueries) {
+		atomic.AddInt64(&st.queries, -1)
+		st.stats.AddRejectedQueries(1)
+		return nil, newTenantLimitError(tenant, "max-concurrent-queries", "%d queries are running", maxQueries)
+	}
+	st.stats.SetRunningQueries(n)
+
+	return func() {
+		st.stats.SetRunningQueries(atomic.AddInt64(&st.queries, -1))
+	}, nil
+}
+
+// AllowBytes checks the size of a write request against the limits of the tenant.
+func AllowBytes(tenant string, size int) error {
+	return tenantLimiter.AllowBytes(Limits(), tenant, size)
+}
+
+// AllowRows checks the rows of a write request against the limits of the tenant.
+func AllowRows(tenant string, rows []influx.Row) error {
+	return tenantLimiter.AllowRows(Limits(), tenant, rows)
+}
+
+// AcquireQuery reserves a query slot of the tenant, the returned function releases it.
+func AcquireQuery(tenant string) (func(), error) {
+	return tenantLimiter.AcquireQuery(Limits(), tenant)
+}
+
+// PointsLimitExceeded counts a query of the tenant aborted by the points per query limit.
+func PointsLimitExceeded(tenant string) {
+	tenantLimiter.get(tenant).stats.AddPointsLimitHits(1)
+}
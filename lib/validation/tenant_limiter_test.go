@@ -0,0 +1,168 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/prometheus/common/model"
+	"github.com/stretchr/testify/require"
+)
+
+func newTenantOverrides(setup func(limits *config.Limits)) *Overrides {
+	defaults := config.NewLimits()
+	tenant := config.NewLimits()
+	setup(&tenant)
+	return &Overrides{
+		defaultLimits: &defaults,
+		tenantLimits:  newMockTenantLimits(map[string]*config.Limits{"team_a": &tenant}),
+	}
+}
+
+func newTenantRows(n int, tags ...string) []influx.Row {
+	rows := make([]influx.Row, n)
+	for i := range rows {
+		rows[i].Name = "cpu"
+		rows[i].Tags = influx.PointTags{{Key: "host", Value: tags[i%len(tags)]}}
+		rows[i].Fields = influx.Fields{{Key: "value", NumValue: 1, Type: influx.Field_Type_Float}}
+	}
+	return rows
+}
+
+func TestOverridesTenantID(t *testing.T) {
+	o := newTenantOverrides(func(limits *config.Limits) {})
+	require.Equal(t, "team_a", o.TenantID("team_a", "db0"))
+	require.Equal(t, "db0", o.TenantID("team_b", "db0"))
+	require.Equal(t, "db0", o.TenantID("", "db0"))
+	require.Equal(t, "db0", Limits().TenantID("team_a", "db0"))
+}
+
+func TestTenantLimiterIngestionRate(t *testing.T) {
+	statistics.InitTenantLimitsStatistics(nil)
+	o := newTenantOverrides(func(limits *config.Limits) {
+		limits.IngestionRate = 1
+		limits.IngestionBurstSize = 10
+		limits.IngestionBytesRate = 1
+		limits.IngestionBytesBurstSize = 100
+	})
+	tl := NewTenantLimiter()
+
+	require.NoError(t, tl.AllowRows(o, "team_a", newTenantRows(8, "a")))
+	err := tl.AllowRows(o, "team_a", newTenantRows(8, "a"))
+	require.True(t, IsTenantLimitError(err))
+	require.EqualError(t, err, `tenant "team_a" exceeded ingestion-rate limit: 8 samples rejected, the limit is 1 samples/s with burst 10`)
+
+	require.NoError(t, tl.AllowBytes(o, "team_a", 60))
+	err = tl.AllowBytes(o, "team_a", 60)
+	require.True(t, IsTenantLimitError(err))
+
+	// the other tenants use the default limits
+	require.NoError(t, tl.AllowRows(o, "db0", newTenantRows(100, "a")))
+	require.NoError(t, tl.AllowBytes(o, "db0", 1000))
+
+	stats := statistics.TenantLimitsStat.GetStats("team_a")
+	require.Equal(t, int64(8), stats.RejectedSamples)
+	require.Equal(t, int64(60), stats.RejectedBytes)
+}
+
+func TestTenantLimiterActiveSeries(t *testing.T) {
+	statistics.InitTenantLimitsStatistics(nil)
+	o := newTenantOverrides(func(limits *config.Limits) {
+		limits.MaxActiveSeries = 2
+		limits.ActiveSeriesIdleTimeout = model.Duration(time.Hour)
+	})
+	tl := NewTenantLimiter()
+
+	require.NoError(t, tl.AllowRows(o, "team_a", newTenantRows(4, "a", "b")))
+	// the existing series can still be written
+	require.NoError(t, tl.AllowRows(o, "team_a", newTenantRows(2, "b")))
+
+	err := tl.AllowRows(o, "team_a", newTenantRows(2, "a", "c"))
+	require.True(t, IsTenantLimitError(err))
+	require.EqualError(t, err, `tenant "team_a" exceeded max-active-series limit: 1 new series rejected, 2 of 2 active series are in use`)
+	require.NoError(t, tl.AllowRows(o, "team_a", newTenantRows(1, "a")))
+
+	stats := statistics.TenantLimitsStat.GetStats("team_a")
+	require.Equal(t, int64(2), stats.ActiveSeries)
+	require.Equal(t, int64(1), stats.RejectedSeries)
+
+	// the series without samples for a while are no longer active
+	st := tl.get("team_a")
+	for h := range st.series {
+		st.series[h] = time.Now().Add(-2 * time.Hour).UnixNano()
+	}
+	st.lastPurge = 0
+	require.NoError(t, tl.AllowRows(o, "team_a", newTenantRows(2, "c", "d")))
+}
+
+func TestTenantLimiterSeriesBeforeRate(t *testing.T) {
+	statistics.InitTenantLimitsStatistics(nil)
+	o := newTenantOverrides(func(limits *config.Limits) {
+		limits.IngestionRate = 1
+		limits.IngestionBurstSize = 4
+		limits.MaxActiveSeries = 1
+		limits.ActiveSeriesIdleTimeout = model.Duration(time.Hour)
+	})
+	tl := NewTenantLimiter()
+
+	// the rows rejected by the series limit do not take the tokens of the rate limit
+	err := tl.AllowRows(o, "team_a", newTenantRows(4, "a", "b"))
+	require.EqualError(t, err, `tenant "team_a" exceeded max-active-series limit: 2 new series rejected, 0 of 1 active series are in use`)
+	require.NoError(t, tl.AllowRows(o, "team_a", newTenantRows(4, "a")))
+
+	// the series of the rows rejected by the rate limit are not active
+	err = tl.AllowRows(o, "team_a", newTenantRows(4, "a"))
+	require.True(t, IsTenantLimitError(err))
+	err = tl.AllowRows(o, "team_a", newTenantRows(1, "b"))
+	require.True(t, IsTenantLimitError(err))
+	stats := statistics.TenantLimitsStat.GetStats("team_a")
+	require.Equal(t, int64(1), stats.ActiveSeries)
+	require.Equal(t, int64(3), stats.RejectedSeries)
+}
+
+func TestSeriesHashIgnoresTagsOrder(t *testing.T) {
+	r1 := influx.Row{Name: "cpu", Tags: influx.PointTags{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}}
+	r2 := influx.Row{Name: "cpu", Tags: influx.PointTags{{Key: "b", Value: "2"}, {Key: "a", Value: "1"}}}
+	r3 := influx.Row{Name: "cpu", Tags: influx.PointTags{{Key: "a", Value: "2"}, {Key: "b", Value: "1"}}}
+	require.Equal(t, seriesHash(&r1), seriesHash(&r2))
+	require.NotEqual(t, seriesHash(&r1), seriesHash(&r3))
+}
+
+func TestTenantLimiterConcurrentQueries(t *testing.T) {
+	statistics.InitTenantLimitsStatistics(nil)
+	o := newTenantOverrides(func(limits *config.Limits) {
+		limits.MaxConcurrentQueries = 1
+	})
+	tl := NewTenantLimiter()
+
+	release, err := tl.AcquireQuery(o, "team_a")
+	require.NoError(t, err)
+	_, err = tl.AcquireQuery(o, "team_a")
+	require.True(t, IsTenantLimitError(err))
+	require.EqualError(t, err, `tenant "team_a" exceeded max-concurrent-queries limit: 1 queries are running`)
+
+	release()
+	release, err = tl.AcquireQuery(o, "team_a")
+	require.NoError(t, err)
+	release()
+
+	stats := statistics.TenantLimitsStat.GetStats("team_a")
+	require.Equal(t, int64(1), stats.RejectedQueries)
+	require.Equal(t, int64(0), stats.RunningQueries)
+}
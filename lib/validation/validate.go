@@ -16,6 +16,7 @@ package validation
 
 import (
 	"bytes"
+	"math"
 	"time"
 
 	prompb2 "github.com/VictoriaMetrics/VictoriaMetrics/lib/prompb"
@@ -29,6 +30,10 @@ const (
 	typeHelp       = "HELP"
 	typeUnit       = "UNIT"
 
+	// the range of the exponential schemas of native histograms
+	histogramSchemaMin = -4
+	histogramSchemaMax = 8
+
 	metricNameTooLong = "metric_name_too_long"
 	helpTooLong       = "help_too_long"
 	unitTooLong       = "unit_too_long"
@@ -156,19 +161,106 @@ func ValidateSeries(userID string, ts *prompb2.TimeSeries) ValidationError {
 		return err
 	}
 
-	if len(ts.Samples) > 0 {
-		for _, s := range ts.Samples {
-			if err := ValidateSample(userID, unsafeMetricName, s); err != nil {
-				return err
-			}
-		}
-	} else {
+	if len(ts.Samples) == 0 && len(ts.Histograms) == 0 {
 		return newNoSampleError()
 	}
+	for _, s := range ts.Samples {
+		if err := ValidateSample(userID, unsafeMetricName, s); err != nil {
+			return err
+		}
+	}
+	for i := range ts.Histograms {
+		if err := ValidateHistogram(userID, unsafeMetricName, &ts.Histograms[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// ValidateHistogram returns an err if the native histogram sample is invalid,
+// the timestamp, the schema, the bucket spans and the bucket counts are checked.
+func ValidateHistogram(userID string, metricName string, h *prompb2.Histogram) ValidationError {
+	if limits == nil {
+		return nil
+	}
+
+	if err := ValidateSample(userID, metricName, prompb2.Sample{Timestamp: h.Timestamp}); err != nil {
+		return err
+	}
+	if h.Schema < histogramSchemaMin || h.Schema > histogramSchemaMax {
+		return newInvalidHistogramError(metricName, h.Timestamp, "schema out of range")
+	}
+
+	var cause string
+	if h.IsFloatHistogram() {
+		cause = checkFloatHistogram(h)
+	} else {
+		cause = checkIntHistogram(h)
+	}
+	if cause != "" {
+		return newInvalidHistogramError(metricName, h.Timestamp, cause)
+	}
 	return nil
 }
 
+func checkIntHistogram(h *prompb2.Histogram) string {
+	if len(h.PositiveCounts) > 0 || len(h.NegativeCounts) > 0 {
+		return "float buckets in integer histogram"
+	}
+	if !checkHistogramSpans(h.PositiveSpans, len(h.PositiveDeltas)) || !checkHistogramSpans(h.NegativeSpans, len(h.NegativeDeltas)) {
+		return "spans do not match buckets"
+	}
+	count := h.ZeroCountInt
+	for _, deltas := range [][]int64{h.PositiveDeltas, h.NegativeDeltas} {
+		var cur int64
+		for _, d := range deltas {
+			cur += d
+			if cur < 0 {
+				return "negative bucket count"
+			}
+			count += uint64(cur)
+		}
+	}
+	if count > h.CountInt {
+		return "count is less than the observations in the buckets"
+	}
+	return ""
+}
+
+func checkFloatHistogram(h *prompb2.Histogram) string {
+	if len(h.PositiveDeltas) > 0 || len(h.NegativeDeltas) > 0 {
+		return "integer buckets in float histogram"
+	}
+	if !checkHistogramSpans(h.PositiveSpans, len(h.PositiveCounts)) || !checkHistogramSpans(h.NegativeSpans, len(h.NegativeCounts)) {
+		return "spans do not match buckets"
+	}
+	if !(h.CountFloat >= 0) || !(h.ZeroCountFloat >= 0) {
+		return "negative count"
+	}
+	for _, counts := range [][]float64{h.PositiveCounts, h.NegativeCounts} {
+		for _, c := range counts {
+			// NaN is rejected as well
+			if !(c >= 0) || math.IsInf(c, 0) {
+				return "negative bucket count"
+			}
+		}
+	}
+	return ""
+}
+
+// checkHistogramSpans returns false if the spans do not cover numBuckets buckets
+func checkHistogramSpans(spans []prompb2.BucketSpan, numBuckets int) bool {
+	var n int
+	for i, span := range spans {
+		if i > 0 && span.Offset < 0 {
+			return false
+		}
+		n += int(span.Length)
+	}
+	return n == numBuckets
+}
+
 // validate query time range.
 func ValidateQueryTimeRange(userID string, startTime, endTime time.Time) ValidationError {
 	if limits == nil {
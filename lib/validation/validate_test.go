@@ -298,6 +298,46 @@ func TestValidateSeries(t *testing.T) {
 	}
 }
 
+func TestValidateHistogram(t *testing.T) {
+	StubInitOverrides()
+
+	now := int64(model.Now())
+	valid := func() *prompb2.Histogram {
+		return &prompb2.Histogram{
+			CountInt:       7,
+			ZeroCountInt:   1,
+			NegativeSpans:  []prompb2.BucketSpan{{Offset: 0, Length: 1}},
+			NegativeDeltas: []int64{1},
+			PositiveSpans:  []prompb2.BucketSpan{{Offset: 0, Length: 1}, {Offset: 2, Length: 1}},
+			PositiveDeltas: []int64{2, 1},
+			Timestamp:      now,
+		}
+	}
+	require.Nil(t, ValidateHistogram("", "testmetric", valid()))
+
+	for _, c := range []struct {
+		desc   string
+		modify func(h *prompb2.Histogram)
+		errMsg string
+	}{
+		{"schema out of range", func(h *prompb2.Histogram) { h.Schema = 9 }, "schema out of range"},
+		{"spans need more buckets", func(h *prompb2.Histogram) { h.PositiveSpans[1].Length = 2 }, "spans do not match buckets"},
+		{"negative span offset", func(h *prompb2.Histogram) { h.PositiveSpans[1].Offset = -1 }, "spans do not match buckets"},
+		{"negative bucket", func(h *prompb2.Histogram) { h.PositiveDeltas[1] = -3 }, "negative bucket count"},
+		{"count too small", func(h *prompb2.Histogram) { h.CountInt = 3 }, "count is less than the observations in the buckets"},
+		{"mixed buckets", func(h *prompb2.Histogram) { h.PositiveCounts = []float64{1} }, "float buckets in integer histogram"},
+		{"timestamp too old", func(h *prompb2.Histogram) { h.Timestamp = int64(model.Now().Add(-25 * time.Hour)) }, "timestamp too old"},
+	} {
+		t.Run(c.desc, func(t *testing.T) {
+			h := valid()
+			c.modify(h)
+			err := ValidateHistogram("", "testmetric", h)
+			require.NotNil(t, err)
+			require.Contains(t, err.Error(), c.errMsg)
+		})
+	}
+}
+
 func TestValidateQueryTimeRange(t *testing.T) {
 	StubInitOverrides()
 
@@ -0,0 +1,364 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"go.uber.org/zap"
+)
+
+const (
+	statusFiring   = "firing"
+	statusResolved = "resolved"
+
+	// webhookVersion is the version of the alertmanager webhook payload.
+	webhookVersion = "4"
+)
+
+// webhookMessage is the payload posted to the receivers, which is compatible with the webhook of alertmanager.
+type webhookMessage struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []webhookAlert    `json:"alerts"`
+}
+
+type webhookAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+type groupedAlert struct {
+	rule  *meta.AlertRuleInfo
+	alert *meta.AlertInfo
+}
+
+// alertGroup is the notification state of the alerts which are sent to a receiver together.
+type alertGroup struct {
+	key       string
+	receiver  *meta.AlertReceiverInfo
+	labels    map[string]string
+	createdAt time.Time
+	lastSent  time.Time
+	sent      map[string]int32 // the state of the alerts in the last notification by fingerprint
+
+	alerts []groupedAlert
+}
+
+// notifier groups the firing and resolved alerts by the receivers and posts them to the webhooks,
+// following the group_wait, group_interval and repeat_interval of the receivers like alertmanager.
+// The notification state is saved in the alerts reported to ts-meta, and restored when a group is not known,
+// so that a restarted sql node or the one taking over the rule does not notify the alerts again.
+type notifier struct {
+	client      *http.Client
+	externalURL string
+	logger      *logger.Logger
+
+	groups map[string]*alertGroup
+}
+
+func newNotifier(timeout time.Duration, externalURL string, logger *logger.Logger) *notifier {
+	return &notifier{
+		client:      &http.Client{Timeout: timeout},
+		externalURL: externalURL,
+		logger:      logger,
+		groups:      make(map[string]*alertGroup),
+	}
+}
+
+func silenced(silences map[string]*meta.AlertSilenceInfo, labels map[string]string, now time.Time) bool {
+	for _, silence := range silences {
+		if silence.Active(now) && silence.Matches(labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// groupAlerts assigns the alerts which are not silenced to the groups of their receivers.
+func (n *notifier) groupAlerts(rules []*meta.AlertRuleInfo, receivers map[string]*meta.AlertReceiverInfo,
+	silences map[string]*meta.AlertSilenceInfo, now time.Time) map[string]*alertGroup {
+	groups := make(map[string]*alertGroup)
+	var created []*alertGroup
+	for _, rule := range rules {
+		for _, alert := range rule.Alerts {
+			if alert.State != meta.AlertFiring && alert.State != meta.AlertResolved {
+				continue
+			}
+			if silenced(silences, alert.Labels, now) {
+				continue
+			}
+			for _, name := range rule.Receivers {
+				receiver, ok := receivers[name]
+				if !ok {
+					continue
+				}
+				labels := groupLabels(receiver, alert)
+				key := receiver.Name + ":" + meta.AlertLabelsString(labels)
+				group, ok := groups[key]
+				if !ok {
+					group, ok = n.groups[key]
+					if !ok {
+						group = &alertGroup{key: key, labels: labels, createdAt: now}
+						created = append(created, group)
+					}
+					group.receiver = receiver
+					group.alerts = group.alerts[:0]
+					groups[key] = group
+				}
+				group.alerts = append(group.alerts, groupedAlert{rule: rule, alert: alert})
+			}
+		}
+	}
+	// the groups unknown to the notifier may have been notified by the previous owner of the rules
+	for _, group := range created {
+		group.restore()
+	}
+	return groups
+}
+
+func groupLabels(receiver *meta.AlertReceiverInfo, alert *meta.AlertInfo) map[string]string {
+	labels := make(map[string]string, len(receiver.GroupBy))
+	for _, label := range receiver.GroupBy {
+		if v, ok := alert.Labels[label]; ok {
+			labels[label] = v
+		}
+	}
+	return labels
+}
+
+func groupKey(receiver *meta.AlertReceiverInfo, alert *meta.AlertInfo) string {
+	return receiver.Name + ":" + meta.AlertLabelsString(groupLabels(receiver, alert))
+}
+
+// restore rebuilds the state of the last notification of the group from the notifications saved in its alerts.
+// The alerts notified at the latest time are the ones of the last notification.
+func (g *alertGroup) restore() {
+	for _, a := range g.alerts {
+		if sent, ok := a.alert.Notification(g.receiver.Name); ok && sent.SentAt.After(g.lastSent) {
+			g.lastSent = sent.SentAt
+		}
+	}
+	if g.lastSent.IsZero() {
+		return
+	}
+	g.sent = make(map[string]int32, len(g.alerts))
+	for _, a := range g.alerts {
+		if sent, ok := a.alert.Notification(g.receiver.Name); ok && sent.SentAt.Equal(g.lastSent) {
+			g.sent[a.alert.Fingerprint()] = sent.State
+		}
+	}
+}
+
+// saveNotifications returns the alerts of the rule with the state of their last notifications sent to the
+// receivers of the rule. The alerts of the groups unknown to the notifier keep the notifications they have.
+func (n *notifier) saveNotifications(rule *meta.AlertRuleInfo, alerts []*meta.AlertInfo,
+	receivers map[string]*meta.AlertReceiverInfo) []*meta.AlertInfo {
+	saved := make([]*meta.AlertInfo, len(alerts))
+	for i, alert := range alerts {
+		// the alerts may be shared with the rules cached by the meta client
+		saved[i] = alert.Clone()
+		for _, name := range rule.Receivers {
+			receiver, ok := receivers[name]
+			if !ok {
+				continue
+			}
+			group, ok := n.groups[groupKey(receiver, alert)]
+			if !ok {
+				continue
+			}
+			saved[i].Notifications = setNotification(saved[i].Notifications, group, alert.Fingerprint())
+		}
+	}
+	return saved
+}
+
+// setNotification replaces the notification of the receiver of the group by the state of its last notification.
+func setNotification(notifications []meta.AlertNotification, group *alertGroup, fp string) []meta.AlertNotification {
+	result := notifications[:0]
+	for _, n := range notifications {
+		if n.Receiver != group.receiver.Name {
+			result = append(result, n)
+		}
+	}
+	if state, ok := group.sent[fp]; ok {
+		result = append(result, meta.AlertNotification{Receiver: group.receiver.Name, State: state, SentAt: group.lastSent})
+	}
+	return result
+}
+
+// notify sends the notifications of the groups which are due at now, it returns true if any notification is sent.
+func (n *notifier) notify(rules []*meta.AlertRuleInfo, receivers map[string]*meta.AlertReceiverInfo,
+	silences map[string]*meta.AlertSilenceInfo, now time.Time) bool {
+	sent := false
+	n.groups = n.groupAlerts(rules, receivers, silences, now)
+	for _, group := range n.groups {
+		alerts, due := group.pending(now)
+		if !due {
+			continue
+		}
+		if err := n.send(group, alerts); err != nil {
+			n.logger.Warn("send alert notification failed", zap.String("receiver", group.receiver.Name),
+				zap.String("url", group.receiver.URL), zap.Error(err))
+			continue
+		}
+		group.lastSent = now
+		group.sent = make(map[string]int32, len(alerts))
+		for _, a := range alerts {
+			group.sent[a.alert.Fingerprint()] = a.alert.State
+		}
+		sent = true
+	}
+	return sent
+}
+
+// pending returns the alerts to be notified and whether the notification is due at now.
+// The resolved alerts are notified once if they have been notified as firing.
+func (g *alertGroup) pending(now time.Time) ([]groupedAlert, bool) {
+	var alerts []groupedAlert
+	changed, firing := false, false
+	for _, a := range g.alerts {
+		sent, ok := g.sent[a.alert.Fingerprint()]
+		if a.alert.State == meta.AlertResolved && sent != meta.AlertFiring {
+			continue
+		}
+		if !ok || sent != a.alert.State {
+			changed = true
+		}
+		if a.alert.State == meta.AlertFiring {
+			firing = true
+		}
+		alerts = append(alerts, a)
+	}
+	if len(alerts) == 0 {
+		return nil, false
+	}
+	switch {
+	case g.lastSent.IsZero():
+		return alerts, now.Sub(g.createdAt) >= g.receiver.GroupWait
+	case changed:
+		return alerts, now.Sub(g.lastSent) >= g.receiver.GroupInterval
+	case firing:
+		return alerts, now.Sub(g.lastSent) >= g.receiver.RepeatInterval
+	}
+	return nil, false
+}
+
+func alertFingerprint(labels map[string]string) string {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(meta.AlertLabelsString(labels)))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// commonLabels returns the labels shared by all the label sets.
+func commonLabels(sets []map[string]string) map[string]string {
+	common := make(map[string]string)
+	if len(sets) == 0 {
+		return common
+	}
+	for k, v := range sets[0] {
+		common[k] = v
+	}
+	for _, set := range sets[1:] {
+		for k, v := range common {
+			if other, ok := set[k]; !ok || other != v {
+				delete(common, k)
+			}
+		}
+	}
+	return common
+}
+
+func (n *notifier) message(group *alertGroup, alerts []groupedAlert) *webhookMessage {
+	sort.Slice(alerts, func(i, j int) bool {
+		return alerts[i].alert.Fingerprint() < alerts[j].alert.Fingerprint()
+	})
+	msg := &webhookMessage{
+		Version:     webhookVersion,
+		GroupKey:    group.key,
+		Status:      statusResolved,
+		Receiver:    group.receiver.Name,
+		GroupLabels: group.labels,
+		ExternalURL: n.externalURL,
+		Alerts:      make([]webhookAlert, len(alerts)),
+	}
+	labels := make([]map[string]string, len(alerts))
+	annotations := make([]map[string]string, len(alerts))
+	for i, a := range alerts {
+		status := statusResolved
+		if a.alert.State == meta.AlertFiring {
+			status = statusFiring
+			msg.Status = statusFiring
+		}
+		labels[i] = a.alert.Labels
+		annotations[i] = a.rule.ExpandAnnotations(a.alert)
+		msg.Alerts[i] = webhookAlert{
+			Status:      status,
+			Labels:      a.alert.Labels,
+			Annotations: annotations[i],
+			StartsAt:    a.alert.ActiveAt,
+			EndsAt:      a.alert.ResolvedAt,
+			Fingerprint: alertFingerprint(a.alert.Labels),
+		}
+		if msg.Alerts[i].Annotations == nil {
+			msg.Alerts[i].Annotations = map[string]string{}
+		}
+	}
+	msg.CommonLabels = commonLabels(labels)
+	msg.CommonAnnotations = commonLabels(annotations)
+	return msg
+}
+
+func (n *notifier) send(group *alertGroup, alerts []groupedAlert) error {
+	body, err := json.Marshal(n.message(group, alerts))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, group.receiver.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
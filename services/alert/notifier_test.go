@@ -0,0 +1,202 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+type webhookRecorder struct {
+	mu       sync.Mutex
+	messages []*webhookMessage
+	status   int
+}
+
+func (r *webhookRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	msg := &webhookMessage{}
+	if err := json.NewDecoder(req.Body).Decode(msg); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.status != 0 {
+		w.WriteHeader(r.status)
+		return
+	}
+	r.messages = append(r.messages, msg)
+}
+
+func (r *webhookRecorder) take() []*webhookMessage {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	messages := r.messages
+	r.messages = nil
+	return messages
+}
+
+func TestNotifier(t *testing.T) {
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	start := time.Unix(1719862200, 0)
+	receivers := map[string]*meta.AlertReceiverInfo{"ops": {Name: "ops", URL: server.URL, GroupBy: []string{"alertname"},
+		GroupWait: 30 * time.Second, GroupInterval: 5 * time.Minute, RepeatInterval: time.Hour}}
+	alert := func(host string, state int32) *meta.AlertInfo {
+		return &meta.AlertInfo{Labels: map[string]string{"alertname": "cpu", "host": host}, State: state, ActiveAt: start, Value: 95}
+	}
+	rule := &meta.AlertRuleInfo{Name: "cpu", Receivers: []string{"ops", "missing"},
+		Annotations: map[string]string{"summary": "{{ $labels.host }} is busy"},
+		Alerts:      []*meta.AlertInfo{alert("a", meta.AlertFiring), alert("b", meta.AlertPending)}}
+	n := newNotifier(time.Second, "http://ts-sql:8086", logger.NewLogger(errno.ModuleUnknown))
+
+	// wait for the group_wait of the new group
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start)
+	require.Empty(t, recorder.take())
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(30*time.Second))
+	messages := recorder.take()
+	require.Len(t, messages, 1)
+	require.Equal(t, statusFiring, messages[0].Status)
+	require.Equal(t, "ops", messages[0].Receiver)
+	require.Equal(t, `ops:{alertname="cpu"}`, messages[0].GroupKey)
+	require.Equal(t, map[string]string{"alertname": "cpu"}, messages[0].GroupLabels)
+	require.Equal(t, map[string]string{"alertname": "cpu", "host": "a"}, messages[0].CommonLabels)
+	require.Equal(t, "http://ts-sql:8086", messages[0].ExternalURL)
+	require.Len(t, messages[0].Alerts, 1)
+	require.Equal(t, map[string]string{"summary": "a is busy"}, messages[0].Alerts[0].Annotations)
+	require.True(t, start.Equal(messages[0].Alerts[0].StartsAt))
+
+	// the new firing alert waits for the group_interval
+	rule.Alerts[1].State = meta.AlertFiring
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(time.Minute))
+	require.Empty(t, recorder.take())
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(5*time.Minute+30*time.Second))
+	messages = recorder.take()
+	require.Len(t, messages, 1)
+	require.Len(t, messages[0].Alerts, 2)
+	require.Equal(t, map[string]string{"alertname": "cpu"}, messages[0].CommonLabels)
+
+	// nothing changed, the group is repeated after the repeat_interval
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(30*time.Minute))
+	require.Empty(t, recorder.take())
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(65*time.Minute+30*time.Second))
+	require.Len(t, recorder.take(), 1)
+
+	// the resolved alert is notified once
+	rule.Alerts[0].State, rule.Alerts[0].ResolvedAt = meta.AlertResolved, start.Add(70*time.Minute)
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(71*time.Minute))
+	messages = recorder.take()
+	require.Len(t, messages, 1)
+	require.Equal(t, statusFiring, messages[0].Status)
+	require.Equal(t, statusResolved, messages[0].Alerts[0].Status)
+	require.Equal(t, statusFiring, messages[0].Alerts[1].Status)
+	rule.Alerts[1].State, rule.Alerts[1].ResolvedAt = meta.AlertResolved, start.Add(75*time.Minute)
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(77*time.Minute))
+	messages = recorder.take()
+	require.Len(t, messages, 1)
+	require.Equal(t, statusResolved, messages[0].Status)
+	require.Len(t, messages[0].Alerts, 1)
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(3*time.Hour))
+	require.Empty(t, recorder.take())
+}
+
+func TestNotifierSilenceAndRetry(t *testing.T) {
+	recorder := &webhookRecorder{status: http.StatusInternalServerError}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	now := time.Unix(1719862200, 0)
+	receivers := map[string]*meta.AlertReceiverInfo{"ops": {Name: "ops", URL: server.URL, GroupBy: []string{"host"}}}
+	rule := &meta.AlertRuleInfo{Name: "cpu", Receivers: []string{"ops"}, Alerts: []*meta.AlertInfo{
+		{Labels: map[string]string{"alertname": "cpu", "host": "a"}, State: meta.AlertFiring, ActiveAt: now},
+		{Labels: map[string]string{"alertname": "cpu", "host": "b"}, State: meta.AlertFiring, ActiveAt: now},
+	}}
+	silences := map[string]*meta.AlertSilenceInfo{"s1": {ID: "s1", Matchers: []meta.AlertMatcher{{Name: "host", Value: "b"}},
+		StartsAt: now.Add(-time.Minute), EndsAt: now.Add(time.Hour)}}
+	n := newNotifier(time.Second, "", logger.NewLogger(errno.ModuleUnknown))
+
+	// the failed notification is retried in the next run
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, silences, now)
+	require.Empty(t, recorder.take())
+	require.True(t, n.groups[`ops:{host="a"}`].lastSent.IsZero())
+
+	recorder.status = 0
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, silences, now.Add(time.Second))
+	messages := recorder.take()
+	require.Len(t, messages, 1)
+	require.Equal(t, map[string]string{"host": "a"}, messages[0].GroupLabels)
+	require.NotContains(t, n.groups, `ops:{host="b"}`)
+
+	// the alert is notified after the silence expires
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, silences, now.Add(2*time.Hour))
+	messages = recorder.take()
+	require.Len(t, messages, 2)
+}
+
+func TestNotifierRestore(t *testing.T) {
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	start := time.Unix(1719862200, 0)
+	receivers := map[string]*meta.AlertReceiverInfo{"ops": {Name: "ops", URL: server.URL, GroupBy: []string{"alertname"},
+		GroupInterval: 5 * time.Minute, RepeatInterval: time.Hour}}
+	rule := &meta.AlertRuleInfo{Name: "cpu", Receivers: []string{"ops"}, Alerts: []*meta.AlertInfo{
+		{Labels: map[string]string{"alertname": "cpu", "host": "a"}, State: meta.AlertFiring, ActiveAt: start},
+	}}
+	n := newNotifier(time.Second, "", logger.NewLogger(errno.ModuleUnknown))
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start)
+	require.Len(t, recorder.take(), 1)
+
+	// the state of the notification is saved in the alerts reported to ts-meta, the cached alerts are not changed
+	alerts := n.saveNotifications(rule, rule.Alerts, receivers)
+	require.Empty(t, rule.Alerts[0].Notifications)
+	require.Len(t, alerts[0].Notifications, 1)
+	require.Equal(t, "ops", alerts[0].Notifications[0].Receiver)
+	require.Equal(t, meta.AlertFiring, alerts[0].Notifications[0].State)
+	require.True(t, start.Equal(alerts[0].Notifications[0].SentAt))
+
+	// another sql node takes over the rule, the firing alert is not notified again before the repeat_interval
+	rule.Alerts = alerts
+	other := newNotifier(time.Second, "", logger.NewLogger(errno.ModuleUnknown))
+	other.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(10*time.Minute))
+	require.Empty(t, recorder.take())
+	other.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(61*time.Minute))
+	require.Len(t, recorder.take(), 1)
+
+	// the resolution is notified after the takeover, and only once
+	rule.Alerts = other.saveNotifications(rule, rule.Alerts, receivers)
+	rule.Alerts[0].State, rule.Alerts[0].ResolvedAt = meta.AlertResolved, start.Add(70*time.Minute)
+	n = newNotifier(time.Second, "", logger.NewLogger(errno.ModuleUnknown))
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(76*time.Minute))
+	messages := recorder.take()
+	require.Len(t, messages, 1)
+	require.Equal(t, statusResolved, messages[0].Status)
+	rule.Alerts = n.saveNotifications(rule, rule.Alerts, receivers)
+	n = newNotifier(time.Second, "", logger.NewLogger(errno.ModuleUnknown))
+	n.notify([]*meta.AlertRuleInfo{rule}, receivers, nil, start.Add(3*time.Hour))
+	require.Empty(t, recorder.take())
+}
@@ -0,0 +1,230 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+const maxQueryResponseSize = 64 << 20
+
+// Sample is one series returned by the query of a rule, the value is compared with the condition of the rule.
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Querier evaluates the query of a rule at the time.
+type Querier interface {
+	Query(ctx context.Context, rule *meta.AlertRuleInfo, ts time.Time) ([]Sample, error)
+}
+
+// httpQuerier sends the queries to the http endpoints of ts-sql, so that the rules are evaluated
+// with the same authorization and limits as the queries of the users.
+type httpQuerier struct {
+	url      string
+	username string
+	password string
+	client   *http.Client
+}
+
+func newHTTPQuerier(queryURL, username, password string, timeout time.Duration) *httpQuerier {
+	return &httpQuerier{
+		url:      strings.TrimSuffix(queryURL, "/"),
+		username: username,
+		password: password,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+func (q *httpQuerier) Query(ctx context.Context, rule *meta.AlertRuleInfo, ts time.Time) ([]Sample, error) {
+	values := url.Values{}
+	var path string
+	var parse func([]byte) ([]Sample, error)
+	switch rule.Type {
+	case meta.AlertTypeInfluxQL:
+		path = "/query"
+		values.Set("db", rule.Database)
+		values.Set("rp", rule.RetentionPolicy)
+		values.Set("q", rule.Query)
+		values.Set("epoch", "ns")
+		parse = parseInfluxQLSamples
+	case meta.AlertTypePromQL:
+		path = "/api/v1/query"
+		values.Set("db", rule.Database)
+		values.Set("rp", rule.RetentionPolicy)
+		values.Set("query", rule.Query)
+		values.Set("time", strconv.FormatFloat(float64(ts.UnixNano())/1e9, 'f', -1, 64))
+		parse = parsePromQLSamples
+	case meta.AlertTypeLogQL:
+		path = fmt.Sprintf("/repo/%s/logstreams/%s/loki/api/v1/query", url.PathEscape(rule.Database), url.PathEscape(rule.LogStream))
+		values.Set("query", rule.Query)
+		values.Set("time", strconv.FormatInt(ts.UnixNano(), 10))
+		parse = parsePromQLSamples
+	default:
+		return nil, fmt.Errorf("unsupported alert rule type %q", rule.Type)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, q.url+path+"?"+values.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if q.username != "" {
+		req.SetBasicAuth(q.username, q.password)
+	}
+	resp, err := q.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxQueryResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("query failed with status %d: %s", resp.StatusCode, queryErrorMessage(body))
+	}
+	return parse(body)
+}
+
+// queryErrorMessage extracts the error from the json response, the plain text response is returned as it is.
+func queryErrorMessage(body []byte) string {
+	var resp struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(body, &resp) == nil && resp.Error != "" {
+		return resp.Error
+	}
+	return strings.TrimSpace(string(body))
+}
+
+type influxQLResponse struct {
+	Results []struct {
+		Series []struct {
+			Name    string            `json:"name"`
+			Tags    map[string]string `json:"tags"`
+			Columns []string          `json:"columns"`
+			Values  [][]interface{}   `json:"values"`
+		} `json:"series"`
+		Error string `json:"error"`
+	} `json:"results"`
+	Error string `json:"error"`
+}
+
+// parseInfluxQLSamples returns a sample for each series, which is the first numeric column of the last row.
+func parseInfluxQLSamples(body []byte) ([]Sample, error) {
+	var resp influxQLResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("invalid influxql response: %v", err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("%s", resp.Error)
+	}
+	var samples []Sample
+	for _, result := range resp.Results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("%s", result.Error)
+		}
+		for _, series := range result.Series {
+			if len(series.Values) == 0 {
+				continue
+			}
+			row := series.Values[len(series.Values)-1]
+			for i, column := range series.Columns {
+				if column == "time" || i >= len(row) {
+					continue
+				}
+				v, ok := row[i].(float64)
+				if !ok {
+					continue
+				}
+				samples = append(samples, Sample{Labels: series.Tags, Value: v})
+				break
+			}
+		}
+	}
+	return samples, nil
+}
+
+type promQLResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+type promQLVectorResult struct {
+	Metric map[string]string `json:"metric"`
+	Value  [2]interface{}    `json:"value"`
+}
+
+// parsePromQLSamples parses the response of the instant query of prometheus or loki.
+func parsePromQLSamples(body []byte) ([]Sample, error) {
+	var resp promQLResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, fmt.Errorf("invalid promql response: %v", err)
+	}
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("%s", resp.Error)
+	}
+	switch resp.Data.ResultType {
+	case "vector":
+		var vector []promQLVectorResult
+		if err := json.Unmarshal(resp.Data.Result, &vector); err != nil {
+			return nil, fmt.Errorf("invalid promql vector: %v", err)
+		}
+		samples := make([]Sample, 0, len(vector))
+		for _, r := range vector {
+			v, err := promQLValue(r.Value)
+			if err != nil {
+				return nil, err
+			}
+			samples = append(samples, Sample{Labels: r.Metric, Value: v})
+		}
+		return samples, nil
+	case "scalar":
+		var scalar [2]interface{}
+		if err := json.Unmarshal(resp.Data.Result, &scalar); err != nil {
+			return nil, fmt.Errorf("invalid promql scalar: %v", err)
+		}
+		v, err := promQLValue(scalar)
+		if err != nil {
+			return nil, err
+		}
+		return []Sample{{Value: v}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported result type %q, the query must return a vector or a scalar", resp.Data.ResultType)
+	}
+}
+
+func promQLValue(value [2]interface{}) (float64, error) {
+	s, ok := value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid sample value %v", value[1])
+	}
+	return strconv.ParseFloat(s, 64)
+}
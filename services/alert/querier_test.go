@@ -0,0 +1,104 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInfluxQLSamples(t *testing.T) {
+	samples, err := parseInfluxQLSamples([]byte(`{"results":[{"statement_id":0,"series":[
+		{"name":"cpu","tags":{"host":"a"},"columns":["time","host_name","usage"],"values":[[1,"a",10],[2,"a",95.5]]},
+		{"name":"cpu","tags":{"host":"b"},"columns":["time","usage"],"values":[[2,null]]},
+		{"name":"cpu","tags":{"host":"c"},"columns":["time","usage"],"values":[]}]}]}`))
+	require.NoError(t, err)
+	require.Equal(t, []Sample{{Labels: map[string]string{"host": "a"}, Value: 95.5}}, samples)
+
+	_, err = parseInfluxQLSamples([]byte(`{"results":[{"statement_id":0,"error":"database not found: db0"}]}`))
+	require.EqualError(t, err, "database not found: db0")
+	_, err = parseInfluxQLSamples([]byte(`{"error":"error parsing query"}`))
+	require.EqualError(t, err, "error parsing query")
+	_, err = parseInfluxQLSamples([]byte(`<html>`))
+	require.Error(t, err)
+}
+
+func TestParsePromQLSamples(t *testing.T) {
+	samples, err := parsePromQLSamples([]byte(`{"status":"success","data":{"resultType":"vector","result":[
+		{"metric":{"job":"node"},"value":[1719862200,"0"]},{"metric":{"job":"api"},"value":[1719862200,"NaN"]}]}}`))
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+	require.Equal(t, Sample{Labels: map[string]string{"job": "node"}, Value: 0}, samples[0])
+
+	samples, err = parsePromQLSamples([]byte(`{"status":"success","data":{"resultType":"scalar","result":[1719862200,"2"]}}`))
+	require.NoError(t, err)
+	require.Equal(t, []Sample{{Value: 2}}, samples)
+
+	_, err = parsePromQLSamples([]byte(`{"status":"success","data":{"resultType":"matrix","result":[]}}`))
+	require.Error(t, err)
+	_, err = parsePromQLSamples([]byte(`{"status":"error","errorType":"bad_data","error":"parse error"}`))
+	require.EqualError(t, err, "parse error")
+	_, err = parsePromQLSamples([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1,2]}]}}`))
+	require.Error(t, err)
+}
+
+func TestHTTPQuerier(t *testing.T) {
+	var requests []*http.Request
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+		switch r.URL.Path {
+		case "/query":
+			_, _ = w.Write([]byte(`{"results":[{"statement_id":0,"series":[{"name":"cpu","columns":["time","last"],"values":[[1,99]]}]}]}`))
+		case "/api/v1/query":
+			_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"vector","result":[{"metric":{"job":"node"},"value":[1,"0"]}]}}`))
+		default:
+			http.Error(w, "log stream not found", http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+
+	q := newHTTPQuerier(server.URL+"/", "admin", "pwd", time.Second)
+	now := time.Unix(1719862212, 500000000)
+	samples, err := q.Query(context.Background(), &meta.AlertRuleInfo{Type: meta.AlertTypeInfluxQL, Database: "db0",
+		RetentionPolicy: "rp0", Query: "SELECT last(usage) FROM cpu"}, now)
+	require.NoError(t, err)
+	require.Equal(t, []Sample{{Value: 99}}, samples)
+	require.Equal(t, "db0", requests[0].URL.Query().Get("db"))
+	require.Equal(t, "ns", requests[0].URL.Query().Get("epoch"))
+	username, password, ok := requests[0].BasicAuth()
+	require.True(t, ok)
+	require.Equal(t, "admin", username)
+	require.Equal(t, "pwd", password)
+
+	samples, err = q.Query(context.Background(), &meta.AlertRuleInfo{Type: meta.AlertTypePromQL, Database: "prom", Query: "up == 0"}, now)
+	require.NoError(t, err)
+	require.Len(t, samples, 1)
+	require.Equal(t, "1719862212.5", requests[1].URL.Query().Get("time"))
+
+	_, err = q.Query(context.Background(), &meta.AlertRuleInfo{Type: meta.AlertTypeLogQL, Database: "repo", LogStream: "app",
+		Query: `sum(count_over_time({app="web"}[5m]))`}, now)
+	require.EqualError(t, err, "query failed with status 400: log stream not found")
+	require.Equal(t, "/repo/repo/logstreams/app/loki/api/v1/query", requests[2].URL.Path)
+	require.Equal(t, "1719862212500000000", requests[2].URL.Query().Get("time"))
+
+	_, err = q.Query(context.Background(), &meta.AlertRuleInfo{Type: "sql"}, now)
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"sort"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+)
+
+// AlertNameLabel is added to the labels of every alert with the name of its rule.
+const AlertNameLabel = "alertname"
+
+// alertLabels returns the labels of the alert raised by the sample, the labels of the rule override the labels
+// of the sample.
+func alertLabels(rule *meta.AlertRuleInfo, sample *Sample) map[string]string {
+	labels := make(map[string]string, len(sample.Labels)+len(rule.Labels)+1)
+	for k, v := range sample.Labels {
+		labels[k] = v
+	}
+	for k, v := range rule.Labels {
+		labels[k] = v
+	}
+	labels[AlertNameLabel] = rule.Name
+	return labels
+}
+
+// evaluate returns the alerts of the rule after an evaluation at now, given the samples returned by its query.
+// A sample which satisfies the condition makes a pending alert, which fires once the condition holds for the
+// `for` duration of the rule. A pending alert is dropped as soon as the condition does not hold anymore, while
+// a firing alert is resolved and kept for the retention so that its resolution can be notified.
+func evaluate(rule *meta.AlertRuleInfo, cond *meta.AlertCondition, samples []Sample, now time.Time, retention time.Duration) []*meta.AlertInfo {
+	previous := make(map[string]*meta.AlertInfo, len(rule.Alerts))
+	for _, alert := range rule.Alerts {
+		previous[alert.Fingerprint()] = alert
+	}
+
+	current := make(map[string]*meta.AlertInfo, len(samples))
+	for i := range samples {
+		if !cond.Match(samples[i].Value) {
+			continue
+		}
+		alert := &meta.AlertInfo{Labels: alertLabels(rule, &samples[i]), Value: samples[i].Value}
+		fp := alert.Fingerprint()
+		if _, ok := current[fp]; ok {
+			// the series only differ in the labels overridden by the rule
+			continue
+		}
+		if old, ok := previous[fp]; ok && old.State != meta.AlertResolved {
+			alert.State, alert.ActiveAt, alert.FiredAt = old.State, old.ActiveAt, old.FiredAt
+			alert.Notifications = old.Notifications
+		} else {
+			alert.State, alert.ActiveAt = meta.AlertPending, now
+		}
+		if alert.State == meta.AlertPending && now.Sub(alert.ActiveAt) >= rule.For {
+			alert.State, alert.FiredAt = meta.AlertFiring, now
+		}
+		current[fp] = alert
+	}
+
+	for fp, old := range previous {
+		if _, ok := current[fp]; ok {
+			continue
+		}
+		switch old.State {
+		case meta.AlertFiring:
+			alert := old.Clone()
+			alert.State, alert.ResolvedAt = meta.AlertResolved, now
+			current[fp] = alert
+		case meta.AlertResolved:
+			if now.Sub(old.ResolvedAt) < retention {
+				current[fp] = old.Clone()
+			}
+		}
+	}
+
+	fps := make([]string, 0, len(current))
+	for fp := range current {
+		fps = append(fps, fp)
+	}
+	sort.Strings(fps)
+	alerts := make([]*meta.AlertInfo, len(fps))
+	for i, fp := range fps {
+		alerts[i] = current[fp]
+	}
+	return alerts
+}
+
+// isDue returns true if the rule should be evaluated by the host at now. The rule leased to another sql node
+// is skipped until the lease expires.
+func isDue(rule *meta.AlertRuleInfo, host string, now time.Time, interval time.Duration) bool {
+	if rule.Owner != "" && rule.Owner != host && now.Before(rule.LeaseExpire) {
+		return false
+	}
+	return now.Sub(rule.LastEvalTime) >= interval
+}
@@ -0,0 +1,95 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvaluate(t *testing.T) {
+	rule := &meta.AlertRuleInfo{Name: "cpu", For: 2 * time.Minute, Labels: map[string]string{"severity": "critical", "host": "overridden"}}
+	cond, err := meta.ParseAlertCondition("> 90")
+	require.NoError(t, err)
+	start := time.Unix(1719862200, 0)
+	labels := map[string]string{"alertname": "cpu", "host": "overridden", "severity": "critical"}
+
+	// the condition holds, the alert is pending until it holds for 2 minutes
+	rule.Alerts = evaluate(rule, cond, []Sample{{Labels: map[string]string{"host": "a"}, Value: 95}, {Value: 50}}, start, time.Minute)
+	require.Len(t, rule.Alerts, 1)
+	require.Equal(t, labels, rule.Alerts[0].Labels)
+	require.Equal(t, meta.AlertPending, rule.Alerts[0].State)
+	require.Equal(t, start, rule.Alerts[0].ActiveAt)
+
+	rule.Alerts = evaluate(rule, cond, []Sample{{Value: 96}}, start.Add(time.Minute), time.Minute)
+	require.Equal(t, meta.AlertPending, rule.Alerts[0].State)
+	require.Equal(t, float64(96), rule.Alerts[0].Value)
+
+	rule.Alerts = evaluate(rule, cond, []Sample{{Value: 97}}, start.Add(2*time.Minute), time.Minute)
+	require.Equal(t, meta.AlertFiring, rule.Alerts[0].State)
+	require.Equal(t, start, rule.Alerts[0].ActiveAt)
+	require.Equal(t, start.Add(2*time.Minute), rule.Alerts[0].FiredAt)
+
+	// the firing alert is resolved, and kept for the retention
+	rule.Alerts = evaluate(rule, cond, []Sample{{Value: 10}}, start.Add(3*time.Minute), time.Minute)
+	require.Equal(t, meta.AlertResolved, rule.Alerts[0].State)
+	require.Equal(t, start.Add(3*time.Minute), rule.Alerts[0].ResolvedAt)
+	rule.Alerts = evaluate(rule, cond, nil, start.Add(3*time.Minute+30*time.Second), time.Minute)
+	require.Len(t, rule.Alerts, 1)
+	rule.Alerts = evaluate(rule, cond, nil, start.Add(4*time.Minute), time.Minute)
+	require.Len(t, rule.Alerts, 0)
+
+	// the pending alert is dropped as soon as the condition does not hold
+	rule.Alerts = evaluate(rule, cond, []Sample{{Value: 91}}, start.Add(5*time.Minute), time.Minute)
+	require.Equal(t, meta.AlertPending, rule.Alerts[0].State)
+	rule.Alerts = evaluate(rule, cond, []Sample{{Value: 90}}, start.Add(6*time.Minute), time.Minute)
+	require.Len(t, rule.Alerts, 0)
+}
+
+func TestEvaluateWithoutFor(t *testing.T) {
+	rule := &meta.AlertRuleInfo{Name: "up"}
+	now := time.Unix(1719862200, 0)
+	alerts := evaluate(rule, nil, []Sample{
+		{Labels: map[string]string{"job": "b"}, Value: 0},
+		{Labels: map[string]string{"job": "a"}, Value: 0},
+	}, now, time.Minute)
+	require.Len(t, alerts, 2)
+	require.Equal(t, "a", alerts[0].Labels["job"])
+	require.Equal(t, meta.AlertFiring, alerts[0].State)
+	require.Equal(t, now, alerts[0].FiredAt)
+
+	// the resolved alert fires again as a new alert
+	rule.Alerts = []*meta.AlertInfo{{Labels: map[string]string{"alertname": "up", "job": "a"}, State: meta.AlertResolved,
+		ActiveAt: now.Add(-time.Hour), ResolvedAt: now.Add(-time.Second)}}
+	alerts = evaluate(rule, nil, []Sample{{Labels: map[string]string{"job": "a"}, Value: 0}}, now, time.Minute)
+	require.Equal(t, meta.AlertFiring, alerts[0].State)
+	require.Equal(t, now, alerts[0].ActiveAt)
+	require.True(t, alerts[0].ResolvedAt.IsZero())
+}
+
+func TestIsDue(t *testing.T) {
+	now := time.Unix(1719862200, 0)
+	rule := &meta.AlertRuleInfo{}
+	require.True(t, isDue(rule, "sql1", now, time.Minute))
+
+	rule.Owner, rule.LastEvalTime, rule.LeaseExpire = "sql1", now.Add(-30*time.Second), now.Add(time.Minute)
+	require.False(t, isDue(rule, "sql1", now, time.Minute))
+	require.True(t, isDue(rule, "sql1", now.Add(30*time.Second), time.Minute))
+	require.False(t, isDue(rule, "sql2", now.Add(30*time.Second), time.Minute))
+	require.True(t, isDue(rule, "sql2", now.Add(time.Minute), time.Minute))
+}
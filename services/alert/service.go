@@ -0,0 +1,215 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/services"
+	"go.uber.org/zap"
+)
+
+const (
+	// leaseFactor is the number of evaluations the lease of a rule lasts, so that another sql node takes over
+	// the rule only after the owner misses several evaluations.
+	leaseFactor = 3
+
+	// silenceRetention is how long the expired silences are kept before they are removed.
+	silenceRetention = 24 * time.Hour
+)
+
+type MetaClient interface {
+	AlertRules() map[string]*meta.AlertRuleInfo
+	AlertReceivers() map[string]*meta.AlertReceiverInfo
+	AlertSilences() map[string]*meta.AlertSilenceInfo
+	ReportAlertStates(host string, now time.Time, states []*meta.AlertRuleState) error
+	DropAlertSilence(id string) error
+}
+
+// Service evaluates the alert rules stored in ts-meta and sends the notifications of their alerts.
+// Each rule is leased to the sql node which evaluates it, the state of the alerts is reported to ts-meta
+// so that the rule can be taken over by another sql node with its alerts.
+type Service struct {
+	base services.Base
+
+	hostname           string
+	evaluationInterval time.Duration
+	queryTimeout       time.Duration
+	resolvedRetention  time.Duration
+	maxConcurrent      int
+
+	MetaClient MetaClient
+	Querier    Querier
+
+	notifier *notifier
+	logger   *logger.Logger
+}
+
+// NewService creates a new Service instance named alert
+func NewService(hostname string, c config.AlertConfig) *Service {
+	s := &Service{
+		hostname:           hostname,
+		evaluationInterval: time.Duration(c.EvaluationInterval),
+		queryTimeout:       time.Duration(c.QueryTimeout),
+		resolvedRetention:  time.Duration(c.ResolvedRetention),
+		maxConcurrent:      c.MaxConcurrent,
+		Querier:            newHTTPQuerier(c.QueryURL, c.Username, c.Password, time.Duration(c.QueryTimeout)),
+	}
+	s.base.Init("alert", time.Duration(c.RunInterval), s.handle)
+	s.logger = s.base.Logger
+	s.notifier = newNotifier(time.Duration(c.WebhookTimeout), c.ExternalURL, s.logger)
+	return s
+}
+
+func (s *Service) WithLogger(logger *logger.Logger) {
+	s.logger = logger.With(zap.String("service", "alert"))
+	s.notifier.logger = s.logger
+}
+
+func (s *Service) Open() error {
+	return s.base.Open()
+}
+
+func (s *Service) Close() error {
+	return s.base.Close()
+}
+
+func (s *Service) ruleInterval(rule *meta.AlertRuleInfo) time.Duration {
+	if rule.Interval > 0 {
+		return rule.Interval
+	}
+	return s.evaluationInterval
+}
+
+func (s *Service) handle() {
+	now := time.Now()
+	var due []*meta.AlertRuleInfo
+	for _, rule := range s.MetaClient.AlertRules() {
+		if isDue(rule, s.hostname, now, s.ruleInterval(rule)) {
+			due = append(due, rule)
+		}
+	}
+
+	if len(due) > 0 {
+		states := s.evaluate(due, now)
+		if err := s.MetaClient.ReportAlertStates(s.hostname, now, states); err != nil {
+			s.logger.Error("report alert states failed", zap.Error(err))
+			return
+		}
+	}
+
+	// only the notifications of the rules leased to this node are sent, the report may have lost the lease
+	var owned []*meta.AlertRuleInfo
+	for _, rule := range s.MetaClient.AlertRules() {
+		if rule.Owner == s.hostname {
+			owned = append(owned, rule)
+		}
+	}
+	sort.Slice(owned, func(i, j int) bool {
+		return owned[i].Name < owned[j].Name
+	})
+	silences := s.MetaClient.AlertSilences()
+	receivers := s.MetaClient.AlertReceivers()
+	if s.notifier.notify(owned, receivers, silences, now) {
+		s.reportNotifications(owned, receivers, now)
+	}
+	s.pruneSilences(silences, now)
+}
+
+// reportNotifications saves the state of the notifications in the alerts of the rules right after they are sent,
+// the rest of the rule state is reported as it is.
+func (s *Service) reportNotifications(rules []*meta.AlertRuleInfo, receivers map[string]*meta.AlertReceiverInfo, now time.Time) {
+	states := make([]*meta.AlertRuleState, len(rules))
+	for i, rule := range rules {
+		states[i] = &meta.AlertRuleState{
+			Name:         rule.Name,
+			LeaseExpire:  rule.LeaseExpire,
+			LastEvalTime: rule.LastEvalTime,
+			LastError:    rule.LastError,
+			Alerts:       s.notifier.saveNotifications(rule, rule.Alerts, receivers),
+		}
+	}
+	if err := s.MetaClient.ReportAlertStates(s.hostname, now, states); err != nil {
+		s.logger.Error("report alert notifications failed", zap.Error(err))
+	}
+}
+
+// evaluate evaluates the rules concurrently and returns their new states.
+func (s *Service) evaluate(rules []*meta.AlertRuleInfo, now time.Time) []*meta.AlertRuleState {
+	states := make([]*meta.AlertRuleState, len(rules))
+	limit := make(chan struct{}, s.maxConcurrent)
+	var wg sync.WaitGroup
+	for i := range rules {
+		limit <- struct{}{}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+			states[i] = s.evaluateRule(rules[i], now)
+		}(i)
+	}
+	wg.Wait()
+	return states
+}
+
+func (s *Service) evaluateRule(rule *meta.AlertRuleInfo, now time.Time) *meta.AlertRuleState {
+	interval := s.ruleInterval(rule)
+	if interval < s.base.Interval {
+		interval = s.base.Interval
+	}
+	state := &meta.AlertRuleState{
+		Name:         rule.Name,
+		LeaseExpire:  now.Add(leaseFactor * interval),
+		LastEvalTime: now,
+		Alerts:       rule.Alerts,
+	}
+
+	cond, err := meta.ParseAlertCondition(rule.Condition)
+	if err != nil {
+		state.LastError = err.Error()
+		return state
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.queryTimeout)
+	defer cancel()
+	samples, err := s.Querier.Query(ctx, rule, now)
+	if err != nil {
+		// keep the alerts as they are, a failed query neither raises nor resolves an alert
+		s.logger.Warn("evaluate alert rule failed", zap.String("rule", rule.Name), zap.Error(err))
+		state.LastError = err.Error()
+		return state
+	}
+	state.Alerts = evaluate(rule, cond, samples, now, s.resolvedRetention)
+	return state
+}
+
+// pruneSilences removes the silences which have expired for a while.
+func (s *Service) pruneSilences(silences map[string]*meta.AlertSilenceInfo, now time.Time) {
+	for id, silence := range silences {
+		if now.Sub(silence.EndsAt) < silenceRetention {
+			continue
+		}
+		if err := s.MetaClient.DropAlertSilence(id); err != nil {
+			s.logger.Debug("remove expired alert silence failed", zap.String("id", id), zap.Error(err))
+		}
+	}
+}
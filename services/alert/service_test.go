@@ -0,0 +1,154 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alert
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/stretchr/testify/require"
+)
+
+// mockMetaClient applies the commands to the meta data like ts-meta.
+type mockMetaClient struct {
+	mu   sync.Mutex
+	data *meta.Data
+}
+
+func (c *mockMetaClient) AlertRules() map[string]*meta.AlertRuleInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.data.CloneAlertRules()
+}
+
+func (c *mockMetaClient) AlertReceivers() map[string]*meta.AlertReceiverInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.data.CloneAlertReceivers()
+}
+
+func (c *mockMetaClient) AlertSilences() map[string]*meta.AlertSilenceInfo {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.data.CloneAlertSilences()
+}
+
+func (c *mockMetaClient) ReportAlertStates(host string, now time.Time, states []*meta.AlertRuleState) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.data.ReportAlertStates(host, now.UnixNano(), states)
+	return nil
+}
+
+func (c *mockMetaClient) DropAlertSilence(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.data.DropAlertSilence(id)
+}
+
+type mockQuerier struct {
+	samples map[string][]Sample
+}
+
+func (q *mockQuerier) Query(_ context.Context, rule *meta.AlertRuleInfo, _ time.Time) ([]Sample, error) {
+	samples, ok := q.samples[rule.Name]
+	if !ok {
+		return nil, fmt.Errorf("database not found: %s", rule.Database)
+	}
+	return samples, nil
+}
+
+func newTestService(t *testing.T, hostname string, client *mockMetaClient, querier Querier) *Service {
+	c := config.NewAlertConfig()
+	c.Enabled = true
+	require.NoError(t, c.Validate())
+	s := NewService(hostname, c)
+	s.WithLogger(logger.NewLogger(errno.ModuleUnknown))
+	s.MetaClient = client
+	s.Querier = querier
+	return s
+}
+
+func TestService_Handle(t *testing.T) {
+	recorder := &webhookRecorder{}
+	server := httptest.NewServer(recorder)
+	defer server.Close()
+
+	data := &meta.Data{}
+	require.NoError(t, data.CreateAlertReceiver(&meta.AlertReceiverInfo{Name: "ops", URL: server.URL, RepeatInterval: time.Hour}))
+	require.NoError(t, data.CreateAlertRule(&meta.AlertRuleInfo{Name: "up", Type: meta.AlertTypePromQL, Database: "prom",
+		Query: "up == 0", Receivers: []string{"ops"}}))
+	require.NoError(t, data.CreateAlertRule(&meta.AlertRuleInfo{Name: "broken", Type: meta.AlertTypeInfluxQL, Database: "db0",
+		Query: "SELECT last(v) FROM m", Interval: time.Hour}))
+	require.NoError(t, data.CreateAlertSilence(&meta.AlertSilenceInfo{ID: "expired", Matchers: []meta.AlertMatcher{{Name: "job", Value: "a"}},
+		StartsAt: time.Unix(1, 0), EndsAt: time.Unix(2, 0)}))
+	client := &mockMetaClient{data: data}
+	querier := &mockQuerier{samples: map[string][]Sample{"up": {{Labels: map[string]string{"job": "node"}, Value: 0}}}}
+
+	s1 := newTestService(t, "sql1:8086", client, querier)
+	s1.handle()
+	rules := client.AlertRules()
+	require.Equal(t, "sql1:8086", rules["up"].Owner)
+	require.Len(t, rules["up"].Alerts, 1)
+	require.Equal(t, meta.AlertFiring, rules["up"].Alerts[0].State)
+	require.Equal(t, "database not found: db0", rules["broken"].LastError)
+	require.Empty(t, client.AlertSilences())
+	messages := recorder.take()
+	require.Len(t, messages, 1)
+	require.Equal(t, map[string]string{"alertname": "up", "job": "node"}, messages[0].Alerts[0].Labels)
+	// the notification is saved with the alert right after it is sent
+	rules = client.AlertRules()
+	require.Len(t, rules["up"].Alerts[0].Notifications, 1)
+	require.Equal(t, "ops", rules["up"].Alerts[0].Notifications[0].Receiver)
+
+	// the rules leased to sql1 are neither evaluated nor notified by sql2
+	s2 := newTestService(t, "sql2:8086", client, &mockQuerier{})
+	s2.evaluationInterval = 0
+	s2.handle()
+	rules = client.AlertRules()
+	require.Equal(t, "sql1:8086", rules["up"].Owner)
+	require.Len(t, rules["up"].Alerts, 1)
+	require.Empty(t, recorder.take())
+
+	// sql2 takes over the rules once the lease expires, and keeps the alerts of sql1 with their notifications
+	client.mu.Lock()
+	for _, rule := range client.data.AlertRules {
+		rule.LeaseExpire = time.Now().Add(-time.Second)
+	}
+	client.mu.Unlock()
+	s2.Querier = querier
+	s2.handle()
+	rules = client.AlertRules()
+	require.Equal(t, "sql2:8086", rules["up"].Owner)
+	// the rule is not taken over until it is due
+	require.Equal(t, "sql1:8086", rules["broken"].Owner)
+	require.Len(t, rules["up"].Alerts, 1)
+	// the firing alert notified by sql1 is not notified again before the repeat_interval
+	require.Empty(t, recorder.take())
+}
+
+func TestService_OpenClose(t *testing.T) {
+	s := newTestService(t, "sql1:8086", &mockMetaClient{data: &meta.Data{}}, &mockQuerier{})
+	require.NoError(t, s.Open())
+	require.NoError(t, s.Close())
+}
@@ -0,0 +1,585 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowflight
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	json2 "encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/array"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/flight/flightsql"
+	"github.com/apache/arrow/go/v13/arrow/flight/flightsql/schema_ref"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/influxdata/influxql"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+const (
+	// DatabaseHeader is the grpc metadata key which selects the database of flight sql statements
+	DatabaseHeader = "database"
+	// RetentionPolicyHeader is the grpc metadata key which selects the retention policy of flight sql statements
+	RetentionPolicyHeader = "retention-policy"
+
+	// TableTypeMeasurement is the only table type, every measurement is reported as a table
+	TableTypeMeasurement = "TABLE"
+
+	PreparedStatementTimeOut = 24 * time.Hour
+
+	flightSQLTypeURLPrefix = "type.googleapis.com/arrow.flight.protocol.sql."
+)
+
+// isFlightSQLCommand reports whether the command of a flight descriptor or ticket is a flight sql command.
+// The tickets of the raw DoGet are json objects or bare InfluxQL statements, which are never a valid protobuf Any.
+func isFlightSQLCommand(cmd []byte) bool {
+	var anyCmd anypb.Any
+	if err := proto.Unmarshal(cmd, &anyCmd); err != nil {
+		return false
+	}
+	return strings.HasPrefix(anyCmd.GetTypeUrl(), flightSQLTypeURLPrefix)
+}
+
+type preparedStatement struct {
+	ticket  *QueryTicket
+	created time.Time
+}
+
+// sqlServer implements the flight sql command set on top of the queryServer.
+// Catalogs are mapped to databases, db schemas to retention policies and tables to measurements.
+// Statements are InfluxQL select statements, they run in the database selected by the DatabaseHeader.
+type sqlServer struct {
+	flightsql.BaseServer
+	reader *queryServer
+	client FlightMetaClient
+	mem    memory.Allocator
+
+	mu       sync.Mutex
+	prepared map[string]*preparedStatement
+}
+
+func newSQLServer(reader *queryServer) *sqlServer {
+	s := &sqlServer{
+		reader:   reader,
+		mem:      reader.mem,
+		prepared: make(map[string]*preparedStatement),
+	}
+	s.Alloc = s.mem
+	_ = s.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerName, "openGemini")
+	_ = s.RegisterSqlInfo(flightsql.SqlInfoFlightSqlServerReadOnly, true)
+	return s
+}
+
+func (s *sqlServer) SetMetaClient(client FlightMetaClient) {
+	s.client = client
+}
+
+// queryTicket builds the ticket of a statement, the database and retention policy are taken from the request headers.
+func (s *sqlServer) queryTicket(ctx context.Context, sql string) *QueryTicket {
+	qt := &QueryTicket{Query: sql}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(DatabaseHeader); len(vals) > 0 {
+			qt.DataBase = vals[0]
+		}
+		if vals := md.Get(RetentionPolicyHeader); len(vals) > 0 {
+			qt.RetentionPolicy = vals[0]
+		}
+	}
+	return qt
+}
+
+func (s *sqlServer) GetFlightInfoStatement(ctx context.Context, cmd flightsql.StatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	qt := s.queryTicket(ctx, cmd.GetQuery())
+	if _, _, err := s.reader.parseQuery(ctx, qt); err != nil {
+		return nil, err
+	}
+	handle, err := json2.Marshal(qt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	ticket, err := flightsql.CreateStatementQueryTicket(handle)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return s.flightInfo(desc, ticket, nil), nil
+}
+
+func (s *sqlServer) DoGetStatement(ctx context.Context, ticket flightsql.StatementQueryTicket) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	qt, err := ParseQueryTicket(ticket.GetStatementHandle())
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.doGetQuery(ctx, qt)
+}
+
+func (s *sqlServer) CreatePreparedStatement(ctx context.Context, req flightsql.ActionCreatePreparedStatementRequest) (flightsql.ActionCreatePreparedStatementResult, error) {
+	qt := s.queryTicket(ctx, req.GetQuery())
+	if _, _, err := s.reader.parseQuery(ctx, qt); err != nil {
+		return flightsql.ActionCreatePreparedStatementResult{}, err
+	}
+
+	handle := make([]byte, 16)
+	if _, err := rand.Read(handle); err != nil {
+		return flightsql.ActionCreatePreparedStatementResult{}, status.Error(codes.Internal, err.Error())
+	}
+	now := time.Now()
+	s.mu.Lock()
+	// the prepared statements which are never closed by the client are dropped after a while
+	for k, stmt := range s.prepared {
+		if now.Sub(stmt.created) > PreparedStatementTimeOut {
+			delete(s.prepared, k)
+		}
+	}
+	s.prepared[hex.EncodeToString(handle)] = &preparedStatement{ticket: qt, created: now}
+	s.mu.Unlock()
+	return flightsql.ActionCreatePreparedStatementResult{Handle: handle}, nil
+}
+
+func (s *sqlServer) ClosePreparedStatement(_ context.Context, req flightsql.ActionClosePreparedStatementRequest) error {
+	s.mu.Lock()
+	delete(s.prepared, hex.EncodeToString(req.GetPreparedStatementHandle()))
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *sqlServer) preparedStatement(handle []byte) (*QueryTicket, error) {
+	s.mu.Lock()
+	stmt, ok := s.prepared[hex.EncodeToString(handle)]
+	s.mu.Unlock()
+	if !ok {
+		return nil, status.Error(codes.NotFound, "prepared statement not found")
+	}
+	return stmt.ticket, nil
+}
+
+func (s *sqlServer) GetFlightInfoPreparedStatement(ctx context.Context, cmd flightsql.PreparedStatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	qt, err := s.preparedStatement(cmd.GetPreparedStatementHandle())
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err = s.reader.parseQuery(ctx, qt); err != nil {
+		return nil, err
+	}
+	// the command itself is the ticket of DoGetPreparedStatement
+	return s.flightInfo(desc, desc.Cmd, nil), nil
+}
+
+func (s *sqlServer) DoGetPreparedStatement(ctx context.Context, cmd flightsql.PreparedStatementQuery) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	qt, err := s.preparedStatement(cmd.GetPreparedStatementHandle())
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.doGetQuery(ctx, qt)
+}
+
+// doGetQuery runs the statement of the ticket. The schema of the stream is built from the first series with values,
+// and every series is sent as one record batch.
+func (s *sqlServer) doGetQuery(ctx context.Context, qt *QueryTicket) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	stmts, authorizer, err := s.reader.parseQuery(ctx, qt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if s.reader.executor == nil {
+		return nil, nil, status.Error(codes.Unavailable, "query executor is not ready")
+	}
+
+	handler := statistics.NewHandler()
+	handler.QueryRequests.Incr()
+	handler.ActiveQueryRequests.Incr()
+	start := time.Now()
+	results, cancel := s.reader.execute(ctx, qt, stmts, authorizer)
+	finish := func() {
+		cancel()
+		// drain the remaining results so the executor can exit
+		for range results {
+		}
+		handler.ActiveQueryRequests.Decr()
+		handler.QueryRequestDuration.AddSinceNano(start)
+	}
+
+	var first *query.Result
+	for r := range results {
+		if r == nil {
+			continue
+		}
+		if r.Err != nil {
+			finish()
+			return nil, nil, status.Error(codes.Internal, r.Err.Error())
+		}
+		if hasValues(r) {
+			first = r
+			break
+		}
+	}
+	ch := make(chan flight.StreamChunk)
+	if first == nil {
+		finish()
+		close(ch)
+		return arrow.NewSchema(nil, nil), ch, nil
+	}
+
+	b := executor.NewRowRecordBuilder(s.mem)
+	b.SetColumnTypes(first.ColumnTypes)
+	for _, row := range first.Series {
+		if len(row.Values) > 0 {
+			if err = b.Init(row); err != nil {
+				finish()
+				return nil, nil, status.Error(codes.Unimplemented, err.Error())
+			}
+			break
+		}
+	}
+
+	go func() {
+		defer finish()
+		defer close(ch)
+		defer b.Release()
+
+		send := func(chunk flight.StreamChunk) bool {
+			select {
+			case ch <- chunk:
+				return true
+			case <-ctx.Done():
+				if chunk.Data != nil {
+					chunk.Data.Release()
+				}
+				return false
+			}
+		}
+		sendResult := func(r *query.Result) error {
+			for _, row := range r.Series {
+				if err := b.Append(row); err != nil {
+					return status.Error(codes.Unimplemented, err.Error())
+				}
+				if rec := b.NewRecord(); rec != nil && !send(flight.StreamChunk{Data: rec}) {
+					return ctx.Err()
+				}
+			}
+			return nil
+		}
+
+		err := sendResult(first)
+		for r := range results {
+			if err != nil || r == nil {
+				continue
+			}
+			if r.Err != nil {
+				err = status.Error(codes.Internal, r.Err.Error())
+				break
+			}
+			err = sendResult(r)
+		}
+		if err != nil {
+			s.reader.logger.Error("arrow flight sql stream result failed", zap.Error(err))
+			send(flight.StreamChunk{Err: err})
+		}
+	}()
+	return b.Schema(), ch, nil
+}
+
+func hasValues(r *query.Result) bool {
+	for _, row := range r.Series {
+		if len(row.Values) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *sqlServer) flightInfo(desc *flight.FlightDescriptor, ticket []byte, schema *arrow.Schema) *flight.FlightInfo {
+	info := &flight.FlightInfo{
+		FlightDescriptor: desc,
+		Endpoint:         []*flight.FlightEndpoint{{Ticket: &flight.Ticket{Ticket: ticket}}},
+		TotalRecords:     -1,
+		TotalBytes:       -1,
+	}
+	if schema != nil {
+		info.Schema = flight.SerializeSchema(schema, s.mem)
+	}
+	return info
+}
+
+// readableDatabases returns the databases which can be read by the user of the request, sorted by name.
+func (s *sqlServer) readableDatabases(ctx context.Context) ([]*meta.DatabaseInfo, error) {
+	if s.client == nil {
+		return nil, status.Error(codes.Unavailable, "meta client is not ready")
+	}
+	var user meta.User
+	if auth := s.reader.authHandler; auth != nil && auth.authEnabled {
+		u, err := auth.UserFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !u.AuthorizeUnrestricted() {
+			user = u
+		}
+	}
+
+	dbs := s.client.Databases()
+	ret := make([]*meta.DatabaseInfo, 0, len(dbs))
+	for name, db := range dbs {
+		if db == nil || db.MarkDeleted {
+			continue
+		}
+		if user != nil && !user.AuthorizeDatabase(influxql.ReadPrivilege, name) {
+			continue
+		}
+		ret = append(ret, db)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret, nil
+}
+
+// sortedRetentionPolicies returns the retention policies of the database matching the pattern, sorted by name.
+func sortedRetentionPolicies(db *meta.DatabaseInfo, pattern *regexp.Regexp) []*meta.RetentionPolicyInfo {
+	ret := make([]*meta.RetentionPolicyInfo, 0, len(db.RetentionPolicies))
+	for name, rp := range db.RetentionPolicies {
+		if rp == nil || rp.MarkDeleted || !pattern.MatchString(name) {
+			continue
+		}
+		ret = append(ret, rp)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+// likePattern compiles the sql LIKE pattern of a flight sql filter, a nil pattern matches everything.
+func likePattern(pattern *string) (*regexp.Regexp, error) {
+	if pattern == nil {
+		return regexp.MustCompile(".*"), nil
+	}
+	var buf strings.Builder
+	buf.WriteString("^")
+	escaped := false
+	for _, c := range *pattern {
+		switch {
+		case escaped:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+			escaped = false
+		case c == '\\':
+			escaped = true
+		case c == '%':
+			buf.WriteString(".*")
+		case c == '_':
+			buf.WriteString(".")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	buf.WriteString("$")
+	re, err := regexp.Compile(buf.String())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid filter pattern %q: %s", *pattern, err.Error()))
+	}
+	return re, nil
+}
+
+// singleRecord streams one record which is built by fn.
+func (s *sqlServer) singleRecord(schema *arrow.Schema, fn func(b *array.RecordBuilder) error) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	b := array.NewRecordBuilder(s.mem, schema)
+	defer b.Release()
+	if err := fn(b); err != nil {
+		return nil, nil, err
+	}
+	ch := make(chan flight.StreamChunk, 1)
+	ch <- flight.StreamChunk{Data: b.NewRecord()}
+	close(ch)
+	return schema, ch, nil
+}
+
+func (s *sqlServer) GetFlightInfoCatalogs(_ context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	return s.flightInfo(desc, desc.Cmd, schema_ref.Catalogs), nil
+}
+
+func (s *sqlServer) DoGetCatalogs(ctx context.Context) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	dbs, err := s.readableDatabases(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.singleRecord(schema_ref.Catalogs, func(b *array.RecordBuilder) error {
+		for _, db := range dbs {
+			b.Field(0).(*array.StringBuilder).Append(db.Name)
+		}
+		return nil
+	})
+}
+
+func (s *sqlServer) GetFlightInfoSchemas(_ context.Context, _ flightsql.GetDBSchemas, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	return s.flightInfo(desc, desc.Cmd, schema_ref.DBSchemas), nil
+}
+
+func (s *sqlServer) DoGetDBSchemas(ctx context.Context, cmd flightsql.GetDBSchemas) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	pattern, err := likePattern(cmd.GetDBSchemaFilterPattern())
+	if err != nil {
+		return nil, nil, err
+	}
+	dbs, err := s.readableDatabases(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.singleRecord(schema_ref.DBSchemas, func(b *array.RecordBuilder) error {
+		for _, db := range dbs {
+			if catalog := cmd.GetCatalog(); catalog != nil && *catalog != db.Name {
+				continue
+			}
+			for _, rp := range sortedRetentionPolicies(db, pattern) {
+				b.Field(0).(*array.StringBuilder).Append(db.Name)
+				b.Field(1).(*array.StringBuilder).Append(rp.Name)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *sqlServer) GetFlightInfoTables(_ context.Context, cmd flightsql.GetTables, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	if cmd.GetIncludeSchema() {
+		return s.flightInfo(desc, desc.Cmd, schema_ref.TablesWithIncludedSchema), nil
+	}
+	return s.flightInfo(desc, desc.Cmd, schema_ref.Tables), nil
+}
+
+func (s *sqlServer) DoGetTables(ctx context.Context, cmd flightsql.GetTables) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	rpPattern, err := likePattern(cmd.GetDBSchemaFilterPattern())
+	if err != nil {
+		return nil, nil, err
+	}
+	mstPattern, err := likePattern(cmd.GetTableNameFilterPattern())
+	if err != nil {
+		return nil, nil, err
+	}
+	dbs, err := s.readableDatabases(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schema := schema_ref.Tables
+	if cmd.GetIncludeSchema() {
+		schema = schema_ref.TablesWithIncludedSchema
+	}
+	if types := cmd.GetTableTypes(); len(types) > 0 && !contains(types, TableTypeMeasurement) {
+		dbs = nil
+	}
+	return s.singleRecord(schema, func(b *array.RecordBuilder) error {
+		for _, db := range dbs {
+			if catalog := cmd.GetCatalog(); catalog != nil && *catalog != db.Name {
+				continue
+			}
+			for _, rp := range sortedRetentionPolicies(db, rpPattern) {
+				for _, mst := range sortedMeasurements(rp, mstPattern) {
+					b.Field(0).(*array.StringBuilder).Append(db.Name)
+					b.Field(1).(*array.StringBuilder).Append(rp.Name)
+					b.Field(2).(*array.StringBuilder).Append(mst.OriginName())
+					b.Field(3).(*array.StringBuilder).Append(TableTypeMeasurement)
+					if cmd.GetIncludeSchema() {
+						b.Field(4).(*array.BinaryBuilder).Append(flight.SerializeSchema(measurementSchema(mst), s.mem))
+					}
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func (s *sqlServer) GetFlightInfoTableTypes(_ context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	return s.flightInfo(desc, desc.Cmd, schema_ref.TableTypes), nil
+}
+
+func (s *sqlServer) DoGetTableTypes(context.Context) (*arrow.Schema, <-chan flight.StreamChunk, error) {
+	return s.singleRecord(schema_ref.TableTypes, func(b *array.RecordBuilder) error {
+		b.Field(0).(*array.StringBuilder).Append(TableTypeMeasurement)
+		return nil
+	})
+}
+
+func contains(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedMeasurements returns the measurements of the retention policy matching the pattern, sorted by name.
+func sortedMeasurements(rp *meta.RetentionPolicyInfo, pattern *regexp.Regexp) []*meta.MeasurementInfo {
+	ret := make([]*meta.MeasurementInfo, 0, len(rp.Measurements))
+	for _, mst := range rp.Measurements {
+		if mst == nil || mst.MarkDeleted || !pattern.MatchString(mst.OriginName()) {
+			continue
+		}
+		ret = append(ret, mst)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].OriginName() < ret[j].OriginName()
+	})
+	return ret
+}
+
+// measurementSchema returns the arrow schema of a measurement in the same layout as the query results:
+// the time column, the tags, and then the fields.
+func measurementSchema(mst *meta.MeasurementInfo) *arrow.Schema {
+	var tags, fields []string
+	types := make(map[string]int32)
+	if mst.Schema != nil {
+		mst.Schema.RangeTypCall(func(name string, typ int32) {
+			if typ == influx.Field_Type_Tag {
+				tags = append(tags, name)
+				return
+			}
+			fields = append(fields, name)
+			types[name] = typ
+		})
+	}
+	sort.Strings(tags)
+	sort.Strings(fields)
+
+	arrowFields := make([]arrow.Field, 0, len(tags)+len(fields)+1)
+	arrowFields = append(arrowFields, arrow.Field{Name: TimeColumn, Type: arrow.FixedWidthTypes.Timestamp_ns, Nullable: true})
+	for _, tag := range tags {
+		arrowFields = append(arrowFields, arrow.Field{Name: tag, Type: arrow.BinaryTypes.String, Nullable: true})
+	}
+	for _, field := range fields {
+		dType, err := executor.ArrowDataType(record.ToInfluxqlTypes(int(types[field])))
+		if err != nil {
+			continue
+		}
+		arrowFields = append(arrowFields, arrow.Field{Name: field, Type: dType, Nullable: true})
+	}
+	md := arrow.NewMetadata([]string{MeasurementMetaKey}, []string{mst.OriginName()})
+	return arrow.NewSchema(arrowFields, &md)
+}
@@ -0,0 +1,289 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package arrowflight
+
+import (
+	"bytes"
+	"context"
+	json2 "encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/apache/arrow/go/v13/arrow"
+	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/ipc"
+	"github.com/apache/arrow/go/v13/arrow/memory"
+	"github.com/influxdata/influxdb/models"
+	"github.com/openGemini/openGemini/engine/executor"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
+	"github.com/openGemini/openGemini/lib/util"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultQueryChunkSize      = 10000
+	DefaultQueryInnerChunkSize = 1024
+
+	// TimeColumn is the name of the time column of every record returned by DoGet
+	TimeColumn = executor.RowTimeColumn
+	// MeasurementMetaKey is the schema metadata key holding the measurement name of the result
+	MeasurementMetaKey = executor.RowMeasurementMetaKey
+)
+
+type QueryExecutor interface {
+	ExecuteQuery(query *influxql.Query, opt query.ExecutionOptions, closing chan struct{}, qDuration *statistics.SQLSlowQueryStatistics) <-chan *query.Result
+}
+
+// QueryTicket is carried by the flight ticket of DoGet and by the command of the flight descriptor of GetFlightInfo.
+// A ticket which is not a json object is treated as a bare InfluxQL statement.
+type QueryTicket struct {
+	DataBase        string `json:"db"`
+	RetentionPolicy string `json:"rp"`
+	Query           string `json:"sql"`
+	ChunkSize       int    `json:"chunk_size,omitempty"`
+}
+
+func ParseQueryTicket(ticket []byte) (*QueryTicket, error) {
+	ticket = bytes.TrimSpace(ticket)
+	if len(ticket) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty query ticket")
+	}
+	qt := &QueryTicket{}
+	if ticket[0] != '{' {
+		qt.Query = string(ticket)
+		return qt, nil
+	}
+	if err := json2.Unmarshal(ticket, qt); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid query ticket: %s", err.Error()))
+	}
+	if qt.Query == "" {
+		return nil, status.Error(codes.InvalidArgument, "query is required")
+	}
+	return qt, nil
+}
+
+// queryServer runs the InfluxQL statement of a ticket through the query executor,
+// and streams the result back as arrow record batches.
+type queryServer struct {
+	executor    QueryExecutor
+	authHandler *authServer
+	mem         memory.Allocator
+	logger      *logger.Logger
+}
+
+func NewQueryServer(logger *logger.Logger) *queryServer {
+	return &queryServer{
+		mem:    memory.NewGoAllocator(),
+		logger: logger,
+	}
+}
+
+func (q *queryServer) SetExecutor(executor QueryExecutor) {
+	q.executor = executor
+}
+
+func (q *queryServer) SetAuthHandler(authHandler *authServer) {
+	q.authHandler = authHandler
+}
+
+// parseQuery parses the statement of the ticket and checks that the user of the request is allowed to run it.
+func (q *queryServer) parseQuery(ctx context.Context, qt *QueryTicket) (*influxql.Query, query.FineAuthorizer, error) {
+	p := influxql.NewParser(strings.NewReader(qt.Query))
+	defer p.Release()
+	yyParser := influxql.NewYyParser(p.GetScanner(), p.GetPara())
+	yyParser.ParseTokens()
+	stmts, err := yyParser.GetQuery()
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, fmt.Sprintf("error parsing query: %s", err.Error()))
+	}
+	if len(stmts.Statements) != 1 {
+		return nil, nil, status.Error(codes.InvalidArgument, "only one statement is supported per ticket")
+	}
+	if _, ok := stmts.Statements[0].(*influxql.SelectStatement); !ok {
+		return nil, nil, status.Error(codes.InvalidArgument, "only select statement is supported")
+	}
+
+	if q.authHandler == nil || !q.authHandler.authEnabled {
+		return stmts, query.OpenAuthorizer, nil
+	}
+	user, err := q.authHandler.UserFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err = user.AuthorizeQuery(qt.DataBase, stmts); err != nil {
+		return nil, nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	if user.AuthorizeUnrestricted() {
+		return stmts, query.OpenAuthorizer, nil
+	}
+	return stmts, user, nil
+}
+
+func (q *queryServer) GetFlightInfo(ctx context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	if desc.GetType() != flight.DescriptorCMD {
+		return nil, status.Error(codes.InvalidArgument, "flight descriptor must be a command carrying the query")
+	}
+	qt, err := ParseQueryTicket(desc.Cmd)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err = q.parseQuery(ctx, qt); err != nil {
+		return nil, err
+	}
+
+	// the result is produced by the ts-sql node which receives DoGet, so the ticket is served right here.
+	return &flight.FlightInfo{
+		FlightDescriptor: desc,
+		Endpoint:         []*flight.FlightEndpoint{{Ticket: &flight.Ticket{Ticket: desc.Cmd}}},
+		TotalRecords:     -1,
+		TotalBytes:       -1,
+	}, nil
+}
+
+// execute runs the statements of the ticket, the query is aborted once the returned cancel is called or ctx is done.
+func (q *queryServer) execute(ctx context.Context, qt *QueryTicket, stmts *influxql.Query, authorizer query.FineAuthorizer) (<-chan *query.Result, func()) {
+	chunkSize := qt.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultQueryChunkSize
+	}
+	closing := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+		close(closing)
+	}()
+
+	opts := query.ExecutionOptions{
+		Database:        qt.DataBase,
+		RetentionPolicy: qt.RetentionPolicy,
+		ChunkSize:       chunkSize,
+		Chunked:         true,
+		ReadOnly:        true,
+		InnerChunkSize:  DefaultQueryInnerChunkSize,
+		Quiet:           true,
+		Authorizer:      authorizer,
+		AbortCh:         closing,
+	}
+
+	q.logger.Info("arrow flight query starting", zap.String("db", qt.DataBase), zap.String("rp", qt.RetentionPolicy), zap.String("query", qt.Query))
+	return q.executor.ExecuteQuery(stmts, opts, closing, nil), func() { close(done) }
+}
+
+func (q *queryServer) DoGet(ticket *flight.Ticket, server flight.FlightService_DoGetServer) error {
+	handler := statistics.NewHandler()
+	handler.QueryRequests.Incr()
+	handler.ActiveQueryRequests.Incr()
+	defer func(start time.Time) {
+		handler.ActiveQueryRequests.Decr()
+		handler.QueryRequestDuration.AddSinceNano(start)
+	}(time.Now())
+
+	qt, err := ParseQueryTicket(ticket.GetTicket())
+	if err != nil {
+		return err
+	}
+	stmts, authorizer, err := q.parseQuery(server.Context(), qt)
+	if err != nil {
+		return err
+	}
+	if q.executor == nil {
+		return status.Error(codes.Unavailable, "query executor is not ready")
+	}
+
+	results, cancel := q.execute(server.Context(), qt, stmts, authorizer)
+	defer cancel()
+
+	rw := newResultWriter(server, q.mem)
+	defer rw.Close()
+	for r := range results {
+		if r == nil {
+			continue
+		}
+		if err == nil && r.Err != nil {
+			err = status.Error(codes.Internal, r.Err.Error())
+		}
+		if err != nil {
+			// drain the remaining results so the executor can exit
+			continue
+		}
+		rw.SetColumnTypes(r.ColumnTypes)
+		for _, row := range r.Series {
+			if err = rw.Write(row); err != nil {
+				q.logger.Error("arrow flight DoGet write result failed", zap.Error(err))
+				break
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return rw.Flush()
+}
+
+// resultWriter streams every series of a query result to the DoGet stream as one record batch.
+type resultWriter struct {
+	*executor.RowRecordBuilder
+	mem    memory.Allocator
+	server flight.FlightService_DoGetServer
+	writer *flight.Writer
+}
+
+func newResultWriter(server flight.FlightService_DoGetServer, mem memory.Allocator) *resultWriter {
+	return &resultWriter{RowRecordBuilder: executor.NewRowRecordBuilder(mem), mem: mem, server: server}
+}
+
+func (w *resultWriter) Write(row *models.Row) error {
+	if len(row.Values) == 0 {
+		return nil
+	}
+	if err := w.Append(row); err != nil {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return w.Flush()
+}
+
+// Flush sends the buffered rows as one record batch.
+// An empty result is still answered with a schema without any field, so the client reader can be created.
+func (w *resultWriter) Flush() error {
+	if w.writer == nil {
+		schema := w.Schema()
+		if schema == nil {
+			schema = arrow.NewSchema(nil, nil)
+		}
+		w.writer = flight.NewRecordWriter(w.server, ipc.WithSchema(schema), ipc.WithAllocator(w.mem))
+	}
+	rec := w.NewRecord()
+	if rec == nil {
+		return nil
+	}
+	defer rec.Release()
+	return w.writer.Write(rec)
+}
+
+func (w *resultWriter) Close() {
+	if w.writer != nil {
+		util.MustClose(w.writer)
+	}
+	w.Release()
+}
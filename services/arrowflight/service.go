@@ -15,6 +15,7 @@
 package arrowflight
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/sha256"
 	json2 "encoding/json"
@@ -27,6 +28,7 @@ import (
 
 	"github.com/apache/arrow/go/v13/arrow"
 	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/flight/flightsql"
 	"github.com/apache/arrow/go/v13/arrow/ipc"
 	"github.com/apache/arrow/go/v13/arrow/memory"
 	"github.com/influxdata/influxql"
@@ -40,6 +42,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -47,6 +50,9 @@ const (
 	WriteAuthSuccess      string = "ArrowFlightWriteSuccessfully"
 	WriteAuthTokenSalty   int64  = 1e9
 	WriteAuthTokenTimeOut        = 24 * time.Hour
+
+	// AuthTokenHeader is the grpc metadata key which carries the token returned by the handshake
+	AuthTokenHeader = "auth-token-bin"
 )
 
 type RecordWriter interface {
@@ -55,6 +61,7 @@ type RecordWriter interface {
 
 type FlightMetaClient interface {
 	Database(name string) (*meta.DatabaseInfo, error)
+	Databases() map[string]*meta.DatabaseInfo
 	Authenticate(username, password string) (ui meta.User, err error)
 	User(username string) (meta.User, error)
 	AdminUserExists() bool
@@ -69,6 +76,8 @@ type FlightMetaClient interface {
 type Service struct {
 	server           flight.Server
 	writer           *writeServer
+	reader           *queryServer
+	sqlServer        *sqlServer
 	authHandler      *authServer
 	Config           *config.Config
 	Logger           *logger.Logger
@@ -80,11 +89,49 @@ type Service struct {
 	RecordWriter interface {
 		RetryWriteRecord(database, retentionPolicy, measurement string, rec arrow.Record) error
 	}
+
+	QueryExecutor QueryExecutor
+}
+
+// flightServer serves DoPut by the writeServer, and GetFlightInfo/DoGet by the queryServer.
+// The flight sql commands are served by the sql server, including the prepared statement actions.
+type flightServer struct {
+	*writeServer
+	reader *queryServer
+	sql    flight.FlightServer
+}
+
+func (f *flightServer) GetFlightInfo(ctx context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	if isFlightSQLCommand(desc.GetCmd()) {
+		return f.sql.GetFlightInfo(ctx, desc)
+	}
+	return f.reader.GetFlightInfo(ctx, desc)
+}
+
+func (f *flightServer) GetSchema(ctx context.Context, desc *flight.FlightDescriptor) (*flight.SchemaResult, error) {
+	return f.sql.GetSchema(ctx, desc)
+}
+
+func (f *flightServer) DoGet(ticket *flight.Ticket, server flight.FlightService_DoGetServer) error {
+	if isFlightSQLCommand(ticket.GetTicket()) {
+		return f.sql.DoGet(ticket, server)
+	}
+	return f.reader.DoGet(ticket, server)
+}
+
+func (f *flightServer) DoAction(action *flight.Action, server flight.FlightService_DoActionServer) error {
+	return f.sql.DoAction(action, server)
+}
+
+func (f *flightServer) ListActions(empty *flight.Empty, server flight.FlightService_ListActionsServer) error {
+	return f.sql.ListActions(empty, server)
 }
 
 func NewService(c config.Config) (*Service, error) {
 	sLogger := logger.NewLogger(errno.ModuleHTTP)
 	writer := NewWriteServer(sLogger)
+	reader := NewQueryServer(sLogger)
+	sqlServer := newSQLServer(reader)
 	authHandler := NewAuthServer(c.FlightAuthEnabled)
 	var maxRecvMsgSize int
 	if c.MaxBodySize <= 0 {
@@ -95,7 +142,12 @@ func NewService(c config.Config) (*Service, error) {
 
 	server := flight.NewServerWithMiddleware(nil, grpc.MaxRecvMsgSize(maxRecvMsgSize))
 	writer.SetAuthHandler(authHandler)
-	server.RegisterFlightService(writer)
+	reader.SetAuthHandler(authHandler)
+	server.RegisterFlightService(&flightServer{
+		writeServer: writer,
+		reader:      reader,
+		sql:         flightsql.NewFlightServerWithAllocator(sqlServer, reader.mem),
+	})
 	if err := server.Init(c.FlightAddress); err != nil {
 		sLogger.Error("arrow flight service start failed", zap.Error(err))
 		return nil, err
@@ -104,6 +156,8 @@ func NewService(c config.Config) (*Service, error) {
 	return &Service{
 		server:      server,
 		writer:      writer,
+		reader:      reader,
+		sqlServer:   sqlServer,
 		authHandler: authHandler,
 		err:         make(chan error),
 		Logger:      sLogger,
@@ -120,6 +174,8 @@ func (s *Service) Open() error {
 	}()
 	s.authHandler.SetMetaClient(s.MetaClient)
 	s.writer.SetWriter(s.RecordWriter)
+	s.reader.SetExecutor(s.QueryExecutor)
+	s.sqlServer.SetMetaClient(s.MetaClient)
 	return nil
 }
 
@@ -194,7 +250,8 @@ func (a *authServer) Authenticate(c flight.AuthConn) error {
 		return status.Error(codes.FailedPrecondition, "error reading auth handshake")
 	}
 
-	// auth whether user has permission to write to the database.
+	// auth whether user has permission to write to or read from the database.
+	// the privilege required by each request is checked again by DoPut and DoGet.
 	authInfo := &AuthInfo{}
 	err = json2.Unmarshal(in, authInfo)
 	if err != nil {
@@ -202,8 +259,9 @@ func (a *authServer) Authenticate(c flight.AuthConn) error {
 	}
 	username, database := authInfo.UserName, authInfo.DataBase
 	u, err := a.client.User(username)
-	if err != nil || u == nil || !u.AuthorizeDatabase(influxql.WritePrivilege, database) {
-		return status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to write to %s", username, database))
+	if err != nil || u == nil ||
+		!(u.AuthorizeDatabase(influxql.WritePrivilege, database) || u.AuthorizeDatabase(influxql.ReadPrivilege, database)) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to read from or write to %s", username, database))
 	}
 
 	// send auth token back
@@ -241,6 +299,27 @@ func (a *authServer) IsValid(authHashID string) (interface{}, error) {
 	return WriteAuthSuccess, nil
 }
 
+// UserFromContext returns the user owning the auth token of the request.
+func (a *authServer) UserFromContext(ctx context.Context) (meta.User, error) {
+	var authHashID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get(AuthTokenHeader); len(vals) > 0 {
+			authHashID = vals[0]
+		}
+	}
+	a.mu.RLock()
+	token, ok := a.token[authHashID]
+	a.mu.RUnlock()
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "invalid auth token")
+	}
+	u, err := a.client.User(token.Username)
+	if err != nil || u == nil {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("user %s not found", token.Username))
+	}
+	return u, nil
+}
+
 func (a *authServer) Close() {
 	a.token = nil
 	a.client = nil
@@ -288,12 +367,27 @@ func (w *writeServer) DoPut(server flight.FlightService_DoPutServer) error {
 		wr.Release()
 	}(time.Now())
 
-	err = json2.Unmarshal(util.Str2bytes(wr.LatestFlightDescriptor().Path[0]), metaData)
+	// the flight sql commands, such as updates and parameter binding, are not supported by DoPut
+	desc := wr.LatestFlightDescriptor()
+	if desc == nil || len(desc.Path) == 0 {
+		return status.Error(codes.InvalidArgument, "flight descriptor must be a path carrying the db/rp/mst")
+	}
+	err = json2.Unmarshal(util.Str2bytes(desc.Path[0]), metaData)
 	if err != nil {
 		w.logger.Error("arrow flight DoPut get metadata err", zap.Error(err))
 		return err
 	}
 
+	if auth, ok := w.GetAuthHandler().(*authServer); ok && auth.authEnabled {
+		u, err := auth.UserFromContext(server.Context())
+		if err != nil {
+			return err
+		}
+		if !u.AuthorizeDatabase(influxql.WritePrivilege, metaData.DataBase) {
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to write to %s", u.ID(), metaData.DataBase))
+		}
+	}
+
 	w.logger.Info("arrow flight DoPut starting", zap.String("db", metaData.DataBase), zap.String("rp", metaData.RetentionPolicy), zap.String("mst", metaData.Measurement))
 	for wr.Next() {
 		r := wr.Record()
@@ -20,26 +20,34 @@ import (
 	"fmt"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/apache/arrow/go/v13/arrow"
 	"github.com/apache/arrow/go/v13/arrow/array"
 	"github.com/apache/arrow/go/v13/arrow/flight"
+	"github.com/apache/arrow/go/v13/arrow/flight/flightsql"
 	"github.com/apache/arrow/go/v13/arrow/ipc"
 	"github.com/apache/arrow/go/v13/arrow/memory"
 	"github.com/influxdata/influxdb/models"
 	"github.com/influxdata/influxql"
+	config2 "github.com/openGemini/openGemini/lib/config"
 	"github.com/openGemini/openGemini/lib/errno"
 	"github.com/openGemini/openGemini/lib/logger"
 	"github.com/openGemini/openGemini/lib/record"
+	"github.com/openGemini/openGemini/lib/statisticsPusher/statistics"
 	"github.com/openGemini/openGemini/lib/util"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/httpd/config"
+	influxql2 "github.com/openGemini/openGemini/lib/util/lifted/influx/influxql"
 	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/query"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
 	"github.com/openGemini/openGemini/services"
 	"github.com/openGemini/openGemini/services/arrowflight"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -78,6 +86,9 @@ func NewMockFlightMetaClient() *MockFlightMetaClient {
 			"xiaoming": &meta.UserInfo{
 				Admin:      true,
 				Privileges: map[string]influxql.Privilege{"db0": influxql.AllPrivileges}},
+			"xiaohong": &meta.UserInfo{
+				Name:       "xiaohong",
+				Privileges: map[string]influxql.Privilege{"db0": influxql.ReadPrivilege}},
 		},
 	}
 
@@ -87,6 +98,26 @@ func (c *MockFlightMetaClient) Database(_ string) (*meta.DatabaseInfo, error) {
 	return nil, nil
 }
 
+func (c *MockFlightMetaClient) Databases() map[string]*meta.DatabaseInfo {
+	mst := meta.NewMeasurementInfo("mst1_0000", "mst1", config2.TSSTORE, 1)
+	mst.Schema = &meta.CleanSchema{
+		"host":   {Typ: influx.Field_Type_Tag},
+		"value":  {Typ: influx.Field_Type_Float},
+		"status": {Typ: influx.Field_Type_String},
+	}
+	databases := make(map[string]*meta.DatabaseInfo)
+	for _, name := range []string{"db0", "db1"} {
+		rp := meta.NewRetentionPolicyInfo("autogen")
+		rp.Measurements = map[string]*meta.MeasurementInfo{mst.Name: mst}
+		databases[name] = &meta.DatabaseInfo{
+			Name:                   name,
+			DefaultRetentionPolicy: rp.Name,
+			RetentionPolicies:      map[string]*meta.RetentionPolicyInfo{rp.Name: rp},
+		}
+	}
+	return databases
+}
+
 func (c *MockFlightMetaClient) Authenticate(_, _ string) (ui meta.User, err error) {
 	return nil, nil
 }
@@ -319,7 +350,7 @@ func TestArrowFlightServiceErr(t *testing.T) {
 
 	auth.SetMetaClient(NewMockFlightMetaClient())
 	err = auth.Authenticate(NewMockAuthConn(nil, true))
-	assert.Equal(t, err, status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to write to %s", "11", "22")))
+	assert.Equal(t, err, status.Error(codes.PermissionDenied, fmt.Sprintf("%s not authorized to read from or write to %s", "11", "22")))
 
 	_, err = auth.IsValid("token")
 	assert.Equal(t, err, status.Error(codes.PermissionDenied, "invalid auth token"))
@@ -332,3 +363,283 @@ func TestArrowFlightServiceErr(t *testing.T) {
 	err = writer.DoPut(NewDoPutServer())
 	assert.Equal(t, err == io.EOF, true)
 }
+
+type MockQueryExecutor struct {
+	results []*query.Result
+}
+
+func (e *MockQueryExecutor) ExecuteQuery(_ *influxql2.Query, _ query.ExecutionOptions, _ chan struct{}, _ *statistics.SQLSlowQueryStatistics) <-chan *query.Result {
+	ch := make(chan *query.Result, len(e.results))
+	for _, r := range e.results {
+		ch <- r
+	}
+	close(ch)
+	return ch
+}
+
+func NewMockQueryExecutor() *MockQueryExecutor {
+	// the status column of the first series has no value, its type is taken from the column types of the result
+	return &MockQueryExecutor{results: []*query.Result{{
+		ColumnTypes: []influxql2.DataType{influxql2.Time, influxql2.Float, influxql2.String},
+		Series: models.Rows{
+			{
+				Name:    "mst1",
+				Tags:    map[string]string{"host": "h1"},
+				Columns: []string{"time", "value", "status"},
+				Values: [][]interface{}{
+					{time.Unix(0, 1), 1.5, nil},
+					{time.Unix(0, 2), nil, nil},
+				},
+			},
+			{
+				Name:    "mst1",
+				Tags:    map[string]string{"host": "h2"},
+				Columns: []string{"time", "value", "status"},
+				Values: [][]interface{}{
+					{time.Unix(0, 3), int64(2), "ok"},
+				},
+			},
+		},
+	}}}
+}
+
+func TestArrowFlightDoGet(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:8088",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+
+	service, err := arrowflight.NewService(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = NewMockQueryExecutor()
+	if err = service.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer service.Close()
+
+	authClient := &clientAuth{authEnabled: c.FlightAuthEnabled}
+	client, err := flight.NewFlightClient(service.GetServer().Addr().String(), authClient, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.WithValue(context.Background(), Token, []byte("{\"username\": \"xiaohong\", \"db\": \"db0\"}"))
+	if err = client.Authenticate(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := []byte(`{"db": "db0", "sql": "select value, status from mst1 group by host"}`)
+	info, err := client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 1, len(info.Endpoint))
+
+	stream, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := flight.NewRecordReader(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Release()
+
+	schema := reader.Schema()
+	assert.Equal(t, []string{"time", "host", "value", "status"}, []string{schema.Field(0).Name, schema.Field(1).Name, schema.Field(2).Name, schema.Field(3).Name})
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, schema.Field(2).Type)
+	assert.Equal(t, arrow.BinaryTypes.String, schema.Field(3).Type)
+
+	var rows int
+	var values []float64
+	for reader.Next() {
+		rec := reader.Record()
+		rows += int(rec.NumRows())
+		col := rec.Column(2).(*array.Float64)
+		for i := 0; i < col.Len(); i++ {
+			if col.IsValid(i) {
+				values = append(values, col.Value(i))
+			}
+		}
+	}
+	assert.Equal(t, 3, rows)
+	assert.Equal(t, []float64{1.5, 2}, values)
+
+	// only select statement is accepted
+	stream, err = client.DoGet(ctx, &flight.Ticket{Ticket: []byte("show databases")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = stream.Recv()
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the user is not authorized to read db1
+	cmd = []byte(`{"db": "db1", "sql": "select value from mst1"}`)
+	_, err = client.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestParseQueryTicket(t *testing.T) {
+	qt, err := arrowflight.ParseQueryTicket([]byte(" select * from db0..mst1 "))
+	assert.NoError(t, err)
+	assert.Equal(t, "select * from db0..mst1", qt.Query)
+
+	qt, err = arrowflight.ParseQueryTicket([]byte(`{"db": "db0", "rp": "rp0", "sql": "select * from mst1", "chunk_size": 10}`))
+	assert.NoError(t, err)
+	assert.Equal(t, &arrowflight.QueryTicket{DataBase: "db0", RetentionPolicy: "rp0", Query: "select * from mst1", ChunkSize: 10}, qt)
+
+	_, err = arrowflight.ParseQueryTicket([]byte(`{"db": "db0"}`))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = arrowflight.ParseQueryTicket(nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func readFlightSQLInfo(t *testing.T, ctx context.Context, client *flightsql.Client, info *flight.FlightInfo) []arrow.Record {
+	reader, err := client.DoGet(ctx, info.Endpoint[0].Ticket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Release()
+	var recs []arrow.Record
+	for reader.Next() {
+		rec := reader.Record()
+		rec.Retain()
+		recs = append(recs, rec)
+	}
+	if err = reader.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return recs
+}
+
+func stringColumn(recs []arrow.Record, col int) []string {
+	var ret []string
+	for _, rec := range recs {
+		c := rec.Column(col).(*array.String)
+		for i := 0; i < c.Len(); i++ {
+			ret = append(ret, c.Value(i))
+		}
+	}
+	return ret
+}
+
+func TestArrowFlightSQL(t *testing.T) {
+	c := config.Config{
+		FlightAddress:     "127.0.0.1:8089",
+		MaxBodySize:       1024 * 1024 * 1024,
+		FlightAuthEnabled: true,
+	}
+
+	service, err := arrowflight.NewService(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	service.MetaClient = NewMockFlightMetaClient()
+	service.RecordWriter = &MockRecordWriter{}
+	service.QueryExecutor = NewMockQueryExecutor()
+	if err = service.Open(); err != nil {
+		t.Fatal(err)
+	}
+	defer service.Close()
+
+	authClient := &clientAuth{authEnabled: c.FlightAuthEnabled}
+	client, err := flightsql.NewClient(service.GetServer().Addr().String(), authClient, nil, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx := context.WithValue(context.Background(), Token, []byte("{\"username\": \"xiaohong\", \"db\": \"db0\"}"))
+	if err = client.Client.Authenticate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	ctx = metadata.AppendToOutgoingContext(ctx, arrowflight.DatabaseHeader, "db0")
+
+	// xiaohong is only allowed to read db0
+	info, err := client.GetCatalogs(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"db0"}, stringColumn(readFlightSQLInfo(t, ctx, client, info), 0))
+
+	catalog := "db0"
+	info, err = client.GetDBSchemas(ctx, &flightsql.GetDBSchemasOpts{Catalog: &catalog})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, []string{"autogen"}, stringColumn(readFlightSQLInfo(t, ctx, client, info), 1))
+
+	pattern := "mst%"
+	info, err = client.GetTables(ctx, &flightsql.GetTablesOpts{Catalog: &catalog, TableNameFilterPattern: &pattern, IncludeSchema: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	recs := readFlightSQLInfo(t, ctx, client, info)
+	assert.Equal(t, []string{"mst1"}, stringColumn(recs, 2))
+	assert.Equal(t, []string{"TABLE"}, stringColumn(recs, 3))
+	schema, err := flight.DeserializeSchema(recs[0].Column(4).(*array.Binary).Value(0), memory.DefaultAllocator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "time", schema.Field(0).Name)
+	assert.Equal(t, "host", schema.Field(1).Name)
+	assert.Equal(t, arrow.PrimitiveTypes.Float64, schema.Field(3).Type)
+
+	pattern = "cpu%"
+	info, err = client.GetTables(ctx, &flightsql.GetTablesOpts{TableNameFilterPattern: &pattern})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 0, len(stringColumn(readFlightSQLInfo(t, ctx, client, info), 2)))
+
+	countRows := func(recs []arrow.Record) int {
+		var rows int
+		for _, rec := range recs {
+			assert.Equal(t, "host", rec.Schema().Field(1).Name)
+			rows += int(rec.NumRows())
+		}
+		return rows
+	}
+
+	info, err = client.Execute(ctx, "select value, status from mst1 group by host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, countRows(readFlightSQLInfo(t, ctx, client, info)))
+
+	prep, err := client.Prepare(ctx, "select value, status from mst1 group by host")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err = prep.Execute(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, 3, countRows(readFlightSQLInfo(t, ctx, client, info)))
+	assert.NoError(t, prep.Close(ctx))
+	_, err = client.DoGet(ctx, info.Endpoint[0].Ticket)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// only select statement is accepted
+	_, err = client.Execute(ctx, "show databases")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// the raw tickets are still served next to flight sql
+	stream, err := client.Client.DoGet(ctx, &flight.Ticket{Ticket: []byte(`{"db": "db0", "sql": "select value, status from mst1 group by host"}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := flight.NewRecordReader(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Release()
+	assert.Equal(t, "time", reader.Schema().Field(0).Name)
+}
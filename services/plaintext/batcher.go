@@ -0,0 +1,156 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintext
+
+import (
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/obs"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+type PointsWriter interface {
+	RetryWritePointRows(database, retentionPolicy string, rows []influx.Row) error
+}
+
+type MetaClient interface {
+	Database(name string) (*meta.DatabaseInfo, error)
+	CreateDatabase(name string, enableTagArray bool, replicaN uint32, options *obs.ObsOptions) (*meta.DatabaseInfo, error)
+}
+
+// batcher buffers the rows of a listener and writes them when batch-size rows
+// are buffered or batch-timeout elapses, whichever comes first.
+type batcher struct {
+	db, rp  string
+	size    int
+	timeout time.Duration
+
+	writer PointsWriter
+	meta   MetaClient
+	logger *logger.Logger
+
+	mu      sync.Mutex
+	rows    []influx.Row
+	dbReady bool
+
+	// serializes the writes so that the rows of a source keep their order
+	writeMu sync.Mutex
+
+	wg   sync.WaitGroup
+	done chan struct{}
+}
+
+func newBatcher(db, rp string, size int, timeout time.Duration, writer PointsWriter, mc MetaClient, log *logger.Logger) *batcher {
+	return &batcher{
+		db:      db,
+		rp:      rp,
+		size:    size,
+		timeout: timeout,
+		writer:  writer,
+		meta:    mc,
+		logger:  log,
+		done:    make(chan struct{}),
+	}
+}
+
+func (b *batcher) start() {
+	if b.timeout <= 0 {
+		return
+	}
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		ticker := time.NewTicker(b.timeout)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-b.done:
+				return
+			case <-ticker.C:
+				b.flush()
+			}
+		}
+	}()
+}
+
+// stop stops the flush loop and writes the rows left.
+func (b *batcher) stop() {
+	close(b.done)
+	b.wg.Wait()
+	b.flush()
+}
+
+// add buffers rows, the rows must not be reused by the caller.
+func (b *batcher) add(rows ...influx.Row) {
+	b.mu.Lock()
+	b.rows = append(b.rows, rows...)
+	full := len(b.rows) >= b.size
+	b.mu.Unlock()
+
+	if full {
+		b.flush()
+	}
+}
+
+func (b *batcher) flush() {
+	b.writeMu.Lock()
+	defer b.writeMu.Unlock()
+
+	b.mu.Lock()
+	rows := b.rows
+	b.rows = nil
+	b.mu.Unlock()
+	if len(rows) == 0 {
+		return
+	}
+
+	// there is no client waiting for the buffered rows, the failures can only be logged
+	if err := b.ensureDatabase(); err != nil {
+		b.logger.Error("create database failed, drop points", zap.String("db", b.db), zap.Int("points", len(rows)), zap.Error(err))
+		return
+	}
+	if err := b.writer.RetryWritePointRows(b.db, b.rp, rows); err != nil {
+		b.logger.Error("write points failed", zap.String("db", b.db), zap.String("rp", b.rp), zap.Int("points", len(rows)), zap.Error(err))
+	}
+}
+
+// write writes the rows without buffering them and returns the error of the write.
+func (b *batcher) write(rows []influx.Row) error {
+	b.writeMu.Lock()
+	defer b.writeMu.Unlock()
+
+	if err := b.ensureDatabase(); err != nil {
+		return err
+	}
+	return b.writer.RetryWritePointRows(b.db, b.rp, rows)
+}
+
+// ensureDatabase creates the database of the listener on the first write if it does not exist.
+func (b *batcher) ensureDatabase() error {
+	if b.dbReady {
+		return nil
+	}
+	if _, err := b.meta.Database(b.db); err != nil {
+		if _, err = b.meta.CreateDatabase(b.db, false, 1, nil); err != nil {
+			return err
+		}
+	}
+	b.dbReady = true
+	return nil
+}
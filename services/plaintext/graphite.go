@@ -0,0 +1,285 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintext
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+const (
+	defaultGraphiteTemplate = "measurement*"
+	defaultFieldName        = "value"
+)
+
+var errSkipPoint = errors.New("point skipped")
+
+// graphiteTemplate maps the parts of the Graphite paths matching filter to
+// measurement, tags and field. A part of the template is either "measurement",
+// "field", a tag name or empty to drop the path part, "measurement*" and
+// "field*" take all the path parts left.
+type graphiteTemplate struct {
+	filter []string
+	parts  []string
+	tags   []influx.Tag
+}
+
+func parseGraphiteTemplate(s string) (*graphiteTemplate, error) {
+	fields := strings.Fields(s)
+	t := &graphiteTemplate{}
+	var tmpl, tags string
+	switch len(fields) {
+	case 1:
+		tmpl = fields[0]
+	case 2:
+		if strings.Contains(fields[1], "=") {
+			tmpl, tags = fields[0], fields[1]
+		} else {
+			t.filter = strings.Split(fields[0], ".")
+			tmpl = fields[1]
+		}
+	case 3:
+		t.filter = strings.Split(fields[0], ".")
+		tmpl, tags = fields[1], fields[2]
+	default:
+		return nil, fmt.Errorf("invalid graphite template %q", s)
+	}
+
+	t.parts = strings.Split(tmpl, ".")
+	var hasMeasurement bool
+	for _, part := range t.parts {
+		if strings.HasPrefix(part, "measurement") {
+			hasMeasurement = true
+		}
+	}
+	if !hasMeasurement {
+		return nil, fmt.Errorf("graphite template %q has no measurement", s)
+	}
+
+	var err error
+	if t.tags, err = parseGraphiteTags(tags, ","); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func parseGraphiteTags(s, sep string) ([]influx.Tag, error) {
+	var tags []influx.Tag
+	if s == "" {
+		return tags, nil
+	}
+	for _, kv := range strings.Split(s, sep) {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" || v == "" {
+			return nil, fmt.Errorf("invalid graphite tag %q", kv)
+		}
+		tags = append(tags, influx.Tag{Key: k, Value: v})
+	}
+	return tags, nil
+}
+
+// match returns true if the filter of t matches the leading parts of the path.
+func (t *graphiteTemplate) match(parts []string) bool {
+	if len(t.filter) > len(parts) {
+		return false
+	}
+	for i, f := range t.filter {
+		if ok, _ := path.Match(f, parts[i]); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// apply returns the measurement, tags and field of the path parts.
+func (t *graphiteTemplate) apply(parts []string, separator string) (string, []influx.Tag, string) {
+	var measurement, field []string
+	tagValues := make(map[string][]string)
+	for i, tp := range t.parts {
+		if i >= len(parts) {
+			break
+		}
+		switch tp {
+		case "":
+		case "measurement":
+			measurement = append(measurement, parts[i])
+		case "measurement*":
+			measurement = append(measurement, parts[i:]...)
+		case "field":
+			field = append(field, parts[i])
+		case "field*":
+			field = append(field, parts[i:]...)
+		default:
+			tagValues[tp] = append(tagValues[tp], parts[i])
+		}
+		if strings.HasSuffix(tp, "*") {
+			break
+		}
+	}
+
+	tags := make([]influx.Tag, 0, len(tagValues)+len(t.tags))
+	for k, v := range tagValues {
+		tags = append(tags, influx.Tag{Key: k, Value: strings.Join(v, separator)})
+	}
+	for _, tag := range t.tags {
+		if _, ok := tagValues[tag.Key]; !ok {
+			tags = append(tags, tag)
+		}
+	}
+
+	f := defaultFieldName
+	if len(field) > 0 {
+		f = strings.Join(field, separator)
+	}
+	return strings.Join(measurement, separator), tags, f
+}
+
+// GraphiteParser parses the Graphite plaintext protocol:
+//
+//	<path>[;tag=value...] <value> [<timestamp>]
+type GraphiteParser struct {
+	separator string
+	templates []*graphiteTemplate
+	deflt     *graphiteTemplate
+	tags      []influx.Tag
+}
+
+func NewGraphiteParser(separator string, templates []string, tags []string) (*GraphiteParser, error) {
+	p := &GraphiteParser{separator: separator}
+	for _, s := range templates {
+		t, err := parseGraphiteTemplate(s)
+		if err != nil {
+			return nil, err
+		}
+		if len(t.filter) == 0 {
+			p.deflt = t
+			continue
+		}
+		p.templates = append(p.templates, t)
+	}
+	if p.deflt == nil {
+		p.deflt, _ = parseGraphiteTemplate(defaultGraphiteTemplate)
+	}
+
+	// the most specific filter is matched first
+	sort.SliceStable(p.templates, func(i, j int) bool {
+		fi, fj := p.templates[i].filter, p.templates[j].filter
+		if len(fi) != len(fj) {
+			return len(fi) > len(fj)
+		}
+		return wildcards(fi) < wildcards(fj)
+	})
+
+	var err error
+	if p.tags, err = parseGraphiteTags(strings.Join(tags, ","), ","); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func wildcards(filter []string) int {
+	var n int
+	for _, f := range filter {
+		if strings.ContainsAny(f, "*?[") {
+			n++
+		}
+	}
+	return n
+}
+
+// Parse parses a line into a row, the timestamp defaults to now.
+func (p *GraphiteParser) Parse(line string, now time.Time) (influx.Row, error) {
+	row := influx.Row{}
+	fields := strings.Fields(line)
+	if len(fields) != 2 && len(fields) != 3 {
+		return row, fmt.Errorf("invalid graphite line %q", line)
+	}
+
+	value, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return row, fmt.Errorf("invalid graphite value %q: %w", fields[1], err)
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return row, errSkipPoint
+	}
+
+	ts := now.UnixNano()
+	if len(fields) == 3 {
+		sec, err := strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return row, fmt.Errorf("invalid graphite timestamp %q: %w", fields[2], err)
+		}
+		if sec != -1 {
+			ts = int64(sec * float64(time.Second))
+		}
+	}
+
+	// graphite 1.1 tagged series: path;tag1=value1;tag2=value2
+	name, inline, _ := strings.Cut(fields[0], ";")
+	inlineTags, err := parseGraphiteTags(inline, ";")
+	if err != nil {
+		return row, err
+	}
+
+	parts := strings.Split(name, ".")
+	t := p.deflt
+	for _, tmpl := range p.templates {
+		if tmpl.match(parts) {
+			t = tmpl
+			break
+		}
+	}
+	measurement, tags, field := t.apply(parts, p.separator)
+	if measurement == "" {
+		measurement = name
+	}
+
+	row.Name = measurement
+	row.Timestamp = ts
+	row.Tags = mergeTags(inlineTags, tags, p.tags)
+	row.Fields = influx.Fields{{Key: field, Type: influx.Field_Type_Float, NumValue: value}}
+	return row, nil
+}
+
+// mergeTags merges the tag groups ordered by priority, and sorts them by key.
+func mergeTags(groups ...[]influx.Tag) influx.PointTags {
+	var tags influx.PointTags
+	for _, group := range groups {
+		for _, tag := range group {
+			if !hasTag(tags, tag.Key) {
+				tags = append(tags, tag)
+			}
+		}
+	}
+	sort.Sort(&tags)
+	return tags
+}
+
+func hasTag(tags influx.PointTags, key string) bool {
+	for i := range tags {
+		if tags[i].Key == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintext
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphiteParser_Parse(t *testing.T) {
+	p, err := NewGraphiteParser(".", []string{
+		"servers.* .host.measurement.field*",
+		"servers.*.cpu .host.measurement.cpu.field dc=sh",
+		"*.app env.service.resource.measurement",
+		"measurement.measurement.region",
+	}, []string{"source=graphite"})
+	require.NoError(t, err)
+
+	now := time.Unix(100, 0)
+	tests := []struct {
+		line        string
+		measurement string
+		tags        []influx.Tag
+		field       string
+		ts          int64
+	}{
+		{
+			line:        "servers.host01.cpu.cpu0.idle 98.5 1700000000",
+			measurement: "cpu",
+			tags:        []influx.Tag{{Key: "cpu", Value: "cpu0"}, {Key: "dc", Value: "sh"}, {Key: "host", Value: "host01"}, {Key: "source", Value: "graphite"}},
+			field:       "idle",
+			ts:          1700000000 * int64(time.Second),
+		},
+		{
+			line:        "servers.host01.mem.free.bytes 1024 1700000000.5",
+			measurement: "mem",
+			tags:        []influx.Tag{{Key: "host", Value: "host01"}, {Key: "source", Value: "graphite"}},
+			field:       "free.bytes",
+			ts:          1700000000*int64(time.Second) + int64(500*time.Millisecond),
+		},
+		{
+			line:        "prod.app.api.requests 3",
+			measurement: "requests",
+			tags:        []influx.Tag{{Key: "env", Value: "prod"}, {Key: "resource", Value: "api"}, {Key: "service", Value: "app"}, {Key: "source", Value: "graphite"}},
+			field:       "value",
+			ts:          now.UnixNano(),
+		},
+		{
+			line:        "disk.used.us-east;host=h1;source=collectd 42 -1",
+			measurement: "disk.used",
+			tags:        []influx.Tag{{Key: "host", Value: "h1"}, {Key: "region", Value: "us-east"}, {Key: "source", Value: "collectd"}},
+			field:       "value",
+			ts:          now.UnixNano(),
+		},
+	}
+	for _, tt := range tests {
+		row, err := p.Parse(tt.line, now)
+		require.NoError(t, err, tt.line)
+		assert.Equal(t, tt.measurement, row.Name, tt.line)
+		assert.Equal(t, influx.PointTags(tt.tags), row.Tags, tt.line)
+		require.Len(t, row.Fields, 1)
+		assert.Equal(t, tt.field, row.Fields[0].Key, tt.line)
+		assert.Equal(t, tt.ts, row.Timestamp, tt.line)
+	}
+}
+
+func TestGraphiteParser_Default(t *testing.T) {
+	p, err := NewGraphiteParser("_", nil, nil)
+	require.NoError(t, err)
+
+	row, err := p.Parse("a.b.c 1.5 10", time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, "a_b_c", row.Name)
+	assert.Empty(t, row.Tags)
+	assert.Equal(t, 1.5, row.Fields[0].NumValue)
+}
+
+func TestGraphiteParser_Invalid(t *testing.T) {
+	p, err := NewGraphiteParser(".", nil, nil)
+	require.NoError(t, err)
+
+	for _, line := range []string{"a.b", "a.b x", "a.b 1 x", "a.b 1 2 3", "a.b;host 1"} {
+		_, err = p.Parse(line, time.Now())
+		assert.Error(t, err, line)
+	}
+	_, err = p.Parse("a.b NaN", time.Now())
+	assert.ErrorIs(t, err, errSkipPoint)
+
+	_, err = NewGraphiteParser(".", []string{"host.field"}, nil)
+	assert.Error(t, err)
+	_, err = NewGraphiteParser(".", []string{"a b c d"}, nil)
+	assert.Error(t, err)
+	_, err = NewGraphiteParser(".", nil, []string{"region"})
+	assert.Error(t, err)
+}
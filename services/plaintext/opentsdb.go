@@ -0,0 +1,200 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintext
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	compression "github.com/openGemini/openGemini/lib/compress"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+)
+
+// the timestamps greater than it are in milliseconds
+const maxSecondTimestamp = 1e10
+
+// ParseOpenTSDBTelnet parses a telnet put command:
+//
+//	put <metric> <timestamp> <value> <tagk1=tagv1 ...>
+func ParseOpenTSDBTelnet(line string) (influx.Row, error) {
+	row := influx.Row{}
+	fields := strings.Fields(line)
+	if len(fields) < 4 || fields[0] != "put" {
+		return row, fmt.Errorf("invalid opentsdb put command %q", line)
+	}
+
+	ts, err := strconv.ParseInt(fields[2], 10, 64)
+	if err != nil {
+		return row, fmt.Errorf("invalid opentsdb timestamp %q: %w", fields[2], err)
+	}
+	value, err := strconv.ParseFloat(fields[3], 64)
+	if err != nil {
+		return row, fmt.Errorf("invalid opentsdb value %q: %w", fields[3], err)
+	}
+
+	tags := make([]influx.Tag, 0, len(fields)-4)
+	for _, kv := range fields[4:] {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok || k == "" || v == "" {
+			return row, fmt.Errorf("invalid opentsdb tag %q", kv)
+		}
+		tags = append(tags, influx.Tag{Key: k, Value: v})
+	}
+	return newOpenTSDBRow(fields[1], ts, value, tags)
+}
+
+func newOpenTSDBRow(metric string, ts int64, value float64, tags []influx.Tag) (influx.Row, error) {
+	row := influx.Row{}
+	if metric == "" {
+		return row, fmt.Errorf("opentsdb metric is required")
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return row, errSkipPoint
+	}
+	if ts < maxSecondTimestamp {
+		ts *= int64(time.Second)
+	} else {
+		ts *= int64(time.Millisecond)
+	}
+
+	row.Name = metric
+	row.Timestamp = ts
+	row.Tags = mergeTags(tags)
+	row.Fields = influx.Fields{{Key: defaultFieldName, Type: influx.Field_Type_Float, NumValue: value}}
+	return row, nil
+}
+
+// openTSDBPoint is a data point of the HTTP /api/put.
+type openTSDBPoint struct {
+	Metric    string            `json:"metric"`
+	Timestamp int64             `json:"timestamp"`
+	Value     json.Number       `json:"value"`
+	Tags      map[string]string `json:"tags"`
+}
+
+// ParseOpenTSDBJSON parses the body of /api/put, which is a single data point or an array of data points.
+func ParseOpenTSDBJSON(body []byte) ([]influx.Row, error) {
+	var points []openTSDBPoint
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '{' {
+		var p openTSDBPoint
+		if err := json.Unmarshal(body, &p); err != nil {
+			return nil, err
+		}
+		points = append(points, p)
+	} else if err := json.Unmarshal(body, &points); err != nil {
+		return nil, err
+	}
+
+	rows := make([]influx.Row, 0, len(points))
+	for i := range points {
+		p := &points[i]
+		value, err := p.Value.Float64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid opentsdb value %q: %w", p.Value, err)
+		}
+		tags := make([]influx.Tag, 0, len(p.Tags))
+		for k, v := range p.Tags {
+			tags = append(tags, influx.Tag{Key: k, Value: v})
+		}
+		row, err := newOpenTSDBRow(p.Metric, p.Timestamp, value, tags)
+		if err == errSkipPoint {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// openTSDBHandler serves the OpenTSDB HTTP api.
+type openTSDBHandler struct {
+	batcher *batcher
+	// maximum size of a request body in bytes, before and after decompression. 0 means unlimited
+	maxBodySize int64
+}
+
+// ServeHTTP serves /api/put. The parsed rows are buffered like the telnet put commands, and the
+// request is answered with 204 before they are written, so the write errors are only logged.
+// With the sync parameter the rows are written before the response, and the write errors are returned.
+func (h *openTSDBHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/api/put" {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if h.maxBodySize > 0 && r.ContentLength > h.maxBodySize {
+		http.Error(w, http.StatusText(http.StatusRequestEntityTooLarge), http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	body := r.Body
+	if h.maxBodySize > 0 {
+		body = http.MaxBytesReader(w, body, h.maxBodySize)
+	}
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gr, err := compression.GetGzipReader(body)
+		if err != nil {
+			http.Error(w, err.Error(), bodyErrorStatus(err))
+			return
+		}
+		defer compression.PutGzipReader(gr)
+		body = io.NopCloser(gr)
+		if h.maxBodySize > 0 {
+			body = http.MaxBytesReader(w, body, h.maxBodySize)
+		}
+	}
+	buf, err := io.ReadAll(body)
+	if err != nil {
+		http.Error(w, err.Error(), bodyErrorStatus(err))
+		return
+	}
+
+	rows, err := ParseOpenTSDBJSON(buf)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if r.URL.Query().Has("sync") {
+		if err = h.batcher.write(rows); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	} else {
+		h.batcher.add(rows...)
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func bodyErrorStatus(err error) int {
+	var maxErr *http.MaxBytesError
+	if errors.As(err, &maxErr) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintext
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOpenTSDBTelnet(t *testing.T) {
+	row, err := ParseOpenTSDBTelnet("put sys.cpu.user 1700000000 42.5 host=web01 cpu=0")
+	require.NoError(t, err)
+	assert.Equal(t, "sys.cpu.user", row.Name)
+	assert.Equal(t, 1700000000*int64(time.Second), row.Timestamp)
+	assert.Equal(t, influx.PointTags{{Key: "cpu", Value: "0"}, {Key: "host", Value: "web01"}}, row.Tags)
+	assert.Equal(t, influx.Fields{{Key: "value", Type: influx.Field_Type_Float, NumValue: 42.5}}, row.Fields)
+
+	row, err = ParseOpenTSDBTelnet("put sys.cpu.user 1700000000123 1")
+	require.NoError(t, err)
+	assert.Equal(t, 1700000000123*int64(time.Millisecond), row.Timestamp)
+
+	for _, line := range []string{"put m 1", "get m 1 1", "put m x 1", "put m 1 x", "put m 1 1 host"} {
+		_, err = ParseOpenTSDBTelnet(line)
+		assert.Error(t, err, line)
+	}
+}
+
+func TestParseOpenTSDBJSON(t *testing.T) {
+	rows, err := ParseOpenTSDBJSON([]byte(`{"metric":"m1","timestamp":1700000000,"value":1,"tags":{"host":"a"}}`))
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, "m1", rows[0].Name)
+
+	rows, err = ParseOpenTSDBJSON([]byte(`[
+		{"metric":"m1","timestamp":1700000000,"value":1.5,"tags":{"host":"a"}},
+		{"metric":"m2","timestamp":1700000000000,"value":2,"tags":{"host":"b","dc":"x"}}
+	]`))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, 1.5, rows[0].Fields[0].NumValue)
+	assert.Equal(t, influx.PointTags{{Key: "dc", Value: "x"}, {Key: "host", Value: "b"}}, rows[1].Tags)
+
+	_, err = ParseOpenTSDBJSON([]byte(`{"metric":"","timestamp":1,"value":1}`))
+	assert.Error(t, err)
+	_, err = ParseOpenTSDBJSON([]byte(`{"metric":"m","timestamp":1,"value":"x"}`))
+	assert.Error(t, err)
+	_, err = ParseOpenTSDBJSON([]byte(`not json`))
+	assert.Error(t, err)
+}
+
+func TestOpenTSDBHandler(t *testing.T) {
+	w := &mockPointsWriter{}
+	b := newBatcher("db0", "", 100, 0, w, &mockMetaClient{}, logger.NewLogger(errno.ModuleUnknown))
+	h := &openTSDBHandler{batcher: b}
+
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, _ = gw.Write([]byte(`[{"metric":"m1","timestamp":1700000000,"value":1,"tags":{"host":"a"}}]`))
+	require.NoError(t, gw.Close())
+
+	req := httptest.NewRequest(http.MethodPost, "/api/put", &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/put", bytes.NewBufferString("{")))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/api/put", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/query", nil))
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	b.stop()
+	assert.Equal(t, 1, w.count())
+}
+
+func TestOpenTSDBHandler_MaxBodySize(t *testing.T) {
+	w := &mockPointsWriter{}
+	b := newBatcher("db0", "", 100, 0, w, &mockMetaClient{}, logger.NewLogger(errno.ModuleUnknown))
+	h := &openTSDBHandler{batcher: b, maxBodySize: 64}
+	point := `{"metric":"m1","timestamp":1700000000,"value":1,"tags":{"host":"a"}}`
+
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/put", bytes.NewBufferString(point)))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+
+	// the size of the body is unknown
+	req := httptest.NewRequest(http.MethodPost, "/api/put", bytes.NewBufferString(point))
+	req.ContentLength = -1
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+
+	// the decompressed body is limited too
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	_, _ = gw.Write(bytes.Repeat([]byte(" "), 1024))
+	require.NoError(t, gw.Close())
+	require.Less(t, buf.Len(), 64)
+	req = httptest.NewRequest(http.MethodPost, "/api/put", &buf)
+	req.Header.Set("Content-Encoding", "gzip")
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+
+	b.stop()
+	assert.Equal(t, 0, w.count())
+}
+
+func TestOpenTSDBHandler_Sync(t *testing.T) {
+	w := &mockPointsWriter{}
+	b := newBatcher("db0", "", 100, 0, w, &mockMetaClient{}, logger.NewLogger(errno.ModuleUnknown))
+	h := &openTSDBHandler{batcher: b}
+	point := `{"metric":"m1","timestamp":1700000000,"value":1,"tags":{"host":"a"}}`
+
+	// the rows are written before the response
+	resp := httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/put?sync", bytes.NewBufferString(point)))
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	assert.Equal(t, 1, w.count())
+
+	// the write errors are returned
+	w.err = errors.New("write failed")
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/put?sync", bytes.NewBufferString(point)))
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, resp.Body.String(), "write failed")
+
+	// without sync the rows are buffered, the write errors are only logged
+	resp = httptest.NewRecorder()
+	h.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/api/put", bytes.NewBufferString(point)))
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+	b.stop()
+	assert.Equal(t, 1, w.count())
+}
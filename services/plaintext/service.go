@@ -0,0 +1,358 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintext
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/errno"
+	"github.com/openGemini/openGemini/lib/logger"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"go.uber.org/zap"
+)
+
+const (
+	maxLineSize   = 1024 * 1024
+	udpBufferSize = 64 * 1024
+)
+
+// Service accepts the legacy plaintext protocols, the Graphite plaintext protocol
+// over tcp or udp, and the OpenTSDB telnet put command and HTTP /api/put which
+// share one tcp address. The points are written through the PointsWriter in batches.
+type Service struct {
+	graphiteConf config.GraphiteConfig
+	openTSDBConf config.OpenTSDBConfig
+
+	graphiteParser *GraphiteParser
+
+	PointsWriter PointsWriter
+	MetaClient   MetaClient
+	Logger       *logger.Logger
+	// maximum size of a request body of the OpenTSDB HTTP api, it follows the max-body-size of the http service
+	MaxBodySize int
+
+	graphiteBatcher *batcher
+	openTSDBBatcher *batcher
+
+	graphiteLn   net.Listener
+	graphiteConn net.PacketConn
+	openTSDBLn   net.Listener
+	httpLn       *chanListener
+	httpServer   *http.Server
+
+	mu    sync.Mutex
+	conns map[net.Conn]struct{}
+	wg    sync.WaitGroup
+	done  chan struct{}
+}
+
+func NewService(graphite config.GraphiteConfig, openTSDB config.OpenTSDBConfig) (*Service, error) {
+	s := &Service{
+		graphiteConf: graphite,
+		openTSDBConf: openTSDB,
+		Logger:       logger.NewLogger(errno.ModuleUnknown).With(zap.String("service", "plaintext")),
+		conns:        make(map[net.Conn]struct{}),
+		done:         make(chan struct{}),
+	}
+	if graphite.Enabled {
+		p, err := NewGraphiteParser(graphite.Separator, graphite.Templates, graphite.Tags)
+		if err != nil {
+			return nil, err
+		}
+		s.graphiteParser = p
+	}
+	return s, nil
+}
+
+func (s *Service) WithLogger(log *logger.Logger) {
+	s.Logger = log.With(zap.String("service", "plaintext"))
+}
+
+func (s *Service) Open() error {
+	if s.graphiteConf.Enabled {
+		if err := s.openGraphite(); err != nil {
+			return err
+		}
+	}
+	if s.openTSDBConf.Enabled {
+		if err := s.openOpenTSDB(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) openGraphite() error {
+	c := &s.graphiteConf
+	s.graphiteBatcher = newBatcher(c.Database, c.RetentionPolicy, c.BatchSize, time.Duration(c.BatchTimeout), s.PointsWriter, s.MetaClient, s.Logger)
+	s.graphiteBatcher.start()
+
+	if c.Protocol == "udp" {
+		conn, err := net.ListenPacket("udp", c.BindAddress)
+		if err != nil {
+			return err
+		}
+		s.graphiteConn = conn
+		s.Logger.Info("Listening on Graphite udp", zap.Stringer("addr", conn.LocalAddr()))
+		s.wg.Add(1)
+		go s.serveGraphiteUDP()
+		return nil
+	}
+
+	ln, err := net.Listen("tcp", c.BindAddress)
+	if err != nil {
+		return err
+	}
+	s.graphiteLn = ln
+	s.Logger.Info("Listening on Graphite tcp", zap.Stringer("addr", ln.Addr()))
+	s.wg.Add(1)
+	go s.acceptLoop(ln, func(conn net.Conn) {
+		s.serveLines(bufio.NewReader(conn), s.handleGraphiteLine)
+	})
+	return nil
+}
+
+func (s *Service) openOpenTSDB() error {
+	c := &s.openTSDBConf
+	s.openTSDBBatcher = newBatcher(c.Database, c.RetentionPolicy, c.BatchSize, time.Duration(c.BatchTimeout), s.PointsWriter, s.MetaClient, s.Logger)
+	s.openTSDBBatcher.start()
+
+	ln, err := net.Listen("tcp", c.BindAddress)
+	if err != nil {
+		return err
+	}
+	s.openTSDBLn = ln
+	s.httpLn = newChanListener(ln.Addr())
+	s.httpServer = &http.Server{Handler: &openTSDBHandler{batcher: s.openTSDBBatcher, maxBodySize: int64(s.MaxBodySize)}}
+	go func() {
+		if err := s.httpServer.Serve(s.httpLn); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			s.Logger.Error("opentsdb http server failed", zap.Error(err))
+		}
+	}()
+
+	s.Logger.Info("Listening on OpenTSDB", zap.Stringer("addr", ln.Addr()))
+	s.wg.Add(1)
+	go s.acceptLoop(ln, s.serveOpenTSDBConn)
+	return nil
+}
+
+func (s *Service) acceptLoop(ln net.Listener, serve func(conn net.Conn)) {
+	defer s.wg.Done()
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			var ne net.Error
+			if errors.As(err, &ne) && ne.Timeout() {
+				continue
+			}
+			s.Logger.Error("accept failed", zap.Error(err))
+			return
+		}
+		if !s.trackConn(conn) {
+			_ = conn.Close()
+			return
+		}
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			defer s.untrackConn(conn)
+			serve(conn)
+		}()
+	}
+}
+
+// serveOpenTSDBConn serves a connection as telnet if it starts with a put
+// command, otherwise hands it over to the http server.
+func (s *Service) serveOpenTSDBConn(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	head, err := r.Peek(4)
+	if err != nil {
+		return
+	}
+	if string(head) != "put " {
+		s.untrackConn(conn)
+		s.httpLn.push(&bufferedConn{Conn: conn, r: r})
+		return
+	}
+	s.serveLines(r, s.handleOpenTSDBLine)
+}
+
+func (s *Service) serveLines(r *bufio.Reader, handle func(line string)) {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 4096), maxLineSize)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		handle(line)
+	}
+}
+
+func (s *Service) serveGraphiteUDP() {
+	defer s.wg.Done()
+	buf := make([]byte, udpBufferSize)
+	for {
+		n, _, err := s.graphiteConn.ReadFrom(buf)
+		if err != nil {
+			select {
+			case <-s.done:
+				return
+			default:
+			}
+			s.Logger.Error("graphite udp read failed", zap.Error(err))
+			continue
+		}
+		for _, line := range strings.Split(string(buf[:n]), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				s.handleGraphiteLine(line)
+			}
+		}
+	}
+}
+
+func (s *Service) handleGraphiteLine(line string) {
+	row, err := s.graphiteParser.Parse(line, time.Now())
+	s.addRow(s.graphiteBatcher, row, err, line)
+}
+
+func (s *Service) handleOpenTSDBLine(line string) {
+	if !strings.HasPrefix(line, "put ") {
+		// the other telnet commands, such as version and stats, are not supported
+		return
+	}
+	row, err := ParseOpenTSDBTelnet(line)
+	s.addRow(s.openTSDBBatcher, row, err, line)
+}
+
+func (s *Service) addRow(b *batcher, row influx.Row, err error, line string) {
+	if errors.Is(err, errSkipPoint) {
+		return
+	}
+	if err != nil {
+		s.Logger.Debug("drop invalid line", zap.String("line", line), zap.Error(err))
+		return
+	}
+	b.add(row)
+}
+
+func (s *Service) trackConn(conn net.Conn) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.done:
+		return false
+	default:
+	}
+	s.conns[conn] = struct{}{}
+	return true
+}
+
+func (s *Service) untrackConn(conn net.Conn) {
+	s.mu.Lock()
+	delete(s.conns, conn)
+	s.mu.Unlock()
+}
+
+func (s *Service) Close() error {
+	s.mu.Lock()
+	close(s.done)
+	for conn := range s.conns {
+		_ = conn.Close()
+	}
+	s.mu.Unlock()
+
+	if s.graphiteLn != nil {
+		_ = s.graphiteLn.Close()
+	}
+	if s.graphiteConn != nil {
+		_ = s.graphiteConn.Close()
+	}
+	if s.openTSDBLn != nil {
+		_ = s.openTSDBLn.Close()
+	}
+	if s.httpServer != nil {
+		_ = s.httpServer.Close()
+	}
+	s.wg.Wait()
+
+	if s.graphiteBatcher != nil {
+		s.graphiteBatcher.stop()
+	}
+	if s.openTSDBBatcher != nil {
+		s.openTSDBBatcher.stop()
+	}
+	return nil
+}
+
+// bufferedConn replays the bytes peeked from the connection.
+type bufferedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// chanListener is a net.Listener of the connections pushed to it.
+type chanListener struct {
+	addr  net.Addr
+	ch    chan net.Conn
+	once  sync.Once
+	close chan struct{}
+}
+
+func newChanListener(addr net.Addr) *chanListener {
+	return &chanListener{addr: addr, ch: make(chan net.Conn), close: make(chan struct{})}
+}
+
+func (l *chanListener) push(conn net.Conn) {
+	select {
+	case l.ch <- conn:
+	case <-l.close:
+		_ = conn.Close()
+	}
+}
+
+func (l *chanListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.ch:
+		return conn, nil
+	case <-l.close:
+		return nil, net.ErrClosed
+	}
+}
+
+func (l *chanListener) Close() error {
+	l.once.Do(func() { close(l.close) })
+	return nil
+}
+
+func (l *chanListener) Addr() net.Addr {
+	return l.addr
+}
@@ -0,0 +1,146 @@
+// Copyright 2024 Huawei Cloud Computing Technologies Co., Ltd.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plaintext
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/openGemini/openGemini/lib/config"
+	"github.com/openGemini/openGemini/lib/obs"
+	"github.com/openGemini/openGemini/lib/util/lifted/influx/meta"
+	"github.com/openGemini/openGemini/lib/util/lifted/vm/protoparser/influx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type mockPointsWriter struct {
+	mu   sync.Mutex
+	rows map[string][]influx.Row
+	err  error
+}
+
+func (w *mockPointsWriter) RetryWritePointRows(database, _ string, rows []influx.Row) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.err != nil {
+		return w.err
+	}
+	if w.rows == nil {
+		w.rows = make(map[string][]influx.Row)
+	}
+	w.rows[database] = append(w.rows[database], rows...)
+	return nil
+}
+
+func (w *mockPointsWriter) count() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var n int
+	for _, rows := range w.rows {
+		n += len(rows)
+	}
+	return n
+}
+
+func (w *mockPointsWriter) get(db string) []influx.Row {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.rows[db]
+}
+
+type mockMetaClient struct {
+	mu      sync.Mutex
+	created []string
+}
+
+func (c *mockMetaClient) Database(name string) (*meta.DatabaseInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, db := range c.created {
+		if db == name {
+			return &meta.DatabaseInfo{Name: name}, nil
+		}
+	}
+	return nil, fmt.Errorf("database not found: %s", name)
+}
+
+func (c *mockMetaClient) CreateDatabase(name string, _ bool, _ uint32, _ *obs.ObsOptions) (*meta.DatabaseInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.created = append(c.created, name)
+	return &meta.DatabaseInfo{Name: name}, nil
+}
+
+func TestBatcher(t *testing.T) {
+	w := &mockPointsWriter{}
+	mc := &mockMetaClient{}
+	b := newBatcher("db0", "", 2, 0, w, mc, nil)
+	b.add(influx.Row{Name: "m1"})
+	assert.Equal(t, 0, w.count())
+	b.add(influx.Row{Name: "m2"})
+	assert.Equal(t, 2, w.count())
+	b.add(influx.Row{Name: "m3"})
+	b.stop()
+	assert.Equal(t, 3, w.count())
+	assert.Equal(t, []string{"db0"}, mc.created)
+}
+
+func TestService(t *testing.T) {
+	g := config.NewGraphiteConfig()
+	g.Enabled = true
+	g.BindAddress = "127.0.0.1:0"
+	g.BatchSize = 1
+	o := config.NewOpenTSDBConfig()
+	o.Enabled = true
+	o.BindAddress = "127.0.0.1:0"
+	o.BatchSize = 1
+
+	s, err := NewService(g, o)
+	require.NoError(t, err)
+	w := &mockPointsWriter{}
+	s.PointsWriter = w
+	s.MetaClient = &mockMetaClient{}
+	require.NoError(t, s.Open())
+
+	conn, err := net.Dial("tcp", s.graphiteLn.Addr().String())
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("cpu.idle 99 1700000000\ninvalid\n"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	conn, err = net.Dial("tcp", s.openTSDBLn.Addr().String())
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("put sys.load 1700000000 1 host=a\n"))
+	require.NoError(t, err)
+	require.NoError(t, conn.Close())
+
+	body := bytes.NewBufferString(`{"metric":"sys.mem","timestamp":1700000000,"value":2,"tags":{"host":"a"}}`)
+	resp, err := http.Post("http://"+s.openTSDBLn.Addr().String()+"/api/put", "application/json", body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	require.Eventually(t, func() bool {
+		return len(w.get(config.DefaultGraphiteDatabase)) == 1 && len(w.get(config.DefaultOpenTSDBDatabase)) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, "cpu.idle", w.get(config.DefaultGraphiteDatabase)[0].Name)
+	require.NoError(t, s.Close())
+}
@@ -179,7 +179,16 @@ overrides:
         creation_grace_period: 5m
         enforce_metadata_metric_name: true
         enforce_metric_name: true
+        ingestion_rate: 0
+        ingestion_burst_size: 0
+        ingestion_bytes_rate: 0
+        ingestion_bytes_burst_size: 0
+        max_active_series: 0
+        active_series_idle_timeout: 10m
+        max_native_histogram_buckets: 160
         max_query_length: 0s
+        max_concurrent_queries: 0
+        max_points_per_query: 0
 `
 		require.Equal(t, http.StatusOK, w.Code)
 		require.Equal(t, expect, w.Body.String())
@@ -117,7 +117,7 @@ func (e *WriteError) Assert(err error, dropped int) error {
 		return nil
 	}
 
-	if errno.Equal(err, errno.ErrorTagArrayFormat, errno.WriteErrorArray, errno.SeriesLimited) {
+	if errno.Equal(err, errno.ErrorTagArrayFormat, errno.WriteErrorArray, errno.SeriesLimited, errno.MeasurementSeriesLimited) {
 		e.partialErr = err
 		return nil
 	}
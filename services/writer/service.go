@@ -313,6 +313,8 @@ func Record2Rows(dst []influx.Row, rec *record.Record) []influx.Row {
 			column2StringFields(dst, sch.Name, col)
 		case influx.Field_Type_Int:
 			column2IntegerFields(dst, sch.Name, col)
+		case influx.Field_Type_UInt:
+			column2UnsignedFields(dst, sch.Name, col)
 		case influx.Field_Type_Float:
 			column2FloatFields(dst, sch.Name, col)
 		case influx.Field_Type_Boolean:
@@ -364,6 +366,22 @@ func column2IntegerFields(dst []influx.Row, key string, col *record.ColVal) {
 	}
 }
 
+func column2UnsignedFields(dst []influx.Row, key string, col *record.ColVal) {
+	values := col.UnsignedValues()
+	hasNil := col.NilCount > 0
+	index := 0
+	for i := range dst {
+		if hasNil && col.IsNil(i) {
+			continue
+		}
+		f := dst[i].AllocField()
+		f.Key = key
+		f.SetUintValue(values[index])
+		f.Type = influx.Field_Type_UInt
+		index++
+	}
+}
+
 func column2FloatFields(dst []influx.Row, key string, col *record.ColVal) {
 	values := col.FloatValues()
 	hasNil := col.NilCount > 0
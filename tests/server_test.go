@@ -11792,6 +11792,68 @@ func TestServer_FullJoin(t *testing.T) {
 		})
 	}
 }
+func TestServer_Join(t *testing.T) {
+	t.Parallel()
+	s := OpenServer(NewParseConfig(testCfgPath))
+	defer s.Close()
+
+	test := NewTest("db0", "rp0")
+	test.writes = Writes{
+		&Write{data: fmt.Sprintf("mst,tk1=tv1 f1=1i 1610380800000000000\nmst,tk1=tv1 f1=2i 1610380801000000000\nmst,tk1=tv2 f1=3i 1610380800000000000\n" +
+			"mst2,tk1=tv1 f2=10i 1610380800000000000\nmst2,tk1=tv3 f2=30i 1610380800000000000\n")},
+	}
+
+	test.addQueries([]*Query{
+		{
+			name:    "inner join on one tag",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.f2 from (select f1 from mst) as m1 inner join (select f2 from mst2) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",1,10]]}]}]}`,
+		},
+		{
+			name:    "left outer join on one tag",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.f2 from (select f1 from mst) as m1 left join (select f2 from mst2) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",1,10],["2021-01-11T16:00:01Z",2,null]]},{"name":"m1,m2","tags":{"tk1":"tv2"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",3,null]]}]}]}`,
+		},
+		{
+			name:    "right outer join on one tag",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.f2 from (select f1 from mst) as m1 right outer join (select f2 from mst2) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",1,10]]},{"name":"m1,m2","tags":{"tk1":"tv3"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",null,30]]}]}]}`,
+		},
+		{
+			name:    "asof join on one tag",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.f2 from (select f1 from mst) as m1 asof join (select f2 from mst2) as m2 on (m1.tk1 = m2.tk1) group by tk1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",1,10],["2021-01-11T16:00:01Z",2,10]]},{"name":"m1,m2","tags":{"tk1":"tv2"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",3,null]]}]}]}`,
+		},
+		{
+			name:    "asof join with tolerance",
+			params:  url.Values{"db": []string{"db0"}},
+			command: `select m1.f1, m2.f2 from (select f1 from mst) as m1 asof join (select f2 from mst2) as m2 on (m1.tk1 = m2.tk1) tolerance 500ms group by tk1`,
+			exp:     `{"results":[{"statement_id":0,"series":[{"name":"m1,m2","tags":{"tk1":"tv1"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",1,10],["2021-01-11T16:00:01Z",2,null]]},{"name":"m1,m2","tags":{"tk1":"tv2"},"columns":["time","m1.f1","m2.f2"],"values":[["2021-01-11T16:00:00Z",3,null]]}]}]}`,
+		},
+	}...)
+
+	for i, query := range test.queries {
+		t.Run(query.name, func(t *testing.T) {
+			if i == 0 {
+				if err := test.init(s); err != nil {
+					t.Fatalf("test init failed: %s", err)
+				}
+			}
+			if query.skip {
+				t.Skipf("SKIP:: %s", query.name)
+			}
+			if err := query.Execute(s); err != nil {
+				t.Error(query.Error(err))
+			} else if !query.success() {
+				t.Error(query.failureMessage())
+			}
+		})
+	}
+}
 func TestServer_Write_Compatible(t *testing.T) {
 	t.Parallel()
 	s := OpenServer(NewParseConfig(testCfgPath))